@@ -266,14 +266,13 @@ func NewOsmosisApp(
 			app.AccountKeeper,
 			app.BankKeeper,
 			app.TxFeesKeeper,
-			app.GAMMKeeper,
+			app.FeeGrantKeeper,
 			ante.DefaultSigVerificationGasConsumer,
 			encodingConfig.TxConfig.SignModeHandler(),
 			app.IBCKeeper,
 		),
 	)
-	// Uncomment to enable postHandlers:
-	// app.SetPostHandler(NewTxPostHandler())
+	app.SetPostHandler(NewTxPostHandler(app.ProtoRevKeeper))
 	app.SetEndBlocker(app.EndBlocker)
 
 	// Register snapshot extensions to enable state-sync for wasm.
@@ -0,0 +1,31 @@
+package apptesting
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v13/x/concentrated-liquidity/model"
+)
+
+var (
+	DefaultConcentratedTickSpacing = uint64(1)
+	DefaultConcentratedSwapFee     = sdk.ZeroDec()
+)
+
+// PrepareConcentratedPool returns the id of a new concentrated liquidity pool
+// between the given denoms, with the default tick spacing and swap fee.
+// The pool has no liquidity and no price until a position is created.
+func (s *KeeperTestHelper) PrepareConcentratedPool(denom0, denom1 string) uint64 {
+	return s.PrepareCustomConcentratedPool(denom0, denom1, DefaultConcentratedTickSpacing, DefaultConcentratedSwapFee)
+}
+
+// PrepareCustomConcentratedPool returns the id of a new concentrated liquidity
+// pool between the given denoms, with the given tick spacing and swap fee.
+func (s *KeeperTestHelper) PrepareCustomConcentratedPool(denom0, denom1 string, tickSpacing uint64, swapFee sdk.Dec) uint64 {
+	// Mint some assets to the account to pay for the pool creation fee.
+	s.FundAcc(s.TestAccs[0], DefaultAcctFunds)
+
+	msg := model.NewMsgCreateConcentratedPool(s.TestAccs[0], denom0, denom1, tickSpacing, swapFee)
+	poolId, err := s.App.SwapRouterKeeper.CreatePool(s.Ctx, msg)
+	s.Require().NoError(err)
+	return poolId
+}
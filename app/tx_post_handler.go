@@ -2,8 +2,12 @@ package app
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	protorevkeeper "github.com/osmosis-labs/osmosis/v13/x/protorev/keeper"
 )
 
-func NewTxPostHandler() sdk.AnteHandler {
-	panic("not implemented")
+func NewTxPostHandler(protoRevKeeper *protorevkeeper.Keeper) sdk.AnteHandler {
+	protoRevDecorator := protorevkeeper.NewProtoRevDecorator(*protoRevKeeper)
+
+	return sdk.ChainAnteDecorators(protoRevDecorator)
 }
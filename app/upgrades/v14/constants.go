@@ -2,9 +2,11 @@ package v14
 
 import (
 	store "github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/cosmos/cosmos-sdk/x/feegrant"
 	ibchookstypes "github.com/osmosis-labs/osmosis/v13/x/ibc-hooks/types"
 
 	"github.com/osmosis-labs/osmosis/v13/app/upgrades"
+	concentratedliquiditytypes "github.com/osmosis-labs/osmosis/v13/x/concentrated-liquidity/types"
 	downtimetypes "github.com/osmosis-labs/osmosis/v13/x/downtime-detector/types"
 	protorevtypes "github.com/osmosis-labs/osmosis/v13/x/protorev/types"
 	swaproutertypes "github.com/osmosis-labs/osmosis/v13/x/swaprouter/types"
@@ -18,7 +20,7 @@ var Upgrade = upgrades.Upgrade{
 	UpgradeName:          UpgradeName,
 	CreateUpgradeHandler: CreateUpgradeHandler,
 	StoreUpgrades: store.StoreUpgrades{
-		Added:   []string{valsetpreftypes.StoreKey, protorevtypes.StoreKey, swaproutertypes.StoreKey, downtimetypes.StoreKey, ibchookstypes.StoreKey},
+		Added:   []string{valsetpreftypes.StoreKey, protorevtypes.StoreKey, swaproutertypes.StoreKey, concentratedliquiditytypes.StoreKey, downtimetypes.StoreKey, ibchookstypes.StoreKey, feegrant.StoreKey},
 		Deleted: []string{},
 	},
 }
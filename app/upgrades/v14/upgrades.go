@@ -1,6 +1,8 @@
 package v14
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
@@ -8,8 +10,11 @@ import (
 	"github.com/osmosis-labs/osmosis/v13/app/keepers"
 	"github.com/osmosis-labs/osmosis/v13/app/upgrades"
 	gammkeeper "github.com/osmosis-labs/osmosis/v13/x/gamm/keeper"
+	gammtypes "github.com/osmosis-labs/osmosis/v13/x/gamm/types"
 	"github.com/osmosis-labs/osmosis/v13/x/swaprouter"
 	swaproutertypes "github.com/osmosis-labs/osmosis/v13/x/swaprouter/types"
+	twaptypes "github.com/osmosis-labs/osmosis/v13/x/twap/types"
+	txfeestypes "github.com/osmosis-labs/osmosis/v13/x/txfees/types"
 )
 
 func CreateUpgradeHandler(
@@ -19,6 +24,15 @@ func CreateUpgradeHandler(
 	keepers *keepers.AppKeepers,
 ) upgradetypes.UpgradeHandler {
 	return func(ctx sdk.Context, plan upgradetypes.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
+		// N.B.: the scaling factor rate provider epoch identifier and the max taker fee share
+		// are new gamm parameters, so they must be set before the gamm params are read.
+		gammParamSpace, ok := keepers.ParamsKeeper.GetSubspace(gammtypes.ModuleName)
+		if !ok {
+			return nil, fmt.Errorf("gamm param subspace not found")
+		}
+		gammParamSpace.Set(ctx, gammtypes.KeyScalingFactorRateProviderEpochIdentifier, gammtypes.DefaultParams().ScalingFactorRateProviderEpochIdentifier)
+		gammParamSpace.Set(ctx, gammtypes.KeyMaxTakerFeeShare, gammtypes.DefaultParams().MaxTakerFeeShare)
+
 		swaprouterParams := swaproutertypes.NewParams(keepers.GAMMKeeper.GetParams(ctx).PoolCreationFee)
 
 		keepers.SwapRouterKeeper.SetParams(ctx, swaprouterParams)
@@ -27,6 +41,20 @@ func CreateUpgradeHandler(
 		// Instead,it is moved to swaprouter.
 		migrateNextPoolId(ctx, keepers.GAMMKeeper, keepers.SwapRouterKeeper)
 
+		// N.B.: checkpoint tiers are a new twap parameter, so they must be set
+		// before the twap params are read.
+		twapParamSpace, ok := keepers.ParamsKeeper.GetSubspace(twaptypes.ModuleName)
+		if !ok {
+			return nil, fmt.Errorf("twap param subspace not found")
+		}
+		twapParamSpace.Set(ctx, twaptypes.KeyCheckpointTiers, twaptypes.DefaultCheckpointTiers())
+
+		// txfees has no params before v14.
+		// The on-chain base fee starts at its minimum, and is left disabled until enabled by governance.
+		txfeesParams := txfeestypes.DefaultParams()
+		keepers.TxFeesKeeper.SetParams(ctx, txfeesParams)
+		keepers.TxFeesKeeper.SetCurrentBaseFee(ctx, txfeesParams.MinBaseFee)
+
 		//  N.B.: this is done to avoid initializing genesis for swaprouter module.
 		// Otherwise, it would overwrite migrations with InitGenesis().
 		// See RunMigrations() for details.
@@ -183,6 +183,34 @@ func (mr *MockCFMMPoolIMockRecorder) GetSwapFee(ctx interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSwapFee", reflect.TypeOf((*MockCFMMPoolI)(nil).GetSwapFee), ctx)
 }
 
+// GetTakerFeeRecipient mocks base method.
+func (m *MockCFMMPoolI) GetTakerFeeRecipient(ctx types.Context) string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTakerFeeRecipient", ctx)
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// GetTakerFeeRecipient indicates an expected call of GetTakerFeeRecipient.
+func (mr *MockCFMMPoolIMockRecorder) GetTakerFeeRecipient(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTakerFeeRecipient", reflect.TypeOf((*MockCFMMPoolI)(nil).GetTakerFeeRecipient), ctx)
+}
+
+// GetTakerFeeShare mocks base method.
+func (m *MockCFMMPoolI) GetTakerFeeShare(ctx types.Context) types.Dec {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTakerFeeShare", ctx)
+	ret0, _ := ret[0].(types.Dec)
+	return ret0
+}
+
+// GetTakerFeeShare indicates an expected call of GetTakerFeeShare.
+func (mr *MockCFMMPoolIMockRecorder) GetTakerFeeShare(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTakerFeeShare", reflect.TypeOf((*MockCFMMPoolI)(nil).GetTakerFeeShare), ctx)
+}
+
 // GetTotalPoolLiquidity mocks base method.
 func (m *MockCFMMPoolI) GetTotalPoolLiquidity(ctx types.Context) types.Coins {
 	m.ctrl.T.Helper()
@@ -539,6 +567,34 @@ func (mr *MockPoolAmountOutExtensionMockRecorder) GetSwapFee(ctx interface{}) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSwapFee", reflect.TypeOf((*MockPoolAmountOutExtension)(nil).GetSwapFee), ctx)
 }
 
+// GetTakerFeeRecipient mocks base method.
+func (m *MockPoolAmountOutExtension) GetTakerFeeRecipient(ctx types.Context) string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTakerFeeRecipient", ctx)
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// GetTakerFeeRecipient indicates an expected call of GetTakerFeeRecipient.
+func (mr *MockPoolAmountOutExtensionMockRecorder) GetTakerFeeRecipient(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTakerFeeRecipient", reflect.TypeOf((*MockPoolAmountOutExtension)(nil).GetTakerFeeRecipient), ctx)
+}
+
+// GetTakerFeeShare mocks base method.
+func (m *MockPoolAmountOutExtension) GetTakerFeeShare(ctx types.Context) types.Dec {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTakerFeeShare", ctx)
+	ret0, _ := ret[0].(types.Dec)
+	return ret0
+}
+
+// GetTakerFeeShare indicates an expected call of GetTakerFeeShare.
+func (mr *MockPoolAmountOutExtensionMockRecorder) GetTakerFeeShare(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTakerFeeShare", reflect.TypeOf((*MockPoolAmountOutExtension)(nil).GetTakerFeeShare), ctx)
+}
+
 // GetTotalPoolLiquidity mocks base method.
 func (m *MockPoolAmountOutExtension) GetTotalPoolLiquidity(ctx types.Context) types.Coins {
 	m.ctrl.T.Helper()
@@ -907,6 +963,34 @@ func (mr *MockWeightedPoolExtensionMockRecorder) GetTokenWeight(denom interface{
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTokenWeight", reflect.TypeOf((*MockWeightedPoolExtension)(nil).GetTokenWeight), denom)
 }
 
+// GetTakerFeeRecipient mocks base method.
+func (m *MockWeightedPoolExtension) GetTakerFeeRecipient(ctx types.Context) string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTakerFeeRecipient", ctx)
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// GetTakerFeeRecipient indicates an expected call of GetTakerFeeRecipient.
+func (mr *MockWeightedPoolExtensionMockRecorder) GetTakerFeeRecipient(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTakerFeeRecipient", reflect.TypeOf((*MockWeightedPoolExtension)(nil).GetTakerFeeRecipient), ctx)
+}
+
+// GetTakerFeeShare mocks base method.
+func (m *MockWeightedPoolExtension) GetTakerFeeShare(ctx types.Context) types.Dec {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTakerFeeShare", ctx)
+	ret0, _ := ret[0].(types.Dec)
+	return ret0
+}
+
+// GetTakerFeeShare indicates an expected call of GetTakerFeeShare.
+func (mr *MockWeightedPoolExtensionMockRecorder) GetTakerFeeShare(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTakerFeeShare", reflect.TypeOf((*MockWeightedPoolExtension)(nil).GetTakerFeeShare), ctx)
+}
+
 // GetTotalPoolLiquidity mocks base method.
 func (m *MockWeightedPoolExtension) GetTotalPoolLiquidity(ctx types.Context) types.Coins {
 	m.ctrl.T.Helper()
@@ -21,6 +21,17 @@ type OsmosisQuery struct {
 	EstimateSwap *EstimateSwap `json:"estimate_swap,omitempty"`
 	/// Returns the admin of a denom, if the denom is a Token Factory denom.
 	DenomAdmin *DenomAdmin `json:"denom_admin,omitempty"`
+	/// Return the arithmetic time weighted average price of base in terms of quote on given pool ID.
+	ArithmeticTwap *ArithmeticTwap `json:"arithmetic_twap,omitempty"`
+	/// Return the arithmetic time weighted average price of base in terms of quote on given pool ID,
+	/// from the given start time until the current block time.
+	ArithmeticTwapToNow *ArithmeticTwapToNow `json:"arithmetic_twap_to_now,omitempty"`
+	/// Return the geometric time weighted average price of base in terms of quote on given pool ID.
+	/// The geometric mean is more resistant to short-lived price manipulation than the arithmetic mean.
+	GeometricTwap *GeometricTwap `json:"geometric_twap,omitempty"`
+	/// Return the geometric time weighted average price of base in terms of quote on given pool ID,
+	/// from the given start time until the current block time.
+	GeometricTwapToNow *GeometricTwapToNow `json:"geometric_twap_to_now,omitempty"`
 }
 
 type FullDenom struct {
@@ -70,6 +81,24 @@ type ArithmeticTwapToNow struct {
 	StartTime int64 `json:"start_time"`
 }
 
+type GeometricTwap struct {
+	PoolId          uint64 `json:"id"`
+	QuoteAssetDenom string `json:"quote_asset_denom"`
+	BaseAssetDenom  string `json:"base_asset_denom"`
+	// NOTE: StartTime is expected to be in Unix time milliseconds.
+	StartTime int64 `json:"start_time"`
+	// NOTE: EndTime is expected to be in Unix time milliseconds.
+	EndTime int64 `json:"end_time"`
+}
+
+type GeometricTwapToNow struct {
+	PoolId          uint64 `json:"id"`
+	QuoteAssetDenom string `json:"quote_asset_denom"`
+	BaseAssetDenom  string `json:"base_asset_denom"`
+	// NOTE: StartTime is expected to be in Unix time milliseconds.
+	StartTime int64 `json:"start_time"`
+}
+
 func (e *EstimateSwap) ToSwapMsg() *SwapMsg {
 	return &SwapMsg{
 		First:  e.First,
@@ -99,3 +128,11 @@ type EstimatePriceResponse struct {
 	// If you query with SwapAmount::Output, this is SwapAmount::Input.
 	Amount SwapAmount `json:"swap_amount"`
 }
+
+type ArithmeticTwapResponse struct {
+	Twap string `json:"twap"`
+}
+
+type GeometricTwapResponse struct {
+	Twap string `json:"twap"`
+}
@@ -9,22 +9,22 @@ import (
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
 	"github.com/osmosis-labs/osmosis/v13/wasmbinding/bindings"
-	gammkeeper "github.com/osmosis-labs/osmosis/v13/x/gamm/keeper"
 	gammtypes "github.com/osmosis-labs/osmosis/v13/x/gamm/types"
+	"github.com/osmosis-labs/osmosis/v13/x/swaprouter"
 	tokenfactorykeeper "github.com/osmosis-labs/osmosis/v13/x/tokenfactory/keeper"
 	twapkeeper "github.com/osmosis-labs/osmosis/v13/x/twap"
 )
 
 type QueryPlugin struct {
-	gammKeeper         *gammkeeper.Keeper
+	swaprouterKeeper   *swaprouter.Keeper
 	twapKeeper         *twapkeeper.Keeper
 	tokenFactoryKeeper *tokenfactorykeeper.Keeper
 }
 
 // NewQueryPlugin returns a reference to a new QueryPlugin.
-func NewQueryPlugin(gk *gammkeeper.Keeper, tk *twapkeeper.Keeper, tfk *tokenfactorykeeper.Keeper) *QueryPlugin {
+func NewQueryPlugin(sk *swaprouter.Keeper, tk *twapkeeper.Keeper, tfk *tokenfactorykeeper.Keeper) *QueryPlugin {
 	return &QueryPlugin{
-		gammKeeper:         gk,
+		swaprouterKeeper:   sk,
 		twapKeeper:         tk,
 		tokenFactoryKeeper: tfk,
 	}
@@ -42,7 +42,7 @@ func (qp QueryPlugin) GetDenomAdmin(ctx sdk.Context, denom string) (*bindings.De
 
 // GetPoolState is a query to get pool liquidity and amount of each denoms' pool shares.
 func (qp QueryPlugin) GetPoolState(ctx sdk.Context, poolID uint64) (*bindings.PoolAssets, error) {
-	poolData, err := qp.gammKeeper.GetPoolAndPoke(ctx, poolID)
+	poolData, err := qp.swaprouterKeeper.GetPool(ctx, poolID)
 	if err != nil {
 		return nil, sdkerrors.Wrap(err, "gamm get pool")
 	}
@@ -67,13 +67,13 @@ func (qp QueryPlugin) GetSpotPrice(ctx sdk.Context, spotPrice *bindings.SpotPric
 	quoteAsset := spotPrice.Swap.DenomIn
 	withSwapFee := spotPrice.WithSwapFee
 
-	price, err := qp.gammKeeper.CalculateSpotPrice(ctx, poolId, quoteAsset, baseAsset)
+	price, err := qp.swaprouterKeeper.RouteCalculateSpotPrice(ctx, poolId, quoteAsset, baseAsset)
 	if err != nil {
 		return nil, sdkerrors.Wrap(err, "gamm get spot price")
 	}
 
 	if withSwapFee {
-		poolData, err := qp.gammKeeper.GetPoolAndPoke(ctx, poolId)
+		poolData, err := qp.swaprouterKeeper.GetPool(ctx, poolId)
 		if err != nil {
 			return nil, sdkerrors.Wrap(err, "gamm get pool")
 		}
@@ -104,7 +104,7 @@ func (qp QueryPlugin) EstimateSwap(ctx sdk.Context, estimateSwap *bindings.Estim
 		return nil, wasmvmtypes.InvalidRequest{Err: "gamm estimate swap empty swap"}
 	}
 
-	estimate, err := PerformSwap(qp.gammKeeper, ctx, senderAddr, estimateSwap.ToSwapMsg())
+	estimate, err := PerformSwap(qp.swaprouterKeeper, ctx, senderAddr, estimateSwap.ToSwapMsg())
 	return estimate, err
 }
 
@@ -144,3 +144,40 @@ func (qp QueryPlugin) ArithmeticTwapToNow(ctx sdk.Context, arithmeticTwap *bindi
 
 	return &twap, nil
 }
+
+func (qp QueryPlugin) GeometricTwap(ctx sdk.Context, geometricTwap *bindings.GeometricTwap) (*sdk.Dec, error) {
+	if geometricTwap == nil {
+		return nil, wasmvmtypes.InvalidRequest{Err: "gamm geometric twap null"}
+	}
+
+	poolId := geometricTwap.PoolId
+	quoteAssetDenom := geometricTwap.QuoteAssetDenom
+	baseAssetDenom := geometricTwap.BaseAssetDenom
+	startTime := time.UnixMilli(geometricTwap.StartTime)
+	endTime := time.UnixMilli(geometricTwap.EndTime)
+
+	twap, err := qp.twapKeeper.GetGeometricTwap(ctx, poolId, baseAssetDenom, quoteAssetDenom, startTime, endTime)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "gamm geometric twap")
+	}
+
+	return &twap, nil
+}
+
+func (qp QueryPlugin) GeometricTwapToNow(ctx sdk.Context, geometricTwap *bindings.GeometricTwapToNow) (*sdk.Dec, error) {
+	if geometricTwap == nil {
+		return nil, wasmvmtypes.InvalidRequest{Err: "gamm geometric twap null"}
+	}
+
+	poolId := geometricTwap.PoolId
+	quoteAssetDenom := geometricTwap.QuoteAssetDenom
+	baseAssetDenom := geometricTwap.BaseAssetDenom
+	startTime := time.UnixMilli(geometricTwap.StartTime)
+
+	twap, err := qp.twapKeeper.GetGeometricTwapToNow(ctx, poolId, baseAssetDenom, quoteAssetDenom, startTime)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "gamm geometric twap")
+	}
+
+	return &twap, nil
+}
@@ -137,6 +137,62 @@ func CustomQuerier(qp *QueryPlugin) func(ctx sdk.Context, request json.RawMessag
 
 			return bz, nil
 
+		case contractQuery.ArithmeticTwap != nil:
+			twap, err := qp.ArithmeticTwap(ctx, contractQuery.ArithmeticTwap)
+			if err != nil {
+				return nil, sdkerrors.Wrap(err, "osmo arithmetic twap query")
+			}
+
+			res := bindings.ArithmeticTwapResponse{Twap: twap.String()}
+			bz, err := json.Marshal(res)
+			if err != nil {
+				return nil, sdkerrors.Wrap(err, "osmo arithmetic twap query response")
+			}
+
+			return bz, nil
+
+		case contractQuery.ArithmeticTwapToNow != nil:
+			twap, err := qp.ArithmeticTwapToNow(ctx, contractQuery.ArithmeticTwapToNow)
+			if err != nil {
+				return nil, sdkerrors.Wrap(err, "osmo arithmetic twap to now query")
+			}
+
+			res := bindings.ArithmeticTwapResponse{Twap: twap.String()}
+			bz, err := json.Marshal(res)
+			if err != nil {
+				return nil, sdkerrors.Wrap(err, "osmo arithmetic twap to now query response")
+			}
+
+			return bz, nil
+
+		case contractQuery.GeometricTwap != nil:
+			twap, err := qp.GeometricTwap(ctx, contractQuery.GeometricTwap)
+			if err != nil {
+				return nil, sdkerrors.Wrap(err, "osmo geometric twap query")
+			}
+
+			res := bindings.GeometricTwapResponse{Twap: twap.String()}
+			bz, err := json.Marshal(res)
+			if err != nil {
+				return nil, sdkerrors.Wrap(err, "osmo geometric twap query response")
+			}
+
+			return bz, nil
+
+		case contractQuery.GeometricTwapToNow != nil:
+			twap, err := qp.GeometricTwapToNow(ctx, contractQuery.GeometricTwapToNow)
+			if err != nil {
+				return nil, sdkerrors.Wrap(err, "osmo geometric twap to now query")
+			}
+
+			res := bindings.GeometricTwapResponse{Twap: twap.String()}
+			bz, err := json.Marshal(res)
+			if err != nil {
+				return nil, sdkerrors.Wrap(err, "osmo geometric twap to now query response")
+			}
+
+			return bz, nil
+
 		default:
 			return nil, wasmvmtypes.UnsupportedRequest{Kind: "unknown osmosis query variant"}
 		}
@@ -22,6 +22,7 @@ import (
 	minttypes "github.com/osmosis-labs/osmosis/v13/x/mint/types"
 	poolincentivestypes "github.com/osmosis-labs/osmosis/v13/x/pool-incentives/types"
 	superfluidtypes "github.com/osmosis-labs/osmosis/v13/x/superfluid/types"
+	swaprouterqueryproto "github.com/osmosis-labs/osmosis/v13/x/swaprouter/client/queryproto"
 	tokenfactorytypes "github.com/osmosis-labs/osmosis/v13/x/tokenfactory/types"
 	twapquerytypes "github.com/osmosis-labs/osmosis/v13/x/twap/client/queryproto"
 	txfeestypes "github.com/osmosis-labs/osmosis/v13/x/txfees/types"
@@ -111,6 +112,12 @@ func init() {
 	setWhitelistedQuery("/osmosis.superfluid.Query/AllAssets", &superfluidtypes.AllAssetsResponse{})
 	setWhitelistedQuery("/osmosis.superfluid.Query/AssetMultiplier", &superfluidtypes.AssetMultiplierResponse{})
 
+	// swaprouter
+	setWhitelistedQuery("/osmosis.swaprouter.v1beta1.Query/EstimateOptimalRouteSwapExactAmountIn", &swaprouterqueryproto.EstimateOptimalRouteSwapExactAmountInResponse{})
+	setWhitelistedQuery("/osmosis.swaprouter.v1beta1.Query/Pool", &swaprouterqueryproto.PoolResponse{})
+	setWhitelistedQuery("/osmosis.swaprouter.v1beta1.Query/SpotPrice", &swaprouterqueryproto.SpotPriceResponse{})
+	setWhitelistedQuery("/osmosis.swaprouter.v1beta1.Query/TotalLiquidity", &swaprouterqueryproto.TotalLiquidityResponse{})
+
 	// txfees
 	setWhitelistedQuery("/osmosis.txfees.v1beta1.Query/FeeTokens", &txfeestypes.QueryFeeTokensResponse{})
 	setWhitelistedQuery("/osmosis.txfees.v1beta1.Query/DenomSpotPrice", &txfeestypes.QueryDenomSpotPriceResponse{})
@@ -125,6 +132,8 @@ func init() {
 	// twap
 	setWhitelistedQuery("/osmosis.twap.v1beta1.Query/ArithmeticTwap", &twapquerytypes.ArithmeticTwapResponse{})
 	setWhitelistedQuery("/osmosis.twap.v1beta1.Query/ArithmeticTwapToNow", &twapquerytypes.ArithmeticTwapToNowResponse{})
+	setWhitelistedQuery("/osmosis.twap.v1beta1.Query/GeometricTwap", &twapquerytypes.GeometricTwapResponse{})
+	setWhitelistedQuery("/osmosis.twap.v1beta1.Query/GeometricTwapToNow", &twapquerytypes.GeometricTwapToNowResponse{})
 	setWhitelistedQuery("/osmosis.twap.v1beta1.Query/Params", &twapquerytypes.ParamsResponse{})
 
 	// downtime-detector
@@ -3,6 +3,7 @@ package wasmbinding
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -78,7 +79,7 @@ func TestDenomAdmin(t *testing.T) {
 	require.NoError(t, err)
 	require.NotEmpty(t, tfDenom)
 
-	queryPlugin := wasmbinding.NewQueryPlugin(app.GAMMKeeper, app.TwapKeeper, app.TokenFactoryKeeper)
+	queryPlugin := wasmbinding.NewQueryPlugin(app.SwapRouterKeeper, app.TwapKeeper, app.TokenFactoryKeeper)
 
 	testCases := []struct {
 		name        string
@@ -132,7 +133,7 @@ func TestPoolState(t *testing.T) {
 	starSharesDenom := fmt.Sprintf("gamm/pool/%d", starPool)
 	starSharedAmount, _ := sdk.NewIntFromString("100_000_000_000_000_000_000")
 
-	queryPlugin := wasmbinding.NewQueryPlugin(osmosis.GAMMKeeper, osmosis.TwapKeeper, osmosis.TokenFactoryKeeper)
+	queryPlugin := wasmbinding.NewQueryPlugin(osmosis.SwapRouterKeeper, osmosis.TwapKeeper, osmosis.TokenFactoryKeeper)
 
 	specs := map[string]struct {
 		poolId       uint64
@@ -192,7 +193,7 @@ func TestSpotPrice(t *testing.T) {
 	starFee := sdk.MustNewDecFromStr(fmt.Sprintf("%f", swapFee))
 	starPriceWithFee := starPrice.Add(starFee)
 
-	queryPlugin := wasmbinding.NewQueryPlugin(osmosis.GAMMKeeper, osmosis.TwapKeeper, osmosis.TokenFactoryKeeper)
+	queryPlugin := wasmbinding.NewQueryPlugin(osmosis.SwapRouterKeeper, osmosis.TwapKeeper, osmosis.TokenFactoryKeeper)
 
 	specs := map[string]struct {
 		spotPrice *bindings.SpotPrice
@@ -335,7 +336,7 @@ func TestEstimateSwap(t *testing.T) {
 
 	starSwapAmount := bindings.SwapAmount{Out: &starAmount}
 
-	queryPlugin := wasmbinding.NewQueryPlugin(osmosis.GAMMKeeper, osmosis.TwapKeeper, osmosis.TokenFactoryKeeper)
+	queryPlugin := wasmbinding.NewQueryPlugin(osmosis.SwapRouterKeeper, osmosis.TwapKeeper, osmosis.TokenFactoryKeeper)
 
 	specs := map[string]struct {
 		estimateSwap *bindings.EstimateSwap
@@ -539,3 +540,98 @@ func TestEstimateSwap(t *testing.T) {
 		})
 	}
 }
+
+func TestGeometricTwap(t *testing.T) {
+	actor := RandomAccountAddress()
+	epsilon := 1e-6
+	osmosis, ctx := SetupCustomApp(t, actor)
+
+	fundAccount(t, ctx, osmosis, actor, defaultFunds)
+
+	poolFunds := []sdk.Coin{
+		sdk.NewInt64Coin("uosmo", 12000000),
+		sdk.NewInt64Coin("ustar", 240000000),
+	}
+	// 20 star to 1 osmo
+	starPool := preparePool(t, ctx, osmosis, actor, poolFunds)
+
+	// start after pool creation, since the binding truncates to milliseconds.
+	startTime := ctx.BlockTime().Add(time.Second)
+	ctx = ctx.WithBlockTime(startTime.Add(time.Hour))
+	endTime := startTime.Add(time.Minute)
+
+	queryPlugin := wasmbinding.NewQueryPlugin(osmosis.SwapRouterKeeper, osmosis.TwapKeeper, osmosis.TokenFactoryKeeper)
+
+	specs := map[string]struct {
+		geometricTwap *bindings.GeometricTwap
+		expTwap       float64
+		expErr        bool
+	}{
+		"valid geometric twap": {
+			geometricTwap: &bindings.GeometricTwap{
+				PoolId:          starPool,
+				QuoteAssetDenom: "ustar",
+				BaseAssetDenom:  "uosmo",
+				StartTime:       startTime.UnixMilli(),
+				EndTime:         endTime.UnixMilli(),
+			},
+			expTwap: 20,
+		},
+		"valid geometric twap, reversed": {
+			geometricTwap: &bindings.GeometricTwap{
+				PoolId:          starPool,
+				QuoteAssetDenom: "uosmo",
+				BaseAssetDenom:  "ustar",
+				StartTime:       startTime.UnixMilli(),
+				EndTime:         endTime.UnixMilli(),
+			},
+			expTwap: 0.05,
+		},
+		"non-existent pool id": {
+			geometricTwap: &bindings.GeometricTwap{
+				PoolId:          starPool + 1,
+				QuoteAssetDenom: "ustar",
+				BaseAssetDenom:  "uosmo",
+				StartTime:       startTime.UnixMilli(),
+				EndTime:         endTime.UnixMilli(),
+			},
+			expErr: true,
+		},
+		"end time in the future": {
+			geometricTwap: &bindings.GeometricTwap{
+				PoolId:          starPool,
+				QuoteAssetDenom: "ustar",
+				BaseAssetDenom:  "uosmo",
+				StartTime:       startTime.UnixMilli(),
+				EndTime:         startTime.Add(2 * time.Hour).UnixMilli(),
+			},
+			expErr: true,
+		},
+		"nil geometric twap": {
+			geometricTwap: nil,
+			expErr:        true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			// when
+			gotTwap, gotErr := queryPlugin.GeometricTwap(ctx, spec.geometricTwap)
+			// then
+			if spec.expErr {
+				require.Error(t, gotErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.InEpsilonf(t, spec.expTwap, gotTwap.MustFloat64(), epsilon, "exp %f but got %s", spec.expTwap, gotTwap.String())
+
+			gotTwapToNow, gotErr := queryPlugin.GeometricTwapToNow(ctx, &bindings.GeometricTwapToNow{
+				PoolId:          spec.geometricTwap.PoolId,
+				QuoteAssetDenom: spec.geometricTwap.QuoteAssetDenom,
+				BaseAssetDenom:  spec.geometricTwap.BaseAssetDenom,
+				StartTime:       spec.geometricTwap.StartTime,
+			})
+			require.NoError(t, gotErr)
+			assert.InEpsilonf(t, spec.expTwap, gotTwapToNow.MustFloat64(), epsilon, "exp %f but got %s", spec.expTwap, gotTwapToNow.String())
+		})
+	}
+}
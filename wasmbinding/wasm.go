@@ -9,24 +9,24 @@ import (
 
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
 
-	gammkeeper "github.com/osmosis-labs/osmosis/v13/x/gamm/keeper"
+	"github.com/osmosis-labs/osmosis/v13/x/swaprouter"
 	tokenfactorykeeper "github.com/osmosis-labs/osmosis/v13/x/tokenfactory/keeper"
 	twap "github.com/osmosis-labs/osmosis/v13/x/twap"
 )
 
 func RegisterCustomPlugins(
-	gammKeeper *gammkeeper.Keeper,
+	swaprouterKeeper *swaprouter.Keeper,
 	bank *bankkeeper.BaseKeeper,
 	twap *twap.Keeper,
 	tokenFactory *tokenfactorykeeper.Keeper,
 ) []wasmkeeper.Option {
-	wasmQueryPlugin := NewQueryPlugin(gammKeeper, twap, tokenFactory)
+	wasmQueryPlugin := NewQueryPlugin(swaprouterKeeper, twap, tokenFactory)
 
 	queryPluginOpt := wasmkeeper.WithQueryPlugins(&wasmkeeper.QueryPlugins{
 		Custom: CustomQuerier(wasmQueryPlugin),
 	})
 	messengerDecoratorOpt := wasmkeeper.WithMessageHandlerDecorator(
-		CustomMessageDecorator(gammKeeper, bank, tokenFactory),
+		CustomMessageDecorator(swaprouterKeeper, bank, tokenFactory),
 	)
 
 	return []wasm.Option{
@@ -0,0 +1,149 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	"github.com/osmosis-labs/osmosis/v13/osmoutils/osmocli"
+	"github.com/osmosis-labs/osmosis/v13/x/concentrated-liquidity/model"
+	"github.com/osmosis-labs/osmosis/v13/x/concentrated-liquidity/types"
+)
+
+func NewTxCmd() *cobra.Command {
+	txCmd := osmocli.TxIndexCmd(types.ModuleName)
+	txCmd.AddCommand(
+		NewCreateConcentratedPoolCmd(),
+		NewCreatePositionCmd(),
+		NewWithdrawPositionCmd(),
+	)
+	return txCmd
+}
+
+func NewCreateConcentratedPoolCmd() *cobra.Command {
+	return osmocli.TxCliDesc{
+		Use:              "create-concentrated-pool [denom-0] [denom-1] [tick-spacing] [swap-fee]",
+		Short:            "create a concentrated liquidity pool with the given tick spacing and swap fee",
+		Example:          "osmosisd tx concentratedliquidity create-concentrated-pool uion uosmo 1 0.01 --from val --chain-id osmosis-1",
+		NumArgs:          4,
+		ParseAndBuildMsg: NewBuildCreateConcentratedPoolMsg,
+	}.BuildCommandCustomFn()
+}
+
+func NewCreatePositionCmd() *cobra.Command {
+	return osmocli.TxCliDesc{
+		Use:   "create-position [pool-id] [lower-tick] [upper-tick] [token-0] [token-1] [token-0-min-amount] [token-1-min-amount]",
+		Short: "add liquidity to a concentrated liquidity pool over the given tick range",
+		Long: "Add liquidity to a concentrated liquidity pool over the given tick range. " +
+			"Negative ticks must be wrapped in brackets so they are not parsed as flags.",
+		Example:          "osmosisd tx concentratedliquidity create-position 1 [-1000] 1000 100uion 100uosmo 0 0 --from val --chain-id osmosis-1",
+		NumArgs:          7,
+		ParseAndBuildMsg: NewBuildCreatePositionMsg,
+	}.BuildCommandCustomFn()
+}
+
+func NewWithdrawPositionCmd() *cobra.Command {
+	return osmocli.TxCliDesc{
+		Use:   "withdraw-position [pool-id] [lower-tick] [upper-tick] [liquidity]",
+		Short: "withdraw liquidity from a concentrated liquidity position",
+		Long: "Withdraw liquidity from a concentrated liquidity position. " +
+			"Negative ticks must be wrapped in brackets so they are not parsed as flags.",
+		Example:          "osmosisd tx concentratedliquidity withdraw-position 1 [-1000] 1000 100.5 --from val --chain-id osmosis-1",
+		NumArgs:          4,
+		ParseAndBuildMsg: NewBuildWithdrawPositionMsg,
+	}.BuildCommandCustomFn()
+}
+
+func NewBuildCreateConcentratedPoolMsg(clientCtx client.Context, args []string, fs *pflag.FlagSet) (sdk.Msg, error) {
+	tickSpacing, err := osmocli.ParseUint(args[2], "tick-spacing")
+	if err != nil {
+		return nil, err
+	}
+	swapFee, err := sdk.NewDecFromStr(args[3])
+	if err != nil {
+		return nil, err
+	}
+
+	msg := model.NewMsgCreateConcentratedPool(clientCtx.GetFromAddress(), args[0], args[1], tickSpacing, swapFee)
+	return &msg, nil
+}
+
+func NewBuildCreatePositionMsg(clientCtx client.Context, args []string, fs *pflag.FlagSet) (sdk.Msg, error) {
+	poolId, err := osmocli.ParseUint(args[0], "pool-id")
+	if err != nil {
+		return nil, err
+	}
+	lowerTick, upperTick, err := parseTickRange(args[1], args[2])
+	if err != nil {
+		return nil, err
+	}
+	tokenDesired0, err := osmocli.ParseCoin(args[3], "token-0")
+	if err != nil {
+		return nil, err
+	}
+	tokenDesired1, err := osmocli.ParseCoin(args[4], "token-1")
+	if err != nil {
+		return nil, err
+	}
+	tokenMinAmount0, err := osmocli.ParseSdkInt(args[5], "token-0-min-amount")
+	if err != nil {
+		return nil, err
+	}
+	tokenMinAmount1, err := osmocli.ParseSdkInt(args[6], "token-1-min-amount")
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCreatePosition{
+		PoolId:          poolId,
+		Sender:          clientCtx.GetFromAddress().String(),
+		LowerTick:       lowerTick,
+		UpperTick:       upperTick,
+		TokenDesired0:   tokenDesired0,
+		TokenDesired1:   tokenDesired1,
+		TokenMinAmount0: tokenMinAmount0,
+		TokenMinAmount1: tokenMinAmount1,
+	}, nil
+}
+
+func NewBuildWithdrawPositionMsg(clientCtx client.Context, args []string, fs *pflag.FlagSet) (sdk.Msg, error) {
+	poolId, err := osmocli.ParseUint(args[0], "pool-id")
+	if err != nil {
+		return nil, err
+	}
+	lowerTick, upperTick, err := parseTickRange(args[1], args[2])
+	if err != nil {
+		return nil, err
+	}
+	liquidity, err := sdk.NewDecFromStr(args[3])
+	if err != nil {
+		return nil, fmt.Errorf("could not parse %s as sdk.Dec for field liquidity: %w", args[3], err)
+	}
+
+	return &types.MsgWithdrawPosition{
+		PoolId:          poolId,
+		Sender:          clientCtx.GetFromAddress().String(),
+		LowerTick:       lowerTick,
+		UpperTick:       upperTick,
+		LiquidityAmount: liquidity,
+	}, nil
+}
+
+// parseTickRange parses the lower and upper ticks, which may be wrapped in
+// brackets to allow negative values.
+func parseTickRange(lowerArg, upperArg string) (lowerTick, upperTick int64, err error) {
+	lowerTick, err = strconv.ParseInt(strings.Trim(lowerArg, "[]"), 10, 64)
+	if err != nil {
+		return 0, 0, fmt.Errorf("could not parse %s as int64 for field lower-tick: %w", lowerArg, err)
+	}
+	upperTick, err = strconv.ParseInt(strings.Trim(upperArg, "[]"), 10, 64)
+	if err != nil {
+		return 0, 0, fmt.Errorf("could not parse %s as int64 for field upper-tick: %w", upperArg, err)
+	}
+	return lowerTick, upperTick, nil
+}
@@ -0,0 +1,34 @@
+package concentrated_liquidity
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v13/x/concentrated-liquidity/model"
+	"github.com/osmosis-labs/osmosis/v13/x/concentrated-liquidity/types"
+)
+
+func (k Keeper) CreatePosition(
+	ctx sdk.Context,
+	poolId uint64,
+	owner sdk.AccAddress,
+	amount0Desired, amount1Desired, amount0Min, amount1Min sdk.Int,
+	lowerTick, upperTick int64,
+) (amount0, amount1 sdk.Int, liquidityCreated sdk.Dec, err error) {
+	return k.createPosition(ctx, poolId, owner, amount0Desired, amount1Desired, amount0Min, amount1Min, lowerTick, upperTick)
+}
+
+func (k Keeper) WithdrawPosition(ctx sdk.Context, poolId uint64, owner sdk.AccAddress, lowerTick, upperTick int64, liquidityAmount sdk.Dec) (amount0, amount1 sdk.Int, err error) {
+	return k.withdrawPosition(ctx, poolId, owner, lowerTick, upperTick, liquidityAmount)
+}
+
+func (k Keeper) GetPoolById(ctx sdk.Context, poolId uint64) (*model.Pool, error) {
+	return k.getPoolById(ctx, poolId)
+}
+
+func (k Keeper) GetTickInfo(ctx sdk.Context, poolId uint64, tickIndex int64) (types.TickInfo, error) {
+	return k.getTickInfo(ctx, poolId, tickIndex)
+}
+
+func (k Keeper) NextInitializedTick(ctx sdk.Context, poolId uint64, currentTick int64, zeroForOne bool) (int64, bool, error) {
+	return k.nextInitializedTick(ctx, poolId, currentTick, zeroForOne)
+}
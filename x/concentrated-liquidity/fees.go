@@ -0,0 +1,77 @@
+package concentrated_liquidity
+
+import (
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v13/x/concentrated-liquidity/model"
+	"github.com/osmosis-labs/osmosis/v13/x/concentrated-liquidity/types"
+)
+
+// getFeeGrowthInside returns the fee growth per unit of liquidity of each
+// token that accrued while the current tick was within [lowerTick, upperTick).
+// Uninitialized ticks are treated as if they were initialized now, so the
+// result is the same before and after a position creates them.
+func (k Keeper) getFeeGrowthInside(ctx sdk.Context, pool *model.Pool, lowerTick, upperTick int64) (feeGrowthInside0, feeGrowthInside1 sdk.Dec, err error) {
+	lowerOutside0, lowerOutside1, err := k.getFeeGrowthOutside(ctx, pool, lowerTick)
+	if err != nil {
+		return sdk.Dec{}, sdk.Dec{}, err
+	}
+	upperOutside0, upperOutside1, err := k.getFeeGrowthOutside(ctx, pool, upperTick)
+	if err != nil {
+		return sdk.Dec{}, sdk.Dec{}, err
+	}
+
+	// Fee growth outside a tick is recorded relative to the side the current
+	// tick is on, convert it to the growth below the lower tick and above
+	// the upper tick.
+	below0, below1 := lowerOutside0, lowerOutside1
+	if pool.CurrentTick < lowerTick {
+		below0 = pool.FeeGrowthGlobal0.Sub(lowerOutside0)
+		below1 = pool.FeeGrowthGlobal1.Sub(lowerOutside1)
+	}
+	above0, above1 := upperOutside0, upperOutside1
+	if pool.CurrentTick >= upperTick {
+		above0 = pool.FeeGrowthGlobal0.Sub(upperOutside0)
+		above1 = pool.FeeGrowthGlobal1.Sub(upperOutside1)
+	}
+
+	feeGrowthInside0 = pool.FeeGrowthGlobal0.Sub(below0).Sub(above0)
+	feeGrowthInside1 = pool.FeeGrowthGlobal1.Sub(below1).Sub(above1)
+	return feeGrowthInside0, feeGrowthInside1, nil
+}
+
+func (k Keeper) getFeeGrowthOutside(ctx sdk.Context, pool *model.Pool, tickIndex int64) (feeGrowthOutside0, feeGrowthOutside1 sdk.Dec, err error) {
+	tickInfo, err := k.getTickInfo(ctx, pool.Id, tickIndex)
+	if err != nil {
+		return sdk.Dec{}, sdk.Dec{}, err
+	}
+	if tickInfo.LiquidityGross.IsZero() && tickIndex <= pool.CurrentTick {
+		return pool.FeeGrowthGlobal0, pool.FeeGrowthGlobal1, nil
+	}
+	return tickInfo.FeeGrowthOutside0, tickInfo.FeeGrowthOutside1, nil
+}
+
+// collectFees sends the fees owed to a position's owner and emits an event,
+// if there are any.
+func (k Keeper) collectFees(ctx sdk.Context, pool *model.Pool, owner sdk.AccAddress, lowerTick, upperTick int64, fees sdk.Coins) error {
+	if fees.IsZero() {
+		return nil
+	}
+
+	if err := k.bankKeeper.SendCoins(ctx, pool.GetAddress(), owner, fees); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.TypeEvtCollectFees,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		sdk.NewAttribute(sdk.AttributeKeySender, owner.String()),
+		sdk.NewAttribute(types.AttributeKeyPoolId, strconv.FormatUint(pool.Id, 10)),
+		sdk.NewAttribute(types.AttributeLowerTick, strconv.FormatInt(lowerTick, 10)),
+		sdk.NewAttribute(types.AttributeUpperTick, strconv.FormatInt(upperTick, 10)),
+		sdk.NewAttribute(types.AttributeFees, fees.String()),
+	))
+	return nil
+}
@@ -0,0 +1,69 @@
+package concentrated_liquidity
+
+import (
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v13/x/concentrated-liquidity/types"
+	swaproutertypes "github.com/osmosis-labs/osmosis/v13/x/swaprouter/types"
+)
+
+// InitGenesis initializes the concentrated liquidity module's state from a
+// provided genesis state, which includes its pools along with their
+// initialized ticks and positions.
+func (k Keeper) InitGenesis(ctx sdk.Context, genState types.GenesisState, unpacker codectypes.AnyUnpacker) {
+	for _, any := range genState.Pools {
+		var poolI swaproutertypes.PoolI
+		if err := unpacker.UnpackAny(any, &poolI); err != nil {
+			panic(err)
+		}
+		pool, err := asConcentratedPool(poolI)
+		if err != nil {
+			panic(err)
+		}
+		k.setPool(ctx, pool)
+	}
+
+	for _, tick := range genState.Ticks {
+		k.setTickInfo(ctx, tick.PoolId, tick.TickIndex, tick.Info)
+	}
+
+	for _, position := range genState.Positions {
+		owner, err := sdk.AccAddressFromBech32(position.Address)
+		if err != nil {
+			panic(err)
+		}
+		k.setPosition(ctx, position.PoolId, owner, position.LowerTick, position.UpperTick, position.Position)
+	}
+}
+
+// ExportGenesis returns the concentrated liquidity module's exported genesis.
+func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
+	pools, err := k.GetAllPools(ctx)
+	if err != nil {
+		panic(err)
+	}
+	poolAnys := []*codectypes.Any{}
+	for _, pool := range pools {
+		any, err := codectypes.NewAnyWithValue(pool)
+		if err != nil {
+			panic(err)
+		}
+		poolAnys = append(poolAnys, any)
+	}
+
+	ticks, err := k.GetAllTicks(ctx)
+	if err != nil {
+		panic(err)
+	}
+	positions, err := k.GetAllPositions(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	return &types.GenesisState{
+		Pools:     poolAnys,
+		Ticks:     ticks,
+		Positions: positions,
+	}
+}
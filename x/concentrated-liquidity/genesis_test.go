@@ -0,0 +1,22 @@
+package concentrated_liquidity_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (s *KeeperTestSuite) TestGenesisRoundTrip() {
+	poolId, _ := s.setupDefaultPosition(sdk.NewDecWithPrec(3, 3))
+	s.createPosition(poolId, s.TestAccs[1], defaultAmount0, defaultAmount1, defaultUpperTick, defaultUpperTick+1000)
+
+	genesis := s.clk.ExportGenesis(s.Ctx)
+	s.Require().Len(genesis.Pools, 1)
+	// Three ticks are initialized, the upper tick of the first position is
+	// shared with the lower tick of the second.
+	s.Require().Len(genesis.Ticks, 3)
+	s.Require().Len(genesis.Positions, 2)
+	s.Require().NoError(genesis.Validate())
+
+	s.SetupTest()
+	s.clk.InitGenesis(s.Ctx, *genesis, s.App.InterfaceRegistry())
+	s.Require().Equal(genesis, s.clk.ExportGenesis(s.Ctx))
+}
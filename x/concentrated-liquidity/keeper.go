@@ -0,0 +1,42 @@
+package concentrated_liquidity
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/osmosis-labs/osmosis/v13/x/concentrated-liquidity/types"
+)
+
+type Keeper struct {
+	storeKey sdk.StoreKey
+	cdc      codec.BinaryCodec
+
+	// keepers
+	bankKeeper       types.BankKeeper
+	swaprouterKeeper types.SwapRouterKeeper
+}
+
+func NewKeeper(cdc codec.BinaryCodec, storeKey sdk.StoreKey, bankKeeper types.BankKeeper) *Keeper {
+	return &Keeper{
+		storeKey:   storeKey,
+		cdc:        cdc,
+		bankKeeper: bankKeeper,
+	}
+}
+
+// SetSwapRouterKeeper sets the swaprouter keeper, used to create pools.
+// It is set after construction since swaprouter itself depends on this keeper
+// to route swaps.
+func (k *Keeper) SetSwapRouterKeeper(swaprouterKeeper types.SwapRouterKeeper) {
+	if k.swaprouterKeeper != nil {
+		panic("cannot set swaprouter keeper twice")
+	}
+	k.swaprouterKeeper = swaprouterKeeper
+}
+
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
@@ -0,0 +1,57 @@
+package concentrated_liquidity_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/osmosis-labs/osmosis/v13/app/apptesting"
+	cl "github.com/osmosis-labs/osmosis/v13/x/concentrated-liquidity"
+)
+
+const (
+	// ETH and USDC are ordered so that ETH is token0, prices are USDC per ETH.
+	ETH  = "eth"
+	USDC = "usdc"
+)
+
+var (
+	// Depositing these amounts into a new pool sets its price to 4000 USDC per ETH.
+	defaultAmount0 = sdk.NewInt(1_000_000)
+	defaultAmount1 = sdk.NewInt(4_000_000_000)
+
+	// 1.0001^82944 ~ 4000, the default position covers [~2000, ~8000].
+	defaultLowerTick int64 = 76_000
+	defaultUpperTick int64 = 89_900
+)
+
+type KeeperTestSuite struct {
+	apptesting.KeeperTestHelper
+	clk *cl.Keeper
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+func (s *KeeperTestSuite) SetupTest() {
+	s.Setup()
+	s.clk = s.App.ConcentratedLiquidityKeeper
+}
+
+// setupDefaultPosition creates an ETH/USDC pool with the given swap fee and a
+// position over the default range funded by the default amounts. Returns the
+// pool id and the liquidity of the position.
+func (s *KeeperTestSuite) setupDefaultPosition(swapFee sdk.Dec) (uint64, sdk.Dec) {
+	poolId := s.PrepareCustomConcentratedPool(ETH, USDC, 100, swapFee)
+	_, _, liquidity := s.createPosition(poolId, s.TestAccs[0], defaultAmount0, defaultAmount1, defaultLowerTick, defaultUpperTick)
+	return poolId, liquidity
+}
+
+func (s *KeeperTestSuite) createPosition(poolId uint64, owner sdk.AccAddress, amount0, amount1 sdk.Int, lowerTick, upperTick int64) (sdk.Int, sdk.Int, sdk.Dec) {
+	s.FundAcc(owner, sdk.NewCoins(sdk.NewCoin(ETH, amount0), sdk.NewCoin(USDC, amount1)))
+	amount0, amount1, liquidity, err := s.clk.CreatePosition(s.Ctx, poolId, owner, amount0, amount1, sdk.ZeroInt(), sdk.ZeroInt(), lowerTick, upperTick)
+	s.Require().NoError(err)
+	return amount0, amount1, liquidity
+}
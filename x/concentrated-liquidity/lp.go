@@ -0,0 +1,246 @@
+package concentrated_liquidity
+
+import (
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v13/osmomath"
+	"github.com/osmosis-labs/osmosis/v13/x/concentrated-liquidity/math"
+	"github.com/osmosis-labs/osmosis/v13/x/concentrated-liquidity/model"
+	"github.com/osmosis-labs/osmosis/v13/x/concentrated-liquidity/types"
+)
+
+// createPosition adds as much liquidity as the desired amounts allow to the
+// position of owner over [lowerTick, upperTick], transferring the required
+// tokens from owner to the pool. The first position of a pool sets its
+// initial price to the ratio of the desired amounts.
+// Returns the amounts deposited and the liquidity created.
+func (k Keeper) createPosition(
+	ctx sdk.Context,
+	poolId uint64,
+	owner sdk.AccAddress,
+	amount0Desired, amount1Desired, amount0Min, amount1Min sdk.Int,
+	lowerTick, upperTick int64,
+) (amount0, amount1 sdk.Int, liquidityCreated sdk.Dec, err error) {
+	pool, err := k.getPoolById(ctx, poolId)
+	if err != nil {
+		return sdk.Int{}, sdk.Int{}, sdk.Dec{}, err
+	}
+	if err := validateTicks(pool, lowerTick, upperTick); err != nil {
+		return sdk.Int{}, sdk.Int{}, sdk.Dec{}, err
+	}
+
+	if pool.CurrentSqrtPrice.IsZero() {
+		if err := initializePoolPrice(pool, amount0Desired, amount1Desired); err != nil {
+			return sdk.Int{}, sdk.Int{}, sdk.Dec{}, err
+		}
+	}
+
+	sqrtPriceLower, sqrtPriceUpper, err := tickRangeToSqrtPrices(lowerTick, upperTick)
+	if err != nil {
+		return sdk.Int{}, sdk.Int{}, sdk.Dec{}, err
+	}
+
+	var liquidity osmomath.BigDec
+	switch {
+	case pool.CurrentTick < lowerTick:
+		liquidity = math.Liquidity0(amount0Desired, sqrtPriceLower, sqrtPriceUpper)
+	case pool.CurrentTick >= upperTick:
+		liquidity = math.Liquidity1(amount1Desired, sqrtPriceLower, sqrtPriceUpper)
+	default:
+		liquidity = math.Liquidity0(amount0Desired, pool.CurrentSqrtPrice, sqrtPriceUpper)
+		// At exactly the lower tick's price the range holds no token1, so
+		// token1 does not bound the liquidity.
+		if pool.CurrentSqrtPrice.GT(sqrtPriceLower) {
+			liquidity = osmomath.MinDec(liquidity, math.Liquidity1(amount1Desired, sqrtPriceLower, pool.CurrentSqrtPrice))
+		}
+	}
+
+	liquidityCreated = liquidity.SDKDec()
+	if !liquidityCreated.IsPositive() {
+		return sdk.Int{}, sdk.Int{}, sdk.Dec{}, types.ErrNotPositiveLiquidity
+	}
+
+	amount0, amount1, err = k.updatePosition(ctx, pool, owner, lowerTick, upperTick, liquidityCreated)
+	if err != nil {
+		return sdk.Int{}, sdk.Int{}, sdk.Dec{}, err
+	}
+
+	// Rounding up can only exceed the desired amounts by dust, which is
+	// absorbed by the rounding of the liquidity.
+	amount0 = sdk.MinInt(amount0, amount0Desired)
+	amount1 = sdk.MinInt(amount1, amount1Desired)
+	if amount0.LT(amount0Min) {
+		return sdk.Int{}, sdk.Int{}, sdk.Dec{}, types.InsufficientAmountError{Denom: pool.Token0, Actual: amount0, Minimum: amount0Min}
+	}
+	if amount1.LT(amount1Min) {
+		return sdk.Int{}, sdk.Int{}, sdk.Dec{}, types.InsufficientAmountError{Denom: pool.Token1, Actual: amount1, Minimum: amount1Min}
+	}
+
+	deposit := sdk.NewCoins(sdk.NewCoin(pool.Token0, amount0), sdk.NewCoin(pool.Token1, amount1))
+	if err := k.bankKeeper.SendCoins(ctx, owner, pool.GetAddress(), deposit); err != nil {
+		return sdk.Int{}, sdk.Int{}, sdk.Dec{}, err
+	}
+
+	k.setPool(ctx, pool)
+
+	emitPositionEvent(ctx, types.TypeEvtCreatePosition, owner, pool.Id, lowerTick, upperTick, liquidityCreated, amount0, amount1)
+	return amount0, amount1, liquidityCreated, nil
+}
+
+// withdrawPosition removes liquidityAmount from the position of owner over
+// [lowerTick, upperTick], transferring the corresponding tokens from the pool
+// to owner. Returns the amounts withdrawn.
+func (k Keeper) withdrawPosition(ctx sdk.Context, poolId uint64, owner sdk.AccAddress, lowerTick, upperTick int64, liquidityAmount sdk.Dec) (amount0, amount1 sdk.Int, err error) {
+	if !liquidityAmount.IsPositive() {
+		return sdk.Int{}, sdk.Int{}, types.ErrNotPositiveLiquidity
+	}
+
+	pool, err := k.getPoolById(ctx, poolId)
+	if err != nil {
+		return sdk.Int{}, sdk.Int{}, err
+	}
+
+	position, err := k.GetPosition(ctx, poolId, owner, lowerTick, upperTick)
+	if err != nil {
+		return sdk.Int{}, sdk.Int{}, err
+	}
+	if liquidityAmount.GT(position.Liquidity) {
+		return sdk.Int{}, sdk.Int{}, types.InsufficientLiquidityError{Actual: liquidityAmount, Available: position.Liquidity}
+	}
+
+	amount0, amount1, err = k.updatePosition(ctx, pool, owner, lowerTick, upperTick, liquidityAmount.Neg())
+	if err != nil {
+		return sdk.Int{}, sdk.Int{}, err
+	}
+
+	withdrawn := sdk.NewCoins(sdk.NewCoin(pool.Token0, amount0), sdk.NewCoin(pool.Token1, amount1))
+	if err := k.bankKeeper.SendCoins(ctx, pool.GetAddress(), owner, withdrawn); err != nil {
+		return sdk.Int{}, sdk.Int{}, err
+	}
+
+	k.setPool(ctx, pool)
+
+	emitPositionEvent(ctx, types.TypeEvtWithdrawPosition, owner, pool.Id, lowerTick, upperTick, liquidityAmount, amount0, amount1)
+	return amount0, amount1, nil
+}
+
+// updatePosition applies liquidityDelta to the position of owner over
+// [lowerTick, upperTick], its ticks and the pool's active liquidity, paying out
+// the fees the position earned since it was last updated. The given pool is
+// updated in place, but not stored.
+// Returns the token amounts that correspond to liquidityDelta, rounded up
+// when liquidity is added and down when it is removed.
+func (k Keeper) updatePosition(ctx sdk.Context, pool *model.Pool, owner sdk.AccAddress, lowerTick, upperTick int64, liquidityDelta sdk.Dec) (amount0, amount1 sdk.Int, err error) {
+	position, err := k.getOrInitPosition(ctx, pool.Id, owner, lowerTick, upperTick)
+	if err != nil {
+		return sdk.Int{}, sdk.Int{}, err
+	}
+
+	feeGrowthInside0, feeGrowthInside1, err := k.getFeeGrowthInside(ctx, pool, lowerTick, upperTick)
+	if err != nil {
+		return sdk.Int{}, sdk.Int{}, err
+	}
+	fees := sdk.NewCoins(
+		sdk.NewCoin(pool.Token0, position.Liquidity.Mul(feeGrowthInside0.Sub(position.FeeGrowthInsideLast0)).TruncateInt()),
+		sdk.NewCoin(pool.Token1, position.Liquidity.Mul(feeGrowthInside1.Sub(position.FeeGrowthInsideLast1)).TruncateInt()),
+	)
+
+	position.Liquidity = position.Liquidity.Add(liquidityDelta)
+	position.FeeGrowthInsideLast0 = feeGrowthInside0
+	position.FeeGrowthInsideLast1 = feeGrowthInside1
+	k.setPosition(ctx, pool.Id, owner, lowerTick, upperTick, position)
+
+	if err := k.initOrUpdateTick(ctx, pool, lowerTick, liquidityDelta, false); err != nil {
+		return sdk.Int{}, sdk.Int{}, err
+	}
+	if err := k.initOrUpdateTick(ctx, pool, upperTick, liquidityDelta, true); err != nil {
+		return sdk.Int{}, sdk.Int{}, err
+	}
+
+	if err := k.collectFees(ctx, pool, owner, lowerTick, upperTick, fees); err != nil {
+		return sdk.Int{}, sdk.Int{}, err
+	}
+
+	sqrtPriceLower, sqrtPriceUpper, err := tickRangeToSqrtPrices(lowerTick, upperTick)
+	if err != nil {
+		return sdk.Int{}, sdk.Int{}, err
+	}
+
+	roundUp := liquidityDelta.IsPositive()
+	liquidity := osmomath.BigDecFromSDKDec(liquidityDelta.Abs())
+	amount0Big, amount1Big := osmomath.ZeroDec(), osmomath.ZeroDec()
+	switch {
+	case pool.CurrentTick < lowerTick:
+		amount0Big = math.CalcAmount0Delta(liquidity, sqrtPriceLower, sqrtPriceUpper, roundUp)
+	case pool.CurrentTick >= upperTick:
+		amount1Big = math.CalcAmount1Delta(liquidity, sqrtPriceLower, sqrtPriceUpper, roundUp)
+	default:
+		amount0Big = math.CalcAmount0Delta(liquidity, pool.CurrentSqrtPrice, sqrtPriceUpper, roundUp)
+		amount1Big = math.CalcAmount1Delta(liquidity, sqrtPriceLower, pool.CurrentSqrtPrice, roundUp)
+		pool.CurrentTickLiquidity = pool.CurrentTickLiquidity.Add(liquidityDelta)
+	}
+
+	if roundUp {
+		return math.BigDecToIntRoundUp(amount0Big), math.BigDecToIntRoundUp(amount1Big), nil
+	}
+	return math.BigDecToIntTruncate(amount0Big), math.BigDecToIntTruncate(amount1Big), nil
+}
+
+// initializePoolPrice sets the price of a pool without liquidity to the ratio
+// of the amounts deposited by its first position.
+func initializePoolPrice(pool *model.Pool, amount0, amount1 sdk.Int) error {
+	if !amount0.IsPositive() || !amount1.IsPositive() {
+		return types.ErrNoInitialPrice
+	}
+
+	price := math.IntToBigDec(amount1).Quo(math.IntToBigDec(amount0))
+	sqrtPrice, err := price.ApproxSqrt()
+	if err != nil {
+		return err
+	}
+	tick, err := math.SqrtPriceToTick(sqrtPrice)
+	if err != nil {
+		return err
+	}
+
+	pool.CurrentSqrtPrice = sqrtPrice
+	pool.CurrentTick = tick
+	return nil
+}
+
+// validateTicks returns an error if [lowerTick, upperTick] is not a valid
+// range for positions in the given pool.
+func validateTicks(pool *model.Pool, lowerTick, upperTick int64) error {
+	if err := types.ValidateTickRange(lowerTick, upperTick); err != nil {
+		return err
+	}
+	return pool.ValidateTickSpacing(lowerTick, upperTick)
+}
+
+func tickRangeToSqrtPrices(lowerTick, upperTick int64) (sqrtPriceLower, sqrtPriceUpper osmomath.BigDec, err error) {
+	sqrtPriceLower, err = math.TickToSqrtPrice(lowerTick)
+	if err != nil {
+		return osmomath.BigDec{}, osmomath.BigDec{}, err
+	}
+	sqrtPriceUpper, err = math.TickToSqrtPrice(upperTick)
+	if err != nil {
+		return osmomath.BigDec{}, osmomath.BigDec{}, err
+	}
+	return sqrtPriceLower, sqrtPriceUpper, nil
+}
+
+func emitPositionEvent(ctx sdk.Context, eventType string, owner sdk.AccAddress, poolId uint64, lowerTick, upperTick int64, liquidity sdk.Dec, amount0, amount1 sdk.Int) {
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		eventType,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		sdk.NewAttribute(sdk.AttributeKeySender, owner.String()),
+		sdk.NewAttribute(types.AttributeKeyPoolId, strconv.FormatUint(poolId, 10)),
+		sdk.NewAttribute(types.AttributeLowerTick, strconv.FormatInt(lowerTick, 10)),
+		sdk.NewAttribute(types.AttributeUpperTick, strconv.FormatInt(upperTick, 10)),
+		sdk.NewAttribute(types.AttributeLiquidity, liquidity.String()),
+		sdk.NewAttribute(types.AttributeAmount0, amount0.String()),
+		sdk.NewAttribute(types.AttributeAmount1, amount1.String()),
+	))
+}
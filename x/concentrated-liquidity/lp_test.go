@@ -0,0 +1,175 @@
+package concentrated_liquidity_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v13/x/concentrated-liquidity/math"
+	"github.com/osmosis-labs/osmosis/v13/x/concentrated-liquidity/types"
+)
+
+func (s *KeeperTestSuite) TestCreatePosition() {
+	tests := map[string]struct {
+		amount0Desired sdk.Int
+		amount1Desired sdk.Int
+		amount0Min     sdk.Int
+		lowerTick      int64
+		upperTick      int64
+		tickSpacing    uint64
+
+		expectedErr error
+	}{
+		"in range position sets the price": {
+			amount0Desired: defaultAmount0,
+			amount1Desired: defaultAmount1,
+			lowerTick:      defaultLowerTick,
+			upperTick:      defaultUpperTick,
+		},
+		"full range position": {
+			amount0Desired: defaultAmount0,
+			amount1Desired: defaultAmount1,
+			lowerTick:      math.MinTick,
+			upperTick:      math.MaxTick,
+		},
+		"first position must deposit both tokens": {
+			amount0Desired: defaultAmount0,
+			amount1Desired: sdk.ZeroInt(),
+			lowerTick:      defaultLowerTick,
+			upperTick:      defaultUpperTick,
+			expectedErr:    types.ErrNoInitialPrice,
+		},
+		"ticks must be multiples of the tick spacing": {
+			amount0Desired: defaultAmount0,
+			amount1Desired: defaultAmount1,
+			lowerTick:      defaultLowerTick + 1,
+			upperTick:      defaultUpperTick,
+			tickSpacing:    100,
+			expectedErr:    types.TickSpacingError{Tick: defaultLowerTick + 1, TickSpacing: 100},
+		},
+		"lower tick must be below upper tick": {
+			amount0Desired: defaultAmount0,
+			amount1Desired: defaultAmount1,
+			lowerTick:      defaultUpperTick,
+			upperTick:      defaultLowerTick,
+			expectedErr:    types.InvalidTickRangeError{LowerTick: defaultUpperTick, UpperTick: defaultLowerTick},
+		},
+		"min amount not met": {
+			amount0Desired: defaultAmount0,
+			amount1Desired: defaultAmount1,
+			amount0Min:     defaultAmount0.AddRaw(1),
+			lowerTick:      defaultLowerTick,
+			upperTick:      defaultUpperTick,
+			expectedErr:    types.InsufficientAmountError{Denom: ETH, Actual: defaultAmount0, Minimum: defaultAmount0.AddRaw(1)},
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			tickSpacing := tc.tickSpacing
+			if tickSpacing == 0 {
+				tickSpacing = 1
+			}
+			poolId := s.PrepareCustomConcentratedPool(ETH, USDC, tickSpacing, sdk.ZeroDec())
+			amount0Min := tc.amount0Min
+			if amount0Min.IsNil() {
+				amount0Min = sdk.ZeroInt()
+			}
+
+			owner := s.TestAccs[1]
+			s.FundAcc(owner, sdk.NewCoins(sdk.NewCoin(ETH, tc.amount0Desired), sdk.NewCoin(USDC, tc.amount1Desired)))
+
+			amount0, amount1, liquidity, err := s.clk.CreatePosition(s.Ctx, poolId, owner, tc.amount0Desired, tc.amount1Desired, amount0Min, sdk.ZeroInt(), tc.lowerTick, tc.upperTick)
+			if tc.expectedErr != nil {
+				s.Require().EqualError(err, tc.expectedErr.Error())
+				return
+			}
+			s.Require().NoError(err)
+
+			// The price is set by the desired amounts, so one of them is fully
+			// used and the other up to rounding.
+			s.Require().True(amount0.LTE(tc.amount0Desired))
+			s.Require().True(amount1.LTE(tc.amount1Desired))
+			s.Require().True(amount0.Equal(tc.amount0Desired) || amount1.Equal(tc.amount1Desired))
+			s.Require().True(liquidity.IsPositive())
+
+			pool, err := s.clk.GetPoolById(s.Ctx, poolId)
+			s.Require().NoError(err)
+			spotPrice, err := pool.SpotPrice(s.Ctx, USDC, ETH)
+			s.Require().NoError(err)
+			s.Require().Equal(sdk.NewDec(4000), spotPrice.RoundInt().ToDec())
+			s.Require().Equal(liquidity, pool.CurrentTickLiquidity)
+
+			position, err := s.clk.GetPosition(s.Ctx, poolId, owner, tc.lowerTick, tc.upperTick)
+			s.Require().NoError(err)
+			s.Require().Equal(liquidity, position.Liquidity)
+
+			lowerTickInfo, err := s.clk.GetTickInfo(s.Ctx, poolId, tc.lowerTick)
+			s.Require().NoError(err)
+			s.Require().Equal(liquidity, lowerTickInfo.LiquidityNet)
+			upperTickInfo, err := s.clk.GetTickInfo(s.Ctx, poolId, tc.upperTick)
+			s.Require().NoError(err)
+			s.Require().Equal(liquidity.Neg(), upperTickInfo.LiquidityNet)
+
+			s.Require().Equal(sdk.NewCoins(sdk.NewCoin(ETH, amount0), sdk.NewCoin(USDC, amount1)), s.App.BankKeeper.GetAllBalances(s.Ctx, pool.GetAddress()))
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestCreatePositionOutOfRange() {
+	poolId, _ := s.setupDefaultPosition(sdk.ZeroDec())
+
+	// A range above the current price only takes token0.
+	amount0, amount1, _ := s.createPosition(poolId, s.TestAccs[1], defaultAmount0, defaultAmount1, defaultUpperTick, defaultUpperTick+1000)
+	s.Require().True(amount0.IsPositive())
+	s.Require().True(amount1.IsZero())
+
+	// A range below the current price only takes token1.
+	amount0, amount1, _ = s.createPosition(poolId, s.TestAccs[1], defaultAmount0, defaultAmount1, defaultLowerTick-1000, defaultLowerTick)
+	s.Require().True(amount0.IsZero())
+	s.Require().True(amount1.IsPositive())
+
+	// Neither changes the active liquidity.
+	pool, err := s.clk.GetPoolById(s.Ctx, poolId)
+	s.Require().NoError(err)
+	position, err := s.clk.GetPosition(s.Ctx, poolId, s.TestAccs[0], defaultLowerTick, defaultUpperTick)
+	s.Require().NoError(err)
+	s.Require().Equal(position.Liquidity, pool.CurrentTickLiquidity)
+}
+
+func (s *KeeperTestSuite) TestWithdrawPosition() {
+	poolId, liquidity := s.setupDefaultPosition(sdk.ZeroDec())
+	owner := s.TestAccs[0]
+
+	// Withdrawing more than the position holds fails.
+	_, _, err := s.clk.WithdrawPosition(s.Ctx, poolId, owner, defaultLowerTick, defaultUpperTick, liquidity.Add(sdk.OneDec()))
+	s.Require().EqualError(err, types.InsufficientLiquidityError{Actual: liquidity.Add(sdk.OneDec()), Available: liquidity}.Error())
+
+	// Withdrawing from another owner's position fails.
+	_, _, err = s.clk.WithdrawPosition(s.Ctx, poolId, s.TestAccs[1], defaultLowerTick, defaultUpperTick, liquidity)
+	s.Require().EqualError(err, types.PositionNotFoundError{PoolId: poolId, LowerTick: defaultLowerTick, UpperTick: defaultUpperTick}.Error())
+
+	// Withdrawing half of the position returns about half of the deposit.
+	half := liquidity.QuoInt64(2)
+	amount0, amount1, err := s.clk.WithdrawPosition(s.Ctx, poolId, owner, defaultLowerTick, defaultUpperTick, half)
+	s.Require().NoError(err)
+	s.Require().Equal(defaultAmount0.QuoRaw(2), amount0.AddRaw(1))
+	s.Require().True(amount1.LTE(defaultAmount1.QuoRaw(2)))
+
+	// Withdrawing the rest deletes the position and its ticks.
+	_, _, err = s.clk.WithdrawPosition(s.Ctx, poolId, owner, defaultLowerTick, defaultUpperTick, liquidity.Sub(half))
+	s.Require().NoError(err)
+	_, err = s.clk.GetPosition(s.Ctx, poolId, owner, defaultLowerTick, defaultUpperTick)
+	s.Require().Error(err)
+	_, found, err := s.clk.NextInitializedTick(s.Ctx, poolId, defaultLowerTick-1, false)
+	s.Require().NoError(err)
+	s.Require().False(found)
+
+	pool, err := s.clk.GetPoolById(s.Ctx, poolId)
+	s.Require().NoError(err)
+	s.Require().True(pool.CurrentTickLiquidity.IsZero())
+
+	// Only rounding dust is left in the pool.
+	poolBalances := s.App.BankKeeper.GetAllBalances(s.Ctx, pool.GetAddress())
+	s.Require().True(poolBalances.AmountOf(ETH).LTE(sdk.NewInt(2)))
+	s.Require().True(poolBalances.AmountOf(USDC).LTE(sdk.NewInt(2)))
+}
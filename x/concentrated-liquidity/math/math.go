@@ -0,0 +1,120 @@
+package math
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v13/osmomath"
+)
+
+// Liquidity0 returns the liquidity provided by amount of token0 over the
+// sqrt price range [sqrtPriceA, sqrtPriceB].
+// liquidity0 = amount * (sqrtPriceA * sqrtPriceB) / (sqrtPriceB - sqrtPriceA)
+func Liquidity0(amount sdk.Int, sqrtPriceA, sqrtPriceB osmomath.BigDec) osmomath.BigDec {
+	if sqrtPriceA.GT(sqrtPriceB) {
+		sqrtPriceA, sqrtPriceB = sqrtPriceB, sqrtPriceA
+	}
+	product := sqrtPriceA.Mul(sqrtPriceB)
+	diff := sqrtPriceB.Sub(sqrtPriceA)
+	return IntToBigDec(amount).Mul(product).QuoTruncate(diff)
+}
+
+// Liquidity1 returns the liquidity provided by amount of token1 over the
+// sqrt price range [sqrtPriceA, sqrtPriceB].
+// liquidity1 = amount / (sqrtPriceB - sqrtPriceA)
+func Liquidity1(amount sdk.Int, sqrtPriceA, sqrtPriceB osmomath.BigDec) osmomath.BigDec {
+	if sqrtPriceA.GT(sqrtPriceB) {
+		sqrtPriceA, sqrtPriceB = sqrtPriceB, sqrtPriceA
+	}
+	diff := sqrtPriceB.Sub(sqrtPriceA)
+	return IntToBigDec(amount).QuoTruncate(diff)
+}
+
+// CalcAmount0Delta returns the amount of token0 between the two sqrt prices
+// for the given liquidity, rounding up when roundUp is set (amounts owed to
+// the pool) and down otherwise (amounts owed by the pool).
+// amount0 = liquidity * (sqrtPriceB - sqrtPriceA) / (sqrtPriceB * sqrtPriceA)
+func CalcAmount0Delta(liquidity, sqrtPriceA, sqrtPriceB osmomath.BigDec, roundUp bool) osmomath.BigDec {
+	if sqrtPriceA.GT(sqrtPriceB) {
+		sqrtPriceA, sqrtPriceB = sqrtPriceB, sqrtPriceA
+	}
+	diff := sqrtPriceB.Sub(sqrtPriceA)
+	product := liquidity.MulTruncate(diff)
+	if roundUp {
+		return product.Add(osmomath.SmallestDec()).QuoRoundUp(sqrtPriceB).QuoRoundUp(sqrtPriceA)
+	}
+	return product.QuoTruncate(sqrtPriceB).QuoTruncate(sqrtPriceA)
+}
+
+// CalcAmount1Delta returns the amount of token1 between the two sqrt prices
+// for the given liquidity, with the same rounding semantics as CalcAmount0Delta.
+// amount1 = liquidity * (sqrtPriceB - sqrtPriceA)
+func CalcAmount1Delta(liquidity, sqrtPriceA, sqrtPriceB osmomath.BigDec, roundUp bool) osmomath.BigDec {
+	if sqrtPriceA.GT(sqrtPriceB) {
+		sqrtPriceA, sqrtPriceB = sqrtPriceB, sqrtPriceA
+	}
+	diff := sqrtPriceB.Sub(sqrtPriceA)
+	amount1 := liquidity.MulTruncate(diff)
+	if roundUp {
+		// MulTruncate drops everything past the last decimal place, so adding the
+		// smallest representable amount bounds the exact product from above.
+		return amount1.Add(osmomath.SmallestDec())
+	}
+	return amount1
+}
+
+// GetNextSqrtPriceFromAmount0InRoundingUp returns the sqrt price after
+// amountIn of token0 is added to the pool at sqrtPriceCurrent.
+// Rounds up so that the price moves less than the exact amount would imply.
+// sqrtPriceNext = liquidity * sqrtPriceCurrent / (liquidity + amountIn * sqrtPriceCurrent)
+func GetNextSqrtPriceFromAmount0InRoundingUp(sqrtPriceCurrent, liquidity, amountIn osmomath.BigDec) osmomath.BigDec {
+	if amountIn.IsZero() {
+		return sqrtPriceCurrent
+	}
+	product := amountIn.Mul(sqrtPriceCurrent)
+	denominator := liquidity.Add(product)
+	return liquidity.Mul(sqrtPriceCurrent).QuoRoundUp(denominator)
+}
+
+// GetNextSqrtPriceFromAmount0OutRoundingUp returns the sqrt price after
+// amountOut of token0 is removed from the pool at sqrtPriceCurrent.
+// sqrtPriceNext = liquidity * sqrtPriceCurrent / (liquidity - amountOut * sqrtPriceCurrent)
+func GetNextSqrtPriceFromAmount0OutRoundingUp(sqrtPriceCurrent, liquidity, amountOut osmomath.BigDec) osmomath.BigDec {
+	if amountOut.IsZero() {
+		return sqrtPriceCurrent
+	}
+	product := amountOut.Mul(sqrtPriceCurrent)
+	denominator := liquidity.Sub(product)
+	return liquidity.Mul(sqrtPriceCurrent).QuoRoundUp(denominator)
+}
+
+// GetNextSqrtPriceFromAmount1InRoundingDown returns the sqrt price after
+// amountIn of token1 is added to the pool at sqrtPriceCurrent.
+// Rounds down so that the price moves less than the exact amount would imply.
+// sqrtPriceNext = sqrtPriceCurrent + amountIn / liquidity
+func GetNextSqrtPriceFromAmount1InRoundingDown(sqrtPriceCurrent, liquidity, amountIn osmomath.BigDec) osmomath.BigDec {
+	return sqrtPriceCurrent.Add(amountIn.QuoTruncate(liquidity))
+}
+
+// GetNextSqrtPriceFromAmount1OutRoundingDown returns the sqrt price after
+// amountOut of token1 is removed from the pool at sqrtPriceCurrent.
+// sqrtPriceNext = sqrtPriceCurrent - amountOut / liquidity
+func GetNextSqrtPriceFromAmount1OutRoundingDown(sqrtPriceCurrent, liquidity, amountOut osmomath.BigDec) osmomath.BigDec {
+	return sqrtPriceCurrent.Sub(amountOut.QuoRoundUp(liquidity))
+}
+
+// IntToBigDec converts an sdk.Int to an osmomath.BigDec.
+func IntToBigDec(i sdk.Int) osmomath.BigDec {
+	return osmomath.NewDecFromBigInt(i.BigInt())
+}
+
+// BigDecToIntTruncate converts an osmomath.BigDec to an sdk.Int, dropping
+// any fractional part.
+func BigDecToIntTruncate(d osmomath.BigDec) sdk.Int {
+	return sdk.NewIntFromBigInt(d.TruncateInt().BigInt())
+}
+
+// BigDecToIntRoundUp converts an osmomath.BigDec to an sdk.Int, rounding any
+// fractional part up.
+func BigDecToIntRoundUp(d osmomath.BigDec) sdk.Int {
+	return sdk.NewIntFromBigInt(d.Ceil().TruncateInt().BigInt())
+}
@@ -0,0 +1,149 @@
+package math
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/osmosis-labs/osmosis/v13/osmomath"
+)
+
+var (
+	sqrtPrice4  = osmomath.NewBigDec(2)
+	sqrtPrice9  = osmomath.NewBigDec(3)
+	sqrtPrice16 = osmomath.NewBigDec(4)
+)
+
+func TestLiquidity(t *testing.T) {
+	// 600 of token0 over sqrt prices [2, 3] is 600 * 2 * 3 / (3 - 2) = 3600.
+	require.Equal(t, osmomath.NewBigDec(3600), Liquidity0(sdk.NewInt(600), sqrtPrice4, sqrtPrice9))
+	require.Equal(t, osmomath.NewBigDec(3600), Liquidity0(sdk.NewInt(600), sqrtPrice9, sqrtPrice4))
+	// 3600 of token1 over sqrt prices [2, 3] is 3600 / (3 - 2) = 3600.
+	require.Equal(t, osmomath.NewBigDec(3600), Liquidity1(sdk.NewInt(3600), sqrtPrice4, sqrtPrice9))
+	require.Equal(t, osmomath.NewBigDec(3600), Liquidity1(sdk.NewInt(3600), sqrtPrice9, sqrtPrice4))
+}
+
+func TestCalcAmountDelta(t *testing.T) {
+	liquidity := osmomath.NewBigDec(3600)
+
+	// Amounts are the inverse of the liquidity computations.
+	require.Equal(t, osmomath.NewBigDec(600).String(), CalcAmount0Delta(liquidity, sqrtPrice4, sqrtPrice9, false).String())
+	require.Equal(t, osmomath.NewBigDec(3600).String(), CalcAmount1Delta(liquidity, sqrtPrice4, sqrtPrice9, false).String())
+
+	// Rounding up never returns less than rounding down.
+	require.True(t, CalcAmount0Delta(liquidity, sqrtPrice4, sqrtPrice9, true).GT(CalcAmount0Delta(liquidity, sqrtPrice4, sqrtPrice9, false)))
+	require.True(t, CalcAmount1Delta(liquidity, sqrtPrice4, sqrtPrice9, true).GT(CalcAmount1Delta(liquidity, sqrtPrice4, sqrtPrice9, false)))
+	require.Equal(t, sdk.NewInt(600), BigDecToIntTruncate(CalcAmount0Delta(liquidity, sqrtPrice4, sqrtPrice9, false)))
+	require.Equal(t, sdk.NewInt(601), BigDecToIntRoundUp(CalcAmount0Delta(liquidity, sqrtPrice4, sqrtPrice9, true)))
+}
+
+func TestGetNextSqrtPrice(t *testing.T) {
+	liquidity := osmomath.NewBigDec(3600)
+
+	// Swapping in 600 of token0 moves the sqrt price from 3 down to 2.
+	require.Equal(t, sqrtPrice4.String(), GetNextSqrtPriceFromAmount0InRoundingUp(sqrtPrice9, liquidity, osmomath.NewBigDec(600)).String())
+	// Swapping out 600 of token0 moves the sqrt price from 2 up to 3.
+	require.Equal(t, sqrtPrice9.String(), GetNextSqrtPriceFromAmount0OutRoundingUp(sqrtPrice4, liquidity, osmomath.NewBigDec(600)).String())
+	// Swapping in 3600 of token1 moves the sqrt price from 2 up to 3.
+	require.Equal(t, sqrtPrice9.String(), GetNextSqrtPriceFromAmount1InRoundingDown(sqrtPrice4, liquidity, osmomath.NewBigDec(3600)).String())
+	// Swapping out 3600 of token1 moves the sqrt price from 3 down to 2.
+	require.Equal(t, sqrtPrice4.String(), GetNextSqrtPriceFromAmount1OutRoundingDown(sqrtPrice9, liquidity, osmomath.NewBigDec(3600)).String())
+
+	// Zero amounts of token0 do not move the price.
+	require.Equal(t, sqrtPrice9.String(), GetNextSqrtPriceFromAmount0InRoundingUp(sqrtPrice9, liquidity, osmomath.ZeroDec()).String())
+	require.Equal(t, sqrtPrice9.String(), GetNextSqrtPriceFromAmount0OutRoundingUp(sqrtPrice9, liquidity, osmomath.ZeroDec()).String())
+}
+
+func TestComputeSwapStepOutGivenIn(t *testing.T) {
+	liquidity := osmomath.NewBigDec(3600)
+
+	tests := map[string]struct {
+		sqrtPriceCurrent osmomath.BigDec
+		sqrtPriceTarget  osmomath.BigDec
+		amountRemaining  osmomath.BigDec
+		swapFee          sdk.Dec
+		zeroForOne       bool
+
+		expectedSqrtPriceNext osmomath.BigDec
+		expectedAmountIn      osmomath.BigDec
+		expectedAmountOut     osmomath.BigDec
+		expectedFee           osmomath.BigDec
+	}{
+		"token1 in, target not reached": {
+			sqrtPriceCurrent: sqrtPrice4,
+			sqrtPriceTarget:  sqrtPrice16,
+			amountRemaining:  osmomath.NewBigDec(3600),
+			swapFee:          sdk.ZeroDec(),
+
+			expectedSqrtPriceNext: sqrtPrice9,
+			expectedAmountIn:      osmomath.NewBigDec(3600),
+			expectedAmountOut:     osmomath.NewBigDec(600),
+			expectedFee:           osmomath.ZeroDec(),
+		},
+		"token1 in, target reached": {
+			sqrtPriceCurrent: sqrtPrice4,
+			sqrtPriceTarget:  sqrtPrice9,
+			amountRemaining:  osmomath.NewBigDec(10000),
+			swapFee:          sdk.ZeroDec(),
+
+			expectedSqrtPriceNext: sqrtPrice9,
+			// Amounts in are rounded up.
+			expectedAmountIn:  osmomath.NewBigDec(3600).Add(osmomath.SmallestDec()),
+			expectedAmountOut: osmomath.NewBigDec(600),
+			expectedFee:       osmomath.ZeroDec(),
+		},
+		"token0 in, target not reached": {
+			sqrtPriceCurrent: sqrtPrice9,
+			sqrtPriceTarget:  osmomath.OneDec(),
+			amountRemaining:  osmomath.NewBigDec(600),
+			swapFee:          sdk.ZeroDec(),
+			zeroForOne:       true,
+
+			expectedSqrtPriceNext: sqrtPrice4,
+			expectedAmountIn:      osmomath.NewBigDec(600),
+			expectedAmountOut:     osmomath.NewBigDec(3600),
+			expectedFee:           osmomath.ZeroDec(),
+		},
+		"token1 in with fee, target not reached": {
+			sqrtPriceCurrent: sqrtPrice4,
+			sqrtPriceTarget:  sqrtPrice16,
+			amountRemaining:  osmomath.NewBigDec(4000),
+			swapFee:          sdk.NewDecWithPrec(1, 1),
+
+			expectedSqrtPriceNext: sqrtPrice9,
+			expectedAmountIn:      osmomath.NewBigDec(3600),
+			expectedAmountOut:     osmomath.NewBigDec(600),
+			expectedFee:           osmomath.NewBigDec(400),
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			sqrtPriceNext, amountIn, amountOut, fee := ComputeSwapStepOutGivenIn(tc.sqrtPriceCurrent, tc.sqrtPriceTarget, liquidity, tc.amountRemaining, tc.swapFee, tc.zeroForOne)
+			require.Equal(t, tc.expectedSqrtPriceNext.String(), sqrtPriceNext.String())
+			require.Equal(t, tc.expectedAmountIn.String(), amountIn.String())
+			require.Equal(t, tc.expectedAmountOut.String(), amountOut.String())
+			require.Equal(t, tc.expectedFee.String(), fee.String())
+		})
+	}
+}
+
+func TestComputeSwapStepInGivenOut(t *testing.T) {
+	liquidity := osmomath.NewBigDec(3600)
+
+	// Swapping out 600 of token0 moves the sqrt price from 2 to 3, and
+	// requires 3600 of token1 in.
+	sqrtPriceNext, amountIn, amountOut, fee := ComputeSwapStepInGivenOut(sqrtPrice4, sqrtPrice16, liquidity, osmomath.NewBigDec(600), sdk.ZeroDec(), false)
+	require.Equal(t, sqrtPrice9.String(), sqrtPriceNext.String())
+	require.Equal(t, osmomath.NewBigDec(3600).Add(osmomath.SmallestDec()).String(), amountIn.String())
+	require.Equal(t, osmomath.NewBigDec(600).String(), amountOut.String())
+	require.Equal(t, osmomath.ZeroDec().String(), fee.String())
+
+	// Zero liquidity moves straight to the target without swapping.
+	sqrtPriceNext, amountIn, amountOut, fee = ComputeSwapStepInGivenOut(sqrtPrice4, sqrtPrice16, osmomath.ZeroDec(), osmomath.NewBigDec(600), sdk.ZeroDec(), false)
+	require.Equal(t, sqrtPrice16.String(), sqrtPriceNext.String())
+	require.True(t, amountIn.IsZero())
+	require.True(t, amountOut.IsZero())
+	require.True(t, fee.IsZero())
+}
@@ -0,0 +1,113 @@
+package math
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v13/osmomath"
+)
+
+// ComputeSwapStepOutGivenIn computes the result of swapping up to
+// amountRemaining (including the swap fee) of the input token within a
+// single range of constant liquidity, moving the price from sqrtPriceCurrent
+// towards, but not past, sqrtPriceTarget. zeroForOne is true when token0 is
+// swapped in and the price moves down.
+//
+// It returns the sqrt price reached, the amount swapped in excluding fees,
+// the amount swapped out and the swap fee charged. If the target is not
+// reached, the amount in and fee add up to exactly amountRemaining.
+func ComputeSwapStepOutGivenIn(
+	sqrtPriceCurrent, sqrtPriceTarget, liquidity, amountRemaining osmomath.BigDec,
+	swapFee sdk.Dec,
+	zeroForOne bool,
+) (sqrtPriceNext, amountIn, amountOut, feeCharged osmomath.BigDec) {
+	if liquidity.IsZero() {
+		return sqrtPriceTarget, osmomath.ZeroDec(), osmomath.ZeroDec(), osmomath.ZeroDec()
+	}
+
+	swapFeeBig := osmomath.BigDecFromSDKDec(swapFee)
+	oneMinusFee := osmomath.OneDec().Sub(swapFeeBig)
+	amountRemainingLessFee := amountRemaining.MulTruncate(oneMinusFee)
+
+	amountInToTarget := calcAmountIn(liquidity, sqrtPriceCurrent, sqrtPriceTarget, zeroForOne)
+	if amountRemainingLessFee.GTE(amountInToTarget) {
+		sqrtPriceNext = sqrtPriceTarget
+		amountIn = amountInToTarget
+	} else {
+		if zeroForOne {
+			sqrtPriceNext = GetNextSqrtPriceFromAmount0InRoundingUp(sqrtPriceCurrent, liquidity, amountRemainingLessFee)
+		} else {
+			sqrtPriceNext = GetNextSqrtPriceFromAmount1InRoundingDown(sqrtPriceCurrent, liquidity, amountRemainingLessFee)
+		}
+		amountIn = osmomath.MinDec(calcAmountIn(liquidity, sqrtPriceCurrent, sqrtPriceNext, zeroForOne), amountRemainingLessFee)
+	}
+
+	amountOut = calcAmountOut(liquidity, sqrtPriceCurrent, sqrtPriceNext, zeroForOne)
+
+	if sqrtPriceNext.Equal(sqrtPriceTarget) {
+		feeCharged = amountIn.Mul(swapFeeBig).QuoRoundUp(oneMinusFee)
+	} else {
+		// The whole remaining amount is consumed, anything not swapped is kept as fee.
+		feeCharged = amountRemaining.Sub(amountIn)
+	}
+
+	return sqrtPriceNext, amountIn, amountOut, feeCharged
+}
+
+// ComputeSwapStepInGivenOut computes the result of swapping out up to
+// amountRemaining of the output token within a single range of constant
+// liquidity, moving the price from sqrtPriceCurrent towards, but not past,
+// sqrtPriceTarget. zeroForOne is true when token0 is swapped in for token1
+// and the price moves down.
+//
+// It returns the sqrt price reached, the amount swapped in excluding fees,
+// the amount swapped out and the swap fee charged on top of the amount in.
+func ComputeSwapStepInGivenOut(
+	sqrtPriceCurrent, sqrtPriceTarget, liquidity, amountRemaining osmomath.BigDec,
+	swapFee sdk.Dec,
+	zeroForOne bool,
+) (sqrtPriceNext, amountIn, amountOut, feeCharged osmomath.BigDec) {
+	if liquidity.IsZero() {
+		return sqrtPriceTarget, osmomath.ZeroDec(), osmomath.ZeroDec(), osmomath.ZeroDec()
+	}
+
+	amountOutToTarget := calcAmountOut(liquidity, sqrtPriceCurrent, sqrtPriceTarget, zeroForOne)
+	if amountRemaining.GTE(amountOutToTarget) {
+		sqrtPriceNext = sqrtPriceTarget
+		amountOut = amountOutToTarget
+	} else {
+		if zeroForOne {
+			sqrtPriceNext = GetNextSqrtPriceFromAmount1OutRoundingDown(sqrtPriceCurrent, liquidity, amountRemaining)
+		} else {
+			sqrtPriceNext = GetNextSqrtPriceFromAmount0OutRoundingUp(sqrtPriceCurrent, liquidity, amountRemaining)
+		}
+		// The next price is rounded in the pool's favor, so the full remaining
+		// amount can be given out.
+		amountOut = amountRemaining
+	}
+
+	amountIn = calcAmountIn(liquidity, sqrtPriceCurrent, sqrtPriceNext, zeroForOne)
+
+	swapFeeBig := osmomath.BigDecFromSDKDec(swapFee)
+	oneMinusFee := osmomath.OneDec().Sub(swapFeeBig)
+	feeCharged = amountIn.Mul(swapFeeBig).QuoRoundUp(oneMinusFee)
+
+	return sqrtPriceNext, amountIn, amountOut, feeCharged
+}
+
+// calcAmountIn returns the amount of the input token needed to move the price
+// between the two sqrt prices, rounded up.
+func calcAmountIn(liquidity, sqrtPriceCurrent, sqrtPriceNext osmomath.BigDec, zeroForOne bool) osmomath.BigDec {
+	if zeroForOne {
+		return CalcAmount0Delta(liquidity, sqrtPriceNext, sqrtPriceCurrent, true)
+	}
+	return CalcAmount1Delta(liquidity, sqrtPriceCurrent, sqrtPriceNext, true)
+}
+
+// calcAmountOut returns the amount of the output token given out when moving
+// the price between the two sqrt prices, rounded down.
+func calcAmountOut(liquidity, sqrtPriceCurrent, sqrtPriceNext osmomath.BigDec, zeroForOne bool) osmomath.BigDec {
+	if zeroForOne {
+		return CalcAmount1Delta(liquidity, sqrtPriceNext, sqrtPriceCurrent, false)
+	}
+	return CalcAmount0Delta(liquidity, sqrtPriceCurrent, sqrtPriceNext, false)
+}
@@ -0,0 +1,73 @@
+package math
+
+import (
+	"fmt"
+
+	"github.com/osmosis-labs/osmosis/v13/osmomath"
+)
+
+const (
+	// MinTick and MaxTick bound the ticks positions may be created at, and
+	// hence the price range a pool can trade in. A tick i corresponds to a
+	// price of 1.0001^i, so the bounds allow prices in roughly [4.3e-18, 2.3e17].
+	MinTick int64 = -400_000
+	MaxTick int64 = 400_000
+)
+
+var (
+	// sqrtTickBase is sqrt(1.0001), the ratio between the sqrt prices of
+	// two adjacent ticks.
+	sqrtTickBase = osmomath.MustNewDecFromStr("1.000049998750062496094023416993798697")
+
+	// MinSqrtPrice and MaxSqrtPrice are the sqrt prices at MinTick and MaxTick.
+	MinSqrtPrice = mustTickToSqrtPrice(MinTick)
+	MaxSqrtPrice = mustTickToSqrtPrice(MaxTick)
+)
+
+// TickToSqrtPrice returns the sqrt price at the given tick, i.e. sqrt(1.0001^tickIndex).
+// Returns an error if the tick is outside of [MinTick, MaxTick].
+func TickToSqrtPrice(tickIndex int64) (osmomath.BigDec, error) {
+	if tickIndex < MinTick || tickIndex > MaxTick {
+		return osmomath.BigDec{}, fmt.Errorf("tick index (%d) is out of bounds [%d, %d]", tickIndex, MinTick, MaxTick)
+	}
+
+	if tickIndex >= 0 {
+		return sqrtTickBase.PowerInteger(uint64(tickIndex)), nil
+	}
+	return osmomath.OneDec().Quo(sqrtTickBase.PowerInteger(uint64(-tickIndex))), nil
+}
+
+// SqrtPriceToTick returns the largest tick whose sqrt price is less than or
+// equal to the given sqrt price. The estimate from the tick logarithm is
+// corrected so that the result is consistent with TickToSqrtPrice.
+func SqrtPriceToTick(sqrtPrice osmomath.BigDec) (int64, error) {
+	if sqrtPrice.LT(MinSqrtPrice) || sqrtPrice.GT(MaxSqrtPrice) {
+		return 0, fmt.Errorf("sqrt price (%s) is out of bounds [%s, %s]", sqrtPrice, MinSqrtPrice, MaxSqrtPrice)
+	}
+
+	price := sqrtPrice.Mul(sqrtPrice)
+	tick := price.TickLog().TruncateInt64()
+	if tick < MinTick {
+		tick = MinTick
+	} else if tick > MaxTick {
+		tick = MaxTick
+	}
+
+	// The logarithm is only approximate, so step the estimate until
+	// sqrtPrice(tick) <= sqrtPrice < sqrtPrice(tick + 1).
+	for tick > MinTick && mustTickToSqrtPrice(tick).GT(sqrtPrice) {
+		tick--
+	}
+	for tick < MaxTick && mustTickToSqrtPrice(tick+1).LTE(sqrtPrice) {
+		tick++
+	}
+	return tick, nil
+}
+
+func mustTickToSqrtPrice(tickIndex int64) osmomath.BigDec {
+	sqrtPrice, err := TickToSqrtPrice(tickIndex)
+	if err != nil {
+		panic(err)
+	}
+	return sqrtPrice
+}
@@ -0,0 +1,139 @@
+package math
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/osmosis-labs/osmosis/v13/osmomath"
+)
+
+func TestTickToSqrtPrice(t *testing.T) {
+	tests := map[string]struct {
+		tickIndex         int64
+		expectedSqrtPrice osmomath.BigDec
+		expectErr         bool
+	}{
+		"tick 0 is price 1": {
+			tickIndex:         0,
+			expectedSqrtPrice: osmomath.OneDec(),
+		},
+		"tick 1 is sqrt(1.0001)": {
+			tickIndex:         1,
+			expectedSqrtPrice: sqrtTickBase,
+		},
+		"tick -1 is 1 / sqrt(1.0001)": {
+			tickIndex:         -1,
+			expectedSqrtPrice: osmomath.OneDec().Quo(sqrtTickBase),
+		},
+		"tick 2 is 1.0001": {
+			tickIndex:         2,
+			expectedSqrtPrice: osmomath.MustNewDecFromStr("1.0001"),
+		},
+		"max tick": {
+			tickIndex:         MaxTick,
+			expectedSqrtPrice: MaxSqrtPrice,
+		},
+		"min tick": {
+			tickIndex:         MinTick,
+			expectedSqrtPrice: MinSqrtPrice,
+		},
+		"above max tick": {
+			tickIndex: MaxTick + 1,
+			expectErr: true,
+		},
+		"below min tick": {
+			tickIndex: MinTick - 1,
+			expectErr: true,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			sqrtPrice, err := TickToSqrtPrice(tc.tickIndex)
+			if tc.expectErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			// PowerInteger rounds on every multiplication, allow for a small error.
+			diff := sqrtPrice.Sub(tc.expectedSqrtPrice).Abs()
+			require.True(t, diff.LTE(osmomath.MustNewDecFromStr("0.000000000000000000000000000001")), "expected %s, got %s", tc.expectedSqrtPrice, sqrtPrice)
+		})
+	}
+}
+
+func TestSqrtPriceToTick(t *testing.T) {
+	tests := map[string]struct {
+		sqrtPrice    osmomath.BigDec
+		expectedTick int64
+		expectErr    bool
+	}{
+		"price 1 is tick 0": {
+			sqrtPrice:    osmomath.OneDec(),
+			expectedTick: 0,
+		},
+		"price 1.0001 is tick 1": {
+			sqrtPrice:    sqrtTickBase,
+			expectedTick: 1,
+		},
+		"just below tick 1 rounds down to tick 0": {
+			sqrtPrice:    sqrtTickBase.Sub(osmomath.SmallestDec()),
+			expectedTick: 0,
+		},
+		"just below tick 0 rounds down to tick -1": {
+			sqrtPrice:    osmomath.OneDec().Sub(osmomath.SmallestDec()),
+			expectedTick: -1,
+		},
+		"price 4 is tick 13863": {
+			// 1.0001^13863 <= 4 < 1.0001^13864
+			sqrtPrice:    osmomath.NewBigDec(2),
+			expectedTick: 13863,
+		},
+		"price 0.25 is tick -13864": {
+			sqrtPrice:    osmomath.MustNewDecFromStr("0.5"),
+			expectedTick: -13864,
+		},
+		"max sqrt price": {
+			sqrtPrice:    MaxSqrtPrice,
+			expectedTick: MaxTick,
+		},
+		"min sqrt price": {
+			sqrtPrice:    MinSqrtPrice,
+			expectedTick: MinTick,
+		},
+		"above max sqrt price": {
+			sqrtPrice: MaxSqrtPrice.Add(osmomath.OneDec()),
+			expectErr: true,
+		},
+		"below min sqrt price": {
+			sqrtPrice: MinSqrtPrice.Sub(osmomath.SmallestDec()),
+			expectErr: true,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			tick, err := SqrtPriceToTick(tc.sqrtPrice)
+			if tc.expectErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedTick, tick)
+		})
+	}
+}
+
+// TestTickRoundTrip tests that converting a tick to its sqrt price and back
+// returns the same tick.
+func TestTickRoundTrip(t *testing.T) {
+	for _, tickIndex := range []int64{MinTick, -200_000, -13_864, -1, 0, 1, 13_863, 200_000, MaxTick} {
+		sqrtPrice, err := TickToSqrtPrice(tickIndex)
+		require.NoError(t, err)
+		tick, err := SqrtPriceToTick(sqrtPrice)
+		require.NoError(t, err)
+		require.Equal(t, tickIndex, tick)
+	}
+}
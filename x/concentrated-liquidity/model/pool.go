@@ -0,0 +1,143 @@
+package model
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v13/osmomath"
+	"github.com/osmosis-labs/osmosis/v13/x/concentrated-liquidity/types"
+	gammtypes "github.com/osmosis-labs/osmosis/v13/x/gamm/types"
+	swaproutertypes "github.com/osmosis-labs/osmosis/v13/x/swaprouter/types"
+)
+
+var _ swaproutertypes.PoolI = &Pool{}
+
+// NewConcentratedLiquidityPool creates a new concentrated liquidity pool
+// between the two given denoms. The pool has no liquidity and no price until
+// the first position is created.
+func NewConcentratedLiquidityPool(poolId uint64, denom0, denom1 string, tickSpacing uint64, swapFee sdk.Dec) (Pool, error) {
+	if err := validatePoolParams(denom0, denom1, tickSpacing, swapFee); err != nil {
+		return Pool{}, err
+	}
+
+	// Order the denoms so that the price is always expressed as token1 per token0.
+	if denom0 > denom1 {
+		denom0, denom1 = denom1, denom0
+	}
+
+	return Pool{
+		Address:              gammtypes.NewPoolAddress(poolId).String(),
+		Id:                   poolId,
+		CurrentTickLiquidity: sdk.ZeroDec(),
+		Token0:               denom0,
+		Token1:               denom1,
+		CurrentSqrtPrice:     osmomath.ZeroDec(),
+		CurrentTick:          0,
+		TickSpacing:          tickSpacing,
+		SwapFee:              swapFee,
+		FeeGrowthGlobal0:     sdk.ZeroDec(),
+		FeeGrowthGlobal1:     sdk.ZeroDec(),
+	}, nil
+}
+
+func validatePoolParams(denom0, denom1 string, tickSpacing uint64, swapFee sdk.Dec) error {
+	if err := sdk.ValidateDenom(denom0); err != nil {
+		return err
+	}
+	if err := sdk.ValidateDenom(denom1); err != nil {
+		return err
+	}
+	if denom0 == denom1 {
+		return fmt.Errorf("pool denoms must be different, got (%s) for both", denom0)
+	}
+	if tickSpacing == 0 {
+		return errors.New("tick spacing must be positive")
+	}
+	if swapFee.IsNil() || swapFee.IsNegative() || swapFee.GTE(sdk.OneDec()) {
+		return fmt.Errorf("swap fee must be in [0, 1), was (%s)", swapFee)
+	}
+	return nil
+}
+
+func (p Pool) GetAddress() sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(p.Address)
+	if err != nil {
+		panic(fmt.Sprintf("could not bech32 decode address of pool with id: %d", p.GetId()))
+	}
+	return addr
+}
+
+func (p Pool) String() string {
+	out, err := json.Marshal(p)
+	if err != nil {
+		panic(err)
+	}
+	return string(out)
+}
+
+func (p Pool) GetId() uint64 {
+	return p.Id
+}
+
+func (p Pool) GetSwapFee(ctx sdk.Context) sdk.Dec {
+	return p.SwapFee
+}
+
+// GetExitFee returns zero, liquidity is withdrawn from positions without a fee.
+func (p Pool) GetExitFee(ctx sdk.Context) sdk.Dec {
+	return sdk.ZeroDec()
+}
+
+func (p Pool) IsActive(ctx sdk.Context) bool {
+	return true
+}
+
+// GetTotalShares returns zero, concentrated liquidity pools do not issue LP
+// shares. Ownership is tracked by positions instead.
+func (p Pool) GetTotalShares() sdk.Int {
+	return sdk.ZeroInt()
+}
+
+// GetTotalPoolLiquidity returns no coins. The tokens backing a concentrated
+// liquidity pool depend on the price and on each position's range, and are
+// held by the pool's address rather than recorded in the pool itself.
+func (p Pool) GetTotalPoolLiquidity(ctx sdk.Context) sdk.Coins {
+	return sdk.Coins{}
+}
+
+// SpotPrice returns the spot price of the base asset in terms of the quote
+// asset, derived from the pool's current sqrt price.
+func (p Pool) SpotPrice(ctx sdk.Context, quoteAssetDenom string, baseAssetDenom string) (sdk.Dec, error) {
+	if p.CurrentSqrtPrice.IsZero() {
+		return sdk.Dec{}, types.ErrPoolNotInitialized
+	}
+
+	price := p.CurrentSqrtPrice.Mul(p.CurrentSqrtPrice)
+	switch {
+	case baseAssetDenom == p.Token0 && quoteAssetDenom == p.Token1:
+		return price.SDKDec(), nil
+	case baseAssetDenom == p.Token1 && quoteAssetDenom == p.Token0:
+		return osmomath.OneDec().Quo(price).SDKDec(), nil
+	default:
+		return sdk.Dec{}, fmt.Errorf("quote asset (%s) and base asset (%s) must be the pool's denoms (%s, %s)", quoteAssetDenom, baseAssetDenom, p.Token0, p.Token1)
+	}
+}
+
+// HasDenom returns whether denom is one of the pool's two tokens.
+func (p Pool) HasDenom(denom string) bool {
+	return denom == p.Token0 || denom == p.Token1
+}
+
+// ValidateTickSpacing returns an error if either tick is not a multiple of the
+// pool's tick spacing.
+func (p Pool) ValidateTickSpacing(lowerTick, upperTick int64) error {
+	for _, tick := range []int64{lowerTick, upperTick} {
+		if tick%int64(p.TickSpacing) != 0 {
+			return types.TickSpacingError{Tick: tick, TickSpacing: p.TickSpacing}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,754 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: osmosis/concentrated-liquidity/v1beta1/pool.proto
+
+package model
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	github_com_osmosis_labs_osmosis_v13_osmomath "github.com/osmosis-labs/osmosis/v13/osmomath"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Pool is the concentrated liquidity Pool struct. Liquidity is provided by
+// positions over tick ranges, and the price moves along sqrt price curves
+// as swaps cross initialized ticks.
+type Pool struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty" yaml:"address"`
+	Id      uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	// Amount of total liquidity active in the current tick range.
+	CurrentTickLiquidity github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=current_tick_liquidity,json=currentTickLiquidity,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"current_tick_liquidity" yaml:"current_tick_liquidity"`
+	// token0 and token1 are the denoms of the pool, token0 < token1.
+	Token0 string `protobuf:"bytes,4,opt,name=token0,proto3" json:"token0,omitempty"`
+	Token1 string `protobuf:"bytes,5,opt,name=token1,proto3" json:"token1,omitempty"`
+	// current_sqrt_price is the square root of the price of token0 in terms of
+	// token1. It is zero until the first position sets the initial price.
+	CurrentSqrtPrice github_com_osmosis_labs_osmosis_v13_osmomath.BigDec `protobuf:"bytes,6,opt,name=current_sqrt_price,json=currentSqrtPrice,proto3,customtype=github.com/osmosis-labs/osmosis/v13/osmomath.BigDec" json:"current_sqrt_price" yaml:"current_sqrt_price"`
+	CurrentTick      int64                                               `protobuf:"varint,7,opt,name=current_tick,json=currentTick,proto3" json:"current_tick,omitempty" yaml:"current_tick"`
+	// tick_spacing is the distance between ticks that positions may use as
+	// their bounds.
+	TickSpacing uint64                                 `protobuf:"varint,8,opt,name=tick_spacing,json=tickSpacing,proto3" json:"tick_spacing,omitempty" yaml:"tick_spacing"`
+	SwapFee     github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,9,opt,name=swap_fee,json=swapFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"swap_fee" yaml:"swap_fee"`
+	// fee_growth_global0 and fee_growth_global1 are the swap fees collected per
+	// unit of liquidity over the lifetime of the pool, in token0 and token1.
+	FeeGrowthGlobal0 github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,10,opt,name=fee_growth_global0,json=feeGrowthGlobal0,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"fee_growth_global0" yaml:"fee_growth_global0"`
+	FeeGrowthGlobal1 github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,11,opt,name=fee_growth_global1,json=feeGrowthGlobal1,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"fee_growth_global1" yaml:"fee_growth_global1"`
+}
+
+func (m *Pool) Reset()      { *m = Pool{} }
+func (*Pool) ProtoMessage() {}
+func (*Pool) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7b7a978d8fb262fa, []int{0}
+}
+func (m *Pool) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Pool) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Pool.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Pool) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Pool.Merge(m, src)
+}
+func (m *Pool) XXX_Size() int {
+	return m.Size()
+}
+func (m *Pool) XXX_DiscardUnknown() {
+	xxx_messageInfo_Pool.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Pool proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*Pool)(nil), "osmosis.concentratedliquidity.v1beta1.Pool")
+}
+
+func init() {
+	proto.RegisterFile("osmosis/concentrated-liquidity/v1beta1/pool.proto", fileDescriptor_7b7a978d8fb262fa)
+}
+
+var fileDescriptor_7b7a978d8fb262fa = []byte{
+	// 542 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0xcd, 0x6e, 0xd3, 0x4c,
+	0x14, 0xb5, 0xd3, 0x34, 0x69, 0x27, 0x9f, 0xfa, 0x95, 0xa1, 0x2a, 0xd3, 0x4a, 0xd8, 0x91, 0x25,
+	0x50, 0x16, 0xc4, 0xae, 0x95, 0x5d, 0x76, 0x44, 0x88, 0x0a, 0x81, 0x44, 0xe5, 0xb2, 0x40, 0xa8,
+	0x92, 0xe5, 0xd8, 0x13, 0x67, 0x14, 0x27, 0xe3, 0x78, 0x26, 0x2d, 0x79, 0x00, 0x10, 0x4b, 0x96,
+	0x2c, 0xfb, 0x10, 0x3c, 0x44, 0xc5, 0xaa, 0x4b, 0xc4, 0x22, 0x42, 0x09, 0x4f, 0x90, 0x27, 0x40,
+	0x1e, 0x8f, 0x83, 0x51, 0x8a, 0x44, 0x25, 0x56, 0x99, 0x7b, 0xee, 0x9c, 0x73, 0xee, 0x4f, 0xc6,
+	0xc0, 0xa6, 0x6c, 0x48, 0x19, 0x61, 0x96, 0x4f, 0x47, 0x3e, 0x1e, 0xf1, 0xc4, 0xe3, 0x38, 0x68,
+	0x46, 0x64, 0x3c, 0x21, 0x01, 0xe1, 0x53, 0xeb, 0xdc, 0xee, 0x62, 0xee, 0xd9, 0x56, 0x4c, 0x69,
+	0x64, 0xc6, 0x09, 0xe5, 0x14, 0x3e, 0x90, 0x14, 0xb3, 0x48, 0x59, 0x31, 0x4c, 0xc9, 0x38, 0x3c,
+	0xf0, 0xc5, 0x3d, 0x57, 0x90, 0xac, 0x2c, 0xc8, 0x14, 0x0e, 0xf7, 0x42, 0x1a, 0xd2, 0x0c, 0x4f,
+	0x4f, 0x19, 0x6a, 0xfc, 0xa8, 0x80, 0xf2, 0x09, 0xa5, 0x11, 0x7c, 0x04, 0xaa, 0x5e, 0x10, 0x24,
+	0x98, 0x31, 0xa4, 0xd6, 0xd5, 0xc6, 0x76, 0x07, 0x2e, 0x67, 0xfa, 0xce, 0xd4, 0x1b, 0x46, 0x6d,
+	0x43, 0x26, 0x0c, 0x27, 0xbf, 0x02, 0x77, 0x40, 0x89, 0x04, 0xa8, 0x54, 0x57, 0x1b, 0x65, 0xa7,
+	0x44, 0x02, 0xf8, 0x4e, 0x05, 0xfb, 0xfe, 0x24, 0x49, 0xf0, 0x88, 0xbb, 0x9c, 0xf8, 0x03, 0x77,
+	0x55, 0x1a, 0xda, 0x10, 0x6a, 0x2f, 0xaf, 0x66, 0xba, 0xf2, 0x6d, 0xa6, 0x3f, 0x0c, 0x09, 0xef,
+	0x4f, 0xba, 0xa6, 0x4f, 0x87, 0xb2, 0x3c, 0xf9, 0xd3, 0x64, 0xc1, 0xc0, 0xe2, 0xd3, 0x18, 0x33,
+	0xf3, 0x09, 0xf6, 0x97, 0x33, 0xfd, 0x7e, 0xe6, 0x7d, 0xb3, 0xaa, 0xe1, 0xec, 0xc9, 0xc4, 0x2b,
+	0xe2, 0x0f, 0x5e, 0xe4, 0x30, 0xdc, 0x07, 0x15, 0x4e, 0x07, 0x78, 0x74, 0x84, 0xca, 0xa9, 0xad,
+	0x23, 0xa3, 0x15, 0x6e, 0xa3, 0xcd, 0x02, 0x6e, 0xc3, 0xf7, 0x2a, 0x80, 0xb9, 0x03, 0x1b, 0x27,
+	0xdc, 0x8d, 0x13, 0xe2, 0x63, 0x54, 0x11, 0x35, 0xbf, 0x96, 0x35, 0xb7, 0x0a, 0x35, 0xcb, 0x35,
+	0x34, 0x23, 0xaf, 0xcb, 0xf2, 0xc0, 0x3a, 0xb7, 0x5b, 0xe2, 0x3c, 0xf4, 0x78, 0xdf, 0xec, 0x90,
+	0x30, 0x6b, 0xe0, 0xe0, 0xf7, 0x06, 0x7e, 0xc9, 0x1b, 0xce, 0xae, 0x04, 0x4f, 0xc7, 0x09, 0x3f,
+	0x49, 0x21, 0xd8, 0x06, 0xff, 0x15, 0x3b, 0x45, 0xd5, 0xba, 0xda, 0xd8, 0xe8, 0xdc, 0x5b, 0xce,
+	0xf4, 0xbb, 0xeb, 0x73, 0x30, 0x9c, 0x5a, 0xa1, 0xfb, 0x94, 0x2b, 0xa6, 0xc3, 0x62, 0xcf, 0x27,
+	0xa3, 0x10, 0x6d, 0xa5, 0x6b, 0x29, 0x72, 0x8b, 0x59, 0xc3, 0xa9, 0xa5, 0xe1, 0x69, 0x16, 0xc1,
+	0x33, 0xb0, 0xc5, 0x2e, 0xbc, 0xd8, 0xed, 0x61, 0x8c, 0xb6, 0x45, 0xd7, 0x8f, 0x6f, 0xbd, 0xa9,
+	0xff, 0x33, 0x97, 0x5c, 0xc7, 0x70, 0xaa, 0xe9, 0xf1, 0x29, 0xc6, 0x70, 0x0a, 0x60, 0x0f, 0x63,
+	0x37, 0x4c, 0xe8, 0x05, 0xef, 0xbb, 0x61, 0x44, 0xbb, 0x5e, 0x74, 0x84, 0x80, 0xf0, 0x79, 0x7e,
+	0x6b, 0x1f, 0x39, 0xd0, 0x75, 0x45, 0xc3, 0xd9, 0xed, 0x61, 0x7c, 0x2c, 0xb0, 0xe3, 0x0c, 0xba,
+	0xd1, 0xda, 0x46, 0xb5, 0x7f, 0x6c, 0x6d, 0xaf, 0x5b, 0xdb, 0xed, 0x3b, 0x1f, 0x2e, 0x75, 0xe5,
+	0xd3, 0xa5, 0xae, 0x7c, 0xf9, 0xdc, 0xdc, 0x4c, 0x1f, 0xd7, 0xb3, 0xce, 0xd9, 0xd5, 0x5c, 0x53,
+	0xaf, 0xe7, 0x9a, 0xfa, 0x7d, 0xae, 0xa9, 0x1f, 0x17, 0x9a, 0x72, 0xbd, 0xd0, 0x94, 0xaf, 0x0b,
+	0x4d, 0x79, 0xd3, 0xf9, 0x9b, 0x3f, 0xd7, 0xdb, 0x3f, 0x7d, 0x29, 0x86, 0x34, 0xc0, 0x51, 0xb7,
+	0x22, 0xde, 0x72, 0xeb, 0xe7, 0x00, 0x34, 0xa3, 0xd9, 0x5b, 0x58, 0x04, 0x00, 0x00,
+}
+
+func (m *Pool) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Pool) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Pool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.FeeGrowthGlobal1.Size()
+		i -= size
+		if _, err := m.FeeGrowthGlobal1.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintPool(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
+	{
+		size := m.FeeGrowthGlobal0.Size()
+		i -= size
+		if _, err := m.FeeGrowthGlobal0.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintPool(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	{
+		size := m.SwapFee.Size()
+		i -= size
+		if _, err := m.SwapFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintPool(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	if m.TickSpacing != 0 {
+		i = encodeVarintPool(dAtA, i, uint64(m.TickSpacing))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.CurrentTick != 0 {
+		i = encodeVarintPool(dAtA, i, uint64(m.CurrentTick))
+		i--
+		dAtA[i] = 0x38
+	}
+	{
+		size := m.CurrentSqrtPrice.Size()
+		i -= size
+		if _, err := m.CurrentSqrtPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintPool(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if len(m.Token1) > 0 {
+		i -= len(m.Token1)
+		copy(dAtA[i:], m.Token1)
+		i = encodeVarintPool(dAtA, i, uint64(len(m.Token1)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Token0) > 0 {
+		i -= len(m.Token0)
+		copy(dAtA[i:], m.Token0)
+		i = encodeVarintPool(dAtA, i, uint64(len(m.Token0)))
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size := m.CurrentTickLiquidity.Size()
+		i -= size
+		if _, err := m.CurrentTickLiquidity.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintPool(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.Id != 0 {
+		i = encodeVarintPool(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintPool(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintPool(dAtA []byte, offset int, v uint64) int {
+	offset -= sovPool(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Pool) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovPool(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovPool(uint64(m.Id))
+	}
+	l = m.CurrentTickLiquidity.Size()
+	n += 1 + l + sovPool(uint64(l))
+	l = len(m.Token0)
+	if l > 0 {
+		n += 1 + l + sovPool(uint64(l))
+	}
+	l = len(m.Token1)
+	if l > 0 {
+		n += 1 + l + sovPool(uint64(l))
+	}
+	l = m.CurrentSqrtPrice.Size()
+	n += 1 + l + sovPool(uint64(l))
+	if m.CurrentTick != 0 {
+		n += 1 + sovPool(uint64(m.CurrentTick))
+	}
+	if m.TickSpacing != 0 {
+		n += 1 + sovPool(uint64(m.TickSpacing))
+	}
+	l = m.SwapFee.Size()
+	n += 1 + l + sovPool(uint64(l))
+	l = m.FeeGrowthGlobal0.Size()
+	n += 1 + l + sovPool(uint64(l))
+	l = m.FeeGrowthGlobal1.Size()
+	n += 1 + l + sovPool(uint64(l))
+	return n
+}
+
+func sovPool(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozPool(x uint64) (n int) {
+	return sovPool(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Pool) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPool
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Pool: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Pool: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentTickLiquidity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CurrentTickLiquidity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Token0", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Token0 = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Token1", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Token1 = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentSqrtPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CurrentSqrtPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentTick", wireType)
+			}
+			m.CurrentTick = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CurrentTick |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TickSpacing", wireType)
+			}
+			m.TickSpacing = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TickSpacing |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SwapFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeGrowthGlobal0", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeeGrowthGlobal0.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeGrowthGlobal1", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeeGrowthGlobal1.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPool(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPool
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipPool(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowPool
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthPool
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupPool
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthPool
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthPool        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowPool          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupPool = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,114 @@
+package concentrated_liquidity
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v13/x/concentrated-liquidity/model"
+	"github.com/osmosis-labs/osmosis/v13/x/concentrated-liquidity/types"
+)
+
+type msgServer struct {
+	keeper *Keeper
+}
+
+func NewMsgServerImpl(keeper *Keeper) types.MsgServer {
+	return &msgServer{
+		keeper: keeper,
+	}
+}
+
+func NewMsgCreatorServerImpl(keeper *Keeper) model.MsgServer {
+	return &msgServer{
+		keeper: keeper,
+	}
+}
+
+var (
+	_ types.MsgServer = msgServer{}
+	_ model.MsgServer = msgServer{}
+)
+
+// CreateConcentratedPool creates a new concentrated liquidity pool through
+// x/swaprouter, which assigns its id and charges the pool creation fee.
+func (server msgServer) CreateConcentratedPool(goCtx context.Context, msg *model.MsgCreateConcentratedPool) (*model.MsgCreateConcentratedPoolResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	poolId, err := server.keeper.swaprouterKeeper.CreatePool(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+	})
+
+	return &model.MsgCreateConcentratedPoolResponse{PoolID: poolId}, nil
+}
+
+func (server msgServer) CreatePosition(goCtx context.Context, msg *types.MsgCreatePosition) (*types.MsgCreatePositionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	pool, err := server.keeper.getPoolById(ctx, msg.PoolId)
+	if err != nil {
+		return nil, err
+	}
+	if msg.TokenDesired0.Denom != pool.Token0 {
+		return nil, types.DenomNotInPoolError{Denom: msg.TokenDesired0.Denom}
+	}
+	if msg.TokenDesired1.Denom != pool.Token1 {
+		return nil, types.DenomNotInPoolError{Denom: msg.TokenDesired1.Denom}
+	}
+
+	amount0, amount1, liquidityCreated, err := server.keeper.createPosition(
+		ctx, msg.PoolId, sender,
+		msg.TokenDesired0.Amount, msg.TokenDesired1.Amount, msg.TokenMinAmount0, msg.TokenMinAmount1,
+		msg.LowerTick, msg.UpperTick)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+	})
+
+	return &types.MsgCreatePositionResponse{Amount0: amount0, Amount1: amount1, LiquidityCreated: liquidityCreated}, nil
+}
+
+func (server msgServer) WithdrawPosition(goCtx context.Context, msg *types.MsgWithdrawPosition) (*types.MsgWithdrawPositionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	amount0, amount1, err := server.keeper.withdrawPosition(ctx, msg.PoolId, sender, msg.LowerTick, msg.UpperTick, msg.LiquidityAmount)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+	})
+
+	return &types.MsgWithdrawPositionResponse{Amount0: amount0, Amount1: amount1}, nil
+}
@@ -0,0 +1,89 @@
+package concentrated_liquidity
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v13/osmoutils"
+	"github.com/osmosis-labs/osmosis/v13/x/concentrated-liquidity/model"
+	"github.com/osmosis-labs/osmosis/v13/x/concentrated-liquidity/types"
+	swaproutertypes "github.com/osmosis-labs/osmosis/v13/x/swaprouter/types"
+)
+
+// InitializePool stores a pool created by swaprouter. The pool starts without
+// liquidity, so there are no tokens or shares to account for.
+func (k Keeper) InitializePool(ctx sdk.Context, poolI swaproutertypes.PoolI, creatorAddress sdk.AccAddress) error {
+	pool, err := asConcentratedPool(poolI)
+	if err != nil {
+		return err
+	}
+
+	k.setPool(ctx, pool)
+	return nil
+}
+
+// GetPool returns the pool with the given id.
+func (k Keeper) GetPool(ctx sdk.Context, poolId uint64) (swaproutertypes.PoolI, error) {
+	return k.getPoolById(ctx, poolId)
+}
+
+// GetAllPools returns all concentrated liquidity pools, ordered by id.
+func (k Keeper) GetAllPools(ctx sdk.Context) ([]*model.Pool, error) {
+	return osmoutils.GatherValuesFromStorePrefix(ctx.KVStore(k.storeKey), types.PoolPrefix, func(bz []byte) (*model.Pool, error) {
+		pool := &model.Pool{}
+		err := k.cdc.Unmarshal(bz, pool)
+		return pool, err
+	})
+}
+
+// GetPools returns all concentrated liquidity pools as swaprouter pools.
+func (k Keeper) GetPools(ctx sdk.Context) ([]swaproutertypes.PoolI, error) {
+	clPools, err := k.GetAllPools(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	pools := make([]swaproutertypes.PoolI, 0, len(clPools))
+	for _, pool := range clPools {
+		pools = append(pools, pool)
+	}
+	return pools, nil
+}
+
+// CalculateSpotPrice returns the spot price of the quote asset in terms of the base asset
+// in the given pool.
+func (k Keeper) CalculateSpotPrice(ctx sdk.Context, poolId uint64, quoteAssetDenom string, baseAssetDenom string) (sdk.Dec, error) {
+	pool, err := k.getPoolById(ctx, poolId)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+
+	return pool.SpotPrice(ctx, quoteAssetDenom, baseAssetDenom)
+}
+
+func (k Keeper) getPoolById(ctx sdk.Context, poolId uint64) (*model.Pool, error) {
+	pool := &model.Pool{}
+	found, err := osmoutils.Get(ctx.KVStore(k.storeKey), types.KeyPool(poolId), pool)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, types.PoolNotFoundError{PoolId: poolId}
+	}
+	return pool, nil
+}
+
+func (k Keeper) setPool(ctx sdk.Context, pool *model.Pool) {
+	osmoutils.MustSet(ctx.KVStore(k.storeKey), types.KeyPool(pool.GetId()), pool)
+}
+
+// asConcentratedPool converts the given pool to a concentrated liquidity pool,
+// returning an error if it is of another pool model.
+func asConcentratedPool(poolI swaproutertypes.PoolI) (*model.Pool, error) {
+	pool, ok := poolI.(*model.Pool)
+	if !ok {
+		return nil, fmt.Errorf("given pool is not a concentrated liquidity pool, got %T", poolI)
+	}
+	return pool, nil
+}
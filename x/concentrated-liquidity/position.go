@@ -0,0 +1,73 @@
+package concentrated_liquidity
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v13/osmoutils"
+	"github.com/osmosis-labs/osmosis/v13/x/concentrated-liquidity/types"
+)
+
+// GetPosition returns the position of owner over [lowerTick, upperTick] in the
+// given pool, or an error if it does not exist.
+func (k Keeper) GetPosition(ctx sdk.Context, poolId uint64, owner sdk.AccAddress, lowerTick, upperTick int64) (types.Position, error) {
+	position := types.Position{}
+	found, err := osmoutils.Get(ctx.KVStore(k.storeKey), types.KeyPosition(poolId, owner, lowerTick, upperTick), &position)
+	if err != nil {
+		return types.Position{}, err
+	}
+	if !found {
+		return types.Position{}, types.PositionNotFoundError{PoolId: poolId, LowerTick: lowerTick, UpperTick: upperTick}
+	}
+	return position, nil
+}
+
+// getOrInitPosition returns the position of owner over [lowerTick, upperTick],
+// or an empty position if it does not exist yet.
+func (k Keeper) getOrInitPosition(ctx sdk.Context, poolId uint64, owner sdk.AccAddress, lowerTick, upperTick int64) (types.Position, error) {
+	position, err := k.GetPosition(ctx, poolId, owner, lowerTick, upperTick)
+	if _, notFound := err.(types.PositionNotFoundError); notFound {
+		return types.Position{
+			Liquidity:            sdk.ZeroDec(),
+			FeeGrowthInsideLast0: sdk.ZeroDec(),
+			FeeGrowthInsideLast1: sdk.ZeroDec(),
+		}, nil
+	}
+	return position, err
+}
+
+// setPosition stores the given position, deleting it if it has no liquidity left.
+func (k Keeper) setPosition(ctx sdk.Context, poolId uint64, owner sdk.AccAddress, lowerTick, upperTick int64, position types.Position) {
+	key := types.KeyPosition(poolId, owner, lowerTick, upperTick)
+	if position.Liquidity.IsZero() {
+		ctx.KVStore(k.storeKey).Delete(key)
+		return
+	}
+	osmoutils.MustSet(ctx.KVStore(k.storeKey), key, &position)
+}
+
+// GetAllPositions returns all positions of all pools.
+func (k Keeper) GetAllPositions(ctx sdk.Context) ([]types.FullPosition, error) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.PositionPrefix)
+	defer iterator.Close()
+
+	positions := []types.FullPosition{}
+	for ; iterator.Valid(); iterator.Next() {
+		poolId, owner, lowerTick, upperTick, err := types.ParsePositionKey(iterator.Key())
+		if err != nil {
+			return nil, err
+		}
+
+		position := types.Position{}
+		if err := k.cdc.Unmarshal(iterator.Value(), &position); err != nil {
+			return nil, err
+		}
+		positions = append(positions, types.FullPosition{
+			PoolId:    poolId,
+			Address:   owner.String(),
+			LowerTick: lowerTick,
+			UpperTick: upperTick,
+			Position:  position,
+		})
+	}
+	return positions, nil
+}
@@ -0,0 +1,301 @@
+package concentrated_liquidity
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v13/osmomath"
+	"github.com/osmosis-labs/osmosis/v13/x/concentrated-liquidity/math"
+	"github.com/osmosis-labs/osmosis/v13/x/concentrated-liquidity/model"
+	"github.com/osmosis-labs/osmosis/v13/x/concentrated-liquidity/types"
+	"github.com/osmosis-labs/osmosis/v13/x/swaprouter/events"
+	swaproutertypes "github.com/osmosis-labs/osmosis/v13/x/swaprouter/types"
+)
+
+// swapResult is the outcome of a swap computed against a pool, before it is
+// applied to state.
+type swapResult struct {
+	amountIn  osmomath.BigDec
+	amountOut osmomath.BigDec
+
+	sqrtPrice        osmomath.BigDec
+	tick             int64
+	liquidity        sdk.Dec
+	feeGrowthGlobal0 sdk.Dec
+	feeGrowthGlobal1 sdk.Dec
+
+	crossedTicks []crossedTick
+}
+
+// crossedTick records an initialized tick crossed by a swap, together with
+// the global fee growth at the time it was crossed.
+type crossedTick struct {
+	tickIndex        int64
+	feeGrowthGlobal0 sdk.Dec
+	feeGrowthGlobal1 sdk.Dec
+}
+
+// SwapExactAmountIn swaps tokenIn for at least tokenOutMinAmount of
+// tokenOutDenom through the given pool, charging swapFee on the amount in.
+func (k Keeper) SwapExactAmountIn(
+	ctx sdk.Context,
+	sender sdk.AccAddress,
+	poolI swaproutertypes.PoolI,
+	tokenIn sdk.Coin,
+	tokenOutDenom string,
+	tokenOutMinAmount sdk.Int,
+	swapFee sdk.Dec,
+) (sdk.Int, error) {
+	pool, err := asConcentratedPool(poolI)
+	if err != nil {
+		return sdk.Int{}, err
+	}
+
+	result, err := k.computeOutAmtGivenIn(ctx, pool, tokenIn, tokenOutDenom, swapFee)
+	if err != nil {
+		return sdk.Int{}, err
+	}
+
+	tokenOutAmount := math.BigDecToIntTruncate(result.amountOut)
+	if !tokenOutAmount.IsPositive() {
+		return sdk.Int{}, swaproutertypes.ErrInvalidMathApprox
+	}
+	if tokenOutAmount.LT(tokenOutMinAmount) {
+		return sdk.Int{}, types.AmountLessThanMinError{TokenAmount: tokenOutAmount, TokenMin: tokenOutMinAmount}
+	}
+
+	tokenOut := sdk.NewCoin(tokenOutDenom, tokenOutAmount)
+	if err := k.applySwap(ctx, pool, sender, tokenIn, tokenOut, result); err != nil {
+		return sdk.Int{}, err
+	}
+	return tokenOutAmount, nil
+}
+
+// CalcOutAmtGivenIn returns the amount of tokenOutDenom the given pool would
+// give out for tokenIn, without mutating any state.
+func (k Keeper) CalcOutAmtGivenIn(
+	ctx sdk.Context,
+	poolI swaproutertypes.PoolI,
+	tokenIn sdk.Coin,
+	tokenOutDenom string,
+	swapFee sdk.Dec,
+) (sdk.Coin, error) {
+	pool, err := asConcentratedPool(poolI)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	result, err := k.computeOutAmtGivenIn(ctx, pool, tokenIn, tokenOutDenom, swapFee)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	return sdk.NewCoin(tokenOutDenom, math.BigDecToIntTruncate(result.amountOut)), nil
+}
+
+// SwapExactAmountOut swaps at most tokenInMaxAmount of tokenInDenom for
+// exactly tokenOut through the given pool, charging swapFee on the amount in.
+func (k Keeper) SwapExactAmountOut(
+	ctx sdk.Context,
+	sender sdk.AccAddress,
+	poolI swaproutertypes.PoolI,
+	tokenInDenom string,
+	tokenInMaxAmount sdk.Int,
+	tokenOut sdk.Coin,
+	swapFee sdk.Dec,
+) (sdk.Int, error) {
+	pool, err := asConcentratedPool(poolI)
+	if err != nil {
+		return sdk.Int{}, err
+	}
+
+	result, err := k.computeInAmtGivenOut(ctx, pool, tokenOut, tokenInDenom, swapFee)
+	if err != nil {
+		return sdk.Int{}, err
+	}
+
+	tokenInAmount := math.BigDecToIntRoundUp(result.amountIn)
+	if !tokenInAmount.IsPositive() {
+		return sdk.Int{}, swaproutertypes.ErrInvalidMathApprox
+	}
+	if tokenInAmount.GT(tokenInMaxAmount) {
+		return sdk.Int{}, types.AmountGreaterThanMaxError{TokenAmount: tokenInAmount, TokenMax: tokenInMaxAmount}
+	}
+
+	tokenIn := sdk.NewCoin(tokenInDenom, tokenInAmount)
+	if err := k.applySwap(ctx, pool, sender, tokenIn, tokenOut, result); err != nil {
+		return sdk.Int{}, err
+	}
+	return tokenInAmount, nil
+}
+
+// CalcInAmtGivenOut returns the amount of tokenInDenom the given pool would
+// take in for tokenOut, without mutating any state.
+func (k Keeper) CalcInAmtGivenOut(
+	ctx sdk.Context,
+	poolI swaproutertypes.PoolI,
+	tokenOut sdk.Coin,
+	tokenInDenom string,
+	swapFee sdk.Dec,
+) (sdk.Coin, error) {
+	pool, err := asConcentratedPool(poolI)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	result, err := k.computeInAmtGivenOut(ctx, pool, tokenOut, tokenInDenom, swapFee)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	return sdk.NewCoin(tokenInDenom, math.BigDecToIntRoundUp(result.amountIn)), nil
+}
+
+func (k Keeper) computeOutAmtGivenIn(ctx sdk.Context, pool *model.Pool, tokenIn sdk.Coin, tokenOutDenom string, swapFee sdk.Dec) (swapResult, error) {
+	return k.computeSwap(ctx, pool, tokenIn.Denom, tokenOutDenom, math.IntToBigDec(tokenIn.Amount), true, swapFee)
+}
+
+func (k Keeper) computeInAmtGivenOut(ctx sdk.Context, pool *model.Pool, tokenOut sdk.Coin, tokenInDenom string, swapFee sdk.Dec) (swapResult, error) {
+	return k.computeSwap(ctx, pool, tokenInDenom, tokenOut.Denom, math.IntToBigDec(tokenOut.Amount), false, swapFee)
+}
+
+// computeSwap walks the pool's initialized ticks in the direction of the swap,
+// swapping within each range of constant liquidity until amountSpecified is
+// exhausted. amountSpecified is the amount in (including fees) when exactIn
+// is set, and the amount out otherwise. State is only read, the result is
+// applied by applySwap.
+func (k Keeper) computeSwap(
+	ctx sdk.Context,
+	pool *model.Pool,
+	tokenInDenom, tokenOutDenom string,
+	amountSpecified osmomath.BigDec,
+	exactIn bool,
+	swapFee sdk.Dec,
+) (swapResult, error) {
+	if tokenInDenom == tokenOutDenom {
+		return swapResult{}, types.ErrSameDenomSwap
+	}
+	for _, denom := range []string{tokenInDenom, tokenOutDenom} {
+		if !pool.HasDenom(denom) {
+			return swapResult{}, types.DenomNotInPoolError{Denom: denom}
+		}
+	}
+	if pool.CurrentSqrtPrice.IsZero() {
+		return swapResult{}, types.ErrPoolNotInitialized
+	}
+
+	zeroForOne := tokenInDenom == pool.Token0
+	result := swapResult{
+		amountIn:         osmomath.ZeroDec(),
+		amountOut:        osmomath.ZeroDec(),
+		sqrtPrice:        pool.CurrentSqrtPrice,
+		tick:             pool.CurrentTick,
+		liquidity:        pool.CurrentTickLiquidity,
+		feeGrowthGlobal0: pool.FeeGrowthGlobal0,
+		feeGrowthGlobal1: pool.FeeGrowthGlobal1,
+	}
+	amountRemaining := amountSpecified
+
+	for amountRemaining.IsPositive() {
+		nextTick, found, err := k.nextInitializedTick(ctx, pool.Id, result.tick, zeroForOne)
+		if err != nil {
+			return swapResult{}, err
+		}
+		if !found {
+			nextTick = math.MaxTick
+			if zeroForOne {
+				nextTick = math.MinTick
+			}
+		}
+		sqrtPriceTarget, err := math.TickToSqrtPrice(nextTick)
+		if err != nil {
+			return swapResult{}, err
+		}
+
+		liquidity := osmomath.BigDecFromSDKDec(result.liquidity)
+		var sqrtPriceNext, amountIn, amountOut, feeCharged osmomath.BigDec
+		if exactIn {
+			sqrtPriceNext, amountIn, amountOut, feeCharged = math.ComputeSwapStepOutGivenIn(result.sqrtPrice, sqrtPriceTarget, liquidity, amountRemaining, swapFee, zeroForOne)
+			amountRemaining = amountRemaining.Sub(amountIn).Sub(feeCharged)
+		} else {
+			sqrtPriceNext, amountIn, amountOut, feeCharged = math.ComputeSwapStepInGivenOut(result.sqrtPrice, sqrtPriceTarget, liquidity, amountRemaining, swapFee, zeroForOne)
+			amountRemaining = amountRemaining.Sub(amountOut)
+		}
+		result.amountIn = result.amountIn.Add(amountIn).Add(feeCharged)
+		result.amountOut = result.amountOut.Add(amountOut)
+
+		if liquidity.IsPositive() {
+			feeGrowth := feeCharged.QuoTruncate(liquidity).SDKDec()
+			if zeroForOne {
+				result.feeGrowthGlobal0 = result.feeGrowthGlobal0.Add(feeGrowth)
+			} else {
+				result.feeGrowthGlobal1 = result.feeGrowthGlobal1.Add(feeGrowth)
+			}
+		}
+		result.sqrtPrice = sqrtPriceNext
+
+		if !sqrtPriceNext.Equal(sqrtPriceTarget) {
+			result.tick, err = math.SqrtPriceToTick(sqrtPriceNext)
+			if err != nil {
+				return swapResult{}, err
+			}
+			continue
+		}
+
+		if !found {
+			// The price reached the bound of the supported range.
+			result.tick = nextTick
+			break
+		}
+
+		tickInfo, err := k.getTickInfo(ctx, pool.Id, nextTick)
+		if err != nil {
+			return swapResult{}, err
+		}
+		result.crossedTicks = append(result.crossedTicks, crossedTick{
+			tickIndex:        nextTick,
+			feeGrowthGlobal0: result.feeGrowthGlobal0,
+			feeGrowthGlobal1: result.feeGrowthGlobal1,
+		})
+		if zeroForOne {
+			result.liquidity = result.liquidity.Sub(tickInfo.LiquidityNet)
+			result.tick = nextTick - 1
+		} else {
+			result.liquidity = result.liquidity.Add(tickInfo.LiquidityNet)
+			result.tick = nextTick
+		}
+	}
+
+	if amountRemaining.IsPositive() {
+		remainingDenom := tokenOutDenom
+		if exactIn {
+			remainingDenom = tokenInDenom
+		}
+		return swapResult{}, types.NotEnoughLiquidityToSwapError{Denom: remainingDenom, Remaining: amountRemaining.SDKDec()}
+	}
+	return result, nil
+}
+
+// applySwap writes the result of a swap to state, settles the tokens between
+// sender and the pool and emits a swap event.
+func (k Keeper) applySwap(ctx sdk.Context, pool *model.Pool, sender sdk.AccAddress, tokenIn, tokenOut sdk.Coin, result swapResult) error {
+	for _, crossed := range result.crossedTicks {
+		if err := k.crossTick(ctx, pool.Id, crossed.tickIndex, crossed.feeGrowthGlobal0, crossed.feeGrowthGlobal1); err != nil {
+			return err
+		}
+	}
+
+	pool.CurrentSqrtPrice = result.sqrtPrice
+	pool.CurrentTick = result.tick
+	pool.CurrentTickLiquidity = result.liquidity
+	pool.FeeGrowthGlobal0 = result.feeGrowthGlobal0
+	pool.FeeGrowthGlobal1 = result.feeGrowthGlobal1
+	k.setPool(ctx, pool)
+
+	if err := k.bankKeeper.SendCoins(ctx, sender, pool.GetAddress(), sdk.Coins{tokenIn}); err != nil {
+		return err
+	}
+	if err := k.bankKeeper.SendCoins(ctx, pool.GetAddress(), sender, sdk.Coins{tokenOut}); err != nil {
+		return err
+	}
+
+	events.EmitSwapEvent(ctx, sender, pool.Id, sdk.Coins{tokenIn}, sdk.Coins{tokenOut})
+	return nil
+}
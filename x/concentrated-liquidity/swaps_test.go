@@ -0,0 +1,166 @@
+package concentrated_liquidity_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v13/x/concentrated-liquidity/types"
+	swaproutertypes "github.com/osmosis-labs/osmosis/v13/x/swaprouter/types"
+)
+
+func (s *KeeperTestSuite) TestSwapExactAmountIn() {
+	tests := map[string]struct {
+		tokenIn       sdk.Coin
+		tokenOutDenom string
+		swapFee       sdk.Dec
+		// whether to add a second position above the default range, so that
+		// the swap crosses into it.
+		secondPosition bool
+
+		expectedErr error
+	}{
+		"eth for usdc": {
+			tokenIn:       sdk.NewCoin(ETH, sdk.NewInt(10_000)),
+			tokenOutDenom: USDC,
+			swapFee:       sdk.ZeroDec(),
+		},
+		"usdc for eth": {
+			tokenIn:       sdk.NewCoin(USDC, sdk.NewInt(40_000_000)),
+			tokenOutDenom: ETH,
+			swapFee:       sdk.ZeroDec(),
+		},
+		"usdc for eth with swap fee": {
+			tokenIn:       sdk.NewCoin(USDC, sdk.NewInt(40_000_000)),
+			tokenOutDenom: ETH,
+			swapFee:       sdk.NewDecWithPrec(3, 3),
+		},
+		"usdc for eth crossing into a second position": {
+			tokenIn:        sdk.NewCoin(USDC, sdk.NewInt(8_000_000_000)),
+			tokenOutDenom:  ETH,
+			swapFee:        sdk.NewDecWithPrec(3, 3),
+			secondPosition: true,
+		},
+		"not enough liquidity": {
+			tokenIn:       sdk.NewCoin(USDC, sdk.NewInt(20_000_000_000)),
+			tokenOutDenom: ETH,
+			swapFee:       sdk.ZeroDec(),
+			expectedErr:   types.NotEnoughLiquidityToSwapError{},
+		},
+		"denom not in pool": {
+			tokenIn:       sdk.NewCoin("foo", sdk.NewInt(10_000)),
+			tokenOutDenom: ETH,
+			swapFee:       sdk.ZeroDec(),
+			expectedErr:   types.DenomNotInPoolError{Denom: "foo"},
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			poolId, _ := s.setupDefaultPosition(tc.swapFee)
+			if tc.secondPosition {
+				s.createPosition(poolId, s.TestAccs[1], defaultAmount0, defaultAmount1, defaultUpperTick, defaultUpperTick+10_000)
+			}
+
+			routes := []swaproutertypes.SwapAmountInRoute{{PoolId: poolId, TokenOutDenom: tc.tokenOutDenom}}
+			expectedOut, estimateErr := s.App.SwapRouterKeeper.MultihopEstimateOutGivenExactAmountIn(s.Ctx, routes, tc.tokenIn)
+
+			sender := s.TestAccs[2]
+			s.FundAcc(sender, sdk.NewCoins(tc.tokenIn))
+			tokenOut, err := s.App.SwapRouterKeeper.RouteExactAmountIn(s.Ctx, sender, routes, tc.tokenIn, sdk.OneInt())
+			if tc.expectedErr != nil {
+				s.Require().Error(err)
+				s.Require().IsType(tc.expectedErr, err)
+				s.Require().Error(estimateErr)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().NoError(estimateErr)
+			s.Require().True(tokenOut.IsPositive())
+			s.Require().Equal(expectedOut, tokenOut)
+
+			s.Require().Equal(sdk.NewCoins(sdk.NewCoin(tc.tokenOutDenom, tokenOut)), s.App.BankKeeper.GetAllBalances(s.Ctx, sender))
+
+			pool, err := s.clk.GetPoolById(s.Ctx, poolId)
+			s.Require().NoError(err)
+			spotPrice, err := pool.SpotPrice(s.Ctx, USDC, ETH)
+			s.Require().NoError(err)
+			if tc.tokenIn.Denom == ETH {
+				s.Require().True(spotPrice.LT(sdk.NewDec(4000)))
+			} else {
+				s.Require().True(spotPrice.GT(sdk.NewDec(4000)))
+			}
+
+			// The swap fee accrues to the liquidity active during the swap.
+			feeGrowthGlobal := pool.FeeGrowthGlobal1
+			if tc.tokenIn.Denom == ETH {
+				feeGrowthGlobal = pool.FeeGrowthGlobal0
+			}
+			s.Require().Equal(tc.swapFee.IsPositive(), feeGrowthGlobal.IsPositive())
+
+			if tc.secondPosition {
+				s.Require().True(pool.CurrentTick >= defaultUpperTick)
+				upperTickInfo, err := s.clk.GetTickInfo(s.Ctx, poolId, defaultUpperTick)
+				s.Require().NoError(err)
+				// The fees collected below the crossed tick are now recorded outside of it.
+				s.Require().True(upperTickInfo.FeeGrowthOutside1.IsPositive())
+			}
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestSwapExactAmountOut() {
+	poolId, _ := s.setupDefaultPosition(sdk.NewDecWithPrec(3, 3))
+	tokenOut := sdk.NewCoin(ETH, sdk.NewInt(10_000))
+	routes := []swaproutertypes.SwapAmountOutRoute{{PoolId: poolId, TokenInDenom: USDC}}
+
+	expectedIn, err := s.App.SwapRouterKeeper.MultihopEstimateInGivenExactAmountOut(s.Ctx, routes, tokenOut)
+	s.Require().NoError(err)
+	// The price is ~4000 USDC per ETH, plus the swap fee.
+	s.Require().True(expectedIn.GT(sdk.NewInt(40_000_000)))
+	s.Require().True(expectedIn.LT(sdk.NewInt(40_300_000)))
+
+	sender := s.TestAccs[2]
+	s.FundAcc(sender, sdk.NewCoins(sdk.NewCoin(USDC, expectedIn)))
+
+	// The max amount in is enforced.
+	_, err = s.App.SwapRouterKeeper.RouteExactAmountOut(s.Ctx, sender, routes, expectedIn.SubRaw(1), tokenOut)
+	s.Require().Error(err)
+
+	tokenIn, err := s.App.SwapRouterKeeper.RouteExactAmountOut(s.Ctx, sender, routes, expectedIn, tokenOut)
+	s.Require().NoError(err)
+	s.Require().Equal(expectedIn, tokenIn)
+	s.Require().Equal(sdk.NewCoins(tokenOut), s.App.BankKeeper.GetAllBalances(s.Ctx, sender))
+}
+
+// TestSwapFeesPaidToPositions tests that the fees of a swap are paid out to the
+// positions that were active during it when they are withdrawn.
+func (s *KeeperTestSuite) TestSwapFeesPaidToPositions() {
+	swapFee := sdk.NewDecWithPrec(1, 2)
+	poolId, liquidity := s.setupDefaultPosition(swapFee)
+	// A second position out of range earns no fees.
+	_, _, outOfRangeLiquidity := s.createPosition(poolId, s.TestAccs[1], defaultAmount0, defaultAmount1, defaultUpperTick, defaultUpperTick+1000)
+
+	tokenIn := sdk.NewCoin(USDC, sdk.NewInt(100_000_000))
+	sender := s.TestAccs[2]
+	s.FundAcc(sender, sdk.NewCoins(tokenIn))
+	routes := []swaproutertypes.SwapAmountInRoute{{PoolId: poolId, TokenOutDenom: ETH}}
+	_, err := s.App.SwapRouterKeeper.RouteExactAmountIn(s.Ctx, sender, routes, tokenIn, sdk.OneInt())
+	s.Require().NoError(err)
+
+	owner := s.TestAccs[0]
+	balanceBefore := s.App.BankKeeper.GetBalance(s.Ctx, owner, USDC)
+	_, amount1, err := s.clk.WithdrawPosition(s.Ctx, poolId, owner, defaultLowerTick, defaultUpperTick, liquidity)
+	s.Require().NoError(err)
+	fees := s.App.BankKeeper.GetBalance(s.Ctx, owner, USDC).Amount.Sub(balanceBefore.Amount).Sub(amount1)
+
+	// All of the fee goes to the only active position, up to rounding.
+	expectedFees := tokenIn.Amount.ToDec().Mul(swapFee).TruncateInt()
+	s.Require().True(fees.LTE(expectedFees))
+	s.Require().True(fees.GTE(expectedFees.SubRaw(1)), "expected fees of %s, got %s", expectedFees, fees)
+
+	outOfRangeOwner := s.TestAccs[1]
+	balanceBefore = s.App.BankKeeper.GetBalance(s.Ctx, outOfRangeOwner, USDC)
+	_, amount1, err = s.clk.WithdrawPosition(s.Ctx, poolId, outOfRangeOwner, defaultUpperTick, defaultUpperTick+1000, outOfRangeLiquidity)
+	s.Require().NoError(err)
+	s.Require().Equal(balanceBefore.Amount.Add(amount1), s.App.BankKeeper.GetBalance(s.Ctx, outOfRangeOwner, USDC).Amount)
+}
@@ -0,0 +1,132 @@
+package concentrated_liquidity
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v13/osmoutils"
+	"github.com/osmosis-labs/osmosis/v13/x/concentrated-liquidity/model"
+	"github.com/osmosis-labs/osmosis/v13/x/concentrated-liquidity/types"
+)
+
+// getTickInfo returns the state of the given tick. Uninitialized ticks are
+// returned with all values set to zero.
+func (k Keeper) getTickInfo(ctx sdk.Context, poolId uint64, tickIndex int64) (types.TickInfo, error) {
+	tickInfo := types.TickInfo{}
+	found, err := osmoutils.Get(ctx.KVStore(k.storeKey), types.KeyTick(poolId, tickIndex), &tickInfo)
+	if err != nil {
+		return types.TickInfo{}, err
+	}
+	if !found {
+		return newEmptyTickInfo(), nil
+	}
+	return tickInfo, nil
+}
+
+func (k Keeper) setTickInfo(ctx sdk.Context, poolId uint64, tickIndex int64, tickInfo types.TickInfo) {
+	osmoutils.MustSet(ctx.KVStore(k.storeKey), types.KeyTick(poolId, tickIndex), &tickInfo)
+}
+
+// initOrUpdateTick adds liquidityDelta to the liquidity referencing the given
+// tick as the lower or upper bound of a position. A tick is initialized the
+// first time liquidity references it, assuming that all fees so far were
+// collected below it if it is at or below the current tick, and is removed
+// once no liquidity references it anymore.
+func (k Keeper) initOrUpdateTick(ctx sdk.Context, pool *model.Pool, tickIndex int64, liquidityDelta sdk.Dec, upper bool) error {
+	tickInfo, err := k.getTickInfo(ctx, pool.Id, tickIndex)
+	if err != nil {
+		return err
+	}
+
+	if tickInfo.LiquidityGross.IsZero() && tickIndex <= pool.CurrentTick {
+		tickInfo.FeeGrowthOutside0 = pool.FeeGrowthGlobal0
+		tickInfo.FeeGrowthOutside1 = pool.FeeGrowthGlobal1
+	}
+
+	tickInfo.LiquidityGross = tickInfo.LiquidityGross.Add(liquidityDelta)
+	if upper {
+		tickInfo.LiquidityNet = tickInfo.LiquidityNet.Sub(liquidityDelta)
+	} else {
+		tickInfo.LiquidityNet = tickInfo.LiquidityNet.Add(liquidityDelta)
+	}
+
+	if tickInfo.LiquidityGross.IsZero() {
+		ctx.KVStore(k.storeKey).Delete(types.KeyTick(pool.Id, tickIndex))
+		return nil
+	}
+
+	k.setTickInfo(ctx, pool.Id, tickIndex, tickInfo)
+	return nil
+}
+
+// crossTick flips the fee growth recorded outside the given tick, as the
+// current tick moves to its other side.
+func (k Keeper) crossTick(ctx sdk.Context, poolId uint64, tickIndex int64, feeGrowthGlobal0, feeGrowthGlobal1 sdk.Dec) error {
+	tickInfo, err := k.getTickInfo(ctx, poolId, tickIndex)
+	if err != nil {
+		return err
+	}
+
+	tickInfo.FeeGrowthOutside0 = feeGrowthGlobal0.Sub(tickInfo.FeeGrowthOutside0)
+	tickInfo.FeeGrowthOutside1 = feeGrowthGlobal1.Sub(tickInfo.FeeGrowthOutside1)
+	k.setTickInfo(ctx, poolId, tickIndex, tickInfo)
+	return nil
+}
+
+// nextInitializedTick returns the next initialized tick the price would reach
+// from currentTick. When zeroForOne is set the price moves down, and the
+// largest initialized tick at or below the current tick is returned.
+// Otherwise the smallest initialized tick above the current tick is returned.
+func (k Keeper) nextInitializedTick(ctx sdk.Context, poolId uint64, currentTick int64, zeroForOne bool) (tickIndex int64, found bool, err error) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyTickPrefix(poolId))
+
+	var iterator sdk.Iterator
+	if zeroForOne {
+		iterator = store.ReverseIterator(nil, types.TickIndexToBytes(currentTick+1))
+	} else {
+		iterator = store.Iterator(types.TickIndexToBytes(currentTick+1), nil)
+	}
+	defer iterator.Close()
+
+	if !iterator.Valid() {
+		return 0, false, nil
+	}
+
+	tickIndex, err = types.TickIndexFromBytes(iterator.Key())
+	if err != nil {
+		return 0, false, err
+	}
+	return tickIndex, true, nil
+}
+
+// GetAllTicks returns all initialized ticks of all pools.
+func (k Keeper) GetAllTicks(ctx sdk.Context) ([]types.FullTick, error) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.TickPrefix)
+	defer iterator.Close()
+
+	ticks := []types.FullTick{}
+	for ; iterator.Valid(); iterator.Next() {
+		key := iterator.Key()[len(types.TickPrefix):]
+		poolId := sdk.BigEndianToUint64(key[:8])
+		tickIndex, err := types.TickIndexFromBytes(key[8:])
+		if err != nil {
+			return nil, err
+		}
+
+		tickInfo := types.TickInfo{}
+		if err := k.cdc.Unmarshal(iterator.Value(), &tickInfo); err != nil {
+			return nil, err
+		}
+		ticks = append(ticks, types.FullTick{PoolId: poolId, TickIndex: tickIndex, Info: tickInfo})
+	}
+	return ticks, nil
+}
+
+func newEmptyTickInfo() types.TickInfo {
+	return types.TickInfo{
+		LiquidityGross:    sdk.ZeroDec(),
+		LiquidityNet:      sdk.ZeroDec(),
+		FeeGrowthOutside0: sdk.ZeroDec(),
+		FeeGrowthOutside1: sdk.ZeroDec(),
+	}
+}
@@ -0,0 +1,115 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var (
+	ErrNotPositiveLiquidity = errors.New("liquidity must be positive")
+	ErrNoInitialPrice       = errors.New("the first position in a pool must deposit both tokens to set the initial price")
+	ErrPoolNotInitialized   = errors.New("pool has no price until its first position is created")
+	ErrSameDenomSwap        = errors.New("cannot trade same denomination in and out")
+)
+
+type PoolNotFoundError struct {
+	PoolId uint64
+}
+
+func (e PoolNotFoundError) Error() string {
+	return fmt.Sprintf("pool not found. pool id (%d)", e.PoolId)
+}
+
+type InvalidTickRangeError struct {
+	LowerTick int64
+	UpperTick int64
+}
+
+func (e InvalidTickRangeError) Error() string {
+	return fmt.Sprintf("invalid tick range: lower tick (%d) must be less than upper tick (%d)", e.LowerTick, e.UpperTick)
+}
+
+type TickOutOfBoundsError struct {
+	Tick    int64
+	MinTick int64
+	MaxTick int64
+}
+
+func (e TickOutOfBoundsError) Error() string {
+	return fmt.Sprintf("tick (%d) is out of bounds [%d, %d]", e.Tick, e.MinTick, e.MaxTick)
+}
+
+type TickSpacingError struct {
+	Tick        int64
+	TickSpacing uint64
+}
+
+func (e TickSpacingError) Error() string {
+	return fmt.Sprintf("tick (%d) is not a multiple of the pool's tick spacing (%d)", e.Tick, e.TickSpacing)
+}
+
+type PositionNotFoundError struct {
+	PoolId    uint64
+	LowerTick int64
+	UpperTick int64
+}
+
+func (e PositionNotFoundError) Error() string {
+	return fmt.Sprintf("position not found. pool id (%d), lower tick (%d), upper tick (%d)", e.PoolId, e.LowerTick, e.UpperTick)
+}
+
+type InsufficientLiquidityError struct {
+	Actual    sdk.Dec
+	Available sdk.Dec
+}
+
+func (e InsufficientLiquidityError) Error() string {
+	return fmt.Sprintf("cannot withdraw liquidity (%s), position only has (%s)", e.Actual, e.Available)
+}
+
+type InsufficientAmountError struct {
+	Denom   string
+	Actual  sdk.Int
+	Minimum sdk.Int
+}
+
+func (e InsufficientAmountError) Error() string {
+	return fmt.Sprintf("amount of %s (%s) is less than the minimum amount (%s)", e.Denom, e.Actual, e.Minimum)
+}
+
+type DenomNotInPoolError struct {
+	Denom string
+}
+
+func (e DenomNotInPoolError) Error() string {
+	return fmt.Sprintf("denom (%s) is not in the pool", e.Denom)
+}
+
+type NotEnoughLiquidityToSwapError struct {
+	Denom     string
+	Remaining sdk.Dec
+}
+
+func (e NotEnoughLiquidityToSwapError) Error() string {
+	return fmt.Sprintf("pool ran out of liquidity with %s of %s left to swap", e.Remaining, e.Denom)
+}
+
+type AmountLessThanMinError struct {
+	TokenAmount sdk.Int
+	TokenMin    sdk.Int
+}
+
+func (e AmountLessThanMinError) Error() string {
+	return fmt.Sprintf("token amount calculated (%s) is lesser than min amount (%s)", e.TokenAmount, e.TokenMin)
+}
+
+type AmountGreaterThanMaxError struct {
+	TokenAmount sdk.Int
+	TokenMax    sdk.Int
+}
+
+func (e AmountGreaterThanMaxError) Error() string {
+	return fmt.Sprintf("token amount calculated (%s) is greater than max amount (%s)", e.TokenAmount, e.TokenMax)
+}
@@ -0,0 +1,16 @@
+package types
+
+const (
+	TypeEvtCreatePosition   = "create_position"
+	TypeEvtWithdrawPosition = "withdraw_position"
+	TypeEvtCollectFees      = "collect_fees"
+
+	AttributeValueCategory = ModuleName
+	AttributeKeyPoolId     = "pool_id"
+	AttributeLowerTick     = "lower_tick"
+	AttributeUpperTick     = "upper_tick"
+	AttributeLiquidity     = "liquidity"
+	AttributeAmount0       = "amount0"
+	AttributeAmount1       = "amount1"
+	AttributeFees          = "fees"
+)
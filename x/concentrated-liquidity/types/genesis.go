@@ -0,0 +1,70 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	swaproutertypes "github.com/osmosis-labs/osmosis/v13/x/swaprouter/types"
+)
+
+// DefaultGenesis returns the default concentrated liquidity genesis state.
+func DefaultGenesis() *GenesisState {
+	return &GenesisState{
+		Pools:     []*codectypes.Any{},
+		Ticks:     []FullTick{},
+		Positions: []FullPosition{},
+	}
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	poolIds := make(map[uint64]bool, len(gs.Pools))
+	for _, any := range gs.Pools {
+		pool, ok := any.GetCachedValue().(swaproutertypes.PoolI)
+		if !ok {
+			return fmt.Errorf("genesis pool must implement PoolI, got %T", any.GetCachedValue())
+		}
+		if poolIds[pool.GetId()] {
+			return fmt.Errorf("duplicate pool id (%d) in genesis", pool.GetId())
+		}
+		poolIds[pool.GetId()] = true
+	}
+
+	for _, tick := range gs.Ticks {
+		if !poolIds[tick.PoolId] {
+			return PoolNotFoundError{PoolId: tick.PoolId}
+		}
+		if tick.Info.LiquidityGross.IsNil() || !tick.Info.LiquidityGross.IsPositive() {
+			return errors.New("genesis ticks must have positive gross liquidity")
+		}
+	}
+
+	for _, position := range gs.Positions {
+		if !poolIds[position.PoolId] {
+			return PoolNotFoundError{PoolId: position.PoolId}
+		}
+		if _, err := sdk.AccAddressFromBech32(position.Address); err != nil {
+			return err
+		}
+		if err := ValidateTickRange(position.LowerTick, position.UpperTick); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (gs GenesisState) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	for _, any := range gs.Pools {
+		var pool swaproutertypes.PoolI
+		if err := unpacker.UnpackAny(any, &pool); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,95 @@
+package types
+
+import (
+	"encoding/binary"
+	"errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
+const (
+	ModuleName = "concentratedliquidity"
+
+	StoreKey = ModuleName
+
+	RouterKey = ModuleName
+)
+
+var (
+	// PoolPrefix defines prefix to store pools.
+	PoolPrefix = []byte{0x01}
+	// TickPrefix defines prefix to store the initialized ticks of each pool.
+	TickPrefix = []byte{0x02}
+	// PositionPrefix defines prefix to store positions.
+	PositionPrefix = []byte{0x03}
+)
+
+// KeyPool returns the key for storing the pool with the given id.
+func KeyPool(poolId uint64) []byte {
+	return append(PoolPrefix, sdk.Uint64ToBigEndian(poolId)...)
+}
+
+// KeyTickPrefix returns the prefix for all ticks of the given pool.
+func KeyTickPrefix(poolId uint64) []byte {
+	return append(TickPrefix, sdk.Uint64ToBigEndian(poolId)...)
+}
+
+// KeyTick returns the key for storing the given tick of the given pool.
+// Ticks of a pool are ordered by their index under KeyTickPrefix.
+func KeyTick(poolId uint64, tickIndex int64) []byte {
+	return append(KeyTickPrefix(poolId), TickIndexToBytes(tickIndex)...)
+}
+
+// TickIndexToBytes encodes a tick index such that the lexicographical order
+// of the encodings matches the numerical order of the indexes.
+func TickIndexToBytes(tickIndex int64) []byte {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, uint64(tickIndex)^(1<<63))
+	return bz
+}
+
+// TickIndexFromBytes decodes a tick index encoded with TickIndexToBytes.
+func TickIndexFromBytes(bz []byte) (int64, error) {
+	if len(bz) != 8 {
+		return 0, errors.New("tick index must be 8 bytes")
+	}
+	return int64(binary.BigEndian.Uint64(bz) ^ (1 << 63)), nil
+}
+
+// KeyPosition returns the key for storing the position of owner over
+// [lowerTick, upperTick] in the given pool.
+func KeyPosition(poolId uint64, owner sdk.AccAddress, lowerTick, upperTick int64) []byte {
+	key := append(PositionPrefix, sdk.Uint64ToBigEndian(poolId)...)
+	key = append(key, address.MustLengthPrefix(owner)...)
+	key = append(key, TickIndexToBytes(lowerTick)...)
+	return append(key, TickIndexToBytes(upperTick)...)
+}
+
+// ParsePositionKey returns the pool id, owner and tick range encoded in a
+// key created with KeyPosition.
+func ParsePositionKey(key []byte) (poolId uint64, owner sdk.AccAddress, lowerTick, upperTick int64, err error) {
+	if len(key) < len(PositionPrefix)+8+1 {
+		return 0, nil, 0, 0, errors.New("position key is too short")
+	}
+	key = key[len(PositionPrefix):]
+	poolId = sdk.BigEndianToUint64(key[:8])
+	key = key[8:]
+
+	addrLen := int(key[0])
+	if len(key) != 1+addrLen+16 {
+		return 0, nil, 0, 0, errors.New("position key has an invalid length")
+	}
+	owner = sdk.AccAddress(key[1 : 1+addrLen])
+	key = key[1+addrLen:]
+
+	lowerTick, err = TickIndexFromBytes(key[:8])
+	if err != nil {
+		return 0, nil, 0, 0, err
+	}
+	upperTick, err = TickIndexFromBytes(key[8:])
+	if err != nil {
+		return 0, nil, 0, 0, err
+	}
+	return poolId, owner, lowerTick, upperTick, nil
+}
@@ -0,0 +1,110 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/osmosis-labs/osmosis/v13/x/concentrated-liquidity/math"
+)
+
+// constants.
+const (
+	TypeMsgCreatePosition   = "create_position"
+	TypeMsgWithdrawPosition = "withdraw_position"
+)
+
+var _ sdk.Msg = &MsgCreatePosition{}
+
+func (msg MsgCreatePosition) Route() string { return RouterKey }
+func (msg MsgCreatePosition) Type() string  { return TypeMsgCreatePosition }
+func (msg MsgCreatePosition) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	if err := ValidateTickRange(msg.LowerTick, msg.UpperTick); err != nil {
+		return err
+	}
+
+	if err := msg.TokenDesired0.Validate(); err != nil {
+		return err
+	}
+	if err := msg.TokenDesired1.Validate(); err != nil {
+		return err
+	}
+	if msg.TokenDesired0.Denom == msg.TokenDesired1.Denom {
+		return fmt.Errorf("desired tokens must have different denoms, got (%s) for both", msg.TokenDesired0.Denom)
+	}
+	if !msg.TokenDesired0.IsPositive() && !msg.TokenDesired1.IsPositive() {
+		return fmt.Errorf("at least one of the desired token amounts must be positive")
+	}
+
+	if msg.TokenMinAmount0.IsNil() || msg.TokenMinAmount0.IsNegative() {
+		return fmt.Errorf("token min amount 0 must be non-negative, was (%s)", msg.TokenMinAmount0)
+	}
+	if msg.TokenMinAmount1.IsNil() || msg.TokenMinAmount1.IsNegative() {
+		return fmt.Errorf("token min amount 1 must be non-negative, was (%s)", msg.TokenMinAmount1)
+	}
+
+	return nil
+}
+
+func (msg MsgCreatePosition) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgCreatePosition) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgWithdrawPosition{}
+
+func (msg MsgWithdrawPosition) Route() string { return RouterKey }
+func (msg MsgWithdrawPosition) Type() string  { return TypeMsgWithdrawPosition }
+func (msg MsgWithdrawPosition) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	if err := ValidateTickRange(msg.LowerTick, msg.UpperTick); err != nil {
+		return err
+	}
+
+	if msg.LiquidityAmount.IsNil() || !msg.LiquidityAmount.IsPositive() {
+		return ErrNotPositiveLiquidity
+	}
+
+	return nil
+}
+
+func (msg MsgWithdrawPosition) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgWithdrawPosition) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+// ValidateTickRange returns an error if lowerTick is not strictly less than
+// upperTick, or if either is out of the supported tick bounds.
+func ValidateTickRange(lowerTick, upperTick int64) error {
+	for _, tick := range []int64{lowerTick, upperTick} {
+		if tick < math.MinTick || tick > math.MaxTick {
+			return TickOutOfBoundsError{Tick: tick, MinTick: math.MinTick, MaxTick: math.MaxTick}
+		}
+	}
+	if lowerTick >= upperTick {
+		return InvalidTickRangeError{LowerTick: lowerTick, UpperTick: upperTick}
+	}
+	return nil
+}
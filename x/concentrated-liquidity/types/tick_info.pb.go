@@ -0,0 +1,474 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: osmosis/concentrated-liquidity/v1beta1/tick_info.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// TickInfo holds the state of an initialized tick.
+type TickInfo struct {
+	// liquidity_gross is the total liquidity of all positions referencing the
+	// tick as either bound. The tick is uninitialized once it reaches zero.
+	LiquidityGross github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=liquidity_gross,json=liquidityGross,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"liquidity_gross" yaml:"liquidity_gross"`
+	// liquidity_net is the amount of liquidity added to the active range when
+	// the price crosses the tick from left to right.
+	LiquidityNet github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=liquidity_net,json=liquidityNet,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"liquidity_net" yaml:"liquidity_net"`
+	// fee_growth_outside0 and fee_growth_outside1 are the fees per unit of
+	// liquidity collected on the other side of the tick from the current one.
+	FeeGrowthOutside0 github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=fee_growth_outside0,json=feeGrowthOutside0,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"fee_growth_outside0" yaml:"fee_growth_outside0"`
+	FeeGrowthOutside1 github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=fee_growth_outside1,json=feeGrowthOutside1,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"fee_growth_outside1" yaml:"fee_growth_outside1"`
+}
+
+func (m *TickInfo) Reset()         { *m = TickInfo{} }
+func (m *TickInfo) String() string { return proto.CompactTextString(m) }
+func (*TickInfo) ProtoMessage()    {}
+func (*TickInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_083e2c3e4bb3f902, []int{0}
+}
+func (m *TickInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TickInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TickInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TickInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TickInfo.Merge(m, src)
+}
+func (m *TickInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *TickInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_TickInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TickInfo proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*TickInfo)(nil), "osmosis.concentratedliquidity.v1beta1.TickInfo")
+}
+
+func init() {
+	proto.RegisterFile("osmosis/concentrated-liquidity/v1beta1/tick_info.proto", fileDescriptor_083e2c3e4bb3f902)
+}
+
+var fileDescriptor_083e2c3e4bb3f902 = []byte{
+	// 344 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x92, 0xcd, 0x4a, 0xf3, 0x40,
+	0x14, 0x86, 0x93, 0xaf, 0x1f, 0xa2, 0xc1, 0x1f, 0x8c, 0x45, 0x42, 0x17, 0xa9, 0x04, 0x14, 0x37,
+	0xcd, 0x18, 0x0a, 0x2e, 0x5c, 0x16, 0xb1, 0x0a, 0xa2, 0x50, 0x5c, 0x89, 0x50, 0x92, 0xc9, 0x49,
+	0x3a, 0xa4, 0xcd, 0xb4, 0x9d, 0xd3, 0x6a, 0xc1, 0x8b, 0xf0, 0xb2, 0xba, 0xec, 0x52, 0x5c, 0x14,
+	0x69, 0xc1, 0x0b, 0xf0, 0x0a, 0xa4, 0xd3, 0x18, 0x4b, 0x89, 0x8b, 0x82, 0xab, 0xe4, 0xbc, 0xcc,
+	0x73, 0x9e, 0xb3, 0x78, 0xb5, 0x53, 0x2e, 0x5a, 0x5c, 0x30, 0x41, 0x28, 0x8f, 0x29, 0xc4, 0xd8,
+	0x75, 0x11, 0xfc, 0x52, 0x93, 0x75, 0x7a, 0xcc, 0x67, 0x38, 0x20, 0x7d, 0xc7, 0x03, 0x74, 0x1d,
+	0x82, 0x8c, 0x46, 0x75, 0x16, 0x07, 0xdc, 0x6e, 0x77, 0x39, 0x72, 0xfd, 0x30, 0xe1, 0xec, 0x45,
+	0x2e, 0xc5, 0xec, 0x04, 0x2b, 0xe4, 0x43, 0x1e, 0x72, 0x49, 0x90, 0xd9, 0xdf, 0x1c, 0xb6, 0x3e,
+	0x72, 0xda, 0xfa, 0x1d, 0xa3, 0xd1, 0x55, 0x1c, 0x70, 0xbd, 0xa3, 0xed, 0xa4, 0x5c, 0x3d, 0xec,
+	0x72, 0x21, 0x0c, 0xf5, 0x40, 0x3d, 0xde, 0xa8, 0x5c, 0x0e, 0xc7, 0x45, 0xe5, 0x6d, 0x5c, 0x3c,
+	0x0a, 0x19, 0x36, 0x7a, 0x9e, 0x4d, 0x79, 0x8b, 0x50, 0xa9, 0x4d, 0x3e, 0x25, 0xe1, 0x47, 0x04,
+	0x07, 0x6d, 0x10, 0xf6, 0x39, 0xd0, 0xcf, 0x71, 0x71, 0x7f, 0xe0, 0xb6, 0x9a, 0x67, 0xd6, 0xd2,
+	0x3a, 0xab, 0xb6, 0x9d, 0x26, 0xd5, 0x59, 0xa0, 0x47, 0xda, 0xd6, 0xcf, 0x9b, 0x18, 0xd0, 0xf8,
+	0x27, 0x85, 0x17, 0x2b, 0x0b, 0xf3, 0xcb, 0xc2, 0x18, 0xd0, 0xaa, 0x6d, 0xa6, 0xf3, 0x0d, 0xa0,
+	0xfe, 0xac, 0xed, 0x05, 0x00, 0xb3, 0x53, 0x1e, 0xb1, 0x51, 0xe7, 0x3d, 0x14, 0xcc, 0x87, 0x13,
+	0x23, 0x27, 0x95, 0xd7, 0x2b, 0x2b, 0x0b, 0x73, 0x65, 0xc6, 0x4a, 0xab, 0xb6, 0x1b, 0x00, 0x54,
+	0x65, 0x78, 0x9b, 0x64, 0xd9, 0x76, 0xc7, 0xf8, 0xff, 0xd7, 0x76, 0x27, 0xc3, 0xee, 0x54, 0x1e,
+	0x86, 0x13, 0x53, 0x1d, 0x4d, 0x4c, 0xf5, 0x7d, 0x62, 0xaa, 0x2f, 0x53, 0x53, 0x19, 0x4d, 0x4d,
+	0xe5, 0x75, 0x6a, 0x2a, 0xf7, 0x95, 0x05, 0x65, 0x52, 0xa5, 0x52, 0xd3, 0xf5, 0xc4, 0xf7, 0x40,
+	0xfa, 0x4e, 0x99, 0x3c, 0xfd, 0xd6, 0x4a, 0x79, 0x92, 0xb7, 0x26, 0xdb, 0x54, 0xfe, 0x1a, 0x00,
+	0x16, 0xe0, 0xc0, 0x98, 0xc4, 0x02, 0x00, 0x00,
+}
+
+func (m *TickInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TickInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TickInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.FeeGrowthOutside1.Size()
+		i -= size
+		if _, err := m.FeeGrowthOutside1.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTickInfo(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.FeeGrowthOutside0.Size()
+		i -= size
+		if _, err := m.FeeGrowthOutside0.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTickInfo(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.LiquidityNet.Size()
+		i -= size
+		if _, err := m.LiquidityNet.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTickInfo(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.LiquidityGross.Size()
+		i -= size
+		if _, err := m.LiquidityGross.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTickInfo(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTickInfo(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTickInfo(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *TickInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.LiquidityGross.Size()
+	n += 1 + l + sovTickInfo(uint64(l))
+	l = m.LiquidityNet.Size()
+	n += 1 + l + sovTickInfo(uint64(l))
+	l = m.FeeGrowthOutside0.Size()
+	n += 1 + l + sovTickInfo(uint64(l))
+	l = m.FeeGrowthOutside1.Size()
+	n += 1 + l + sovTickInfo(uint64(l))
+	return n
+}
+
+func sovTickInfo(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTickInfo(x uint64) (n int) {
+	return sovTickInfo(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *TickInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTickInfo
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TickInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TickInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityGross", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTickInfo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTickInfo
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTickInfo
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LiquidityGross.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityNet", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTickInfo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTickInfo
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTickInfo
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LiquidityNet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeGrowthOutside0", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTickInfo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTickInfo
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTickInfo
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeeGrowthOutside0.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeGrowthOutside1", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTickInfo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTickInfo
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTickInfo
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeeGrowthOutside1.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTickInfo(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTickInfo
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipTickInfo(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowTickInfo
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTickInfo
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTickInfo
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthTickInfo
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupTickInfo
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthTickInfo
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthTickInfo        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTickInfo          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupTickInfo = fmt.Errorf("proto: unexpected end of group")
+)
@@ -10,6 +10,7 @@ import (
 	"github.com/osmosis-labs/osmosis/v13/osmoutils/osmocli"
 	"github.com/osmosis-labs/osmosis/v13/x/gamm/client/cli"
 	"github.com/osmosis-labs/osmosis/v13/x/gamm/pool-models/balancer"
+	"github.com/osmosis-labs/osmosis/v13/x/gamm/pool-models/stableswap"
 	"github.com/osmosis-labs/osmosis/v13/x/gamm/types"
 
 	"github.com/cosmos/cosmos-sdk/testutil"
@@ -306,6 +307,42 @@ func TestNewExitSwapShareAmountInCmd(t *testing.T) {
 	osmocli.RunTxTestCases(t, desc, tcs)
 }
 
+func TestNewMigrateLiquidityCmd(t *testing.T) {
+	desc, _ := cli.NewMigrateLiquidityCmd()
+	tcs := map[string]osmocli.TxCliTestCase[*types.MsgMigrateLiquidity]{
+		"migrate liquidity": {
+			Cmd: "1 2 10 9 --lock-id=3 --min-amounts-out=100stake --from=" + testAddresses[0].String(),
+			ExpectedMsg: &types.MsgMigrateLiquidity{
+				Sender:            testAddresses[0].String(),
+				PoolIdLeaving:     1,
+				PoolIdEntering:    2,
+				SharesToMigrate:   sdk.NewIntFromUint64(10),
+				TokenOutMins:      sdk.NewCoins(sdk.NewInt64Coin("stake", 100)),
+				ShareOutMinAmount: sdk.NewIntFromUint64(9),
+				LockId:            3,
+			},
+		},
+	}
+	osmocli.RunTxTestCases(t, desc, tcs)
+}
+
+func TestNewStableSwapExitPoolToDenomsCmd(t *testing.T) {
+	desc, _ := cli.NewStableSwapExitPoolToDenomsCmd()
+	tcs := map[string]osmocli.TxCliTestCase[*stableswap.MsgStableSwapExitPoolToDenoms]{
+		"exit pool to denoms": {
+			Cmd: "1 10 uusdc,uusdt --min-amounts-out=100uusdc --from=" + testAddresses[0].String(),
+			ExpectedMsg: &stableswap.MsgStableSwapExitPoolToDenoms{
+				Sender:         testAddresses[0].String(),
+				PoolID:         1,
+				ShareInAmount:  sdk.NewIntFromUint64(10),
+				TokenOutDenoms: []string{"uusdc", "uusdt"},
+				TokenOutMins:   sdk.NewCoins(sdk.NewInt64Coin("uusdc", 100)),
+			},
+		},
+	}
+	osmocli.RunTxTestCases(t, desc, tcs)
+}
+
 func TestGetCmdPools(t *testing.T) {
 	desc, _ := cli.GetCmdPools()
 	tcs := map[string]osmocli.QueryCliTestCase[*types.QueryPoolsRequest]{
@@ -15,6 +15,10 @@ const (
 	PoolFileSwapFee        = "swap-fee"
 	PoolFileExitFee        = "exit-fee"
 	PoolFileFutureGovernor = "future-governor"
+	PoolFilePoolController = "pool-controller"
+	// Will be parsed to sdk.Dec, defaulting to zero.
+	PoolFileTakerFeeShare     = "taker-fee-share"
+	PoolFileTakerFeeRecipient = "taker-fee-recipient"
 
 	PoolFileSmoothWeightChangeParams = "lbp-params"
 	PoolFileStartTime                = "start-time"
@@ -40,6 +44,18 @@ const (
 	FlagSwapRouteDenoms = "swap-route-denoms"
 	// FlagScalingFactors represents the flag name for the scaling factors.
 	FlagScalingFactors = "scaling-factors"
+	// Will be parsed to time.Duration.
+	FlagScalingFactorChangeDuration = "scaling-factor-change-duration"
+	// FlagRateProviderContract represents the flag name for the scaling factor rate provider contract address.
+	FlagRateProviderContract = "rate-provider-contract"
+	// Will be parsed to time.Time.
+	FlagStartTime = "start-time"
+	// Will be parsed to time.Duration.
+	FlagDuration = "duration"
+	// Will be parsed to []sdk.DecCoin.
+	FlagTargetPoolWeights = "target-pool-weights"
+	// FlagLockId represents the flag name for the id of a lock.
+	FlagLockId = "lock-id"
 )
 
 type createBalancerPoolInputs struct {
@@ -48,6 +64,9 @@ type createBalancerPoolInputs struct {
 	SwapFee                  string                         `json:"swap-fee"`
 	ExitFee                  string                         `json:"exit-fee"`
 	FutureGovernor           string                         `json:"future-governor"`
+	PoolController           string                         `json:"pool-controller"`
+	TakerFeeShare            string                         `json:"taker-fee-share"`
+	TakerFeeRecipient        string                         `json:"taker-fee-recipient"`
 	SmoothWeightChangeParams smoothWeightChangeParamsInputs `json:"lbp-params"`
 }
 
@@ -58,6 +77,8 @@ type createStableswapPoolInputs struct {
 	FutureGovernor          string `json:"future-governor"`
 	ScalingFactorController string `json:"scaling-factor-controller"`
 	ScalingFactors          string `json:"scaling-factors"`
+	TakerFeeShare           string `json:"taker-fee-share"`
+	TakerFeeRecipient       string `json:"taker-fee-recipient"`
 }
 
 type smoothWeightChangeParamsInputs struct {
@@ -103,6 +124,19 @@ func FlagSetExitPool() *flag.FlagSet {
 	return fs
 }
 
+func FlagSetMigrateLiquidity() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+	fs.Uint64(FlagLockId, 0, "The id of the lock holding the shares to migrate, if they are locked")
+	fs.StringArray(FlagMinAmountsOut, []string{}, "Minimum amount of each denom to exit from the pool left (specify multiple denoms with: --min-amounts-out=1uosmo --min-amounts-out=1uion)")
+	return fs
+}
+
+func FlagSetExitPoolToDenoms() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+	fs.StringArray(FlagMinAmountsOut, []string{}, "Minimum amount of each denom to exit from the pool (specify multiple denoms with: --min-amounts-out=1uusdc --min-amounts-out=1uusdt)")
+	return fs
+}
+
 func FlagSetJustPoolId() *flag.FlagSet {
 	fs := flag.NewFlagSet("", flag.ContinueOnError)
 	fs.Uint64(FlagPoolId, 0, "The id of pool")
@@ -112,5 +146,21 @@ func FlagSetJustPoolId() *flag.FlagSet {
 func FlagSetAdjustScalingFactors() *flag.FlagSet {
 	fs := FlagSetJustPoolId()
 	fs.String(FlagScalingFactors, "", "The scaling factors")
+	fs.Duration(FlagScalingFactorChangeDuration, 0, "The duration for the scaling factors to change over linearly, they are set immediately if zero")
+	return fs
+}
+
+func FlagSetScalingFactorRateProvider() *flag.FlagSet {
+	fs := FlagSetJustPoolId()
+	fs.String(FlagRateProviderContract, "", "The rate provider contract address, the rate provider is removed if empty")
+	fs.Duration(FlagScalingFactorChangeDuration, 0, "The duration for the scaling factors to change over linearly to the ones returned by the rate provider")
+	return fs
+}
+
+func FlagSetUpdateSmoothWeightChange() *flag.FlagSet {
+	fs := FlagSetJustPoolId()
+	fs.String(FlagStartTime, "", "The RFC3339 start time of the weight change, defaults to the block time")
+	fs.Duration(FlagDuration, 0, "The duration for the weights to change over linearly")
+	fs.String(FlagTargetPoolWeights, "", "The target weights of every pool asset, e.g. 1uatom,1uosmo")
 	return fs
 }
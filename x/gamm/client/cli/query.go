@@ -21,6 +21,7 @@ import (
 	"github.com/osmosis-labs/osmosis/v13/osmoutils/osmocli"
 	"github.com/osmosis-labs/osmosis/v13/x/gamm/pool-models/balancer"
 	"github.com/osmosis-labs/osmosis/v13/x/gamm/types"
+	"github.com/osmosis-labs/osmosis/v13/x/gamm/v2types"
 )
 
 // GetQueryCmd returns the cli query commands for this module.
@@ -31,6 +32,8 @@ func GetQueryCmd() *cobra.Command {
 	osmocli.AddQueryCmd(cmd, types.NewQueryClient, GetCmdPools)
 	osmocli.AddQueryCmd(cmd, types.NewQueryClient, GetCmdEstimateSwapExactAmountIn)
 	osmocli.AddQueryCmd(cmd, types.NewQueryClient, GetCmdEstimateSwapExactAmountOut)
+	osmocli.AddQueryCmd(cmd, v2types.NewQueryClient, GetCmdSmoothWeightChange)
+	osmocli.AddQueryCmd(cmd, v2types.NewQueryClient, GetCmdProtocolFees)
 	cmd.AddCommand(
 		GetCmdNumPools(),
 		GetCmdPoolParams(),
@@ -52,6 +55,22 @@ func GetCmdPool() (*osmocli.QueryDescriptor, *types.QueryPoolRequest) {
 {{.CommandPrefix}} pool 1`}, &types.QueryPoolRequest{}
 }
 
+func GetCmdSmoothWeightChange() (*osmocli.QueryDescriptor, *v2types.QuerySmoothWeightChangeRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "smooth-weight-change [poolID]",
+		Short: "Query the smooth weight change and current weights of a balancer pool",
+		Long: `{{.Short}}{{.ExampleHeader}}
+{{.CommandPrefix}} smooth-weight-change 1`}, &v2types.QuerySmoothWeightChangeRequest{}
+}
+
+func GetCmdProtocolFees() (*osmocli.QueryDescriptor, *v2types.QueryProtocolFeesRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "protocol-fees [poolID]",
+		Short: "Query the taker fees a pool has sent to its taker fee recipient in total",
+		Long: `{{.Short}}{{.ExampleHeader}}
+{{.CommandPrefix}} protocol-fees 1`}, &v2types.QueryProtocolFeesRequest{}
+}
+
 // TODO: Push this to the SDK.
 func writeOutputBoilerplate(ctx client.Context, out []byte) error {
 	writer := ctx.Output
@@ -29,9 +29,13 @@ func NewTxCmd() *cobra.Command {
 	osmocli.AddTxCmd(txCmd, NewJoinSwapShareAmountOut)
 	osmocli.AddTxCmd(txCmd, NewExitSwapExternAmountOut)
 	osmocli.AddTxCmd(txCmd, NewExitSwapShareAmountIn)
+	osmocli.AddTxCmd(txCmd, NewMigrateLiquidityCmd)
+	osmocli.AddTxCmd(txCmd, NewStableSwapExitPoolToDenomsCmd)
 	txCmd.AddCommand(
 		NewCreatePoolCmd().BuildCommandCustomFn(),
 		NewStableSwapAdjustScalingFactorsCmd(),
+		NewStableSwapSetScalingFactorRateProviderCmd(),
+		NewUpdateSmoothWeightChangeCmd(),
 	)
 	return txCmd
 }
@@ -51,9 +55,14 @@ Sample pool JSON file contents for balancer:
 	"initial-deposit": "100uatom,5osmo,20uakt",
 	"swap-fee": "0.01",
 	"exit-fee": "0.01",
-	"future-governor": "168h"
+	"future-governor": "168h",
+	"taker-fee-share": "0.2",
+	"taker-fee-recipient": "osmo1..."
 }
 
+"taker-fee-share" is the share of the swap fee sent to "taker-fee-recipient" instead of LPs,
+or to the community pool if "taker-fee-recipient" is empty. Both are optional, for stableswap pools as well.
+
 For stableswap (demonstrating need for a 1:1000 scaling factor, see doc)
 {
 	"initial-deposit": "1000000uusdc,1000miliusdc",
@@ -161,12 +170,45 @@ func NewExitSwapShareAmountIn() (*osmocli.TxCliDesc, *types.MsgExitSwapShareAmou
 	}, &types.MsgExitSwapShareAmountIn{}
 }
 
+func NewMigrateLiquidityCmd() (*osmocli.TxCliDesc, *types.MsgMigrateLiquidity) {
+	return &osmocli.TxCliDesc{
+		Use:   "migrate-liquidity [pool-id-leaving] [pool-id-entering] [shares-to-migrate] [share-out-min-amount]",
+		Short: "exit shares from a pool and join another pool with the same assets",
+		Long: `Exit shares from a pool and join another pool with the same assets with the tokens exited.
+If --lock-id is set, the shares of the lock are migrated, and the lock and any superfluid delegation of it
+are kept on the shares of the pool joined. Only whole locks can be migrated.`,
+		Example: "osmosisd tx gamm migrate-liquidity 1 2 50000000000000000000 49000000000000000000 --lock-id=3 --min-amounts-out=990uusdc,990uusdt",
+		CustomFlagOverrides: map[string]string{
+			"LockId": FlagLockId,
+		},
+		CustomFieldParsers: map[string]osmocli.CustomFieldParserFn{
+			"TokenOutMins": osmocli.FlagOnlyParser(minAmountsOutParser),
+		},
+		Flags: osmocli.FlagDesc{OptionalFlags: []*flag.FlagSet{FlagSetMigrateLiquidity()}},
+	}, &types.MsgMigrateLiquidity{}
+}
+
+func NewStableSwapExitPoolToDenomsCmd() (*osmocli.TxCliDesc, *stableswap.MsgStableSwapExitPoolToDenoms) {
+	return &osmocli.TxCliDesc{
+		Use:   "exit-pool-to-denoms [pool-id] [share-in-amount] [token-out-denoms]",
+		Short: "exit a stableswap pool to a subset of its assets",
+		Long: `Exit shares from a stableswap pool to a comma separated subset of its assets.
+The exited tokens of the other pool assets are swapped into them, paying the pool's swap fee.`,
+		Example: "osmosisd tx gamm exit-pool-to-denoms 1 50000000000000000000 uusdc,uusdt --min-amounts-out=990uusdc",
+		CustomFieldParsers: map[string]osmocli.CustomFieldParserFn{
+			"TokenOutDenoms": tokenOutDenomsParser,
+			"TokenOutMins":   osmocli.FlagOnlyParser(minAmountsOutParser),
+		},
+		Flags: osmocli.FlagDesc{OptionalFlags: []*flag.FlagSet{FlagSetExitPoolToDenoms()}},
+	}, &stableswap.MsgStableSwapExitPoolToDenoms{}
+}
+
 // TODO: Change these flags to args. Required flags don't make that much sense.
 func NewStableSwapAdjustScalingFactorsCmd() *cobra.Command {
 	cmd := osmocli.TxCliDesc{
 		Use:              "adjust-scaling-factors --pool-id=[pool-id] --scaling-factors=[scaling-factors]",
 		Short:            "adjust scaling factors",
-		Example:          "osmosisd adjust-scaling-factors --pool-id=1 --scaling-factors=\"100, 100\"",
+		Example:          "osmosisd adjust-scaling-factors --pool-id=1 --scaling-factors=\"100, 100\" --scaling-factor-change-duration=24h",
 		NumArgs:          0,
 		ParseAndBuildMsg: NewStableSwapAdjustScalingFactorsMsg,
 	}.BuildCommandCustomFn()
@@ -177,6 +219,36 @@ func NewStableSwapAdjustScalingFactorsCmd() *cobra.Command {
 	return cmd
 }
 
+func NewStableSwapSetScalingFactorRateProviderCmd() *cobra.Command {
+	cmd := osmocli.TxCliDesc{
+		Use:              "set-scaling-factor-rate-provider --pool-id=[pool-id] --rate-provider-contract=[contract-address]",
+		Short:            "set the contract that updates the scaling factors every epoch",
+		Example:          "osmosisd set-scaling-factor-rate-provider --pool-id=1 --rate-provider-contract=osmo1... --scaling-factor-change-duration=24h",
+		NumArgs:          0,
+		ParseAndBuildMsg: NewStableSwapSetScalingFactorRateProviderMsg,
+	}.BuildCommandCustomFn()
+
+	cmd.Flags().AddFlagSet(FlagSetScalingFactorRateProvider())
+	_ = cmd.MarkFlagRequired(FlagPoolId)
+	return cmd
+}
+
+func NewUpdateSmoothWeightChangeCmd() *cobra.Command {
+	cmd := osmocli.TxCliDesc{
+		Use:              "update-smooth-weight-change --pool-id=[pool-id] --duration=[duration] --target-pool-weights=[weights]",
+		Short:            "start a new smooth weight change of a balancer pool's weights, as its pool controller",
+		Example:          "osmosisd update-smooth-weight-change --pool-id=1 --duration=72h --target-pool-weights=1uatom,1uosmo --start-time=2023-01-01T00:00:00Z",
+		NumArgs:          0,
+		ParseAndBuildMsg: NewUpdateSmoothWeightChangeMsg,
+	}.BuildCommandCustomFn()
+
+	cmd.Flags().AddFlagSet(FlagSetUpdateSmoothWeightChange())
+	_ = cmd.MarkFlagRequired(FlagPoolId)
+	_ = cmd.MarkFlagRequired(FlagDuration)
+	_ = cmd.MarkFlagRequired(FlagTargetPoolWeights)
+	return cmd
+}
+
 func BuildCreatePoolCmd(clientCtx client.Context, args []string, fs *flag.FlagSet) (sdk.Msg, error) {
 	poolType, err := fs.GetString(FlagPoolType)
 	if err != nil {
@@ -241,9 +313,17 @@ func NewBuildCreateBalancerPoolMsg(clientCtx client.Context, fs *flag.FlagSet) (
 		})
 	}
 
+	takerFeeShare, err := parseTakerFeeShare(pool.TakerFeeShare)
+	if err != nil {
+		return nil, err
+	}
+
 	poolParams := &balancer.PoolParams{
-		SwapFee: swapFee,
-		ExitFee: exitFee,
+		SwapFee:           swapFee,
+		ExitFee:           exitFee,
+		PoolController:    pool.PoolController,
+		TakerFeeShare:     takerFeeShare,
+		TakerFeeRecipient: pool.TakerFeeRecipient,
 	}
 
 	msg := &balancer.MsgCreateBalancerPool{
@@ -324,9 +404,16 @@ func NewBuildCreateStableswapPoolMsg(clientCtx client.Context, fs *flag.FlagSet)
 		return nil, err
 	}
 
+	takerFeeShare, err := parseTakerFeeShare(flags.TakerFeeShare)
+	if err != nil {
+		return nil, err
+	}
+
 	poolParams := &stableswap.PoolParams{
-		SwapFee: swapFee,
-		ExitFee: exitFee,
+		SwapFee:           swapFee,
+		ExitFee:           exitFee,
+		TakerFeeShare:     takerFeeShare,
+		TakerFeeRecipient: flags.TakerFeeRecipient,
 	}
 
 	scalingFactors := []uint64{}
@@ -355,10 +442,26 @@ func NewBuildCreateStableswapPoolMsg(clientCtx client.Context, fs *flag.FlagSet)
 	}, nil
 }
 
+// parseTakerFeeShare parses the optional taker fee share of a pool file, which defaults to zero.
+func parseTakerFeeShare(takerFeeShare string) (sdk.Dec, error) {
+	if takerFeeShare == "" {
+		return sdk.ZeroDec(), nil
+	}
+	return sdk.NewDecFromStr(takerFeeShare)
+}
+
 func maxAmountsInParser(fs *flag.FlagSet) (sdk.Coins, error) {
 	return stringArrayCoinsParser(FlagMaxAmountsIn, fs)
 }
 
+func tokenOutDenomsParser(arg string, _ *flag.FlagSet) (any, osmocli.FieldReadLocation, error) {
+	denoms := strings.Split(arg, ",")
+	for i, denom := range denoms {
+		denoms[i] = strings.TrimSpace(denom)
+	}
+	return denoms, osmocli.UsedArg, nil
+}
+
 func minAmountsOutParser(fs *flag.FlagSet) (sdk.Coins, error) {
 	return stringArrayCoinsParser(FlagMinAmountsOut, fs)
 }
@@ -488,10 +591,102 @@ func NewStableSwapAdjustScalingFactorsMsg(clientCtx client.Context, _args []stri
 		scalingFactors[i] = scalingFactor
 	}
 
+	changeDuration, err := fs.GetDuration(FlagScalingFactorChangeDuration)
+	if err != nil {
+		return nil, err
+	}
+
 	msg := &stableswap.MsgStableSwapAdjustScalingFactors{
-		Sender:         clientCtx.GetFromAddress().String(),
-		PoolID:         poolID,
-		ScalingFactors: scalingFactors,
+		Sender:                      clientCtx.GetFromAddress().String(),
+		PoolID:                      poolID,
+		ScalingFactors:              scalingFactors,
+		ScalingFactorChangeDuration: changeDuration,
+	}
+
+	return msg, nil
+}
+
+func NewStableSwapSetScalingFactorRateProviderMsg(clientCtx client.Context, _args []string, fs *flag.FlagSet) (sdk.Msg, error) {
+	poolID, err := fs.GetUint64(FlagPoolId)
+	if err != nil {
+		return nil, err
+	}
+
+	contractAddress, err := fs.GetString(FlagRateProviderContract)
+	if err != nil {
+		return nil, err
+	}
+
+	changeDuration, err := fs.GetDuration(FlagScalingFactorChangeDuration)
+	if err != nil {
+		return nil, err
+	}
+
+	var rateProvider *stableswap.ScalingFactorRateProvider
+	if contractAddress != "" {
+		rateProvider = &stableswap.ScalingFactorRateProvider{
+			ContractAddress: contractAddress,
+			ChangeDuration:  changeDuration,
+		}
+	}
+
+	msg := &stableswap.MsgStableSwapSetScalingFactorRateProvider{
+		Sender:       clientCtx.GetFromAddress().String(),
+		PoolID:       poolID,
+		RateProvider: rateProvider,
+	}
+
+	return msg, nil
+}
+
+func NewUpdateSmoothWeightChangeMsg(clientCtx client.Context, _args []string, fs *flag.FlagSet) (sdk.Msg, error) {
+	poolID, err := fs.GetUint64(FlagPoolId)
+	if err != nil {
+		return nil, err
+	}
+
+	duration, err := fs.GetDuration(FlagDuration)
+	if err != nil {
+		return nil, err
+	}
+
+	targetPoolWeightsStr, err := fs.GetString(FlagTargetPoolWeights)
+	if err != nil {
+		return nil, err
+	}
+	targetPoolWeightCoins, err := sdk.ParseDecCoins(targetPoolWeightsStr)
+	if err != nil {
+		return nil, err
+	}
+	targetPoolWeights := make([]balancer.PoolAsset, len(targetPoolWeightCoins))
+	for i, weight := range targetPoolWeightCoins {
+		targetPoolWeights[i] = balancer.PoolAsset{
+			Weight: weight.Amount.RoundInt(),
+			Token:  sdk.NewCoin(weight.Denom, sdk.ZeroInt()),
+		}
+	}
+
+	smoothWeightChangeParams := balancer.SmoothWeightChangeParams{
+		Duration:          duration,
+		TargetPoolWeights: targetPoolWeights,
+	}
+
+	startTimeStr, err := fs.GetString(FlagStartTime)
+	if err != nil {
+		return nil, err
+	}
+	if startTimeStr != "" {
+		startTime, err := time.Parse(time.RFC3339, startTimeStr)
+		if err != nil {
+			return nil, fmt.Errorf("could not parse time: %w", err)
+		}
+		smoothWeightChangeParams.StartTime = startTime
+	}
+
+	msg := &balancer.MsgUpdateSmoothWeightChange{
+		Sender:                   clientCtx.GetFromAddress().String(),
+		PoolID:                   poolID,
+		SmoothWeightChangeParams: smoothWeightChangeParams,
 	}
 
 	return msg, nil
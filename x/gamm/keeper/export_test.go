@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/osmosis-labs/osmosis/v13/x/gamm/types"
@@ -17,13 +19,8 @@ func (k Keeper) SetPool(ctx sdk.Context, pool swaproutertypes.PoolI) error {
 	return k.setPool(ctx, pool)
 }
 
-func (k Keeper) SetStableSwapScalingFactors(ctx sdk.Context, poolId uint64, scalingFactors []uint64, sender string) error {
-	return k.setStableSwapScalingFactors(ctx, poolId, scalingFactors, sender)
-}
-
-func (k Keeper) GetOsmoRoutedMultihopTotalSwapFee(ctx sdk.Context, route types.MultihopRoute) (
-	totalPathSwapFee sdk.Dec, sumOfSwapFees sdk.Dec, err error) {
-	return k.getOsmoRoutedMultihopTotalSwapFee(ctx, route)
+func (k Keeper) SetStableSwapScalingFactors(ctx sdk.Context, poolId uint64, scalingFactors []uint64, changeDuration time.Duration, sender string) error {
+	return k.setStableSwapScalingFactors(ctx, poolId, scalingFactors, changeDuration, sender)
 }
 
 func ConvertToCFMMPool(pool swaproutertypes.PoolI) (types.CFMMPoolI, error) {
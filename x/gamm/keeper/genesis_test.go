@@ -43,7 +43,9 @@ func TestGammInitGenesis(t *testing.T) {
 		Pools:          []*codectypes.Any{any},
 		NextPoolNumber: 2,
 		Params: types.Params{
-			PoolCreationFee: sdk.Coins{sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000_000_000)},
+			PoolCreationFee:                          sdk.Coins{sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000_000_000)},
+			ScalingFactorRateProviderEpochIdentifier: "day",
+			MaxTakerFeeShare:                         sdk.NewDecWithPrec(5, 1),
 		},
 	}, app.AppCodec())
 
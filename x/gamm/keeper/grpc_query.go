@@ -114,7 +114,7 @@ func (q Querier) NumPools(ctx context.Context, _ *types.QueryNumPoolsRequest) (*
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 
 	return &types.QueryNumPoolsResponse{
-		NumPools: q.poolCreationManager.GetNextPoolId(sdkCtx) - 1,
+		NumPools: q.poolManager.GetNextPoolId(sdkCtx) - 1,
 	}, nil
 }
 
@@ -396,6 +396,42 @@ func (q QuerierV2) SpotPrice(ctx context.Context, req *v2types.QuerySpotPriceReq
 	}, nil
 }
 
+// SmoothWeightChange returns the smooth weight change of a balancer pool, if any, and its current weights.
+func (q QuerierV2) SmoothWeightChange(ctx context.Context, req *v2types.QuerySmoothWeightChangeRequest) (*v2types.QuerySmoothWeightChangeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	balancerPool, err := q.Keeper.getBalancerPool(sdkCtx, req.PoolId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &v2types.QuerySmoothWeightChangeResponse{
+		SmoothWeightChangeParams: balancerPool.GetPoolParams().SmoothWeightChangeParams,
+		CurrentPoolWeights:       balancerPool.GetAllPoolAssets(),
+	}, nil
+}
+
+// ProtocolFees returns the taker fees a pool has sent to its taker fee recipient in total.
+func (q QuerierV2) ProtocolFees(ctx context.Context, req *v2types.QueryProtocolFeesRequest) (*v2types.QueryProtocolFeesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	if _, err := q.Keeper.GetPoolAndPoke(sdkCtx, req.PoolId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &v2types.QueryProtocolFeesResponse{
+		ProtocolFees: q.Keeper.GetProtocolFees(sdkCtx, req.PoolId),
+	}, nil
+}
+
 // TotalLiquidity returns total liquidity across all pools.
 func (q Querier) TotalLiquidity(ctx context.Context, _ *types.QueryTotalLiquidityRequest) (*types.QueryTotalLiquidityResponse, error) {
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
@@ -422,7 +458,7 @@ func (q Querier) EstimateSwapExactAmountIn(ctx context.Context, req *types.Query
 
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 
-	tokenOutAmount, err := q.Keeper.MultihopEstimateOutGivenExactAmountIn(sdkCtx, req.Routes, tokenIn)
+	tokenOutAmount, err := q.poolManager.MultihopEstimateOutGivenExactAmountIn(sdkCtx, types.SwapAmountInRoutes(req.Routes).ToSwaprouterRoutes(), tokenIn)
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
@@ -449,7 +485,7 @@ func (q Querier) EstimateSwapExactAmountOut(ctx context.Context, req *types.Quer
 
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 
-	tokenInAmount, err := q.Keeper.MultihopEstimateInGivenExactAmountOut(sdkCtx, req.Routes, tokenOut)
+	tokenInAmount, err := q.poolManager.MultihopEstimateInGivenExactAmountOut(sdkCtx, types.SwapAmountOutRoutes(req.Routes).ToSwaprouterRoutes(), tokenOut)
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
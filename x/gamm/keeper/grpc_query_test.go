@@ -3,6 +3,7 @@ package keeper_test
 import (
 	gocontext "context"
 	"errors"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -807,6 +808,80 @@ func (suite *KeeperTestSuite) TestV2QueryBalancerPoolSpotPrice() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestV2QuerySmoothWeightChange() {
+	v2queryClient := v2types.NewQueryClient(suite.QueryHelper)
+	poolID := suite.PrepareBalancerPool()
+	stableswapPoolID := suite.PrepareBasicStableswapPool()
+
+	// pool without a smooth weight change
+	res, err := v2queryClient.SmoothWeightChange(gocontext.Background(), &v2types.QuerySmoothWeightChangeRequest{PoolId: poolID})
+	suite.Require().NoError(err)
+	suite.Require().Nil(res.SmoothWeightChangeParams)
+	pool, err := suite.App.GAMMKeeper.GetPoolAndPoke(suite.Ctx, poolID)
+	suite.Require().NoError(err)
+	suite.Require().Equal(pool.(*balancer.Pool).PoolAssets, res.CurrentPoolWeights)
+
+	// pool with a smooth weight change
+	var targetWeights []balancer.PoolAsset
+	for _, asset := range res.CurrentPoolWeights {
+		targetWeights = append(targetWeights, balancer.PoolAsset{
+			Weight: sdk.NewInt(1),
+			Token:  sdk.NewCoin(asset.Token.Denom, sdk.ZeroInt()),
+		})
+	}
+	proposal := balancer.NewUpdateSmoothWeightChangeProposal("title", "description", poolID, balancer.SmoothWeightChangeParams{
+		Duration:          time.Hour,
+		TargetPoolWeights: targetWeights,
+	})
+	err = suite.App.GAMMKeeper.HandleUpdateSmoothWeightChangeProposal(suite.Ctx, &proposal)
+	suite.Require().NoError(err)
+
+	res, err = v2queryClient.SmoothWeightChange(gocontext.Background(), &v2types.QuerySmoothWeightChangeRequest{PoolId: poolID})
+	suite.Require().NoError(err)
+	suite.Require().NotNil(res.SmoothWeightChangeParams)
+	suite.Require().Equal(time.Hour, res.SmoothWeightChangeParams.Duration)
+	suite.Require().Equal(len(targetWeights), len(res.SmoothWeightChangeParams.TargetPoolWeights))
+
+	// errors for pools that are not balancer pools or do not exist
+	_, err = v2queryClient.SmoothWeightChange(gocontext.Background(), &v2types.QuerySmoothWeightChangeRequest{PoolId: stableswapPoolID})
+	suite.Require().Error(err)
+	_, err = v2queryClient.SmoothWeightChange(gocontext.Background(), &v2types.QuerySmoothWeightChangeRequest{PoolId: stableswapPoolID + 1})
+	suite.Require().Error(err)
+}
+
+func (suite *KeeperTestSuite) TestV2QueryProtocolFees() {
+	v2queryClient := v2types.NewQueryClient(suite.QueryHelper)
+	poolID := suite.PrepareBalancerPoolWithPoolParams(balancer.PoolParams{
+		SwapFee:           sdk.NewDecWithPrec(1, 2),
+		ExitFee:           sdk.ZeroDec(),
+		TakerFeeShare:     sdk.NewDecWithPrec(5, 1),
+		TakerFeeRecipient: suite.TestAccs[1].String(),
+	})
+
+	// no swaps yet
+	res, err := v2queryClient.ProtocolFees(gocontext.Background(), &v2types.QueryProtocolFeesRequest{PoolId: poolID})
+	suite.Require().NoError(err)
+	suite.Require().True(res.ProtocolFees.Empty())
+
+	// taker fees accumulate across swaps and denoms
+	pool, err := suite.App.GAMMKeeper.GetPool(suite.Ctx, poolID)
+	suite.Require().NoError(err)
+	_, err = suite.App.GAMMKeeper.SwapExactAmountIn(suite.Ctx, suite.TestAccs[0], pool, sdk.NewInt64Coin("foo", 100000), "bar", sdk.OneInt(), pool.GetSwapFee(suite.Ctx))
+	suite.Require().NoError(err)
+	_, err = suite.App.GAMMKeeper.SwapExactAmountIn(suite.Ctx, suite.TestAccs[0], pool, sdk.NewInt64Coin("foo", 300000), "bar", sdk.OneInt(), pool.GetSwapFee(suite.Ctx))
+	suite.Require().NoError(err)
+	_, err = suite.App.GAMMKeeper.SwapExactAmountIn(suite.Ctx, suite.TestAccs[0], pool, sdk.NewInt64Coin("bar", 200000), "foo", sdk.OneInt(), pool.GetSwapFee(suite.Ctx))
+	suite.Require().NoError(err)
+
+	res, err = v2queryClient.ProtocolFees(gocontext.Background(), &v2types.QueryProtocolFeesRequest{PoolId: poolID})
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("bar", 1000), sdk.NewInt64Coin("foo", 2000)), res.ProtocolFees)
+
+	// errors for pools that do not exist
+	_, err = v2queryClient.ProtocolFees(gocontext.Background(), &v2types.QueryProtocolFeesRequest{PoolId: poolID + 1})
+	suite.Require().Error(err)
+}
+
 func (suite *KeeperTestSuite) TestQueryStableswapPoolSpotPrice() {
 	queryClient := suite.queryClient
 	poolIDEven := suite.PrepareBasicStableswapPool()
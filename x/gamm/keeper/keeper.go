@@ -29,11 +29,13 @@ type Keeper struct {
 	hooks      types.GammHooks
 
 	// keepers
-	accountKeeper        types.AccountKeeper
-	bankKeeper           types.BankKeeper
-	communityPoolKeeper  types.CommunityPoolKeeper
-	poolIncentivesKeeper types.PoolIncentivesKeeper
-	poolCreationManager  types.PoolCreationManager
+	accountKeeper       types.AccountKeeper
+	bankKeeper          types.BankKeeper
+	communityPoolKeeper types.CommunityPoolKeeper
+	poolManager         types.PoolManager
+	contractKeeper      types.ContractKeeper
+
+	lockedLiquidityMigrator types.LockedLiquidityMigrator
 }
 
 func NewKeeper(cdc codec.BinaryCodec, storeKey sdk.StoreKey, paramSpace paramtypes.Subspace, accountKeeper types.AccountKeeper, bankKeeper types.BankKeeper, communityPoolKeeper types.CommunityPoolKeeper) Keeper {
@@ -73,12 +75,19 @@ func (k *Keeper) SetHooks(gh types.GammHooks) *Keeper {
 	return k
 }
 
-func (k *Keeper) SetPoolIncentivesKeeper(poolIncentivesKeeper types.PoolIncentivesKeeper) {
-	k.poolIncentivesKeeper = poolIncentivesKeeper
+// SetPoolManager sets the swaprouter keeper that pools are created and swapped through.
+func (k *Keeper) SetPoolManager(poolManager types.PoolManager) {
+	k.poolManager = poolManager
+}
+
+// SetContractKeeper sets the CosmWasm keeper that stableswap scaling factor rate providers are queried through.
+func (k *Keeper) SetContractKeeper(contractKeeper types.ContractKeeper) {
+	k.contractKeeper = contractKeeper
 }
 
-func (k *Keeper) SetPoolCreationManager(poolCreationManager types.PoolCreationManager) {
-	k.poolCreationManager = poolCreationManager
+// SetLockedLiquidityMigrator sets the superfluid keeper that pool shares held in locks are migrated through.
+func (k *Keeper) SetLockedLiquidityMigrator(lockedLiquidityMigrator types.LockedLiquidityMigrator) {
+	k.lockedLiquidityMigrator = lockedLiquidityMigrator
 }
 
 // GetParams returns the total set params.
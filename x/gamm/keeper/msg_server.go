@@ -57,20 +57,80 @@ func (server msgServer) CreateStableswapPool(goCtx context.Context, msg *stables
 func (server msgServer) StableSwapAdjustScalingFactors(goCtx context.Context, msg *stableswap.MsgStableSwapAdjustScalingFactors) (*stableswap.MsgStableSwapAdjustScalingFactorsResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	if err := server.keeper.setStableSwapScalingFactors(ctx, msg.PoolID, msg.ScalingFactors, msg.Sender); err != nil {
+	if err := server.keeper.setStableSwapScalingFactors(ctx, msg.PoolID, msg.ScalingFactors, msg.ScalingFactorChangeDuration, msg.Sender); err != nil {
 		return nil, err
 	}
 
+	emitScalingFactorsAdjustedEvent(ctx, msg.PoolID, msg.ScalingFactors, msg.ScalingFactorChangeDuration.String(), msg.Sender)
+
 	return &stableswap.MsgStableSwapAdjustScalingFactorsResponse{}, nil
 }
 
+func (server msgServer) StableSwapSetScalingFactorRateProvider(goCtx context.Context, msg *stableswap.MsgStableSwapSetScalingFactorRateProvider) (*stableswap.MsgStableSwapSetScalingFactorRateProviderResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := server.keeper.setStableSwapScalingFactorRateProvider(ctx, msg.PoolID, msg.RateProvider, msg.Sender); err != nil {
+		return nil, err
+	}
+
+	contractAddress := ""
+	if msg.RateProvider != nil {
+		contractAddress = msg.RateProvider.ContractAddress
+	}
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.TypeEvtScalingFactorRateProviderSet,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		sdk.NewAttribute(types.AttributeKeyPoolId, strconv.FormatUint(msg.PoolID, 10)),
+		sdk.NewAttribute(types.AttributeKeyContract, contractAddress),
+	))
+
+	return &stableswap.MsgStableSwapSetScalingFactorRateProviderResponse{}, nil
+}
+
+func (server msgServer) StableSwapExitPoolToDenoms(goCtx context.Context, msg *stableswap.MsgStableSwapExitPoolToDenoms) (*stableswap.MsgStableSwapExitPoolToDenomsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	tokensOut, err := server.keeper.ExitPoolToDenoms(ctx, sender, msg.PoolID, msg.ShareInAmount, msg.TokenOutDenoms, msg.TokenOutMins)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+	})
+
+	return &stableswap.MsgStableSwapExitPoolToDenomsResponse{
+		TokenOut: tokensOut,
+	}, nil
+}
+
+func (server msgServer) UpdateSmoothWeightChange(goCtx context.Context, msg *balancer.MsgUpdateSmoothWeightChange) (*balancer.MsgUpdateSmoothWeightChangeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := server.keeper.setSmoothWeightChange(ctx, msg.PoolID, msg.SmoothWeightChangeParams, msg.Sender); err != nil {
+		return nil, err
+	}
+
+	return &balancer.MsgUpdateSmoothWeightChangeResponse{}, nil
+}
+
 // CreatePool attempts to create a pool returning the newly created pool ID or an error upon failure.
 // The pool creation fee is used to fund the community pool.
 // It will create a dedicated module account for the pool and sends the initial liquidity to the created module account.
 func (server msgServer) CreatePool(goCtx context.Context, msg swaproutertypes.CreatePoolMsg) (poolId uint64, err error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	poolId, err = server.keeper.poolCreationManager.CreatePool(ctx, msg)
+	poolId, err = server.keeper.poolManager.CreatePool(ctx, msg)
 	if err != nil {
 		return 0, err
 	}
@@ -163,7 +223,7 @@ func (server msgServer) SwapExactAmountIn(goCtx context.Context, msg *types.MsgS
 		return nil, err
 	}
 
-	tokenOutAmount, err := server.keeper.MultihopSwapExactAmountIn(ctx, sender, msg.Routes, msg.TokenIn, msg.TokenOutMinAmount)
+	tokenOutAmount, err := server.keeper.poolManager.RouteExactAmountIn(ctx, sender, types.SwapAmountInRoutes(msg.Routes).ToSwaprouterRoutes(), msg.TokenIn, msg.TokenOutMinAmount)
 	if err != nil {
 		return nil, err
 	}
@@ -188,7 +248,7 @@ func (server msgServer) SwapExactAmountOut(goCtx context.Context, msg *types.Msg
 		return nil, err
 	}
 
-	tokenInAmount, err := server.keeper.MultihopSwapExactAmountOut(ctx, sender, msg.Routes, msg.TokenInMaxAmount, msg.TokenOut)
+	tokenInAmount, err := server.keeper.poolManager.RouteExactAmountOut(ctx, sender, types.SwapAmountOutRoutes(msg.Routes).ToSwaprouterRoutes(), msg.TokenInMaxAmount, msg.TokenOut)
 	if err != nil {
 		return nil, err
 	}
@@ -309,3 +369,34 @@ func (server msgServer) ExitSwapShareAmountIn(goCtx context.Context, msg *types.
 
 	return &types.MsgExitSwapShareAmountInResponse{TokenOutAmount: tokenOutAmount}, nil
 }
+
+func (server msgServer) MigrateLiquidity(goCtx context.Context, msg *types.MsgMigrateLiquidity) (*types.MsgMigrateLiquidityResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	var sharesOut sdk.Int
+	if msg.LockId == 0 {
+		sharesOut, err = server.keeper.MigrateLiquidity(ctx, sender, msg.PoolIdLeaving, msg.PoolIdEntering, msg.SharesToMigrate, msg.TokenOutMins, msg.ShareOutMinAmount)
+	} else {
+		sharesOut, err = server.keeper.migrateLockedLiquidity(ctx, sender, msg.LockId, msg.PoolIdLeaving, msg.PoolIdEntering, msg.SharesToMigrate, msg.TokenOutMins, msg.ShareOutMinAmount)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+	})
+
+	return &types.MsgMigrateLiquidityResponse{
+		ShareOutAmount: sharesOut,
+	}, nil
+}
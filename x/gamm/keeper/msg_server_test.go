@@ -1,9 +1,14 @@
 package keeper_test
 
 import (
+	"fmt"
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/osmosis-labs/osmosis/v13/x/gamm/keeper"
+	"github.com/osmosis-labs/osmosis/v13/x/gamm/pool-models/balancer"
+	"github.com/osmosis-labs/osmosis/v13/x/gamm/pool-models/stableswap"
 	"github.com/osmosis-labs/osmosis/v13/x/gamm/types"
 )
 
@@ -355,3 +360,114 @@ func (suite *KeeperTestSuite) TestExitPool_Events() {
 		})
 	}
 }
+
+// TestStableSwapExitPoolToDenoms_Events tests that events are correctly emitted
+// when calling StableSwapExitPoolToDenoms.
+func (suite *KeeperTestSuite) TestStableSwapExitPoolToDenoms_Events() {
+	suite.Setup()
+	ctx := suite.Ctx.WithEventManager(sdk.NewEventManager())
+	poolId := suite.PrepareBasicStableswapPool()
+	msgServer := keeper.NewStableswapMsgServerImpl(suite.App.GAMMKeeper)
+	sender := suite.TestAccs[0]
+	fooBalanceBefore := suite.App.BankKeeper.GetBalance(ctx, sender, "foo")
+
+	msg := stableswap.NewMsgStableSwapExitPoolToDenoms(sender.String(), poolId, types.OneShare.MulRaw(10), []string{"foo"}, sdk.Coins{})
+	response, err := msgServer.StableSwapExitPoolToDenoms(sdk.WrapSDKContext(ctx), &msg)
+	suite.Require().NoError(err)
+	suite.Require().Len(response.TokenOut, 1)
+	suite.Require().Equal(fooBalanceBefore.Add(response.TokenOut[0]), suite.App.BankKeeper.GetBalance(ctx, sender, "foo"))
+
+	suite.AssertEventEmitted(ctx, types.TypeEvtPoolExited, 1)
+	suite.AssertEventEmitted(ctx, types.TypeEvtTokenSwapped, 0)
+}
+
+// TestUpdateSmoothWeightChange tests that only the pool controller can start a
+// new smooth weight change of a balancer pool, and that events are emitted.
+func (suite *KeeperTestSuite) TestUpdateSmoothWeightChange() {
+	smoothWeightChangeParams := balancer.SmoothWeightChangeParams{
+		Duration: time.Hour,
+		TargetPoolWeights: []balancer.PoolAsset{
+			{Weight: sdk.NewInt(1), Token: sdk.NewCoin("foo", sdk.ZeroInt())},
+			{Weight: sdk.NewInt(2), Token: sdk.NewCoin("bar", sdk.ZeroInt())},
+		},
+	}
+
+	testcases := map[string]struct {
+		hasPoolController bool
+		senderIsOther     bool
+		isStableswapPool  bool
+		params            balancer.SmoothWeightChangeParams
+		expectedErr       error
+	}{
+		"pool controller starts a weight change": {
+			hasPoolController: true,
+			params:            smoothWeightChangeParams,
+		},
+		"sender is not the pool controller": {
+			hasPoolController: true,
+			senderIsOther:     true,
+			params:            smoothWeightChangeParams,
+			expectedErr:       types.ErrNotPoolController,
+		},
+		"pool has no pool controller": {
+			params:      smoothWeightChangeParams,
+			expectedErr: types.ErrNotPoolController,
+		},
+		"target weights do not match the pool assets": {
+			hasPoolController: true,
+			params: balancer.SmoothWeightChangeParams{
+				Duration:          time.Hour,
+				TargetPoolWeights: smoothWeightChangeParams.TargetPoolWeights[:1],
+			},
+			expectedErr: types.ErrPoolParamsInvalidNumDenoms,
+		},
+		"not a balancer pool": {
+			isStableswapPool: true,
+			params:           smoothWeightChangeParams,
+			expectedErr:      fmt.Errorf("pool id 1 is not of type balancer pool"),
+		},
+	}
+
+	for name, tc := range testcases {
+		suite.Run(name, func() {
+			suite.SetupTest()
+			controller := suite.TestAccs[1]
+			sender := controller
+			if tc.senderIsOther {
+				sender = suite.TestAccs[2]
+			}
+
+			poolParams := balancer.PoolParams{SwapFee: defaultSwapFee, ExitFee: defaultExitFee}
+			if tc.hasPoolController {
+				poolParams.PoolController = controller.String()
+			}
+			var poolId uint64
+			if tc.isStableswapPool {
+				poolId = suite.PrepareBasicStableswapPool()
+			} else {
+				poolId = suite.PrepareCustomBalancerPoolFromCoins(sdk.NewCoins(
+					sdk.NewInt64Coin("foo", 1000), sdk.NewInt64Coin("bar", 1000)), poolParams)
+			}
+
+			msgServer := keeper.NewBalancerMsgServerImpl(suite.App.GAMMKeeper)
+			ctx := suite.Ctx.WithEventManager(sdk.NewEventManager())
+
+			msg := balancer.NewMsgUpdateSmoothWeightChange(sender, poolId, tc.params)
+			_, err := msgServer.UpdateSmoothWeightChange(sdk.WrapSDKContext(ctx), &msg)
+			if tc.expectedErr != nil {
+				suite.Require().ErrorContains(err, tc.expectedErr.Error())
+				suite.AssertEventEmitted(ctx, types.TypeEvtSmoothWeightChangeUpdated, 0)
+				return
+			}
+			suite.Require().NoError(err)
+			suite.AssertEventEmitted(ctx, types.TypeEvtSmoothWeightChangeUpdated, 1)
+
+			pool, err := suite.App.GAMMKeeper.GetPoolAndPoke(ctx, poolId)
+			suite.Require().NoError(err)
+			params := pool.(*balancer.Pool).GetPoolParams().SmoothWeightChangeParams
+			suite.Require().NotNil(params)
+			suite.Require().Equal(ctx.BlockTime().Unix(), params.StartTime.Unix())
+			suite.Require().Equal(time.Hour, params.Duration)
+		})
+	}
+}
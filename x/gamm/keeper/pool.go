@@ -2,11 +2,16 @@ package keeper
 
 import (
 	"fmt"
+	"strconv"
+	"strings"
+	"time"
 
 	gogotypes "github.com/gogo/protobuf/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 
 	"github.com/osmosis-labs/osmosis/v13/osmoutils"
 	"github.com/osmosis-labs/osmosis/v13/x/gamm/pool-models/balancer"
@@ -25,7 +30,8 @@ func (k Keeper) UnmarshalPool(bz []byte) (types.CFMMPoolI, error) {
 }
 
 // GetPoolAndPoke returns a PoolI based on it's identifier if one exists. If poolId corresponds
-// to a pool with weights (e.g. balancer), the weights of the pool are updated via PokePool prior to returning.
+// to a pool whose parameters change over time (e.g. balancer weights or stableswap scaling factors),
+// the pool is updated via PokePool prior to returning.
 // TODO: Consider rename to GetPool due to downstream API confusion.
 func (k Keeper) GetPoolAndPoke(ctx sdk.Context, poolId uint64) (types.CFMMPoolI, error) {
 	store := ctx.KVStore(k.storeKey)
@@ -41,7 +47,7 @@ func (k Keeper) GetPoolAndPoke(ctx sdk.Context, poolId uint64) (types.CFMMPoolI,
 		return nil, err
 	}
 
-	if pokePool, ok := pool.(types.WeightedPoolExtension); ok {
+	if pokePool, ok := pool.(types.PokePoolExtension); ok {
 		pokePool.PokePool(ctx.BlockTime())
 	}
 
@@ -49,6 +55,12 @@ func (k Keeper) GetPoolAndPoke(ctx sdk.Context, poolId uint64) (types.CFMMPoolI,
 }
 
 // Get pool and check if the pool is active, i.e. allowed to be swapped against.
+// GetPool returns the pool with the given id as a swaprouter pool, without
+// checking whether it is active.
+func (k Keeper) GetPool(ctx sdk.Context, poolId uint64) (swaproutertypes.PoolI, error) {
+	return k.GetPoolAndPoke(ctx, poolId)
+}
+
 func (k Keeper) getPoolForSwap(ctx sdk.Context, poolId uint64) (types.CFMMPoolI, error) {
 	pool, err := k.GetPoolAndPoke(ctx, poolId)
 	if err != nil {
@@ -78,7 +90,7 @@ func (k Keeper) GetPoolsAndPoke(ctx sdk.Context) (res []types.CFMMPoolI, err err
 			return nil, err
 		}
 
-		if pokePool, ok := pool.(types.WeightedPoolExtension); ok {
+		if pokePool, ok := pool.(types.PokePoolExtension); ok {
 			pokePool.PokePool(ctx.BlockTime())
 		}
 		res = append(res, pool)
@@ -87,6 +99,20 @@ func (k Keeper) GetPoolsAndPoke(ctx sdk.Context) (res []types.CFMMPoolI, err err
 	return res, nil
 }
 
+// GetPools returns all gamm pools as swaprouter pools.
+func (k Keeper) GetPools(ctx sdk.Context) ([]swaproutertypes.PoolI, error) {
+	cfmmPools, err := k.GetPoolsAndPoke(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	pools := make([]swaproutertypes.PoolI, 0, len(cfmmPools))
+	for _, pool := range cfmmPools {
+		pools = append(pools, pool)
+	}
+	return pools, nil
+}
+
 func (k Keeper) setPool(ctx sdk.Context, pool swaproutertypes.PoolI) error {
 	bz, err := k.MarshalPool(pool)
 	if err != nil {
@@ -260,23 +286,112 @@ func (k Keeper) GetPoolType(ctx sdk.Context, poolId uint64) (swaproutertypes.Poo
 	}
 }
 
-// setStableSwapScalingFactors sets the stable swap scaling factors.
+// setStableSwapScalingFactors sets the stable swap scaling factors, linearly over changeDuration if it is positive.
 // errors if the pool does not exist, the sender is not the scaling factor controller, or due to other
 // internal errors.
-func (k Keeper) setStableSwapScalingFactors(ctx sdk.Context, poolId uint64, scalingFactors []uint64, sender string) error {
-	pool, err := k.GetPoolAndPoke(ctx, poolId)
+func (k Keeper) setStableSwapScalingFactors(ctx sdk.Context, poolId uint64, scalingFactors []uint64, changeDuration time.Duration, sender string) error {
+	stableswapPool, err := k.getStableswapPool(ctx, poolId)
+	if err != nil {
+		return err
+	}
+	if err := stableswapPool.SetScalingFactors(ctx, scalingFactors, changeDuration, sender); err != nil {
+		return err
+	}
+
+	return k.setPool(ctx, stableswapPool)
+}
+
+// setStableSwapScalingFactorRateProvider sets the contract that updates the stable swap scaling factors,
+// or removes it if rateProvider is nil.
+// errors if the pool does not exist, the sender is not the scaling factor controller, or due to other
+// internal errors.
+func (k Keeper) setStableSwapScalingFactorRateProvider(ctx sdk.Context, poolId uint64, rateProvider *stableswap.ScalingFactorRateProvider, sender string) error {
+	stableswapPool, err := k.getStableswapPool(ctx, poolId)
 	if err != nil {
 		return err
 	}
+	if err := stableswapPool.SetScalingFactorRateProvider(rateProvider, sender); err != nil {
+		return err
+	}
+
+	return k.setPool(ctx, stableswapPool)
+}
+
+func (k Keeper) getStableswapPool(ctx sdk.Context, poolId uint64) (*stableswap.Pool, error) {
+	pool, err := k.GetPoolAndPoke(ctx, poolId)
+	if err != nil {
+		return nil, err
+	}
 	stableswapPool, ok := pool.(*stableswap.Pool)
 	if !ok {
-		return fmt.Errorf("pool id %d is not of type stableswap pool", poolId)
+		return nil, fmt.Errorf("pool id %d is not of type stableswap pool", poolId)
 	}
-	if err := stableswapPool.SetScalingFactors(ctx, scalingFactors, sender); err != nil {
+	return stableswapPool, nil
+}
+
+// setSmoothWeightChange starts a new smooth weight change of a balancer pool's weights, replacing any
+// ongoing one.
+// errors if the pool does not exist, is not a balancer pool, the sender is not the pool controller, or
+// the weight change is invalid for the pool.
+func (k Keeper) setSmoothWeightChange(ctx sdk.Context, poolId uint64, params balancer.SmoothWeightChangeParams, sender string) error {
+	balancerPool, err := k.getBalancerPool(ctx, poolId)
+	if err != nil {
 		return err
 	}
+	poolController := balancerPool.GetPoolParams().PoolController
+	if poolController == "" || poolController != sender {
+		return sdkerrors.Wrapf(types.ErrNotPoolController, "sender %s, pool controller %s", sender, poolController)
+	}
 
-	return k.setPool(ctx, stableswapPool)
+	return k.updateSmoothWeightChange(ctx, balancerPool, params, sender)
+}
+
+// HandleUpdateSmoothWeightChangeProposal starts the proposal's smooth weight change on its balancer pool,
+// whether or not the pool has a pool controller.
+func (k Keeper) HandleUpdateSmoothWeightChangeProposal(ctx sdk.Context, p *balancer.UpdateSmoothWeightChangeProposal) error {
+	balancerPool, err := k.getBalancerPool(ctx, p.PoolId)
+	if err != nil {
+		return err
+	}
+
+	return k.updateSmoothWeightChange(ctx, balancerPool, p.SmoothWeightChangeParams, authtypes.NewModuleAddress(govtypes.ModuleName).String())
+}
+
+func (k Keeper) updateSmoothWeightChange(ctx sdk.Context, balancerPool *balancer.Pool, params balancer.SmoothWeightChangeParams, sender string) error {
+	if err := balancerPool.UpdateSmoothWeightChangeParams(params, ctx.BlockTime()); err != nil {
+		return err
+	}
+	if err := k.setPool(ctx, balancerPool); err != nil {
+		return err
+	}
+
+	newParams := balancerPool.GetPoolParams().SmoothWeightChangeParams
+	targetWeights := make([]string, len(newParams.TargetPoolWeights))
+	for i, asset := range newParams.TargetPoolWeights {
+		targetWeights[i] = asset.Weight.String() + asset.Token.Denom
+	}
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.TypeEvtSmoothWeightChangeUpdated,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		sdk.NewAttribute(sdk.AttributeKeySender, sender),
+		sdk.NewAttribute(types.AttributeKeyPoolId, strconv.FormatUint(balancerPool.GetId(), 10)),
+		sdk.NewAttribute(types.AttributeKeyStartTime, newParams.StartTime.UTC().String()),
+		sdk.NewAttribute(types.AttributeKeyDuration, newParams.Duration.String()),
+		sdk.NewAttribute(types.AttributeKeyTargetWeights, strings.Join(targetWeights, ",")),
+	))
+	return nil
+}
+
+func (k Keeper) getBalancerPool(ctx sdk.Context, poolId uint64) (*balancer.Pool, error) {
+	pool, err := k.GetPoolAndPoke(ctx, poolId)
+	if err != nil {
+		return nil, err
+	}
+	balancerPool, ok := pool.(*balancer.Pool)
+	if !ok {
+		return nil, fmt.Errorf("pool id %d is not of type balancer pool", poolId)
+	}
+	return balancerPool, nil
 }
 
 // convertToCFMMPool converts PoolI to CFMMPoolI by casting the input.
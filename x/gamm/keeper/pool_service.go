@@ -63,6 +63,14 @@ func (k Keeper) CalculateSpotPrice(
 // - Records total liquidity increase
 // - Calls the AfterPoolCreated hook
 func (k Keeper) InitializePool(ctx sdk.Context, pool swaproutertypes.PoolI, sender sdk.AccAddress) (err error) {
+	cfmmPool, err := asCFMMPool(pool)
+	if err != nil {
+		return err
+	}
+	if maxTakerFeeShare := k.GetParams(ctx).MaxTakerFeeShare; cfmmPool.GetTakerFeeShare(ctx).GT(maxTakerFeeShare) {
+		return sdkerrors.Wrapf(types.ErrTooMuchTakerFeeShare, "got %s, max %s", cfmmPool.GetTakerFeeShare(ctx), maxTakerFeeShare)
+	}
+
 	// Mint the initial pool shares share token to the sender
 	err = k.MintPoolShareToAccount(ctx, pool, sender, pool.GetTotalShares())
 	if err != nil {
@@ -327,6 +335,103 @@ func (k Keeper) ExitPool(
 	return exitCoins, nil
 }
 
+// ExitPoolToDenoms exits shareInAmount shares of the stableswap pool #{poolId} to the tokenOutDenoms
+// subset of its assets. The exited tokens of the other pool assets are swapped into tokenOutDenoms
+// within the pool, paying its swap fee.
+// Returns an error if the pool is not a stableswap pool, or fewer than tokenOutMins are exited.
+func (k Keeper) ExitPoolToDenoms(
+	ctx sdk.Context,
+	sender sdk.AccAddress,
+	poolId uint64,
+	shareInAmount sdk.Int,
+	tokenOutDenoms []string,
+	tokenOutMins sdk.Coins,
+) (tokensOut sdk.Coins, err error) {
+	pool, err := k.getStableswapPool(ctx, poolId)
+	if err != nil {
+		return sdk.Coins{}, err
+	}
+
+	totalSharesAmount := pool.GetTotalShares()
+	if shareInAmount.GTE(totalSharesAmount) {
+		return sdk.Coins{}, sdkerrors.Wrapf(types.ErrInvalidMathApprox, "Trying to exit >= the number of shares contained in the pool.")
+	} else if shareInAmount.LTE(sdk.ZeroInt()) {
+		return sdk.Coins{}, sdkerrors.Wrapf(types.ErrInvalidMathApprox, "Trying to exit a negative amount of shares")
+	}
+	tokensOut, err = pool.ExitPoolToDenoms(ctx, shareInAmount, tokenOutDenoms, pool.GetExitFee(ctx), pool.GetSwapFee(ctx))
+	if err != nil {
+		return sdk.Coins{}, err
+	}
+	if !tokenOutMins.DenomsSubsetOf(tokensOut) || tokenOutMins.IsAnyGT(tokensOut) {
+		return sdk.Coins{}, sdkerrors.Wrapf(types.ErrLimitMinAmount,
+			"Exit pool returned %s , minimum tokens out specified as %s",
+			tokensOut, tokenOutMins)
+	}
+
+	// the swapped tokens stay in the pool, so its liquidity only decreases by the tokens out.
+	err = k.applyExitPoolStateChange(ctx, pool, sender, shareInAmount, tokensOut)
+	if err != nil {
+		return sdk.Coins{}, err
+	}
+
+	return tokensOut, nil
+}
+
+// MigrateLiquidity exits sharesToMigrate shares of pool #{poolIdLeaving}, and joins pool #{poolIdEntering}
+// with all of the coins exited. Both pools must have the same assets.
+// Returns an error if fewer than tokenOutMins are exited, or fewer than shareOutMinAmount shares are joined.
+func (k Keeper) MigrateLiquidity(
+	ctx sdk.Context,
+	sender sdk.AccAddress,
+	poolIdLeaving, poolIdEntering uint64,
+	sharesToMigrate sdk.Int,
+	tokenOutMins sdk.Coins,
+	shareOutMinAmount sdk.Int,
+) (sharesOut sdk.Int, err error) {
+	if poolIdLeaving == poolIdEntering {
+		return sdk.Int{}, sdkerrors.Wrapf(types.ErrMigrationSamePool, "pool id: %d", poolIdLeaving)
+	}
+
+	poolLeaving, err := k.GetPoolAndPoke(ctx, poolIdLeaving)
+	if err != nil {
+		return sdk.Int{}, err
+	}
+	poolEntering, err := k.GetPoolAndPoke(ctx, poolIdEntering)
+	if err != nil {
+		return sdk.Int{}, err
+	}
+	leavingDenoms := poolLeaving.GetTotalPoolLiquidity(ctx)
+	enteringDenoms := poolEntering.GetTotalPoolLiquidity(ctx)
+	if !leavingDenoms.DenomsSubsetOf(enteringDenoms) || !enteringDenoms.DenomsSubsetOf(leavingDenoms) {
+		return sdk.Int{}, sdkerrors.Wrapf(types.ErrMigrationAssetsMismatch,
+			"pool %d has assets %s, pool %d has assets %s", poolIdLeaving, leavingDenoms, poolIdEntering, enteringDenoms)
+	}
+
+	exitCoins, err := k.ExitPool(ctx, sender, poolIdLeaving, sharesToMigrate, tokenOutMins)
+	if err != nil {
+		return sdk.Int{}, err
+	}
+
+	return k.JoinSwapExactAmountIn(ctx, sender, poolIdEntering, exitCoins, shareOutMinAmount)
+}
+
+// migrateLockedLiquidity migrates the pool shares held in the given lock through the locked liquidity migrator,
+// which keeps the lock and any superfluid delegation of it on the shares of pool #{poolIdEntering}.
+func (k Keeper) migrateLockedLiquidity(
+	ctx sdk.Context,
+	sender sdk.AccAddress,
+	lockId uint64,
+	poolIdLeaving, poolIdEntering uint64,
+	sharesToMigrate sdk.Int,
+	tokenOutMins sdk.Coins,
+	shareOutMinAmount sdk.Int,
+) (sdk.Int, error) {
+	if k.lockedLiquidityMigrator == nil {
+		return sdk.Int{}, types.ErrLockedLiquidityMigrationNotSupported
+	}
+	return k.lockedLiquidityMigrator.MigrateLockedLiquidity(ctx, sender, lockId, poolIdLeaving, poolIdEntering, sharesToMigrate, tokenOutMins, shareOutMinAmount)
+}
+
 // ExitSwapShareAmountIn is an Exit Pool transaction, that will exit all of the provided LP shares,
 // and then swap it all against the pool into tokenOutDenom.
 // If the amount of tokens gotten out after the swap is less than tokenOutMinAmount, return an error.
@@ -342,12 +447,18 @@ func (k Keeper) ExitSwapShareAmountIn(
 	if err != nil {
 		return sdk.Int{}, err
 	}
+	pool, err := k.getPoolForSwap(ctx, poolId)
+	if err != nil {
+		return sdk.Int{}, err
+	}
+	swapFee := pool.GetSwapFee(ctx)
+
 	tokenOutAmount = exitCoins.AmountOf(tokenOutDenom)
 	for _, coin := range exitCoins {
 		if coin.Denom == tokenOutDenom {
 			continue
 		}
-		swapOut, err := k.SwapExactAmountIn(ctx, sender, poolId, coin, tokenOutDenom, sdk.ZeroInt())
+		swapOut, err := k.swapExactAmountIn(ctx, sender, pool, coin, tokenOutDenom, sdk.ZeroInt(), swapFee)
 		if err != nil {
 			return sdk.Int{}, err
 		}
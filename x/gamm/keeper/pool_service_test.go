@@ -6,6 +6,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	"github.com/osmosis-labs/osmosis/v13/app/apptesting"
 	"github.com/osmosis-labs/osmosis/v13/app/apptesting/osmoassert"
 	"github.com/osmosis-labs/osmosis/v13/x/gamm/pool-models/balancer"
 	balancertypes "github.com/osmosis-labs/osmosis/v13/x/gamm/pool-models/balancer"
@@ -74,6 +75,25 @@ func (suite *KeeperTestSuite) TestCreateBalancerPool() {
 			}, defaultPoolAssets, defaultFutureGovernor),
 			emptySender: false,
 			expectPass:  false,
+		}, {
+			name: "create a pool with a taker fee share and recipient",
+			msg: balancer.NewMsgCreateBalancerPool(testAccount, balancer.PoolParams{
+				SwapFee:           sdk.NewDecWithPrec(1, 2),
+				ExitFee:           sdk.NewDecWithPrec(1, 2),
+				TakerFeeShare:     types.DefaultParams().MaxTakerFeeShare,
+				TakerFeeRecipient: suite.TestAccs[1].String(),
+			}, defaultPoolAssets, defaultFutureGovernor),
+			emptySender: false,
+			expectPass:  true,
+		}, {
+			name: "create a pool with a taker fee share above the max taker fee share",
+			msg: balancer.NewMsgCreateBalancerPool(testAccount, balancer.PoolParams{
+				SwapFee:       sdk.NewDecWithPrec(1, 2),
+				ExitFee:       sdk.NewDecWithPrec(1, 2),
+				TakerFeeShare: types.DefaultParams().MaxTakerFeeShare.Add(sdk.NewDecWithPrec(1, 2)),
+			}, defaultPoolAssets, defaultFutureGovernor),
+			emptySender: false,
+			expectPass:  false,
 		}, {
 			name: "create a pool with negative exit fee",
 			msg: balancer.NewMsgCreateBalancerPool(testAccount, balancer.PoolParams{
@@ -512,6 +532,224 @@ func (suite *KeeperTestSuite) TestExitPool() {
 	}
 }
 
+// TestMigrateLiquidity tests that migrating liquidity exits shares from one pool
+// and joins another pool with the same assets with all of the coins exited.
+func (suite *KeeperTestSuite) TestMigrateLiquidity() {
+	stableLiquidity := sdk.NewCoins(
+		sdk.NewCoin("bar", sdk.NewInt(10000000)),
+		sdk.NewCoin("baz", sdk.NewInt(10000000)),
+		sdk.NewCoin("foo", sdk.NewInt(10000000)),
+	)
+	const (
+		balancerPoolId = uint64(1)
+		stablePoolId   = uint64(2)
+		osmoPoolId     = uint64(3)
+	)
+
+	tests := map[string]struct {
+		poolIdLeaving     uint64
+		poolIdEntering    uint64
+		sharesToMigrate   sdk.Int
+		tokenOutMins      sdk.Coins
+		shareOutMinAmount sdk.Int
+		expectedErr       error
+	}{
+		"migrate half of the shares from balancer to stableswap": {
+			poolIdLeaving:     balancerPoolId,
+			poolIdEntering:    stablePoolId,
+			sharesToMigrate:   types.OneShare.MulRaw(50),
+			tokenOutMins:      sdk.NewCoins(sdk.NewCoin("foo", sdk.NewInt(5000000))),
+			shareOutMinAmount: types.OneShare.MulRaw(49),
+		},
+		"migrate from stableswap to balancer": {
+			poolIdLeaving:     stablePoolId,
+			poolIdEntering:    balancerPoolId,
+			sharesToMigrate:   types.OneShare.MulRaw(10),
+			tokenOutMins:      sdk.Coins{},
+			shareOutMinAmount: sdk.ZeroInt(),
+		},
+		"migrate to the same pool": {
+			poolIdLeaving:     balancerPoolId,
+			poolIdEntering:    balancerPoolId,
+			sharesToMigrate:   types.OneShare.MulRaw(50),
+			tokenOutMins:      sdk.Coins{},
+			shareOutMinAmount: sdk.ZeroInt(),
+			expectedErr:       types.ErrMigrationSamePool,
+		},
+		"migrate to a pool with other assets": {
+			poolIdLeaving:     balancerPoolId,
+			poolIdEntering:    osmoPoolId,
+			sharesToMigrate:   types.OneShare.MulRaw(50),
+			tokenOutMins:      sdk.Coins{},
+			shareOutMinAmount: sdk.ZeroInt(),
+			expectedErr:       types.ErrMigrationAssetsMismatch,
+		},
+		"migrate to a pool that does not exist": {
+			poolIdLeaving:     balancerPoolId,
+			poolIdEntering:    4,
+			sharesToMigrate:   types.OneShare.MulRaw(50),
+			tokenOutMins:      sdk.Coins{},
+			shareOutMinAmount: sdk.ZeroInt(),
+			expectedErr:       types.PoolDoesNotExistError{PoolId: 4},
+		},
+		"token out mins above the coins exited": {
+			poolIdLeaving:     balancerPoolId,
+			poolIdEntering:    stablePoolId,
+			sharesToMigrate:   types.OneShare.MulRaw(50),
+			tokenOutMins:      sdk.NewCoins(sdk.NewCoin("foo", sdk.NewInt(5000001))),
+			shareOutMinAmount: sdk.ZeroInt(),
+			expectedErr:       types.ErrLimitMinAmount,
+		},
+		"share out min above the shares joined": {
+			poolIdLeaving:     balancerPoolId,
+			poolIdEntering:    stablePoolId,
+			sharesToMigrate:   types.OneShare.MulRaw(50),
+			tokenOutMins:      sdk.Coins{},
+			shareOutMinAmount: types.OneShare.MulRaw(51),
+			expectedErr:       types.ErrLimitMinAmount,
+		},
+	}
+
+	for name, tc := range tests {
+		suite.Run(name, func() {
+			suite.SetupTest()
+			sender := suite.TestAccs[0]
+			suite.PrepareBalancerPoolWithCoins(stableLiquidity...)
+			suite.PrepareBasicStableswapPool()
+			suite.PrepareBalancerPool()
+
+			gammKeeper := suite.App.GAMMKeeper
+			bankKeeper := suite.App.BankKeeper
+			leavingDenom := types.GetPoolShareDenom(tc.poolIdLeaving)
+			enteringDenom := types.GetPoolShareDenom(tc.poolIdEntering)
+			leavingSharesBefore := bankKeeper.GetBalance(suite.Ctx, sender, leavingDenom).Amount
+			enteringSharesBefore := bankKeeper.GetBalance(suite.Ctx, sender, enteringDenom).Amount
+			liquidityBefore := gammKeeper.GetTotalLiquidity(suite.Ctx)
+
+			sharesOut, err := gammKeeper.MigrateLiquidity(suite.Ctx, sender, tc.poolIdLeaving, tc.poolIdEntering, tc.sharesToMigrate, tc.tokenOutMins, tc.shareOutMinAmount)
+			if tc.expectedErr != nil {
+				suite.Require().ErrorIs(err, tc.expectedErr)
+				return
+			}
+			suite.Require().NoError(err)
+			suite.Require().True(sharesOut.GTE(tc.shareOutMinAmount))
+
+			suite.Require().Equal(leavingSharesBefore.Sub(tc.sharesToMigrate), bankKeeper.GetBalance(suite.Ctx, sender, leavingDenom).Amount)
+			suite.Require().Equal(enteringSharesBefore.Add(sharesOut), bankKeeper.GetBalance(suite.Ctx, sender, enteringDenom).Amount)
+			// all of the coins exited are joined, so no liquidity leaves the pools.
+			suite.Require().Equal(liquidityBefore, gammKeeper.GetTotalLiquidity(suite.Ctx))
+
+			suite.AssertEventEmitted(suite.Ctx, types.TypeEvtPoolExited, 1)
+			suite.AssertEventEmitted(suite.Ctx, types.TypeEvtPoolJoined, 1)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestExitPoolToDenoms() {
+	const (
+		stablePoolId   = uint64(1)
+		balancerPoolId = uint64(2)
+	)
+
+	tests := map[string]struct {
+		poolId         uint64
+		shareInAmount  sdk.Int
+		tokenOutDenoms []string
+		tokenOutMins   sdk.Coins
+		expectedErr    error
+	}{
+		"exit to one denom": {
+			poolId:         stablePoolId,
+			shareInAmount:  types.OneShare.MulRaw(10),
+			tokenOutDenoms: []string{"foo"},
+			tokenOutMins:   sdk.NewCoins(sdk.NewCoin("foo", sdk.NewInt(2_900_000))),
+		},
+		"exit to two denoms": {
+			poolId:         stablePoolId,
+			shareInAmount:  types.OneShare.MulRaw(10),
+			tokenOutDenoms: []string{"bar", "foo"},
+			tokenOutMins:   sdk.NewCoins(sdk.NewCoin("bar", sdk.NewInt(1_000_000)), sdk.NewCoin("foo", sdk.NewInt(1_000_000))),
+		},
+		"exit to all denoms": {
+			poolId:         stablePoolId,
+			shareInAmount:  types.OneShare.MulRaw(10),
+			tokenOutDenoms: []string{"bar", "baz", "foo"},
+			tokenOutMins:   sdk.NewCoins(sdk.NewCoin("bar", sdk.NewInt(1_000_000)), sdk.NewCoin("baz", sdk.NewInt(1_000_000)), sdk.NewCoin("foo", sdk.NewInt(1_000_000))),
+		},
+		"token out mins above the tokens exited": {
+			poolId:         stablePoolId,
+			shareInAmount:  types.OneShare.MulRaw(10),
+			tokenOutDenoms: []string{"foo"},
+			tokenOutMins:   sdk.NewCoins(sdk.NewCoin("foo", sdk.NewInt(3_000_001))),
+			expectedErr:    types.ErrLimitMinAmount,
+		},
+		"denom not in pool": {
+			poolId:         stablePoolId,
+			shareInAmount:  types.OneShare.MulRaw(10),
+			tokenOutDenoms: []string{"uosmo"},
+			expectedErr:    types.ErrDenomNotFoundInPool,
+		},
+		"exit all of the shares": {
+			poolId:         stablePoolId,
+			shareInAmount:  types.InitPoolSharesSupply,
+			tokenOutDenoms: []string{"foo"},
+			expectedErr:    types.ErrInvalidMathApprox,
+		},
+		"not a stableswap pool": {
+			poolId:         balancerPoolId,
+			shareInAmount:  types.OneShare.MulRaw(10),
+			tokenOutDenoms: []string{"foo"},
+			expectedErr:    fmt.Errorf("pool id %d is not of type stableswap pool", balancerPoolId),
+		},
+	}
+
+	for name, tc := range tests {
+		suite.Run(name, func() {
+			suite.SetupTest()
+			sender := suite.TestAccs[0]
+			suite.PrepareBasicStableswapPool()
+			suite.PrepareBalancerPool()
+
+			gammKeeper := suite.App.GAMMKeeper
+			bankKeeper := suite.App.BankKeeper
+			shareDenom := types.GetPoolShareDenom(tc.poolId)
+			sharesBefore := bankKeeper.GetBalance(suite.Ctx, sender, shareDenom).Amount
+			balancesBefore := bankKeeper.GetAllBalances(suite.Ctx, sender)
+			liquidityBefore := gammKeeper.GetTotalLiquidity(suite.Ctx)
+
+			tokensOut, err := gammKeeper.ExitPoolToDenoms(suite.Ctx, sender, tc.poolId, tc.shareInAmount, tc.tokenOutDenoms, tc.tokenOutMins)
+			if tc.expectedErr != nil {
+				suite.Require().ErrorContains(err, tc.expectedErr.Error())
+				suite.Require().Equal(balancesBefore, bankKeeper.GetAllBalances(suite.Ctx, sender))
+				return
+			}
+			suite.Require().NoError(err)
+
+			// only the token out denoms are exited, at least as many as the minimums.
+			suite.Require().Len(tokensOut, len(tc.tokenOutDenoms))
+			for _, denom := range tc.tokenOutDenoms {
+				suite.Require().True(tokensOut.AmountOf(denom).IsPositive())
+			}
+			suite.Require().False(tc.tokenOutMins.IsAnyGT(tokensOut))
+
+			suite.Require().Equal(sharesBefore.Sub(tc.shareInAmount), bankKeeper.GetBalance(suite.Ctx, sender, shareDenom).Amount)
+			for _, coin := range tokensOut {
+				suite.Require().Equal(balancesBefore.AmountOf(coin.Denom).Add(coin.Amount), bankKeeper.GetBalance(suite.Ctx, sender, coin.Denom).Amount)
+			}
+			suite.Require().Equal(liquidityBefore.Sub(tokensOut), gammKeeper.GetTotalLiquidity(suite.Ctx))
+
+			// the pool keeps the tokens swapped into the token out denoms.
+			pool, err := gammKeeper.GetPoolAndPoke(suite.Ctx, tc.poolId)
+			suite.Require().NoError(err)
+			poolAddrBalances := bankKeeper.GetAllBalances(suite.Ctx, pool.GetAddress())
+			suite.Require().Equal(apptesting.DefaultStableswapLiquidity.Sub(tokensOut), poolAddrBalances)
+			suite.Require().Equal(poolAddrBalances, pool.GetTotalPoolLiquidity(suite.Ctx))
+
+			suite.AssertEventEmitted(suite.Ctx, types.TypeEvtPoolExited, 1)
+		})
+	}
+}
+
 // TestJoinPoolExitPool_InverseRelationship tests that joining pool and exiting pool
 // guarantees same amount in and out
 func (suite *KeeperTestSuite) TestJoinPoolExitPool_InverseRelationship() {
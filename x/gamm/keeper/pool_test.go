@@ -517,7 +517,7 @@ func (suite *KeeperTestSuite) TestSetStableSwapScalingFactors() {
 					defaultPoolAssets,
 					defaultPoolParams)
 			}
-			err := suite.App.GAMMKeeper.SetStableSwapScalingFactors(suite.Ctx, tc.poolId, tc.scalingFactors, tc.sender.String())
+			err := suite.App.GAMMKeeper.SetStableSwapScalingFactors(suite.Ctx, tc.poolId, tc.scalingFactors, 0, tc.sender.String())
 			if tc.expError != nil {
 				suite.Require().Error(err)
 				suite.Require().EqualError(err, tc.expError.Error())
@@ -528,3 +528,42 @@ func (suite *KeeperTestSuite) TestSetStableSwapScalingFactors() {
 	}
 
 }
+
+func (suite *KeeperTestSuite) TestHandleUpdateSmoothWeightChangeProposal() {
+	suite.SetupTest()
+
+	// governance can update pools without a pool controller
+	poolId := suite.PrepareBalancerPool()
+	pool, err := suite.App.GAMMKeeper.GetPoolAndPoke(suite.Ctx, poolId)
+	suite.Require().NoError(err)
+	suite.Require().Empty(pool.(*balancer.Pool).GetPoolParams().PoolController)
+
+	var targetWeights []balancer.PoolAsset
+	for _, asset := range pool.(*balancer.Pool).PoolAssets {
+		targetWeights = append(targetWeights, balancer.PoolAsset{
+			Weight: sdk.NewInt(1),
+			Token:  sdk.NewCoin(asset.Token.Denom, sdk.ZeroInt()),
+		})
+	}
+	proposal := balancer.NewUpdateSmoothWeightChangeProposal("title", "description", poolId, balancer.SmoothWeightChangeParams{
+		Duration:          time.Hour,
+		TargetPoolWeights: targetWeights,
+	})
+	suite.Require().NoError(proposal.ValidateBasic())
+
+	err = suite.App.GAMMKeeper.HandleUpdateSmoothWeightChangeProposal(suite.Ctx, &proposal)
+	suite.Require().NoError(err)
+
+	// all weights are equal at the end of the weight change
+	suite.Ctx = suite.Ctx.WithBlockTime(suite.Ctx.BlockTime().Add(time.Hour))
+	pool, err = suite.App.GAMMKeeper.GetPoolAndPoke(suite.Ctx, poolId)
+	suite.Require().NoError(err)
+	for _, asset := range pool.(*balancer.Pool).PoolAssets {
+		suite.Require().Equal(sdk.NewInt(balancer.GuaranteedWeightPrecision).String(), asset.Weight.String())
+	}
+
+	// the proposal errors for pools that do not exist
+	proposal.PoolId = poolId + 1
+	err = suite.App.GAMMKeeper.HandleUpdateSmoothWeightChangeProposal(suite.Ctx, &proposal)
+	suite.Require().Error(err)
+}
@@ -0,0 +1,133 @@
+package keeper
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/osmosis-labs/osmosis/v13/osmoutils"
+	epochtypes "github.com/osmosis-labs/osmosis/v13/x/epochs/types"
+	"github.com/osmosis-labs/osmosis/v13/x/gamm/pool-models/stableswap"
+	"github.com/osmosis-labs/osmosis/v13/x/gamm/types"
+)
+
+var _ epochtypes.EpochHooks = &epochhook{}
+
+type epochhook struct {
+	k Keeper
+}
+
+// EpochHooks returns the epoch hooks of x/gamm, which update the scaling factors of
+// stableswap pools from their rate providers.
+func (k Keeper) EpochHooks() epochtypes.EpochHooks {
+	return &epochhook{k}
+}
+
+func (hook *epochhook) BeforeEpochStart(ctx sdk.Context, epochIdentifier string, epochNumber int64) error {
+	return nil
+}
+
+func (hook *epochhook) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, epochNumber int64) error {
+	if epochIdentifier == hook.k.GetParams(ctx).ScalingFactorRateProviderEpochIdentifier {
+		hook.k.UpdateScalingFactorsFromRateProviders(ctx)
+	}
+	return nil
+}
+
+// UpdateScalingFactorsFromRateProviders queries the rate provider contract of every stableswap
+// pool that has one for the pool's scaling factors, and changes the pool's scaling factors to
+// them over the rate provider's change duration.
+// A pool whose rate provider fails, or returns invalid scaling factors, is left unchanged.
+func (k Keeper) UpdateScalingFactorsFromRateProviders(ctx sdk.Context) {
+	pools, err := k.GetPoolsAndPoke(ctx)
+	if err != nil {
+		ctx.Logger().Error("Error getting pools to update from rate providers", err)
+		return
+	}
+
+	for _, pool := range pools {
+		stableswapPool, ok := pool.(*stableswap.Pool)
+		if !ok || stableswapPool.ScalingFactorRateProvider == nil {
+			continue
+		}
+
+		err := osmoutils.ApplyFuncIfNoError(ctx, func(cacheCtx sdk.Context) error {
+			return k.updateScalingFactorsFromRateProvider(cacheCtx, stableswapPool)
+		})
+		if err != nil {
+			ctx.EventManager().EmitEvent(sdk.NewEvent(
+				types.TypeEvtScalingFactorRateProviderError,
+				sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+				sdk.NewAttribute(types.AttributeKeyPoolId, strconv.FormatUint(stableswapPool.Id, 10)),
+				sdk.NewAttribute(types.AttributeKeyContract, stableswapPool.ScalingFactorRateProvider.ContractAddress),
+				sdk.NewAttribute(types.AttributeKeyReason, err.Error()),
+			))
+		}
+	}
+}
+
+// updateScalingFactorsFromRateProvider changes the scaling factors of the pool to the ones
+// returned by its rate provider contract.
+func (k Keeper) updateScalingFactorsFromRateProvider(ctx sdk.Context, pool *stableswap.Pool) error {
+	rateProvider := *pool.ScalingFactorRateProvider
+
+	scalingFactors, err := k.queryRateProvider(ctx, *pool)
+	if err != nil {
+		return err
+	}
+
+	if err := pool.SetScalingFactors(ctx, scalingFactors, rateProvider.ChangeDuration, rateProvider.ContractAddress); err != nil {
+		return err
+	}
+
+	if err := k.setPool(ctx, pool); err != nil {
+		return err
+	}
+
+	emitScalingFactorsAdjustedEvent(ctx, pool.Id, scalingFactors, rateProvider.ChangeDuration.String(), rateProvider.ContractAddress)
+	return nil
+}
+
+// queryRateProvider returns the scaling factors that the rate provider contract of the pool
+// returns, using at most MaxRateProviderQueryGas.
+func (k Keeper) queryRateProvider(ctx sdk.Context, pool stableswap.Pool) ([]uint64, error) {
+	if k.contractKeeper == nil {
+		return nil, sdkerrors.Wrap(types.ErrRateProviderQuery, "contract keeper is not set")
+	}
+
+	contractAddr, err := sdk.AccAddressFromBech32(pool.ScalingFactorRateProvider.ContractAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := stableswap.NewRateProviderQueryMsg(pool)
+	if err != nil {
+		return nil, err
+	}
+
+	queryCtx := ctx.WithGasMeter(sdk.NewGasMeter(types.MaxRateProviderQueryGas))
+	bz, err := k.contractKeeper.QuerySmart(queryCtx, contractAddr, req)
+	if err != nil {
+		return nil, sdkerrors.Wrap(types.ErrRateProviderQuery, err.Error())
+	}
+
+	var res stableswap.ScalingFactorsResponse
+	if err := json.Unmarshal(bz, &res); err != nil {
+		return nil, sdkerrors.Wrap(types.ErrRateProviderQuery, err.Error())
+	}
+	return res.ScalingFactors, nil
+}
+
+func emitScalingFactorsAdjustedEvent(ctx sdk.Context, poolId uint64, scalingFactors []uint64, changeDuration string, sender string) {
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.TypeEvtScalingFactorsAdjusted,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		sdk.NewAttribute(sdk.AttributeKeySender, sender),
+		sdk.NewAttribute(types.AttributeKeyPoolId, strconv.FormatUint(poolId, 10)),
+		sdk.NewAttribute(types.AttributeKeyScalingFactors, fmt.Sprint(scalingFactors)),
+		sdk.NewAttribute(types.AttributeKeyChangeDuration, changeDuration),
+	))
+}
@@ -0,0 +1,146 @@
+package keeper_test
+
+import (
+	"encoding/json"
+	"errors"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v13/x/gamm/pool-models/stableswap"
+	"github.com/osmosis-labs/osmosis/v13/x/gamm/types"
+)
+
+// mockRateProvider returns scalingFactors to every rate provider query, after consuming gasUsed.
+type mockRateProvider struct {
+	scalingFactors []uint64
+	gasUsed        uint64
+	err            error
+
+	queries []stableswap.RateProviderQueryMsg
+}
+
+func (m *mockRateProvider) QuerySmart(ctx sdk.Context, contractAddr sdk.AccAddress, req []byte) ([]byte, error) {
+	var query stableswap.RateProviderQueryMsg
+	if err := json.Unmarshal(req, &query); err != nil {
+		return nil, err
+	}
+	m.queries = append(m.queries, query)
+
+	ctx.GasMeter().ConsumeGas(m.gasUsed, "rate provider")
+	if m.err != nil {
+		return nil, m.err
+	}
+	return json.Marshal(stableswap.ScalingFactorsResponse{ScalingFactors: m.scalingFactors})
+}
+
+func (suite *KeeperTestSuite) TestUpdateScalingFactorsFromRateProviders() {
+	testcases := []struct {
+		name            string
+		epochIdentifier string
+		rateProvider    mockRateProvider
+		expectUpdate    bool
+		expectQuery     bool
+	}{
+		{
+			name:            "rate provider updates scaling factors over its change duration",
+			epochIdentifier: "day",
+			rateProvider:    mockRateProvider{scalingFactors: []uint64{1, 2}},
+			expectUpdate:    true,
+			expectQuery:     true,
+		},
+		{
+			name:            "not the rate provider epoch",
+			epochIdentifier: "week",
+			rateProvider:    mockRateProvider{scalingFactors: []uint64{1, 2}},
+			expectUpdate:    false,
+			expectQuery:     false,
+		},
+		{
+			name:            "rate provider query fails",
+			epochIdentifier: "day",
+			rateProvider:    mockRateProvider{err: errors.New("oracle is down")},
+			expectUpdate:    false,
+			expectQuery:     true,
+		},
+		{
+			name:            "rate provider returns invalid scaling factors",
+			epochIdentifier: "day",
+			rateProvider:    mockRateProvider{scalingFactors: []uint64{1, 2, 3}},
+			expectUpdate:    false,
+			expectQuery:     true,
+		},
+		{
+			name:            "rate provider runs out of gas",
+			epochIdentifier: "day",
+			rateProvider:    mockRateProvider{scalingFactors: []uint64{1, 2}, gasUsed: types.MaxRateProviderQueryGas + 1},
+			expectUpdate:    false,
+			expectQuery:     true,
+		},
+	}
+
+	for _, tc := range testcases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			rateProvider := tc.rateProvider
+			suite.App.GAMMKeeper.SetContractKeeper(&rateProvider)
+
+			liquidity := sdk.NewCoins(sdk.NewInt64Coin("bar", 1_000_000), sdk.NewInt64Coin("foo", 1_000_000))
+			poolId := suite.prepareCustomStableswapPool(defaultAcctFunds, stableswap.PoolParams{SwapFee: defaultSwapFee, ExitFee: defaultExitFee}, liquidity, []uint64{1, 1})
+			// a pool without a rate provider is left alone
+			otherPoolId := suite.prepareCustomStableswapPool(defaultAcctFunds, stableswap.PoolParams{SwapFee: defaultSwapFee, ExitFee: defaultExitFee}, liquidity, []uint64{1, 1})
+
+			controller := suite.TestAccs[0].String()
+			contractAddr := suite.TestAccs[1].String()
+			pool, err := suite.App.GAMMKeeper.GetPoolAndPoke(suite.Ctx, poolId)
+			suite.Require().NoError(err)
+			stableswapPool := pool.(*stableswap.Pool)
+			stableswapPool.ScalingFactorController = controller
+			err = stableswapPool.SetScalingFactorRateProvider(&stableswap.ScalingFactorRateProvider{ContractAddress: contractAddr, ChangeDuration: time.Hour}, controller)
+			suite.Require().NoError(err)
+			err = suite.App.GAMMKeeper.SetPool(suite.Ctx, stableswapPool)
+			suite.Require().NoError(err)
+
+			suite.Ctx = suite.Ctx.WithEventManager(sdk.NewEventManager())
+			err = suite.App.GAMMKeeper.EpochHooks().AfterEpochEnd(suite.Ctx, tc.epochIdentifier, 1)
+			suite.Require().NoError(err)
+
+			if tc.expectQuery {
+				suite.Require().Equal([]stableswap.RateProviderQueryMsg{{ScalingFactors: stableswap.ScalingFactorsQuery{PoolId: poolId, Denoms: []string{"bar", "foo"}}}}, rateProvider.queries)
+			} else {
+				suite.Require().Empty(rateProvider.queries)
+			}
+
+			pool, err = suite.App.GAMMKeeper.GetPoolAndPoke(suite.Ctx, poolId)
+			suite.Require().NoError(err)
+			stableswapPool = pool.(*stableswap.Pool)
+			suite.Require().Equal([]uint64{1, 1}, stableswapPool.ScalingFactors)
+
+			if !tc.expectUpdate {
+				suite.Require().Nil(stableswapPool.ScalingFactorChange)
+				if tc.expectQuery {
+					suite.AssertEventEmitted(suite.Ctx, types.TypeEvtScalingFactorRateProviderError, 1)
+				}
+				return
+			}
+
+			suite.AssertEventEmitted(suite.Ctx, types.TypeEvtScalingFactorsAdjusted, 1)
+			suite.Require().Equal(&stableswap.ScalingFactorChange{
+				StartTime:             suite.Ctx.BlockTime(),
+				Duration:              time.Hour,
+				InitialScalingFactors: []uint64{1, 1},
+				TargetScalingFactors:  []uint64{1, 2},
+			}, stableswapPool.ScalingFactorChange)
+
+			// the scaling factors reach the rate provider's ones over its change duration
+			suite.Ctx = suite.Ctx.WithBlockTime(suite.Ctx.BlockTime().Add(time.Hour))
+			pool, err = suite.App.GAMMKeeper.GetPoolAndPoke(suite.Ctx, poolId)
+			suite.Require().NoError(err)
+			suite.Require().Equal([]uint64{1, 2}, pool.(*stableswap.Pool).ScalingFactors)
+
+			otherPool, err := suite.App.GAMMKeeper.GetPoolAndPoke(suite.Ctx, otherPoolId)
+			suite.Require().NoError(err)
+			suite.Require().Equal([]uint64{1, 1}, otherPool.(*stableswap.Pool).ScalingFactors)
+		})
+	}
+}
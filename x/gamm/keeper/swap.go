@@ -9,34 +9,37 @@ import (
 
 	"github.com/osmosis-labs/osmosis/v13/x/gamm/types"
 	"github.com/osmosis-labs/osmosis/v13/x/swaprouter/events"
+	swaproutertypes "github.com/osmosis-labs/osmosis/v13/x/swaprouter/types"
 )
 
 // SwapExactAmountIn attempts to swap one asset, tokenIn, for another asset
-// denominated via tokenOutDenom through a pool denoted by poolId specifying that
+// denominated via tokenOutDenom through the given pool specifying that
 // tokenOutMinAmount must be returned in the resulting asset returning an error
-// upon failure. Upon success, the resulting tokens swapped for are returned. A
-// swap fee is applied determined by the pool's parameters.
+// upon failure. Upon success, the resulting tokens swapped for are returned.
+// The given swapFee is applied instead of the pool's own, allowing the caller
+// to discount it (e.g. for osmo-routed multihops).
 func (k Keeper) SwapExactAmountIn(
 	ctx sdk.Context,
 	sender sdk.AccAddress,
-	poolId uint64,
+	pool swaproutertypes.PoolI,
 	tokenIn sdk.Coin,
 	tokenOutDenom string,
 	tokenOutMinAmount sdk.Int,
+	swapFee sdk.Dec,
 ) (sdk.Int, error) {
-	pool, err := k.getPoolForSwap(ctx, poolId)
+	cfmmPool, err := asActiveCFMMPool(ctx, pool)
 	if err != nil {
 		return sdk.Int{}, err
 	}
-
-	swapFee := pool.GetSwapFee(ctx)
-	return k.swapExactAmountIn(ctx, sender, pool, tokenIn, tokenOutDenom, tokenOutMinAmount, swapFee)
+	return k.swapExactAmountIn(ctx, sender, cfmmPool, tokenIn, tokenOutDenom, tokenOutMinAmount, swapFee)
 }
 
 // swapExactAmountIn is an internal method for swapping an exact amount of tokens
 // as input to a pool, using the provided swapFee. This is intended to allow
 // different swap fees as determined by multi-hops, or when recovering from
 // chain liveness failures.
+// The pool's taker fee share of the swapFee is charged on tokenIn and sent to its
+// taker fee recipient, and the rest of tokenIn is swapped with the remaining swap fee.
 func (k Keeper) swapExactAmountIn(
 	ctx sdk.Context,
 	sender sdk.AccAddress,
@@ -49,7 +52,6 @@ func (k Keeper) swapExactAmountIn(
 	if tokenIn.Denom == tokenOutDenom {
 		return sdk.Int{}, errors.New("cannot trade same denomination in and out")
 	}
-	tokensIn := sdk.Coins{tokenIn}
 
 	defer func() {
 		if r := recover(); r != nil {
@@ -58,9 +60,12 @@ func (k Keeper) swapExactAmountIn(
 		}
 	}()
 
+	takerFeeRate, lpSwapFee := k.splitSwapFee(ctx, pool, swapFee)
+	takerFee, tokenIn := calcTakerFeeGivenIn(tokenIn, takerFeeRate)
+
 	// Executes the swap in the pool and stores the output. Updates pool assets but
 	// does not actually transfer any tokens to or from the pool.
-	tokenOutCoin, err := pool.SwapOutAmtGivenIn(ctx, tokensIn, tokenOutDenom, swapFee)
+	tokenOutCoin, err := pool.SwapOutAmtGivenIn(ctx, sdk.Coins{tokenIn}, tokenOutDenom, lpSwapFee)
 	if err != nil {
 		return sdk.Int{}, err
 	}
@@ -75,6 +80,10 @@ func (k Keeper) swapExactAmountIn(
 		return sdk.Int{}, sdkerrors.Wrapf(types.ErrLimitMinAmount, "%s token is lesser than min amount", tokenOutDenom)
 	}
 
+	if err := k.chargeTakerFee(ctx, pool, sender, takerFee); err != nil {
+		return sdk.Int{}, err
+	}
+
 	// Settles balances between the tx sender and the pool to match the swap that was executed earlier.
 	// Also emits swap event and updates related liquidity metrics
 	if err := k.updatePoolForSwap(ctx, pool, sender, tokenIn, tokenOutCoin); err != nil {
@@ -84,26 +93,30 @@ func (k Keeper) swapExactAmountIn(
 	return tokenOutAmount, nil
 }
 
+// SwapExactAmountOut attempts to swap at most tokenInMaxAmount of tokenInDenom
+// for exactly tokenOut through the given pool, using the given swapFee.
 func (k Keeper) SwapExactAmountOut(
 	ctx sdk.Context,
 	sender sdk.AccAddress,
-	poolId uint64,
+	pool swaproutertypes.PoolI,
 	tokenInDenom string,
 	tokenInMaxAmount sdk.Int,
 	tokenOut sdk.Coin,
+	swapFee sdk.Dec,
 ) (tokenInAmount sdk.Int, err error) {
-	pool, err := k.getPoolForSwap(ctx, poolId)
+	cfmmPool, err := asActiveCFMMPool(ctx, pool)
 	if err != nil {
 		return sdk.Int{}, err
 	}
-	swapFee := pool.GetSwapFee(ctx)
-	return k.swapExactAmountOut(ctx, sender, pool, tokenInDenom, tokenInMaxAmount, tokenOut, swapFee)
+	return k.swapExactAmountOut(ctx, sender, cfmmPool, tokenInDenom, tokenInMaxAmount, tokenOut, swapFee)
 }
 
 // swapExactAmountOut is an internal method for swapping to get an exact number of tokens out of a pool,
 // using the provided swapFee.
 // This is intended to allow different swap fees as determined by multi-hops,
 // or when recovering from chain liveness failures.
+// The pool's taker fee share of the swapFee is charged on top of the tokens swapped through the pool
+// with the remaining swap fee, and sent to its taker fee recipient. tokenInAmount includes it.
 func (k Keeper) swapExactAmountOut(
 	ctx sdk.Context,
 	sender sdk.AccAddress,
@@ -130,18 +143,25 @@ func (k Keeper) swapExactAmountOut(
 			"can't get more tokens out than there are tokens in the pool")
 	}
 
-	tokenIn, err := pool.SwapInAmtGivenOut(ctx, sdk.Coins{tokenOut}, tokenInDenom, swapFee)
+	takerFeeRate, lpSwapFee := k.splitSwapFee(ctx, pool, swapFee)
+
+	tokenIn, err := pool.SwapInAmtGivenOut(ctx, sdk.Coins{tokenOut}, tokenInDenom, lpSwapFee)
 	if err != nil {
 		return sdk.Int{}, err
 	}
-	tokenInAmount = tokenIn.Amount
+	takerFee := calcTakerFeeGivenOut(tokenIn, takerFeeRate)
+	tokenInAmount = tokenIn.Amount.Add(takerFee.Amount)
 
 	if tokenInAmount.LTE(sdk.ZeroInt()) {
 		return sdk.Int{}, sdkerrors.Wrapf(types.ErrInvalidMathApprox, "token amount is zero or negative")
 	}
 
 	if tokenInAmount.GT(tokenInMaxAmount) {
-		return sdk.Int{}, sdkerrors.Wrapf(types.ErrLimitMaxAmount, "Swap requires %s, which is greater than the amount %s", tokenIn, tokenInMaxAmount)
+		return sdk.Int{}, sdkerrors.Wrapf(types.ErrLimitMaxAmount, "Swap requires %s, which is greater than the amount %s", tokenIn.Add(takerFee), tokenInMaxAmount)
+	}
+
+	if err := k.chargeTakerFee(ctx, pool, sender, takerFee); err != nil {
+		return sdk.Int{}, err
 	}
 
 	err = k.updatePoolForSwap(ctx, pool, sender, tokenIn, tokenOut)
@@ -190,3 +210,65 @@ func (k Keeper) updatePoolForSwap(
 
 	return err
 }
+
+// CalcOutAmtGivenIn calculates the amount of tokenOutDenom given out by the
+// pool for tokenIn, net of the pool's taker fee, without mutating any state.
+func (k Keeper) CalcOutAmtGivenIn(
+	ctx sdk.Context,
+	poolI swaproutertypes.PoolI,
+	tokenIn sdk.Coin,
+	tokenOutDenom string,
+	swapFee sdk.Dec,
+) (tokenOut sdk.Coin, err error) {
+	cfmmPool, err := asCFMMPool(poolI)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	takerFeeRate, lpSwapFee := k.splitSwapFee(ctx, cfmmPool, swapFee)
+	_, tokenIn = calcTakerFeeGivenIn(tokenIn, takerFeeRate)
+	return cfmmPool.CalcOutAmtGivenIn(ctx, sdk.NewCoins(tokenIn), tokenOutDenom, lpSwapFee)
+}
+
+// CalcInAmtGivenOut calculates the amount of tokenInDenom required by the pool
+// to give out tokenOut, including the pool's taker fee, without mutating any state.
+func (k Keeper) CalcInAmtGivenOut(
+	ctx sdk.Context,
+	poolI swaproutertypes.PoolI,
+	tokenOut sdk.Coin,
+	tokenInDenom string,
+	swapFee sdk.Dec,
+) (tokenIn sdk.Coin, err error) {
+	cfmmPool, err := asCFMMPool(poolI)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	takerFeeRate, lpSwapFee := k.splitSwapFee(ctx, cfmmPool, swapFee)
+	tokenIn, err = cfmmPool.CalcInAmtGivenOut(ctx, sdk.NewCoins(tokenOut), tokenInDenom, lpSwapFee)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	return tokenIn.Add(calcTakerFeeGivenOut(tokenIn, takerFeeRate)), nil
+}
+
+// asCFMMPool converts the given pool to a CFMM pool, returning an error
+// if it is not one of the pool models managed by x/gamm.
+func asCFMMPool(pool swaproutertypes.PoolI) (types.CFMMPoolI, error) {
+	cfmmPool, ok := pool.(types.CFMMPoolI)
+	if !ok {
+		return nil, fmt.Errorf("given pool does not implement CFMMPoolI, implements %T", pool)
+	}
+	return cfmmPool, nil
+}
+
+// asActiveCFMMPool converts the given pool to a CFMM pool, additionally
+// returning an error if swaps on it are currently disabled.
+func asActiveCFMMPool(ctx sdk.Context, pool swaproutertypes.PoolI) (types.CFMMPoolI, error) {
+	cfmmPool, err := asCFMMPool(pool)
+	if err != nil {
+		return nil, err
+	}
+	if !cfmmPool.IsActive(ctx) {
+		return nil, sdkerrors.Wrapf(types.ErrPoolLocked, "swap on inactive pool")
+	}
+	return cfmmPool, nil
+}
@@ -7,9 +7,12 @@ import (
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/suite"
 
+	"github.com/osmosis-labs/osmosis/v13/app/apptesting"
 	"github.com/osmosis-labs/osmosis/v13/tests/mocks"
 	"github.com/osmosis-labs/osmosis/v13/x/gamm/pool-models/balancer"
+	"github.com/osmosis-labs/osmosis/v13/x/gamm/pool-models/stableswap"
 	"github.com/osmosis-labs/osmosis/v13/x/gamm/types"
+	swaproutertypes "github.com/osmosis-labs/osmosis/v13/x/swaprouter/types"
 )
 
 var _ = suite.TestingSuite(nil)
@@ -92,13 +95,15 @@ func (suite *KeeperTestSuite) TestBalancerPoolSimpleSwapExactAmountIn() {
 			poolId := suite.PrepareBalancerPool()
 			keeper := suite.App.GAMMKeeper
 			ctx := suite.Ctx
+			pool, err := keeper.GetPool(ctx, poolId)
+			suite.Require().NoError(err)
 
 			if test.expectPass {
 				spotPriceBefore, err := keeper.CalculateSpotPrice(ctx, poolId, test.param.tokenIn.Denom, test.param.tokenOutDenom)
 				suite.NoError(err, "test: %v", test.name)
 
 				prevGasConsumed := suite.Ctx.GasMeter().GasConsumed()
-				tokenOutAmount, err := keeper.SwapExactAmountIn(ctx, suite.TestAccs[0], poolId, test.param.tokenIn, test.param.tokenOutDenom, test.param.tokenOutMinAmount)
+				tokenOutAmount, err := keeper.SwapExactAmountIn(ctx, suite.TestAccs[0], pool, test.param.tokenIn, test.param.tokenOutDenom, test.param.tokenOutMinAmount, pool.GetSwapFee(ctx))
 				suite.NoError(err, "test: %v", test.name)
 				suite.True(tokenOutAmount.Equal(test.param.expectedTokenOut), "test: %v", test.name)
 				gasConsumedForSwap := suite.Ctx.GasMeter().GasConsumed() - prevGasConsumed
@@ -114,7 +119,7 @@ func (suite *KeeperTestSuite) TestBalancerPoolSimpleSwapExactAmountIn() {
 				tradeAvgPrice := test.param.tokenIn.Amount.ToDec().Quo(tokenOutAmount.ToDec())
 				suite.True(tradeAvgPrice.GT(spotPriceBefore) && tradeAvgPrice.LT(spotPriceAfter), "test: %v", test.name)
 			} else {
-				_, err := keeper.SwapExactAmountIn(ctx, suite.TestAccs[0], poolId, test.param.tokenIn, test.param.tokenOutDenom, test.param.tokenOutMinAmount)
+				_, err := keeper.SwapExactAmountIn(ctx, suite.TestAccs[0], pool, test.param.tokenIn, test.param.tokenOutDenom, test.param.tokenOutMinAmount, pool.GetSwapFee(ctx))
 				suite.Error(err, "test: %v", test.name)
 			}
 		})
@@ -200,13 +205,15 @@ func (suite *KeeperTestSuite) TestBalancerPoolSimpleSwapExactAmountOut() {
 
 			keeper := suite.App.GAMMKeeper
 			ctx := suite.Ctx
+			pool, err := keeper.GetPool(ctx, poolId)
+			suite.Require().NoError(err)
 
 			if test.expectPass {
 				spotPriceBefore, err := keeper.CalculateSpotPrice(ctx, poolId, test.param.tokenInDenom, test.param.tokenOut.Denom)
 				suite.NoError(err, "test: %v", test.name)
 
 				prevGasConsumed := suite.Ctx.GasMeter().GasConsumed()
-				tokenInAmount, err := keeper.SwapExactAmountOut(ctx, suite.TestAccs[0], poolId, test.param.tokenInDenom, test.param.tokenInMaxAmount, test.param.tokenOut)
+				tokenInAmount, err := keeper.SwapExactAmountOut(ctx, suite.TestAccs[0], pool, test.param.tokenInDenom, test.param.tokenInMaxAmount, test.param.tokenOut, pool.GetSwapFee(ctx))
 				suite.NoError(err, "test: %v", test.name)
 				suite.True(tokenInAmount.Equal(test.param.expectedTokenInAmount),
 					"test: %v\n expect_eq actual: %s, expected: %s",
@@ -224,7 +231,7 @@ func (suite *KeeperTestSuite) TestBalancerPoolSimpleSwapExactAmountOut() {
 				tradeAvgPrice := tokenInAmount.ToDec().Quo(test.param.tokenOut.Amount.ToDec())
 				suite.True(tradeAvgPrice.GT(spotPriceBefore) && tradeAvgPrice.LT(spotPriceAfter), "test: %v", test.name)
 			} else {
-				_, err := keeper.SwapExactAmountOut(suite.Ctx, suite.TestAccs[0], poolId, test.param.tokenInDenom, test.param.tokenInMaxAmount, test.param.tokenOut)
+				_, err := keeper.SwapExactAmountOut(ctx, suite.TestAccs[0], pool, test.param.tokenInDenom, test.param.tokenInMaxAmount, test.param.tokenOut, pool.GetSwapFee(ctx))
 				suite.Error(err, "test: %v", test.name)
 			}
 		})
@@ -257,16 +264,18 @@ func (suite *KeeperTestSuite) TestActiveBalancerPoolSwap() {
 			suite.Ctx = suite.Ctx.WithBlockTime(tc.blockTime)
 
 			foocoin := sdk.NewCoin("foo", sdk.NewInt(10))
+			pool, err := suite.App.GAMMKeeper.GetPool(suite.Ctx, poolId)
+			suite.Require().NoError(err)
 
 			if tc.expectPass {
-				_, err := suite.App.GAMMKeeper.SwapExactAmountIn(suite.Ctx, suite.TestAccs[0], poolId, foocoin, "bar", sdk.ZeroInt())
+				_, err := suite.App.GAMMKeeper.SwapExactAmountIn(suite.Ctx, suite.TestAccs[0], pool, foocoin, "bar", sdk.ZeroInt(), pool.GetSwapFee(suite.Ctx))
 				suite.Require().NoError(err)
-				_, err = suite.App.GAMMKeeper.SwapExactAmountOut(suite.Ctx, suite.TestAccs[0], poolId, "bar", sdk.NewInt(1000000000000000000), foocoin)
+				_, err = suite.App.GAMMKeeper.SwapExactAmountOut(suite.Ctx, suite.TestAccs[0], pool, "bar", sdk.NewInt(1000000000000000000), foocoin, pool.GetSwapFee(suite.Ctx))
 				suite.Require().NoError(err)
 			} else {
-				_, err := suite.App.GAMMKeeper.SwapExactAmountIn(suite.Ctx, suite.TestAccs[0], poolId, foocoin, "bar", sdk.ZeroInt())
+				_, err := suite.App.GAMMKeeper.SwapExactAmountIn(suite.Ctx, suite.TestAccs[0], pool, foocoin, "bar", sdk.ZeroInt(), pool.GetSwapFee(suite.Ctx))
 				suite.Require().Error(err)
-				_, err = suite.App.GAMMKeeper.SwapExactAmountOut(suite.Ctx, suite.TestAccs[0], poolId, "bar", sdk.NewInt(1000000000000000000), foocoin)
+				_, err = suite.App.GAMMKeeper.SwapExactAmountOut(suite.Ctx, suite.TestAccs[0], pool, "bar", sdk.NewInt(1000000000000000000), foocoin, pool.GetSwapFee(suite.Ctx))
 				suite.Require().Error(err)
 			}
 		}
@@ -287,6 +296,8 @@ func (suite *KeeperTestSuite) TestInactivePoolFreezeSwaps() {
 
 	// Setup active pool
 	activePoolId := suite.PrepareBalancerPool()
+	activePool, err := suite.App.GAMMKeeper.GetPool(suite.Ctx, activePoolId)
+	suite.Require().NoError(err)
 
 	// Setup mock inactive pool
 	gammKeeper := suite.App.GAMMKeeper
@@ -301,20 +312,20 @@ func (suite *KeeperTestSuite) TestInactivePoolFreezeSwaps() {
 	gammKeeper.SetPool(suite.Ctx, inactivePool)
 
 	type testCase struct {
-		poolId     uint64
+		pool       swaproutertypes.PoolI
 		expectPass bool
 		name       string
 	}
 	testCases := []testCase{
-		{activePoolId, true, "swap succeeds on active pool"},
-		{inactivePoolId, false, "swap fails on inactive pool"},
+		{activePool, true, "swap succeeds on active pool"},
+		{inactivePool, false, "swap fails on inactive pool"},
 	}
 
 	for _, test := range testCases {
 		suite.Run(test.name, func() {
 			// Check swaps
-			_, swapInErr := gammKeeper.SwapExactAmountIn(suite.Ctx, suite.TestAccs[0], test.poolId, testCoin, "bar", sdk.ZeroInt())
-			_, swapOutErr := gammKeeper.SwapExactAmountOut(suite.Ctx, suite.TestAccs[0], test.poolId, "bar", sdk.NewInt(1000000000000000000), testCoin)
+			_, swapInErr := gammKeeper.SwapExactAmountIn(suite.Ctx, suite.TestAccs[0], test.pool, testCoin, "bar", sdk.ZeroInt(), sdk.ZeroDec())
+			_, swapOutErr := gammKeeper.SwapExactAmountOut(suite.Ctx, suite.TestAccs[0], test.pool, "bar", sdk.NewInt(1000000000000000000), testCoin, sdk.ZeroDec())
 			if test.expectPass {
 				suite.Require().NoError(swapInErr)
 				suite.Require().NoError(swapOutErr)
@@ -325,3 +336,154 @@ func (suite *KeeperTestSuite) TestInactivePoolFreezeSwaps() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestSwapTakerFee() {
+	tests := map[string]struct {
+		isStableswap     bool
+		swapFee          sdk.Dec
+		takerFeeShare    sdk.Dec
+		hasRecipient     bool
+		maxTakerFeeShare sdk.Dec
+		isExactAmountOut bool
+		// expectedTakerFeeShare is the taker fee share charged after the max taker fee share cap
+		expectedTakerFeeShare sdk.Dec
+	}{
+		"balancer, exact amount in, sent to recipient": {
+			swapFee:               sdk.NewDecWithPrec(1, 2),
+			takerFeeShare:         sdk.NewDecWithPrec(3, 1),
+			hasRecipient:          true,
+			expectedTakerFeeShare: sdk.NewDecWithPrec(3, 1),
+		},
+		"balancer, exact amount out, sent to community pool": {
+			swapFee:               sdk.NewDecWithPrec(1, 2),
+			takerFeeShare:         sdk.NewDecWithPrec(3, 1),
+			isExactAmountOut:      true,
+			expectedTakerFeeShare: sdk.NewDecWithPrec(3, 1),
+		},
+		"balancer, taker fee share above lowered max is capped": {
+			swapFee:               sdk.NewDecWithPrec(1, 2),
+			takerFeeShare:         sdk.NewDecWithPrec(5, 1),
+			hasRecipient:          true,
+			maxTakerFeeShare:      sdk.NewDecWithPrec(2, 1),
+			expectedTakerFeeShare: sdk.NewDecWithPrec(2, 1),
+		},
+		"balancer, no taker fee share": {
+			swapFee:               sdk.NewDecWithPrec(1, 2),
+			takerFeeShare:         sdk.ZeroDec(),
+			hasRecipient:          true,
+			expectedTakerFeeShare: sdk.ZeroDec(),
+		},
+		"stableswap, exact amount in, sent to community pool": {
+			isStableswap:          true,
+			swapFee:               sdk.NewDecWithPrec(3, 3),
+			takerFeeShare:         sdk.NewDecWithPrec(5, 1),
+			expectedTakerFeeShare: sdk.NewDecWithPrec(5, 1),
+		},
+		"stableswap, exact amount out, sent to recipient": {
+			isStableswap:          true,
+			swapFee:               sdk.NewDecWithPrec(3, 3),
+			takerFeeShare:         sdk.NewDecWithPrec(5, 1),
+			hasRecipient:          true,
+			isExactAmountOut:      true,
+			expectedTakerFeeShare: sdk.NewDecWithPrec(5, 1),
+		},
+	}
+
+	for name, tc := range tests {
+		suite.Run(name, func() {
+			suite.SetupTest()
+			keeper := suite.App.GAMMKeeper
+			sender, recipient := suite.TestAccs[0], ""
+			if tc.hasRecipient {
+				recipient = suite.TestAccs[2].String()
+			}
+
+			var poolId uint64
+			if tc.isStableswap {
+				suite.FundAcc(sender, apptesting.DefaultAcctFunds)
+				msg := stableswap.NewMsgCreateStableswapPool(sender, stableswap.PoolParams{
+					SwapFee:           tc.swapFee,
+					ExitFee:           sdk.ZeroDec(),
+					TakerFeeShare:     tc.takerFeeShare,
+					TakerFeeRecipient: recipient,
+				}, apptesting.DefaultStableswapLiquidity, []uint64{}, "")
+				var err error
+				poolId, err = suite.App.SwapRouterKeeper.CreatePool(suite.Ctx, msg)
+				suite.Require().NoError(err)
+			} else {
+				poolId = suite.PrepareBalancerPoolWithPoolParams(balancer.PoolParams{
+					SwapFee:           tc.swapFee,
+					ExitFee:           sdk.ZeroDec(),
+					TakerFeeShare:     tc.takerFeeShare,
+					TakerFeeRecipient: recipient,
+				})
+			}
+			suite.FundAcc(sender, apptesting.DefaultAcctFunds)
+
+			if !tc.maxTakerFeeShare.IsNil() {
+				params := keeper.GetParams(suite.Ctx)
+				params.MaxTakerFeeShare = tc.maxTakerFeeShare
+				keeper.SetParams(suite.Ctx, params)
+			}
+
+			pool, err := keeper.GetPoolAndPoke(suite.Ctx, poolId)
+			suite.Require().NoError(err)
+			liquidityBefore := pool.GetTotalPoolLiquidity(suite.Ctx)
+			senderBalanceBefore := suite.App.BankKeeper.GetBalance(suite.Ctx, sender, "foo")
+			communityPoolBefore := suite.App.DistrKeeper.GetFeePoolCommunityCoins(suite.Ctx)
+			recipientBalanceBefore := suite.App.BankKeeper.GetBalance(suite.Ctx, suite.TestAccs[2], "foo")
+			suite.Ctx = suite.Ctx.WithEventManager(sdk.NewEventManager())
+
+			var tokenInAmount sdk.Int
+			if tc.isExactAmountOut {
+				tokenOut := sdk.NewCoin("bar", sdk.NewInt(100000))
+				estimatedTokenIn, err := keeper.CalcInAmtGivenOut(suite.Ctx, pool, tokenOut, "foo", tc.swapFee)
+				suite.Require().NoError(err)
+
+				tokenInAmount, err = keeper.SwapExactAmountOut(suite.Ctx, sender, pool, "foo", sdk.NewInt(1000000), tokenOut, tc.swapFee)
+				suite.Require().NoError(err)
+				suite.Require().Equal(estimatedTokenIn.Amount, tokenInAmount)
+			} else {
+				tokenInAmount = sdk.NewInt(100000)
+				tokenIn := sdk.NewCoin("foo", tokenInAmount)
+				estimatedTokenOut, err := keeper.CalcOutAmtGivenIn(suite.Ctx, pool, tokenIn, "bar", tc.swapFee)
+				suite.Require().NoError(err)
+
+				tokenOutAmount, err := keeper.SwapExactAmountIn(suite.Ctx, sender, pool, tokenIn, "bar", sdk.OneInt(), tc.swapFee)
+				suite.Require().NoError(err)
+				suite.Require().Equal(estimatedTokenOut.Amount, tokenOutAmount)
+			}
+
+			// the taker fee is within rounding of the taker fee share of the swap fee on the tokens in
+			takerFee := keeper.GetProtocolFees(suite.Ctx, poolId).AmountOf("foo")
+			expectedTakerFee := tokenInAmount.ToDec().Mul(tc.swapFee).Mul(tc.expectedTakerFeeShare)
+			suite.Require().True(takerFee.ToDec().Sub(expectedTakerFee).Abs().LTE(sdk.OneDec()),
+				"taker fee %s, expected %s", takerFee, expectedTakerFee)
+			if tc.expectedTakerFeeShare.IsZero() {
+				suite.Require().True(takerFee.IsZero())
+				suite.AssertEventEmitted(suite.Ctx, types.TypeEvtTakerFeeCharged, 0)
+			} else {
+				suite.Require().True(takerFee.IsPositive())
+				suite.AssertEventEmitted(suite.Ctx, types.TypeEvtTakerFeeCharged, 1)
+			}
+
+			// the sender pays the taker fee on top of the tokens swapped through the pool
+			senderBalanceAfter := suite.App.BankKeeper.GetBalance(suite.Ctx, sender, "foo")
+			suite.Require().Equal(tokenInAmount, senderBalanceBefore.Amount.Sub(senderBalanceAfter.Amount))
+			pool, err = keeper.GetPoolAndPoke(suite.Ctx, poolId)
+			suite.Require().NoError(err)
+			liquidityAfter := pool.GetTotalPoolLiquidity(suite.Ctx)
+			suite.Require().Equal(tokenInAmount.Sub(takerFee), liquidityAfter.AmountOf("foo").Sub(liquidityBefore.AmountOf("foo")))
+
+			recipientBalanceAfter := suite.App.BankKeeper.GetBalance(suite.Ctx, suite.TestAccs[2], "foo")
+			communityPoolAfter := suite.App.DistrKeeper.GetFeePoolCommunityCoins(suite.Ctx)
+			if tc.hasRecipient {
+				suite.Require().Equal(takerFee, recipientBalanceAfter.Amount.Sub(recipientBalanceBefore.Amount))
+				suite.Require().Equal(communityPoolBefore, communityPoolAfter)
+			} else {
+				suite.Require().Equal(recipientBalanceBefore, recipientBalanceAfter)
+				suite.Require().Equal(takerFee.ToDec(), communityPoolAfter.AmountOf("foo").Sub(communityPoolBefore.AmountOf("foo")))
+			}
+		})
+	}
+}
@@ -0,0 +1,107 @@
+package keeper
+
+import (
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v13/x/gamm/types"
+)
+
+// splitSwapFee splits the swap fee applied to a swap through the given pool into the taker fee rate,
+// charged on the tokens in and sent to the pool's taker fee recipient, and the swap fee that remains for LPs.
+// The taker fee rate is the swap fee times the pool's taker fee share, capped by the max taker fee share param.
+// As the taker fee is a share of the applied swap fee, discounts on it (e.g. for osmo-routed multihops)
+// apply to the taker fee and the LPs' swap fee alike.
+func (k Keeper) splitSwapFee(ctx sdk.Context, pool types.CFMMPoolI, swapFee sdk.Dec) (takerFeeRate, lpSwapFee sdk.Dec) {
+	takerFeeShare := sdk.MinDec(pool.GetTakerFeeShare(ctx), k.GetParams(ctx).MaxTakerFeeShare)
+	takerFeeRate = swapFee.Mul(takerFeeShare)
+	return takerFeeRate, swapFee.Sub(takerFeeRate)
+}
+
+// calcTakerFeeGivenIn returns the taker fee charged on tokenIn at the given rate, rounded down,
+// and the remaining tokens that are swapped through the pool.
+func calcTakerFeeGivenIn(tokenIn sdk.Coin, takerFeeRate sdk.Dec) (takerFee, tokenInAfterTakerFee sdk.Coin) {
+	takerFee = sdk.NewCoin(tokenIn.Denom, tokenIn.Amount.ToDec().MulTruncate(takerFeeRate).TruncateInt())
+	return takerFee, tokenIn.Sub(takerFee)
+}
+
+// calcTakerFeeGivenOut returns the taker fee charged at the given rate on the tokens in, such that
+// tokenInAfterTakerFee remains to be swapped through the pool. It is rounded up.
+func calcTakerFeeGivenOut(tokenInAfterTakerFee sdk.Coin, takerFeeRate sdk.Dec) (takerFee sdk.Coin) {
+	tokenInAmount := tokenInAfterTakerFee.Amount.ToDec().QuoRoundUp(sdk.OneDec().Sub(takerFeeRate)).Ceil().TruncateInt()
+	return sdk.NewCoin(tokenInAfterTakerFee.Denom, tokenInAmount.Sub(tokenInAfterTakerFee.Amount))
+}
+
+// chargeTakerFee sends the taker fee from the sender to the pool's taker fee recipient,
+// or to the community pool if it has none, and records it in the pool's protocol fees.
+func (k Keeper) chargeTakerFee(ctx sdk.Context, pool types.CFMMPoolI, sender sdk.AccAddress, takerFee sdk.Coin) error {
+	if !takerFee.IsPositive() {
+		return nil
+	}
+
+	recipient := pool.GetTakerFeeRecipient(ctx)
+	if recipient == "" {
+		if err := k.communityPoolKeeper.FundCommunityPool(ctx, sdk.NewCoins(takerFee), sender); err != nil {
+			return err
+		}
+	} else {
+		recipientAddr, err := sdk.AccAddressFromBech32(recipient)
+		if err != nil {
+			return err
+		}
+		if err := k.bankKeeper.SendCoins(ctx, sender, recipientAddr, sdk.NewCoins(takerFee)); err != nil {
+			return err
+		}
+	}
+
+	k.recordProtocolFee(ctx, pool.GetId(), takerFee)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.TypeEvtTakerFeeCharged,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, sender.String()),
+			sdk.NewAttribute(types.AttributeKeyPoolId, strconv.FormatUint(pool.GetId(), 10)),
+			sdk.NewAttribute(types.AttributeKeyRecipient, recipient),
+			sdk.NewAttribute(types.AttributeKeyTakerFee, takerFee.String()),
+		),
+	)
+	return nil
+}
+
+// GetProtocolFees returns the taker fees the given pool has sent to its taker fee recipient in total.
+func (k Keeper) GetProtocolFees(ctx sdk.Context, poolId uint64) sdk.Coins {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetKeyPrefixProtocolFees(poolId))
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	protocolFees := sdk.NewCoins()
+	for ; iterator.Valid(); iterator.Next() {
+		var amount sdk.Int
+		if err := amount.Unmarshal(iterator.Value()); err != nil {
+			panic(err)
+		}
+		protocolFees = protocolFees.Add(sdk.NewCoin(string(iterator.Key()), amount))
+	}
+	return protocolFees
+}
+
+func (k Keeper) recordProtocolFee(ctx sdk.Context, poolId uint64, fee sdk.Coin) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetKeyPrefixProtocolFees(poolId))
+	amount := fee.Amount
+	if bz := store.Get([]byte(fee.Denom)); bz != nil {
+		var prevAmount sdk.Int
+		if err := prevAmount.Unmarshal(bz); err != nil {
+			panic(err)
+		}
+		amount = amount.Add(prevAmount)
+	}
+
+	bz, err := amount.Marshal()
+	if err != nil {
+		panic(err)
+	}
+	store.Set([]byte(fee.Denom), bz)
+}
@@ -137,6 +137,17 @@ type PoolParams struct {
 	SwapFee                  github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=swap_fee,json=swapFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"swap_fee" yaml:"swap_fee"`
 	ExitFee                  github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=exit_fee,json=exitFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"exit_fee" yaml:"exit_fee"`
 	SmoothWeightChangeParams *SmoothWeightChangeParams              `protobuf:"bytes,3,opt,name=smooth_weight_change_params,json=smoothWeightChangeParams,proto3" json:"smooth_weight_change_params,omitempty" yaml:"smooth_weight_change_params"`
+	// pool_controller is the address that can start a new smooth weight change
+	// on the pool after its creation, with MsgUpdateSmoothWeightChange.
+	// It is optional, smooth weight changes can always be started by governance.
+	PoolController string `protobuf:"bytes,4,opt,name=pool_controller,json=poolController,proto3" json:"pool_controller,omitempty" yaml:"pool_controller"`
+	// taker_fee_share is the share of the swap fee that is sent to the
+	// taker_fee_recipient instead of the pool's LPs. It is capped by the gamm
+	// max_taker_fee_share param.
+	TakerFeeShare github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=taker_fee_share,json=takerFeeShare,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"taker_fee_share" yaml:"taker_fee_share"`
+	// taker_fee_recipient is the address taker fees are sent to.
+	// If empty, they are sent to the community pool.
+	TakerFeeRecipient string `protobuf:"bytes,6,opt,name=taker_fee_recipient,json=takerFeeRecipient,proto3" json:"taker_fee_recipient,omitempty" yaml:"taker_fee_recipient"`
 }
 
 func (m *PoolParams) Reset()         { *m = PoolParams{} }
@@ -179,6 +190,20 @@ func (m *PoolParams) GetSmoothWeightChangeParams() *SmoothWeightChangeParams {
 	return nil
 }
 
+func (m *PoolParams) GetPoolController() string {
+	if m != nil {
+		return m.PoolController
+	}
+	return ""
+}
+
+func (m *PoolParams) GetTakerFeeRecipient() string {
+	if m != nil {
+		return m.TakerFeeRecipient
+	}
+	return ""
+}
+
 // Pool asset is an internal struct that combines the amount of the
 // token in the pool, and its balancer weight.
 // This is an awkward packaging of data,
@@ -298,59 +323,65 @@ func init() {
 }
 
 var fileDescriptor_7e991f749f68c2a4 = []byte{
-	// 831 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x55, 0x4f, 0x4f, 0xe3, 0x46,
-	0x14, 0x8f, 0x93, 0x40, 0x96, 0xc9, 0x76, 0x2b, 0x66, 0x73, 0x30, 0x41, 0x8d, 0xd1, 0x54, 0xaa,
-	0x56, 0xd5, 0xc6, 0x56, 0x76, 0x7b, 0xda, 0xcb, 0x6a, 0x03, 0xb4, 0xe2, 0x46, 0x4d, 0x25, 0x4a,
-	0x85, 0x64, 0x4d, 0x92, 0x89, 0x6d, 0x61, 0x7b, 0x2c, 0xcf, 0x24, 0xc0, 0x37, 0xe8, 0x91, 0x23,
-	0xbd, 0x71, 0xef, 0xb5, 0xfd, 0x0e, 0xa8, 0xbd, 0x70, 0xac, 0x7a, 0x70, 0x2b, 0xe8, 0xa9, 0xc7,
-	0x7c, 0x82, 0x6a, 0xfe, 0x38, 0x09, 0x34, 0x51, 0x41, 0x3d, 0x65, 0xde, 0x9b, 0xf7, 0x7e, 0xef,
-	0xf7, 0xde, 0xfb, 0x8d, 0x03, 0xbe, 0xa0, 0x2c, 0xa6, 0x2c, 0x64, 0x8e, 0x8f, 0xe3, 0xd8, 0x49,
-	0x29, 0x8d, 0xda, 0x31, 0x1d, 0x90, 0x88, 0x39, 0x3d, 0x1c, 0xe1, 0xa4, 0x4f, 0xb2, 0xe9, 0x61,
-	0x9f, 0xd2, 0xc8, 0x4e, 0x33, 0xca, 0x29, 0x6c, 0xe8, 0x2c, 0x5b, 0x64, 0xd9, 0xe3, 0x4e, 0x8f,
-	0x70, 0xdc, 0x69, 0x6e, 0xf4, 0xa5, 0xdb, 0x93, 0x31, 0x8e, 0x32, 0x54, 0x42, 0xb3, 0xe1, 0x53,
-	0x9f, 0x2a, 0xbf, 0x38, 0x69, 0x6f, 0xcb, 0xa7, 0xd4, 0x8f, 0x88, 0x23, 0xad, 0xde, 0x68, 0xe8,
-	0x0c, 0x46, 0x19, 0xe6, 0x21, 0x4d, 0xf4, 0xbd, 0xf5, 0xf0, 0x9e, 0x87, 0x31, 0x61, 0x1c, 0xc7,
-	0x69, 0x01, 0xa0, 0x8a, 0x38, 0x78, 0xc4, 0x03, 0x47, 0xd3, 0x90, 0xc6, 0x83, 0xfb, 0x1e, 0x66,
-	0x64, 0x7a, 0xdf, 0xa7, 0xa1, 0x2e, 0x80, 0x7e, 0xad, 0x00, 0xf3, 0x20, 0xa6, 0x94, 0x07, 0x87,
-	0x24, 0xf4, 0x03, 0xbe, 0x1d, 0xe0, 0xc4, 0x27, 0xfb, 0x38, 0xc3, 0x31, 0x83, 0xdf, 0x02, 0xc0,
-	0x38, 0xce, 0xb8, 0x27, 0xaa, 0x9a, 0xc6, 0x96, 0xf1, 0xaa, 0xfe, 0xa6, 0x69, 0x2b, 0x4a, 0x76,
-	0x41, 0xc9, 0xfe, 0xa6, 0xa0, 0xd4, 0xfd, 0xe4, 0x3a, 0xb7, 0x4a, 0x93, 0xdc, 0x5a, 0x3f, 0xc7,
-	0x71, 0xf4, 0x0e, 0xcd, 0x72, 0xd1, 0xc5, 0x1f, 0x96, 0xe1, 0xae, 0x49, 0x87, 0x08, 0x87, 0x01,
-	0x78, 0x56, 0x74, 0x6a, 0x96, 0x25, 0xee, 0xc6, 0xbf, 0x70, 0x77, 0x74, 0x40, 0xb7, 0x23, 0x60,
-	0xff, 0xce, 0x2d, 0x58, 0xa4, 0xbc, 0xa6, 0x71, 0xc8, 0x49, 0x9c, 0xf2, 0xf3, 0x49, 0x6e, 0x7d,
-	0xac, 0x8a, 0x15, 0x77, 0xe8, 0x52, 0x94, 0x9a, 0xa2, 0xc3, 0x31, 0x68, 0x84, 0x49, 0xc8, 0x43,
-	0x1c, 0x79, 0x62, 0xb7, 0xde, 0xa9, 0x6c, 0x93, 0x99, 0x95, 0xad, 0xca, 0xab, 0xfa, 0x1b, 0xcb,
-	0x5e, 0xb4, 0x47, 0x5b, 0x2c, 0xfa, 0x03, 0x63, 0x84, 0x77, 0x3f, 0xd5, 0x2d, 0x6d, 0xaa, 0x2a,
-	0x8b, 0xa0, 0x90, 0x0b, 0xb5, 0x5b, 0xa4, 0xa9, 0x31, 0x32, 0xc8, 0xc0, 0x4b, 0x8e, 0x33, 0x9f,
-	0xf0, 0xfb, 0x65, 0xab, 0x8f, 0x2b, 0x8b, 0x74, 0xd9, 0xa6, 0x2a, 0xbb, 0x00, 0x09, 0xb9, 0xeb,
-	0xca, 0x3b, 0x57, 0x14, 0xfd, 0x55, 0x06, 0x40, 0xd8, 0x7a, 0x7f, 0xc7, 0xe0, 0x19, 0x3b, 0xc5,
-	0xa9, 0x37, 0x24, 0x6a, 0x7b, 0x6b, 0xdd, 0x0f, 0x02, 0xf7, 0xf7, 0xdc, 0xfa, 0xcc, 0x0f, 0x79,
-	0x30, 0xea, 0xd9, 0x7d, 0x1a, 0x6b, 0x99, 0xea, 0x9f, 0x36, 0x1b, 0x9c, 0x38, 0xfc, 0x3c, 0x25,
-	0xcc, 0xde, 0x21, 0xfd, 0xd9, 0x78, 0x0b, 0x1c, 0xe4, 0xd6, 0xc4, 0xf1, 0x4b, 0x42, 0x04, 0x3a,
-	0x39, 0x0b, 0xb9, 0x44, 0x2f, 0xff, 0x3f, 0xf4, 0x02, 0x07, 0xb9, 0x35, 0x71, 0x14, 0xe8, 0x3f,
-	0x18, 0x60, 0x93, 0x49, 0x61, 0xea, 0x8e, 0xbd, 0xbe, 0x94, 0xa6, 0x97, 0xca, 0xde, 0xcc, 0x8a,
-	0x54, 0x8d, 0xbd, 0x78, 0x90, 0xcb, 0x14, 0xdd, 0xfd, 0xfc, 0x3a, 0xb7, 0x8c, 0x49, 0x6e, 0x21,
-	0xdd, 0xd5, 0xf2, 0x02, 0xc8, 0x35, 0xd9, 0x12, 0x14, 0xf4, 0xa3, 0x01, 0xd6, 0xa6, 0xbb, 0x82,
-	0xbb, 0x60, 0x85, 0xd3, 0x13, 0x92, 0xe8, 0x07, 0xb2, 0x61, 0xeb, 0x77, 0x2f, 0x9e, 0xdc, 0x94,
-	0xd1, 0x36, 0x0d, 0x93, 0x6e, 0x43, 0x6f, 0xf5, 0xb9, 0xde, 0xaa, 0xc8, 0x42, 0xae, 0xca, 0x86,
-	0x87, 0x60, 0x55, 0xf1, 0xd0, 0xc3, 0x7c, 0xff, 0x84, 0x61, 0xee, 0x25, 0x7c, 0x92, 0x5b, 0x1f,
-	0x29, 0x58, 0x85, 0x82, 0x5c, 0x0d, 0x87, 0x7e, 0xae, 0x82, 0xaa, 0x60, 0x0b, 0x5f, 0x83, 0x1a,
-	0x1e, 0x0c, 0x32, 0xc2, 0x98, 0x56, 0x03, 0x9c, 0xe4, 0xd6, 0x0b, 0x95, 0xa4, 0x2f, 0x90, 0x5b,
-	0x84, 0xc0, 0x17, 0xa0, 0x1c, 0x0e, 0x24, 0x97, 0xaa, 0x5b, 0x0e, 0x07, 0x70, 0x08, 0xea, 0x52,
-	0x7f, 0xf7, 0xe6, 0xbf, 0xb5, 0x5c, 0xc8, 0x7a, 0xe2, 0x0f, 0x1e, 0x50, 0xf1, 0x29, 0xf5, 0xe6,
-	0xb0, 0x90, 0x0b, 0xd2, 0x99, 0x68, 0xbf, 0x06, 0x8d, 0xe1, 0x88, 0x8f, 0x32, 0xa2, 0x42, 0x7c,
-	0x3a, 0x26, 0x59, 0x42, 0x33, 0xb3, 0x2a, 0x29, 0x5b, 0x33, 0xa8, 0x45, 0x51, 0xc8, 0x85, 0xca,
-	0x2d, 0x18, 0x7c, 0xa5, 0x9d, 0xf0, 0x08, 0x3c, 0xe7, 0x94, 0xe3, 0xc8, 0x63, 0x01, 0xce, 0x08,
-	0x33, 0x57, 0xfe, 0x6b, 0x51, 0x9b, 0x9a, 0xf4, 0xcb, 0x62, 0x51, 0xb3, 0x64, 0xe4, 0xd6, 0xa5,
-	0x79, 0x20, 0x2d, 0x78, 0xac, 0xa7, 0x82, 0x85, 0x14, 0x98, 0xb9, 0xfa, 0xb8, 0xe7, 0xdd, 0xd4,
-	0xf8, 0x50, 0xe1, 0xcf, 0x21, 0xe8, 0x59, 0xc8, 0x30, 0x06, 0x83, 0x82, 0xb8, 0x56, 0x46, 0x4d,
-	0xce, 0x60, 0xf7, 0xc9, 0xca, 0xb8, 0xd7, 0x47, 0xa1, 0x0f, 0xd5, 0x87, 0x92, 0xf7, 0xbb, 0xf5,
-	0xef, 0xaf, 0xac, 0xd2, 0xe5, 0x95, 0x55, 0xfa, 0xe5, 0xa7, 0xf6, 0x8a, 0x20, 0xba, 0xd7, 0x3d,
-	0xba, 0xbe, 0x6d, 0x19, 0x37, 0xb7, 0x2d, 0xe3, 0xcf, 0xdb, 0x96, 0x71, 0x71, 0xd7, 0x2a, 0xdd,
-	0xdc, 0xb5, 0x4a, 0xbf, 0xdd, 0xb5, 0x4a, 0xdf, 0xbd, 0x9f, 0x2b, 0xac, 0x3b, 0x6d, 0x47, 0xb8,
-	0xc7, 0x0a, 0xc3, 0x19, 0x77, 0xde, 0x3a, 0x67, 0xcb, 0xff, 0x50, 0x7b, 0xab, 0xf2, 0x23, 0xff,
-	0xf6, 0x9f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x9d, 0xba, 0x2b, 0xb9, 0x7c, 0x07, 0x00, 0x00,
+	// 919 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0x4d, 0x6f, 0xdb, 0x36,
+	0x18, 0xb6, 0x12, 0x27, 0x69, 0x98, 0x36, 0x41, 0x18, 0xa3, 0x50, 0x1c, 0xcc, 0x0a, 0x38, 0x60,
+	0x28, 0x86, 0x46, 0x42, 0xda, 0x9d, 0x7a, 0x29, 0xea, 0xb4, 0xdd, 0x7a, 0x19, 0x3a, 0x76, 0x40,
+	0xd7, 0x61, 0x80, 0x40, 0xdb, 0x8c, 0x44, 0x44, 0x12, 0x05, 0x91, 0x4e, 0x9b, 0x7f, 0xb0, 0x63,
+	0x8f, 0xdd, 0xad, 0xf7, 0x5d, 0xb7, 0xff, 0x10, 0x6c, 0x97, 0x1e, 0x87, 0x1d, 0xb4, 0x21, 0xb9,
+	0x6d, 0x37, 0xff, 0x82, 0x81, 0x5f, 0xb6, 0x93, 0xda, 0x58, 0x83, 0x9e, 0x4c, 0xbe, 0x7c, 0xdf,
+	0xe7, 0x79, 0x3f, 0x1e, 0x52, 0x06, 0x5f, 0x70, 0x91, 0x73, 0xc1, 0x44, 0x94, 0x90, 0x3c, 0x8f,
+	0x4a, 0xce, 0xb3, 0xbd, 0x9c, 0x0f, 0x68, 0x26, 0xa2, 0x1e, 0xc9, 0x48, 0xd1, 0xa7, 0xd5, 0x78,
+	0xf1, 0x94, 0xf3, 0x2c, 0x2c, 0x2b, 0x2e, 0x39, 0x6c, 0xd9, 0xa8, 0x50, 0x45, 0x85, 0xc7, 0xfb,
+	0x3d, 0x2a, 0xc9, 0x7e, 0x7b, 0xbb, 0xaf, 0xcd, 0xb1, 0xf6, 0x89, 0xcc, 0xc6, 0x04, 0xb4, 0x5b,
+	0x09, 0x4f, 0xb8, 0xb1, 0xab, 0x95, 0xb5, 0x76, 0x12, 0xce, 0x93, 0x8c, 0x46, 0x7a, 0xd7, 0x1b,
+	0x1e, 0x46, 0x83, 0x61, 0x45, 0x24, 0xe3, 0x85, 0x3d, 0x0f, 0x2e, 0x9f, 0x4b, 0x96, 0x53, 0x21,
+	0x49, 0x5e, 0x3a, 0x00, 0x43, 0x12, 0x91, 0xa1, 0x4c, 0x23, 0x9b, 0x86, 0xde, 0x5c, 0x3a, 0xef,
+	0x11, 0x41, 0xc7, 0xe7, 0x7d, 0xce, 0x2c, 0x01, 0xfa, 0x7d, 0x11, 0xf8, 0xcf, 0x72, 0xce, 0x65,
+	0xfa, 0x9c, 0xb2, 0x24, 0x95, 0x07, 0x29, 0x29, 0x12, 0xfa, 0x94, 0x54, 0x24, 0x17, 0xf0, 0x3b,
+	0x00, 0x84, 0x24, 0x95, 0x8c, 0x15, 0xab, 0xef, 0xed, 0x7a, 0xb7, 0xd6, 0xee, 0xb4, 0x43, 0x93,
+	0x52, 0xe8, 0x52, 0x0a, 0xbf, 0x75, 0x29, 0x75, 0x3f, 0x39, 0xad, 0x83, 0xc6, 0xa8, 0x0e, 0x36,
+	0x4f, 0x48, 0x9e, 0xdd, 0x43, 0x93, 0x58, 0xf4, 0xfa, 0xaf, 0xc0, 0xc3, 0xab, 0xda, 0xa0, 0xdc,
+	0x61, 0x0a, 0xae, 0xb9, 0x4a, 0xfd, 0x05, 0x8d, 0xbb, 0xfd, 0x1e, 0xee, 0x43, 0xeb, 0xd0, 0xdd,
+	0x57, 0xb0, 0xff, 0xd4, 0x01, 0x74, 0x21, 0xb7, 0x79, 0xce, 0x24, 0xcd, 0x4b, 0x79, 0x32, 0xaa,
+	0x83, 0x0d, 0x43, 0xe6, 0xce, 0xd0, 0x1b, 0x45, 0x35, 0x46, 0x87, 0xc7, 0xa0, 0xc5, 0x0a, 0x26,
+	0x19, 0xc9, 0x62, 0x35, 0xdb, 0xf8, 0xa5, 0x2e, 0x53, 0xf8, 0x8b, 0xbb, 0x8b, 0xb7, 0xd6, 0xee,
+	0x04, 0xe1, 0xac, 0x39, 0x86, 0x6a, 0xd0, 0x0f, 0x84, 0xa0, 0xb2, 0xfb, 0xa9, 0x2d, 0x69, 0xc7,
+	0xb0, 0xcc, 0x82, 0x42, 0x18, 0x5a, 0xb3, 0x0a, 0x33, 0x6d, 0x14, 0x50, 0x80, 0x2d, 0x49, 0xaa,
+	0x84, 0xca, 0x8b, 0xb4, 0xcd, 0x0f, 0xa3, 0x45, 0x96, 0xb6, 0x6d, 0x68, 0x67, 0x20, 0x21, 0xbc,
+	0x69, 0xac, 0x53, 0xa4, 0xe8, 0xdf, 0x26, 0x00, 0x6a, 0x6f, 0xe7, 0xf7, 0x03, 0xb8, 0x26, 0x5e,
+	0x92, 0x32, 0x3e, 0xa4, 0x66, 0x7a, 0xab, 0xdd, 0x07, 0x0a, 0xf7, 0xcf, 0x3a, 0xf8, 0x2c, 0x61,
+	0x32, 0x1d, 0xf6, 0xc2, 0x3e, 0xcf, 0xad, 0x4c, 0xed, 0xcf, 0x9e, 0x18, 0x1c, 0x45, 0xf2, 0xa4,
+	0xa4, 0x22, 0x7c, 0x48, 0xfb, 0x93, 0xf6, 0x3a, 0x1c, 0x84, 0x57, 0xd4, 0xf2, 0x31, 0xa5, 0x0a,
+	0x9d, 0xbe, 0x62, 0x52, 0xa3, 0x2f, 0x7c, 0x1c, 0xba, 0xc3, 0x41, 0x78, 0x45, 0x2d, 0x15, 0xfa,
+	0x4f, 0x1e, 0xd8, 0x11, 0x5a, 0x98, 0xb6, 0xe2, 0xb8, 0xaf, 0xa5, 0x19, 0x97, 0xba, 0x36, 0x7f,
+	0x51, 0xab, 0x26, 0x9c, 0xdd, 0xc8, 0x79, 0x8a, 0xee, 0x7e, 0x7e, 0x5a, 0x07, 0xde, 0xa8, 0x0e,
+	0x90, 0xad, 0x6a, 0x3e, 0x01, 0xc2, 0xbe, 0x98, 0x77, 0x2f, 0x0e, 0xc0, 0x86, 0x1e, 0x45, 0x9f,
+	0x17, 0xb2, 0xe2, 0x59, 0x46, 0x2b, 0xbf, 0xa9, 0x1b, 0xd0, 0x1e, 0xd5, 0xc1, 0x4d, 0x03, 0x7d,
+	0xc9, 0x01, 0xe1, 0x75, 0x65, 0x39, 0x18, 0x1b, 0x60, 0x09, 0x36, 0x24, 0x39, 0xa2, 0x95, 0xaa,
+	0x3b, 0x16, 0x29, 0xa9, 0xa8, 0xbf, 0xa4, 0x41, 0xbe, 0xba, 0x72, 0x17, 0x6f, 0x3a, 0x95, 0x5c,
+	0x80, 0x43, 0xf8, 0x86, 0xb6, 0x3c, 0xa6, 0xf4, 0x99, 0xda, 0xc3, 0xaf, 0xc1, 0xd6, 0xc4, 0xa5,
+	0xa2, 0x7d, 0x56, 0x32, 0x5a, 0x48, 0x7f, 0x59, 0xb3, 0x76, 0xa6, 0xd5, 0xf6, 0x9e, 0x93, 0x56,
+	0x9b, 0xc1, 0xc2, 0x63, 0xdb, 0xcf, 0x1e, 0x58, 0x1d, 0x4b, 0x16, 0x3e, 0x02, 0x4b, 0x92, 0x1f,
+	0xd1, 0xc2, 0xbe, 0x13, 0xdb, 0xa1, 0x7d, 0xfe, 0xd4, 0xcb, 0x33, 0x1e, 0xcc, 0x01, 0x67, 0x45,
+	0xb7, 0x65, 0xc5, 0x7d, 0xdd, 0xd2, 0xa9, 0x28, 0x84, 0x4d, 0x34, 0x7c, 0x0e, 0x96, 0xcd, 0x38,
+	0xac, 0xa6, 0xee, 0x5f, 0xa1, 0x1b, 0x4f, 0x0a, 0x39, 0xaa, 0x83, 0x1b, 0x06, 0xd6, 0xa0, 0x20,
+	0x6c, 0xe1, 0xd0, 0xaf, 0x4d, 0xd0, 0x54, 0xd9, 0xc2, 0xdb, 0x60, 0x85, 0x0c, 0x06, 0x15, 0x15,
+	0xc2, 0x5e, 0x0a, 0x38, 0xaa, 0x83, 0x75, 0x13, 0x64, 0x0f, 0x10, 0x76, 0x2e, 0x70, 0x1d, 0x2c,
+	0xb0, 0x81, 0xce, 0xa5, 0x89, 0x17, 0xd8, 0x00, 0x1e, 0x82, 0x35, 0x3d, 0xda, 0x0b, 0x32, 0xdc,
+	0x9d, 0x7f, 0x9f, 0xad, 0xf0, 0x2e, 0xbd, 0x23, 0xee, 0x8b, 0x12, 0x4f, 0x61, 0x21, 0x0c, 0xca,
+	0xc9, 0xdd, 0xfd, 0x06, 0xb4, 0x0e, 0x87, 0x72, 0x58, 0x51, 0xe3, 0x92, 0xf0, 0x63, 0x5a, 0x15,
+	0xdc, 0x09, 0x2d, 0x98, 0x40, 0xcd, 0xf2, 0x42, 0x18, 0x1a, 0xb3, 0xca, 0xe0, 0x4b, 0x6b, 0x84,
+	0x2f, 0xc0, 0x75, 0xc9, 0x25, 0xc9, 0x8c, 0x3c, 0x84, 0xbf, 0xf4, 0x7f, 0x83, 0xda, 0xb1, 0x49,
+	0x6f, 0xb9, 0x41, 0x4d, 0x82, 0x11, 0x5e, 0xd3, 0x5b, 0xad, 0x2c, 0xf5, 0xd2, 0x98, 0xae, 0x10,
+	0x25, 0x05, 0xe1, 0x2f, 0x7f, 0xd8, 0x2b, 0xd7, 0xb6, 0xf8, 0x70, 0xea, 0xca, 0x18, 0x04, 0xdb,
+	0x0b, 0xed, 0x26, 0x60, 0xea, 0x12, 0xb7, 0xca, 0x58, 0xd1, 0x3d, 0x78, 0x74, 0x65, 0x65, 0x5c,
+	0xa8, 0xc3, 0xe9, 0xc3, 0xd4, 0x61, 0x6e, 0xf9, 0xbd, 0xcd, 0x1f, 0xdf, 0x06, 0x8d, 0x37, 0x6f,
+	0x83, 0xc6, 0x6f, 0xbf, 0xec, 0x2d, 0xa9, 0x44, 0x9f, 0x74, 0x5f, 0x9c, 0x9e, 0x75, 0xbc, 0x77,
+	0x67, 0x1d, 0xef, 0xef, 0xb3, 0x8e, 0xf7, 0xfa, 0xbc, 0xd3, 0x78, 0x77, 0xde, 0x69, 0xfc, 0x71,
+	0xde, 0x69, 0x7c, 0x7f, 0x7f, 0x8a, 0xd8, 0x56, 0xba, 0x97, 0x91, 0x9e, 0x70, 0x9b, 0xe8, 0x78,
+	0xff, 0x6e, 0xf4, 0x6a, 0xfe, 0xff, 0x8a, 0xde, 0xb2, 0xfe, 0xd6, 0xdd, 0xfd, 0x6f, 0x00, 0xce,
+	0xd1, 0x2f, 0x8b, 0x83, 0x08, 0x00, 0x00,
 }
 
 func (m *SmoothWeightChangeParams) Marshal() (dAtA []byte, err error) {
@@ -440,6 +471,30 @@ func (m *PoolParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.TakerFeeRecipient) > 0 {
+		i -= len(m.TakerFeeRecipient)
+		copy(dAtA[i:], m.TakerFeeRecipient)
+		i = encodeVarintBalancerPool(dAtA, i, uint64(len(m.TakerFeeRecipient)))
+		i--
+		dAtA[i] = 0x32
+	}
+	{
+		size := m.TakerFeeShare.Size()
+		i -= size
+		if _, err := m.TakerFeeShare.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintBalancerPool(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.PoolController) > 0 {
+		i -= len(m.PoolController)
+		copy(dAtA[i:], m.PoolController)
+		i = encodeVarintBalancerPool(dAtA, i, uint64(len(m.PoolController)))
+		i--
+		dAtA[i] = 0x22
+	}
 	if m.SmoothWeightChangeParams != nil {
 		{
 			size, err := m.SmoothWeightChangeParams.MarshalToSizedBuffer(dAtA[:i])
@@ -654,6 +709,16 @@ func (m *PoolParams) Size() (n int) {
 		l = m.SmoothWeightChangeParams.Size()
 		n += 1 + l + sovBalancerPool(uint64(l))
 	}
+	l = len(m.PoolController)
+	if l > 0 {
+		n += 1 + l + sovBalancerPool(uint64(l))
+	}
+	l = m.TakerFeeShare.Size()
+	n += 1 + l + sovBalancerPool(uint64(l))
+	l = len(m.TakerFeeRecipient)
+	if l > 0 {
+		n += 1 + l + sovBalancerPool(uint64(l))
+	}
 	return n
 }
 
@@ -1025,6 +1090,104 @@ func (m *PoolParams) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolController", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBalancerPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBalancerPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBalancerPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PoolController = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TakerFeeShare", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBalancerPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBalancerPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBalancerPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TakerFeeShare.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TakerFeeRecipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBalancerPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBalancerPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBalancerPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TakerFeeRecipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBalancerPool(dAtA[iNdEx:])
@@ -8,6 +8,7 @@ import (
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/msgservice"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	proto "github.com/gogo/protobuf/proto"
 )
 
@@ -17,6 +18,8 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&Pool{}, "osmosis/gamm/BalancerPool", nil)
 	cdc.RegisterConcrete(&MsgCreateBalancerPool{}, "osmosis/gamm/create-balancer-pool", nil)
 	cdc.RegisterConcrete(&PoolParams{}, "osmosis/gamm/BalancerPoolParams", nil)
+	cdc.RegisterConcrete(&MsgUpdateSmoothWeightChange{}, "osmosis/gamm/update-smooth-weight-change", nil)
+	cdc.RegisterConcrete(&UpdateSmoothWeightChangeProposal{}, "osmosis/UpdateSmoothWeightChangeProposal", nil)
 }
 
 func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
@@ -33,6 +36,11 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 	registry.RegisterImplementations(
 		(*sdk.Msg)(nil),
 		&MsgCreateBalancerPool{},
+		&MsgUpdateSmoothWeightChange{},
+	)
+	registry.RegisterImplementations(
+		(*govtypes.Content)(nil),
+		&UpdateSmoothWeightChangeProposal{},
 	)
 	registry.RegisterImplementations(
 		(*proto.Message)(nil),
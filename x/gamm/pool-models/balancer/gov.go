@@ -0,0 +1,60 @@
+package balancer
+
+import (
+	"fmt"
+	"strings"
+
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/osmosis-labs/osmosis/v13/x/gamm/types"
+)
+
+const (
+	ProposalTypeUpdateSmoothWeightChange = "UpdateSmoothWeightChange"
+)
+
+func init() {
+	govtypes.RegisterProposalType(ProposalTypeUpdateSmoothWeightChange)
+	govtypes.RegisterProposalTypeCodec(&UpdateSmoothWeightChangeProposal{}, "osmosis/UpdateSmoothWeightChangeProposal")
+}
+
+var _ govtypes.Content = &UpdateSmoothWeightChangeProposal{}
+
+func NewUpdateSmoothWeightChangeProposal(title, description string, poolId uint64, params SmoothWeightChangeParams) UpdateSmoothWeightChangeProposal {
+	return UpdateSmoothWeightChangeProposal{
+		Title:                    title,
+		Description:              description,
+		PoolId:                   poolId,
+		SmoothWeightChangeParams: params,
+	}
+}
+
+func (p *UpdateSmoothWeightChangeProposal) GetTitle() string { return p.Title }
+
+func (p *UpdateSmoothWeightChangeProposal) GetDescription() string { return p.Description }
+
+func (p *UpdateSmoothWeightChangeProposal) ProposalRoute() string { return types.RouterKey }
+
+func (p *UpdateSmoothWeightChangeProposal) ProposalType() string {
+	return ProposalTypeUpdateSmoothWeightChange
+}
+
+func (p *UpdateSmoothWeightChangeProposal) ValidateBasic() error {
+	err := govtypes.ValidateAbstract(p)
+	if err != nil {
+		return err
+	}
+
+	return validateUserSpecifiedSmoothWeightChange(p.SmoothWeightChangeParams)
+}
+
+func (p UpdateSmoothWeightChangeProposal) String() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Update Smooth Weight Change Proposal:
+  Title:       %s
+  Description: %s
+  Pool ID:     %d
+  Params:      %s
+`, p.Title, p.Description, p.PoolId, p.SmoothWeightChangeParams.String()))
+	return b.String()
+}
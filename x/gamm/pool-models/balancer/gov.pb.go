@@ -0,0 +1,445 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: osmosis/gamm/pool-models/balancer/tx/gov.proto
+
+package balancer
+
+import (
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// UpdateSmoothWeightChangeProposal is a gov Content type for starting a new
+// smooth weight change of a balancer pool's weights from their current
+// values, replacing any ongoing one. Unlike MsgUpdateSmoothWeightChange, it
+// does not require the pool to have a pool controller.
+type UpdateSmoothWeightChangeProposal struct {
+	Title                    string                   `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty" yaml:"title"`
+	Description              string                   `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty" yaml:"description"`
+	PoolId                   uint64                   `protobuf:"varint,3,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+	SmoothWeightChangeParams SmoothWeightChangeParams `protobuf:"bytes,4,opt,name=smooth_weight_change_params,json=smoothWeightChangeParams,proto3" json:"smooth_weight_change_params" yaml:"smooth_weight_change_params"`
+}
+
+func (m *UpdateSmoothWeightChangeProposal) Reset()      { *m = UpdateSmoothWeightChangeProposal{} }
+func (*UpdateSmoothWeightChangeProposal) ProtoMessage() {}
+func (*UpdateSmoothWeightChangeProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_57dea57390459272, []int{0}
+}
+func (m *UpdateSmoothWeightChangeProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UpdateSmoothWeightChangeProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpdateSmoothWeightChangeProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UpdateSmoothWeightChangeProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateSmoothWeightChangeProposal.Merge(m, src)
+}
+func (m *UpdateSmoothWeightChangeProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *UpdateSmoothWeightChangeProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateSmoothWeightChangeProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateSmoothWeightChangeProposal proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*UpdateSmoothWeightChangeProposal)(nil), "osmosis.gamm.poolmodels.balancer.v1beta1.UpdateSmoothWeightChangeProposal")
+}
+
+func init() {
+	proto.RegisterFile("osmosis/gamm/pool-models/balancer/tx/gov.proto", fileDescriptor_57dea57390459272)
+}
+
+var fileDescriptor_57dea57390459272 = []byte{
+	// 381 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x91, 0xc1, 0xca, 0xd3, 0x40,
+	0x14, 0x85, 0x33, 0xbf, 0xbf, 0x55, 0x53, 0x11, 0x09, 0x22, 0xa1, 0x42, 0x12, 0xb2, 0x90, 0xa0,
+	0x74, 0x86, 0x5a, 0x17, 0xd2, 0x8d, 0x10, 0x57, 0xee, 0x4a, 0x44, 0x44, 0x37, 0x61, 0x92, 0x0c,
+	0x49, 0x60, 0xa6, 0x37, 0x64, 0xc6, 0x5a, 0xdf, 0xc0, 0xa5, 0x4b, 0xdd, 0xf5, 0x71, 0xba, 0xec,
+	0x4e, 0x57, 0x41, 0xda, 0x37, 0xc8, 0x13, 0x48, 0x26, 0x29, 0x54, 0xb0, 0xba, 0xbb, 0x33, 0xf7,
+	0x3b, 0x73, 0xce, 0xdc, 0x6b, 0x62, 0x90, 0x02, 0x64, 0x29, 0x49, 0x4e, 0x85, 0x20, 0x15, 0x00,
+	0x9f, 0x0a, 0xc8, 0x18, 0x97, 0x24, 0xa1, 0x9c, 0xae, 0x52, 0x56, 0x13, 0xb5, 0x21, 0x39, 0xac,
+	0x71, 0x55, 0x83, 0x02, 0x2b, 0x18, 0x78, 0xdc, 0xf1, 0xb8, 0xe3, 0x7b, 0x1c, 0x9f, 0x70, 0xbc,
+	0x9e, 0x25, 0x4c, 0xd1, 0xd9, 0xe4, 0x41, 0x0e, 0x39, 0x68, 0x11, 0xe9, 0xaa, 0x5e, 0x3f, 0x79,
+	0xfe, 0x7f, 0xbf, 0x53, 0xb1, 0x04, 0xe0, 0xbd, 0xca, 0xff, 0x71, 0x65, 0x7a, 0x6f, 0xab, 0x8c,
+	0x2a, 0xf6, 0x46, 0x00, 0xa8, 0xe2, 0x1d, 0x2b, 0xf3, 0x42, 0xbd, 0x2a, 0xe8, 0x2a, 0x67, 0xcb,
+	0x1a, 0x2a, 0x90, 0x94, 0x5b, 0x8f, 0xcd, 0x9b, 0xaa, 0x54, 0x9c, 0xd9, 0xc8, 0x43, 0xc1, 0x9d,
+	0xf0, 0x7e, 0xdb, 0xb8, 0x77, 0x3f, 0x53, 0xc1, 0x17, 0xbe, 0xbe, 0xf6, 0xa3, 0xbe, 0x6d, 0xbd,
+	0x30, 0xc7, 0x19, 0x93, 0x69, 0x5d, 0x56, 0xaa, 0x84, 0x95, 0x7d, 0xa5, 0xe9, 0x87, 0x6d, 0xe3,
+	0x5a, 0x3d, 0x7d, 0xd6, 0xf4, 0xa3, 0x73, 0xd4, 0x7a, 0x6a, 0xde, 0xea, 0x12, 0xc7, 0x65, 0x66,
+	0xdf, 0xf0, 0x50, 0x70, 0x1d, 0x5a, 0x6d, 0xe3, 0xde, 0xeb, 0x55, 0x43, 0xc3, 0x8f, 0x46, 0x5d,
+	0xf5, 0x3a, 0xb3, 0xbe, 0x23, 0xf3, 0x91, 0xd4, 0x69, 0xe3, 0x4f, 0x3a, 0x6e, 0x9c, 0xea, 0xbc,
+	0x71, 0x45, 0x6b, 0x2a, 0xa4, 0x7d, 0xed, 0xa1, 0x60, 0xfc, 0x0c, 0xe3, 0x3f, 0x06, 0x3a, 0x0c,
+	0x0f, 0xff, 0xe5, 0x9b, 0x5a, 0x15, 0x3e, 0xd9, 0x35, 0xae, 0xd1, 0x36, 0xae, 0xdf, 0xbb, 0xfe,
+	0xc3, 0xc0, 0x8f, 0x6c, 0x79, 0xe1, 0x95, 0xc5, 0xed, 0x2f, 0x5b, 0xd7, 0xf8, 0xb6, 0x75, 0x8d,
+	0xf0, 0xfd, 0xee, 0xe0, 0xa0, 0xfd, 0xc1, 0x41, 0xbf, 0x0e, 0x0e, 0xfa, 0x7a, 0x74, 0x8c, 0xfd,
+	0xd1, 0x31, 0x7e, 0x1e, 0x1d, 0xe3, 0xc3, 0xcb, 0xbc, 0x54, 0xc5, 0xc7, 0x04, 0xa7, 0x20, 0xc8,
+	0x90, 0x71, 0xca, 0x69, 0x22, 0x4f, 0x07, 0xb2, 0x9e, 0xcd, 0xc9, 0xe6, 0xf2, 0x1e, 0x93, 0x91,
+	0xde, 0xdd, 0xfc, 0xf7, 0x00, 0xa7, 0xe8, 0x43, 0xe9, 0x63, 0x02, 0x00, 0x00,
+}
+
+func (m *UpdateSmoothWeightChangeProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateSmoothWeightChangeProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdateSmoothWeightChangeProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.SmoothWeightChangeParams.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGov(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.PoolId != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGov(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGov(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *UpdateSmoothWeightChangeProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovGov(uint64(m.PoolId))
+	}
+	l = m.SmoothWeightChangeParams.Size()
+	n += 1 + l + sovGov(uint64(l))
+	return n
+}
+
+func sovGov(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGov(x uint64) (n int) {
+	return sovGov(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *UpdateSmoothWeightChangeProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdateSmoothWeightChangeProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdateSmoothWeightChangeProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SmoothWeightChangeParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SmoothWeightChangeParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipGov(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthGov
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupGov
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthGov
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthGov        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowGov          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupGov = fmt.Errorf("proto: unexpected end of group")
+)
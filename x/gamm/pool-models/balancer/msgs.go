@@ -9,7 +9,8 @@ import (
 )
 
 const (
-	TypeMsgCreateBalancerPool = "create_balancer_pool"
+	TypeMsgCreateBalancerPool       = "create_balancer_pool"
+	TypeMsgUpdateSmoothWeightChange = "update_smooth_weight_change"
 )
 
 var (
@@ -103,3 +104,70 @@ func (msg MsgCreateBalancerPool) CreatePool(ctx sdk.Context, poolID uint64) (swa
 func (msg MsgCreateBalancerPool) GetPoolType() swaproutertypes.PoolType {
 	return swaproutertypes.Balancer
 }
+
+var _ sdk.Msg = &MsgUpdateSmoothWeightChange{}
+
+func NewMsgUpdateSmoothWeightChange(
+	sender sdk.AccAddress,
+	poolId uint64,
+	smoothWeightChangeParams SmoothWeightChangeParams,
+) MsgUpdateSmoothWeightChange {
+	return MsgUpdateSmoothWeightChange{
+		Sender:                   sender.String(),
+		PoolID:                   poolId,
+		SmoothWeightChangeParams: smoothWeightChangeParams,
+	}
+}
+
+func (msg MsgUpdateSmoothWeightChange) Route() string { return types.RouterKey }
+func (msg MsgUpdateSmoothWeightChange) Type() string  { return TypeMsgUpdateSmoothWeightChange }
+func (msg MsgUpdateSmoothWeightChange) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	return validateUserSpecifiedSmoothWeightChange(msg.SmoothWeightChangeParams)
+}
+
+func (msg MsgUpdateSmoothWeightChange) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgUpdateSmoothWeightChange) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+// validateUserSpecifiedSmoothWeightChange does the stateless checks of a smooth weight change
+// that starts on an existing pool. Whether the target weights match the pool's assets is
+// checked against the pool.
+func validateUserSpecifiedSmoothWeightChange(params SmoothWeightChangeParams) error {
+	if params.Duration <= 0 {
+		return sdkerrors.Wrap(types.ErrInvalidSmoothWeightChange, "smooth weight change must have a positive duration")
+	}
+
+	if len(params.TargetPoolWeights) == 0 {
+		return sdkerrors.Wrap(types.ErrInvalidSmoothWeightChange, "smooth weight change must have target pool weights")
+	}
+
+	denoms := make(map[string]bool, len(params.TargetPoolWeights))
+	for _, asset := range params.TargetPoolWeights {
+		if err := sdk.ValidateDenom(asset.Token.Denom); err != nil {
+			return err
+		}
+		if denoms[asset.Token.Denom] {
+			return sdkerrors.Wrapf(types.ErrInvalidSmoothWeightChange, "duplicate target weight denom %s", asset.Token.Denom)
+		}
+		denoms[asset.Token.Denom] = true
+
+		if err := ValidateUserSpecifiedWeight(asset.Weight); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
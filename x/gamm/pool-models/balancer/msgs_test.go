@@ -2,6 +2,7 @@ package balancer_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -209,6 +210,47 @@ func TestMsgCreateBalancerPool(t *testing.T) {
 			}),
 			expectPass: false,
 		},
+		{
+			name: "with pool controller",
+			msg: createMsg(func(msg balancer.MsgCreateBalancerPool) balancer.MsgCreateBalancerPool {
+				msg.PoolParams.PoolController = addr1
+				return msg
+			}),
+			expectPass: true,
+		},
+		{
+			name: "invalid pool controller",
+			msg: createMsg(func(msg balancer.MsgCreateBalancerPool) balancer.MsgCreateBalancerPool {
+				msg.PoolParams.PoolController = "invalid"
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "with taker fee share and recipient",
+			msg: createMsg(func(msg balancer.MsgCreateBalancerPool) balancer.MsgCreateBalancerPool {
+				msg.PoolParams.TakerFeeShare = sdk.NewDecWithPrec(5, 1)
+				msg.PoolParams.TakerFeeRecipient = addr1
+				return msg
+			}),
+			expectPass: true,
+		},
+		{
+			name: "negative taker fee share",
+			msg: createMsg(func(msg balancer.MsgCreateBalancerPool) balancer.MsgCreateBalancerPool {
+				msg.PoolParams.TakerFeeShare = sdk.NewDecWithPrec(-1, 2)
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "invalid taker fee recipient",
+			msg: createMsg(func(msg balancer.MsgCreateBalancerPool) balancer.MsgCreateBalancerPool {
+				msg.PoolParams.TakerFeeRecipient = "invalid"
+				return msg
+			}),
+			expectPass: false,
+		},
 		// {
 		// 	name: "Create an LBP",
 		// 	msg: createMsg(func(msg balancer.MsgCreateBalancerPool) balancer.MsgCreateBalancerPool {
@@ -240,3 +282,105 @@ func TestMsgCreateBalancerPool(t *testing.T) {
 		}
 	}
 }
+
+func TestMsgUpdateSmoothWeightChange(t *testing.T) {
+	appParams.SetAddressPrefixes()
+	pk1 := ed25519.GenPrivKey().PubKey()
+	addr1 := sdk.AccAddress(pk1.Address())
+
+	createMsg := func(after func(msg balancer.MsgUpdateSmoothWeightChange) balancer.MsgUpdateSmoothWeightChange) balancer.MsgUpdateSmoothWeightChange {
+		msg := balancer.NewMsgUpdateSmoothWeightChange(addr1, 1, balancer.SmoothWeightChangeParams{
+			Duration: time.Hour,
+			TargetPoolWeights: []balancer.PoolAsset{
+				{
+					Weight: sdk.NewInt(200),
+					Token:  sdk.NewCoin("test", sdk.ZeroInt()),
+				},
+				{
+					Weight: sdk.NewInt(50),
+					Token:  sdk.NewCoin("test2", sdk.ZeroInt()),
+				},
+			},
+		})
+
+		return after(msg)
+	}
+
+	defaultMsg := createMsg(func(msg balancer.MsgUpdateSmoothWeightChange) balancer.MsgUpdateSmoothWeightChange {
+		return msg
+	})
+
+	require.Equal(t, defaultMsg.Route(), types.RouterKey)
+	require.Equal(t, defaultMsg.Type(), "update_smooth_weight_change")
+	signers := defaultMsg.GetSigners()
+	require.Equal(t, len(signers), 1)
+	require.Equal(t, signers[0].String(), addr1.String())
+
+	tests := []struct {
+		name       string
+		msg        balancer.MsgUpdateSmoothWeightChange
+		expectPass bool
+	}{
+		{
+			name:       "proper msg",
+			msg:        defaultMsg,
+			expectPass: true,
+		},
+		{
+			name: "invalid sender",
+			msg: createMsg(func(msg balancer.MsgUpdateSmoothWeightChange) balancer.MsgUpdateSmoothWeightChange {
+				msg.Sender = "invalid"
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "zero duration",
+			msg: createMsg(func(msg balancer.MsgUpdateSmoothWeightChange) balancer.MsgUpdateSmoothWeightChange {
+				msg.SmoothWeightChangeParams.Duration = 0
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "no target weights",
+			msg: createMsg(func(msg balancer.MsgUpdateSmoothWeightChange) balancer.MsgUpdateSmoothWeightChange {
+				msg.SmoothWeightChangeParams.TargetPoolWeights = nil
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "duplicate target weight denom",
+			msg: createMsg(func(msg balancer.MsgUpdateSmoothWeightChange) balancer.MsgUpdateSmoothWeightChange {
+				msg.SmoothWeightChangeParams.TargetPoolWeights[1].Token.Denom = "test"
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "zero target weight",
+			msg: createMsg(func(msg balancer.MsgUpdateSmoothWeightChange) balancer.MsgUpdateSmoothWeightChange {
+				msg.SmoothWeightChangeParams.TargetPoolWeights[0].Weight = sdk.ZeroInt()
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "too large of a target weight",
+			msg: createMsg(func(msg balancer.MsgUpdateSmoothWeightChange) balancer.MsgUpdateSmoothWeightChange {
+				msg.SmoothWeightChangeParams.TargetPoolWeights[0].Weight = sdk.NewInt(1 << 21)
+				return msg
+			}),
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		if test.expectPass {
+			require.NoError(t, test.msg.ValidateBasic(), "test: %v", test.name)
+		} else {
+			require.Error(t, test.msg.ValidateBasic(), "test: %v", test.name)
+		}
+	}
+}
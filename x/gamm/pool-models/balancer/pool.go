@@ -100,6 +100,18 @@ func (p Pool) GetExitFee(_ sdk.Context) sdk.Dec {
 	return p.PoolParams.ExitFee
 }
 
+// GetTakerFeeShare returns the pool's taker fee share, which is zero for pools created before it existed.
+func (p Pool) GetTakerFeeShare(_ sdk.Context) sdk.Dec {
+	if p.PoolParams.TakerFeeShare.IsNil() {
+		return sdk.ZeroDec()
+	}
+	return p.PoolParams.TakerFeeShare
+}
+
+func (p Pool) GetTakerFeeRecipient(_ sdk.Context) string {
+	return p.PoolParams.TakerFeeRecipient
+}
+
 func (p Pool) GetPoolParams() PoolParams {
 	return p.PoolParams
 }
@@ -173,43 +185,76 @@ func (p *Pool) SetInitialPoolAssets(PoolAssets []PoolAsset) error {
 // setInitialPoolParams
 func (p *Pool) setInitialPoolParams(params PoolParams, sortedAssets []PoolAsset, curBlockTime time.Time) error {
 	p.PoolParams = params
+	if params.TakerFeeShare.IsNil() {
+		p.PoolParams.TakerFeeShare = sdk.ZeroDec()
+	}
 	if params.SmoothWeightChangeParams != nil {
-		// set initial assets
-		initialWeights := make([]PoolAsset, len(sortedAssets))
-		for i, v := range sortedAssets {
-			initialWeights[i] = PoolAsset{
-				Weight: v.Weight,
-				Token:  sdk.Coin{Denom: v.Token.Denom, Amount: sdk.ZeroInt()},
-			}
-		}
-		params.SmoothWeightChangeParams.InitialPoolWeights = initialWeights
-
-		// sort target weights by denom
-		targetPoolWeights := params.SmoothWeightChangeParams.TargetPoolWeights
-		sortPoolAssetsByDenom(targetPoolWeights)
-
-		// scale target pool weights by GuaranteedWeightPrecision
-		for i, v := range targetPoolWeights {
-			err := ValidateUserSpecifiedWeight(v.Weight)
-			if err != nil {
-				return err
-			}
-			p.PoolParams.SmoothWeightChangeParams.TargetPoolWeights[i] = PoolAsset{
-				Weight: v.Weight.MulRaw(GuaranteedWeightPrecision),
-				Token:  v.Token,
-			}
+		return p.setSmoothWeightChangeParams(params.SmoothWeightChangeParams, sortedAssets, curBlockTime)
+	}
+
+	return nil
+}
+
+// setSmoothWeightChangeParams sets the pool's smooth weight change to params, starting from
+// the given sorted assets' weights.
+func (p *Pool) setSmoothWeightChangeParams(params *SmoothWeightChangeParams, sortedAssets []PoolAsset, curBlockTime time.Time) error {
+	// set initial assets
+	initialWeights := make([]PoolAsset, len(sortedAssets))
+	for i, v := range sortedAssets {
+		initialWeights[i] = PoolAsset{
+			Weight: v.Weight,
+			Token:  sdk.Coin{Denom: v.Token.Denom, Amount: sdk.ZeroInt()},
 		}
+	}
+	params.InitialPoolWeights = initialWeights
+
+	// sort target weights by denom
+	targetPoolWeights := params.TargetPoolWeights
+	sortPoolAssetsByDenom(targetPoolWeights)
 
-		// Set start time if not present.
-		if params.SmoothWeightChangeParams.StartTime.Unix() <= 0 {
-			// Per https://golang.org/pkg/time/#Time.Unix, should be timezone independent
-			params.SmoothWeightChangeParams.StartTime = time.Unix(curBlockTime.Unix(), 0)
+	// scale target pool weights by GuaranteedWeightPrecision
+	for i, v := range targetPoolWeights {
+		err := ValidateUserSpecifiedWeight(v.Weight)
+		if err != nil {
+			return err
+		}
+		params.TargetPoolWeights[i] = PoolAsset{
+			Weight: v.Weight.MulRaw(GuaranteedWeightPrecision),
+			Token:  v.Token,
 		}
 	}
 
+	// Set start time if not present.
+	if params.StartTime.Unix() <= 0 {
+		// Per https://golang.org/pkg/time/#Time.Unix, should be timezone independent
+		params.StartTime = time.Unix(curBlockTime.Unix(), 0)
+	}
+
+	p.PoolParams.SmoothWeightChangeParams = params
 	return nil
 }
 
+// UpdateSmoothWeightChangeParams starts a new smooth weight change of the pool's weights,
+// from their current values to the target weights of params, replacing any ongoing one.
+// The target weights are user specified weights, as at pool creation, and must be given for
+// every pool asset. The start time defaults to the block time, and cannot be before it.
+func (p *Pool) UpdateSmoothWeightChangeParams(params SmoothWeightChangeParams, blockTime time.Time) error {
+	if err := params.validate(p.PoolAssets); err != nil {
+		return err
+	}
+
+	if params.StartTime.Unix() > 0 && params.StartTime.Before(blockTime) {
+		return types.ErrSmoothWeightChangeStartTime
+	}
+
+	// The new weight change starts from wherever an ongoing one has got to.
+	p.PokePool(blockTime)
+
+	// Copy the target weights, as they are sorted and scaled in place.
+	params.TargetPoolWeights = append([]PoolAsset(nil), params.TargetPoolWeights...)
+	return p.setSmoothWeightChangeParams(&params, p.PoolAssets, blockTime)
+}
+
 // GetPoolAssets returns the denom's PoolAsset, If the PoolAsset doesn't exist, will return error.
 // As above, it will search the denom's PoolAsset by using binary search.
 // So, it is important to make sure that the PoolAssets are sorted.
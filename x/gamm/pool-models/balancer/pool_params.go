@@ -4,6 +4,7 @@ import (
 	"errors"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
 	"github.com/osmosis-labs/osmosis/v13/x/gamm/types"
 )
@@ -34,36 +35,52 @@ func (params PoolParams) Validate(poolWeights []PoolAsset) error {
 	}
 
 	if params.SmoothWeightChangeParams != nil {
-		targetWeights := params.SmoothWeightChangeParams.TargetPoolWeights
-		// Ensure it has the right number of weights
-		if len(targetWeights) != len(poolWeights) {
-			return types.ErrPoolParamsInvalidNumDenoms
+		if err := params.SmoothWeightChangeParams.validate(poolWeights); err != nil {
+			return err
 		}
-		// Validate all user specified weights
-		for _, v := range targetWeights {
-			err := ValidateUserSpecifiedWeight(v.Weight)
-			if err != nil {
-				return err
-			}
+	}
+
+	if params.PoolController != "" {
+		if _, err := sdk.AccAddressFromBech32(params.PoolController); err != nil {
+			return sdkerrors.Wrapf(types.ErrInvalidPoolController, "%s", err)
 		}
-		// Ensure that all the target weight denoms are same as pool asset weights
-		sortedTargetPoolWeights := sortPoolAssetsOutOfPlaceByDenom(targetWeights)
-		sortedPoolWeights := sortPoolAssetsOutOfPlaceByDenom(poolWeights)
-		for i, v := range sortedPoolWeights {
-			if sortedTargetPoolWeights[i].Token.Denom != v.Token.Denom {
-				return types.ErrPoolParamsInvalidDenom
-			}
+	}
+
+	return types.ValidateTakerFeeParams(params.TakerFeeShare, params.TakerFeeRecipient)
+}
+
+// validate checks that the target weights are valid user specified weights of the
+// given pool assets, and that the duration is positive.
+func (params SmoothWeightChangeParams) validate(poolWeights []PoolAsset) error {
+	targetWeights := params.TargetPoolWeights
+	// Ensure it has the right number of weights
+	if len(targetWeights) != len(poolWeights) {
+		return types.ErrPoolParamsInvalidNumDenoms
+	}
+	// Validate all user specified weights
+	for _, v := range targetWeights {
+		err := ValidateUserSpecifiedWeight(v.Weight)
+		if err != nil {
+			return err
+		}
+	}
+	// Ensure that all the target weight denoms are same as pool asset weights
+	sortedTargetPoolWeights := sortPoolAssetsOutOfPlaceByDenom(targetWeights)
+	sortedPoolWeights := sortPoolAssetsOutOfPlaceByDenom(poolWeights)
+	for i, v := range sortedPoolWeights {
+		if sortedTargetPoolWeights[i].Token.Denom != v.Token.Denom {
+			return types.ErrPoolParamsInvalidDenom
 		}
+	}
 
-		// No start time validation needed
+	// No start time validation needed
 
-		// We do not need to validate InitialPoolWeights, as we set that ourselves
-		// in setInitialPoolParams
+	// We do not need to validate InitialPoolWeights, as we set that ourselves
+	// in setSmoothWeightChangeParams
 
-		// TODO: Is there anything else we can validate for duration?
-		if params.SmoothWeightChangeParams.Duration <= 0 {
-			return errors.New("params.SmoothWeightChangeParams must have a positive duration")
-		}
+	// TODO: Is there anything else we can validate for duration?
+	if params.Duration <= 0 {
+		return errors.New("params.SmoothWeightChangeParams must have a positive duration")
 	}
 
 	return nil
@@ -977,6 +977,162 @@ func TestLBPParamsEmptyStartTime(t *testing.T) {
 	require.Equal(t, pacc.PoolParams.SmoothWeightChangeParams.StartTime, defaultCurBlockTime)
 }
 
+func TestUpdateSmoothWeightChangeParams(t *testing.T) {
+	defaultDuration := 100 * time.Second
+	precision := balancer.GuaranteedWeightPrecision
+
+	targetWeights := func(weight1, weight2 int64) []balancer.PoolAsset {
+		return []balancer.PoolAsset{
+			{
+				Weight: sdk.NewInt(weight1),
+				Token:  sdk.NewCoin("asset1", sdk.ZeroInt()),
+			},
+			{
+				Weight: sdk.NewInt(weight2),
+				Token:  sdk.NewCoin("asset2", sdk.ZeroInt()),
+			},
+		}
+	}
+
+	tests := map[string]struct {
+		// ongoing smooth weight change of the pool, if any
+		initialParams *balancer.SmoothWeightChangeParams
+		// time elapsed since pool creation when the update is made
+		elapsed   time.Duration
+		newParams balancer.SmoothWeightChangeParams
+
+		expectedErr bool
+		// expected scaled weights at the start and halfway through the new weight change
+		expectedInitialWeights []sdk.Int
+		expectedHalfwayWeights []sdk.Int
+	}{
+		"no ongoing change, 1:1 to 1:3": {
+			newParams: balancer.SmoothWeightChangeParams{
+				Duration:          defaultDuration,
+				TargetPoolWeights: targetWeights(1, 3),
+			},
+			expectedInitialWeights: []sdk.Int{sdk.NewInt(precision), sdk.NewInt(precision)},
+			expectedHalfwayWeights: []sdk.Int{sdk.NewInt(precision), sdk.NewInt(2 * precision)},
+		},
+		"ongoing change halfway from 1:1 to 1:3, replaced by 3:1": {
+			initialParams: &balancer.SmoothWeightChangeParams{
+				Duration:          defaultDuration,
+				TargetPoolWeights: targetWeights(1, 3),
+			},
+			elapsed: defaultDuration / 2,
+			newParams: balancer.SmoothWeightChangeParams{
+				Duration:          defaultDuration,
+				TargetPoolWeights: targetWeights(3, 1),
+			},
+			expectedInitialWeights: []sdk.Int{sdk.NewInt(precision), sdk.NewInt(2 * precision)},
+			expectedHalfwayWeights: []sdk.Int{sdk.NewInt(2 * precision), sdk.NewInt(3 * precision / 2)},
+		},
+		"target weights in reverse denom order": {
+			newParams: balancer.SmoothWeightChangeParams{
+				Duration:          defaultDuration,
+				TargetPoolWeights: []balancer.PoolAsset{targetWeights(1, 3)[1], targetWeights(1, 3)[0]},
+			},
+			expectedInitialWeights: []sdk.Int{sdk.NewInt(precision), sdk.NewInt(precision)},
+			expectedHalfwayWeights: []sdk.Int{sdk.NewInt(precision), sdk.NewInt(2 * precision)},
+		},
+		"start time in the future": {
+			newParams: balancer.SmoothWeightChangeParams{
+				StartTime:         defaultCurBlockTime.Add(defaultDuration),
+				Duration:          defaultDuration,
+				TargetPoolWeights: targetWeights(1, 3),
+			},
+			expectedInitialWeights: []sdk.Int{sdk.NewInt(precision), sdk.NewInt(precision)},
+			// halfway through the duration is before the start time
+			expectedHalfwayWeights: []sdk.Int{sdk.NewInt(precision), sdk.NewInt(precision)},
+		},
+		"error: start time in the past": {
+			newParams: balancer.SmoothWeightChangeParams{
+				StartTime:         defaultCurBlockTime.Add(-time.Second),
+				Duration:          defaultDuration,
+				TargetPoolWeights: targetWeights(1, 3),
+			},
+			expectedErr: true,
+		},
+		"error: target weight denom not in pool": {
+			newParams: balancer.SmoothWeightChangeParams{
+				Duration: defaultDuration,
+				TargetPoolWeights: []balancer.PoolAsset{
+					targetWeights(1, 3)[0],
+					{Weight: sdk.NewInt(1), Token: sdk.NewCoin("asset3", sdk.ZeroInt())},
+				},
+			},
+			expectedErr: true,
+		},
+		"error: missing target weight": {
+			newParams: balancer.SmoothWeightChangeParams{
+				Duration:          defaultDuration,
+				TargetPoolWeights: targetWeights(1, 3)[:1],
+			},
+			expectedErr: true,
+		},
+		"error: zero duration": {
+			newParams: balancer.SmoothWeightChangeParams{
+				TargetPoolWeights: targetWeights(1, 3),
+			},
+			expectedErr: true,
+		},
+		"error: target weight too large": {
+			newParams: balancer.SmoothWeightChangeParams{
+				Duration:          defaultDuration,
+				TargetPoolWeights: targetWeights(1, 1<<21),
+			},
+			expectedErr: true,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			initialPoolAssets := []balancer.PoolAsset{
+				{
+					Weight: sdk.NewInt(1),
+					Token:  sdk.NewCoin("asset1", sdk.NewInt(1000)),
+				},
+				{
+					Weight: sdk.NewInt(1),
+					Token:  sdk.NewCoin("asset2", sdk.NewInt(1000)),
+				},
+			}
+			createTime := defaultCurBlockTime.Add(-tc.elapsed)
+			pool, err := balancer.NewBalancerPool(defaultPoolId, balancer.PoolParams{
+				SmoothWeightChangeParams: tc.initialParams,
+				SwapFee:                  defaultSwapFee,
+				ExitFee:                  defaultExitFee,
+			}, initialPoolAssets, defaultFutureGovernor, createTime)
+			require.NoError(t, err)
+			originalTargetWeights := append([]balancer.PoolAsset(nil), tc.newParams.TargetPoolWeights...)
+
+			err = pool.UpdateSmoothWeightChangeParams(tc.newParams, defaultCurBlockTime)
+			if tc.expectedErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			// the caller's target weights are not modified
+			require.Equal(t, originalTargetWeights, tc.newParams.TargetPoolWeights)
+
+			params := pool.GetPoolParams().SmoothWeightChangeParams
+			require.NotNil(t, params)
+			if tc.newParams.StartTime.IsZero() {
+				require.Equal(t, defaultCurBlockTime, params.StartTime)
+			}
+			for i, asset := range params.InitialPoolWeights {
+				require.Equal(t, tc.expectedInitialWeights[i].String(), asset.Weight.String())
+			}
+
+			pool.PokePool(defaultCurBlockTime.Add(defaultDuration / 2))
+			for i, asset := range pool.PoolAssets {
+				require.Equal(t, tc.expectedHalfwayWeights[i].String(), asset.Weight.String())
+			}
+		})
+	}
+}
+
 func TestBalancerPoolPokeTokenWeights(t *testing.T) {
 	// Set default date
 	defaultStartTime := time.Unix(1618703511, 0)
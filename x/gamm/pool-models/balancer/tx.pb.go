@@ -142,9 +142,111 @@ func (m *MsgCreateBalancerPoolResponse) GetPoolID() uint64 {
 	return 0
 }
 
+// ===================== MsgUpdateSmoothWeightChange
+// Sender must be the pool's pool_controller in order for the tx to succeed.
+// Starts a new smooth weight change of the pool's weights from their current
+// values, replacing any ongoing one.
+type MsgUpdateSmoothWeightChange struct {
+	Sender                   string                   `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
+	PoolID                   uint64                   `protobuf:"varint,2,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	SmoothWeightChangeParams SmoothWeightChangeParams `protobuf:"bytes,3,opt,name=smooth_weight_change_params,json=smoothWeightChangeParams,proto3" json:"smooth_weight_change_params" yaml:"smooth_weight_change_params"`
+}
+
+func (m *MsgUpdateSmoothWeightChange) Reset()         { *m = MsgUpdateSmoothWeightChange{} }
+func (m *MsgUpdateSmoothWeightChange) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateSmoothWeightChange) ProtoMessage()    {}
+func (*MsgUpdateSmoothWeightChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0647ee155de97433, []int{2}
+}
+func (m *MsgUpdateSmoothWeightChange) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateSmoothWeightChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateSmoothWeightChange.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateSmoothWeightChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateSmoothWeightChange.Merge(m, src)
+}
+func (m *MsgUpdateSmoothWeightChange) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateSmoothWeightChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateSmoothWeightChange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateSmoothWeightChange proto.InternalMessageInfo
+
+func (m *MsgUpdateSmoothWeightChange) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgUpdateSmoothWeightChange) GetPoolID() uint64 {
+	if m != nil {
+		return m.PoolID
+	}
+	return 0
+}
+
+func (m *MsgUpdateSmoothWeightChange) GetSmoothWeightChangeParams() SmoothWeightChangeParams {
+	if m != nil {
+		return m.SmoothWeightChangeParams
+	}
+	return SmoothWeightChangeParams{}
+}
+
+type MsgUpdateSmoothWeightChangeResponse struct {
+}
+
+func (m *MsgUpdateSmoothWeightChangeResponse) Reset()         { *m = MsgUpdateSmoothWeightChangeResponse{} }
+func (m *MsgUpdateSmoothWeightChangeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateSmoothWeightChangeResponse) ProtoMessage()    {}
+func (*MsgUpdateSmoothWeightChangeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0647ee155de97433, []int{3}
+}
+func (m *MsgUpdateSmoothWeightChangeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateSmoothWeightChangeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateSmoothWeightChangeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateSmoothWeightChangeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateSmoothWeightChangeResponse.Merge(m, src)
+}
+func (m *MsgUpdateSmoothWeightChangeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateSmoothWeightChangeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateSmoothWeightChangeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateSmoothWeightChangeResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgCreateBalancerPool)(nil), "osmosis.gamm.poolmodels.balancer.v1beta1.MsgCreateBalancerPool")
 	proto.RegisterType((*MsgCreateBalancerPoolResponse)(nil), "osmosis.gamm.poolmodels.balancer.v1beta1.MsgCreateBalancerPoolResponse")
+	proto.RegisterType((*MsgUpdateSmoothWeightChange)(nil), "osmosis.gamm.poolmodels.balancer.v1beta1.MsgUpdateSmoothWeightChange")
+	proto.RegisterType((*MsgUpdateSmoothWeightChangeResponse)(nil), "osmosis.gamm.poolmodels.balancer.v1beta1.MsgUpdateSmoothWeightChangeResponse")
 }
 
 func init() {
@@ -152,34 +254,41 @@ func init() {
 }
 
 var fileDescriptor_0647ee155de97433 = []byte{
-	// 427 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x92, 0xc1, 0x6e, 0xd3, 0x30,
-	0x18, 0x80, 0xeb, 0xb5, 0x0a, 0xc2, 0x15, 0x07, 0xac, 0x81, 0xa2, 0x22, 0x92, 0x28, 0x5c, 0xc2,
-	0xa1, 0xb6, 0xda, 0x71, 0xe2, 0x32, 0x11, 0x26, 0xa6, 0x1d, 0x26, 0x8d, 0xdc, 0xc6, 0x65, 0x72,
-	0x16, 0x13, 0x2a, 0x25, 0x71, 0x64, 0xbb, 0x55, 0x79, 0x0b, 0x9e, 0x80, 0x23, 0xcf, 0xc0, 0x23,
-	0xec, 0xb8, 0x23, 0xa7, 0x08, 0xa5, 0x6f, 0xd0, 0x27, 0x40, 0xb6, 0x13, 0x34, 0xa4, 0x4c, 0x20,
-	0x71, 0x73, 0x7e, 0x7f, 0xff, 0xf7, 0xff, 0xbf, 0xf3, 0xc3, 0x39, 0x97, 0x25, 0x97, 0x2b, 0x49,
-	0x72, 0x5a, 0x96, 0xa4, 0xe6, 0xbc, 0x98, 0x97, 0x3c, 0x63, 0x85, 0x24, 0x29, 0x2d, 0x68, 0x75,
-	0xcd, 0x04, 0x51, 0x5b, 0xa2, 0xb6, 0xb8, 0x16, 0x5c, 0x71, 0x14, 0x75, 0x38, 0xd6, 0x38, 0xd6,
-	0xb8, 0xa5, 0x71, 0x4f, 0xe3, 0xcd, 0x22, 0x65, 0x8a, 0x2e, 0x66, 0x87, 0x39, 0xcf, 0xb9, 0x49,
-	0x22, 0xfa, 0x64, 0xf3, 0x67, 0xaf, 0xfe, 0x5e, 0xae, 0x3f, 0x5c, 0x70, 0x5e, 0xd8, 0xac, 0xf0,
-	0xfb, 0x01, 0x7c, 0x72, 0x2e, 0xf3, 0xb7, 0x82, 0x51, 0xc5, 0xe2, 0x3b, 0xf7, 0xe8, 0x25, 0x74,
-	0x24, 0xab, 0x32, 0x26, 0x5c, 0x10, 0x80, 0xe8, 0x61, 0xfc, 0x78, 0xdf, 0xf8, 0x8f, 0x3e, 0xd3,
-	0xb2, 0x78, 0x1d, 0xda, 0x78, 0x98, 0x74, 0x00, 0xba, 0x84, 0x53, 0x5d, 0xef, 0xaa, 0xa6, 0x82,
-	0x96, 0xd2, 0x3d, 0x08, 0x40, 0x34, 0x5d, 0x06, 0xf8, 0x8f, 0x81, 0xba, 0xe6, 0xb1, 0x76, 0x5f,
-	0x18, 0x2e, 0x7e, 0xba, 0x6f, 0x7c, 0x64, 0x8d, 0x77, 0xd2, 0xc3, 0x04, 0xd6, 0xbf, 0x19, 0xf4,
-	0xae, 0x53, 0x53, 0x29, 0x99, 0x92, 0xee, 0x38, 0x18, 0x47, 0xd3, 0xa5, 0x7f, 0xbf, 0xfa, 0x8d,
-	0xe6, 0xe2, 0xc9, 0x4d, 0xe3, 0x8f, 0xac, 0xc7, 0x04, 0x24, 0x7a, 0x0f, 0x0f, 0x3f, 0xae, 0xd5,
-	0x5a, 0xb0, 0x2b, 0xa3, 0xcb, 0xf9, 0x86, 0x89, 0x8a, 0x0b, 0x77, 0x62, 0x66, 0xf3, 0xf7, 0x8d,
-	0xff, 0xcc, 0x76, 0x32, 0x44, 0x85, 0x09, 0xb2, 0x61, 0x5d, 0xe1, 0xb4, 0x0f, 0x9e, 0xc0, 0xe7,
-	0x83, 0x2f, 0x97, 0x30, 0x59, 0xf3, 0x4a, 0x32, 0xf4, 0x02, 0x3e, 0x30, 0x9a, 0x55, 0x66, 0x9e,
-	0x70, 0x12, 0xc3, 0xb6, 0xf1, 0x1d, 0x8d, 0x9c, 0x9d, 0x24, 0x8e, 0xbe, 0x3a, 0xcb, 0x96, 0xdf,
-	0x00, 0x1c, 0x9f, 0xcb, 0x1c, 0x7d, 0x05, 0x10, 0x0d, 0xfc, 0x85, 0x63, 0xfc, 0xaf, 0x6b, 0x81,
-	0x07, 0x9b, 0x99, 0x9d, 0xfe, 0xa7, 0xa0, 0x9f, 0x26, 0xbe, 0xbc, 0x69, 0x3d, 0x70, 0xdb, 0x7a,
-	0xe0, 0x67, 0xeb, 0x81, 0x2f, 0x3b, 0x6f, 0x74, 0xbb, 0xf3, 0x46, 0x3f, 0x76, 0xde, 0xe8, 0xc3,
-	0x71, 0xbe, 0x52, 0x9f, 0xd6, 0x29, 0xbe, 0xe6, 0x25, 0xe9, 0x8a, 0xcd, 0x0b, 0x9a, 0xca, 0xfe,
-	0x83, 0x6c, 0x16, 0x47, 0x64, 0x7b, 0xff, 0x5e, 0xa6, 0x8e, 0xd9, 0xc5, 0xa3, 0x5f, 0x01, 0x00,
-	0x00, 0xff, 0xff, 0x09, 0x14, 0x1c, 0x57, 0x32, 0x03, 0x00, 0x00,
+	// 531 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0xc1, 0x8a, 0xd3, 0x40,
+	0x18, 0x6e, 0xda, 0x52, 0x71, 0x8a, 0x07, 0x87, 0x55, 0x42, 0x8b, 0x49, 0xc9, 0x22, 0x54, 0xa1,
+	0x13, 0xda, 0xf5, 0xe4, 0x65, 0x31, 0xbb, 0xba, 0xec, 0xa1, 0xb0, 0x46, 0x44, 0xd6, 0x4b, 0x99,
+	0x34, 0xe3, 0xb4, 0x90, 0x64, 0x42, 0x66, 0x5a, 0xeb, 0x23, 0x78, 0xf3, 0xea, 0xc5, 0x67, 0xf0,
+	0xe8, 0x23, 0xec, 0x71, 0x8f, 0x7a, 0x09, 0xd2, 0xbe, 0x41, 0x9f, 0x40, 0x66, 0x92, 0x2c, 0x15,
+	0x13, 0x75, 0xd1, 0xdb, 0xe4, 0x9f, 0xef, 0xff, 0xbe, 0x7f, 0xbe, 0x6f, 0x32, 0x60, 0xc0, 0x78,
+	0xc8, 0xf8, 0x9c, 0xdb, 0x14, 0x87, 0xa1, 0x1d, 0x33, 0x16, 0x0c, 0x42, 0xe6, 0x93, 0x80, 0xdb,
+	0x1e, 0x0e, 0x70, 0x34, 0x25, 0x89, 0x2d, 0x56, 0xb6, 0x58, 0xa1, 0x38, 0x61, 0x82, 0xc1, 0x7e,
+	0x0e, 0x47, 0x12, 0x8e, 0x24, 0x3c, 0x43, 0xa3, 0x02, 0x8d, 0x96, 0x43, 0x8f, 0x08, 0x3c, 0xec,
+	0xec, 0x51, 0x46, 0x99, 0x6a, 0xb2, 0xe5, 0x2a, 0xeb, 0xef, 0x3c, 0xfa, 0xb3, 0x5c, 0xb1, 0x38,
+	0x63, 0x2c, 0xc8, 0xba, 0xac, 0x2f, 0x75, 0x70, 0x67, 0xcc, 0xe9, 0x51, 0x42, 0xb0, 0x20, 0xce,
+	0xce, 0x3e, 0x7c, 0x00, 0x5a, 0x9c, 0x44, 0x3e, 0x49, 0x74, 0xad, 0xa7, 0xf5, 0x6f, 0x3a, 0xb7,
+	0xb7, 0xa9, 0x79, 0xeb, 0x1d, 0x0e, 0x83, 0xc7, 0x56, 0x56, 0xb7, 0xdc, 0x1c, 0x00, 0xcf, 0x41,
+	0x5b, 0xea, 0x4d, 0x62, 0x9c, 0xe0, 0x90, 0xeb, 0xf5, 0x9e, 0xd6, 0x6f, 0x8f, 0x7a, 0xe8, 0xa7,
+	0x03, 0xe5, 0xc3, 0x23, 0xc9, 0x7d, 0xa6, 0x70, 0xce, 0xdd, 0x6d, 0x6a, 0xc2, 0x8c, 0x71, 0xa7,
+	0xdd, 0x72, 0x41, 0x7c, 0x85, 0x81, 0xcf, 0x72, 0x6a, 0xcc, 0x39, 0x11, 0x5c, 0x6f, 0xf4, 0x1a,
+	0xfd, 0xf6, 0xc8, 0xac, 0xa6, 0x7e, 0x22, 0x71, 0x4e, 0xf3, 0x22, 0x35, 0x6b, 0x19, 0x8f, 0x2a,
+	0x70, 0xf8, 0x1c, 0xec, 0xbd, 0x59, 0x88, 0x45, 0x42, 0x26, 0x8a, 0x8e, 0xb2, 0x25, 0x49, 0x22,
+	0x96, 0xe8, 0x4d, 0x75, 0x36, 0x73, 0x9b, 0x9a, 0xdd, 0x6c, 0x92, 0x32, 0x94, 0xe5, 0xc2, 0xac,
+	0x2c, 0x15, 0x4e, 0x8a, 0xe2, 0x31, 0xb8, 0x57, 0xea, 0x9c, 0x4b, 0x78, 0xcc, 0x22, 0x4e, 0xe0,
+	0x3e, 0xb8, 0xa1, 0x68, 0xe6, 0xbe, 0xb2, 0xb0, 0xe9, 0x80, 0x75, 0x6a, 0xb6, 0x24, 0xe4, 0xf4,
+	0xd8, 0x6d, 0xc9, 0xad, 0x53, 0xdf, 0x7a, 0x5f, 0x07, 0xdd, 0x31, 0xa7, 0x2f, 0x63, 0x1f, 0x0b,
+	0xf2, 0x22, 0x64, 0x4c, 0xcc, 0x5e, 0x91, 0x39, 0x9d, 0x89, 0xa3, 0x19, 0x8e, 0x28, 0xb9, 0x4e,
+	0x0c, 0x3b, 0x7a, 0xf5, 0x2a, 0x3d, 0xf8, 0x51, 0x03, 0x5d, 0xae, 0x64, 0x26, 0x6f, 0x95, 0xce,
+	0x64, 0xaa, 0x84, 0x8a, 0xf0, 0x1a, 0x2a, 0x3c, 0x54, 0xee, 0xf0, 0xaf, 0xf3, 0xe5, 0x51, 0x3e,
+	0x94, 0x86, 0x6f, 0x53, 0xd3, 0xca, 0x27, 0xab, 0x16, 0xb0, 0x5c, 0x9d, 0x57, 0xb0, 0x58, 0xf7,
+	0xc1, 0xfe, 0x6f, 0xac, 0x28, 0x7c, 0x1d, 0x7d, 0xab, 0x83, 0xc6, 0x98, 0x53, 0xf8, 0x49, 0x03,
+	0xb0, 0xe4, 0xe2, 0x1e, 0xa2, 0xbf, 0xfd, 0x93, 0x50, 0x69, 0x7e, 0x9d, 0x93, 0x7f, 0x24, 0xb8,
+	0xba, 0x00, 0x9f, 0x35, 0xa0, 0x57, 0x06, 0xfb, 0xf4, 0x5a, 0x2a, 0x55, 0x34, 0x9d, 0xf1, 0x7f,
+	0xa1, 0x29, 0x46, 0x76, 0xce, 0x2f, 0xd6, 0x86, 0x76, 0xb9, 0x36, 0xb4, 0xef, 0x6b, 0x43, 0xfb,
+	0xb0, 0x31, 0x6a, 0x97, 0x1b, 0xa3, 0xf6, 0x75, 0x63, 0xd4, 0x5e, 0x1f, 0xd2, 0xb9, 0x98, 0x2d,
+	0x3c, 0x34, 0x65, 0xa1, 0x9d, 0x4b, 0x0e, 0x02, 0xec, 0xf1, 0xe2, 0xc3, 0x5e, 0x0e, 0x0f, 0xec,
+	0x55, 0xf5, 0xeb, 0xe3, 0xb5, 0xd4, 0x8b, 0x73, 0xf0, 0x63, 0x00, 0xb6, 0x13, 0x04, 0x34, 0x18,
+	0x05, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -195,6 +304,7 @@ const _ = grpc.SupportPackageIsVersion4
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
 type MsgClient interface {
 	CreateBalancerPool(ctx context.Context, in *MsgCreateBalancerPool, opts ...grpc.CallOption) (*MsgCreateBalancerPoolResponse, error)
+	UpdateSmoothWeightChange(ctx context.Context, in *MsgUpdateSmoothWeightChange, opts ...grpc.CallOption) (*MsgUpdateSmoothWeightChangeResponse, error)
 }
 
 type msgClient struct {
@@ -214,9 +324,19 @@ func (c *msgClient) CreateBalancerPool(ctx context.Context, in *MsgCreateBalance
 	return out, nil
 }
 
+func (c *msgClient) UpdateSmoothWeightChange(ctx context.Context, in *MsgUpdateSmoothWeightChange, opts ...grpc.CallOption) (*MsgUpdateSmoothWeightChangeResponse, error) {
+	out := new(MsgUpdateSmoothWeightChangeResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.gamm.poolmodels.balancer.v1beta1.Msg/UpdateSmoothWeightChange", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	CreateBalancerPool(context.Context, *MsgCreateBalancerPool) (*MsgCreateBalancerPoolResponse, error)
+	UpdateSmoothWeightChange(context.Context, *MsgUpdateSmoothWeightChange) (*MsgUpdateSmoothWeightChangeResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -226,6 +346,9 @@ type UnimplementedMsgServer struct {
 func (*UnimplementedMsgServer) CreateBalancerPool(ctx context.Context, req *MsgCreateBalancerPool) (*MsgCreateBalancerPoolResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateBalancerPool not implemented")
 }
+func (*UnimplementedMsgServer) UpdateSmoothWeightChange(ctx context.Context, req *MsgUpdateSmoothWeightChange) (*MsgUpdateSmoothWeightChangeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateSmoothWeightChange not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -249,6 +372,24 @@ func _Msg_CreateBalancerPool_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_UpdateSmoothWeightChange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateSmoothWeightChange)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateSmoothWeightChange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.gamm.poolmodels.balancer.v1beta1.Msg/UpdateSmoothWeightChange",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateSmoothWeightChange(ctx, req.(*MsgUpdateSmoothWeightChange))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "osmosis.gamm.poolmodels.balancer.v1beta1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -257,6 +398,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "CreateBalancerPool",
 			Handler:    _Msg_CreateBalancerPool_Handler,
 		},
+		{
+			MethodName: "UpdateSmoothWeightChange",
+			Handler:    _Msg_UpdateSmoothWeightChange_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "osmosis/gamm/pool-models/balancer/tx/tx.proto",
@@ -353,6 +498,74 @@ func (m *MsgCreateBalancerPoolResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgUpdateSmoothWeightChange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateSmoothWeightChange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateSmoothWeightChange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.SmoothWeightChangeParams.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.PoolID != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PoolID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateSmoothWeightChangeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateSmoothWeightChangeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateSmoothWeightChangeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -403,6 +616,33 @@ func (m *MsgCreateBalancerPoolResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgUpdateSmoothWeightChange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PoolID != 0 {
+		n += 1 + sovTx(uint64(m.PoolID))
+	}
+	l = m.SmoothWeightChangeParams.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateSmoothWeightChangeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -662,6 +902,190 @@ func (m *MsgCreateBalancerPoolResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgUpdateSmoothWeightChange) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateSmoothWeightChange: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateSmoothWeightChange: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolID", wireType)
+			}
+			m.PoolID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SmoothWeightChangeParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SmoothWeightChangeParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateSmoothWeightChangeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateSmoothWeightChangeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateSmoothWeightChangeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -2,8 +2,10 @@ package stableswap
 
 import (
 	"errors"
+	"fmt"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
 	"github.com/osmosis-labs/osmosis/v13/osmomath"
 	"github.com/osmosis-labs/osmosis/v13/x/gamm/pool-models/internal/cfmm_common"
@@ -79,7 +81,7 @@ func solveCfmm(xReserve, yReserve osmomath.BigDec, remReserves []osmomath.BigDec
 	for _, assetReserve := range remReserves {
 		wSumSquares = wSumSquares.Add(assetReserve.Mul(assetReserve))
 	}
-	return solveCFMMBinarySearchMulti(xReserve, yReserve, wSumSquares, yIn)
+	return solveCFMMNewtonMulti(xReserve, yReserve, wSumSquares, yIn)
 }
 
 // solidly CFMM is xy(x^2 + y^2) = k
@@ -356,6 +358,104 @@ func solveCFMMBinarySearchMulti(xReserve, yReserve, wSumSquares, yIn osmomath.Bi
 	return xOut
 }
 
+// newtonMaxIterations bounds the number of iterations of solveCFMMNewtonMulti.
+// Newton's method shrinks the distance to the root by at least a third per iteration
+// while far from it, and converges quadratically once close, so valid inputs need far fewer.
+const newtonMaxIterations = 256
+
+// newtonErrTolerance is the multiplicative error tolerance of solveCFMMNewtonMulti on x_out.
+var newtonErrTolerance = osmomath.NewDecWithPrec(1, 12)
+
+// solveCFMMNewtonMulti solves the multi-asset CFMM xy(x^2 + y^2 + w) = k for the amount of x out,
+// given yIn units of y in, using Newton's method.
+//
+// Let y_f = y_0 + yIn and f(x_f) = x_f y_f (x_f^2 + y_f^2 + w) - k.
+// f is increasing and convex for x_f > 0, so Newton's method started from any x_f with f(x_f) >= 0
+// approaches the root from above, without ever overshooting it.
+// We start from x_0 if yIn is positive, and otherwise from the linear upperbound on x_f
+// from deriveUpperLowerXFinalReserveBounds, both of which have f(x_f) >= 0.
+// Since x_f is always over-estimated, x_out = x_0 - x_f is rounded in the pool's favor as in
+// solveCFMMBinarySearchMulti: it is rounded down if yIn is positive, and is a larger negative otherwise.
+// Steps are truncated to preserve this.
+//
+// We stop once the Newton step is within a factor of 10^-12 of x_out. The error of the estimate
+// after that step is then on the order of the step squared, as convergence is quadratic,
+// so x_out is well within a factor of 10^-12 of its true value.
+//
+// Newton's method should always converge within newtonMaxIterations on valid inputs. If it does not,
+// we fall back to solveCFMMBinarySearchMulti rather than failing the swap.
+func solveCFMMNewtonMulti(xReserve, yReserve, wSumSquares, yIn osmomath.BigDec) osmomath.BigDec {
+	return solveCFMMNewtonMultiWithMaxIterations(xReserve, yReserve, wSumSquares, yIn, newtonMaxIterations)
+}
+
+// solveCFMMNewtonMultiWithMaxIterations is solveCFMMNewtonMulti, falling back to binary search
+// after maxIterations Newton iterations.
+func solveCFMMNewtonMultiWithMaxIterations(xReserve, yReserve, wSumSquares, yIn osmomath.BigDec, maxIterations int) osmomath.BigDec {
+	if !xReserve.IsPositive() || !yReserve.IsPositive() || wSumSquares.IsNegative() {
+		panic("invalid input: reserves and input must be positive")
+	} else if yIn.Abs().GTE(yReserve) {
+		panic("cannot input more than pool reserves")
+	}
+	if yIn.IsZero() {
+		return osmomath.ZeroDec()
+	}
+
+	xFinal, _, err := newtonSolveCFMMXFinal(xReserve, yReserve, wSumSquares, yIn, maxIterations)
+	if err != nil {
+		return solveCFMMBinarySearchMulti(xReserve, yReserve, wSumSquares, yIn)
+	}
+	return checkCFMMOutput(xReserve, xFinal)
+}
+
+// newtonSolveCFMMXFinal runs Newton's method for the final x reserve of solveCFMMNewtonMulti, given valid
+// and non-zero inputs. It returns the final x reserve and the number of iterations it took,
+// or an error if it did not converge within maxIterations.
+func newtonSolveCFMMXFinal(xReserve, yReserve, wSumSquares, yIn osmomath.BigDec, maxIterations int) (osmomath.BigDec, int, error) {
+	yFinal := yReserve.Add(yIn)
+	k := cfmmConstantMultiNoV(xReserve, yReserve, wSumSquares)
+	// f(x) = y_f x^3 + y_f (y_f^2 + w) x - k, f'(x) = 3 y_f x^2 + y_f (y_f^2 + w)
+	linearCoeff := yFinal.Mul(yFinal.Mul(yFinal).Add(wSumSquares))
+	f := func(x osmomath.BigDec) osmomath.BigDec {
+		return yFinal.Mul(x.Mul(x).Mul(x)).Add(linearCoeff.Mul(x)).Sub(k)
+	}
+	fDerivative := func(x osmomath.BigDec) osmomath.BigDec {
+		return yFinal.Mul(x.Mul(x)).MulInt64(3).Add(linearCoeff)
+	}
+
+	xEst := xReserve
+	if yIn.IsNegative() {
+		_, xEst = deriveUpperLowerXFinalReserveBounds(xReserve, yReserve, wSumSquares, yFinal)
+	}
+
+	for i := 1; i <= maxIterations; i++ {
+		fEst := f(xEst)
+		if !fEst.IsPositive() {
+			// xEst is the root, up to the precision of BigDec
+			return xEst, i, nil
+		}
+		step := fEst.QuoTruncate(fDerivative(xEst))
+		xEst = xEst.Sub(step)
+		xOut := xReserve.Sub(xEst)
+		if step.LTE(xOut.Abs().Mul(newtonErrTolerance)) {
+			return xEst, i, nil
+		}
+	}
+
+	return osmomath.BigDec{}, maxIterations, fmt.Errorf("newton solver did not converge within %d iterations", maxIterations)
+}
+
+// checkCFMMOutput returns the amount of x out given the final x reserve,
+// checking its absolute value against the x reserve amount to ensure that:
+// 1. Swaps cannot more than double the input token's pool supply
+// 2. Swaps cannot output more than the output token's pool supply
+func checkCFMMOutput(xReserve, xFinal osmomath.BigDec) osmomath.BigDec {
+	xOut := xReserve.Sub(xFinal)
+	if xOut.Abs().GTE(xReserve) {
+		panic("invalid output: greater than full pool reserves")
+	}
+	return xOut
+}
+
 func (p Pool) spotPrice(quoteDenom, baseDenom string) (spotPrice sdk.Dec, err error) {
 	// Define f_{y -> x}(a) as the function that outputs the amount of tokens X you'd get by
 	// trading "a" units of Y against the pool, assuming 0 swap fee, at the current liquidity.
@@ -481,32 +581,43 @@ func (p *Pool) singleAssetJoinSwapFeeRatio(tokenInDenom string) (sdk.Dec, error)
 	return nonInternalAssetRatio, nil
 }
 
-// Route a pool join attempt to either a single-asset join or all-asset join (mutates pool state)
+// Route a pool join attempt to either an all-asset join, or single-asset joins of every token in tokensIn,
+// which can contain any other subset of the pool assets (mutates pool state).
+// All-asset joins only join as many tokens as possible at the pool's ratio, while single-asset joins incur swap fee.
 // Eventually, we intend to switch this to a COW wrapped pa for better performance
 func (p *Pool) joinPoolSharesInternal(ctx sdk.Context, tokensIn sdk.Coins, swapFee sdk.Dec) (numShares sdk.Int, tokensJoined sdk.Coins, err error) {
 	if !tokensIn.DenomsSubsetOf(p.GetTotalPoolLiquidity(ctx)) {
 		return sdk.ZeroInt(), sdk.NewCoins(), errors.New("attempted joining pool with assets that do not exist in pool")
 	}
-	if len(tokensIn) == 1 && tokensIn[0].Amount.GT(sdk.OneInt()) {
-		numShares, err = p.calcSingleAssetJoinShares(tokensIn[0], swapFee)
-		if err != nil {
-			return sdk.ZeroInt(), sdk.NewCoins(), err
-		}
-
-		tokensJoined = tokensIn
-
-		p.updatePoolForJoin(tokensJoined, numShares)
+	if len(tokensIn) == p.NumAssets() {
+		return p.joinPoolAllAssetsInternal(tokensIn)
+	}
 
-		if err = validatePoolLiquidity(p.PoolLiquidity, p.ScalingFactors); err != nil {
+	numShares, tokensJoined = sdk.ZeroInt(), sdk.NewCoins()
+	for _, tokenIn := range tokensIn {
+		// single asset joins of one unit cannot get any shares
+		if tokenIn.Amount.LTE(sdk.OneInt()) {
+			return sdk.ZeroInt(), sdk.NewCoins(), fmt.Errorf(
+				"stableswap pool single asset joins must join more than one unit, got %s", tokenIn)
+		}
+		newShares, err := p.calcSingleAssetJoinShares(tokenIn, swapFee)
+		if err != nil {
 			return sdk.ZeroInt(), sdk.NewCoins(), err
 		}
+		p.updatePoolForJoin(sdk.NewCoins(tokenIn), newShares)
+		numShares = numShares.Add(newShares)
+		tokensJoined = tokensJoined.Add(tokenIn)
+	}
 
-		return numShares, tokensJoined, nil
-	} else if len(tokensIn) != p.NumAssets() {
-		return sdk.ZeroInt(), sdk.NewCoins(), errors.New(
-			"stableswap pool only supports LP'ing with one asset, or all assets in pool")
+	if err = validatePoolLiquidity(p.PoolLiquidity, p.ScalingFactors); err != nil {
+		return sdk.ZeroInt(), sdk.NewCoins(), err
 	}
 
+	return numShares, tokensJoined, nil
+}
+
+// joinPoolAllAssetsInternal joins as many of tokensIn as possible at the pool's ratio, without swaps (mutates pool state).
+func (p *Pool) joinPoolAllAssetsInternal(tokensIn sdk.Coins) (numShares sdk.Int, tokensJoined sdk.Coins, err error) {
 	// Add all exact coins we can (no swap). ctx arg doesn't matter for Stableswap
 	numShares, remCoins, err := cfmm_common.MaximalExactRatioJoin(p, sdk.Context{}, tokensIn)
 	if err != nil {
@@ -522,3 +633,61 @@ func (p *Pool) joinPoolSharesInternal(ctx sdk.Context, tokensIn sdk.Coins, swapF
 
 	return numShares, tokensJoined, nil
 }
+
+// exitPoolToDenomsInternal exits exitingShares from the pool, and swaps the exited tokens of every other denom
+// into the tokenOutDenoms (mutates pool state). Each of these tokens is swapped into the denom of tokenOutDenoms
+// with the most scaled liquidity at the time of the swap, as it has the least slippage.
+// Returns the tokens exited, which only contain tokenOutDenoms.
+func (p *Pool) exitPoolToDenomsInternal(ctx sdk.Context, exitingShares sdk.Int, tokenOutDenoms []string, exitFee, swapFee sdk.Dec) (sdk.Coins, error) {
+	if len(tokenOutDenoms) == 0 {
+		return sdk.Coins{}, errors.New("must exit to at least one denom")
+	}
+	liquidityIndexes := p.getLiquidityIndexMap()
+	isTokenOutDenom := make(map[string]bool, len(tokenOutDenoms))
+	for _, denom := range tokenOutDenoms {
+		if _, ok := liquidityIndexes[denom]; !ok {
+			return sdk.Coins{}, sdkerrors.Wrapf(types.ErrDenomNotFoundInPool, "denom %s", denom)
+		}
+		isTokenOutDenom[denom] = true
+	}
+
+	exitedCoins, err := p.ExitPool(ctx, exitingShares, exitFee)
+	if err != nil {
+		return sdk.Coins{}, err
+	}
+
+	tokensOut := sdk.NewCoins()
+	for _, coin := range exitedCoins {
+		if isTokenOutDenom[coin.Denom] {
+			tokensOut = tokensOut.Add(coin)
+			continue
+		}
+		swapToDenom, err := p.mostScaledLiquidityDenom(tokenOutDenoms)
+		if err != nil {
+			return sdk.Coins{}, err
+		}
+		tokenOut, err := p.SwapOutAmtGivenIn(ctx, sdk.NewCoins(coin), swapToDenom, swapFee)
+		if err != nil {
+			return sdk.Coins{}, err
+		}
+		tokensOut = tokensOut.Add(tokenOut)
+	}
+
+	return tokensOut, nil
+}
+
+// mostScaledLiquidityDenom returns the denom of denoms with the most scaled liquidity in the pool,
+// breaking ties by the order of denoms.
+func (p Pool) mostScaledLiquidityDenom(denoms []string) (string, error) {
+	mostDenom, mostScaledLiquidity := "", osmomath.ZeroDec()
+	for _, denom := range denoms {
+		scaledLiquidity, err := p.scaleCoin(sdk.NewCoin(denom, p.PoolLiquidity.AmountOf(denom)), osmomath.RoundDown)
+		if err != nil {
+			return "", err
+		}
+		if mostDenom == "" || scaledLiquidity.GT(mostScaledLiquidity) {
+			mostDenom, mostScaledLiquidity = denom, scaledLiquidity
+		}
+	}
+	return mostDenom, nil
+}
@@ -1,10 +1,12 @@
 package stableswap
 
 import (
+	"fmt"
 	"math/rand"
 	"testing"
 
 	"github.com/osmosis-labs/osmosis/v13/osmomath"
+	swaproutertypes "github.com/osmosis-labs/osmosis/v13/x/swaprouter/types"
 )
 
 func BenchmarkCFMM(b *testing.B) {
@@ -20,6 +22,16 @@ func BenchmarkBinarySearchMultiAsset(b *testing.B) {
 	}
 }
 
+func BenchmarkNewtonMultiAsset(b *testing.B) {
+	for numAssets := 2; numAssets <= swaproutertypes.MaxPoolAssets; numAssets++ {
+		b.Run(fmt.Sprintf("%d assets", numAssets), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				runCalcNAsset(numAssets, solveCFMMNewtonMulti)
+			}
+		})
+	}
+}
+
 func runCalcCFMM(solve func(osmomath.BigDec, osmomath.BigDec, []osmomath.BigDec, osmomath.BigDec) osmomath.BigDec) {
 	xReserve := osmomath.NewBigDec(rand.Int63n(100000) + 50000)
 	yReserve := osmomath.NewBigDec(rand.Int63n(100000) + 50000)
@@ -43,3 +55,15 @@ func runCalcMultiAsset(solve func(osmomath.BigDec, osmomath.BigDec, osmomath.Big
 	yIn := osmomath.NewBigDec(rand.Int63n(100000))
 	solve(xReserve, yReserve, w, yIn)
 }
+
+func runCalcNAsset(numAssets int, solve func(osmomath.BigDec, osmomath.BigDec, osmomath.BigDec, osmomath.BigDec) osmomath.BigDec) {
+	xReserve := osmomath.NewBigDec(rand.Int63n(100000) + 50000)
+	yReserve := osmomath.NewBigDec(rand.Int63n(100000) + 50000)
+	w := osmomath.ZeroDec()
+	for i := 2; i < numAssets; i++ {
+		reserve := osmomath.NewBigDec(rand.Int63n(100000) + 50000)
+		w = w.Add(reserve.Mul(reserve))
+	}
+	yIn := osmomath.NewBigDec(rand.Int63n(50000))
+	solve(xReserve, yReserve, w, yIn)
+}
@@ -16,6 +16,7 @@ import (
 	"github.com/osmosis-labs/osmosis/v13/x/gamm/pool-models/internal/cfmm_common"
 	"github.com/osmosis-labs/osmosis/v13/x/gamm/pool-models/internal/test_helpers"
 	types "github.com/osmosis-labs/osmosis/v13/x/gamm/types"
+	swaproutertypes "github.com/osmosis-labs/osmosis/v13/x/swaprouter/types"
 )
 
 // CFMMTestCase defines a testcase for stableswap pools
@@ -523,6 +524,200 @@ func TestCFMMInvariantMultiAssetsBinarySearch(t *testing.T) {
 	}
 }
 
+func TestCFMMInvariantMultiAssetsNewton(t *testing.T) {
+	kErrTolerance := osmomath.OneDec()
+
+	tests := multiAssetCFMMTestCases
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			// system under test
+			sut := func() {
+				wSumSquares := calcWSumSquares(test.remReserves)
+
+				// using multi-asset cfmm
+				k2 := cfmmConstantMultiNoV(test.xReserve, test.yReserve, wSumSquares)
+				xOut2 := solveCFMMNewtonMulti(test.xReserve, test.yReserve, wSumSquares, test.yIn)
+				k3 := cfmmConstantMultiNoV(test.xReserve.Sub(xOut2), test.yReserve.Add(test.yIn), wSumSquares)
+				osmomath.DecApproxEq(t, k2, k3, kErrTolerance)
+			}
+
+			osmoassert.ConditionalPanic(t, test.expectPanic, sut)
+		})
+	}
+}
+
+// TestSolveCFMMMultiAssetReference checks solveCFMMNewtonMulti against the
+// vectors printed by the multi-asset reference solver in cfmm.py.
+func TestSolveCFMMMultiAssetReference(t *testing.T) {
+	tests := []struct {
+		xReserve    int64
+		yReserve    int64
+		remReserves []int64
+		yIn         int64
+		expectedOut string
+	}{
+		{100, 100, []int64{100}, 1, "0.998003618271571673523836855610847961"},
+		{1000000, 1000000, []int64{1000000, 1000000}, 100000, "96749.101509513322079945522468517625348049"},
+		{123456789, 987654321, []int64{555555555}, 12345678, "3702787.550408668512524431325986956502053183"},
+		{1e12, 1e12, []int64{1e12, 1e12, 1e12}, -1e11, "-104501156586.725098088458237790103460844560236279"},
+		{5e9, 7e9, []int64{3e9, 9e9, 1e9, 2e9}, 1e9, "811866612.952635212942878192164428313381303284"},
+		{1e15, 1e15, []int64{1e15, 1e15, 1e15, 1e15, 1e15, 1e15}, 1e10, "9999940000.359996920029519742242173740446424869"},
+		{1e15, 1e15, []int64{1e15, 1e15, 1e15, 1e15, 1e15, 1e15}, -5e14, "-730642264857705.375487083898460103701519922343302670"},
+		{
+			31415926535, 27182818284,
+			[]int64{14142135623, 17320508075, 22360679774, 24494897427, 26457513110, 28284271247},
+			-1234567890, "-1351225776.310957710751924428379956498239980752",
+		},
+	}
+
+	errTolerance := osmomath.ErrTolerance{AdditiveTolerance: sdk.Dec{}, MultiplicativeTolerance: newtonErrTolerance.SDKDec()}
+	for _, test := range tests {
+		numAssets := len(test.remReserves) + 2
+		t.Run(fmt.Sprintf("%d assets, yIn %d", numAssets, test.yIn), func(t *testing.T) {
+			remReserves := make([]osmomath.BigDec, len(test.remReserves))
+			for i, reserve := range test.remReserves {
+				remReserves[i] = osmomath.NewBigDec(reserve)
+			}
+			expectedOut := osmomath.MustNewDecFromStr(test.expectedOut)
+
+			xOut := solveCFMMNewtonMulti(osmomath.NewBigDec(test.xReserve), osmomath.NewBigDec(test.yReserve), calcWSumSquares(remReserves), osmomath.NewBigDec(test.yIn))
+
+			require.Equal(t, 0, errTolerance.CompareBigDec(expectedOut, xOut), "expected %s, got %s", expectedOut, xOut)
+			// x out must be rounded in the pool's favor
+			require.True(t, xOut.LTE(expectedOut), "expected %s, got %s", expectedOut, xOut)
+		})
+	}
+}
+
+// TestSolveCFMMNewtonMultiRandomized checks solveCFMMNewtonMulti against solveCFMMBinarySearchMulti
+// on random pools of 2 to 8 assets, and that swaps never decrease the CFMM constant.
+func TestSolveCFMMNewtonMultiRandomized(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	errTolerance := osmomath.ErrTolerance{AdditiveTolerance: sdk.Dec{}, MultiplicativeTolerance: sdk.NewDecWithPrec(1, 10)}
+
+	for i := 0; i < 500; i++ {
+		numAssets := 2 + r.Intn(swaproutertypes.MaxPoolAssets-1)
+		randReserve := func() osmomath.BigDec {
+			return osmomath.NewBigDec(r.Int63n(1e15) + 1e6)
+		}
+		xReserve, yReserve := randReserve(), randReserve()
+		remReserves := make([]osmomath.BigDec, numAssets-2)
+		for j := range remReserves {
+			remReserves[j] = randReserve()
+		}
+		// up to 10% of the y reserve in or out, so that x reserves can never double
+		yIn := yReserve.QuoInt64(10).MulInt64(r.Int63n(2001) - 1000).QuoInt64(1000).TruncateDec()
+		if yIn.IsZero() {
+			continue
+		}
+		wSumSquares := calcWSumSquares(remReserves)
+
+		requireNewtonMatchesBinarySearch(t, xReserve, yReserve, wSumSquares, yIn, errTolerance)
+	}
+}
+
+// requireNewtonMatchesBinarySearch checks solveCFMMNewtonMulti converges without falling back to binary search,
+// matches solveCFMMBinarySearchMulti within errTolerance, and does not decrease the CFMM constant.
+func requireNewtonMatchesBinarySearch(t *testing.T, xReserve, yReserve, wSumSquares, yIn osmomath.BigDec, errTolerance osmomath.ErrTolerance) {
+	_, _, err := newtonSolveCFMMXFinal(xReserve, yReserve, wSumSquares, yIn, newtonMaxIterations)
+	require.NoError(t, err, "x %s, y %s, w %s, yIn %s", xReserve, yReserve, wSumSquares, yIn)
+	xOut := solveCFMMNewtonMulti(xReserve, yReserve, wSumSquares, yIn)
+
+	expectedXOut := solveCFMMBinarySearchMulti(xReserve, yReserve, wSumSquares, yIn)
+	require.Equal(t, 0, errTolerance.CompareBigDec(expectedXOut, xOut),
+		"x %s, y %s, w %s, yIn %s: newton %s, binary search %s", xReserve, yReserve, wSumSquares, yIn, xOut, expectedXOut)
+
+	kBefore := cfmmConstantMultiNoV(xReserve, yReserve, wSumSquares)
+	kAfter := cfmmConstantMultiNoV(xReserve.Sub(xOut), yReserve.Add(yIn), wSumSquares)
+	require.True(t, kAfter.GTE(kBefore), "k decreased from %s to %s", kBefore, kAfter)
+}
+
+// FuzzSolveCFMMNewtonMulti checks solveCFMMNewtonMulti against solveCFMMBinarySearchMulti on pools
+// of 2 to 8 assets with reserves of 1 to 10^15, and swaps of up to 10% of the y reserve in or out,
+// so that x reserves can never double. The seed corpus includes the pools of the cfmm.py reference vectors.
+func FuzzSolveCFMMNewtonMulti(f *testing.F) {
+	f.Add(int64(100), int64(100), uint8(1), int64(100), int16(100))
+	f.Add(int64(1e6), int64(1e6), uint8(2), int64(1e6), int16(1000))
+	f.Add(int64(123456789), int64(987654321), uint8(1), int64(555555555), int16(125))
+	f.Add(int64(1e12), int64(1e12), uint8(3), int64(1e12), int16(-1000))
+	f.Add(int64(5e9), int64(7e9), uint8(4), int64(3e9), int16(-714))
+	f.Add(int64(1e15), int64(1e15), uint8(6), int64(1e15), int16(1))
+	f.Add(int64(1), int64(1e15), uint8(6), int64(1), int16(-1000))
+	f.Add(int64(1e15), int64(10), uint8(0), int64(0), int16(1000))
+
+	errTolerance := osmomath.ErrTolerance{AdditiveTolerance: sdk.Dec{}, MultiplicativeTolerance: sdk.NewDecWithPrec(1, 10)}
+	f.Fuzz(func(t *testing.T, x, y int64, numRemAssets uint8, remSeed int64, yInPermille int16) {
+		toReserve := func(v int64) osmomath.BigDec {
+			if v < 0 {
+				v = -(v + 1)
+			}
+			return osmomath.NewBigDec(v%1e15 + 1)
+		}
+		xReserve, yReserve := toReserve(x), toReserve(y)
+		remReserves := make([]osmomath.BigDec, int(numRemAssets)%(swaproutertypes.MaxPoolAssets-1))
+		r := rand.New(rand.NewSource(remSeed))
+		for i := range remReserves {
+			remReserves[i] = toReserve(r.Int63())
+		}
+		yIn := yReserve.QuoInt64(10).MulInt64(int64(yInPermille) % 1001).QuoInt64(1000).TruncateDec()
+		if yIn.IsZero() {
+			return
+		}
+
+		requireNewtonMatchesBinarySearch(t, xReserve, yReserve, calcWSumSquares(remReserves), yIn, errTolerance)
+	})
+}
+
+// newtonIterationBudget is the maximum number of Newton iterations we allow
+// solveCFMMNewtonMulti to take on any pool of up to MaxPoolAssets assets, to keep swap gas bounded.
+const newtonIterationBudget = 16
+
+// TestNewtonIterationBudget checks that Newton's method converges within newtonIterationBudget
+// iterations on random pools, from balanced ones to ones with reserves 15 orders of magnitude apart.
+// Swaps that would drain or double the x reserve are rejected after converging, and are only
+// checked to converge within newtonMaxIterations.
+func TestNewtonIterationBudget(t *testing.T) {
+	r := rand.New(rand.NewSource(2))
+	for i := 0; i < 2000; i++ {
+		xReserve := osmomath.NewBigDec(r.Int63n(1e15) + 1)
+		yReserve := osmomath.NewBigDec(r.Int63n(1e15) + 1)
+		wSumSquares := osmomath.ZeroDec()
+		numRemAssets := r.Intn(swaproutertypes.MaxPoolAssets - 1)
+		for j := 0; j < numRemAssets; j++ {
+			reserve := osmomath.NewBigDec(r.Int63n(1e15) + 1)
+			wSumSquares = wSumSquares.Add(reserve.Mul(reserve))
+		}
+		yIn := yReserve.MulInt64(r.Int63n(1999) - 999).QuoInt64(1000).TruncateDec()
+		if yIn.IsZero() {
+			continue
+		}
+
+		xFinal, iterations, err := newtonSolveCFMMXFinal(xReserve, yReserve, wSumSquares, yIn, newtonMaxIterations)
+		require.NoError(t, err)
+		if xReserve.Sub(xFinal).Abs().GTE(xReserve) {
+			continue
+		}
+		require.LessOrEqual(t, iterations, newtonIterationBudget,
+			"x %s, y %s, w %s, yIn %s", xReserve, yReserve, wSumSquares, yIn)
+	}
+}
+
+// TestSolveCFMMNewtonMultiFallback checks solveCFMMNewtonMulti falls back to solveCFMMBinarySearchMulti
+// when Newton's method does not converge within its maximum number of iterations.
+func TestSolveCFMMNewtonMultiFallback(t *testing.T) {
+	xReserve, yReserve := osmomath.NewBigDec(1e12), osmomath.NewBigDec(1e12)
+	wSumSquares := calcWSumSquares([]osmomath.BigDec{osmomath.NewBigDec(1e12)})
+
+	for _, yIn := range []osmomath.BigDec{osmomath.NewBigDec(1e11), osmomath.NewBigDec(-1e11)} {
+		_, _, err := newtonSolveCFMMXFinal(xReserve, yReserve, wSumSquares, yIn, 1)
+		require.Error(t, err)
+
+		xOut := solveCFMMNewtonMultiWithMaxIterations(xReserve, yReserve, wSumSquares, yIn, 1)
+		require.Equal(t, solveCFMMBinarySearchMulti(xReserve, yReserve, wSumSquares, yIn), xOut)
+	}
+}
+
 func (suite *StableSwapTestSuite) Test_StableSwap_CalculateAmountOutAndIn_InverseRelationship() {
 	type testcase struct {
 		denomOut       string
@@ -1001,6 +1196,86 @@ func TestJoinPoolSharesInternal(t *testing.T) {
 	}
 }
 
+// TestJoinPoolSharesInternalSubset tests joins with proper subsets of the pool assets, which are
+// routed to single asset joins of each token in.
+func TestJoinPoolSharesInternalSubset(t *testing.T) {
+	type testcase struct {
+		tokensIn       sdk.Coins
+		poolAssets     sdk.Coins
+		scalingFactors []uint64
+		swapFee        sdk.Dec
+		expectPass     bool
+	}
+
+	tests := map[string]testcase{
+		"two of three assets, even pool": {
+			tokensIn:       sdk.NewCoins(sdk.NewInt64Coin("asset/a", 100000), sdk.NewInt64Coin("asset/b", 100000)),
+			poolAssets:     threeEvenStablePoolAssets,
+			scalingFactors: defaultThreeAssetScalingFactors,
+			swapFee:        sdk.ZeroDec(),
+			expectPass:     true,
+		},
+		"two of three assets, uneven pool, with swap fee": {
+			tokensIn:       sdk.NewCoins(sdk.NewInt64Coin("asset/a", 100000), sdk.NewInt64Coin("asset/c", 300000)),
+			poolAssets:     threeUnevenStablePoolAssets,
+			scalingFactors: defaultThreeAssetScalingFactors,
+			swapFee:        defaultSwapFee,
+			expectPass:     true,
+		},
+		"three of five assets": {
+			tokensIn: sdk.NewCoins(
+				sdk.NewInt64Coin("asset/a", 100000000),
+				sdk.NewInt64Coin("asset/c", 200000000),
+				sdk.NewInt64Coin("asset/e", 50000000),
+			),
+			poolAssets:     fiveEvenStablePoolAssets,
+			scalingFactors: defaultFiveAssetScalingFactors,
+			swapFee:        defaultSwapFee,
+			expectPass:     true,
+		},
+		"token in of one unit": {
+			tokensIn:       sdk.NewCoins(sdk.NewInt64Coin("asset/a", 100000), sdk.NewInt64Coin("asset/b", 1)),
+			poolAssets:     threeEvenStablePoolAssets,
+			scalingFactors: defaultThreeAssetScalingFactors,
+			swapFee:        sdk.ZeroDec(),
+			expectPass:     false,
+		},
+		"denom not in pool": {
+			tokensIn:       sdk.NewCoins(sdk.NewInt64Coin("asset/a", 100000), sdk.NewInt64Coin("asset/z", 100000)),
+			poolAssets:     threeEvenStablePoolAssets,
+			scalingFactors: defaultThreeAssetScalingFactors,
+			swapFee:        sdk.ZeroDec(),
+			expectPass:     false,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx := sdk.Context{}
+			p := poolStructFromAssets(tc.poolAssets, tc.scalingFactors)
+			initialShares := p.TotalShares.Amount
+
+			shares, joinedLiquidity, err := p.joinPoolSharesInternal(ctx, tc.tokensIn, tc.swapFee)
+
+			osmoassert.ConditionalError(t, !tc.expectPass, err)
+			if tc.expectPass {
+				require.Equal(t, tc.tokensIn, joinedLiquidity)
+				require.Equal(t, tc.poolAssets.Add(tc.tokensIn...), p.PoolLiquidity)
+				require.Equal(t, initialShares.Add(shares), p.TotalShares.Amount)
+
+				// a subset join can never get more shares than a join at the pool's ratio
+				// of its largest token relative to the pool would, as it incurs slippage
+				ratioJoinShares := initialShares.Mul(tc.tokensIn.AmountOf(tc.tokensIn[0].Denom)).Quo(tc.poolAssets.AmountOf(tc.tokensIn[0].Denom))
+				for _, tokenIn := range tc.tokensIn[1:] {
+					ratioJoinShares = sdk.MaxInt(ratioJoinShares, initialShares.Mul(tokenIn.Amount).Quo(tc.poolAssets.AmountOf(tokenIn.Denom)))
+				}
+				require.True(t, shares.IsPositive())
+				require.True(t, shares.LTE(ratioJoinShares), "shares %s, ratio join shares %s", shares, ratioJoinShares)
+			}
+		})
+	}
+}
+
 func TestSingleAssetJoinSwapFeeRatio(t *testing.T) {
 	largeInt, ok := sdk.NewIntFromString("123456789012345678")
 	require.True(t, ok)
@@ -31,4 +31,47 @@ def binary_search(x0, y0, yin):
 xOut = binary_search(x0, y0, yin)
 print(cfmm(x0, y0))
 print(cfmm(x0 - xOut, y0 + yin))
-print(xOut)
\ No newline at end of file
+print(xOut)
+# Multi-asset reference solver, used to generate the test vectors of
+# TestSolveCFMMMultiAssetReference in amm_test.go.
+# The multi-asset CFMM is xy(x^2 + y^2 + w) = k, where w is the sum of the
+# squares of the remaining reserves. We bisect with 120 digits of precision,
+# and print x_out rounded up to the 36 decimals of osmomath.BigDec.
+from decimal import Decimal, getcontext, ROUND_CEILING
+
+getcontext().prec = 120
+
+cfmm_multi = lambda x, y, w: x*y*(x*x + y*y + w)
+
+def solve_cfmm_multi(x0, y0, rem_reserves, yin):
+    x0, y0, yin = Decimal(x0), Decimal(y0), Decimal(yin)
+    w = sum(Decimal(r)**2 for r in rem_reserves)
+    k = cfmm_multi(x0, y0, w)
+    yf = y0 + yin
+    x_low_est, x_high_est = Decimal(0), x0
+    # x_f > x_0 if yin is negative, and the CFMM is superlinear in x
+    while cfmm_multi(x_high_est, yf, w) < k:
+        x_high_est *= 2
+    for _ in range(500):
+        x_est = (x_high_est + x_low_est) / 2
+        if cfmm_multi(x_est, yf, w) > k:
+            x_high_est = x_est
+        else:
+            x_low_est = x_est
+    return x0 - x_high_est
+
+multi_asset_vectors = [
+    # (x reserve, y reserve, remaining reserves, y in)
+    (100, 100, [100], 1),
+    (1000000, 1000000, [1000000, 1000000], 100000),
+    (123456789, 987654321, [555555555], 12345678),
+    (10**12, 10**12, [10**12] * 3, -10**11),
+    (5 * 10**9, 7 * 10**9, [3 * 10**9, 9 * 10**9, 10**9, 2 * 10**9], 10**9),
+    (10**15, 10**15, [10**15] * 6, 10**10),
+    (10**15, 10**15, [10**15] * 6, -5 * 10**14),
+    (31415926535, 27182818284, [14142135623, 17320508075, 22360679774, 24494897427, 26457513110, 28284271247], -1234567890),
+]
+
+for x0, y0, rem_reserves, yin in multi_asset_vectors:
+    x_out = solve_cfmm_multi(x0, y0, rem_reserves, yin)
+    print(x0, y0, rem_reserves, yin, x_out.quantize(Decimal(10) ** -36, rounding=ROUND_CEILING))
@@ -2,10 +2,12 @@
 // We expect tests for:
 // * MsgCreatePool creating correct pool as expected
 // * MsgStableSwapAdjustScalingFactors works as expected
+// * MsgStableSwapSetScalingFactorRateProvider works as expected
 package stableswap_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -34,14 +36,16 @@ func (s *TestSuite) TestSetScalingFactors() {
 	nextPoolId := s.App.GAMMKeeper.GetNextPoolId(s.Ctx)
 	defaultCreatePoolMsg := *baseCreatePoolMsgGen(addr1)
 	defaultCreatePoolMsg.ScalingFactorController = defaultCreatePoolMsg.Sender
-	defaultAdjustSFMsg := stableswap.NewMsgStableSwapAdjustScalingFactors(defaultCreatePoolMsg.Sender, nextPoolId, []uint64{1, 1})
+	defaultAdjustSFMsg := stableswap.NewMsgStableSwapAdjustScalingFactors(defaultCreatePoolMsg.Sender, nextPoolId, []uint64{1, 1}, 0)
+	changingAdjustSFMsg := stableswap.NewMsgStableSwapAdjustScalingFactors(defaultCreatePoolMsg.Sender, nextPoolId, []uint64{1, 3}, time.Hour)
 
 	tests := map[string]struct {
 		createMsg  stableswap.MsgCreateStableswapPool
 		setMsg     stableswap.MsgStableSwapAdjustScalingFactors
 		expectPass bool
 	}{
-		"valid_msg": {defaultCreatePoolMsg, defaultAdjustSFMsg, true},
+		"valid_msg":                  {defaultCreatePoolMsg, defaultAdjustSFMsg, true},
+		"valid_msg_change_over_time": {defaultCreatePoolMsg, changingAdjustSFMsg, true},
 	}
 
 	for name, tc := range tests {
@@ -57,3 +61,26 @@ func (s *TestSuite) TestSetScalingFactors() {
 		})
 	}
 }
+
+func (s *TestSuite) TestSetScalingFactorRateProvider() {
+	s.SetupTest()
+	addr1 := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	contractAddr := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	nextPoolId := s.App.GAMMKeeper.GetNextPoolId(s.Ctx)
+	createPoolMsg := *baseCreatePoolMsgGen(addr1)
+	createPoolMsg.ScalingFactorController = createPoolMsg.Sender
+
+	rateProvider := &stableswap.ScalingFactorRateProvider{ContractAddress: contractAddr.String(), ChangeDuration: time.Hour}
+	setRateProviderMsg := stableswap.NewMsgStableSwapSetScalingFactorRateProvider(createPoolMsg.Sender, nextPoolId, rateProvider)
+
+	s.FundAcc(addr1, s.App.GAMMKeeper.GetParams(s.Ctx).PoolCreationFee)
+	s.FundAcc(addr1, createPoolMsg.InitialPoolLiquidity.Sort())
+	_, err := s.RunMsg(&createPoolMsg)
+	s.Require().NoError(err)
+	_, err = s.RunMsg(&setRateProviderMsg)
+	s.Require().NoError(err)
+
+	pool, err := s.App.GAMMKeeper.GetPoolAndPoke(s.Ctx, nextPoolId)
+	s.Require().NoError(err)
+	s.Require().Equal(rateProvider, pool.(*stableswap.Pool).ScalingFactorRateProvider)
+}
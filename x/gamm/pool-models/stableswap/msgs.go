@@ -1,6 +1,8 @@
 package stableswap
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
@@ -9,8 +11,10 @@ import (
 )
 
 const (
-	TypeMsgCreateStableswapPool           = "create_stableswap_pool"
-	TypeMsgStableSwapAdjustScalingFactors = "stable_swap_adjust_scaling_factors"
+	TypeMsgCreateStableswapPool                   = "create_stableswap_pool"
+	TypeMsgStableSwapAdjustScalingFactors         = "stable_swap_adjust_scaling_factors"
+	TypeMsgStableSwapSetScalingFactorRateProvider = "stable_swap_set_scaling_factor_rate_provider"
+	TypeMsgStableSwapExitPoolToDenoms             = "stable_swap_exit_pool_to_denoms"
 )
 
 var (
@@ -130,11 +134,13 @@ func NewMsgStableSwapAdjustScalingFactors(
 	sender string,
 	poolID uint64,
 	scalingFactors []uint64,
+	scalingFactorChangeDuration time.Duration,
 ) MsgStableSwapAdjustScalingFactors {
 	return MsgStableSwapAdjustScalingFactors{
-		Sender:         sender,
-		PoolID:         poolID,
-		ScalingFactors: scalingFactors,
+		Sender:                      sender,
+		PoolID:                      poolID,
+		ScalingFactors:              scalingFactors,
+		ScalingFactorChangeDuration: scalingFactorChangeDuration,
 	}
 }
 
@@ -142,8 +148,15 @@ func (msg MsgStableSwapAdjustScalingFactors) Route() string {
 	return types.RouterKey
 }
 
-func (msg MsgStableSwapAdjustScalingFactors) Type() string { return TypeMsgCreateStableswapPool }
+func (msg MsgStableSwapAdjustScalingFactors) Type() string {
+	return TypeMsgStableSwapAdjustScalingFactors
+}
+
 func (msg MsgStableSwapAdjustScalingFactors) ValidateBasic() error {
+	if msg.ScalingFactorChangeDuration < 0 {
+		return types.ErrInvalidScalingFactorChange
+	}
+
 	if msg.Sender == "" {
 		return nil
 	}
@@ -168,3 +181,127 @@ func (msg MsgStableSwapAdjustScalingFactors) GetSigners() []sdk.AccAddress {
 
 	return []sdk.AccAddress{scalingFactorGovernor}
 }
+
+var _ sdk.Msg = &MsgStableSwapSetScalingFactorRateProvider{}
+
+// Implement sdk.Msg
+func NewMsgStableSwapSetScalingFactorRateProvider(
+	sender string,
+	poolID uint64,
+	rateProvider *ScalingFactorRateProvider,
+) MsgStableSwapSetScalingFactorRateProvider {
+	return MsgStableSwapSetScalingFactorRateProvider{
+		Sender:       sender,
+		PoolID:       poolID,
+		RateProvider: rateProvider,
+	}
+}
+
+func (msg MsgStableSwapSetScalingFactorRateProvider) Route() string {
+	return types.RouterKey
+}
+
+func (msg MsgStableSwapSetScalingFactorRateProvider) Type() string {
+	return TypeMsgStableSwapSetScalingFactorRateProvider
+}
+
+func (msg MsgStableSwapSetScalingFactorRateProvider) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	if msg.RateProvider != nil {
+		return msg.RateProvider.Validate()
+	}
+
+	return nil
+}
+
+func (msg MsgStableSwapSetScalingFactorRateProvider) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgStableSwapSetScalingFactorRateProvider) GetSigners() []sdk.AccAddress {
+	scalingFactorGovernor, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{scalingFactorGovernor}
+}
+
+var _ sdk.Msg = &MsgStableSwapExitPoolToDenoms{}
+
+// Implement sdk.Msg
+func NewMsgStableSwapExitPoolToDenoms(
+	sender string,
+	poolID uint64,
+	shareInAmount sdk.Int,
+	tokenOutDenoms []string,
+	tokenOutMins sdk.Coins,
+) MsgStableSwapExitPoolToDenoms {
+	return MsgStableSwapExitPoolToDenoms{
+		Sender:         sender,
+		PoolID:         poolID,
+		ShareInAmount:  shareInAmount,
+		TokenOutDenoms: tokenOutDenoms,
+		TokenOutMins:   tokenOutMins,
+	}
+}
+
+func (msg MsgStableSwapExitPoolToDenoms) Route() string {
+	return types.RouterKey
+}
+
+func (msg MsgStableSwapExitPoolToDenoms) Type() string {
+	return TypeMsgStableSwapExitPoolToDenoms
+}
+
+func (msg MsgStableSwapExitPoolToDenoms) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	if !msg.ShareInAmount.IsPositive() {
+		return sdkerrors.Wrap(types.ErrNotPositiveRequireAmount, msg.ShareInAmount.String())
+	}
+
+	if len(msg.TokenOutDenoms) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "must exit to at least one denom")
+	}
+	seenDenoms := make(map[string]bool, len(msg.TokenOutDenoms))
+	for _, denom := range msg.TokenOutDenoms {
+		if err := sdk.ValidateDenom(denom); err != nil {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, err.Error())
+		}
+		if seenDenoms[denom] {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "duplicate token out denom %s", denom)
+		}
+		seenDenoms[denom] = true
+	}
+
+	if !msg.TokenOutMins.IsValid() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, msg.TokenOutMins.String())
+	}
+	for _, coin := range msg.TokenOutMins {
+		if !seenDenoms[coin.Denom] {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "minimum token out %s is not in the token out denoms", coin)
+		}
+	}
+
+	return nil
+}
+
+func (msg MsgStableSwapExitPoolToDenoms) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgStableSwapExitPoolToDenoms) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
@@ -129,6 +129,44 @@ func TestMsgCreateStableswapPoolValidateBasic(t *testing.T) {
 			}),
 			expectPass: false,
 		},
+		{
+			name: "taker fee share and recipient",
+			msg: updateMsg(func(msg stableswap.MsgCreateStableswapPool) stableswap.MsgCreateStableswapPool {
+				msg.PoolParams = &stableswap.PoolParams{
+					SwapFee:           sdk.NewDecWithPrec(1, 2),
+					ExitFee:           sdk.ZeroDec(),
+					TakerFeeShare:     sdk.NewDecWithPrec(5, 1),
+					TakerFeeRecipient: addr1.String(),
+				}
+				return msg
+			}),
+			expectPass: true,
+		},
+		{
+			name: "taker fee share greater than 1",
+			msg: updateMsg(func(msg stableswap.MsgCreateStableswapPool) stableswap.MsgCreateStableswapPool {
+				msg.PoolParams = &stableswap.PoolParams{
+					SwapFee:       sdk.NewDecWithPrec(1, 2),
+					ExitFee:       sdk.ZeroDec(),
+					TakerFeeShare: sdk.NewDecWithPrec(11, 1),
+				}
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "invalid taker fee recipient",
+			msg: updateMsg(func(msg stableswap.MsgCreateStableswapPool) stableswap.MsgCreateStableswapPool {
+				msg.PoolParams = &stableswap.PoolParams{
+					SwapFee:           sdk.NewDecWithPrec(1, 2),
+					ExitFee:           sdk.ZeroDec(),
+					TakerFeeShare:     sdk.NewDecWithPrec(5, 1),
+					TakerFeeRecipient: "invalid",
+				}
+				return msg
+			}),
+			expectPass: false,
+		},
 		{
 			name: "scaling factors with invalid length",
 			msg: updateMsg(func(msg stableswap.MsgCreateStableswapPool) stableswap.MsgCreateStableswapPool {
@@ -296,3 +334,93 @@ func TestMsgCreateStableswapPoolValidateBasic(t *testing.T) {
 		}
 	}
 }
+
+func TestMsgStableSwapExitPoolToDenomsValidateBasic(t *testing.T) {
+	appParams.SetAddressPrefixes()
+	addr1 := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	updateMsg := func(f func(msg stableswap.MsgStableSwapExitPoolToDenoms) stableswap.MsgStableSwapExitPoolToDenoms) stableswap.MsgStableSwapExitPoolToDenoms {
+		msg := stableswap.NewMsgStableSwapExitPoolToDenoms(addr1.String(), 1, sdk.NewInt(100),
+			[]string{"bar", "foo"}, sdk.NewCoins(sdk.NewCoin("foo", sdk.NewInt(10))))
+		return f(msg)
+	}
+
+	default_msg := updateMsg(func(msg stableswap.MsgStableSwapExitPoolToDenoms) stableswap.MsgStableSwapExitPoolToDenoms {
+		return msg
+	})
+	require.Equal(t, default_msg.Route(), types.RouterKey)
+	require.Equal(t, default_msg.Type(), "stable_swap_exit_pool_to_denoms")
+	signers := default_msg.GetSigners()
+	require.Equal(t, len(signers), 1)
+	require.Equal(t, signers[0].String(), addr1.String())
+
+	tests := []struct {
+		name       string
+		msg        stableswap.MsgStableSwapExitPoolToDenoms
+		expectPass bool
+	}{
+		{
+			name:       "proper msg",
+			msg:        default_msg,
+			expectPass: true,
+		},
+		{
+			name: "no token out mins",
+			msg: updateMsg(func(msg stableswap.MsgStableSwapExitPoolToDenoms) stableswap.MsgStableSwapExitPoolToDenoms {
+				msg.TokenOutMins = sdk.Coins{}
+				return msg
+			}),
+			expectPass: true,
+		},
+		{
+			name: "invalid sender",
+			msg: updateMsg(func(msg stableswap.MsgStableSwapExitPoolToDenoms) stableswap.MsgStableSwapExitPoolToDenoms {
+				msg.Sender = sdk.AccAddress("invalid").String()
+				return msg
+			}),
+		},
+		{
+			name: "zero shares",
+			msg: updateMsg(func(msg stableswap.MsgStableSwapExitPoolToDenoms) stableswap.MsgStableSwapExitPoolToDenoms {
+				msg.ShareInAmount = sdk.ZeroInt()
+				return msg
+			}),
+		},
+		{
+			name: "no token out denoms",
+			msg: updateMsg(func(msg stableswap.MsgStableSwapExitPoolToDenoms) stableswap.MsgStableSwapExitPoolToDenoms {
+				msg.TokenOutDenoms = nil
+				msg.TokenOutMins = sdk.Coins{}
+				return msg
+			}),
+		},
+		{
+			name: "invalid token out denom",
+			msg: updateMsg(func(msg stableswap.MsgStableSwapExitPoolToDenoms) stableswap.MsgStableSwapExitPoolToDenoms {
+				msg.TokenOutDenoms = []string{"foo", "1bar"}
+				return msg
+			}),
+		},
+		{
+			name: "duplicate token out denoms",
+			msg: updateMsg(func(msg stableswap.MsgStableSwapExitPoolToDenoms) stableswap.MsgStableSwapExitPoolToDenoms {
+				msg.TokenOutDenoms = []string{"foo", "foo"}
+				return msg
+			}),
+		},
+		{
+			name: "token out min of another denom",
+			msg: updateMsg(func(msg stableswap.MsgStableSwapExitPoolToDenoms) stableswap.MsgStableSwapExitPoolToDenoms {
+				msg.TokenOutMins = sdk.NewCoins(sdk.NewCoin("baz", sdk.NewInt(10)))
+				return msg
+			}),
+		},
+	}
+
+	for _, test := range tests {
+		if test.expectPass {
+			require.NoError(t, test.msg.ValidateBasic(), "test: %v", test.name)
+		} else {
+			require.Error(t, test.msg.ValidateBasic(), "test: %v", test.name)
+		}
+	}
+}
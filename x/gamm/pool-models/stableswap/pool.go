@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -15,8 +16,9 @@ import (
 )
 
 var (
-	_ swaproutertypes.PoolI = &Pool{}
-	_ types.CFMMPoolI       = &Pool{}
+	_ swaproutertypes.PoolI   = &Pool{}
+	_ types.CFMMPoolI         = &Pool{}
+	_ types.PokePoolExtension = &Pool{}
 )
 
 type unsortedPoolLiqError struct {
@@ -66,6 +68,10 @@ func NewStableswapPool(poolId uint64,
 		return Pool{}, err
 	}
 
+	if stableswapPoolParams.TakerFeeShare.IsNil() {
+		stableswapPoolParams.TakerFeeShare = sdk.ZeroDec()
+	}
+
 	pool := Pool{
 		Address:                 types.NewPoolAddress(poolId).String(),
 		Id:                      poolId,
@@ -108,6 +114,18 @@ func (p Pool) GetExitFee(ctx sdk.Context) sdk.Dec {
 	return p.PoolParams.ExitFee
 }
 
+// GetTakerFeeShare returns the pool's taker fee share, which is zero for pools created before it existed.
+func (p Pool) GetTakerFeeShare(ctx sdk.Context) sdk.Dec {
+	if p.PoolParams.TakerFeeShare.IsNil() {
+		return sdk.ZeroDec()
+	}
+	return p.PoolParams.TakerFeeShare
+}
+
+func (p Pool) GetTakerFeeRecipient(ctx sdk.Context) string {
+	return p.PoolParams.TakerFeeRecipient
+}
+
 func (p Pool) IsActive(ctx sdk.Context) bool {
 	return true
 }
@@ -410,14 +428,39 @@ func (p Pool) CalcExitPoolCoinsFromShares(ctx sdk.Context, exitingShares sdk.Int
 	return cfmm_common.CalcExitPool(ctx, &p, exitingShares, exitFee)
 }
 
+// ExitPoolToDenoms exits exitingShares from the pool to the given subset of its assets, by swapping
+// the exited tokens of the other pool assets into them. The swaps are charged the swap fee.
+func (p *Pool) ExitPoolToDenoms(ctx sdk.Context, exitingShares sdk.Int, tokenOutDenoms []string, exitFee, swapFee sdk.Dec) (tokensOut sdk.Coins, err error) {
+	pCopy := p.Copy()
+	tokensOut, err = pCopy.exitPoolToDenomsInternal(ctx, exitingShares, tokenOutDenoms, exitFee, swapFee)
+	if err != nil {
+		return sdk.Coins{}, err
+	}
+	*p = pCopy
+	return tokensOut, nil
+}
+
+// CalcExitPoolToDenoms returns the tokens ExitPoolToDenoms would exit, without mutating the pool.
+func (p Pool) CalcExitPoolToDenoms(ctx sdk.Context, exitingShares sdk.Int, tokenOutDenoms []string, exitFee, swapFee sdk.Dec) (tokensOut sdk.Coins, err error) {
+	pCopy := p.Copy()
+	return pCopy.exitPoolToDenomsInternal(ctx, exitingShares, tokenOutDenoms, exitFee, swapFee)
+}
+
 // SetScalingFactors sets scaling factors for pool to the given amount
-// It should only be able to be successfully called by the pool's ScalingFactorGovernor
+// It should only be able to be successfully called by the pool's ScalingFactorGovernor,
+// or by its scaling factor rate provider contract.
+// If changeDuration is positive, the scaling factors change linearly from their current values
+// to the given ones over changeDuration, see PokePool. Otherwise, they are set immediately.
 // TODO: move commented test for this function from x/gamm/keeper/pool_service_test.go once a pool_test.go file has been created for stableswap
-func (p *Pool) SetScalingFactors(ctx sdk.Context, scalingFactors []uint64, sender string) error {
-	if sender != p.ScalingFactorController {
+func (p *Pool) SetScalingFactors(ctx sdk.Context, scalingFactors []uint64, changeDuration time.Duration, sender string) error {
+	if sender != p.ScalingFactorController && (p.ScalingFactorRateProvider == nil || sender != p.ScalingFactorRateProvider.ContractAddress) {
 		return types.ErrNotScalingFactorGovernor
 	}
 
+	if changeDuration < 0 {
+		return types.ErrInvalidScalingFactorChange
+	}
+
 	scalingFactors = applyScalingFactorMultiplier(scalingFactors)
 
 	if err := validateScalingFactors(scalingFactors, p.PoolLiquidity.Len()); err != nil {
@@ -428,10 +471,90 @@ func (p *Pool) SetScalingFactors(ctx sdk.Context, scalingFactors []uint64, sende
 		return err
 	}
 
-	p.ScalingFactors = scalingFactors
+	// The new change starts from wherever an ongoing change has got to.
+	p.PokePool(ctx.BlockTime())
+
+	if changeDuration == 0 {
+		p.ScalingFactors = scalingFactors
+		p.ScalingFactorChange = nil
+		return nil
+	}
+
+	p.ScalingFactorChange = &ScalingFactorChange{
+		StartTime:             ctx.BlockTime(),
+		Duration:              changeDuration,
+		InitialScalingFactors: p.ScalingFactors,
+		TargetScalingFactors:  scalingFactors,
+	}
 	return nil
 }
 
+// SetScalingFactorRateProvider sets the contract that updates the pool's scaling factors,
+// or removes it if rateProvider is nil.
+// It should only be able to be successfully called by the pool's ScalingFactorGovernor.
+func (p *Pool) SetScalingFactorRateProvider(rateProvider *ScalingFactorRateProvider, sender string) error {
+	if sender != p.ScalingFactorController {
+		return types.ErrNotScalingFactorGovernor
+	}
+
+	if rateProvider != nil {
+		if err := rateProvider.Validate(); err != nil {
+			return err
+		}
+	}
+
+	p.ScalingFactorRateProvider = rateProvider
+	return nil
+}
+
+// PokePool checks to see if the pool's scaling factors are changing, and
+// if so, updates them to their values at blockTime.
+func (p *Pool) PokePool(blockTime time.Time) {
+	if p.ScalingFactorChange == nil {
+		return
+	}
+
+	change := *p.ScalingFactorChange
+
+	// The scaling factors s(t) for the pool at time `t` are defined in one of three
+	// possible ways:
+	//
+	// 1. t <= start_time: s(t) = initial_scaling_factors
+	//
+	// 2. start_time < t < start_time + duration:
+	//     s(t) = initial_scaling_factors + (t - start_time) *
+	//       (target_scaling_factors - initial_scaling_factors) / (duration)
+	//
+	// 3. t >= start_time + duration: s(t) = target_scaling_factors
+	switch {
+	case !blockTime.After(change.StartTime):
+		// case 1: t <= start_time
+		p.ScalingFactors = change.InitialScalingFactors
+		return
+
+	case !blockTime.Before(change.StartTime.Add(change.Duration)):
+		// case 3: t >= start_time + duration
+		p.ScalingFactors = change.TargetScalingFactors
+
+		// we've finished updating the scaling factors, so reset the change
+		p.ScalingFactorChange = nil
+		return
+
+	default:
+		// case 2: start_time < t < start_time + duration
+		shiftedBlockTime := blockTime.Sub(change.StartTime).Milliseconds()
+		percentDurationElapsed := sdk.NewDec(shiftedBlockTime).QuoInt64(change.Duration.Milliseconds())
+
+		scalingFactors := make([]uint64, len(change.TargetScalingFactors))
+		for i := range scalingFactors {
+			initial := sdk.NewIntFromUint64(change.InitialScalingFactors[i])
+			diff := sdk.NewIntFromUint64(change.TargetScalingFactors[i]).Sub(initial)
+			scalingFactors[i] = initial.Add(diff.ToDec().Mul(percentDurationElapsed).TruncateInt()).Uint64()
+		}
+		p.ScalingFactors = scalingFactors
+	}
+}
+
 func validateScalingFactorController(scalingFactorController string) error {
 	if len(scalingFactorController) == 0 {
 		return nil
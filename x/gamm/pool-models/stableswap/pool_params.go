@@ -22,5 +22,6 @@ func (params PoolParams) Validate() error {
 	if params.SwapFee.GTE(sdk.OneDec()) {
 		return types.ErrTooMuchSwapFee
 	}
-	return nil
+
+	return types.ValidateTakerFeeParams(params.TakerFeeShare, params.TakerFeeRecipient)
 }
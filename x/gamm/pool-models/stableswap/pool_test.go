@@ -3,6 +3,7 @@ package stableswap
 
 import (
 	"testing"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
@@ -1044,6 +1045,98 @@ func TestExitPool(t *testing.T) {
 	}
 }
 
+func TestExitPoolToDenoms(t *testing.T) {
+	type testcase struct {
+		sharesIn             sdk.Int
+		initialPoolLiquidity sdk.Coins
+		scalingFactors       []uint64
+		tokenOutDenoms       []string
+		expectPass           bool
+	}
+	tests := map[string]testcase{
+		"three-asset pool exit to one denom": {
+			sharesIn:             types.InitPoolSharesSupply.Quo(sdk.NewInt(10)),
+			initialPoolLiquidity: threeEvenStablePoolAssets,
+			scalingFactors:       defaultThreeAssetScalingFactors,
+			tokenOutDenoms:       []string{"asset/b"},
+			expectPass:           true,
+		},
+		"five-asset pool exit to two denoms": {
+			sharesIn:             types.InitPoolSharesSupply.Quo(sdk.NewInt(10)),
+			initialPoolLiquidity: fiveEvenStablePoolAssets,
+			scalingFactors:       defaultFiveAssetScalingFactors,
+			tokenOutDenoms:       []string{"asset/a", "asset/d"},
+			expectPass:           true,
+		},
+		"exit to all denoms is a regular exit": {
+			sharesIn:             types.InitPoolSharesSupply.Quo(sdk.NewInt(10)),
+			initialPoolLiquidity: threeUnevenStablePoolAssets,
+			scalingFactors:       defaultThreeAssetScalingFactors,
+			tokenOutDenoms:       []string{"asset/a", "asset/b", "asset/c"},
+			expectPass:           true,
+		},
+		"no denoms": {
+			sharesIn:             types.InitPoolSharesSupply.Quo(sdk.NewInt(10)),
+			initialPoolLiquidity: threeEvenStablePoolAssets,
+			scalingFactors:       defaultThreeAssetScalingFactors,
+			tokenOutDenoms:       []string{},
+			expectPass:           false,
+		},
+		"denom not in pool": {
+			sharesIn:             types.InitPoolSharesSupply.Quo(sdk.NewInt(10)),
+			initialPoolLiquidity: threeEvenStablePoolAssets,
+			scalingFactors:       defaultThreeAssetScalingFactors,
+			tokenOutDenoms:       []string{"asset/a", "asset/z"},
+			expectPass:           false,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx := sdk.Context{}
+			p := poolStructFromAssets(tc.initialPoolLiquidity, tc.scalingFactors)
+			regularExitTokens, err := p.CalcExitPoolCoinsFromShares(ctx, tc.sharesIn, defaultExitFee)
+			require.NoError(t, err)
+
+			calcTokensOut, calcErr := p.CalcExitPoolToDenoms(ctx, tc.sharesIn, tc.tokenOutDenoms, defaultExitFee, defaultSwapFee)
+			// CalcExitPoolToDenoms must not mutate the pool
+			require.Equal(t, tc.initialPoolLiquidity, p.PoolLiquidity)
+
+			tokensOut, err := p.ExitPoolToDenoms(ctx, tc.sharesIn, tc.tokenOutDenoms, defaultExitFee, defaultSwapFee)
+			osmoassert.ConditionalError(t, !tc.expectPass, err)
+			osmoassert.ConditionalError(t, !tc.expectPass, calcErr)
+			if !tc.expectPass {
+				require.Equal(t, tc.initialPoolLiquidity, p.PoolLiquidity)
+				return
+			}
+
+			require.Equal(t, calcTokensOut, tokensOut)
+			require.Equal(t, tc.initialPoolLiquidity.Sub(tokensOut), p.PoolLiquidity)
+			require.Equal(t, types.InitPoolSharesSupply.Sub(tc.sharesIn), p.TotalShares.Amount)
+			for _, coin := range tokensOut {
+				require.Contains(t, tc.tokenOutDenoms, coin.Denom)
+			}
+
+			// the swaps only add to the exited tokens of tokenOutDenoms, and as even pools value all assets
+			// at par, they incur slippage and swap fees on the other exited tokens
+			require.True(t, tokensOut.IsAllGTE(regularExitTokens.FilterDenoms(tc.tokenOutDenoms)))
+			if len(tc.tokenOutDenoms) < len(tc.initialPoolLiquidity) {
+				require.True(t, sumAmounts(tokensOut).LT(sumAmounts(regularExitTokens)))
+			} else {
+				require.Equal(t, regularExitTokens, tokensOut)
+			}
+		})
+	}
+}
+
+func sumAmounts(coins sdk.Coins) sdk.Int {
+	sum := sdk.ZeroInt()
+	for _, coin := range coins {
+		sum = sum.Add(coin.Amount)
+	}
+	return sum
+}
+
 func TestValidatePoolLiquidity(t *testing.T) {
 	const (
 		a = "aaa"
@@ -1127,11 +1220,18 @@ func TestSetScalingFactors(t *testing.T) {
 	failPk := ed25519.GenPrivKey().PubKey()
 	failAddr := sdk.AccAddress(failPk.Address())
 
+	rateProviderPk := ed25519.GenPrivKey().PubKey()
+	rateProviderAddr := sdk.AccAddress(rateProviderPk.Address())
+
+	blockTime := time.Unix(1_000_000, 0).UTC()
+
 	tests := map[string]struct {
-		scalingFactors []uint64
-		sender         string
-		poolAssets     sdk.Coins
-		expError       error
+		scalingFactors         []uint64
+		changeDuration         time.Duration
+		sender                 string
+		poolAssets             sdk.Coins
+		expError               error
+		expScalingFactorChange *ScalingFactorChange
 	}{
 		"Sender is not scaling factor governor in pool": {
 			scalingFactors: defaultTwoAssetScalingFactors,
@@ -1152,6 +1252,13 @@ func TestSetScalingFactors(t *testing.T) {
 			poolAssets:     sdk.NewCoins(sdk.NewInt64Coin("foo", 1000000000)),
 			expError:       types.ErrTooFewPoolAssets,
 		},
+		"Negative change duration": {
+			scalingFactors: defaultTwoAssetScalingFactors,
+			changeDuration: -time.Hour,
+			sender:         addr.String(),
+			poolAssets:     twoEvenStablePoolAssets,
+			expError:       types.ErrInvalidScalingFactorChange,
+		},
 		"Valid set scaling for two assets in pool": {
 			scalingFactors: defaultTwoAssetScalingFactors,
 			sender:         addr.String(),
@@ -1172,23 +1279,172 @@ func TestSetScalingFactors(t *testing.T) {
 			sender:         addr.String(),
 			poolAssets:     threeUnevenStablePoolAssets,
 		},
+		"Valid set scaling by rate provider contract": {
+			scalingFactors: defaultTwoAssetScalingFactors,
+			sender:         rateProviderAddr.String(),
+			poolAssets:     twoEvenStablePoolAssets,
+		},
+		"Valid set scaling over change duration": {
+			scalingFactors: []uint64{3, 5},
+			changeDuration: time.Hour,
+			sender:         addr.String(),
+			poolAssets:     twoEvenStablePoolAssets,
+			expScalingFactorChange: &ScalingFactorChange{
+				StartTime:             blockTime,
+				Duration:              time.Hour,
+				InitialScalingFactors: applyScalingFactorMultiplier(defaultTwoAssetScalingFactors),
+				TargetScalingFactors:  applyScalingFactorMultiplier([]uint64{3, 5}),
+			},
+		},
 	}
 	for name, tc := range tests {
 		t.Run(name, func(t *testing.T) {
-			ctx := sdk.Context{}
-			pool := poolStructFromAssets(tc.poolAssets, tc.scalingFactors)
+			ctx := sdk.Context{}.WithBlockTime(blockTime)
+			initialScalingFactors := make([]uint64, len(tc.poolAssets))
+			for i := range initialScalingFactors {
+				initialScalingFactors[i] = 1
+			}
+			pool := poolStructFromAssets(tc.poolAssets, initialScalingFactors)
 			pool.ScalingFactorController = addr.String()
-			err := pool.SetScalingFactors(ctx, tc.scalingFactors, tc.sender)
+			pool.ScalingFactorRateProvider = &ScalingFactorRateProvider{ContractAddress: rateProviderAddr.String()}
+			err := pool.SetScalingFactors(ctx, tc.scalingFactors, tc.changeDuration, tc.sender)
 			if tc.expError != nil {
 				require.Error(t, err)
 				require.Equal(t, err, tc.expError)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.expScalingFactorChange, pool.ScalingFactorChange)
+			if tc.expScalingFactorChange == nil {
+				require.Equal(t, applyScalingFactorMultiplier(tc.scalingFactors), pool.ScalingFactors)
 			} else {
-				require.NoError(t, err)
+				require.Equal(t, tc.expScalingFactorChange.InitialScalingFactors, pool.ScalingFactors)
+			}
+		})
+	}
+}
+
+func TestSetScalingFactorRateProvider(t *testing.T) {
+	addr := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	failAddr := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	contractAddr := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+
+	tests := map[string]struct {
+		rateProvider *ScalingFactorRateProvider
+		sender       string
+		expError     error
+	}{
+		"Sender is not scaling factor governor in pool": {
+			rateProvider: &ScalingFactorRateProvider{ContractAddress: contractAddr.String()},
+			sender:       failAddr.String(),
+			expError:     types.ErrNotScalingFactorGovernor,
+		},
+		"Invalid contract address": {
+			rateProvider: &ScalingFactorRateProvider{ContractAddress: "invalid"},
+			sender:       addr.String(),
+			expError:     types.ErrInvalidRateProvider,
+		},
+		"Negative change duration": {
+			rateProvider: &ScalingFactorRateProvider{ContractAddress: contractAddr.String(), ChangeDuration: -time.Hour},
+			sender:       addr.String(),
+			expError:     types.ErrInvalidScalingFactorChange,
+		},
+		"Valid set rate provider": {
+			rateProvider: &ScalingFactorRateProvider{ContractAddress: contractAddr.String(), ChangeDuration: time.Hour},
+			sender:       addr.String(),
+		},
+		"Valid remove rate provider": {
+			rateProvider: nil,
+			sender:       addr.String(),
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			pool := poolStructFromAssets(twoEvenStablePoolAssets, defaultTwoAssetScalingFactors)
+			pool.ScalingFactorController = addr.String()
+			pool.ScalingFactorRateProvider = &ScalingFactorRateProvider{ContractAddress: failAddr.String()}
+			err := pool.SetScalingFactorRateProvider(tc.rateProvider, tc.sender)
+			if tc.expError != nil {
+				require.ErrorIs(t, err, tc.expError)
+				return
 			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.rateProvider, pool.ScalingFactorRateProvider)
 		})
 	}
 }
 
+func TestStableswapPokePool(t *testing.T) {
+	startTime := time.Unix(1_000_000, 0).UTC()
+	change := &ScalingFactorChange{
+		StartTime:             startTime,
+		Duration:              100 * time.Second,
+		InitialScalingFactors: []uint64{1000, 5000},
+		TargetScalingFactors:  []uint64{2000, 1000},
+	}
+
+	tests := map[string]struct {
+		blockTime         time.Time
+		expScalingFactors []uint64
+		expChangeFinished bool
+	}{
+		"before start time": {
+			blockTime:         startTime.Add(-time.Second),
+			expScalingFactors: []uint64{1000, 5000},
+		},
+		"at start time": {
+			blockTime:         startTime,
+			expScalingFactors: []uint64{1000, 5000},
+		},
+		"quarter of the way": {
+			blockTime:         startTime.Add(25 * time.Second),
+			expScalingFactors: []uint64{1250, 4000},
+		},
+		"half of the way": {
+			blockTime:         startTime.Add(50 * time.Second),
+			expScalingFactors: []uint64{1500, 3000},
+		},
+		"truncates intermediate scaling factors": {
+			blockTime:         startTime.Add(33 * time.Second),
+			expScalingFactors: []uint64{1330, 3680},
+		},
+		"at end time": {
+			blockTime:         startTime.Add(100 * time.Second),
+			expScalingFactors: []uint64{2000, 1000},
+			expChangeFinished: true,
+		},
+		"after end time": {
+			blockTime:         startTime.Add(time.Hour),
+			expScalingFactors: []uint64{2000, 1000},
+			expChangeFinished: true,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			pool := poolStructFromAssets(twoEvenStablePoolAssets, change.InitialScalingFactors)
+			changeCopy := *change
+			pool.ScalingFactorChange = &changeCopy
+
+			pool.PokePool(tc.blockTime)
+
+			require.Equal(t, tc.expScalingFactors, pool.ScalingFactors)
+			if tc.expChangeFinished {
+				require.Nil(t, pool.ScalingFactorChange)
+			} else {
+				require.Equal(t, change, pool.ScalingFactorChange)
+			}
+		})
+	}
+
+	t.Run("no change", func(t *testing.T) {
+		pool := poolStructFromAssets(twoEvenStablePoolAssets, defaultTwoAssetScalingFactors)
+		pool.PokePool(startTime)
+		require.Equal(t, applyScalingFactorMultiplier(defaultTwoAssetScalingFactors), pool.ScalingFactors)
+	})
+}
+
 func TestStableswapSpotPrice(t *testing.T) {
 	type testcase struct {
 		baseDenom      string
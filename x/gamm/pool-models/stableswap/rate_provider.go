@@ -0,0 +1,51 @@
+package stableswap
+
+import (
+	"encoding/json"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/osmosis-labs/osmosis/v13/x/gamm/types"
+)
+
+// Validate returns an error if the rate provider contract address is invalid,
+// or its change duration is negative.
+func (rp ScalingFactorRateProvider) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(rp.ContractAddress); err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidRateProvider, "invalid contract address (%s)", err)
+	}
+	if rp.ChangeDuration < 0 {
+		return types.ErrInvalidScalingFactorChange
+	}
+	return nil
+}
+
+// RateProviderQueryMsg is the query sent to a scaling factor rate provider contract
+// for the scaling factors of a pool. The scaling factors are expected in the order of
+// the pool's liquidity denoms, which are sorted.
+type RateProviderQueryMsg struct {
+	ScalingFactors ScalingFactorsQuery `json:"scaling_factors"`
+}
+
+type ScalingFactorsQuery struct {
+	PoolId uint64   `json:"pool_id"`
+	Denoms []string `json:"denoms"`
+}
+
+// ScalingFactorsResponse is the response of a scaling factor rate provider contract
+// to a RateProviderQueryMsg.
+type ScalingFactorsResponse struct {
+	ScalingFactors []uint64 `json:"scaling_factors"`
+}
+
+// NewRateProviderQueryMsg returns the query for the scaling factors of the given pool.
+func NewRateProviderQueryMsg(p Pool) ([]byte, error) {
+	denoms := make([]string, len(p.PoolLiquidity))
+	for i, coin := range p.PoolLiquidity {
+		denoms[i] = coin.Denom
+	}
+	return json.Marshal(RateProviderQueryMsg{
+		ScalingFactors: ScalingFactorsQuery{PoolId: p.Id, Denoms: denoms},
+	})
+}
@@ -7,20 +7,23 @@ import (
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
-	types "github.com/cosmos/cosmos-sdk/types"
+	types1 "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/x/auth/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	_ "github.com/gogo/protobuf/types"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -35,6 +38,13 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type PoolParams struct {
 	SwapFee github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=swap_fee,json=swapFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"swap_fee" yaml:"swap_fee"`
 	ExitFee github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=exit_fee,json=exitFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"exit_fee" yaml:"exit_fee"`
+	// taker_fee_share is the share of the swap fee that is sent to the
+	// taker_fee_recipient instead of the pool's LPs. It is capped by the gamm
+	// max_taker_fee_share param.
+	TakerFeeShare github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=taker_fee_share,json=takerFeeShare,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"taker_fee_share" yaml:"taker_fee_share"`
+	// taker_fee_recipient is the address taker fees are sent to.
+	// If empty, they are sent to the community pool.
+	TakerFeeRecipient string `protobuf:"bytes,4,opt,name=taker_fee_recipient,json=takerFeeRecipient,proto3" json:"taker_fee_recipient,omitempty" yaml:"taker_fee_recipient"`
 }
 
 func (m *PoolParams) Reset()         { *m = PoolParams{} }
@@ -70,6 +80,147 @@ func (m *PoolParams) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_PoolParams proto.InternalMessageInfo
 
+func (m *PoolParams) GetTakerFeeRecipient() string {
+	if m != nil {
+		return m.TakerFeeRecipient
+	}
+	return ""
+}
+
+// ScalingFactorChange defines a linear change of a pool's scaling factors
+// over time, so that adjusting them does not make the pool's price jump.
+type ScalingFactorChange struct {
+	// The start time of the scaling factor change.
+	StartTime time.Time `protobuf:"bytes,1,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time" yaml:"start_time"`
+	// Duration for the scaling factors to change over.
+	Duration time.Duration `protobuf:"bytes,2,opt,name=duration,proto3,stdduration" json:"duration,omitempty" yaml:"duration"`
+	// The scaling factors of the pool when the change started.
+	InitialScalingFactors []uint64 `protobuf:"varint,3,rep,packed,name=initial_scaling_factors,json=initialScalingFactors,proto3" json:"initial_scaling_factors,omitempty" yaml:"initial_scaling_factors"`
+	// The scaling factors change linearly with respect to time from the
+	// initial_scaling_factors at start_time to the target_scaling_factors at
+	// start_time + duration.
+	TargetScalingFactors []uint64 `protobuf:"varint,4,rep,packed,name=target_scaling_factors,json=targetScalingFactors,proto3" json:"target_scaling_factors,omitempty" yaml:"target_scaling_factors"`
+}
+
+func (m *ScalingFactorChange) Reset()         { *m = ScalingFactorChange{} }
+func (m *ScalingFactorChange) String() string { return proto.CompactTextString(m) }
+func (*ScalingFactorChange) ProtoMessage()    {}
+func (*ScalingFactorChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ae0f054436f9999a, []int{1}
+}
+func (m *ScalingFactorChange) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScalingFactorChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScalingFactorChange.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ScalingFactorChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScalingFactorChange.Merge(m, src)
+}
+func (m *ScalingFactorChange) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScalingFactorChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScalingFactorChange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScalingFactorChange proto.InternalMessageInfo
+
+func (m *ScalingFactorChange) GetStartTime() time.Time {
+	if m != nil {
+		return m.StartTime
+	}
+	return time.Time{}
+}
+
+func (m *ScalingFactorChange) GetDuration() time.Duration {
+	if m != nil {
+		return m.Duration
+	}
+	return 0
+}
+
+func (m *ScalingFactorChange) GetInitialScalingFactors() []uint64 {
+	if m != nil {
+		return m.InitialScalingFactors
+	}
+	return nil
+}
+
+func (m *ScalingFactorChange) GetTargetScalingFactors() []uint64 {
+	if m != nil {
+		return m.TargetScalingFactors
+	}
+	return nil
+}
+
+// ScalingFactorRateProvider is a CosmWasm contract, such as a liquid staking
+// exchange rate oracle, that the pool's scaling factors are kept in sync with.
+// It is queried for new scaling factors at the end of each
+// scaling_factor_rate_provider_epoch_identifier epoch of x/gamm.
+type ScalingFactorRateProvider struct {
+	ContractAddress string `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty" yaml:"contract_address"`
+	// Duration for the scaling factors to change over to the scaling factors
+	// returned by the contract.
+	ChangeDuration time.Duration `protobuf:"bytes,2,opt,name=change_duration,json=changeDuration,proto3,stdduration" json:"change_duration,omitempty" yaml:"change_duration"`
+}
+
+func (m *ScalingFactorRateProvider) Reset()         { *m = ScalingFactorRateProvider{} }
+func (m *ScalingFactorRateProvider) String() string { return proto.CompactTextString(m) }
+func (*ScalingFactorRateProvider) ProtoMessage()    {}
+func (*ScalingFactorRateProvider) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ae0f054436f9999a, []int{2}
+}
+func (m *ScalingFactorRateProvider) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScalingFactorRateProvider) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScalingFactorRateProvider.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ScalingFactorRateProvider) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScalingFactorRateProvider.Merge(m, src)
+}
+func (m *ScalingFactorRateProvider) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScalingFactorRateProvider) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScalingFactorRateProvider.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScalingFactorRateProvider proto.InternalMessageInfo
+
+func (m *ScalingFactorRateProvider) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
+	}
+	return ""
+}
+
+func (m *ScalingFactorRateProvider) GetChangeDuration() time.Duration {
+	if m != nil {
+		return m.ChangeDuration
+	}
+	return 0
+}
+
 // Pool is the stableswap Pool struct
 type Pool struct {
 	Address    string     `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty" yaml:"address"`
@@ -85,19 +236,25 @@ type Pool struct {
 	// would need to be locked up to count in governance. 0w means no lockup.
 	FuturePoolGovernor string `protobuf:"bytes,4,opt,name=future_pool_governor,json=futurePoolGovernor,proto3" json:"future_pool_governor,omitempty" yaml:"future_pool_governor"`
 	// sum of all LP shares
-	TotalShares types.Coin `protobuf:"bytes,5,opt,name=total_shares,json=totalShares,proto3" json:"total_shares" yaml:"total_shares"`
+	TotalShares types1.Coin `protobuf:"bytes,5,opt,name=total_shares,json=totalShares,proto3" json:"total_shares" yaml:"total_shares"`
 	// assets in the pool
 	PoolLiquidity github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,6,rep,name=pool_liquidity,json=poolLiquidity,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"pool_liquidity"`
 	// for calculation amognst assets with different precisions
 	ScalingFactors []uint64 `protobuf:"varint,7,rep,packed,name=scaling_factors,json=scalingFactors,proto3" json:"scaling_factors,omitempty" yaml:"stableswap_scaling_factors"`
 	// scaling_factor_controller is the address can adjust pool scaling factors
 	ScalingFactorController string `protobuf:"bytes,8,opt,name=scaling_factor_controller,json=scalingFactorController,proto3" json:"scaling_factor_controller,omitempty" yaml:"scaling_factor_controller"`
+	// scaling_factor_change is the ongoing change of the scaling factors, if
+	// any.
+	ScalingFactorChange *ScalingFactorChange `protobuf:"bytes,9,opt,name=scaling_factor_change,json=scalingFactorChange,proto3" json:"scaling_factor_change,omitempty" yaml:"scaling_factor_change"`
+	// scaling_factor_rate_provider is the contract that updates the scaling
+	// factors, if any.
+	ScalingFactorRateProvider *ScalingFactorRateProvider `protobuf:"bytes,10,opt,name=scaling_factor_rate_provider,json=scalingFactorRateProvider,proto3" json:"scaling_factor_rate_provider,omitempty" yaml:"scaling_factor_rate_provider"`
 }
 
 func (m *Pool) Reset()      { *m = Pool{} }
 func (*Pool) ProtoMessage() {}
 func (*Pool) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ae0f054436f9999a, []int{1}
+	return fileDescriptor_ae0f054436f9999a, []int{3}
 }
 func (m *Pool) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -128,6 +285,8 @@ var xxx_messageInfo_Pool proto.InternalMessageInfo
 
 func init() {
 	proto.RegisterType((*PoolParams)(nil), "osmosis.gamm.poolmodels.stableswap.v1beta1.PoolParams")
+	proto.RegisterType((*ScalingFactorChange)(nil), "osmosis.gamm.poolmodels.stableswap.v1beta1.ScalingFactorChange")
+	proto.RegisterType((*ScalingFactorRateProvider)(nil), "osmosis.gamm.poolmodels.stableswap.v1beta1.ScalingFactorRateProvider")
 	proto.RegisterType((*Pool)(nil), "osmosis.gamm.poolmodels.stableswap.v1beta1.Pool")
 }
 
@@ -136,47 +295,70 @@ func init() {
 }
 
 var fileDescriptor_ae0f054436f9999a = []byte{
-	// 642 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x54, 0xcd, 0x6e, 0xd3, 0x40,
-	0x10, 0x8e, 0xdb, 0xb4, 0x29, 0x5b, 0x48, 0x85, 0xa9, 0x84, 0xdb, 0x0a, 0x6f, 0xb0, 0x28, 0x8a,
-	0x10, 0xb1, 0x29, 0x95, 0x90, 0xe8, 0xad, 0x29, 0x2a, 0x42, 0x42, 0xa8, 0x98, 0x13, 0x3f, 0x52,
-	0x58, 0xdb, 0x1b, 0x77, 0x85, 0x9d, 0x35, 0xbb, 0xeb, 0xd2, 0x5e, 0x38, 0x73, 0xe4, 0xc8, 0xb1,
-	0x67, 0xb8, 0xf2, 0x10, 0x15, 0xa7, 0x1e, 0x11, 0x07, 0x83, 0xda, 0x37, 0xc8, 0x13, 0xa0, 0x5d,
-	0xaf, 0xfb, 0x13, 0xa0, 0xaa, 0xc4, 0x29, 0x3b, 0x33, 0xdf, 0x7c, 0x33, 0xdf, 0xcc, 0xc4, 0xe0,
-	0x3e, 0xe5, 0x29, 0xe5, 0x84, 0x7b, 0x31, 0x4a, 0x53, 0x2f, 0xa3, 0x34, 0xe9, 0xa4, 0x34, 0xc2,
-	0x09, 0xf7, 0xb8, 0x40, 0x41, 0x82, 0xf9, 0x3b, 0x94, 0x9d, 0x78, 0xf6, 0x24, 0xc2, 0xcd, 0x18,
-	0x15, 0xd4, 0xbc, 0xa5, 0x53, 0x5d, 0x99, 0xea, 0xca, 0x40, 0x99, 0xe9, 0x1e, 0xc3, 0xdd, 0xad,
-	0xa5, 0x00, 0x0b, 0xb4, 0x34, 0x3f, 0x17, 0x2a, 0x70, 0x4f, 0x65, 0x7a, 0xa5, 0x51, 0xd2, 0xcc,
-	0xcf, 0xc6, 0x34, 0xa6, 0xa5, 0x5f, 0xbe, 0xb4, 0xd7, 0x8e, 0x29, 0x8d, 0x13, 0xec, 0x29, 0x2b,
-	0xc8, 0xfb, 0x5e, 0x94, 0x33, 0x24, 0x08, 0x1d, 0xe8, 0x38, 0x1c, 0x8d, 0x0b, 0x92, 0x62, 0x2e,
-	0x50, 0x9a, 0x55, 0x04, 0x65, 0x11, 0x0f, 0xe5, 0x62, 0xd3, 0xd3, 0x6d, 0x28, 0x63, 0x24, 0x1e,
-	0x20, 0x8e, 0x8f, 0xe2, 0x21, 0x25, 0xba, 0x80, 0xb3, 0x67, 0x00, 0xb0, 0x41, 0x69, 0xb2, 0x81,
-	0x18, 0x4a, 0xb9, 0xf9, 0x0a, 0x4c, 0x29, 0xfd, 0x7d, 0x8c, 0x2d, 0xa3, 0x65, 0xb4, 0x2f, 0x74,
-	0x57, 0xf7, 0x0a, 0x58, 0xfb, 0x51, 0xc0, 0x9b, 0x31, 0x11, 0x9b, 0x79, 0xe0, 0x86, 0x34, 0xd5,
-	0xc2, 0xf4, 0x4f, 0x87, 0x47, 0x6f, 0x3c, 0xb1, 0x93, 0x61, 0xee, 0x3e, 0xc0, 0xe1, 0xb0, 0x80,
-	0x33, 0x3b, 0x28, 0x4d, 0x56, 0x9c, 0x8a, 0xc7, 0xf1, 0x1b, 0xf2, 0xb9, 0x8e, 0xb1, 0x64, 0xc7,
-	0xdb, 0x44, 0x28, 0xf6, 0xb1, 0xff, 0x63, 0xaf, 0x78, 0x1c, 0xbf, 0x21, 0x9f, 0xeb, 0x18, 0x3b,
-	0x5f, 0x26, 0x40, 0x5d, 0x4a, 0x31, 0x6f, 0x83, 0x06, 0x8a, 0x22, 0x86, 0x39, 0xd7, 0x1a, 0xcc,
-	0x61, 0x01, 0x9b, 0x65, 0x9e, 0x0e, 0x38, 0x7e, 0x05, 0x31, 0x9b, 0x60, 0x8c, 0x44, 0xaa, 0x9d,
-	0xba, 0x3f, 0x46, 0x22, 0xf3, 0x3d, 0x98, 0x96, 0x4b, 0xee, 0x65, 0x6a, 0x22, 0xd6, 0x78, 0xcb,
-	0x68, 0x4f, 0xdf, 0xbd, 0xe7, 0x9e, 0xff, 0x0a, 0xdc, 0xe3, 0x79, 0x76, 0x17, 0xa5, 0xbe, 0x61,
-	0x01, 0xaf, 0xe9, 0x99, 0x9c, 0xbe, 0x30, 0x5d, 0xc3, 0xf1, 0x41, 0x76, 0xbc, 0x82, 0xa7, 0x60,
-	0xb6, 0x9f, 0x8b, 0x9c, 0xe1, 0x12, 0x12, 0xd3, 0x2d, 0xcc, 0x06, 0x94, 0x59, 0x75, 0x25, 0x05,
-	0x0e, 0x0b, 0xb8, 0x50, 0x92, 0xfd, 0x0d, 0xe5, 0xf8, 0x66, 0xe9, 0x96, 0x3d, 0x3c, 0xd4, 0x4e,
-	0xf3, 0x39, 0xb8, 0x28, 0xa8, 0x40, 0x49, 0x8f, 0x6f, 0x22, 0x86, 0xb9, 0x35, 0xa1, 0x34, 0xcd,
-	0xb9, 0xfa, 0x40, 0xe5, 0x6d, 0x1c, 0x35, 0xbf, 0x46, 0xc9, 0xa0, 0xbb, 0xa0, 0xdb, 0xbe, 0x52,
-	0x56, 0x3a, 0x99, 0xec, 0xf8, 0xd3, 0xca, 0x7c, 0xa6, 0x2c, 0x93, 0x81, 0xa6, 0x6a, 0x20, 0x21,
-	0x6f, 0x73, 0x12, 0x11, 0xb1, 0x63, 0x4d, 0xb6, 0xc6, 0xcf, 0x26, 0xbf, 0x23, 0xc9, 0x3f, 0xff,
-	0x84, 0xed, 0x73, 0xec, 0x5c, 0x26, 0x70, 0xff, 0x92, 0x2c, 0xf1, 0xb8, 0xaa, 0x60, 0x3e, 0x01,
-	0x33, 0x3c, 0x44, 0x09, 0x19, 0xc4, 0xbd, 0x3e, 0x0a, 0x05, 0x65, 0xdc, 0x6a, 0xb4, 0xc6, 0xdb,
-	0xf5, 0xee, 0xe2, 0xb0, 0x80, 0xd7, 0xff, 0x98, 0xf4, 0x08, 0xd6, 0xf1, 0x9b, 0xda, 0xb3, 0x5e,
-	0x3a, 0xcc, 0xd7, 0x60, 0xee, 0x34, 0xa6, 0x17, 0xd2, 0x81, 0x60, 0x34, 0x49, 0x30, 0xb3, 0xa6,
-	0xd4, 0xd8, 0x6f, 0x0c, 0x0b, 0xd8, 0xd2, 0xcc, 0xff, 0x82, 0x3a, 0xfe, 0xd5, 0x53, 0xc4, 0x6b,
-	0x47, 0x91, 0x95, 0xcb, 0x1f, 0x76, 0x61, 0xed, 0xd3, 0x2e, 0xac, 0x7d, 0xfb, 0xda, 0x99, 0x90,
-	0xab, 0x79, 0xd4, 0x7d, 0xb9, 0x77, 0x60, 0x1b, 0xfb, 0x07, 0xb6, 0xf1, 0xeb, 0xc0, 0x36, 0x3e,
-	0x1e, 0xda, 0xb5, 0xfd, 0x43, 0xbb, 0xf6, 0xfd, 0xd0, 0xae, 0xbd, 0x58, 0x3d, 0x31, 0x17, 0x7d,
-	0x75, 0x9d, 0x04, 0x05, 0xbc, 0x32, 0xbc, 0xad, 0xa5, 0x65, 0x6f, 0xfb, 0xac, 0x2f, 0x59, 0x30,
-	0xa9, 0xfe, 0xdc, 0xcb, 0xbf, 0x03, 0x00, 0x00, 0xff, 0xff, 0xeb, 0xf7, 0x52, 0xb8, 0xf7, 0x04,
+	// 1010 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0xcf, 0x6f, 0x1b, 0x45,
+	0x14, 0xf6, 0xc6, 0x69, 0x93, 0x8c, 0xa9, 0x4d, 0x36, 0x69, 0x63, 0x27, 0x8d, 0x27, 0x1d, 0x5a,
+	0x14, 0x41, 0xb3, 0x4b, 0x52, 0x09, 0x89, 0x5e, 0xaa, 0x38, 0x25, 0x80, 0x84, 0xaa, 0xb0, 0x45,
+	0x02, 0x0a, 0xd2, 0x32, 0x5e, 0x4f, 0xd6, 0xa3, 0xee, 0x7a, 0x96, 0x9d, 0x71, 0x68, 0x2e, 0x5c,
+	0xb8, 0x20, 0x0e, 0xa8, 0xc7, 0x72, 0xeb, 0x99, 0x2b, 0xfc, 0x11, 0x11, 0xa7, 0x1e, 0x11, 0x87,
+	0x2d, 0x4a, 0x4e, 0x70, 0xdc, 0x3b, 0x12, 0x9a, 0xd9, 0x59, 0xff, 0xd8, 0x38, 0x55, 0x4a, 0x4f,
+	0x9e, 0x79, 0xef, 0x7b, 0xdf, 0x7b, 0xb3, 0xef, 0xbd, 0x4f, 0x06, 0xef, 0x31, 0x1e, 0x32, 0x4e,
+	0xb9, 0xed, 0xe3, 0x30, 0xb4, 0x23, 0xc6, 0x82, 0x8d, 0x90, 0x75, 0x48, 0xc0, 0x6d, 0x2e, 0x70,
+	0x3b, 0x20, 0xfc, 0x5b, 0x1c, 0x8d, 0x1c, 0x5d, 0x89, 0xb0, 0xa2, 0x98, 0x09, 0x66, 0xbe, 0xa5,
+	0x43, 0x2d, 0x19, 0x6a, 0x49, 0x47, 0x16, 0x69, 0x0d, 0xe1, 0xd6, 0xc1, 0x66, 0x9b, 0x08, 0xbc,
+	0xb9, 0xdc, 0xf0, 0x14, 0xd8, 0x55, 0x91, 0x76, 0x76, 0xc9, 0x68, 0x96, 0x17, 0x7d, 0xe6, 0xb3,
+	0xcc, 0x2e, 0x4f, 0xda, 0xda, 0xf4, 0x19, 0xf3, 0x03, 0x62, 0xab, 0x5b, 0xbb, 0xbf, 0x6f, 0x77,
+	0xfa, 0x31, 0x16, 0x94, 0xf5, 0xb4, 0x1f, 0x16, 0xfd, 0x82, 0x86, 0x84, 0x0b, 0x1c, 0x46, 0x39,
+	0x41, 0x96, 0xc4, 0xc6, 0x7d, 0xd1, 0xb5, 0x75, 0x19, 0xea, 0x52, 0xf0, 0xb7, 0x31, 0x27, 0x03,
+	0xbf, 0xc7, 0xa8, 0x4e, 0x80, 0x7e, 0x2c, 0x03, 0xb0, 0xc7, 0x58, 0xb0, 0x87, 0x63, 0x1c, 0x72,
+	0xf3, 0x2b, 0x30, 0xab, 0xde, 0xbf, 0x4f, 0x48, 0xdd, 0x58, 0x33, 0xd6, 0xe7, 0x5a, 0xdb, 0x47,
+	0x09, 0x2c, 0xfd, 0x99, 0xc0, 0x37, 0x7d, 0x2a, 0xba, 0xfd, 0xb6, 0xe5, 0xb1, 0x50, 0x3f, 0x4c,
+	0xff, 0x6c, 0xf0, 0xce, 0x43, 0x5b, 0x1c, 0x46, 0x84, 0x5b, 0x77, 0x89, 0x97, 0x26, 0xb0, 0x76,
+	0x88, 0xc3, 0xe0, 0x36, 0xca, 0x79, 0x90, 0x33, 0x23, 0x8f, 0xbb, 0x84, 0x48, 0x76, 0xf2, 0x88,
+	0x0a, 0xc5, 0x3e, 0xf5, 0x6a, 0xec, 0x39, 0x0f, 0x72, 0x66, 0xe4, 0x51, 0xb2, 0x47, 0xa0, 0x26,
+	0xf0, 0x43, 0x12, 0x4b, 0xb3, 0xcb, 0xbb, 0x38, 0x26, 0xf5, 0xb2, 0x4a, 0xf2, 0xe1, 0x4b, 0x27,
+	0xb9, 0x92, 0x25, 0x29, 0xd0, 0x21, 0xe7, 0x92, 0xb2, 0xec, 0x12, 0x72, 0x5f, 0xde, 0xcd, 0x7b,
+	0x60, 0x61, 0x08, 0x89, 0x89, 0x47, 0x23, 0x4a, 0x7a, 0xa2, 0x3e, 0xad, 0xb2, 0x36, 0xd3, 0x04,
+	0x2e, 0x17, 0x79, 0x06, 0x20, 0xe4, 0xcc, 0xe7, 0x5c, 0xce, 0xc0, 0xf6, 0x53, 0x19, 0x2c, 0xdc,
+	0xf7, 0x70, 0x40, 0x7b, 0xfe, 0x2e, 0xf6, 0x04, 0x8b, 0x77, 0xba, 0xb8, 0xe7, 0x13, 0xf3, 0x73,
+	0x00, 0xb8, 0xc0, 0xb1, 0x70, 0x65, 0xf7, 0x55, 0x5f, 0x2a, 0x5b, 0xcb, 0x56, 0x36, 0x1a, 0x56,
+	0x3e, 0x1a, 0xd6, 0xa7, 0xf9, 0x68, 0xb4, 0x56, 0xe5, 0x83, 0xd3, 0x04, 0xce, 0xeb, 0x4e, 0x0c,
+	0x62, 0xd1, 0xe3, 0xe7, 0xd0, 0x70, 0xe6, 0x94, 0x41, 0xc2, 0xcd, 0x2e, 0x98, 0xcd, 0x27, 0x4e,
+	0x75, 0xa4, 0xb2, 0xd5, 0x38, 0xc5, 0x7b, 0x57, 0x03, 0x5a, 0x9b, 0x92, 0xf6, 0x9f, 0x04, 0x9a,
+	0x79, 0xc8, 0x4d, 0x16, 0x52, 0x41, 0xc2, 0x48, 0x1c, 0x0e, 0x1b, 0x93, 0xfb, 0xd0, 0x13, 0x99,
+	0x6a, 0xc0, 0x6e, 0x3e, 0x00, 0x4b, 0xb4, 0x47, 0x05, 0xc5, 0x81, 0xcb, 0xb3, 0x27, 0xba, 0xfb,
+	0xea, 0x8d, 0xbc, 0x5e, 0x5e, 0x2b, 0xaf, 0x4f, 0xb7, 0x50, 0x9a, 0xc0, 0x66, 0xc6, 0x71, 0x06,
+	0x10, 0x39, 0x97, 0xb5, 0x67, 0xec, 0x23, 0x71, 0xf3, 0x33, 0x70, 0x45, 0xe0, 0xd8, 0x27, 0xe2,
+	0x14, 0xf5, 0xb4, 0xa2, 0xbe, 0x96, 0x26, 0x70, 0x35, 0x6f, 0xc5, 0x24, 0x1c, 0x72, 0x16, 0x33,
+	0xc7, 0x38, 0x31, 0xfa, 0xdb, 0x00, 0x8d, 0x31, 0x93, 0x83, 0x05, 0xd9, 0x8b, 0xd9, 0x01, 0xed,
+	0x90, 0xd8, 0xdc, 0x05, 0xaf, 0x7b, 0xac, 0x27, 0x62, 0xec, 0x09, 0x17, 0x77, 0x3a, 0x31, 0xe1,
+	0x5c, 0x2f, 0xcd, 0x4a, 0x9a, 0xc0, 0xa5, 0x2c, 0x61, 0x11, 0x81, 0x9c, 0x5a, 0x6e, 0xda, 0xce,
+	0x2c, 0xe6, 0xf7, 0x06, 0xa8, 0x79, 0xaa, 0xd3, 0xee, 0xf9, 0x9b, 0x71, 0x47, 0x37, 0xa3, 0x51,
+	0x88, 0x1c, 0xeb, 0x89, 0x9e, 0xe3, 0x02, 0x24, 0x6b, 0x4d, 0x35, 0xb3, 0xe6, 0x84, 0xe8, 0xdf,
+	0x19, 0x30, 0x2d, 0x95, 0xc0, 0xbc, 0x09, 0x66, 0xc6, 0x5f, 0x63, 0xa6, 0x09, 0xac, 0x66, 0x4c,
+	0x83, 0x47, 0xe4, 0x10, 0xb3, 0x0a, 0xa6, 0x68, 0x47, 0x95, 0x3b, 0xed, 0x4c, 0xd1, 0x8e, 0xf9,
+	0x1d, 0xa8, 0x48, 0x8d, 0x74, 0x23, 0x25, 0x28, 0x6a, 0x03, 0x2b, 0x5b, 0xef, 0x5a, 0xe7, 0x17,
+	0x51, 0x6b, 0x28, 0x47, 0xad, 0x1b, 0x7a, 0x90, 0x57, 0x07, 0x83, 0x3c, 0x2a, 0xd0, 0x3a, 0x07,
+	0x72, 0x40, 0x34, 0x54, 0xb0, 0x4f, 0xc0, 0xe2, 0x7e, 0x5f, 0xf4, 0x63, 0x92, 0x41, 0x7c, 0x76,
+	0x40, 0xe2, 0x1e, 0x8b, 0xf5, 0x52, 0xc2, 0x34, 0x81, 0x2b, 0x19, 0xd9, 0x24, 0x14, 0x72, 0xcc,
+	0xcc, 0x2c, 0x6b, 0xf8, 0x40, 0x1b, 0xcd, 0x2f, 0xc0, 0x6b, 0x82, 0x09, 0x39, 0x8f, 0x72, 0xeb,
+	0x79, 0xfd, 0x82, 0xee, 0x8d, 0xd6, 0x77, 0x29, 0xad, 0x83, 0xe2, 0x77, 0x18, 0xed, 0xb5, 0x56,
+	0x74, 0xd9, 0x0b, 0x7a, 0xe6, 0x46, 0x82, 0x91, 0x53, 0x51, 0x57, 0x25, 0x20, 0xdc, 0x8c, 0x41,
+	0x55, 0x15, 0x10, 0xd0, 0x6f, 0xfa, 0xb4, 0x43, 0xc5, 0x61, 0xfd, 0xe2, 0x5a, 0xf9, 0xc5, 0xe4,
+	0xef, 0x48, 0xf2, 0x5f, 0x9e, 0xc3, 0xf5, 0x73, 0xa8, 0x99, 0x0c, 0xe0, 0xce, 0x25, 0x99, 0xe2,
+	0xe3, 0x3c, 0x83, 0x79, 0x0f, 0xd4, 0x8a, 0x6b, 0x32, 0xa3, 0xd6, 0xe4, 0x46, 0x9a, 0xc0, 0x6b,
+	0xa7, 0xbe, 0xf4, 0xa9, 0x55, 0xa9, 0xf2, 0xf1, 0xed, 0xfb, 0x1a, 0x34, 0xc6, 0x31, 0xae, 0x1a,
+	0x70, 0x16, 0x04, 0x24, 0xae, 0xcf, 0xaa, 0xcf, 0x7e, 0x3d, 0x4d, 0xe0, 0x9a, 0x66, 0x3e, 0x0b,
+	0x8a, 0x9c, 0xa5, 0x31, 0xe2, 0x9d, 0x81, 0xc7, 0xfc, 0xd9, 0x00, 0x97, 0x8b, 0x71, 0x6a, 0x78,
+	0xeb, 0x73, 0xaa, 0x15, 0x77, 0x5e, 0x66, 0xbc, 0x26, 0x08, 0x6c, 0xeb, 0xfa, 0x51, 0x02, 0x8d,
+	0x34, 0x81, 0x57, 0x27, 0xd7, 0xa8, 0x40, 0xc8, 0x59, 0xe0, 0x13, 0xb4, 0xf9, 0x57, 0x03, 0x5c,
+	0x2d, 0xe0, 0x63, 0x2c, 0x88, 0x1b, 0x69, 0x95, 0xa8, 0x03, 0x55, 0xe2, 0xfb, 0xff, 0xbb, 0xc4,
+	0x51, 0xc9, 0x69, 0xbd, 0xad, 0x0b, 0x7d, 0x63, 0x62, 0xa1, 0x63, 0x89, 0x91, 0xd3, 0xe0, 0x67,
+	0xf1, 0xdc, 0x9e, 0xff, 0xe1, 0x29, 0x2c, 0x3d, 0x79, 0x0a, 0x4b, 0xbf, 0xff, 0xb6, 0x71, 0x41,
+	0x0e, 0xfb, 0x47, 0xad, 0x2f, 0x8f, 0x8e, 0x9b, 0xc6, 0xb3, 0xe3, 0xa6, 0xf1, 0xd7, 0x71, 0xd3,
+	0x78, 0x7c, 0xd2, 0x2c, 0x3d, 0x3b, 0x69, 0x96, 0xfe, 0x38, 0x69, 0x96, 0x1e, 0x6c, 0x8f, 0x4c,
+	0x9a, 0x7e, 0xc5, 0x46, 0x80, 0xdb, 0x3c, 0xbf, 0xd8, 0x07, 0x9b, 0xb7, 0xec, 0x47, 0x2f, 0xfa,
+	0x6b, 0xd5, 0xbe, 0xa8, 0x04, 0xec, 0xd6, 0x7f, 0x03, 0x00, 0xdc, 0xb5, 0xda, 0x1e, 0x88, 0x09,
 	0x00, 0x00,
 }
 
@@ -200,6 +382,23 @@ func (m *PoolParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.TakerFeeRecipient) > 0 {
+		i -= len(m.TakerFeeRecipient)
+		copy(dAtA[i:], m.TakerFeeRecipient)
+		i = encodeVarintStableswapPool(dAtA, i, uint64(len(m.TakerFeeRecipient)))
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size := m.TakerFeeShare.Size()
+		i -= size
+		if _, err := m.TakerFeeShare.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStableswapPool(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
 	{
 		size := m.ExitFee.Size()
 		i -= size
@@ -223,6 +422,119 @@ func (m *PoolParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ScalingFactorChange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScalingFactorChange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScalingFactorChange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TargetScalingFactors) > 0 {
+		dAtA2 := make([]byte, len(m.TargetScalingFactors)*10)
+		var j1 int
+		for _, num := range m.TargetScalingFactors {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintStableswapPool(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.InitialScalingFactors) > 0 {
+		dAtA4 := make([]byte, len(m.InitialScalingFactors)*10)
+		var j3 int
+		for _, num := range m.InitialScalingFactors {
+			for num >= 1<<7 {
+				dAtA4[j3] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j3++
+			}
+			dAtA4[j3] = uint8(num)
+			j3++
+		}
+		i -= j3
+		copy(dAtA[i:], dAtA4[:j3])
+		i = encodeVarintStableswapPool(dAtA, i, uint64(j3))
+		i--
+		dAtA[i] = 0x1a
+	}
+	n5, err5 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Duration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Duration):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintStableswapPool(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0x12
+	n6, err6 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime):])
+	if err6 != nil {
+		return 0, err6
+	}
+	i -= n6
+	i = encodeVarintStableswapPool(dAtA, i, uint64(n6))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ScalingFactorRateProvider) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScalingFactorRateProvider) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScalingFactorRateProvider) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n7, err7 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.ChangeDuration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.ChangeDuration):])
+	if err7 != nil {
+		return 0, err7
+	}
+	i -= n7
+	i = encodeVarintStableswapPool(dAtA, i, uint64(n7))
+	i--
+	dAtA[i] = 0x12
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintStableswapPool(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Pool) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -243,6 +555,30 @@ func (m *Pool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ScalingFactorRateProvider != nil {
+		{
+			size, err := m.ScalingFactorRateProvider.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintStableswapPool(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.ScalingFactorChange != nil {
+		{
+			size, err := m.ScalingFactorChange.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintStableswapPool(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
 	if len(m.ScalingFactorController) > 0 {
 		i -= len(m.ScalingFactorController)
 		copy(dAtA[i:], m.ScalingFactorController)
@@ -251,20 +587,20 @@ func (m *Pool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x42
 	}
 	if len(m.ScalingFactors) > 0 {
-		dAtA2 := make([]byte, len(m.ScalingFactors)*10)
-		var j1 int
+		dAtA11 := make([]byte, len(m.ScalingFactors)*10)
+		var j10 int
 		for _, num := range m.ScalingFactors {
 			for num >= 1<<7 {
-				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA11[j10] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j1++
+				j10++
 			}
-			dAtA2[j1] = uint8(num)
-			j1++
+			dAtA11[j10] = uint8(num)
+			j10++
 		}
-		i -= j1
-		copy(dAtA[i:], dAtA2[:j1])
-		i = encodeVarintStableswapPool(dAtA, i, uint64(j1))
+		i -= j10
+		copy(dAtA[i:], dAtA11[:j10])
+		i = encodeVarintStableswapPool(dAtA, i, uint64(j10))
 		i--
 		dAtA[i] = 0x3a
 	}
@@ -275,127 +611,635 @@ func (m *Pool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				if err != nil {
 					return 0, err
 				}
-				i -= size
-				i = encodeVarintStableswapPool(dAtA, i, uint64(size))
+				i -= size
+				i = encodeVarintStableswapPool(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	{
+		size, err := m.TotalShares.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintStableswapPool(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.FuturePoolGovernor) > 0 {
+		i -= len(m.FuturePoolGovernor)
+		copy(dAtA[i:], m.FuturePoolGovernor)
+		i = encodeVarintStableswapPool(dAtA, i, uint64(len(m.FuturePoolGovernor)))
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size, err := m.PoolParams.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintStableswapPool(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.Id != 0 {
+		i = encodeVarintStableswapPool(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintStableswapPool(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintStableswapPool(dAtA []byte, offset int, v uint64) int {
+	offset -= sovStableswapPool(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *PoolParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.SwapFee.Size()
+	n += 1 + l + sovStableswapPool(uint64(l))
+	l = m.ExitFee.Size()
+	n += 1 + l + sovStableswapPool(uint64(l))
+	l = m.TakerFeeShare.Size()
+	n += 1 + l + sovStableswapPool(uint64(l))
+	l = len(m.TakerFeeRecipient)
+	if l > 0 {
+		n += 1 + l + sovStableswapPool(uint64(l))
+	}
+	return n
+}
+
+func (m *ScalingFactorChange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovStableswapPool(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Duration)
+	n += 1 + l + sovStableswapPool(uint64(l))
+	if len(m.InitialScalingFactors) > 0 {
+		l = 0
+		for _, e := range m.InitialScalingFactors {
+			l += sovStableswapPool(uint64(e))
+		}
+		n += 1 + sovStableswapPool(uint64(l)) + l
+	}
+	if len(m.TargetScalingFactors) > 0 {
+		l = 0
+		for _, e := range m.TargetScalingFactors {
+			l += sovStableswapPool(uint64(e))
+		}
+		n += 1 + sovStableswapPool(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *ScalingFactorRateProvider) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovStableswapPool(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.ChangeDuration)
+	n += 1 + l + sovStableswapPool(uint64(l))
+	return n
+}
+
+func (m *Pool) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovStableswapPool(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovStableswapPool(uint64(m.Id))
+	}
+	l = m.PoolParams.Size()
+	n += 1 + l + sovStableswapPool(uint64(l))
+	l = len(m.FuturePoolGovernor)
+	if l > 0 {
+		n += 1 + l + sovStableswapPool(uint64(l))
+	}
+	l = m.TotalShares.Size()
+	n += 1 + l + sovStableswapPool(uint64(l))
+	if len(m.PoolLiquidity) > 0 {
+		for _, e := range m.PoolLiquidity {
+			l = e.Size()
+			n += 1 + l + sovStableswapPool(uint64(l))
+		}
+	}
+	if len(m.ScalingFactors) > 0 {
+		l = 0
+		for _, e := range m.ScalingFactors {
+			l += sovStableswapPool(uint64(e))
+		}
+		n += 1 + sovStableswapPool(uint64(l)) + l
+	}
+	l = len(m.ScalingFactorController)
+	if l > 0 {
+		n += 1 + l + sovStableswapPool(uint64(l))
+	}
+	if m.ScalingFactorChange != nil {
+		l = m.ScalingFactorChange.Size()
+		n += 1 + l + sovStableswapPool(uint64(l))
+	}
+	if m.ScalingFactorRateProvider != nil {
+		l = m.ScalingFactorRateProvider.Size()
+		n += 1 + l + sovStableswapPool(uint64(l))
+	}
+	return n
+}
+
+func sovStableswapPool(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozStableswapPool(x uint64) (n int) {
+	return sovStableswapPool(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *PoolParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStableswapPool
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PoolParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PoolParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStableswapPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStableswapPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStableswapPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SwapFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExitFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStableswapPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStableswapPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStableswapPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ExitFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TakerFeeShare", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStableswapPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStableswapPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStableswapPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TakerFeeShare.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TakerFeeRecipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStableswapPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStableswapPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStableswapPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TakerFeeRecipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStableswapPool(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStableswapPool
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ScalingFactorChange) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStableswapPool
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ScalingFactorChange: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ScalingFactorChange: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStableswapPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStableswapPool
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStableswapPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStableswapPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStableswapPool
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStableswapPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Duration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowStableswapPool
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.InitialScalingFactors = append(m.InitialScalingFactors, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowStableswapPool
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthStableswapPool
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthStableswapPool
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.InitialScalingFactors) == 0 {
+					m.InitialScalingFactors = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowStableswapPool
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.InitialScalingFactors = append(m.InitialScalingFactors, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitialScalingFactors", wireType)
+			}
+		case 4:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowStableswapPool
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.TargetScalingFactors = append(m.TargetScalingFactors, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowStableswapPool
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthStableswapPool
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthStableswapPool
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.TargetScalingFactors) == 0 {
+					m.TargetScalingFactors = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowStableswapPool
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.TargetScalingFactors = append(m.TargetScalingFactors, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetScalingFactors", wireType)
 			}
-			i--
-			dAtA[i] = 0x32
-		}
-	}
-	{
-		size, err := m.TotalShares.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintStableswapPool(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x2a
-	if len(m.FuturePoolGovernor) > 0 {
-		i -= len(m.FuturePoolGovernor)
-		copy(dAtA[i:], m.FuturePoolGovernor)
-		i = encodeVarintStableswapPool(dAtA, i, uint64(len(m.FuturePoolGovernor)))
-		i--
-		dAtA[i] = 0x22
-	}
-	{
-		size, err := m.PoolParams.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStableswapPool(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStableswapPool
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
-		i -= size
-		i = encodeVarintStableswapPool(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	if m.Id != 0 {
-		i = encodeVarintStableswapPool(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintStableswapPool(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
-}
-
-func encodeVarintStableswapPool(dAtA []byte, offset int, v uint64) int {
-	offset -= sovStableswapPool(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *PoolParams) Size() (n int) {
-	if m == nil {
-		return 0
 	}
-	var l int
-	_ = l
-	l = m.SwapFee.Size()
-	n += 1 + l + sovStableswapPool(uint64(l))
-	l = m.ExitFee.Size()
-	n += 1 + l + sovStableswapPool(uint64(l))
-	return n
-}
 
-func (m *Pool) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovStableswapPool(uint64(l))
-	}
-	if m.Id != 0 {
-		n += 1 + sovStableswapPool(uint64(m.Id))
-	}
-	l = m.PoolParams.Size()
-	n += 1 + l + sovStableswapPool(uint64(l))
-	l = len(m.FuturePoolGovernor)
-	if l > 0 {
-		n += 1 + l + sovStableswapPool(uint64(l))
-	}
-	l = m.TotalShares.Size()
-	n += 1 + l + sovStableswapPool(uint64(l))
-	if len(m.PoolLiquidity) > 0 {
-		for _, e := range m.PoolLiquidity {
-			l = e.Size()
-			n += 1 + l + sovStableswapPool(uint64(l))
-		}
-	}
-	if len(m.ScalingFactors) > 0 {
-		l = 0
-		for _, e := range m.ScalingFactors {
-			l += sovStableswapPool(uint64(e))
-		}
-		n += 1 + sovStableswapPool(uint64(l)) + l
-	}
-	l = len(m.ScalingFactorController)
-	if l > 0 {
-		n += 1 + l + sovStableswapPool(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovStableswapPool(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozStableswapPool(x uint64) (n int) {
-	return sovStableswapPool(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *PoolParams) Unmarshal(dAtA []byte) error {
+func (m *ScalingFactorRateProvider) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -418,15 +1262,15 @@ func (m *PoolParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PoolParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: ScalingFactorRateProvider: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PoolParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ScalingFactorRateProvider: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SwapFee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -454,15 +1298,13 @@ func (m *PoolParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SwapFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExitFee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChangeDuration", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStableswapPool
@@ -472,23 +1314,22 @@ func (m *PoolParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthStableswapPool
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthStableswapPool
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ExitFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.ChangeDuration, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -720,7 +1561,7 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PoolLiquidity = append(m.PoolLiquidity, types.Coin{})
+			m.PoolLiquidity = append(m.PoolLiquidity, types1.Coin{})
 			if err := m.PoolLiquidity[len(m.PoolLiquidity)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
@@ -833,6 +1674,78 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 			}
 			m.ScalingFactorController = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScalingFactorChange", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStableswapPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStableswapPool
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStableswapPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ScalingFactorChange == nil {
+				m.ScalingFactorChange = &ScalingFactorChange{}
+			}
+			if err := m.ScalingFactorChange.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScalingFactorRateProvider", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStableswapPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStableswapPool
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStableswapPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ScalingFactorRateProvider == nil {
+				m.ScalingFactorRateProvider = &ScalingFactorRateProvider{}
+			}
+			if err := m.ScalingFactorRateProvider.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStableswapPool(dAtA[iNdEx:])
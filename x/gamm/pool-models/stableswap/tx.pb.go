@@ -11,18 +11,22 @@ import (
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
+	_ "github.com/gogo/protobuf/types"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -160,12 +164,14 @@ func (m *MsgCreateStableswapPoolResponse) GetPoolID() uint64 {
 	return 0
 }
 
-// Sender must be the pool's scaling_factor_governor in order for the tx to
-// succeed. Adjusts stableswap scaling factors.
+// Sender must be the pool's scaling_factor_governor, or its scaling factor
+// rate provider contract, in order for the tx to succeed. Adjusts stableswap
+// scaling factors, linearly over scaling_factor_change_duration if it is set.
 type MsgStableSwapAdjustScalingFactors struct {
-	Sender         string   `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
-	PoolID         uint64   `protobuf:"varint,2,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
-	ScalingFactors []uint64 `protobuf:"varint,3,rep,packed,name=scaling_factors,json=scalingFactors,proto3" json:"scaling_factors,omitempty" yaml:"stableswap_scaling_factor"`
+	Sender                      string        `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
+	PoolID                      uint64        `protobuf:"varint,2,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	ScalingFactors              []uint64      `protobuf:"varint,3,rep,packed,name=scaling_factors,json=scalingFactors,proto3" json:"scaling_factors,omitempty" yaml:"stableswap_scaling_factor"`
+	ScalingFactorChangeDuration time.Duration `protobuf:"bytes,4,opt,name=scaling_factor_change_duration,json=scalingFactorChangeDuration,proto3,stdduration" json:"scaling_factor_change_duration" yaml:"scaling_factor_change_duration"`
 }
 
 func (m *MsgStableSwapAdjustScalingFactors) Reset()         { *m = MsgStableSwapAdjustScalingFactors{} }
@@ -222,6 +228,13 @@ func (m *MsgStableSwapAdjustScalingFactors) GetScalingFactors() []uint64 {
 	return nil
 }
 
+func (m *MsgStableSwapAdjustScalingFactors) GetScalingFactorChangeDuration() time.Duration {
+	if m != nil {
+		return m.ScalingFactorChangeDuration
+	}
+	return 0
+}
+
 type MsgStableSwapAdjustScalingFactorsResponse struct {
 }
 
@@ -262,11 +275,238 @@ func (m *MsgStableSwapAdjustScalingFactorsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgStableSwapAdjustScalingFactorsResponse proto.InternalMessageInfo
 
+// Sender must be the pool's scaling_factor_governor in order for the tx to
+// succeed. Sets the contract that updates the stableswap scaling factors, or
+// removes it if rate_provider is not set.
+type MsgStableSwapSetScalingFactorRateProvider struct {
+	Sender       string                     `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
+	PoolID       uint64                     `protobuf:"varint,2,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	RateProvider *ScalingFactorRateProvider `protobuf:"bytes,3,opt,name=rate_provider,json=rateProvider,proto3" json:"rate_provider,omitempty" yaml:"rate_provider"`
+}
+
+func (m *MsgStableSwapSetScalingFactorRateProvider) Reset() {
+	*m = MsgStableSwapSetScalingFactorRateProvider{}
+}
+func (m *MsgStableSwapSetScalingFactorRateProvider) String() string {
+	return proto.CompactTextString(m)
+}
+func (*MsgStableSwapSetScalingFactorRateProvider) ProtoMessage() {}
+func (*MsgStableSwapSetScalingFactorRateProvider) Descriptor() ([]byte, []int) {
+	return fileDescriptor_46b7c8a0f24de97c, []int{4}
+}
+func (m *MsgStableSwapSetScalingFactorRateProvider) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgStableSwapSetScalingFactorRateProvider) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgStableSwapSetScalingFactorRateProvider.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgStableSwapSetScalingFactorRateProvider) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgStableSwapSetScalingFactorRateProvider.Merge(m, src)
+}
+func (m *MsgStableSwapSetScalingFactorRateProvider) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgStableSwapSetScalingFactorRateProvider) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgStableSwapSetScalingFactorRateProvider.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgStableSwapSetScalingFactorRateProvider proto.InternalMessageInfo
+
+func (m *MsgStableSwapSetScalingFactorRateProvider) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgStableSwapSetScalingFactorRateProvider) GetPoolID() uint64 {
+	if m != nil {
+		return m.PoolID
+	}
+	return 0
+}
+
+func (m *MsgStableSwapSetScalingFactorRateProvider) GetRateProvider() *ScalingFactorRateProvider {
+	if m != nil {
+		return m.RateProvider
+	}
+	return nil
+}
+
+type MsgStableSwapSetScalingFactorRateProviderResponse struct {
+}
+
+func (m *MsgStableSwapSetScalingFactorRateProviderResponse) Reset() {
+	*m = MsgStableSwapSetScalingFactorRateProviderResponse{}
+}
+func (m *MsgStableSwapSetScalingFactorRateProviderResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*MsgStableSwapSetScalingFactorRateProviderResponse) ProtoMessage() {}
+func (*MsgStableSwapSetScalingFactorRateProviderResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_46b7c8a0f24de97c, []int{5}
+}
+func (m *MsgStableSwapSetScalingFactorRateProviderResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgStableSwapSetScalingFactorRateProviderResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgStableSwapSetScalingFactorRateProviderResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgStableSwapSetScalingFactorRateProviderResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgStableSwapSetScalingFactorRateProviderResponse.Merge(m, src)
+}
+func (m *MsgStableSwapSetScalingFactorRateProviderResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgStableSwapSetScalingFactorRateProviderResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgStableSwapSetScalingFactorRateProviderResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgStableSwapSetScalingFactorRateProviderResponse proto.InternalMessageInfo
+
+// Exits share_in_amount shares of the pool to the token_out_denoms subset of
+// its assets. The exited tokens of the other pool assets are swapped into
+// token_out_denoms, paying the pool's swap fee.
+type MsgStableSwapExitPoolToDenoms struct {
+	Sender         string                                   `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
+	PoolID         uint64                                   `protobuf:"varint,2,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	ShareInAmount  github_com_cosmos_cosmos_sdk_types.Int   `protobuf:"bytes,3,opt,name=share_in_amount,json=shareInAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"share_in_amount" yaml:"share_in_amount"`
+	TokenOutDenoms []string                                 `protobuf:"bytes,4,rep,name=token_out_denoms,json=tokenOutDenoms,proto3" json:"token_out_denoms,omitempty" yaml:"token_out_denoms"`
+	TokenOutMins   github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=token_out_mins,json=tokenOutMins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"token_out_mins" yaml:"token_out_min_amounts"`
+}
+
+func (m *MsgStableSwapExitPoolToDenoms) Reset()         { *m = MsgStableSwapExitPoolToDenoms{} }
+func (m *MsgStableSwapExitPoolToDenoms) String() string { return proto.CompactTextString(m) }
+func (*MsgStableSwapExitPoolToDenoms) ProtoMessage()    {}
+func (*MsgStableSwapExitPoolToDenoms) Descriptor() ([]byte, []int) {
+	return fileDescriptor_46b7c8a0f24de97c, []int{6}
+}
+func (m *MsgStableSwapExitPoolToDenoms) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgStableSwapExitPoolToDenoms) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgStableSwapExitPoolToDenoms.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgStableSwapExitPoolToDenoms) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgStableSwapExitPoolToDenoms.Merge(m, src)
+}
+func (m *MsgStableSwapExitPoolToDenoms) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgStableSwapExitPoolToDenoms) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgStableSwapExitPoolToDenoms.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgStableSwapExitPoolToDenoms proto.InternalMessageInfo
+
+func (m *MsgStableSwapExitPoolToDenoms) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgStableSwapExitPoolToDenoms) GetPoolID() uint64 {
+	if m != nil {
+		return m.PoolID
+	}
+	return 0
+}
+
+func (m *MsgStableSwapExitPoolToDenoms) GetTokenOutDenoms() []string {
+	if m != nil {
+		return m.TokenOutDenoms
+	}
+	return nil
+}
+
+func (m *MsgStableSwapExitPoolToDenoms) GetTokenOutMins() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.TokenOutMins
+	}
+	return nil
+}
+
+type MsgStableSwapExitPoolToDenomsResponse struct {
+	TokenOut github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=token_out,json=tokenOut,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"token_out" yaml:"token_out"`
+}
+
+func (m *MsgStableSwapExitPoolToDenomsResponse) Reset()         { *m = MsgStableSwapExitPoolToDenomsResponse{} }
+func (m *MsgStableSwapExitPoolToDenomsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgStableSwapExitPoolToDenomsResponse) ProtoMessage()    {}
+func (*MsgStableSwapExitPoolToDenomsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_46b7c8a0f24de97c, []int{7}
+}
+func (m *MsgStableSwapExitPoolToDenomsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgStableSwapExitPoolToDenomsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgStableSwapExitPoolToDenomsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgStableSwapExitPoolToDenomsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgStableSwapExitPoolToDenomsResponse.Merge(m, src)
+}
+func (m *MsgStableSwapExitPoolToDenomsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgStableSwapExitPoolToDenomsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgStableSwapExitPoolToDenomsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgStableSwapExitPoolToDenomsResponse proto.InternalMessageInfo
+
+func (m *MsgStableSwapExitPoolToDenomsResponse) GetTokenOut() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.TokenOut
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*MsgCreateStableswapPool)(nil), "osmosis.gamm.poolmodels.stableswap.v1beta1.MsgCreateStableswapPool")
 	proto.RegisterType((*MsgCreateStableswapPoolResponse)(nil), "osmosis.gamm.poolmodels.stableswap.v1beta1.MsgCreateStableswapPoolResponse")
 	proto.RegisterType((*MsgStableSwapAdjustScalingFactors)(nil), "osmosis.gamm.poolmodels.stableswap.v1beta1.MsgStableSwapAdjustScalingFactors")
 	proto.RegisterType((*MsgStableSwapAdjustScalingFactorsResponse)(nil), "osmosis.gamm.poolmodels.stableswap.v1beta1.MsgStableSwapAdjustScalingFactorsResponse")
+	proto.RegisterType((*MsgStableSwapSetScalingFactorRateProvider)(nil), "osmosis.gamm.poolmodels.stableswap.v1beta1.MsgStableSwapSetScalingFactorRateProvider")
+	proto.RegisterType((*MsgStableSwapSetScalingFactorRateProviderResponse)(nil), "osmosis.gamm.poolmodels.stableswap.v1beta1.MsgStableSwapSetScalingFactorRateProviderResponse")
+	proto.RegisterType((*MsgStableSwapExitPoolToDenoms)(nil), "osmosis.gamm.poolmodels.stableswap.v1beta1.MsgStableSwapExitPoolToDenoms")
+	proto.RegisterType((*MsgStableSwapExitPoolToDenomsResponse)(nil), "osmosis.gamm.poolmodels.stableswap.v1beta1.MsgStableSwapExitPoolToDenomsResponse")
 }
 
 func init() {
@@ -274,46 +514,68 @@ func init() {
 }
 
 var fileDescriptor_46b7c8a0f24de97c = []byte{
-	// 622 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0x4f, 0x6f, 0xd3, 0x30,
-	0x14, 0x6f, 0xd6, 0x52, 0x84, 0x27, 0x40, 0x44, 0xd5, 0x96, 0x15, 0x29, 0x29, 0x81, 0x43, 0x07,
-	0x2c, 0x66, 0x9b, 0x84, 0x04, 0xb7, 0xa5, 0x68, 0x68, 0x82, 0x4a, 0x23, 0x13, 0x17, 0x38, 0x14,
-	0x27, 0xf1, 0x82, 0x21, 0x89, 0x43, 0xec, 0x76, 0xeb, 0x91, 0x6f, 0xc0, 0xc7, 0x40, 0x7c, 0x07,
-	0x2e, 0x1c, 0xd0, 0x8e, 0x3b, 0x72, 0x0a, 0xa8, 0xfd, 0x06, 0xbd, 0x71, 0x43, 0x89, 0xd3, 0x7f,
-	0x52, 0xbb, 0xad, 0x68, 0xa7, 0x38, 0xcf, 0xbf, 0xf7, 0xfb, 0xbd, 0xf7, 0x7b, 0xb6, 0xc1, 0x43,
-	0xca, 0x02, 0xca, 0x08, 0x83, 0x1e, 0x0a, 0x02, 0x18, 0x51, 0xea, 0x6f, 0x04, 0xd4, 0xc5, 0x3e,
-	0x83, 0x8c, 0x23, 0xdb, 0xc7, 0xec, 0x08, 0x45, 0x90, 0x1f, 0x1b, 0x51, 0x4c, 0x39, 0x95, 0xef,
-	0xe7, 0x68, 0x23, 0x45, 0x1b, 0x29, 0x5a, 0x80, 0x8d, 0x31, 0xd8, 0xe8, 0x6c, 0xda, 0x98, 0xa3,
-	0xcd, 0xaa, 0xea, 0x64, 0x60, 0x68, 0x23, 0x86, 0x61, 0x1e, 0x84, 0x0e, 0x25, 0xa1, 0xe0, 0xaa,
-	0x56, 0x3c, 0xea, 0xd1, 0x6c, 0x09, 0xd3, 0x55, 0x1e, 0x7d, 0x72, 0x91, 0x7a, 0xc6, 0xcb, 0x56,
-	0x8a, 0x10, 0xa9, 0xfa, 0xf7, 0x12, 0x58, 0x6d, 0x32, 0xaf, 0x11, 0x63, 0xc4, 0xf1, 0xc1, 0x08,
-	0xb2, 0x4f, 0xa9, 0x2f, 0xaf, 0x83, 0x32, 0xc3, 0xa1, 0x8b, 0x63, 0x45, 0xaa, 0x49, 0xf5, 0x6b,
-	0xe6, 0xad, 0x41, 0xa2, 0x5d, 0xef, 0xa2, 0xc0, 0x7f, 0xaa, 0x8b, 0xb8, 0x6e, 0xe5, 0x00, 0x99,
-	0x82, 0xe5, 0x94, 0xb4, 0x15, 0xa1, 0x18, 0x05, 0x4c, 0x59, 0xaa, 0x49, 0xf5, 0xe5, 0xad, 0xc7,
-	0xc6, 0xc5, 0x3b, 0x37, 0x52, 0xc5, 0xfd, 0x2c, 0xdb, 0x5c, 0x19, 0x24, 0x9a, 0x2c, 0x74, 0x26,
-	0x48, 0x75, 0x0b, 0x44, 0x23, 0x8c, 0xfc, 0x59, 0x02, 0x2b, 0x24, 0x24, 0x9c, 0x20, 0x3f, 0x6b,
-	0xa7, 0xe5, 0x93, 0x4f, 0x6d, 0xe2, 0x12, 0xde, 0x55, 0x8a, 0xb5, 0x62, 0x7d, 0x79, 0x6b, 0xcd,
-	0x10, 0x56, 0x1a, 0xa9, 0x95, 0x23, 0x95, 0x06, 0x25, 0xa1, 0xf9, 0xe8, 0x24, 0xd1, 0x0a, 0xdf,
-	0x7e, 0x6b, 0x75, 0x8f, 0xf0, 0xf7, 0x6d, 0xdb, 0x70, 0x68, 0x00, 0x73, 0xdf, 0xc5, 0x67, 0x83,
-	0xb9, 0x1f, 0x21, 0xef, 0x46, 0x98, 0x65, 0x09, 0xcc, 0xaa, 0xe4, 0x52, 0x69, 0x91, 0x2f, 0x87,
-	0x42, 0x72, 0x13, 0xdc, 0x64, 0x0e, 0xf2, 0x49, 0xe8, 0xb5, 0x0e, 0x91, 0xc3, 0x69, 0xcc, 0x94,
-	0x52, 0xad, 0x58, 0x2f, 0x99, 0xf7, 0x06, 0x89, 0x56, 0xcb, 0x8d, 0x1a, 0xbb, 0x3e, 0x8d, 0xd5,
-	0xad, 0x1b, 0x79, 0x60, 0x57, 0xe4, 0xca, 0xaf, 0x40, 0xe5, 0xb0, 0xcd, 0xdb, 0x31, 0x16, 0x0d,
-	0x79, 0xb4, 0x83, 0xe3, 0x90, 0xc6, 0xca, 0x95, 0xcc, 0x7c, 0x6d, 0x90, 0x68, 0xb7, 0x05, 0xe7,
-	0x2c, 0x94, 0x6e, 0xc9, 0x22, 0x9c, 0x96, 0xf8, 0x3c, 0x0f, 0xca, 0xef, 0xc0, 0xda, 0xb4, 0x6a,
-	0xcb, 0xa1, 0x21, 0x8f, 0xa9, 0xef, 0xe3, 0x58, 0x29, 0x67, 0xbc, 0x93, 0xb5, 0xce, 0x83, 0xea,
-	0xd6, 0xea, 0x54, 0xad, 0x8d, 0xf1, 0xce, 0x2e, 0xd0, 0xe6, 0x1c, 0x1f, 0x0b, 0xb3, 0x88, 0x86,
-	0x0c, 0xcb, 0x77, 0xc1, 0xd5, 0xac, 0x54, 0xe2, 0x66, 0xe7, 0xa8, 0x64, 0x82, 0x5e, 0xa2, 0x95,
-	0x53, 0xc8, 0xde, 0x33, 0xab, 0x9c, 0x6e, 0xed, 0xb9, 0xfa, 0x0f, 0x09, 0xdc, 0x69, 0x32, 0x4f,
-	0x50, 0x1c, 0x1c, 0xa1, 0x68, 0xc7, 0xfd, 0xd0, 0x66, 0xfc, 0x60, 0xda, 0xa2, 0x05, 0x4e, 0xe4,
-	0x84, 0xea, 0xd2, 0x3c, 0xd5, 0x59, 0x13, 0x2c, 0xfe, 0xff, 0x04, 0xf5, 0x07, 0x60, 0xfd, 0xdc,
-	0x1e, 0x86, 0xb6, 0x6c, 0xfd, 0x5d, 0x02, 0xc5, 0x26, 0xf3, 0xe4, 0xaf, 0x12, 0xa8, 0xcc, 0xbc,
-	0x7e, 0x8d, 0x45, 0xae, 0xcf, 0x9c, 0x21, 0x54, 0x5f, 0x5c, 0x02, 0xc9, 0x68, 0x92, 0x3f, 0x25,
-	0xa0, 0x9e, 0x33, 0xa1, 0xe6, 0x82, 0x7a, 0x67, 0xd3, 0x55, 0x5f, 0x5f, 0x2a, 0xdd, 0xb0, 0x11,
-	0xf3, 0xed, 0x49, 0x4f, 0x95, 0x4e, 0x7b, 0xaa, 0xf4, 0xa7, 0xa7, 0x4a, 0x5f, 0xfa, 0x6a, 0xe1,
-	0xb4, 0xaf, 0x16, 0x7e, 0xf5, 0xd5, 0xc2, 0x9b, 0x9d, 0x89, 0x37, 0x21, 0x97, 0xde, 0xf0, 0x91,
-	0xcd, 0x86, 0x3f, 0xb0, 0xb3, 0xb9, 0x0d, 0x8f, 0xcf, 0x7a, 0x68, 0xed, 0x72, 0xf6, 0xb2, 0x6e,
-	0xff, 0x0b, 0x00, 0x00, 0xff, 0xff, 0xc6, 0x5b, 0x3f, 0xf5, 0x26, 0x06, 0x00, 0x00,
+	// 969 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0x4f, 0x6f, 0xe3, 0x44,
+	0x1c, 0xad, 0x9b, 0x6e, 0xa0, 0xd3, 0x6d, 0x77, 0xb1, 0xa2, 0xd6, 0x4d, 0x17, 0x3b, 0x18, 0x76,
+	0x95, 0x05, 0x6a, 0x93, 0x56, 0x42, 0x82, 0x5b, 0xdd, 0x76, 0x21, 0x82, 0x88, 0xae, 0x0b, 0x17,
+	0x10, 0x32, 0x93, 0x64, 0xea, 0x0e, 0x6b, 0x7b, 0x8c, 0x67, 0xdc, 0x6d, 0x25, 0x2e, 0x5c, 0x10,
+	0x47, 0xf6, 0x04, 0x5f, 0x00, 0x09, 0xf1, 0x11, 0x10, 0x5c, 0x51, 0x8f, 0x7b, 0x42, 0x88, 0x83,
+	0x17, 0xb5, 0xdf, 0x20, 0x9f, 0x00, 0x79, 0xfc, 0x27, 0x71, 0xd4, 0xa4, 0xc9, 0x12, 0x4e, 0xb5,
+	0x7f, 0x79, 0xf3, 0xde, 0x9b, 0xf7, 0x9b, 0xf9, 0xb9, 0xe0, 0x4d, 0x42, 0x5d, 0x42, 0x31, 0xd5,
+	0x6d, 0xe8, 0xba, 0xba, 0x4f, 0x88, 0xb3, 0xe9, 0x92, 0x2e, 0x72, 0xa8, 0x4e, 0x19, 0x6c, 0x3b,
+	0x88, 0x3e, 0x86, 0xbe, 0xce, 0x4e, 0x35, 0x3f, 0x20, 0x8c, 0x88, 0xaf, 0xa7, 0x68, 0x2d, 0x46,
+	0x6b, 0x31, 0x3a, 0x01, 0x6b, 0x7d, 0xb0, 0x76, 0xd2, 0x68, 0x23, 0x06, 0x1b, 0x55, 0xb9, 0xc3,
+	0xc1, 0x7a, 0x1b, 0x52, 0xa4, 0xa7, 0x45, 0xbd, 0x43, 0xb0, 0x97, 0x70, 0x55, 0x2b, 0x36, 0xb1,
+	0x09, 0x7f, 0xd4, 0xe3, 0xa7, 0xb4, 0x2a, 0xdb, 0x84, 0xd8, 0x0e, 0xd2, 0xf9, 0x5b, 0x3b, 0x3c,
+	0xd2, 0xbb, 0x61, 0x00, 0x19, 0x26, 0xd9, 0xaa, 0x77, 0x26, 0xf1, 0xdb, 0x7f, 0xb4, 0x62, 0x44,
+	0xb2, 0x54, 0xfd, 0x7d, 0x01, 0xac, 0xb5, 0xa8, 0xbd, 0x1b, 0x20, 0xc8, 0xd0, 0x61, 0x0e, 0x39,
+	0x20, 0xc4, 0x11, 0xef, 0x83, 0x32, 0x45, 0x5e, 0x17, 0x05, 0x92, 0x50, 0x13, 0xea, 0x8b, 0xc6,
+	0x4b, 0xbd, 0x48, 0x59, 0x3e, 0x83, 0xae, 0xf3, 0xae, 0x9a, 0xd4, 0x55, 0x33, 0x05, 0x88, 0x04,
+	0x2c, 0xc5, 0xa4, 0x96, 0x0f, 0x03, 0xe8, 0x52, 0x69, 0xbe, 0x26, 0xd4, 0x97, 0xb6, 0xde, 0xd6,
+	0x26, 0x4f, 0x46, 0x8b, 0x15, 0x0f, 0xf8, 0x6a, 0x63, 0xb5, 0x17, 0x29, 0x62, 0xa2, 0x33, 0x40,
+	0xaa, 0x9a, 0xc0, 0xcf, 0x31, 0xe2, 0x37, 0x02, 0x58, 0xc5, 0x1e, 0x66, 0x18, 0x3a, 0x7c, 0x3b,
+	0x96, 0x83, 0xbf, 0x0a, 0x71, 0x17, 0xb3, 0x33, 0xa9, 0x54, 0x2b, 0xd5, 0x97, 0xb6, 0xd6, 0xb5,
+	0x24, 0x6a, 0x2d, 0x8e, 0x3a, 0x57, 0xd9, 0x25, 0xd8, 0x33, 0xde, 0x3a, 0x8f, 0x94, 0xb9, 0x5f,
+	0x9e, 0x29, 0x75, 0x1b, 0xb3, 0xe3, 0xb0, 0xad, 0x75, 0x88, 0xab, 0xa7, 0x7d, 0x49, 0xfe, 0x6c,
+	0xd2, 0xee, 0x23, 0x9d, 0x9d, 0xf9, 0x88, 0xf2, 0x05, 0xd4, 0xac, 0xa4, 0x52, 0xb1, 0xc9, 0x0f,
+	0x33, 0x21, 0xb1, 0x05, 0x6e, 0xd1, 0x0e, 0x74, 0xb0, 0x67, 0x5b, 0x47, 0xb0, 0xc3, 0x48, 0x40,
+	0xa5, 0x85, 0x5a, 0xa9, 0xbe, 0x60, 0xbc, 0xd6, 0x8b, 0x94, 0x5a, 0x1a, 0x54, 0x3f, 0xf5, 0x22,
+	0x56, 0x35, 0x57, 0xd2, 0xc2, 0x83, 0x64, 0xad, 0xf8, 0x10, 0x54, 0x8e, 0x42, 0x16, 0x06, 0x28,
+	0xd9, 0x90, 0x4d, 0x4e, 0x50, 0xe0, 0x91, 0x40, 0xba, 0xc1, 0xc3, 0x57, 0x7a, 0x91, 0xb2, 0x91,
+	0x70, 0x5e, 0x85, 0x52, 0x4d, 0x31, 0x29, 0xc7, 0x16, 0xdf, 0x4b, 0x8b, 0xe2, 0x17, 0x60, 0xbd,
+	0xa8, 0x6a, 0x75, 0x88, 0xc7, 0x02, 0xe2, 0x38, 0x28, 0x90, 0xca, 0x9c, 0x77, 0xd0, 0xeb, 0x28,
+	0xa8, 0x6a, 0xae, 0x15, 0xbc, 0xee, 0xf6, 0x7f, 0x79, 0x00, 0x94, 0x11, 0xc7, 0xc7, 0x44, 0xd4,
+	0x27, 0x1e, 0x45, 0xe2, 0xab, 0xe0, 0x05, 0x6e, 0x15, 0x77, 0xf9, 0x39, 0x5a, 0x30, 0xc0, 0x45,
+	0xa4, 0x94, 0x63, 0x48, 0x73, 0xcf, 0x2c, 0xc7, 0x3f, 0x35, 0xbb, 0xea, 0x9f, 0xf3, 0xe0, 0x95,
+	0x16, 0xb5, 0x13, 0x8a, 0xc3, 0xc7, 0xd0, 0xdf, 0xe9, 0x7e, 0x19, 0x52, 0x76, 0x58, 0x8c, 0x68,
+	0x8a, 0x13, 0x39, 0xa0, 0x3a, 0x3f, 0x4a, 0xf5, 0xaa, 0x0e, 0x96, 0xfe, 0x43, 0x07, 0x9f, 0x08,
+	0x40, 0x1e, 0x0e, 0xf1, 0x18, 0x7a, 0x36, 0xb2, 0xb2, 0x0b, 0x2b, 0x2d, 0xf0, 0x9b, 0xb1, 0xae,
+	0x25, 0x37, 0x5a, 0xcb, 0x6e, 0xb4, 0xb6, 0x97, 0x02, 0x8c, 0x46, 0x7c, 0x38, 0x7b, 0x91, 0x72,
+	0xf7, 0xea, 0x9e, 0x14, 0xe9, 0xd4, 0x1f, 0x9f, 0x29, 0x82, 0xb9, 0x51, 0x6c, 0x0e, 0x87, 0x64,
+	0x7c, 0xea, 0x1b, 0xe0, 0xfe, 0xb5, 0xb9, 0x66, 0xad, 0x52, 0xbf, 0x9d, 0x1f, 0x42, 0x1f, 0xa2,
+	0x22, 0xd4, 0x84, 0x0c, 0x1d, 0x04, 0xe4, 0x04, 0xc7, 0x11, 0xcf, 0xba, 0x1b, 0xdf, 0x09, 0x60,
+	0x39, 0x80, 0x0c, 0x59, 0x7e, 0xaa, 0x20, 0x95, 0x78, 0x5a, 0xfb, 0xd3, 0xcc, 0x91, 0x91, 0x76,
+	0x8d, 0x3b, 0xe7, 0x91, 0x22, 0xf4, 0x22, 0xa5, 0x92, 0x58, 0x2c, 0x28, 0xa9, 0xe6, 0xcd, 0x60,
+	0x00, 0xab, 0x6e, 0x83, 0xc6, 0xc4, 0x39, 0xe4, 0xe9, 0xfd, 0x56, 0x02, 0x2f, 0x17, 0x56, 0xed,
+	0x9f, 0x62, 0x16, 0xef, 0xf1, 0x63, 0xb2, 0x87, 0x3c, 0xe2, 0xce, 0xfe, 0xfc, 0xfa, 0xe0, 0x16,
+	0x3d, 0x86, 0x01, 0xb2, 0xb0, 0x67, 0x41, 0x97, 0x84, 0x1e, 0xe3, 0x91, 0x2d, 0x1a, 0xef, 0xc7,
+	0xa7, 0xe8, 0xef, 0x48, 0xb9, 0x37, 0xc1, 0x88, 0x6b, 0x7a, 0xac, 0x17, 0x29, 0xab, 0xa9, 0x8d,
+	0x22, 0x9d, 0x6a, 0x2e, 0xf3, 0x4a, 0xd3, 0xdb, 0xe1, 0xef, 0xe2, 0x3e, 0xb8, 0xcd, 0xc8, 0x23,
+	0xe4, 0x59, 0x24, 0x64, 0x56, 0x97, 0xef, 0x8a, 0x0f, 0xbd, 0x45, 0x63, 0xa3, 0x17, 0x29, 0x6b,
+	0x09, 0xc9, 0x30, 0x42, 0x35, 0x57, 0x78, 0xe9, 0xa3, 0x90, 0xa5, 0x41, 0x3c, 0x11, 0xc0, 0x4a,
+	0x1f, 0xe5, 0x62, 0x8f, 0x4a, 0x37, 0xae, 0x1b, 0xdb, 0x07, 0xe9, 0xcd, 0xb8, 0x33, 0x2c, 0xe2,
+	0xe6, 0x76, 0xa9, 0x3a, 0xd5, 0x58, 0xbf, 0x99, 0xb9, 0x6a, 0x61, 0x8f, 0xaa, 0x3f, 0x09, 0xe0,
+	0xee, 0xd8, 0xf6, 0xe5, 0x13, 0xed, 0x6b, 0xb0, 0x98, 0xab, 0x4b, 0xc2, 0x75, 0xbe, 0xf7, 0x52,
+	0xdf, 0xb7, 0x87, 0x7c, 0x4f, 0xe7, 0xf5, 0xc5, 0xcc, 0xeb, 0xd6, 0x0f, 0x65, 0x50, 0x6a, 0x51,
+	0x5b, 0xfc, 0x59, 0x00, 0x95, 0x2b, 0xbf, 0xdb, 0xbb, 0xd3, 0xdc, 0x97, 0x11, 0xd3, 0xbb, 0xfa,
+	0xc1, 0x0c, 0x48, 0xf2, 0xc0, 0xfe, 0x10, 0x80, 0x7c, 0xcd, 0x68, 0x6f, 0x4d, 0xa9, 0x37, 0x9e,
+	0xae, 0xfa, 0xc9, 0x4c, 0xe9, 0xf2, 0x8d, 0x44, 0x02, 0xb8, 0x37, 0xe1, 0x74, 0x7c, 0x7e, 0x07,
+	0xe3, 0x68, 0xab, 0x9f, 0xff, 0x2f, 0xb4, 0xf9, 0x06, 0x7f, 0x15, 0x40, 0x75, 0xcc, 0x00, 0x6b,
+	0x3e, 0xb7, 0xfa, 0x30, 0x55, 0xf5, 0xe1, 0xcc, 0xa8, 0x32, 0xf3, 0xc6, 0x67, 0xe7, 0x17, 0xb2,
+	0xf0, 0xf4, 0x42, 0x16, 0xfe, 0xb9, 0x90, 0x85, 0xef, 0x2f, 0xe5, 0xb9, 0xa7, 0x97, 0xf2, 0xdc,
+	0x5f, 0x97, 0xf2, 0xdc, 0xa7, 0x3b, 0x03, 0xf7, 0x2c, 0x95, 0xdd, 0x74, 0x60, 0x9b, 0x66, 0x2f,
+	0xfa, 0x49, 0x63, 0x5b, 0x3f, 0x1d, 0xf7, 0xff, 0x73, 0xbb, 0xcc, 0xbf, 0xd5, 0xdb, 0xff, 0x0e,
+	0x00, 0x38, 0x54, 0x63, 0x29, 0x1d, 0x0c, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -330,6 +592,8 @@ const _ = grpc.SupportPackageIsVersion4
 type MsgClient interface {
 	CreateStableswapPool(ctx context.Context, in *MsgCreateStableswapPool, opts ...grpc.CallOption) (*MsgCreateStableswapPoolResponse, error)
 	StableSwapAdjustScalingFactors(ctx context.Context, in *MsgStableSwapAdjustScalingFactors, opts ...grpc.CallOption) (*MsgStableSwapAdjustScalingFactorsResponse, error)
+	StableSwapSetScalingFactorRateProvider(ctx context.Context, in *MsgStableSwapSetScalingFactorRateProvider, opts ...grpc.CallOption) (*MsgStableSwapSetScalingFactorRateProviderResponse, error)
+	StableSwapExitPoolToDenoms(ctx context.Context, in *MsgStableSwapExitPoolToDenoms, opts ...grpc.CallOption) (*MsgStableSwapExitPoolToDenomsResponse, error)
 }
 
 type msgClient struct {
@@ -358,10 +622,30 @@ func (c *msgClient) StableSwapAdjustScalingFactors(ctx context.Context, in *MsgS
 	return out, nil
 }
 
+func (c *msgClient) StableSwapSetScalingFactorRateProvider(ctx context.Context, in *MsgStableSwapSetScalingFactorRateProvider, opts ...grpc.CallOption) (*MsgStableSwapSetScalingFactorRateProviderResponse, error) {
+	out := new(MsgStableSwapSetScalingFactorRateProviderResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.gamm.poolmodels.stableswap.v1beta1.Msg/StableSwapSetScalingFactorRateProvider", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) StableSwapExitPoolToDenoms(ctx context.Context, in *MsgStableSwapExitPoolToDenoms, opts ...grpc.CallOption) (*MsgStableSwapExitPoolToDenomsResponse, error) {
+	out := new(MsgStableSwapExitPoolToDenomsResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.gamm.poolmodels.stableswap.v1beta1.Msg/StableSwapExitPoolToDenoms", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	CreateStableswapPool(context.Context, *MsgCreateStableswapPool) (*MsgCreateStableswapPoolResponse, error)
 	StableSwapAdjustScalingFactors(context.Context, *MsgStableSwapAdjustScalingFactors) (*MsgStableSwapAdjustScalingFactorsResponse, error)
+	StableSwapSetScalingFactorRateProvider(context.Context, *MsgStableSwapSetScalingFactorRateProvider) (*MsgStableSwapSetScalingFactorRateProviderResponse, error)
+	StableSwapExitPoolToDenoms(context.Context, *MsgStableSwapExitPoolToDenoms) (*MsgStableSwapExitPoolToDenomsResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -374,6 +658,12 @@ func (*UnimplementedMsgServer) CreateStableswapPool(ctx context.Context, req *Ms
 func (*UnimplementedMsgServer) StableSwapAdjustScalingFactors(ctx context.Context, req *MsgStableSwapAdjustScalingFactors) (*MsgStableSwapAdjustScalingFactorsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method StableSwapAdjustScalingFactors not implemented")
 }
+func (*UnimplementedMsgServer) StableSwapSetScalingFactorRateProvider(ctx context.Context, req *MsgStableSwapSetScalingFactorRateProvider) (*MsgStableSwapSetScalingFactorRateProviderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StableSwapSetScalingFactorRateProvider not implemented")
+}
+func (*UnimplementedMsgServer) StableSwapExitPoolToDenoms(ctx context.Context, req *MsgStableSwapExitPoolToDenoms) (*MsgStableSwapExitPoolToDenomsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StableSwapExitPoolToDenoms not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -415,6 +705,42 @@ func _Msg_StableSwapAdjustScalingFactors_Handler(srv interface{}, ctx context.Co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_StableSwapSetScalingFactorRateProvider_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgStableSwapSetScalingFactorRateProvider)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).StableSwapSetScalingFactorRateProvider(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.gamm.poolmodels.stableswap.v1beta1.Msg/StableSwapSetScalingFactorRateProvider",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).StableSwapSetScalingFactorRateProvider(ctx, req.(*MsgStableSwapSetScalingFactorRateProvider))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_StableSwapExitPoolToDenoms_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgStableSwapExitPoolToDenoms)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).StableSwapExitPoolToDenoms(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.gamm.poolmodels.stableswap.v1beta1.Msg/StableSwapExitPoolToDenoms",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).StableSwapExitPoolToDenoms(ctx, req.(*MsgStableSwapExitPoolToDenoms))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "osmosis.gamm.poolmodels.stableswap.v1beta1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -427,6 +753,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "StableSwapAdjustScalingFactors",
 			Handler:    _Msg_StableSwapAdjustScalingFactors_Handler,
 		},
+		{
+			MethodName: "StableSwapSetScalingFactorRateProvider",
+			Handler:    _Msg_StableSwapSetScalingFactorRateProvider_Handler,
+		},
+		{
+			MethodName: "StableSwapExitPoolToDenoms",
+			Handler:    _Msg_StableSwapExitPoolToDenoms_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "osmosis/gamm/pool-models/stableswap/tx.proto",
@@ -568,21 +902,29 @@ func (m *MsgStableSwapAdjustScalingFactors) MarshalToSizedBuffer(dAtA []byte) (i
 	_ = i
 	var l int
 	_ = l
+	n4, err4 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.ScalingFactorChangeDuration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.ScalingFactorChangeDuration):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintTx(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x22
 	if len(m.ScalingFactors) > 0 {
-		dAtA5 := make([]byte, len(m.ScalingFactors)*10)
-		var j4 int
+		dAtA6 := make([]byte, len(m.ScalingFactors)*10)
+		var j5 int
 		for _, num := range m.ScalingFactors {
 			for num >= 1<<7 {
-				dAtA5[j4] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA6[j5] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j4++
+				j5++
 			}
-			dAtA5[j4] = uint8(num)
-			j4++
+			dAtA6[j5] = uint8(num)
+			j5++
 		}
-		i -= j4
-		copy(dAtA[i:], dAtA5[:j4])
-		i = encodeVarintTx(dAtA, i, uint64(j4))
+		i -= j5
+		copy(dAtA[i:], dAtA6[:j5])
+		i = encodeVarintTx(dAtA, i, uint64(j5))
 		i--
 		dAtA[i] = 0x1a
 	}
@@ -624,53 +966,228 @@ func (m *MsgStableSwapAdjustScalingFactorsResponse) MarshalToSizedBuffer(dAtA []
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgStableSwapSetScalingFactorRateProvider) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgCreateStableswapPool) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgStableSwapSetScalingFactorRateProvider) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgStableSwapSetScalingFactorRateProvider) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.PoolParams != nil {
-		l = m.PoolParams.Size()
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if len(m.InitialPoolLiquidity) > 0 {
-		for _, e := range m.InitialPoolLiquidity {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
-	}
-	if len(m.ScalingFactors) > 0 {
-		l = 0
-		for _, e := range m.ScalingFactors {
-			l += sovTx(uint64(e))
+	if m.RateProvider != nil {
+		{
+			size, err := m.RateProvider.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
 		}
-		n += 1 + sovTx(uint64(l)) + l
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.FuturePoolGovernor)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.PoolID != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PoolID))
+		i--
+		dAtA[i] = 0x10
 	}
-	l = len(m.ScalingFactorController)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgStableSwapSetScalingFactorRateProviderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgStableSwapSetScalingFactorRateProviderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgStableSwapSetScalingFactorRateProviderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgStableSwapExitPoolToDenoms) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgStableSwapExitPoolToDenoms) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgStableSwapExitPoolToDenoms) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TokenOutMins) > 0 {
+		for iNdEx := len(m.TokenOutMins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TokenOutMins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.TokenOutDenoms) > 0 {
+		for iNdEx := len(m.TokenOutDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.TokenOutDenoms[iNdEx])
+			copy(dAtA[i:], m.TokenOutDenoms[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.TokenOutDenoms[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	{
+		size := m.ShareInAmount.Size()
+		i -= size
+		if _, err := m.ShareInAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.PoolID != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PoolID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgStableSwapExitPoolToDenomsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgStableSwapExitPoolToDenomsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgStableSwapExitPoolToDenomsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TokenOut) > 0 {
+		for iNdEx := len(m.TokenOut) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TokenOut[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgCreateStableswapPool) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PoolParams != nil {
+		l = m.PoolParams.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.InitialPoolLiquidity) > 0 {
+		for _, e := range m.InitialPoolLiquidity {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.ScalingFactors) > 0 {
+		l = 0
+		for _, e := range m.ScalingFactors {
+			l += sovTx(uint64(e))
+		}
+		n += 1 + sovTx(uint64(l)) + l
+	}
+	l = len(m.FuturePoolGovernor)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ScalingFactorController)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
 }
 
 func (m *MsgCreateStableswapPoolResponse) Size() (n int) {
@@ -705,6 +1222,8 @@ func (m *MsgStableSwapAdjustScalingFactors) Size() (n int) {
 		}
 		n += 1 + sovTx(uint64(l)) + l
 	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.ScalingFactorChangeDuration)
+	n += 1 + l + sovTx(uint64(l))
 	return n
 }
 
@@ -717,6 +1236,80 @@ func (m *MsgStableSwapAdjustScalingFactorsResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgStableSwapSetScalingFactorRateProvider) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PoolID != 0 {
+		n += 1 + sovTx(uint64(m.PoolID))
+	}
+	if m.RateProvider != nil {
+		l = m.RateProvider.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgStableSwapSetScalingFactorRateProviderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgStableSwapExitPoolToDenoms) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PoolID != 0 {
+		n += 1 + sovTx(uint64(m.PoolID))
+	}
+	l = m.ShareInAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.TokenOutDenoms) > 0 {
+		for _, s := range m.TokenOutDenoms {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.TokenOutMins) > 0 {
+		for _, e := range m.TokenOutMins {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgStableSwapExitPoolToDenomsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.TokenOut) > 0 {
+		for _, e := range m.TokenOut {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1240,6 +1833,39 @@ func (m *MsgStableSwapAdjustScalingFactors) Unmarshal(dAtA []byte) error {
 			} else {
 				return fmt.Errorf("proto: wrong wireType = %d for field ScalingFactors", wireType)
 			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScalingFactorChangeDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.ScalingFactorChangeDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1311,6 +1937,478 @@ func (m *MsgStableSwapAdjustScalingFactorsResponse) Unmarshal(dAtA []byte) error
 	}
 	return nil
 }
+func (m *MsgStableSwapSetScalingFactorRateProvider) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgStableSwapSetScalingFactorRateProvider: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgStableSwapSetScalingFactorRateProvider: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolID", wireType)
+			}
+			m.PoolID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RateProvider", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RateProvider == nil {
+				m.RateProvider = &ScalingFactorRateProvider{}
+			}
+			if err := m.RateProvider.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgStableSwapSetScalingFactorRateProviderResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgStableSwapSetScalingFactorRateProviderResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgStableSwapSetScalingFactorRateProviderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgStableSwapExitPoolToDenoms) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgStableSwapExitPoolToDenoms: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgStableSwapExitPoolToDenoms: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolID", wireType)
+			}
+			m.PoolID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShareInAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ShareInAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOutDenoms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenOutDenoms = append(m.TokenOutDenoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOutMins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenOutMins = append(m.TokenOutMins, types.Coin{})
+			if err := m.TokenOutMins[len(m.TokenOutMins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgStableSwapExitPoolToDenomsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgStableSwapExitPoolToDenomsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgStableSwapExitPoolToDenomsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOut", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenOut = append(m.TokenOut, types.Coin{})
+			if err := m.TokenOut[len(m.TokenOut)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
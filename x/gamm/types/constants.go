@@ -18,6 +18,10 @@ const (
 	// pools can be created with min and max number of assets defined with this constants
 	MinNumOfAssetsInPool = 2
 	MaxNumOfAssetsInPool = 8
+
+	// MaxRateProviderQueryGas is the most gas a scaling factor rate provider contract can use
+	// to return the scaling factors of a pool, so that one contract cannot stall the epoch.
+	MaxRateProviderQueryGas = 500_000
 )
 
 var (
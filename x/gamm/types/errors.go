@@ -54,4 +54,20 @@ var (
 	ErrInvalidScalingFactors      = sdkerrors.Register(ModuleName, 64, "scaling factors cannot be 0 or use more than 63 bits")
 	ErrHitMaxScaledAssets         = sdkerrors.Register(ModuleName, 65, "post-scaled pool assets can not exceed 10^34")
 	ErrHitMinScaledAssets         = sdkerrors.Register(ModuleName, 66, "post-scaled pool assets can not be less than 1")
+	ErrInvalidScalingFactorChange = sdkerrors.Register(ModuleName, 67, "scaling factor change duration cannot be negative")
+	ErrInvalidRateProvider        = sdkerrors.Register(ModuleName, 68, "invalid scaling factor rate provider")
+	ErrRateProviderQuery          = sdkerrors.Register(ModuleName, 69, "scaling factor rate provider query failed")
+
+	ErrInvalidPoolController       = sdkerrors.Register(ModuleName, 70, "invalid pool controller")
+	ErrNotPoolController           = sdkerrors.Register(ModuleName, 71, "not pool controller")
+	ErrSmoothWeightChangeStartTime = sdkerrors.Register(ModuleName, 72, "smooth weight change cannot start before the current block time")
+	ErrInvalidSmoothWeightChange   = sdkerrors.Register(ModuleName, 73, "invalid smooth weight change")
+
+	ErrInvalidTakerFeeShare     = sdkerrors.Register(ModuleName, 74, "taker fee share must be between 0 and 1")
+	ErrInvalidTakerFeeRecipient = sdkerrors.Register(ModuleName, 75, "invalid taker fee recipient")
+	ErrTooMuchTakerFeeShare     = sdkerrors.Register(ModuleName, 76, "taker fee share exceeds the max taker fee share")
+
+	ErrMigrationSamePool                    = sdkerrors.Register(ModuleName, 77, "cannot migrate liquidity to the pool it is leaving")
+	ErrMigrationAssetsMismatch              = sdkerrors.Register(ModuleName, 78, "pools to migrate liquidity between must have the same assets")
+	ErrLockedLiquidityMigrationNotSupported = sdkerrors.Register(ModuleName, 79, "locked liquidity migration is not supported")
 )
@@ -6,9 +6,25 @@ const (
 	TypeEvtPoolCreated  = "pool_created"
 	TypeEvtTokenSwapped = "token_swapped"
 
+	TypeEvtScalingFactorsAdjusted         = "scaling_factors_adjusted"
+	TypeEvtScalingFactorRateProviderSet   = "scaling_factor_rate_provider_set"
+	TypeEvtScalingFactorRateProviderError = "scaling_factor_rate_provider_error"
+	TypeEvtSmoothWeightChangeUpdated      = "smooth_weight_change_updated"
+	TypeEvtTakerFeeCharged                = "taker_fee_charged"
+
 	AttributeValueCategory = ModuleName
 	AttributeKeyPoolId     = "pool_id"
 	AttributeKeySwapFee    = "swap_fee"
 	AttributeKeyTokensIn   = "tokens_in"
 	AttributeKeyTokensOut  = "tokens_out"
+
+	AttributeKeyScalingFactors = "scaling_factors"
+	AttributeKeyChangeDuration = "change_duration"
+	AttributeKeyContract       = "contract"
+	AttributeKeyReason         = "reason"
+	AttributeKeyStartTime      = "start_time"
+	AttributeKeyDuration       = "duration"
+	AttributeKeyTargetWeights  = "target_weights"
+	AttributeKeyRecipient      = "recipient"
+	AttributeKeyTakerFee       = "taker_fee"
 )
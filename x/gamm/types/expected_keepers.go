@@ -44,11 +44,54 @@ type CommunityPoolKeeper interface {
 	FundCommunityPool(ctx sdk.Context, amount sdk.Coins, sender sdk.AccAddress) error
 }
 
-type PoolIncentivesKeeper interface {
-	IsPoolIncentivized(ctx sdk.Context, poolId uint64) bool
+// ContractKeeper defines the CosmWasm contract that x/gamm relies on to query
+// stableswap scaling factor rate provider contracts.
+type ContractKeeper interface {
+	QuerySmart(ctx sdk.Context, contractAddr sdk.AccAddress, req []byte) ([]byte, error)
 }
 
-type PoolCreationManager interface {
+// PoolManager defines the swaprouter contract that x/gamm relies on to create
+// pools and to route swaps and swap estimates through them.
+type PoolManager interface {
 	CreatePool(ctx sdk.Context, msg swaproutertypes.CreatePoolMsg) (uint64, error)
 	GetNextPoolId(ctx sdk.Context) uint64
+
+	RouteExactAmountIn(
+		ctx sdk.Context,
+		sender sdk.AccAddress,
+		routes []swaproutertypes.SwapAmountInRoute,
+		tokenIn sdk.Coin,
+		tokenOutMinAmount sdk.Int) (tokenOutAmount sdk.Int, err error)
+
+	RouteExactAmountOut(ctx sdk.Context,
+		sender sdk.AccAddress,
+		routes []swaproutertypes.SwapAmountOutRoute,
+		tokenInMaxAmount sdk.Int,
+		tokenOut sdk.Coin,
+	) (tokenInAmount sdk.Int, err error)
+
+	MultihopEstimateOutGivenExactAmountIn(
+		ctx sdk.Context,
+		routes []swaproutertypes.SwapAmountInRoute,
+		tokenIn sdk.Coin,
+	) (tokenOutAmount sdk.Int, err error)
+
+	MultihopEstimateInGivenExactAmountOut(
+		ctx sdk.Context,
+		routes []swaproutertypes.SwapAmountOutRoute,
+		tokenOut sdk.Coin) (tokenInAmount sdk.Int, err error)
+}
+
+// LockedLiquidityMigrator migrates the pool shares held in a lock to another pool,
+// keeping the lock and any superfluid delegation of it on the new pool shares.
+type LockedLiquidityMigrator interface {
+	MigrateLockedLiquidity(
+		ctx sdk.Context,
+		sender sdk.AccAddress,
+		lockId uint64,
+		poolIdLeaving, poolIdEntering uint64,
+		sharesToMigrate sdk.Int,
+		tokenOutMins sdk.Coins,
+		shareOutMinAmount sdk.Int,
+	) (sharesOut sdk.Int, err error)
 }
@@ -30,6 +30,13 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 // Params holds parameters for the incentives module
 type Params struct {
 	PoolCreationFee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=pool_creation_fee,json=poolCreationFee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"pool_creation_fee" yaml:"pool_creation_fee"`
+	// scaling_factor_rate_provider_epoch_identifier is the epoch at the end of
+	// which stableswap pools with a scaling factor rate provider are updated.
+	ScalingFactorRateProviderEpochIdentifier string `protobuf:"bytes,2,opt,name=scaling_factor_rate_provider_epoch_identifier,json=scalingFactorRateProviderEpochIdentifier,proto3" json:"scaling_factor_rate_provider_epoch_identifier,omitempty" yaml:"scaling_factor_rate_provider_epoch_identifier"`
+	// max_taker_fee_share is the maximum share of a pool's swap fee that can be
+	// sent to its taker fee recipient. Pools with a higher taker_fee_share are
+	// charged this share instead.
+	MaxTakerFeeShare github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=max_taker_fee_share,json=maxTakerFeeShare,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_taker_fee_share" yaml:"max_taker_fee_share"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -72,6 +79,13 @@ func (m *Params) GetPoolCreationFee() github_com_cosmos_cosmos_sdk_types.Coins {
 	return nil
 }
 
+func (m *Params) GetScalingFactorRateProviderEpochIdentifier() string {
+	if m != nil {
+		return m.ScalingFactorRateProviderEpochIdentifier
+	}
+	return ""
+}
+
 // GenesisState defines the gamm module's genesis state.
 type GenesisState struct {
 	Pools []*types1.Any `protobuf:"bytes,1,rep,name=pools,proto3" json:"pools,omitempty"`
@@ -144,33 +158,40 @@ func init() {
 }
 
 var fileDescriptor_5a324eb7f1dd793e = []byte{
-	// 401 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x92, 0xcf, 0xaa, 0xd3, 0x40,
-	0x18, 0xc5, 0x33, 0xde, 0x7b, 0x0b, 0xe6, 0x8a, 0x7f, 0x42, 0x17, 0xb9, 0x17, 0x49, 0x4b, 0x56,
-	0xd9, 0x74, 0xc6, 0xde, 0xe2, 0xa6, 0x3b, 0x53, 0x50, 0x14, 0x91, 0x12, 0x77, 0x6e, 0xc2, 0x24,
-	0x4e, 0x63, 0x30, 0x99, 0x2f, 0x64, 0xa6, 0xa5, 0x79, 0x0b, 0xc1, 0xbd, 0x0f, 0xa0, 0x5b, 0x1f,
-	0xa2, 0xb8, 0xea, 0xd2, 0x55, 0x95, 0xf6, 0x0d, 0x7c, 0x02, 0x99, 0x3f, 0x11, 0x41, 0x57, 0xc9,
-	0x37, 0xdf, 0xef, 0x1c, 0xce, 0x9c, 0xc4, 0x0d, 0x41, 0xd4, 0x20, 0x4a, 0x41, 0x0a, 0x5a, 0xd7,
-	0x64, 0x33, 0xcd, 0x98, 0xa4, 0x53, 0x52, 0x30, 0xce, 0x44, 0x29, 0x70, 0xd3, 0x82, 0x04, 0x6f,
-	0x68, 0x19, 0xac, 0x18, 0x6c, 0x99, 0xeb, 0x61, 0x01, 0x05, 0x68, 0x80, 0xa8, 0x37, 0xc3, 0x5e,
-	0x5f, 0x15, 0x00, 0x45, 0xc5, 0x88, 0x9e, 0xb2, 0xf5, 0x8a, 0x50, 0xde, 0xf5, 0xab, 0x5c, 0xfb,
-	0xa4, 0x46, 0x63, 0x06, 0xbb, 0x0a, 0xcc, 0x44, 0x32, 0x2a, 0xd8, 0x9f, 0x10, 0x39, 0x94, 0xdc,
-	0xec, 0xc3, 0x4f, 0xc8, 0x1d, 0x2c, 0x69, 0x4b, 0x6b, 0xe1, 0x7d, 0x44, 0xee, 0x83, 0x06, 0xa0,
-	0x4a, 0xf3, 0x96, 0x51, 0x59, 0x02, 0x4f, 0x57, 0x8c, 0xf9, 0x68, 0x7c, 0x16, 0x5d, 0xde, 0x5c,
-	0x61, 0xeb, 0xaa, 0x7c, 0xfa, 0xa0, 0x78, 0x01, 0x25, 0x8f, 0x5f, 0xee, 0x0e, 0x23, 0xe7, 0xd7,
-	0x61, 0xe4, 0x77, 0xb4, 0xae, 0xe6, 0xe1, 0x3f, 0x0e, 0xe1, 0xe7, 0x1f, 0xa3, 0xa8, 0x28, 0xe5,
-	0xbb, 0x75, 0x86, 0x73, 0xa8, 0x6d, 0x3c, 0xfb, 0x98, 0x88, 0xb7, 0xef, 0x89, 0xec, 0x1a, 0x26,
-	0xb4, 0x99, 0x48, 0xee, 0x29, 0xfd, 0xc2, 0xca, 0x9f, 0x32, 0x16, 0x7e, 0x41, 0xee, 0x9d, 0x67,
-	0xa6, 0xb4, 0xd7, 0x92, 0x4a, 0xe6, 0x3d, 0x76, 0x2f, 0x14, 0x23, 0x6c, 0xb2, 0x21, 0x36, 0xbd,
-	0xe0, 0xbe, 0x17, 0xfc, 0x84, 0x77, 0xf1, 0xed, 0x6f, 0x5f, 0x27, 0x17, 0x4b, 0x80, 0xea, 0x79,
-	0x62, 0x68, 0x2f, 0x72, 0xef, 0x73, 0xb6, 0x95, 0xa9, 0xce, 0xc7, 0xd7, 0x75, 0xc6, 0x5a, 0xff,
-	0xd6, 0x18, 0x45, 0xe7, 0xc9, 0x5d, 0x75, 0xae, 0xd8, 0x57, 0xfa, 0xd4, 0x9b, 0xbb, 0x83, 0x46,
-	0x37, 0xe2, 0x9f, 0x8d, 0x51, 0x74, 0x79, 0xf3, 0x10, 0xff, 0xef, 0x2b, 0x61, 0xd3, 0x5a, 0x7c,
-	0xae, 0xae, 0x9f, 0x58, 0x45, 0xfc, 0x62, 0x77, 0x0c, 0xd0, 0xfe, 0x18, 0xa0, 0x9f, 0xc7, 0x00,
-	0x7d, 0x38, 0x05, 0xce, 0xfe, 0x14, 0x38, 0xdf, 0x4f, 0x81, 0xf3, 0xe6, 0xd1, 0x5f, 0x15, 0x58,
-	0xbf, 0x49, 0x45, 0x33, 0xd1, 0x0f, 0x64, 0x33, 0x9d, 0x91, 0xad, 0xf9, 0x59, 0x74, 0x21, 0xd9,
-	0x40, 0xdf, 0x68, 0xf6, 0x3b, 0x00, 0x00, 0xff, 0xff, 0x43, 0x18, 0x92, 0x53, 0x49, 0x02, 0x00,
-	0x00,
+	// 520 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x93, 0xcf, 0x6e, 0xd3, 0x40,
+	0x10, 0xc6, 0x63, 0xd2, 0x46, 0xaa, 0x8b, 0xa0, 0x98, 0x1c, 0xd2, 0x08, 0xd9, 0x95, 0x0f, 0x28,
+	0x97, 0xac, 0x49, 0x0b, 0x12, 0xea, 0x8d, 0x14, 0x82, 0x8a, 0x10, 0x8a, 0x5c, 0x4e, 0x5c, 0x56,
+	0x6b, 0x67, 0xe2, 0xac, 0x6a, 0xef, 0x46, 0xde, 0x4d, 0x94, 0x88, 0x97, 0x40, 0xe2, 0xc4, 0x2b,
+	0xc0, 0x15, 0x89, 0x57, 0xa8, 0x38, 0xf5, 0x88, 0x38, 0x04, 0x94, 0xbc, 0x41, 0x9e, 0x00, 0xed,
+	0x9f, 0x54, 0x48, 0xf4, 0x40, 0x4f, 0xf6, 0xcc, 0xfc, 0xe6, 0xd3, 0xcc, 0xf8, 0xb3, 0x1b, 0x72,
+	0x51, 0x70, 0x41, 0x45, 0x94, 0x91, 0xa2, 0x88, 0xa6, 0x9d, 0x04, 0x24, 0xe9, 0x44, 0x19, 0x30,
+	0x10, 0x54, 0xa0, 0x71, 0xc9, 0x25, 0xf7, 0xea, 0x96, 0x41, 0x8a, 0x41, 0x96, 0x69, 0xd6, 0x33,
+	0x9e, 0x71, 0x0d, 0x44, 0xea, 0xcd, 0xb0, 0xcd, 0xfd, 0x8c, 0xf3, 0x2c, 0x87, 0x48, 0x47, 0xc9,
+	0x64, 0x18, 0x11, 0x36, 0xdf, 0x94, 0x52, 0xad, 0x83, 0x4d, 0x8f, 0x09, 0x6c, 0xc9, 0x37, 0x51,
+	0x94, 0x10, 0x01, 0x57, 0x43, 0xa4, 0x9c, 0x32, 0x53, 0x0f, 0xbf, 0x55, 0xdd, 0x5a, 0x9f, 0x94,
+	0xa4, 0x10, 0xde, 0x47, 0xc7, 0xbd, 0x37, 0xe6, 0x3c, 0xc7, 0x69, 0x09, 0x44, 0x52, 0xce, 0xf0,
+	0x10, 0xa0, 0xe1, 0x1c, 0x54, 0x5b, 0xbb, 0x87, 0xfb, 0xc8, 0xaa, 0x2a, 0x9d, 0xcd, 0xa0, 0xe8,
+	0x84, 0x53, 0xd6, 0x7d, 0x7d, 0xb1, 0x08, 0x2a, 0xeb, 0x45, 0xd0, 0x98, 0x93, 0x22, 0x3f, 0x0e,
+	0xff, 0x51, 0x08, 0x3f, 0xff, 0x0a, 0x5a, 0x19, 0x95, 0xa3, 0x49, 0x82, 0x52, 0x5e, 0xd8, 0xf1,
+	0xec, 0xa3, 0x2d, 0x06, 0xe7, 0x91, 0x9c, 0x8f, 0x41, 0x68, 0x31, 0x11, 0xdf, 0x55, 0xfd, 0x27,
+	0xb6, 0xbd, 0x07, 0xe0, 0x7d, 0x72, 0xdc, 0xb6, 0x48, 0x49, 0x4e, 0x59, 0x86, 0x87, 0x24, 0x95,
+	0xbc, 0xc4, 0x25, 0x91, 0xa0, 0x76, 0x9d, 0xd2, 0x01, 0x94, 0x18, 0xc6, 0x3c, 0x1d, 0x61, 0x3a,
+	0x00, 0x26, 0xe9, 0x90, 0x42, 0xd9, 0xb8, 0x75, 0xe0, 0xb4, 0x76, 0xba, 0x4f, 0xd7, 0x8b, 0xe0,
+	0xb1, 0x19, 0xe9, 0x46, 0xed, 0x61, 0xdc, 0xb2, 0x7c, 0x4f, 0xe3, 0x31, 0x91, 0xd0, 0xb7, 0xf0,
+	0x0b, 0xc5, 0x9e, 0x5e, 0xa1, 0xde, 0x7b, 0xf7, 0x7e, 0x41, 0x66, 0x58, 0x92, 0x73, 0x28, 0xd5,
+	0xaa, 0x58, 0x8c, 0x48, 0x09, 0x8d, 0xaa, 0x1e, 0x40, 0xdf, 0xe5, 0xe7, 0x22, 0x78, 0xf8, 0x1f,
+	0xbb, 0x3f, 0x87, 0x74, 0xbd, 0x08, 0x9a, 0x66, 0xdc, 0x6b, 0x24, 0xc3, 0x78, 0xaf, 0x20, 0xb3,
+	0xb7, 0x2a, 0xd9, 0x03, 0x38, 0xd3, 0xa9, 0x2f, 0x8e, 0x7b, 0xfb, 0xa5, 0x71, 0xd3, 0x99, 0x24,
+	0x12, 0xbc, 0x27, 0xee, 0xb6, 0x3a, 0x9e, 0xb0, 0x9f, 0xac, 0x8e, 0x8c, 0x61, 0xd0, 0xc6, 0x30,
+	0xe8, 0x19, 0x9b, 0x77, 0x77, 0xbe, 0x7f, 0x6d, 0x6f, 0xf7, 0x39, 0xcf, 0x4f, 0x63, 0x43, 0x7b,
+	0x2d, 0x77, 0x8f, 0xc1, 0x4c, 0x62, 0x15, 0x61, 0x36, 0x29, 0x12, 0x7b, 0xc2, 0xad, 0xf8, 0x8e,
+	0xca, 0x2b, 0xf6, 0x8d, 0xce, 0x7a, 0xc7, 0x6e, 0x6d, 0xac, 0xad, 0xa2, 0x37, 0xdc, 0x3d, 0x7c,
+	0x80, 0xae, 0xb3, 0x2f, 0x32, 0x76, 0xea, 0x6e, 0xa9, 0xfd, 0x63, 0xdb, 0xd1, 0x7d, 0x75, 0xb1,
+	0xf4, 0x9d, 0xcb, 0xa5, 0xef, 0xfc, 0x5e, 0xfa, 0xce, 0x87, 0x95, 0x5f, 0xb9, 0x5c, 0xf9, 0x95,
+	0x1f, 0x2b, 0xbf, 0xf2, 0xee, 0xd1, 0x5f, 0xf7, 0xb1, 0x7a, 0xed, 0x9c, 0x24, 0x62, 0x13, 0x44,
+	0xd3, 0xce, 0x51, 0x34, 0x33, 0x7f, 0x91, 0xbe, 0x56, 0x52, 0xd3, 0x1b, 0x1d, 0xfd, 0x19, 0x00,
+	0xc8, 0xed, 0xb4, 0xec, 0x62, 0x03, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -193,6 +214,23 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.MaxTakerFeeShare.Size()
+		i -= size
+		if _, err := m.MaxTakerFeeShare.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.ScalingFactorRateProviderEpochIdentifier) > 0 {
+		i -= len(m.ScalingFactorRateProviderEpochIdentifier)
+		copy(dAtA[i:], m.ScalingFactorRateProviderEpochIdentifier)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ScalingFactorRateProviderEpochIdentifier)))
+		i--
+		dAtA[i] = 0x12
+	}
 	if len(m.PoolCreationFee) > 0 {
 		for iNdEx := len(m.PoolCreationFee) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -285,6 +323,12 @@ func (m *Params) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	l = len(m.ScalingFactorRateProviderEpochIdentifier)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.MaxTakerFeeShare.Size()
+	n += 1 + l + sovGenesis(uint64(l))
 	return n
 }
 
@@ -377,6 +421,72 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScalingFactorRateProviderEpochIdentifier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScalingFactorRateProviderEpochIdentifier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTakerFeeShare", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxTakerFeeShare.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
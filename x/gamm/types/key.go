@@ -25,6 +25,8 @@ var (
 	KeyPrefixPools = []byte{0x02}
 	// KeyTotalLiquidity defines key to store total liquidity.
 	KeyTotalLiquidity = []byte{0x03}
+	// KeyPrefixProtocolFees defines prefix to store the taker fees sent by each pool to its taker fee recipient.
+	KeyPrefixProtocolFees = []byte{0x04}
 )
 
 func MustGetPoolIdFromShareDenom(denom string) uint64 {
@@ -45,6 +47,19 @@ func ValidatePoolShareDenom(denom string) error {
 	return nil
 }
 
+// GetPoolIdFromShareDenom returns the ID of the pool of the given pool share denom,
+// or an error if the denom is not a pool share denom.
+func GetPoolIdFromShareDenom(denom string) (uint64, error) {
+	if !strings.HasPrefix(denom, "gamm/pool/") {
+		return 0, fmt.Errorf("%s is not a pool share denom", denom)
+	}
+	poolId, err := strconv.ParseUint(strings.TrimPrefix(denom, "gamm/pool/"), 10, 64)
+	if err != nil || GetPoolShareDenom(poolId) != denom {
+		return 0, fmt.Errorf("%s is not a pool share denom", denom)
+	}
+	return poolId, nil
+}
+
 func GetDenomPrefix(denom string) []byte {
 	return append(KeyTotalLiquidity, []byte(denom)...)
 }
@@ -56,3 +71,7 @@ func GetPoolShareDenom(poolId uint64) string {
 func GetKeyPrefixPools(poolId uint64) []byte {
 	return append(KeyPrefixPools, sdk.Uint64ToBigEndian(poolId)...)
 }
+
+func GetKeyPrefixProtocolFees(poolId uint64) []byte {
+	return append(KeyPrefixProtocolFees, sdk.Uint64ToBigEndian(poolId)...)
+}
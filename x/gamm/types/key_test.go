@@ -22,3 +22,18 @@ func TestGetPoolShareDenom(t *testing.T) {
 	require.NoError(t, sdk.ValidateDenom(denom))
 	require.Equal(t, "gamm/pool/18446744073709551615", denom)
 }
+
+func TestGetPoolIdFromShareDenom(t *testing.T) {
+	poolId, err := GetPoolIdFromShareDenom("gamm/pool/10")
+	require.NoError(t, err)
+	require.Equal(t, uint64(10), poolId)
+
+	poolId, err = GetPoolIdFromShareDenom(GetPoolShareDenom(math.MaxUint64))
+	require.NoError(t, err)
+	require.Equal(t, uint64(math.MaxUint64), poolId)
+
+	for _, denom := range []string{"uosmo", "10", "gamm/pool/", "gamm/pool/010", "gamm/pool/-1", "gamm/pool/1/2", "cl/pool/1"} {
+		_, err = GetPoolIdFromShareDenom(denom)
+		require.Error(t, err, denom)
+	}
+}
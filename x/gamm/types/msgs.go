@@ -19,6 +19,7 @@ const (
 	TypeMsgJoinSwapShareAmountOut  = "join_swap_share_amount_out"
 	TypeMsgExitSwapExternAmountOut = "exit_swap_extern_amount_out"
 	TypeMsgExitSwapShareAmountIn   = "exit_swap_share_amount_in"
+	TypeMsgMigrateLiquidity        = "migrate_liquidity"
 )
 
 func ValidateFutureGovernor(governor string) error {
@@ -347,3 +348,45 @@ func (msg MsgExitSwapShareAmountIn) GetSigners() []sdk.AccAddress {
 	}
 	return []sdk.AccAddress{sender}
 }
+
+var _ sdk.Msg = &MsgMigrateLiquidity{}
+
+func (msg MsgMigrateLiquidity) Route() string { return RouterKey }
+func (msg MsgMigrateLiquidity) Type() string  { return TypeMsgMigrateLiquidity }
+func (msg MsgMigrateLiquidity) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	if msg.PoolIdLeaving == msg.PoolIdEntering {
+		return sdkerrors.Wrapf(ErrMigrationSamePool, "pool id: %d", msg.PoolIdLeaving)
+	}
+
+	if !msg.SharesToMigrate.IsPositive() {
+		return sdkerrors.Wrap(ErrNotPositiveRequireAmount, msg.SharesToMigrate.String())
+	}
+
+	tokenOutMins := sdk.Coins(msg.TokenOutMins)
+	if !tokenOutMins.IsValid() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, tokenOutMins.String())
+	}
+
+	if msg.ShareOutMinAmount.IsNegative() {
+		return sdkerrors.Wrap(ErrNotPositiveCriteria, msg.ShareOutMinAmount.String())
+	}
+
+	return nil
+}
+
+func (msg MsgMigrateLiquidity) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgMigrateLiquidity) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
@@ -872,6 +872,115 @@ func TestMsgExitSwapShareAmountIn(t *testing.T) {
 }
 
 // Test authz serialize and de-serializes for gamm msg.
+func TestMsgMigrateLiquidity(t *testing.T) {
+	appParams.SetAddressPrefixes()
+	pk1 := ed25519.GenPrivKey().PubKey()
+	addr1 := sdk.AccAddress(pk1.Address()).String()
+	invalidAddr := sdk.AccAddress("invalid")
+
+	createMsg := func(after func(msg gammtypes.MsgMigrateLiquidity) gammtypes.MsgMigrateLiquidity) gammtypes.MsgMigrateLiquidity {
+		properMsg := gammtypes.MsgMigrateLiquidity{
+			Sender:            addr1,
+			PoolIdLeaving:     1,
+			PoolIdEntering:    2,
+			SharesToMigrate:   sdk.NewInt(10),
+			TokenOutMins:      sdk.NewCoins(sdk.NewCoin("test1", sdk.NewInt(10)), sdk.NewCoin("test2", sdk.NewInt(20))),
+			ShareOutMinAmount: sdk.NewInt(9),
+		}
+		return after(properMsg)
+	}
+
+	msg := createMsg(func(msg gammtypes.MsgMigrateLiquidity) gammtypes.MsgMigrateLiquidity {
+		// Do nothing
+		return msg
+	})
+
+	require.Equal(t, msg.Route(), gammtypes.RouterKey)
+	require.Equal(t, msg.Type(), "migrate_liquidity")
+	signers := msg.GetSigners()
+	require.Equal(t, len(signers), 1)
+	require.Equal(t, signers[0].String(), addr1)
+
+	tests := []struct {
+		name       string
+		msg        gammtypes.MsgMigrateLiquidity
+		expectPass bool
+	}{
+		{
+			name: "proper msg",
+			msg: createMsg(func(msg gammtypes.MsgMigrateLiquidity) gammtypes.MsgMigrateLiquidity {
+				// Do nothing
+				return msg
+			}),
+			expectPass: true,
+		},
+		{
+			name: "proper msg with lock",
+			msg: createMsg(func(msg gammtypes.MsgMigrateLiquidity) gammtypes.MsgMigrateLiquidity {
+				msg.LockId = 1
+				return msg
+			}),
+			expectPass: true,
+		},
+		{
+			name: "invalid sender",
+			msg: createMsg(func(msg gammtypes.MsgMigrateLiquidity) gammtypes.MsgMigrateLiquidity {
+				msg.Sender = invalidAddr.String()
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "same pool",
+			msg: createMsg(func(msg gammtypes.MsgMigrateLiquidity) gammtypes.MsgMigrateLiquidity {
+				msg.PoolIdEntering = msg.PoolIdLeaving
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "zero shares to migrate",
+			msg: createMsg(func(msg gammtypes.MsgMigrateLiquidity) gammtypes.MsgMigrateLiquidity {
+				msg.SharesToMigrate = sdk.ZeroInt()
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "zero token out min",
+			msg: createMsg(func(msg gammtypes.MsgMigrateLiquidity) gammtypes.MsgMigrateLiquidity {
+				msg.TokenOutMins[1].Amount = sdk.NewInt(0)
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "'empty token min out' can pass",
+			msg: createMsg(func(msg gammtypes.MsgMigrateLiquidity) gammtypes.MsgMigrateLiquidity {
+				msg.TokenOutMins = nil
+				return msg
+			}),
+			expectPass: true,
+		},
+		{
+			name: "negative share out min",
+			msg: createMsg(func(msg gammtypes.MsgMigrateLiquidity) gammtypes.MsgMigrateLiquidity {
+				msg.ShareOutMinAmount = sdk.NewInt(-1)
+				return msg
+			}),
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		if test.expectPass {
+			require.NoError(t, test.msg.ValidateBasic(), "test: %v", test.name)
+		} else {
+			require.Error(t, test.msg.ValidateBasic(), "test: %v", test.name)
+		}
+	}
+}
+
 func TestAuthzMsg(t *testing.T) {
 	pk1 := ed25519.GenPrivKey().PubKey()
 	addr1 := sdk.AccAddress(pk1.Address()).String()
@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	appparams "github.com/osmosis-labs/osmosis/v13/app/params"
+	epochtypes "github.com/osmosis-labs/osmosis/v13/x/epochs/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
@@ -11,7 +12,9 @@ import (
 
 // Parameter store keys.
 var (
-	KeyPoolCreationFee = []byte("PoolCreationFee")
+	KeyPoolCreationFee                          = []byte("PoolCreationFee")
+	KeyScalingFactorRateProviderEpochIdentifier = []byte("ScalingFactorRateProviderEpochIdentifier")
+	KeyMaxTakerFeeShare                         = []byte("MaxTakerFeeShare")
 )
 
 // ParamTable for gamm module.
@@ -19,16 +22,20 @@ func ParamKeyTable() paramtypes.KeyTable {
 	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
 }
 
-func NewParams(poolCreationFee sdk.Coins) Params {
+func NewParams(poolCreationFee sdk.Coins, scalingFactorRateProviderEpochIdentifier string, maxTakerFeeShare sdk.Dec) Params {
 	return Params{
-		PoolCreationFee: poolCreationFee,
+		PoolCreationFee:                          poolCreationFee,
+		ScalingFactorRateProviderEpochIdentifier: scalingFactorRateProviderEpochIdentifier,
+		MaxTakerFeeShare:                         maxTakerFeeShare,
 	}
 }
 
 // default gamm module parameters.
 func DefaultParams() Params {
 	return Params{
-		PoolCreationFee: sdk.Coins{sdk.NewInt64Coin(appparams.BaseCoinUnit, 1000_000_000)}, // 1000 OSMO
+		PoolCreationFee:                          sdk.Coins{sdk.NewInt64Coin(appparams.BaseCoinUnit, 1000_000_000)}, // 1000 OSMO
+		ScalingFactorRateProviderEpochIdentifier: "day",
+		MaxTakerFeeShare:                         sdk.NewDecWithPrec(5, 1), // 50%
 	}
 }
 
@@ -38,6 +45,14 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := epochtypes.ValidateEpochIdentifierString(p.ScalingFactorRateProviderEpochIdentifier); err != nil {
+		return err
+	}
+
+	if err := validateMaxTakerFeeShare(p.MaxTakerFeeShare); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -45,6 +60,8 @@ func (p Params) Validate() error {
 func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 	return paramtypes.ParamSetPairs{
 		paramtypes.NewParamSetPair(KeyPoolCreationFee, &p.PoolCreationFee, validatePoolCreationFee),
+		paramtypes.NewParamSetPair(KeyScalingFactorRateProviderEpochIdentifier, &p.ScalingFactorRateProviderEpochIdentifier, epochtypes.ValidateEpochIdentifierInterface),
+		paramtypes.NewParamSetPair(KeyMaxTakerFeeShare, &p.MaxTakerFeeShare, validateMaxTakerFeeShare),
 	}
 }
 
@@ -60,3 +77,16 @@ func validatePoolCreationFee(i interface{}) error {
 
 	return nil
 }
+
+func validateMaxTakerFeeShare(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() || v.IsNegative() || v.GT(sdk.OneDec()) {
+		return fmt.Errorf("max taker fee share must be between 0 and 1, got %s", v)
+	}
+
+	return nil
+}
@@ -6,6 +6,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/types/address"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
 	swaproutertypes "github.com/osmosis-labs/osmosis/v13/x/swaprouter/types"
 )
@@ -52,6 +53,11 @@ type CFMMPoolI interface {
 	// CalcInAmtGivenOut returns how many coins SwapInAmtGivenOut would return on these arguments.
 	// This does not mutate the pool, or state.
 	CalcInAmtGivenOut(ctx sdk.Context, tokenOut sdk.Coins, tokenInDenom string, swapFee sdk.Dec) (tokenIn sdk.Coin, err error)
+
+	// GetTakerFeeShare returns the share of the swap fee that is sent to the pool's taker fee recipient instead of its LPs.
+	GetTakerFeeShare(ctx sdk.Context) sdk.Dec
+	// GetTakerFeeRecipient returns the address taker fees are sent to, or the empty string if they are sent to the community pool.
+	GetTakerFeeRecipient(ctx sdk.Context) string
 }
 
 // PoolAmountOutExtension is an extension of the PoolI
@@ -95,19 +101,41 @@ type PoolAmountOutExtension interface {
 	IncreaseLiquidity(sharesOut sdk.Int, coinsIn sdk.Coins)
 }
 
-// WeightedPoolExtension is an extension of the PoolI interface
-// That defines an additional API for handling the pool's weights.
-type WeightedPoolExtension interface {
+// PokePoolExtension is an extension of the PoolI interface
+// for pools whose parameters change over time.
+type PokePoolExtension interface {
 	CFMMPoolI
 
-	// PokePool determines if a pool's weights need to be updated and updates
-	// them if so.
+	// PokePool determines if a pool's parameters need to be updated
+	// for the given block time and updates them if so.
 	PokePool(blockTime time.Time)
+}
+
+// WeightedPoolExtension is an extension of the PoolI interface
+// That defines an additional API for handling the pool's weights.
+type WeightedPoolExtension interface {
+	PokePoolExtension
 
 	// GetTokenWeight returns the weight of the specified token in the pool.
 	GetTokenWeight(denom string) (sdk.Int, error)
 }
 
+// ValidateTakerFeeParams validates the taker fee share and recipient of a pool's params.
+// A nil share is treated as zero, and an empty recipient as the community pool.
+func ValidateTakerFeeParams(takerFeeShare sdk.Dec, takerFeeRecipient string) error {
+	if !takerFeeShare.IsNil() && (takerFeeShare.IsNegative() || takerFeeShare.GT(sdk.OneDec())) {
+		return sdkerrors.Wrapf(ErrInvalidTakerFeeShare, "got %s", takerFeeShare)
+	}
+
+	if takerFeeRecipient != "" {
+		if _, err := sdk.AccAddressFromBech32(takerFeeRecipient); err != nil {
+			return sdkerrors.Wrapf(ErrInvalidTakerFeeRecipient, "%s", err)
+		}
+	}
+
+	return nil
+}
+
 // TODO: move to swaprouter
 func NewPoolAddress(poolId uint64) sdk.AccAddress {
 	key := append([]byte("pool"), sdk.Uint64ToBigEndian(poolId)...)
@@ -2,6 +2,8 @@ package types
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	swaproutertypes "github.com/osmosis-labs/osmosis/v13/x/swaprouter/types"
 )
 
 type SwapAmountInRoutes []SwapAmountInRoute
@@ -45,6 +47,19 @@ func (routes SwapAmountInRoutes) Length() int {
 	return len(routes)
 }
 
+// ToSwaprouterRoutes converts the routes to swaprouter routes, so that the swap
+// can be routed through swaprouter.
+func (routes SwapAmountInRoutes) ToSwaprouterRoutes() []swaproutertypes.SwapAmountInRoute {
+	swaprouterRoutes := make([]swaproutertypes.SwapAmountInRoute, 0, len(routes))
+	for _, route := range routes {
+		swaprouterRoutes = append(swaprouterRoutes, swaproutertypes.SwapAmountInRoute{
+			PoolId:        route.PoolId,
+			TokenOutDenom: route.TokenOutDenom,
+		})
+	}
+	return swaprouterRoutes
+}
+
 type SwapAmountOutRoutes []SwapAmountOutRoute
 
 func (routes SwapAmountOutRoutes) Validate() error {
@@ -86,8 +101,15 @@ func (routes SwapAmountOutRoutes) Length() int {
 	return len(routes)
 }
 
-type MultihopRoute interface {
-	Length() int
-	PoolIds() []uint64
-	IntermediateDenoms() []string
+// ToSwaprouterRoutes converts the routes to swaprouter routes, so that the swap
+// can be routed through swaprouter.
+func (routes SwapAmountOutRoutes) ToSwaprouterRoutes() []swaproutertypes.SwapAmountOutRoute {
+	swaprouterRoutes := make([]swaproutertypes.SwapAmountOutRoute, 0, len(routes))
+	for _, route := range routes {
+		swaprouterRoutes = append(swaprouterRoutes, swaproutertypes.SwapAmountOutRoute{
+			PoolId:       route.PoolId,
+			TokenInDenom: route.TokenInDenom,
+		})
+	}
+	return swaprouterRoutes
 }
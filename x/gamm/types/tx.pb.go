@@ -945,6 +945,126 @@ func (m *MsgExitSwapExternAmountOutResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgExitSwapExternAmountOutResponse proto.InternalMessageInfo
 
+// ===================== MsgMigrateLiquidity
+// MsgMigrateLiquidity exits shares from one pool and joins another pool with
+// the same assets with the coins exited. If lock_id is set, the shares locked
+// in the lock are migrated, and the lock and any superfluid delegation of it
+// are kept on the shares of the pool joined.
+type MsgMigrateLiquidity struct {
+	Sender            string                                 `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
+	PoolIdLeaving     uint64                                 `protobuf:"varint,2,opt,name=pool_id_leaving,json=poolIdLeaving,proto3" json:"pool_id_leaving,omitempty" yaml:"pool_id_leaving"`
+	PoolIdEntering    uint64                                 `protobuf:"varint,3,opt,name=pool_id_entering,json=poolIdEntering,proto3" json:"pool_id_entering,omitempty" yaml:"pool_id_entering"`
+	SharesToMigrate   github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=shares_to_migrate,json=sharesToMigrate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"shares_to_migrate" yaml:"shares_to_migrate"`
+	TokenOutMins      []types.Coin                           `protobuf:"bytes,5,rep,name=token_out_mins,json=tokenOutMins,proto3" json:"token_out_mins" yaml:"token_out_min_amounts"`
+	ShareOutMinAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,6,opt,name=share_out_min_amount,json=shareOutMinAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"share_out_min_amount" yaml:"share_out_min_amount"`
+	LockId            uint64                                 `protobuf:"varint,7,opt,name=lock_id,json=lockId,proto3" json:"lock_id,omitempty" yaml:"lock_id"`
+}
+
+func (m *MsgMigrateLiquidity) Reset()         { *m = MsgMigrateLiquidity{} }
+func (m *MsgMigrateLiquidity) String() string { return proto.CompactTextString(m) }
+func (*MsgMigrateLiquidity) ProtoMessage()    {}
+func (*MsgMigrateLiquidity) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cfc8fd3ac7df3247, []int{18}
+}
+func (m *MsgMigrateLiquidity) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgMigrateLiquidity) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgMigrateLiquidity.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgMigrateLiquidity) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgMigrateLiquidity.Merge(m, src)
+}
+func (m *MsgMigrateLiquidity) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgMigrateLiquidity) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgMigrateLiquidity.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgMigrateLiquidity proto.InternalMessageInfo
+
+func (m *MsgMigrateLiquidity) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgMigrateLiquidity) GetPoolIdLeaving() uint64 {
+	if m != nil {
+		return m.PoolIdLeaving
+	}
+	return 0
+}
+
+func (m *MsgMigrateLiquidity) GetPoolIdEntering() uint64 {
+	if m != nil {
+		return m.PoolIdEntering
+	}
+	return 0
+}
+
+func (m *MsgMigrateLiquidity) GetTokenOutMins() []types.Coin {
+	if m != nil {
+		return m.TokenOutMins
+	}
+	return nil
+}
+
+func (m *MsgMigrateLiquidity) GetLockId() uint64 {
+	if m != nil {
+		return m.LockId
+	}
+	return 0
+}
+
+type MsgMigrateLiquidityResponse struct {
+	ShareOutAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,1,opt,name=share_out_amount,json=shareOutAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"share_out_amount" yaml:"share_out_amount"`
+}
+
+func (m *MsgMigrateLiquidityResponse) Reset()         { *m = MsgMigrateLiquidityResponse{} }
+func (m *MsgMigrateLiquidityResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgMigrateLiquidityResponse) ProtoMessage()    {}
+func (*MsgMigrateLiquidityResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cfc8fd3ac7df3247, []int{19}
+}
+func (m *MsgMigrateLiquidityResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgMigrateLiquidityResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgMigrateLiquidityResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgMigrateLiquidityResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgMigrateLiquidityResponse.Merge(m, src)
+}
+func (m *MsgMigrateLiquidityResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgMigrateLiquidityResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgMigrateLiquidityResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgMigrateLiquidityResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgJoinPool)(nil), "osmosis.gamm.v1beta1.MsgJoinPool")
 	proto.RegisterType((*MsgJoinPoolResponse)(nil), "osmosis.gamm.v1beta1.MsgJoinPoolResponse")
@@ -964,82 +1084,94 @@ func init() {
 	proto.RegisterType((*MsgExitSwapShareAmountInResponse)(nil), "osmosis.gamm.v1beta1.MsgExitSwapShareAmountInResponse")
 	proto.RegisterType((*MsgExitSwapExternAmountOut)(nil), "osmosis.gamm.v1beta1.MsgExitSwapExternAmountOut")
 	proto.RegisterType((*MsgExitSwapExternAmountOutResponse)(nil), "osmosis.gamm.v1beta1.MsgExitSwapExternAmountOutResponse")
+	proto.RegisterType((*MsgMigrateLiquidity)(nil), "osmosis.gamm.v1beta1.MsgMigrateLiquidity")
+	proto.RegisterType((*MsgMigrateLiquidityResponse)(nil), "osmosis.gamm.v1beta1.MsgMigrateLiquidityResponse")
 }
 
 func init() { proto.RegisterFile("osmosis/gamm/v1beta1/tx.proto", fileDescriptor_cfc8fd3ac7df3247) }
 
 var fileDescriptor_cfc8fd3ac7df3247 = []byte{
-	// 1121 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x58, 0x4b, 0x6f, 0x1b, 0x45,
-	0x1c, 0xcf, 0xd8, 0x6e, 0x1e, 0x93, 0xe6, 0xb5, 0x4d, 0x1a, 0x67, 0xdb, 0xda, 0xe9, 0x80, 0x20,
-	0xa5, 0xea, 0x6e, 0x93, 0x48, 0x80, 0xb8, 0x00, 0x86, 0x20, 0x5c, 0x61, 0xa5, 0xda, 0x5e, 0x2a,
-	0x2e, 0xd6, 0x3a, 0x5e, 0xb9, 0xab, 0x66, 0x67, 0x2c, 0xcf, 0x6c, 0x70, 0x85, 0x04, 0x12, 0x8f,
-	0x3b, 0x08, 0xf1, 0xf8, 0x04, 0x88, 0xaf, 0xc0, 0x01, 0x0e, 0x70, 0xe9, 0xb1, 0x37, 0x28, 0x07,
-	0x0b, 0x25, 0xdf, 0xc0, 0x9f, 0xa0, 0xda, 0x9d, 0xd9, 0xa7, 0x77, 0x63, 0x6f, 0x62, 0x37, 0xa7,
-	0xc4, 0x3b, 0xff, 0xf7, 0xff, 0x37, 0xbf, 0xff, 0x7f, 0x17, 0xde, 0x20, 0xd4, 0x22, 0xd4, 0xa4,
-	0x6a, 0x4b, 0xb7, 0x2c, 0xf5, 0x68, 0xbb, 0x61, 0x30, 0x7d, 0x5b, 0x65, 0x5d, 0xa5, 0xdd, 0x21,
-	0x8c, 0x48, 0xab, 0xe2, 0x58, 0x71, 0x8e, 0x15, 0x71, 0x2c, 0xaf, 0xb6, 0x48, 0x8b, 0xb8, 0x02,
-	0xaa, 0xf3, 0x1f, 0x97, 0x95, 0x4b, 0x07, 0xae, 0xb0, 0xda, 0xd0, 0xa9, 0xe1, 0x5b, 0x3a, 0x20,
-	0x26, 0xe6, 0xe7, 0xe8, 0x8f, 0x1c, 0x9c, 0xaf, 0xd1, 0xd6, 0x3d, 0x62, 0xe2, 0xfb, 0x84, 0x1c,
-	0x4a, 0xb7, 0xe0, 0x34, 0x35, 0x70, 0xd3, 0xe8, 0x14, 0xc1, 0x26, 0xd8, 0x9a, 0xab, 0xac, 0xf4,
-	0x7b, 0xe5, 0x85, 0x27, 0xba, 0x75, 0xf8, 0x0e, 0xe2, 0xcf, 0x91, 0x26, 0x04, 0xa4, 0xdb, 0x70,
-	0xa6, 0x4d, 0xc8, 0x61, 0xdd, 0x6c, 0x16, 0x73, 0x9b, 0x60, 0xab, 0x50, 0x91, 0xfa, 0xbd, 0xf2,
-	0x22, 0x97, 0x15, 0x07, 0x48, 0x9b, 0x76, 0xfe, 0xab, 0x36, 0xa5, 0x0e, 0x5c, 0xa6, 0x8f, 0xf4,
-	0x8e, 0x51, 0x27, 0x36, 0xab, 0xeb, 0x16, 0xb1, 0x31, 0x2b, 0xe6, 0x5d, 0x0f, 0x1f, 0x3f, 0xed,
-	0x95, 0xa7, 0xfe, 0xeb, 0x95, 0x5f, 0x6b, 0x99, 0xec, 0x91, 0xdd, 0x50, 0x0e, 0x88, 0xa5, 0x8a,
-	0xa0, 0xf9, 0x9f, 0x3b, 0xb4, 0xf9, 0x58, 0x65, 0x4f, 0xda, 0x06, 0x55, 0xaa, 0x98, 0xf5, 0x7b,
-	0xe5, 0xab, 0x21, 0x1f, 0xdc, 0x94, 0x63, 0x15, 0x69, 0x8b, 0xae, 0x87, 0x7d, 0x9b, 0xbd, 0xef,
-	0x3e, 0x94, 0x1a, 0x70, 0x81, 0x91, 0xc7, 0x06, 0xae, 0x9b, 0xb8, 0x6e, 0xe9, 0x5d, 0x5a, 0x2c,
-	0x6c, 0xe6, 0xb7, 0xe6, 0x77, 0x36, 0x14, 0x6e, 0x57, 0x71, 0x6a, 0xe2, 0x95, 0x4f, 0xf9, 0x80,
-	0x98, 0xb8, 0xf2, 0x8a, 0x13, 0x4b, 0xbf, 0x57, 0xbe, 0xc6, 0x3d, 0x84, 0xb5, 0x85, 0x27, 0x8a,
-	0xb4, 0x79, 0xf7, 0x71, 0x15, 0xd7, 0xf4, 0x2e, 0x45, 0xcf, 0x01, 0xbc, 0x12, 0xaa, 0x9f, 0x66,
-	0xd0, 0x36, 0xc1, 0xd4, 0x90, 0x68, 0x42, 0xbe, 0xbc, 0xa2, 0xd5, 0xcc, 0xf9, 0xae, 0x8b, 0xfa,
-	0xc7, 0xec, 0x0d, 0x26, 0x5c, 0x83, 0xb3, 0x5e, 0xc8, 0xc5, 0xdc, 0xb0, 0x5c, 0xd7, 0x45, 0xae,
-	0x4b, 0xd1, 0x5c, 0x91, 0x36, 0x23, 0xf2, 0x43, 0x7f, 0x72, 0x6c, 0xec, 0x75, 0x4d, 0x36, 0x51,
-	0x6c, 0xb4, 0xe1, 0x12, 0xcf, 0xcd, 0xc4, 0x63, 0x82, 0x46, 0xcc, 0x1c, 0xd2, 0x16, 0xdc, 0x27,
-	0x55, 0x2c, 0x0a, 0x65, 0xc0, 0x45, 0x9e, 0xaf, 0x53, 0x4d, 0xcb, 0xc4, 0x23, 0x40, 0xe3, 0x55,
-	0x51, 0xae, 0xeb, 0xe1, 0x72, 0x09, 0xf5, 0x00, 0x1b, 0x97, 0xdd, 0xe7, 0xfb, 0x36, 0xab, 0x99,
-	0x98, 0xa2, 0x96, 0x8b, 0x0d, 0xaf, 0x7e, 0x3e, 0x36, 0xee, 0xc3, 0x39, 0x5f, 0xbd, 0x08, 0x86,
-	0x39, 0x2e, 0x0a, 0xc7, 0xcb, 0x31, 0xc7, 0x48, 0x9b, 0xf5, 0x9c, 0xa1, 0x6f, 0x00, 0x5c, 0x79,
-	0xf0, 0x99, 0xde, 0xe6, 0xe9, 0x55, 0xb1, 0x46, 0x6c, 0x66, 0x84, 0x9b, 0x00, 0x86, 0x36, 0xa1,
-	0x02, 0x97, 0x82, 0x9c, 0x9a, 0x06, 0x26, 0x96, 0xdb, 0xb9, 0xb9, 0x8a, 0x1c, 0x94, 0x35, 0x26,
-	0x80, 0xb4, 0x05, 0x2f, 0x82, 0x0f, 0xdd, 0xdf, 0xff, 0xe4, 0xe0, 0x6a, 0x8d, 0xb6, 0x9c, 0x48,
-	0xf6, 0xba, 0xfa, 0x01, 0xf3, 0xc2, 0xc9, 0x82, 0x9c, 0x3d, 0x38, 0xdd, 0x71, 0xa2, 0xa7, 0x02,
-	0xc1, 0xaf, 0x2b, 0x49, 0x6c, 0xa7, 0x0c, 0x64, 0x5b, 0x29, 0x38, 0x75, 0xd2, 0x84, 0x72, 0xe4,
-	0x2a, 0x38, 0x60, 0x3a, 0xdf, 0x55, 0x90, 0xbe, 0x80, 0xab, 0x49, 0x1d, 0x2f, 0x16, 0xdc, 0x74,
-	0x6a, 0x99, 0x71, 0x7a, 0x2d, 0x1d, 0x45, 0x48, 0x5b, 0x09, 0x81, 0x88, 0xe7, 0x88, 0x7e, 0x00,
-	0xf0, 0x7a, 0x52, 0x65, 0xc3, 0x7c, 0x13, 0x18, 0x1b, 0x0f, 0xdf, 0xc4, 0xed, 0x21, 0x6d, 0xd1,
-	0x0b, 0x4c, 0x44, 0xf5, 0x35, 0x80, 0x52, 0xd0, 0x88, 0x7d, 0x9b, 0x9d, 0x01, 0x77, 0xef, 0x79,
-	0x57, 0xd1, 0xc4, 0x23, 0xc3, 0xee, 0xb2, 0x68, 0x0b, 0x47, 0xdd, 0xf3, 0x1c, 0x5c, 0x1b, 0xac,
-	0xcd, 0xbe, 0xcd, 0xb2, 0xc0, 0xee, 0xa3, 0x18, 0xec, 0xb6, 0x86, 0xc1, 0xce, 0xcb, 0x36, 0x86,
-	0xbb, 0xcf, 0xe1, 0x95, 0x84, 0xa9, 0x21, 0xf8, 0xec, 0x93, 0xcc, 0xad, 0x90, 0x53, 0x07, 0x11,
-	0xd2, 0x96, 0x83, 0x39, 0x24, 0x68, 0x2d, 0x42, 0x2c, 0x85, 0x61, 0xa8, 0x1f, 0x85, 0x58, 0xbe,
-	0x07, 0xf0, 0x46, 0x62, 0x6d, 0x7d, 0xe0, 0xb5, 0x3d, 0xde, 0x08, 0x2e, 0x05, 0x38, 0x1f, 0x79,
-	0xc7, 0xcc, 0x79, 0x2c, 0xe3, 0x91, 0x37, 0xfa, 0x2b, 0x07, 0x37, 0xc4, 0xc8, 0xe5, 0x71, 0x31,
-	0xa3, 0x83, 0xcf, 0x42, 0x35, 0x99, 0x86, 0xd4, 0xf8, 0x09, 0x25, 0x98, 0xe7, 0xe3, 0x23, 0x94,
-	0x24, 0x9b, 0x48, 0x5b, 0xf1, 0xf6, 0x84, 0x80, 0x50, 0x7e, 0x01, 0xf0, 0x66, 0x6a, 0x11, 0x2f,
-	0x74, 0x8b, 0x41, 0xbf, 0xe6, 0x23, 0xfd, 0x7d, 0xe0, 0x9c, 0x9e, 0xe9, 0x4e, 0x67, 0xea, 0xef,
-	0xbb, 0x03, 0x3c, 0xc4, 0xef, 0xec, 0x46, 0xbf, 0x57, 0x5e, 0x8b, 0x01, 0x33, 0x89, 0x86, 0x12,
-	0x6b, 0x55, 0x98, 0xf4, 0xc6, 0x97, 0x42, 0x37, 0x97, 0x5e, 0x06, 0xdd, 0xa0, 0x1f, 0xa3, 0x18,
-	0x8a, 0x36, 0xea, 0x02, 0x09, 0xe2, 0xb7, 0x3c, 0x2c, 0x8a, 0xbd, 0x2b, 0x16, 0xd7, 0x04, 0xf9,
-	0x21, 0x61, 0x7f, 0xca, 0x67, 0xdc, 0x9f, 0x92, 0x16, 0xe1, 0xc2, 0x64, 0x17, 0xe1, 0xb4, 0xbd,
-	0xe6, 0xd2, 0x4b, 0xda, 0x6b, 0x7e, 0x06, 0x70, 0x33, 0xad, 0x55, 0x17, 0xbb, 0xdb, 0xfc, 0x9d,
-	0x83, 0x72, 0x28, 0xb2, 0x30, 0x41, 0x4e, 0x92, 0x86, 0x22, 0x23, 0x3c, 0x3f, 0x86, 0x11, 0xee,
-	0x50, 0x84, 0x8f, 0x82, 0x10, 0x45, 0x14, 0xce, 0x47, 0x11, 0x09, 0x26, 0x91, 0xb6, 0x2c, 0xc0,
-	0x15, 0x50, 0xc4, 0x4f, 0x00, 0xa2, 0xf4, 0x2a, 0x86, 0x39, 0x22, 0x0e, 0x7c, 0x30, 0x51, 0xe0,
-	0xef, 0xfc, 0x3e, 0x03, 0xf3, 0x35, 0xda, 0x92, 0x1e, 0xc2, 0x59, 0xff, 0xdb, 0xc7, 0xcd, 0xe4,
-	0x9d, 0x2f, 0xf4, 0x7a, 0x2f, 0xdf, 0x1a, 0x2a, 0xe2, 0xe7, 0xf4, 0x10, 0xce, 0xfa, 0x6f, 0xce,
-	0xe9, 0x96, 0x3d, 0x91, 0x53, 0x2c, 0x0f, 0xbc, 0x3f, 0x52, 0xfe, 0xb2, 0x17, 0x7d, 0xc5, 0x7a,
-	0x23, 0x55, 0x7f, 0x40, 0x56, 0xde, 0x19, 0x5d, 0xd6, 0x77, 0x7a, 0xc4, 0x57, 0xfd, 0xd8, 0x86,
-	0x7d, 0x7b, 0x54, 0x4b, 0xfb, 0x36, 0x93, 0x77, 0x33, 0x08, 0xfb, 0x7e, 0xbf, 0x02, 0xf0, 0x6a,
-	0xca, 0xaa, 0xa7, 0x9e, 0xda, 0x8c, 0x41, 0x05, 0xf9, 0xad, 0x8c, 0x0a, 0x89, 0x41, 0xc4, 0xf6,
-	0x91, 0xe1, 0x41, 0x44, 0x15, 0x46, 0x08, 0x22, 0x65, 0x90, 0x7e, 0x0b, 0xe0, 0x7a, 0x1a, 0x1d,
-	0xdd, 0x3d, 0x15, 0x3d, 0x09, 0x1a, 0xf2, 0xdb, 0x59, 0x35, 0xfc, 0x38, 0xbe, 0x84, 0x6b, 0xc9,
-	0xa3, 0x55, 0x19, 0x6a, 0x32, 0x22, 0x2f, 0xbf, 0x99, 0x4d, 0xde, 0x0b, 0xa0, 0x72, 0xef, 0xe9,
-	0x71, 0x09, 0x3c, 0x3b, 0x2e, 0x81, 0xff, 0x8f, 0x4b, 0xe0, 0xbb, 0x93, 0xd2, 0xd4, 0xb3, 0x93,
-	0xd2, 0xd4, 0xbf, 0x27, 0xa5, 0xa9, 0x4f, 0xef, 0x86, 0x68, 0x42, 0xd8, 0xbe, 0x73, 0xa8, 0x37,
-	0xa8, 0xf7, 0x43, 0x3d, 0xda, 0xde, 0x55, 0xbb, 0xfc, 0xb3, 0xaa, 0x4b, 0x1a, 0x8d, 0x69, 0xf7,
-	0x33, 0xe8, 0xee, 0x8b, 0x00, 0x00, 0x00, 0xff, 0xff, 0x66, 0x91, 0x23, 0xe6, 0x73, 0x15, 0x00,
+	// 1281 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x59, 0x4d, 0x4f, 0x1b, 0xc7,
+	0x1b, 0x67, 0x6c, 0x63, 0x60, 0x08, 0x60, 0x16, 0x08, 0x66, 0x49, 0x6c, 0x32, 0xff, 0xbf, 0x5a,
+	0x68, 0x94, 0x75, 0x00, 0xa9, 0xa9, 0x7a, 0x69, 0xeb, 0x96, 0xaa, 0x46, 0x58, 0x44, 0x9b, 0x1e,
+	0xa2, 0x5e, 0xac, 0x35, 0x5e, 0x39, 0x2b, 0xec, 0x19, 0xd7, 0x33, 0x4b, 0x8d, 0x2a, 0xb5, 0x52,
+	0x5f, 0xee, 0x7d, 0x51, 0x5f, 0x3e, 0x41, 0x55, 0xa9, 0x9f, 0xa1, 0x3d, 0xb4, 0x97, 0x1c, 0x73,
+	0x4b, 0xd3, 0x83, 0x55, 0xc1, 0x37, 0xe0, 0x13, 0x54, 0xbb, 0x33, 0xfb, 0xe2, 0xf5, 0x6e, 0xcc,
+	0x82, 0x0d, 0x27, 0xbc, 0x33, 0xcf, 0x3c, 0xaf, 0xbf, 0xf9, 0x3d, 0xcf, 0x2e, 0xf0, 0x36, 0xa1,
+	0x4d, 0x42, 0x0d, 0x5a, 0xa8, 0x6b, 0xcd, 0x66, 0xe1, 0x68, 0xb3, 0xaa, 0x33, 0x6d, 0xb3, 0xc0,
+	0x3a, 0x4a, 0xab, 0x4d, 0x18, 0x91, 0x16, 0xc5, 0xb6, 0x62, 0x6d, 0x2b, 0x62, 0x5b, 0x5e, 0xac,
+	0x93, 0x3a, 0xb1, 0x05, 0x0a, 0xd6, 0x2f, 0x2e, 0x2b, 0xe7, 0x0e, 0x6c, 0xe1, 0x42, 0x55, 0xa3,
+	0xba, 0xab, 0xe9, 0x80, 0x18, 0x98, 0xef, 0xa3, 0xdf, 0x13, 0x70, 0xba, 0x4c, 0xeb, 0xbb, 0xc4,
+	0xc0, 0x0f, 0x09, 0x69, 0x48, 0x1b, 0x30, 0x4d, 0x75, 0x5c, 0xd3, 0xdb, 0x59, 0xb0, 0x06, 0xd6,
+	0xa7, 0x8a, 0xf3, 0x67, 0xdd, 0xfc, 0xcc, 0xb1, 0xd6, 0x6c, 0xbc, 0x89, 0xf8, 0x3a, 0x52, 0x85,
+	0x80, 0x74, 0x17, 0x4e, 0xb4, 0x08, 0x69, 0x54, 0x8c, 0x5a, 0x36, 0xb1, 0x06, 0xd6, 0x53, 0x45,
+	0xe9, 0xac, 0x9b, 0x9f, 0xe5, 0xb2, 0x62, 0x03, 0xa9, 0x69, 0xeb, 0x57, 0xa9, 0x26, 0xb5, 0x61,
+	0x86, 0x3e, 0xd1, 0xda, 0x7a, 0x85, 0x98, 0xac, 0xa2, 0x35, 0x89, 0x89, 0x59, 0x36, 0x69, 0x5b,
+	0xf8, 0xe0, 0x69, 0x37, 0x3f, 0xf6, 0x4f, 0x37, 0xff, 0x4a, 0xdd, 0x60, 0x4f, 0xcc, 0xaa, 0x72,
+	0x40, 0x9a, 0x05, 0xe1, 0x34, 0xff, 0x73, 0x8f, 0xd6, 0x0e, 0x0b, 0xec, 0xb8, 0xa5, 0x53, 0xa5,
+	0x84, 0xd9, 0x59, 0x37, 0x7f, 0xd3, 0x67, 0x83, 0xab, 0xb2, 0xb4, 0x22, 0x75, 0xd6, 0xb6, 0xb0,
+	0x6f, 0xb2, 0x77, 0xec, 0x45, 0xa9, 0x0a, 0x67, 0x18, 0x39, 0xd4, 0x71, 0xc5, 0xc0, 0x95, 0xa6,
+	0xd6, 0xa1, 0xd9, 0xd4, 0x5a, 0x72, 0x7d, 0x7a, 0x6b, 0x45, 0xe1, 0x7a, 0x15, 0x2b, 0x27, 0x4e,
+	0xfa, 0x94, 0x77, 0x89, 0x81, 0x8b, 0xff, 0xb3, 0x7c, 0x39, 0xeb, 0xe6, 0x57, 0xb9, 0x05, 0xff,
+	0x69, 0x61, 0x89, 0x22, 0x75, 0xda, 0x5e, 0x2e, 0xe1, 0xb2, 0xd6, 0xa1, 0xe8, 0x05, 0x80, 0x0b,
+	0xbe, 0xfc, 0xa9, 0x3a, 0x6d, 0x11, 0x4c, 0x75, 0x89, 0x86, 0xc4, 0xcb, 0x33, 0x5a, 0x8a, 0x1d,
+	0xef, 0xb2, 0xc8, 0x7f, 0x40, 0x5f, 0x7f, 0xc0, 0x65, 0x38, 0xe9, 0xb8, 0x9c, 0x4d, 0x0c, 0x8a,
+	0x75, 0x59, 0xc4, 0x3a, 0xd7, 0x1b, 0x2b, 0x52, 0x27, 0x44, 0x7c, 0xe8, 0x0f, 0x8e, 0x8d, 0x9d,
+	0x8e, 0xc1, 0x46, 0x8a, 0x8d, 0x16, 0x9c, 0xe3, 0xb1, 0x19, 0x78, 0x48, 0xd0, 0x08, 0xa8, 0x43,
+	0xea, 0x8c, 0xbd, 0x52, 0xc2, 0x22, 0x51, 0x3a, 0x9c, 0xe5, 0xf1, 0x5a, 0xd9, 0x6c, 0x1a, 0xf8,
+	0x1c, 0xd0, 0xf8, 0xbf, 0x48, 0xd7, 0x2d, 0x7f, 0xba, 0xc4, 0x71, 0x0f, 0x1b, 0x37, 0xec, 0xf5,
+	0x7d, 0x93, 0x95, 0x0d, 0x4c, 0x51, 0x1d, 0x2e, 0xf8, 0xf2, 0xe7, 0x62, 0xe3, 0x21, 0x9c, 0x72,
+	0x8f, 0x67, 0xc1, 0x20, 0xc3, 0x59, 0x61, 0x38, 0x13, 0x30, 0x8c, 0xd4, 0x49, 0xc7, 0x18, 0xfa,
+	0x0a, 0xc0, 0xf9, 0x47, 0x9f, 0x68, 0x2d, 0x1e, 0x5e, 0x09, 0xab, 0xc4, 0x64, 0xba, 0xbf, 0x08,
+	0x60, 0x60, 0x11, 0x8a, 0x70, 0xce, 0x8b, 0xa9, 0xa6, 0x63, 0xd2, 0xb4, 0x2b, 0x37, 0x55, 0x94,
+	0xbd, 0xb4, 0x06, 0x04, 0x90, 0x3a, 0xe3, 0x78, 0xf0, 0x9e, 0xfd, 0xfc, 0x3c, 0x01, 0x17, 0xcb,
+	0xb4, 0x6e, 0x79, 0xb2, 0xd3, 0xd1, 0x0e, 0x98, 0xe3, 0x4e, 0x1c, 0xe4, 0xec, 0xc0, 0x74, 0xdb,
+	0xf2, 0x9e, 0x0a, 0x04, 0xbf, 0xaa, 0x84, 0xb1, 0x9d, 0xd2, 0x17, 0x6d, 0x31, 0x65, 0xe5, 0x49,
+	0x15, 0x87, 0x7b, 0xae, 0x82, 0x05, 0xa6, 0xcb, 0x5d, 0x05, 0xe9, 0x33, 0xb8, 0x18, 0x56, 0xf1,
+	0x6c, 0xca, 0x0e, 0xa7, 0x1c, 0x1b, 0xa7, 0xab, 0xd1, 0x28, 0x42, 0xea, 0xbc, 0x0f, 0x44, 0x3c,
+	0x46, 0xf4, 0x3d, 0x80, 0xb7, 0xc2, 0x32, 0xeb, 0xe7, 0x1b, 0x4f, 0xd9, 0x70, 0xf8, 0x26, 0xa8,
+	0x0f, 0xa9, 0xb3, 0x8e, 0x63, 0xc2, 0xab, 0x2f, 0x01, 0x94, 0xbc, 0x42, 0xec, 0x9b, 0xec, 0x02,
+	0xb8, 0x7b, 0xdb, 0xb9, 0x8a, 0x06, 0x3e, 0x37, 0xec, 0x6e, 0x88, 0xb2, 0x70, 0xd4, 0xbd, 0x48,
+	0xc0, 0xa5, 0xfe, 0xdc, 0xec, 0x9b, 0x2c, 0x0e, 0xec, 0xde, 0x0f, 0xc0, 0x6e, 0x7d, 0x10, 0xec,
+	0x9c, 0x68, 0x03, 0xb8, 0xfb, 0x14, 0x2e, 0x84, 0x74, 0x0d, 0xc1, 0x67, 0x7b, 0xb1, 0x4b, 0x21,
+	0x47, 0x36, 0x22, 0xa4, 0x66, 0xbc, 0x3e, 0x24, 0x68, 0xad, 0x87, 0x58, 0x52, 0x6b, 0xe0, 0xf2,
+	0xc4, 0xf2, 0x2d, 0x80, 0xb7, 0x43, 0x73, 0xeb, 0x02, 0xaf, 0xe5, 0xf0, 0x86, 0x77, 0x29, 0xc0,
+	0xe5, 0xc8, 0x3b, 0xa0, 0xce, 0x61, 0x19, 0x87, 0xbc, 0xd1, 0x9f, 0x09, 0xb8, 0x22, 0x5a, 0x2e,
+	0xf7, 0x8b, 0xe9, 0x6d, 0x7c, 0x11, 0xaa, 0x89, 0xd5, 0xa4, 0x86, 0x4f, 0x28, 0x5e, 0x3f, 0x1f,
+	0x1e, 0xa1, 0x84, 0xe9, 0x44, 0xea, 0xbc, 0x33, 0x27, 0x78, 0x84, 0xf2, 0x33, 0x80, 0x77, 0x22,
+	0x93, 0x78, 0xad, 0x53, 0x0c, 0xfa, 0x25, 0xd9, 0x53, 0xdf, 0x47, 0xd6, 0xee, 0x85, 0xee, 0x74,
+	0xac, 0xfa, 0xbe, 0xd5, 0xc7, 0x43, 0xfc, 0xce, 0xae, 0x9c, 0x75, 0xf3, 0x4b, 0x01, 0x60, 0x86,
+	0xd1, 0x50, 0x68, 0xae, 0x52, 0xa3, 0x9e, 0xf8, 0x22, 0xe8, 0x66, 0xfc, 0x2a, 0xe8, 0x06, 0xfd,
+	0xd0, 0x8b, 0xa1, 0xde, 0x42, 0x5d, 0x23, 0x41, 0xfc, 0x9a, 0x84, 0x59, 0x31, 0x77, 0x05, 0xfc,
+	0x1a, 0x21, 0x3f, 0x84, 0xcc, 0x4f, 0xc9, 0x98, 0xf3, 0x53, 0xd8, 0x20, 0x9c, 0x1a, 0xed, 0x20,
+	0x1c, 0x35, 0xd7, 0x8c, 0x5f, 0xd1, 0x5c, 0xf3, 0x13, 0x80, 0x6b, 0x51, 0xa5, 0xba, 0xde, 0xd9,
+	0xe6, 0xaf, 0x04, 0x94, 0x7d, 0x9e, 0xf9, 0x09, 0x72, 0x94, 0x34, 0xd4, 0xd3, 0xc2, 0x93, 0x43,
+	0x68, 0xe1, 0x16, 0x45, 0xb8, 0x28, 0xf0, 0x51, 0x44, 0xea, 0x72, 0x14, 0x11, 0xa2, 0x12, 0xa9,
+	0x19, 0x01, 0x2e, 0x8f, 0x22, 0x7e, 0x04, 0x10, 0x45, 0x67, 0xd1, 0xcf, 0x11, 0x41, 0xe0, 0x83,
+	0x91, 0x02, 0x1f, 0x3d, 0x4f, 0xd9, 0xef, 0x66, 0x65, 0xa3, 0xde, 0xd6, 0x98, 0xbe, 0x67, 0x7c,
+	0x6c, 0x1a, 0x35, 0x83, 0x1d, 0xc7, 0xa9, 0x6b, 0x11, 0xce, 0x89, 0xf2, 0x55, 0x1a, 0xba, 0x76,
+	0x64, 0xe0, 0xba, 0xa8, 0xaf, 0x1c, 0xf8, 0x46, 0xe1, 0x09, 0x20, 0x75, 0x86, 0xd7, 0x79, 0x8f,
+	0x3f, 0x4b, 0x3b, 0x30, 0xe3, 0x88, 0xe8, 0x98, 0xe9, 0x6d, 0x4b, 0x49, 0xd2, 0x56, 0xb2, 0xea,
+	0x81, 0x35, 0x28, 0x81, 0xd4, 0x59, 0xae, 0x65, 0x47, 0x2c, 0x48, 0x47, 0x90, 0xb7, 0x78, 0x5a,
+	0x61, 0xa4, 0xd2, 0xe4, 0x31, 0x89, 0x0a, 0xef, 0xc6, 0xce, 0x60, 0xd6, 0x97, 0x41, 0xbf, 0x42,
+	0xa4, 0xf2, 0x22, 0xd1, 0x0f, 0x89, 0x48, 0x5b, 0xc8, 0x7b, 0xf4, 0xf8, 0x08, 0xde, 0xa3, 0x23,
+	0x87, 0xa5, 0xf4, 0xd5, 0x0c, 0x4b, 0xd6, 0x0d, 0x6e, 0x90, 0x83, 0x43, 0xeb, 0x06, 0x4f, 0x04,
+	0x6f, 0xb0, 0xd8, 0x40, 0x6a, 0xda, 0xfa, 0x55, 0xaa, 0xa1, 0xef, 0x00, 0x5c, 0x0d, 0x41, 0xd6,
+	0xb5, 0xce, 0x54, 0x5b, 0xbf, 0x4d, 0xc2, 0x64, 0x99, 0xd6, 0xa5, 0xc7, 0x70, 0xd2, 0xfd, 0xd4,
+	0x77, 0x27, 0xfc, 0x15, 0xc7, 0xf7, 0x35, 0x4b, 0xde, 0x18, 0x28, 0xe2, 0x86, 0xf5, 0x18, 0x4e,
+	0xba, 0x1f, 0x8a, 0xa2, 0x35, 0x3b, 0x22, 0xf2, 0xc6, 0x40, 0x11, 0x5f, 0xc2, 0xe6, 0xfb, 0xbf,
+	0x28, 0xbc, 0x16, 0x79, 0xbe, 0x4f, 0x56, 0xde, 0x3a, 0xbf, 0xac, 0x6b, 0xf4, 0x08, 0x4a, 0x81,
+	0x4d, 0x8b, 0x4b, 0xef, 0x9e, 0x57, 0xd3, 0xbe, 0xc9, 0xe4, 0xed, 0x18, 0xc2, 0xae, 0xdd, 0x2f,
+	0x00, 0xbc, 0x19, 0xf1, 0x66, 0x53, 0x78, 0x69, 0x31, 0xfa, 0x0f, 0xc8, 0x0f, 0x62, 0x1e, 0x08,
+	0x75, 0x22, 0x30, 0x7e, 0x0f, 0x76, 0xa2, 0xf7, 0x80, 0xfc, 0x20, 0xe6, 0x01, 0xd7, 0x89, 0xaf,
+	0x01, 0x5c, 0x8e, 0xea, 0xbe, 0xf7, 0x5f, 0x8a, 0x9e, 0x90, 0x13, 0xf2, 0x1b, 0x71, 0x4f, 0xb8,
+	0x7e, 0x7c, 0x0e, 0x97, 0xc2, 0x27, 0x49, 0x65, 0xa0, 0xca, 0x1e, 0x79, 0xf9, 0xf5, 0x78, 0xf2,
+	0xbe, 0xe6, 0x98, 0xe9, 0x6f, 0x53, 0x91, 0xba, 0x82, 0xa2, 0xf2, 0xe6, 0xb9, 0x45, 0x1d, 0x8b,
+	0xc5, 0xdd, 0xa7, 0x27, 0x39, 0xf0, 0xec, 0x24, 0x07, 0xfe, 0x3d, 0xc9, 0x81, 0x6f, 0x4e, 0x73,
+	0x63, 0xcf, 0x4e, 0x73, 0x63, 0x7f, 0x9f, 0xe6, 0xc6, 0x3e, 0xba, 0xef, 0xa3, 0x26, 0xa1, 0xf6,
+	0x5e, 0x43, 0xab, 0x52, 0xe7, 0xa1, 0x70, 0xb4, 0xb9, 0x5d, 0xe8, 0xf0, 0xff, 0x5b, 0xd8, 0x44,
+	0x55, 0x4d, 0xdb, 0xff, 0x67, 0xd8, 0xfe, 0x6f, 0x00, 0xe9, 0xce, 0x03, 0x2f, 0xd4, 0x18, 0x00,
 	0x00,
 }
 
@@ -1063,6 +1195,7 @@ type MsgClient interface {
 	JoinSwapShareAmountOut(ctx context.Context, in *MsgJoinSwapShareAmountOut, opts ...grpc.CallOption) (*MsgJoinSwapShareAmountOutResponse, error)
 	ExitSwapExternAmountOut(ctx context.Context, in *MsgExitSwapExternAmountOut, opts ...grpc.CallOption) (*MsgExitSwapExternAmountOutResponse, error)
 	ExitSwapShareAmountIn(ctx context.Context, in *MsgExitSwapShareAmountIn, opts ...grpc.CallOption) (*MsgExitSwapShareAmountInResponse, error)
+	MigrateLiquidity(ctx context.Context, in *MsgMigrateLiquidity, opts ...grpc.CallOption) (*MsgMigrateLiquidityResponse, error)
 }
 
 type msgClient struct {
@@ -1145,6 +1278,15 @@ func (c *msgClient) ExitSwapShareAmountIn(ctx context.Context, in *MsgExitSwapSh
 	return out, nil
 }
 
+func (c *msgClient) MigrateLiquidity(ctx context.Context, in *MsgMigrateLiquidity, opts ...grpc.CallOption) (*MsgMigrateLiquidityResponse, error) {
+	out := new(MsgMigrateLiquidityResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.gamm.v1beta1.Msg/MigrateLiquidity", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	JoinPool(context.Context, *MsgJoinPool) (*MsgJoinPoolResponse, error)
@@ -1155,6 +1297,7 @@ type MsgServer interface {
 	JoinSwapShareAmountOut(context.Context, *MsgJoinSwapShareAmountOut) (*MsgJoinSwapShareAmountOutResponse, error)
 	ExitSwapExternAmountOut(context.Context, *MsgExitSwapExternAmountOut) (*MsgExitSwapExternAmountOutResponse, error)
 	ExitSwapShareAmountIn(context.Context, *MsgExitSwapShareAmountIn) (*MsgExitSwapShareAmountInResponse, error)
+	MigrateLiquidity(context.Context, *MsgMigrateLiquidity) (*MsgMigrateLiquidityResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -1185,6 +1328,9 @@ func (*UnimplementedMsgServer) ExitSwapExternAmountOut(ctx context.Context, req
 func (*UnimplementedMsgServer) ExitSwapShareAmountIn(ctx context.Context, req *MsgExitSwapShareAmountIn) (*MsgExitSwapShareAmountInResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ExitSwapShareAmountIn not implemented")
 }
+func (*UnimplementedMsgServer) MigrateLiquidity(ctx context.Context, req *MsgMigrateLiquidity) (*MsgMigrateLiquidityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MigrateLiquidity not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -1334,6 +1480,24 @@ func _Msg_ExitSwapShareAmountIn_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_MigrateLiquidity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgMigrateLiquidity)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).MigrateLiquidity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.gamm.v1beta1.Msg/MigrateLiquidity",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).MigrateLiquidity(ctx, req.(*MsgMigrateLiquidity))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "osmosis.gamm.v1beta1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -1370,6 +1534,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ExitSwapShareAmountIn",
 			Handler:    _Msg_ExitSwapShareAmountIn_Handler,
 		},
+		{
+			MethodName: "MigrateLiquidity",
+			Handler:    _Msg_MigrateLiquidity_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "osmosis/gamm/v1beta1/tx.proto",
@@ -2207,6 +2375,118 @@ func (m *MsgExitSwapExternAmountOutResponse) MarshalToSizedBuffer(dAtA []byte) (
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgMigrateLiquidity) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgMigrateLiquidity) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMigrateLiquidity) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LockId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.LockId))
+		i--
+		dAtA[i] = 0x38
+	}
+	{
+		size := m.ShareOutMinAmount.Size()
+		i -= size
+		if _, err := m.ShareOutMinAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if len(m.TokenOutMins) > 0 {
+		for iNdEx := len(m.TokenOutMins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TokenOutMins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	{
+		size := m.SharesToMigrate.Size()
+		i -= size
+		if _, err := m.SharesToMigrate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.PoolIdEntering != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PoolIdEntering))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.PoolIdLeaving != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PoolIdLeaving))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgMigrateLiquidityResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgMigrateLiquidityResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMigrateLiquidityResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.ShareOutAmount.Size()
+		i -= size
+		if _, err := m.ShareOutAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -2530,6 +2810,49 @@ func (m *MsgExitSwapExternAmountOutResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgMigrateLiquidity) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PoolIdLeaving != 0 {
+		n += 1 + sovTx(uint64(m.PoolIdLeaving))
+	}
+	if m.PoolIdEntering != 0 {
+		n += 1 + sovTx(uint64(m.PoolIdEntering))
+	}
+	l = m.SharesToMigrate.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.TokenOutMins) > 0 {
+		for _, e := range m.TokenOutMins {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = m.ShareOutMinAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.LockId != 0 {
+		n += 1 + sovTx(uint64(m.LockId))
+	}
+	return n
+}
+
+func (m *MsgMigrateLiquidityResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ShareOutAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -4886,6 +5209,331 @@ func (m *MsgExitSwapExternAmountOutResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgMigrateLiquidity) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgMigrateLiquidity: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgMigrateLiquidity: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolIdLeaving", wireType)
+			}
+			m.PoolIdLeaving = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolIdLeaving |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolIdEntering", wireType)
+			}
+			m.PoolIdEntering = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolIdEntering |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SharesToMigrate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SharesToMigrate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOutMins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenOutMins = append(m.TokenOutMins, types.Coin{})
+			if err := m.TokenOutMins[len(m.TokenOutMins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShareOutMinAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ShareOutMinAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockId", wireType)
+			}
+			m.LockId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LockId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgMigrateLiquidityResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgMigrateLiquidityResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgMigrateLiquidityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShareOutAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ShareOutAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
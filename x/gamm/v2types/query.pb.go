@@ -8,11 +8,13 @@ import (
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	_ "github.com/cosmos/cosmos-sdk/codec/types"
-	_ "github.com/cosmos/cosmos-sdk/types"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
+	balancer "github.com/osmosis-labs/osmosis/v13/x/gamm/pool-models/balancer"
 	_ "github.com/osmosis-labs/osmosis/v13/x/gamm/types"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
@@ -143,44 +145,262 @@ func (m *QuerySpotPriceResponse) GetSpotPrice() string {
 	return ""
 }
 
+// QuerySmoothWeightChangeRequest defines the gRPC request structure for a
+// SmoothWeightChange query.
+type QuerySmoothWeightChangeRequest struct {
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+}
+
+func (m *QuerySmoothWeightChangeRequest) Reset()         { *m = QuerySmoothWeightChangeRequest{} }
+func (m *QuerySmoothWeightChangeRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySmoothWeightChangeRequest) ProtoMessage()    {}
+func (*QuerySmoothWeightChangeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_49ff000e88fc374c, []int{2}
+}
+func (m *QuerySmoothWeightChangeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySmoothWeightChangeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySmoothWeightChangeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySmoothWeightChangeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySmoothWeightChangeRequest.Merge(m, src)
+}
+func (m *QuerySmoothWeightChangeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySmoothWeightChangeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySmoothWeightChangeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySmoothWeightChangeRequest proto.InternalMessageInfo
+
+func (m *QuerySmoothWeightChangeRequest) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+// QuerySmoothWeightChangeResponse defines the gRPC response structure for a
+// SmoothWeightChange query.
+type QuerySmoothWeightChangeResponse struct {
+	// smooth_weight_change_params is not set if the pool's weights are not
+	// changing.
+	SmoothWeightChangeParams *balancer.SmoothWeightChangeParams `protobuf:"bytes,1,opt,name=smooth_weight_change_params,json=smoothWeightChangeParams,proto3" json:"smooth_weight_change_params,omitempty" yaml:"smooth_weight_change_params"`
+	CurrentPoolWeights       []balancer.PoolAsset               `protobuf:"bytes,2,rep,name=current_pool_weights,json=currentPoolWeights,proto3" json:"current_pool_weights" yaml:"current_pool_weights"`
+}
+
+func (m *QuerySmoothWeightChangeResponse) Reset()         { *m = QuerySmoothWeightChangeResponse{} }
+func (m *QuerySmoothWeightChangeResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySmoothWeightChangeResponse) ProtoMessage()    {}
+func (*QuerySmoothWeightChangeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_49ff000e88fc374c, []int{3}
+}
+func (m *QuerySmoothWeightChangeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySmoothWeightChangeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySmoothWeightChangeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySmoothWeightChangeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySmoothWeightChangeResponse.Merge(m, src)
+}
+func (m *QuerySmoothWeightChangeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySmoothWeightChangeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySmoothWeightChangeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySmoothWeightChangeResponse proto.InternalMessageInfo
+
+func (m *QuerySmoothWeightChangeResponse) GetSmoothWeightChangeParams() *balancer.SmoothWeightChangeParams {
+	if m != nil {
+		return m.SmoothWeightChangeParams
+	}
+	return nil
+}
+
+func (m *QuerySmoothWeightChangeResponse) GetCurrentPoolWeights() []balancer.PoolAsset {
+	if m != nil {
+		return m.CurrentPoolWeights
+	}
+	return nil
+}
+
+// QueryProtocolFeesRequest defines the gRPC request structure for a
+// ProtocolFees query.
+type QueryProtocolFeesRequest struct {
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+}
+
+func (m *QueryProtocolFeesRequest) Reset()         { *m = QueryProtocolFeesRequest{} }
+func (m *QueryProtocolFeesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryProtocolFeesRequest) ProtoMessage()    {}
+func (*QueryProtocolFeesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_49ff000e88fc374c, []int{4}
+}
+func (m *QueryProtocolFeesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProtocolFeesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProtocolFeesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryProtocolFeesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProtocolFeesRequest.Merge(m, src)
+}
+func (m *QueryProtocolFeesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProtocolFeesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProtocolFeesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryProtocolFeesRequest proto.InternalMessageInfo
+
+func (m *QueryProtocolFeesRequest) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+// QueryProtocolFeesResponse defines the gRPC response structure for a
+// ProtocolFees query.
+type QueryProtocolFeesResponse struct {
+	ProtocolFees github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=protocol_fees,json=protocolFees,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"protocol_fees" yaml:"protocol_fees"`
+}
+
+func (m *QueryProtocolFeesResponse) Reset()         { *m = QueryProtocolFeesResponse{} }
+func (m *QueryProtocolFeesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryProtocolFeesResponse) ProtoMessage()    {}
+func (*QueryProtocolFeesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_49ff000e88fc374c, []int{5}
+}
+func (m *QueryProtocolFeesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProtocolFeesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProtocolFeesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryProtocolFeesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProtocolFeesResponse.Merge(m, src)
+}
+func (m *QueryProtocolFeesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProtocolFeesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProtocolFeesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryProtocolFeesResponse proto.InternalMessageInfo
+
+func (m *QueryProtocolFeesResponse) GetProtocolFees() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.ProtocolFees
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QuerySpotPriceRequest)(nil), "osmosis.gamm.v2.QuerySpotPriceRequest")
 	proto.RegisterType((*QuerySpotPriceResponse)(nil), "osmosis.gamm.v2.QuerySpotPriceResponse")
+	proto.RegisterType((*QuerySmoothWeightChangeRequest)(nil), "osmosis.gamm.v2.QuerySmoothWeightChangeRequest")
+	proto.RegisterType((*QuerySmoothWeightChangeResponse)(nil), "osmosis.gamm.v2.QuerySmoothWeightChangeResponse")
+	proto.RegisterType((*QueryProtocolFeesRequest)(nil), "osmosis.gamm.v2.QueryProtocolFeesRequest")
+	proto.RegisterType((*QueryProtocolFeesResponse)(nil), "osmosis.gamm.v2.QueryProtocolFeesResponse")
 }
 
 func init() { proto.RegisterFile("osmosis/gamm/v2/query.proto", fileDescriptor_49ff000e88fc374c) }
 
 var fileDescriptor_49ff000e88fc374c = []byte{
-	// 459 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x92, 0x41, 0x8b, 0x13, 0x31,
-	0x14, 0xc7, 0x9b, 0x55, 0x57, 0x9a, 0xc3, 0xae, 0x1b, 0x5c, 0xad, 0xdd, 0x75, 0xba, 0xcc, 0xc1,
-	0x5d, 0x15, 0x27, 0xb4, 0xeb, 0xc9, 0x9b, 0x45, 0x41, 0x41, 0x44, 0xc7, 0x9b, 0x97, 0x21, 0xd3,
-	0xc6, 0x71, 0xa0, 0x33, 0x2f, 0xed, 0xcb, 0x94, 0x2d, 0xe2, 0xc5, 0xbb, 0x20, 0x78, 0xf0, 0x2b,
-	0x79, 0x5c, 0x10, 0xc1, 0x53, 0x91, 0xd6, 0x4f, 0xd0, 0x4f, 0x20, 0x49, 0x66, 0xba, 0xee, 0x28,
-	0x78, 0x4b, 0xde, 0xef, 0x9f, 0x5f, 0x92, 0xc7, 0xa3, 0x7b, 0x80, 0x19, 0x60, 0x8a, 0x3c, 0x11,
-	0x59, 0xc6, 0xa7, 0x3d, 0x3e, 0x2e, 0xe4, 0x64, 0x16, 0xa8, 0x09, 0x68, 0x60, 0xdb, 0x25, 0x0c,
-	0x0c, 0x0c, 0xa6, 0xbd, 0xf6, 0xd5, 0x04, 0x12, 0xb0, 0x8c, 0x9b, 0x95, 0x8b, 0xb5, 0x6f, 0x9e,
-	0x77, 0x74, 0x63, 0xa9, 0x45, 0x97, 0xeb, 0x93, 0x12, 0x7b, 0x03, 0xcb, 0x79, 0x2c, 0x50, 0xae,
-	0xe9, 0x00, 0xd2, 0xbc, 0xe4, 0x77, 0xfe, 0xe4, 0xf6, 0xfa, 0x75, 0x4a, 0x89, 0x24, 0xcd, 0x85,
-	0x4e, 0xa1, 0xca, 0xee, 0x27, 0x00, 0xc9, 0x48, 0x72, 0xa1, 0x52, 0x2e, 0xf2, 0x1c, 0xb4, 0x85,
-	0x58, 0xd2, 0x1b, 0x25, 0xb5, 0xbb, 0xb8, 0x78, 0xc3, 0x45, 0x3e, 0xab, 0x90, 0xbb, 0x24, 0x72,
-	0x8f, 0x77, 0x1b, 0x87, 0xfc, 0xef, 0x84, 0xee, 0xbe, 0x34, 0xd7, 0xbe, 0x52, 0xa0, 0x5f, 0x4c,
-	0xd2, 0x81, 0x0c, 0xe5, 0xb8, 0x90, 0xa8, 0xd9, 0x5d, 0x7a, 0x59, 0x01, 0x8c, 0xa2, 0x74, 0xd8,
-	0x22, 0x07, 0xe4, 0xe8, 0x62, 0x9f, 0xad, 0xe6, 0x9d, 0xad, 0x99, 0xc8, 0x46, 0x0f, 0xfc, 0x12,
-	0xf8, 0xe1, 0xa6, 0x59, 0x3d, 0x1d, 0xb2, 0xc7, 0xf4, 0x8a, 0xf9, 0x41, 0x24, 0x10, 0xa5, 0x8e,
-	0x86, 0x32, 0x87, 0xac, 0xb5, 0x71, 0x40, 0x8e, 0x9a, 0xfd, 0xbd, 0xd5, 0xbc, 0x73, 0xdd, 0x9d,
-	0xaa, 0x27, 0xfc, 0x70, 0xcb, 0x94, 0x1e, 0x9a, 0xca, 0x23, 0x53, 0x60, 0x4f, 0xe8, 0xce, 0xb8,
-	0x00, 0x7d, 0xde, 0x73, 0xc1, 0x7a, 0xf6, 0x57, 0xf3, 0x4e, 0xcb, 0x79, 0xfe, 0x8a, 0xf8, 0xe1,
-	0xb6, 0xad, 0x9d, 0x99, 0xfc, 0xe7, 0xf4, 0x5a, 0xfd, 0x5b, 0xa8, 0x20, 0x47, 0xc9, 0xee, 0x53,
-	0x8a, 0x0a, 0x74, 0xa4, 0x4c, 0xd5, 0x7e, 0xad, 0xd9, 0xdf, 0x5d, 0xcd, 0x3b, 0x3b, 0x4e, 0x7e,
-	0xc6, 0xfc, 0xb0, 0x89, 0xd5, 0xe9, 0xde, 0x17, 0x42, 0x2f, 0x59, 0x21, 0xfb, 0x48, 0x68, 0x73,
-	0x6d, 0x65, 0xb7, 0x82, 0xda, 0x98, 0x04, 0xff, 0xec, 0x66, 0xfb, 0xf0, 0xbf, 0x39, 0xf7, 0x3c,
-	0x9f, 0x7f, 0xf8, 0xf6, 0xeb, 0xf3, 0xc6, 0x6d, 0x76, 0xc8, 0xeb, 0xc3, 0x69, 0x5a, 0x8d, 0xfc,
-	0x5d, 0xd9, 0xfb, 0xf7, 0xdc, 0x3e, 0x12, 0xfb, 0xcf, 0xbe, 0x2e, 0x3c, 0x72, 0xba, 0xf0, 0xc8,
-	0xcf, 0x85, 0x47, 0x3e, 0x2d, 0xbd, 0xc6, 0xe9, 0xd2, 0x6b, 0xfc, 0x58, 0x7a, 0x8d, 0xd7, 0xbd,
-	0x24, 0xd5, 0x6f, 0x8b, 0x38, 0x18, 0x40, 0x56, 0xc9, 0xee, 0x8d, 0x44, 0x8c, 0x6b, 0xf3, 0xb4,
-	0x7b, 0xcc, 0x4f, 0x2a, 0xbf, 0x9e, 0x29, 0x89, 0xf1, 0xa6, 0x1d, 0x8b, 0xe3, 0xdf, 0x01, 0x00,
-	0x00, 0xff, 0xff, 0x83, 0x84, 0x35, 0xf6, 0x1b, 0x03, 0x00, 0x00,
+	// 771 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0xcf, 0x4f, 0x1b, 0x47,
+	0x14, 0xf6, 0x9a, 0x96, 0xca, 0x03, 0x85, 0x32, 0x82, 0xd6, 0xd8, 0x74, 0x17, 0x6d, 0x25, 0x30,
+	0x54, 0xec, 0x80, 0xa1, 0xad, 0x54, 0xa9, 0x87, 0x9a, 0xfe, 0xa0, 0x52, 0x5b, 0xb9, 0xdb, 0x03,
+	0x52, 0x2f, 0xab, 0xf1, 0x7a, 0x58, 0xaf, 0xba, 0xbb, 0xb3, 0xec, 0x8c, 0x1d, 0xac, 0x28, 0x97,
+	0x9c, 0x72, 0x89, 0x14, 0x29, 0xca, 0x31, 0xd7, 0x1c, 0x72, 0xcb, 0x25, 0x7f, 0x03, 0x47, 0xa4,
+	0x28, 0x52, 0x4e, 0x4e, 0x04, 0xf9, 0x03, 0x22, 0xff, 0x05, 0xd1, 0xcc, 0x8e, 0x8d, 0x6d, 0x6c,
+	0x81, 0x38, 0x79, 0xf7, 0x7d, 0xef, 0x7d, 0xef, 0xfb, 0xde, 0xbe, 0x67, 0x50, 0xa4, 0x2c, 0xa4,
+	0xcc, 0x67, 0xc8, 0xc3, 0x61, 0x88, 0x5a, 0x65, 0x74, 0xdc, 0x24, 0x49, 0xdb, 0x8a, 0x13, 0xca,
+	0x29, 0x9c, 0x57, 0xa0, 0x25, 0x40, 0xab, 0x55, 0x2e, 0x2c, 0x7a, 0xd4, 0xa3, 0x12, 0x43, 0xe2,
+	0x29, 0x4d, 0x2b, 0x7c, 0x3d, 0xcc, 0xb1, 0x53, 0x23, 0x1c, 0xef, 0x20, 0x7e, 0xa2, 0xe0, 0xbd,
+	0x21, 0x38, 0xa6, 0x34, 0xd8, 0x0a, 0x69, 0x9d, 0x04, 0x0c, 0xd5, 0x70, 0x80, 0x23, 0x97, 0x24,
+	0xfd, 0x87, 0x2a, 0xa5, 0x81, 0xaa, 0xd2, 0x5d, 0x59, 0x86, 0x6a, 0x98, 0x91, 0x3e, 0xa7, 0x4b,
+	0xfd, 0x48, 0xe1, 0x9b, 0x83, 0xb8, 0x14, 0xdd, 0xcf, 0x8a, 0xb1, 0xe7, 0x47, 0x98, 0xfb, 0xb4,
+	0x97, 0xbb, 0xe2, 0x51, 0xea, 0x05, 0x04, 0xe1, 0xd8, 0x47, 0x38, 0x8a, 0x28, 0x97, 0x20, 0x53,
+	0xe8, 0xb2, 0x42, 0xe5, 0x5b, 0xad, 0x79, 0x84, 0x70, 0xd4, 0xee, 0x41, 0x69, 0x13, 0x27, 0xb5,
+	0x9c, 0xbe, 0xa4, 0x90, 0xf9, 0x5a, 0x03, 0x4b, 0xff, 0x88, 0xb6, 0xff, 0xc6, 0x94, 0x57, 0x13,
+	0xdf, 0x25, 0x36, 0x39, 0x6e, 0x12, 0xc6, 0xe1, 0xb7, 0xe0, 0x33, 0x61, 0xd2, 0xf1, 0xeb, 0x79,
+	0x6d, 0x55, 0x2b, 0x7d, 0x52, 0x81, 0xdd, 0x8e, 0x31, 0xd7, 0xc6, 0x61, 0xf0, 0xa3, 0xa9, 0x00,
+	0xd3, 0x9e, 0x16, 0x4f, 0x7f, 0xd4, 0xe1, 0xaf, 0xe0, 0x0b, 0xe1, 0xc0, 0xc1, 0x8c, 0x11, 0xee,
+	0xd4, 0x49, 0x44, 0xc3, 0x7c, 0x76, 0x55, 0x2b, 0xe5, 0x2a, 0xc5, 0x6e, 0xc7, 0xf8, 0x2a, 0xad,
+	0x1a, 0xcd, 0x30, 0xed, 0x39, 0x11, 0xfa, 0x59, 0x44, 0x7e, 0x11, 0x01, 0x78, 0x00, 0x16, 0x8e,
+	0x9b, 0x94, 0x0f, 0xf3, 0x4c, 0x49, 0x9e, 0x95, 0x6e, 0xc7, 0xc8, 0xa7, 0x3c, 0x57, 0x52, 0x4c,
+	0x7b, 0x5e, 0xc6, 0x2e, 0x99, 0xcc, 0xbf, 0xc1, 0x97, 0xa3, 0xb6, 0x58, 0x4c, 0x23, 0x46, 0xe0,
+	0x1e, 0x00, 0x2c, 0xa6, 0xdc, 0x89, 0x45, 0x54, 0x5a, 0xcb, 0x55, 0x96, 0xba, 0x1d, 0x63, 0x21,
+	0x25, 0xbf, 0xc4, 0x4c, 0x3b, 0xc7, 0x7a, 0xd5, 0xe6, 0x5f, 0x40, 0x4f, 0xf9, 0x42, 0x4a, 0x79,
+	0xe3, 0x90, 0xf8, 0x5e, 0x83, 0xef, 0x37, 0x70, 0xe4, 0xdd, 0x6a, 0x5e, 0xe6, 0x8b, 0x2c, 0x30,
+	0x26, 0xf2, 0x29, 0xa1, 0x4f, 0x34, 0x50, 0x64, 0x12, 0x76, 0xee, 0x48, 0xdc, 0x71, 0x65, 0x82,
+	0x13, 0xe3, 0x04, 0x87, 0x4c, 0x76, 0x99, 0x29, 0x5b, 0xd6, 0xf0, 0x76, 0xa7, 0xcb, 0x63, 0x5d,
+	0xe5, 0xad, 0xca, 0xaa, 0xca, 0x5a, 0xb7, 0x63, 0x98, 0xca, 0xea, 0x64, 0x72, 0xd3, 0xce, 0xb3,
+	0x09, 0x0c, 0xb0, 0x05, 0x16, 0xdd, 0x66, 0x92, 0x90, 0x88, 0x3b, 0xd2, 0x57, 0x5a, 0xcf, 0xf2,
+	0xd9, 0xd5, 0xa9, 0xd2, 0x4c, 0xd9, 0x18, 0xaf, 0x47, 0x9c, 0x84, 0xfc, 0x3c, 0x95, 0x6f, 0x4e,
+	0x3b, 0x46, 0xa6, 0xdb, 0x31, 0x8a, 0xa9, 0x88, 0x71, 0x54, 0xa6, 0x0d, 0x55, 0x58, 0x94, 0x1d,
+	0xaa, 0xe0, 0xef, 0x20, 0x2f, 0x47, 0x56, 0x4d, 0x28, 0xa7, 0x2e, 0x0d, 0x7e, 0x23, 0x84, 0xdd,
+	0x6a, 0xf8, 0xcf, 0x34, 0xb0, 0x3c, 0x86, 0x49, 0x8d, 0xfd, 0x81, 0x06, 0x3e, 0x8f, 0x15, 0xe0,
+	0x1c, 0x11, 0x22, 0x06, 0x2d, 0x8c, 0x2d, 0x5b, 0xea, 0x70, 0xc4, 0xce, 0xf6, 0x7d, 0xed, 0x53,
+	0x3f, 0xaa, 0x1c, 0x28, 0x4b, 0x8b, 0xaa, 0xe1, 0x60, 0xb5, 0xf9, 0xfc, 0xad, 0x51, 0xf2, 0x7c,
+	0xde, 0x68, 0xd6, 0x2c, 0x97, 0x86, 0xea, 0xfa, 0xd4, 0xcf, 0x16, 0xab, 0xff, 0x8f, 0x78, 0x3b,
+	0x26, 0x4c, 0x12, 0x31, 0x7b, 0x36, 0x1e, 0x90, 0x54, 0xfe, 0x30, 0x05, 0x3e, 0x95, 0x42, 0xe1,
+	0x43, 0x0d, 0xe4, 0xfa, 0xab, 0x0c, 0xd7, 0x46, 0x66, 0x5c, 0xb6, 0xc6, 0x9e, 0x70, 0x61, 0xfd,
+	0xda, 0xbc, 0xd4, 0xb3, 0x89, 0xee, 0xbf, 0x7a, 0xff, 0x38, 0xbb, 0x01, 0xd7, 0xd1, 0xe8, 0xff,
+	0xa8, 0x18, 0x19, 0x43, 0x77, 0xd5, 0x0c, 0xef, 0x21, 0x79, 0x19, 0x0c, 0xbe, 0xd4, 0x00, 0xbc,
+	0xba, 0x62, 0x10, 0x4d, 0x68, 0x38, 0xe9, 0x68, 0x0a, 0xdb, 0x37, 0x2f, 0x50, 0x52, 0x7f, 0x92,
+	0x52, 0x7f, 0x80, 0xdf, 0x5d, 0x2b, 0x75, 0xdc, 0x7a, 0xc3, 0xa7, 0x1a, 0x98, 0x1d, 0xfc, 0xec,
+	0x70, 0x63, 0xbc, 0x82, 0x31, 0x4b, 0x56, 0xd8, 0xbc, 0x49, 0xaa, 0x92, 0xf9, 0xbd, 0x94, 0xb9,
+	0x0d, 0xad, 0x1b, 0x4c, 0x74, 0x60, 0x5b, 0x2a, 0x7f, 0x9e, 0x9e, 0xeb, 0xda, 0xd9, 0xb9, 0xae,
+	0xbd, 0x3b, 0xd7, 0xb5, 0x47, 0x17, 0x7a, 0xe6, 0xec, 0x42, 0xcf, 0xbc, 0xb9, 0xd0, 0x33, 0xff,
+	0x95, 0x07, 0x96, 0x48, 0x71, 0x6e, 0x05, 0xb8, 0xc6, 0xfa, 0x0d, 0x5a, 0x3b, 0xbb, 0xe8, 0xa4,
+	0xd7, 0x46, 0x2e, 0x55, 0x6d, 0x5a, 0x92, 0xef, 0x7e, 0x1c, 0x00, 0xe0, 0x7c, 0xe0, 0xc4, 0x1f,
+	0x07, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -198,6 +418,12 @@ type QueryClient interface {
 	// SpotPrice defines a gRPC query handler that returns the spot price given
 	// a base denomination and a quote denomination.
 	SpotPrice(ctx context.Context, in *QuerySpotPriceRequest, opts ...grpc.CallOption) (*QuerySpotPriceResponse, error)
+	// SmoothWeightChange returns the ongoing smooth weight change of a balancer
+	// pool, if any, along with the pool's current weights.
+	SmoothWeightChange(ctx context.Context, in *QuerySmoothWeightChangeRequest, opts ...grpc.CallOption) (*QuerySmoothWeightChangeResponse, error)
+	// ProtocolFees returns the taker fees a pool has sent to its taker fee
+	// recipient in total.
+	ProtocolFees(ctx context.Context, in *QueryProtocolFeesRequest, opts ...grpc.CallOption) (*QueryProtocolFeesResponse, error)
 }
 
 type queryClient struct {
@@ -217,11 +443,35 @@ func (c *queryClient) SpotPrice(ctx context.Context, in *QuerySpotPriceRequest,
 	return out, nil
 }
 
+func (c *queryClient) SmoothWeightChange(ctx context.Context, in *QuerySmoothWeightChangeRequest, opts ...grpc.CallOption) (*QuerySmoothWeightChangeResponse, error) {
+	out := new(QuerySmoothWeightChangeResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.gamm.v2.Query/SmoothWeightChange", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ProtocolFees(ctx context.Context, in *QueryProtocolFeesRequest, opts ...grpc.CallOption) (*QueryProtocolFeesResponse, error) {
+	out := new(QueryProtocolFeesResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.gamm.v2.Query/ProtocolFees", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// SpotPrice defines a gRPC query handler that returns the spot price given
 	// a base denomination and a quote denomination.
 	SpotPrice(context.Context, *QuerySpotPriceRequest) (*QuerySpotPriceResponse, error)
+	// SmoothWeightChange returns the ongoing smooth weight change of a balancer
+	// pool, if any, along with the pool's current weights.
+	SmoothWeightChange(context.Context, *QuerySmoothWeightChangeRequest) (*QuerySmoothWeightChangeResponse, error)
+	// ProtocolFees returns the taker fees a pool has sent to its taker fee
+	// recipient in total.
+	ProtocolFees(context.Context, *QueryProtocolFeesRequest) (*QueryProtocolFeesResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -231,6 +481,12 @@ type UnimplementedQueryServer struct {
 func (*UnimplementedQueryServer) SpotPrice(ctx context.Context, req *QuerySpotPriceRequest) (*QuerySpotPriceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SpotPrice not implemented")
 }
+func (*UnimplementedQueryServer) SmoothWeightChange(ctx context.Context, req *QuerySmoothWeightChangeRequest) (*QuerySmoothWeightChangeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SmoothWeightChange not implemented")
+}
+func (*UnimplementedQueryServer) ProtocolFees(ctx context.Context, req *QueryProtocolFeesRequest) (*QueryProtocolFeesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProtocolFees not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -254,6 +510,42 @@ func _Query_SpotPrice_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_SmoothWeightChange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySmoothWeightChangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SmoothWeightChange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.gamm.v2.Query/SmoothWeightChange",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SmoothWeightChange(ctx, req.(*QuerySmoothWeightChangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ProtocolFees_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProtocolFeesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ProtocolFees(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.gamm.v2.Query/ProtocolFees",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProtocolFees(ctx, req.(*QueryProtocolFeesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "osmosis.gamm.v2.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -262,6 +554,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SpotPrice",
 			Handler:    _Query_SpotPrice_Handler,
 		},
+		{
+			MethodName: "SmoothWeightChange",
+			Handler:    _Query_SmoothWeightChange_Handler,
+		},
+		{
+			MethodName: "ProtocolFees",
+			Handler:    _Query_ProtocolFees_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "osmosis/gamm/v2/query.proto",
@@ -339,71 +639,271 @@ func (m *QuerySpotPriceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QuerySmoothWeightChangeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QuerySpotPriceRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *QuerySmoothWeightChangeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySmoothWeightChangeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if m.PoolId != 0 {
-		n += 1 + sovQuery(uint64(m.PoolId))
-	}
-	l = len(m.BaseAssetDenom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.QuoteAssetDenom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QuerySpotPriceResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QuerySmoothWeightChangeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySmoothWeightChangeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySmoothWeightChangeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.SpotPrice)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.CurrentPoolWeights) > 0 {
+		for iNdEx := len(m.CurrentPoolWeights) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CurrentPoolWeights[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
 	}
-	return n
+	if m.SmoothWeightChangeParams != nil {
+		{
+			size, err := m.SmoothWeightChangeParams.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *QueryProtocolFeesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
 }
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *QueryProtocolFeesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (m *QuerySpotPriceRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
+
+func (m *QueryProtocolFeesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProtocolFeesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProtocolFeesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProtocolFeesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ProtocolFees) > 0 {
+		for iNdEx := len(m.ProtocolFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ProtocolFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QuerySpotPriceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	l = len(m.BaseAssetDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.QuoteAssetDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySpotPriceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SpotPrice)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySmoothWeightChangeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	return n
+}
+
+func (m *QuerySmoothWeightChangeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SmoothWeightChangeParams != nil {
+		l = m.SmoothWeightChangeParams.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.CurrentPoolWeights) > 0 {
+		for _, e := range m.CurrentPoolWeights {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryProtocolFeesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	return n
+}
+
+func (m *QueryProtocolFeesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ProtocolFees) > 0 {
+		for _, e := range m.ProtocolFees {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QuerySpotPriceRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
 			wire |= uint64(b&0x7F) << shift
 			if b < 0x80 {
 				break
@@ -604,6 +1104,348 @@ func (m *QuerySpotPriceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QuerySmoothWeightChangeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySmoothWeightChangeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySmoothWeightChangeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySmoothWeightChangeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySmoothWeightChangeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySmoothWeightChangeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SmoothWeightChangeParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SmoothWeightChangeParams == nil {
+				m.SmoothWeightChangeParams = &balancer.SmoothWeightChangeParams{}
+			}
+			if err := m.SmoothWeightChangeParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentPoolWeights", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CurrentPoolWeights = append(m.CurrentPoolWeights, balancer.PoolAsset{})
+			if err := m.CurrentPoolWeights[len(m.CurrentPoolWeights)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryProtocolFeesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProtocolFeesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProtocolFeesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryProtocolFeesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProtocolFeesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProtocolFeesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProtocolFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProtocolFees = append(m.ProtocolFees, types.Coin{})
+			if err := m.ProtocolFees[len(m.ProtocolFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
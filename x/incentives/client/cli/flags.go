@@ -12,6 +12,8 @@ const (
 	FlagStartTime = "start-time"
 	FlagEpochs    = "epochs"
 	FlagPerpetual = "perpetual"
+	FlagNoLock    = "no-lock"
+	FlagPoolIds   = "pool-ids"
 	FlagTimestamp = "timestamp"
 	FlagOwner     = "owner"
 	FlagLockIds   = "lock-ids"
@@ -27,5 +29,7 @@ func FlagSetCreateGauge() *flag.FlagSet {
 	fs.String(FlagStartTime, "", "Timestamp to begin distribution")
 	fs.Uint64(FlagEpochs, 0, "Total epochs to distribute tokens")
 	fs.Bool(FlagPerpetual, false, "Perpetual distribution")
+	fs.Bool(FlagNoLock, false, "Distribute to the unlocked shares of the pool of the pool share lockup_denom held over each epoch, instead of to locks")
+	fs.UintSlice(FlagPoolIds, nil, "Create a group gauge splitting the rewards across these pools by their trading volume of lockup_denom, distributed to the locks of their shares")
 	return fs
 }
@@ -82,12 +82,26 @@ func NewCreateGaugeCmd() *cobra.Command {
 				return err
 			}
 
+			noLock, err := cmd.Flags().GetBool(FlagNoLock)
+			if err != nil {
+				return err
+			}
+
 			distributeTo := lockuptypes.QueryCondition{
 				LockQueryType: lockuptypes.ByDuration,
 				Denom:         denom,
 				Duration:      duration,
 				Timestamp:     time.Unix(0, 0), // XXX check
 			}
+			if noLock {
+				distributeTo.LockQueryType = lockuptypes.NoLock
+				distributeTo.Duration = 0
+			}
+
+			poolIds, err := cmd.Flags().GetUintSlice(FlagPoolIds)
+			if err != nil {
+				return err
+			}
 
 			msg := types.NewMsgCreateGauge(
 				epochs == 1,
@@ -97,6 +111,9 @@ func NewCreateGaugeCmd() *cobra.Command {
 				startTime,
 				epochs,
 			)
+			for _, poolId := range poolIds {
+				msg.PoolIds = append(msg.PoolIds, uint64(poolId))
+			}
 
 			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
 		},
@@ -2,10 +2,12 @@ package keeper
 
 import (
 	"fmt"
+	"math/big"
 	"time"
 
 	db "github.com/tendermint/tm-db"
 
+	gammtypes "github.com/osmosis-labs/osmosis/v13/x/gamm/types"
 	"github.com/osmosis-labs/osmosis/v13/x/incentives/types"
 	lockuptypes "github.com/osmosis-labs/osmosis/v13/x/lockup/types"
 
@@ -308,6 +310,153 @@ func (k Keeper) distributeInternal(
 	return totalDistrCoins, err
 }
 
+// distributeNoLockInternal runs the distribution logic for a NoLock gauge, and adds the sends to the distrInfo struct.
+// The rewards are distributed to the accounts holding unlocked shares of the gauge's pool,
+// pro rata to the shares they held since the last distribution, weighted by the time they held them for.
+// It also updates the gauge for the distribution.
+// weightsCache is expected to be shared by the NoLock gauges of a distribution, so that their pools' weights are only computed once.
+func (k Keeper) distributeNoLockInternal(
+	ctx sdk.Context, gauge types.Gauge, weightsCache map[uint64]poolShareWeights, distrInfo *distributionInfo,
+) (sdk.Coins, error) {
+	if gauge.Coins.Empty() {
+		return nil, nil
+	}
+	poolId, err := gammtypes.GetPoolIdFromShareDenom(gauge.DistributeTo.Denom)
+	if err != nil {
+		return nil, err
+	}
+	weights, ok := weightsCache[poolId]
+	if !ok {
+		weights = k.getPoolShareWeights(ctx, poolId)
+		weightsCache[poolId] = weights
+	}
+	if len(weights.addresses) == 0 {
+		return nil, nil
+	}
+
+	totalDistrCoins := sdk.NewCoins()
+	remainCoins := gauge.Coins.Sub(gauge.DistributedCoins)
+	// if its a perpetual gauge, we set remaining epochs to 1.
+	// otherwise is is a non perpetual gauge and we determine how many epoch payouts are left
+	remainEpochs := uint64(1)
+	if !gauge.IsPerpetual {
+		remainEpochs = gauge.NumEpochsPaidOver - gauge.FilledEpochs
+	}
+
+	for i, address := range weights.addresses {
+		distrCoins := sdk.Coins{}
+		for _, coin := range remainCoins {
+			// distribution amount = gauge_size * (account_weight / total_weight) / remain_epochs
+			amt := coin.Amount.ToDec().Mul(weights.fractions[i]).QuoInt64(int64(remainEpochs)).TruncateInt()
+			if amt.IsPositive() {
+				newlyDistributedCoin := sdk.Coin{Denom: coin.Denom, Amount: amt}
+				distrCoins = distrCoins.Add(newlyDistributedCoin)
+			}
+		}
+		distrCoins = distrCoins.Sort()
+		if distrCoins.Empty() {
+			continue
+		}
+		// update the amount for that address
+		err := distrInfo.addLockRewards(address, distrCoins)
+		if err != nil {
+			return nil, err
+		}
+
+		totalDistrCoins = totalDistrCoins.Add(distrCoins...)
+	}
+
+	err = k.updateGaugePostDistribute(ctx, gauge, totalDistrCoins)
+	return totalDistrCoins, err
+}
+
+// distributeGroupInternal runs the distribution logic for a group gauge, and adds the sends to the distrInfo struct.
+// The rewards are split across the gauge's pools by their relative trading volume of the gauge's denom over the epoch,
+// and the rewards of each pool are distributed to the locks of its shares that meet the gauge's duration.
+// Volumes of the pools' other denoms are not counted. Pools without such locks are left out of the split. It also updates the gauge for the distribution.
+func (k Keeper) distributeGroupInternal(
+	ctx sdk.Context, gauge types.Gauge, locksByDenomCache map[string][]lockuptypes.PeriodLock, distrInfo *distributionInfo,
+) (sdk.Coins, error) {
+	if gauge.Coins.Empty() {
+		return nil, nil
+	}
+
+	type poolLocks struct {
+		shareDenom string
+		volume     sdk.Int
+		locks      []lockuptypes.PeriodLock
+		lockSum    sdk.Int
+	}
+	pools := []poolLocks{}
+	totalVolume := sdk.ZeroInt()
+	for _, poolId := range gauge.PoolIds {
+		volume := k.GetPoolVolume(ctx, poolId, gauge.DistributeTo.Denom)
+		if volume.IsZero() {
+			continue
+		}
+		// the pool's rewards are distributed like those of a gauge for the pool's shares
+		poolGauge := gauge
+		poolGauge.DistributeTo.Denom = gammtypes.GetPoolShareDenom(poolId)
+		locks := k.getDistributeToBaseLocks(ctx, poolGauge, locksByDenomCache)
+		lockSum := lockuptypes.SumLocksByDenom(locks, poolGauge.DistributeTo.Denom)
+		if lockSum.IsZero() {
+			continue
+		}
+		pools = append(pools, poolLocks{poolGauge.DistributeTo.Denom, volume, locks, lockSum})
+		totalVolume = totalVolume.Add(volume)
+	}
+	if totalVolume.IsZero() {
+		return nil, nil
+	}
+
+	totalDistrCoins := sdk.NewCoins()
+	remainCoins := gauge.Coins.Sub(gauge.DistributedCoins)
+	// if its a perpetual gauge, we set remaining epochs to 1.
+	// otherwise is is a non perpetual gauge and we determine how many epoch payouts are left
+	remainEpochs := uint64(1)
+	if !gauge.IsPerpetual {
+		remainEpochs = gauge.NumEpochsPaidOver - gauge.FilledEpochs
+	}
+
+	for _, pool := range pools {
+		// pool_size = gauge_size * pool_volume / total_volume
+		// The product is taken on big ints, as it can exceed the bounds of sdk.Int, while the pool size does not.
+		poolCoins := sdk.Coins{}
+		for _, coin := range remainCoins {
+			amt := new(big.Int).Mul(coin.Amount.BigInt(), pool.volume.BigInt())
+			amt.Quo(amt, totalVolume.BigInt())
+			poolCoins = append(poolCoins, sdk.Coin{Denom: coin.Denom, Amount: sdk.NewIntFromBigInt(amt)})
+		}
+
+		for _, lock := range pool.locks {
+			distrCoins := sdk.Coins{}
+			for _, coin := range poolCoins {
+				// distribution amount = pool_size * denom_lock_amount / (total_denom_lock_amount * remain_epochs)
+				denomLockAmt := lock.Coins.AmountOfNoDenomValidation(pool.shareDenom)
+				amt := coin.Amount.Mul(denomLockAmt).Quo(pool.lockSum.Mul(sdk.NewInt(int64(remainEpochs))))
+				if amt.IsPositive() {
+					newlyDistributedCoin := sdk.Coin{Denom: coin.Denom, Amount: amt}
+					distrCoins = distrCoins.Add(newlyDistributedCoin)
+				}
+			}
+			distrCoins = distrCoins.Sort()
+			if distrCoins.Empty() {
+				continue
+			}
+			// update the amount for that address
+			err := distrInfo.addLockRewards(lock.Owner, distrCoins)
+			if err != nil {
+				return nil, err
+			}
+
+			totalDistrCoins = totalDistrCoins.Add(distrCoins...)
+		}
+	}
+
+	err := k.updateGaugePostDistribute(ctx, gauge, totalDistrCoins)
+	return totalDistrCoins, err
+}
+
 // updateGaugePostDistribute increments the gauge's filled epochs field.
 // Also adds the coins that were just distributed to the gauge's distributed coins field.
 func (k Keeper) updateGaugePostDistribute(ctx sdk.Context, gauge types.Gauge, newlyDistributedCoins sdk.Coins) error {
@@ -343,12 +492,32 @@ func (k Keeper) Distribute(ctx sdk.Context, gauges []types.Gauge) (sdk.Coins, er
 	distrInfo := newDistributionInfo()
 
 	locksByDenomCache := make(map[string][]lockuptypes.PeriodLock)
+	poolShareWeightsCache := make(map[uint64]poolShareWeights)
 	totalDistributedCoins := sdk.Coins{}
 	for _, gauge := range gauges {
-		filteredLocks := k.getDistributeToBaseLocks(ctx, gauge, locksByDenomCache)
-		// send based on synthetic lockup coins if it's distributing to synthetic lockups
 		var gaugeDistributedCoins sdk.Coins
 		var err error
+		// NoLock gauges distribute to unlocked pool shares instead of locks
+		if gauge.DistributeTo.LockQueryType == lockuptypes.NoLock {
+			gaugeDistributedCoins, err = k.distributeNoLockInternal(ctx, gauge, poolShareWeightsCache, &distrInfo)
+			if err != nil {
+				return nil, err
+			}
+			totalDistributedCoins = totalDistributedCoins.Add(gaugeDistributedCoins...)
+			continue
+		}
+		// group gauges distribute to the locks of the shares of their pools, split by volume
+		if gauge.IsGroupGauge() {
+			gaugeDistributedCoins, err = k.distributeGroupInternal(ctx, gauge, locksByDenomCache, &distrInfo)
+			if err != nil {
+				return nil, err
+			}
+			totalDistributedCoins = totalDistributedCoins.Add(gaugeDistributedCoins...)
+			continue
+		}
+
+		filteredLocks := k.getDistributeToBaseLocks(ctx, gauge, locksByDenomCache)
+		// send based on synthetic lockup coins if it's distributing to synthetic lockups
 		if lockuptypes.IsSyntheticDenom(gauge.DistributeTo.Denom) {
 			gaugeDistributedCoins, err = k.distributeSyntheticInternal(ctx, gauge, filteredLocks, &distrInfo)
 		} else {
@@ -12,6 +12,7 @@ import (
 	db "github.com/tendermint/tm-db"
 
 	epochtypes "github.com/osmosis-labs/osmosis/v13/x/epochs/types"
+	gammtypes "github.com/osmosis-labs/osmosis/v13/x/gamm/types"
 	"github.com/osmosis-labs/osmosis/v13/x/incentives/types"
 	lockuptypes "github.com/osmosis-labs/osmosis/v13/x/lockup/types"
 
@@ -92,6 +93,42 @@ func (k Keeper) SetGaugeWithRefKey(ctx sdk.Context, gauge *types.Gauge) error {
 
 // CreateGauge creates a gauge and sends coins to the gauge.
 func (k Keeper) CreateGauge(ctx sdk.Context, isPerpetual bool, owner sdk.AccAddress, coins sdk.Coins, distrTo lockuptypes.QueryCondition, startTime time.Time, numEpochsPaidOver uint64) (uint64, error) {
+	return k.createGauge(ctx, isPerpetual, owner, coins, distrTo, nil, startTime, numEpochsPaidOver)
+}
+
+// CreateGroupGauge creates a group gauge and sends coins to the gauge.
+// Group gauges split each epoch's rewards across the provided pools by their relative trading volume of distrTo's denom,
+// and distribute the rewards of each pool to the locks of its shares that meet distrTo's duration.
+// The volumes of the pools are all measured in distrTo's denom, so pools that do not trade it get no rewards.
+func (k Keeper) CreateGroupGauge(ctx sdk.Context, isPerpetual bool, owner sdk.AccAddress, coins sdk.Coins, distrTo lockuptypes.QueryCondition, poolIds []uint64, startTime time.Time, numEpochsPaidOver uint64) (uint64, error) {
+	if len(poolIds) == 0 {
+		return 0, fmt.Errorf("group gauge should have at least one pool")
+	}
+	if len(poolIds) > types.MaxGroupGaugePools {
+		return 0, fmt.Errorf("group gauge should have at most %d pools, got %d", types.MaxGroupGaugePools, len(poolIds))
+	}
+	if distrTo.LockQueryType != lockuptypes.ByDuration {
+		return 0, fmt.Errorf("group gauge should distribute to locks by duration")
+	}
+	if lockuptypes.IsSyntheticDenom(distrTo.Denom) {
+		return 0, fmt.Errorf("group gauge should not distribute to synthetic denom: %s", distrTo.Denom)
+	}
+	seenPoolIds := make(map[uint64]bool, len(poolIds))
+	for _, poolId := range poolIds {
+		if seenPoolIds[poolId] {
+			return 0, fmt.Errorf("duplicate group gauge pool ID: %d", poolId)
+		}
+		seenPoolIds[poolId] = true
+		// Ensure that the pool exists on-chain, whichever pool module it belongs to
+		if _, err := k.srk.GetPool(ctx, poolId); err != nil {
+			return 0, fmt.Errorf("pool does not exist: %d: %w", poolId, err)
+		}
+	}
+	return k.createGauge(ctx, isPerpetual, owner, coins, distrTo, poolIds, startTime, numEpochsPaidOver)
+}
+
+// createGauge creates a gauge, or a group gauge if pool IDs are provided, and sends coins to the gauge.
+func (k Keeper) createGauge(ctx sdk.Context, isPerpetual bool, owner sdk.AccAddress, coins sdk.Coins, distrTo lockuptypes.QueryCondition, poolIds []uint64, startTime time.Time, numEpochsPaidOver uint64) (uint64, error) {
 	// Ensure that this gauge's duration is one of the allowed durations on chain
 	durations := k.GetLockableDurations(ctx)
 	if distrTo.LockQueryType == lockuptypes.ByDuration {
@@ -107,6 +144,16 @@ func (k Keeper) CreateGauge(ctx sdk.Context, isPerpetual bool, owner sdk.AccAddr
 		}
 	}
 
+	// Ensure that NoLock gauges pay out to the unlocked shares of a pool
+	if distrTo.LockQueryType == lockuptypes.NoLock {
+		if _, err := gammtypes.GetPoolIdFromShareDenom(distrTo.Denom); err != nil {
+			return 0, err
+		}
+		if distrTo.Duration != 0 {
+			return 0, fmt.Errorf("no lock gauge should not set a duration: %d", distrTo.Duration)
+		}
+	}
+
 	// Ensure that the denom this gauge pays out to exists on-chain
 	if !k.bk.HasSupply(ctx, distrTo.Denom) && !strings.Contains(distrTo.Denom, "osmovaloper") {
 		return 0, fmt.Errorf("denom does not exist: %s", distrTo.Denom)
@@ -119,6 +166,7 @@ func (k Keeper) CreateGauge(ctx sdk.Context, isPerpetual bool, owner sdk.AccAddr
 		Coins:             coins,
 		StartTime:         startTime,
 		NumEpochsPaidOver: numEpochsPaidOver,
+		PoolIds:           poolIds,
 	}
 
 	if err := k.bk.SendCoinsFromAccountToModule(ctx, owner, types.ModuleName, gauge.Coins); err != nil {
@@ -252,6 +300,10 @@ func (k Keeper) GetRewardsEst(ctx sdk.Context, addr sdk.AccAddress, locks []lock
 	// no need to change storage while doing estimation as we use cached context
 	cacheCtx, _ := ctx.CacheContext()
 	for _, gauge := range gauges {
+		// NoLock gauges do not reward locks, and group gauges reward the locks of their pools' shares
+		if gauge.DistributeTo.LockQueryType == lockuptypes.NoLock || gauge.IsGroupGauge() {
+			continue
+		}
 		distrBeginEpoch := epochInfo.CurrentEpoch
 		blockTime := ctx.BlockTime()
 		if gauge.StartTime.After(blockTime) {
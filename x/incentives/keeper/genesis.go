@@ -18,14 +18,24 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState types.GenesisState) {
 		}
 	}
 	k.SetLastGaugeID(ctx, genState.LastGaugeId)
+	for _, holding := range genState.PoolShareHoldings {
+		k.SetPoolShareHolding(ctx, holding)
+	}
+	k.SetLastDistributionTime(ctx, genState.LastDistributionTime)
+	for _, poolVolume := range genState.PoolVolumes {
+		k.SetPoolVolume(ctx, poolVolume)
+	}
 }
 
 // ExportGenesis returns the x/incentives module's exported genesis.
 func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 	return &types.GenesisState{
-		Params:            k.GetParams(ctx),
-		LockableDurations: k.GetLockableDurations(ctx),
-		Gauges:            k.GetNotFinishedGauges(ctx),
-		LastGaugeId:       k.GetLastGaugeID(ctx),
+		Params:               k.GetParams(ctx),
+		LockableDurations:    k.GetLockableDurations(ctx),
+		Gauges:               k.GetNotFinishedGauges(ctx),
+		LastGaugeId:          k.GetLastGaugeID(ctx),
+		PoolShareHoldings:    k.GetAllPoolShareHoldings(ctx),
+		LastDistributionTime: k.GetLastDistributionTime(ctx),
+		PoolVolumes:          k.GetAllPoolVolumes(ctx),
 	}
 }
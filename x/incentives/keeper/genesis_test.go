@@ -24,6 +24,7 @@ func TestIncentivesExportGenesis(t *testing.T) {
 	genesis := app.IncentivesKeeper.ExportGenesis(ctx)
 	require.Equal(t, genesis.Params.DistrEpochIdentifier, "week")
 	require.Len(t, genesis.Gauges, 0)
+	require.Len(t, genesis.PoolShareHoldings, 0)
 
 	// create an address and fund with coins
 	addr := sdk.AccAddress([]byte("addr1---------------"))
@@ -94,7 +95,22 @@ func TestIncentivesInitGenesis(t *testing.T) {
 		StartTime:         startTime.UTC(),
 	}
 
-	// initialize genesis with specified parameter, the gauge created earlier, and lockable durations
+	// unlocked pool shares held by an account since the last distribution
+	lastDistributionTime := startTime.Add(-time.Hour).UTC()
+	holding := types.PoolShareHolding{
+		PoolId:         1,
+		Address:        sdk.AccAddress([]byte("addr1---------------")).String(),
+		Shares:         sdk.NewInt(100),
+		LastUpdateTime: startTime.UTC(),
+		Accumulator:    sdk.NewInt(1000),
+	}
+	poolVolume := types.PoolVolume{
+		PoolId: 1,
+		Denom:  "uosmo",
+		Volume: sdk.NewInt(5000),
+	}
+
+	// initialize genesis with specified parameter, the gauge created earlier, lockable durations, pool share holdings and pool volumes
 	app.IncentivesKeeper.InitGenesis(ctx, types.GenesisState{
 		Params: types.Params{
 			DistrEpochIdentifier: "week",
@@ -106,10 +122,19 @@ func TestIncentivesInitGenesis(t *testing.T) {
 			time.Hour * 3,
 			time.Hour * 7,
 		},
+		PoolShareHoldings:    []types.PoolShareHolding{holding},
+		LastDistributionTime: lastDistributionTime,
+		PoolVolumes:          []types.PoolVolume{poolVolume},
 	})
 
 	// check that the gauge created earlier was initialized through initGenesis and still exists on chain
 	gauges := app.IncentivesKeeper.GetGauges(ctx)
 	require.Len(t, gauges, 1)
 	require.Equal(t, gauges[0], gauge)
+
+	// check that the pool share holdings, last distribution time and pool volumes are initialized and exported
+	genesis := app.IncentivesKeeper.ExportGenesis(ctx)
+	require.Equal(t, []types.PoolShareHolding{holding}, genesis.PoolShareHoldings)
+	require.Equal(t, lastDistributionTime, genesis.LastDistributionTime)
+	require.Equal(t, []types.PoolVolume{poolVolume}, genesis.PoolVolumes)
 }
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	epochstypes "github.com/osmosis-labs/osmosis/v13/x/epochs/types"
+	gammtypes "github.com/osmosis-labs/osmosis/v13/x/gamm/types"
 	"github.com/osmosis-labs/osmosis/v13/x/incentives/types"
 	lockuptypes "github.com/osmosis-labs/osmosis/v13/x/lockup/types"
 
@@ -47,6 +48,10 @@ func (k Keeper) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, epochNumb
 		if err != nil {
 			return err
 		}
+		// NoLock gauges weight the pool shares held from this distribution on
+		k.SetLastDistributionTime(ctx, ctx.BlockTime())
+		// group gauges split the next distribution by the volumes traded from now on
+		k.resetPoolVolumes(ctx)
 	}
 	return nil
 }
@@ -74,3 +79,39 @@ func (h Hooks) BeforeEpochStart(ctx sdk.Context, epochIdentifier string, epochNu
 func (h Hooks) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, epochNumber int64) error {
 	return h.k.AfterEpochEnd(ctx, epochIdentifier, epochNumber)
 }
+
+// ___________________________________________________________________________________________________
+
+// gammHooks is the wrapper struct for the incentives keeper gamm hooks,
+// which track the unlocked pool shares held by accounts for NoLock gauges,
+// and the trading volumes of pools for group gauges.
+type gammHooks struct {
+	k Keeper
+}
+
+var _ gammtypes.GammHooks = gammHooks{}
+
+// GammHooks returns the gamm hooks wrapper struct.
+func (k Keeper) GammHooks() gammtypes.GammHooks {
+	return gammHooks{k}
+}
+
+// AfterPoolCreated updates the pool shares held by the pool creator.
+func (h gammHooks) AfterPoolCreated(ctx sdk.Context, sender sdk.AccAddress, poolId uint64) {
+	h.k.UpdatePoolShareHolding(ctx, poolId, sender)
+}
+
+// AfterJoinPool updates the pool shares held by the account joining the pool.
+func (h gammHooks) AfterJoinPool(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, enterCoins sdk.Coins, shareOutAmount sdk.Int) {
+	h.k.UpdatePoolShareHolding(ctx, poolId, sender)
+}
+
+// AfterExitPool updates the pool shares held by the account exiting the pool.
+func (h gammHooks) AfterExitPool(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, shareInAmount sdk.Int, exitCoins sdk.Coins) {
+	h.k.UpdatePoolShareHolding(ctx, poolId, sender)
+}
+
+// AfterSwap adds the coins swapped in and out of the pool to its trading volumes.
+func (h gammHooks) AfterSwap(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, input sdk.Coins, output sdk.Coins) {
+	h.k.addPoolVolume(ctx, poolId, input.Add(output...))
+}
@@ -23,10 +23,11 @@ type Keeper struct {
 	ek         types.EpochKeeper
 	ck         types.CommunityPoolKeeper
 	tk         types.TxFeesKeeper
+	srk        types.SwapRouterKeeper
 }
 
 // NewKeeper returns a new instance of the incentive module keeper struct.
-func NewKeeper(storeKey sdk.StoreKey, paramSpace paramtypes.Subspace, bk types.BankKeeper, lk types.LockupKeeper, ek types.EpochKeeper, ck types.CommunityPoolKeeper, txfk types.TxFeesKeeper) *Keeper {
+func NewKeeper(storeKey sdk.StoreKey, paramSpace paramtypes.Subspace, bk types.BankKeeper, lk types.LockupKeeper, ek types.EpochKeeper, ck types.CommunityPoolKeeper, txfk types.TxFeesKeeper, srk types.SwapRouterKeeper) *Keeper {
 	if !paramSpace.HasKeyTable() {
 		paramSpace = paramSpace.WithKeyTable(types.ParamKeyTable())
 	}
@@ -39,6 +40,7 @@ func NewKeeper(storeKey sdk.StoreKey, paramSpace paramtypes.Subspace, bk types.B
 		ek:         ek,
 		ck:         ck,
 		tk:         txfk,
+		srk:        srk,
 	}
 }
 
@@ -37,7 +37,12 @@ func (server msgServer) CreateGauge(goCtx context.Context, msg *types.MsgCreateG
 		return nil, err
 	}
 
-	gaugeID, err := server.keeper.CreateGauge(ctx, msg.IsPerpetual, owner, msg.Coins, msg.DistributeTo, msg.StartTime, msg.NumEpochsPaidOver)
+	var gaugeID uint64
+	if len(msg.PoolIds) > 0 {
+		gaugeID, err = server.keeper.CreateGroupGauge(ctx, msg.IsPerpetual, owner, msg.Coins, msg.DistributeTo, msg.PoolIds, msg.StartTime, msg.NumEpochsPaidOver)
+	} else {
+		gaugeID, err = server.keeper.CreateGauge(ctx, msg.IsPerpetual, owner, msg.Coins, msg.DistributeTo, msg.StartTime, msg.NumEpochsPaidOver)
+	}
 	if err != nil {
 		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
 	}
@@ -0,0 +1,191 @@
+package keeper
+
+import (
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+
+	gammtypes "github.com/osmosis-labs/osmosis/v13/x/gamm/types"
+	"github.com/osmosis-labs/osmosis/v13/x/incentives/types"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// poolShareHoldingsPrefix returns the store key prefix of the pool share holdings of the provided pool.
+func poolShareHoldingsPrefix(poolId uint64) []byte {
+	return append(append([]byte{}, types.KeyPrefixPoolShareHoldings...), sdk.Uint64ToBigEndian(poolId)...)
+}
+
+// poolShareHoldingStoreKey returns the store key of the pool share holding of the provided pool and address.
+func poolShareHoldingStoreKey(poolId uint64, addr sdk.AccAddress) []byte {
+	return append(poolShareHoldingsPrefix(poolId), addr...)
+}
+
+// SetPoolShareHolding sets the unlocked pool shares held by an account.
+func (k Keeper) SetPoolShareHolding(ctx sdk.Context, holding types.PoolShareHolding) {
+	addr, err := sdk.AccAddressFromBech32(holding.Address)
+	if err != nil {
+		panic(err)
+	}
+	store := ctx.KVStore(k.storeKey)
+	bz, err := proto.Marshal(&holding)
+	if err != nil {
+		panic(err)
+	}
+	store.Set(poolShareHoldingStoreKey(holding.PoolId, addr), bz)
+}
+
+// GetPoolShareHolding returns the unlocked pool shares held by an account, and a bool if found / not found.
+func (k Keeper) GetPoolShareHolding(ctx sdk.Context, poolId uint64, addr sdk.AccAddress) (types.PoolShareHolding, bool) {
+	holding := types.PoolShareHolding{}
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(poolShareHoldingStoreKey(poolId, addr))
+	if bz == nil {
+		return holding, false
+	}
+	if err := proto.Unmarshal(bz, &holding); err != nil {
+		panic(err)
+	}
+	return holding, true
+}
+
+// GetPoolShareHoldings returns the unlocked shares of the provided pool held by all accounts.
+func (k Keeper) GetPoolShareHoldings(ctx sdk.Context, poolId uint64) []types.PoolShareHolding {
+	return k.getPoolShareHoldingsByPrefix(ctx, poolShareHoldingsPrefix(poolId))
+}
+
+// GetAllPoolShareHoldings returns the unlocked pool shares held by all accounts, for all pools.
+func (k Keeper) GetAllPoolShareHoldings(ctx sdk.Context) []types.PoolShareHolding {
+	return k.getPoolShareHoldingsByPrefix(ctx, types.KeyPrefixPoolShareHoldings)
+}
+
+// getPoolShareHoldingsByPrefix returns the pool share holdings under the provided store key prefix.
+func (k Keeper) getPoolShareHoldingsByPrefix(ctx sdk.Context, keyPrefix []byte) []types.PoolShareHolding {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), keyPrefix)
+	iterator := prefixStore.Iterator(nil, nil)
+	defer iterator.Close()
+
+	holdings := []types.PoolShareHolding{}
+	for ; iterator.Valid(); iterator.Next() {
+		holding := types.PoolShareHolding{}
+		if err := proto.Unmarshal(iterator.Value(), &holding); err != nil {
+			panic(err)
+		}
+		holdings = append(holdings, holding)
+	}
+	return holdings
+}
+
+// GetLastDistributionTime returns the time of the last epoch distribution.
+func (k Keeper) GetLastDistributionTime(ctx sdk.Context) time.Time {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.KeyLastDistributionTime)
+	if bz == nil {
+		return time.Time{}
+	}
+	lastDistributionTime, err := sdk.ParseTimeBytes(bz)
+	if err != nil {
+		panic(err)
+	}
+	return lastDistributionTime
+}
+
+// SetLastDistributionTime sets the time of the last epoch distribution.
+func (k Keeper) SetLastDistributionTime(ctx sdk.Context, lastDistributionTime time.Time) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.KeyLastDistributionTime, sdk.FormatTimeBytes(lastDistributionTime))
+}
+
+// UpdatePoolShareHolding updates the unlocked shares of the pool held by the account to its current balance,
+// accumulating the shares it held since the holding was last updated.
+// It is only called by the gamm hooks, so shares held before the account's first update, e.g. from before the upgrade
+// or received by a bank send, are only tracked once the account next creates, joins or exits the pool.
+func (k Keeper) UpdatePoolShareHolding(ctx sdk.Context, poolId uint64, addr sdk.AccAddress) {
+	holding, found := k.GetPoolShareHolding(ctx, poolId, addr)
+	if !found {
+		holding = types.PoolShareHolding{
+			PoolId:      poolId,
+			Address:     addr.String(),
+			Shares:      sdk.ZeroInt(),
+			Accumulator: sdk.ZeroInt(),
+		}
+	}
+
+	holding.Accumulator = accumulatedPoolShares(holding, k.GetLastDistributionTime(ctx), ctx.BlockTime())
+	holding.Shares = k.bk.GetBalance(ctx, addr, gammtypes.GetPoolShareDenom(poolId)).Amount
+	holding.LastUpdateTime = ctx.BlockTime()
+	k.SetPoolShareHolding(ctx, holding)
+}
+
+// accumulatedPoolShares returns the sum of the shares of the holding multiplied by the nanoseconds they were held for,
+// from the last distribution to now.
+func accumulatedPoolShares(holding types.PoolShareHolding, lastDistributionTime time.Time, now time.Time) sdk.Int {
+	accumulator := holding.Accumulator
+	heldSince := holding.LastUpdateTime
+	// the accumulator of a holding last updated before the last distribution has already been distributed to
+	if heldSince.Before(lastDistributionTime) {
+		accumulator = sdk.ZeroInt()
+		heldSince = lastDistributionTime
+	}
+	if !now.After(heldSince) {
+		return accumulator
+	}
+	return accumulator.Add(holding.Shares.Mul(sdk.NewInt(int64(now.Sub(heldSince)))))
+}
+
+// poolShareWeights are the weights of the accounts holding the unlocked shares of a pool,
+// that the rewards of NoLock gauges are distributed by.
+// Each weight is the fraction of the pool's total weight held by the account, so that rewards can be split
+// without multiplying them by the weights, which are shares multiplied by nanoseconds.
+type poolShareWeights struct {
+	addresses []string
+	fractions []sdk.Dec
+}
+
+// getPoolShareWeights returns the weights of the accounts holding the unlocked shares of the pool since the last distribution,
+// which are the shares they held multiplied by the nanoseconds they held them for.
+// As the holdings are only updated when accounts join or exit the pool, shares sent to other accounts
+// or locked in the meantime would still be accounted for. The weight of each account is thus capped
+// by its current balance of shares held over the whole time since the last distribution.
+// It is called once per distribution for each pool with a NoLock gauge to distribute, and reads every holding of the pool,
+// like lock based gauges read every lock of their denom. The holdings without shares left are deleted along the way,
+// as they will have no weight in the next distributions, so that only the accounts still holding shares are read again.
+func (k Keeper) getPoolShareWeights(ctx sdk.Context, poolId uint64) poolShareWeights {
+	lastDistributionTime := k.GetLastDistributionTime(ctx)
+	shareDenom := gammtypes.GetPoolShareDenom(poolId)
+	store := ctx.KVStore(k.storeKey)
+
+	addresses := []string{}
+	weights := []sdk.Int{}
+	total := sdk.ZeroInt()
+	for _, holding := range k.GetPoolShareHoldings(ctx, poolId) {
+		addr, err := sdk.AccAddressFromBech32(holding.Address)
+		if err != nil {
+			panic(err)
+		}
+		weight := accumulatedPoolShares(holding, lastDistributionTime, ctx.BlockTime())
+		if holding.Shares.IsZero() {
+			store.Delete(poolShareHoldingStoreKey(poolId, addr))
+		}
+		if weight.IsZero() {
+			continue
+		}
+
+		balance := k.bk.GetBalance(ctx, addr, shareDenom).Amount
+		weight = sdk.MinInt(weight, balance.Mul(sdk.NewInt(int64(ctx.BlockTime().Sub(lastDistributionTime)))))
+		if !weight.IsPositive() {
+			continue
+		}
+
+		addresses = append(addresses, holding.Address)
+		weights = append(weights, weight)
+		total = total.Add(weight)
+	}
+
+	poolWeights := poolShareWeights{addresses: addresses, fractions: make([]sdk.Dec, len(weights))}
+	for i, weight := range weights {
+		poolWeights.fractions[i] = weight.ToDec().QuoTruncate(total.ToDec())
+	}
+	return poolWeights
+}
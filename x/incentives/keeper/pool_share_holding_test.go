@@ -0,0 +1,255 @@
+package keeper_test
+
+import (
+	"time"
+
+	gammtypes "github.com/osmosis-labs/osmosis/v13/x/gamm/types"
+	"github.com/osmosis-labs/osmosis/v13/x/incentives/types"
+	lockuptypes "github.com/osmosis-labs/osmosis/v13/x/lockup/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// joinPool funds the account and joins the pool for the given amount of shares.
+func (suite *KeeperTestSuite) joinPool(addr sdk.AccAddress, poolId uint64, shares sdk.Int) {
+	suite.FundAcc(addr, sdk.NewCoins(
+		sdk.NewInt64Coin("foo", 5000000), sdk.NewInt64Coin("bar", 5000000),
+		sdk.NewInt64Coin("baz", 5000000), sdk.NewInt64Coin("uosmo", 5000000)))
+	_, _, err := suite.App.GAMMKeeper.JoinPoolNoSwap(suite.Ctx, addr, poolId, shares, sdk.Coins{})
+	suite.Require().NoError(err)
+}
+
+// TestPoolShareHoldingUpdates tests the unlocked pool shares held by accounts are tracked by the gamm hooks.
+func (suite *KeeperTestSuite) TestPoolShareHoldingUpdates() {
+	suite.SetupTest()
+	startTime := suite.Ctx.BlockTime()
+	creator := suite.TestAccs[0]
+	joiner := suite.TestAccs[1]
+
+	// the pool creator holds the initial shares
+	poolId := suite.PrepareBalancerPool()
+	holding, found := suite.App.IncentivesKeeper.GetPoolShareHolding(suite.Ctx, poolId, creator)
+	suite.Require().True(found)
+	suite.Require().Equal(types.PoolShareHolding{
+		PoolId:         poolId,
+		Address:        creator.String(),
+		Shares:         gammtypes.InitPoolSharesSupply,
+		LastUpdateTime: startTime,
+		Accumulator:    sdk.ZeroInt(),
+	}, holding)
+
+	// joining the pool updates the holding of the joiner only
+	suite.Ctx = suite.Ctx.WithBlockTime(startTime.Add(10 * time.Second))
+	suite.joinPool(joiner, poolId, gammtypes.InitPoolSharesSupply)
+	holding, found = suite.App.IncentivesKeeper.GetPoolShareHolding(suite.Ctx, poolId, joiner)
+	suite.Require().True(found)
+	suite.Require().Equal(gammtypes.InitPoolSharesSupply, holding.Shares)
+	suite.Require().Equal(sdk.ZeroInt(), holding.Accumulator)
+
+	// exiting the pool accumulates the shares held until then
+	suite.Ctx = suite.Ctx.WithBlockTime(startTime.Add(20 * time.Second))
+	halfShares := gammtypes.InitPoolSharesSupply.QuoRaw(2)
+	_, err := suite.App.GAMMKeeper.ExitPool(suite.Ctx, creator, poolId, halfShares, sdk.Coins{})
+	suite.Require().NoError(err)
+	holding, found = suite.App.IncentivesKeeper.GetPoolShareHolding(suite.Ctx, poolId, creator)
+	suite.Require().True(found)
+	suite.Require().Equal(halfShares, holding.Shares)
+	suite.Require().Equal(suite.Ctx.BlockTime(), holding.LastUpdateTime)
+	suite.Require().Equal(gammtypes.InitPoolSharesSupply.Mul(sdk.NewInt(int64(20*time.Second))), holding.Accumulator)
+
+	// the accumulator restarts from the last distribution
+	suite.App.IncentivesKeeper.SetLastDistributionTime(suite.Ctx, startTime.Add(25*time.Second))
+	suite.Ctx = suite.Ctx.WithBlockTime(startTime.Add(30 * time.Second))
+	_, err = suite.App.GAMMKeeper.ExitPool(suite.Ctx, creator, poolId, halfShares, sdk.Coins{})
+	suite.Require().NoError(err)
+	holding, found = suite.App.IncentivesKeeper.GetPoolShareHolding(suite.Ctx, poolId, creator)
+	suite.Require().True(found)
+	suite.Require().Equal(sdk.ZeroInt(), holding.Shares)
+	suite.Require().Equal(halfShares.Mul(sdk.NewInt(int64(5*time.Second))), holding.Accumulator)
+
+	suite.Require().Len(suite.App.IncentivesKeeper.GetPoolShareHoldings(suite.Ctx, poolId), 2)
+}
+
+// TestPoolShareGaugeDistribution tests NoLock gauges distribute to the unlocked pool shares held since the last distribution,
+// weighted by the time they were held for.
+func (suite *KeeperTestSuite) TestPoolShareGaugeDistribution() {
+	rewards := sdk.Coins{sdk.NewInt64Coin(defaultRewardDenom, 3000)}
+
+	tests := []struct {
+		name string
+		// whether the joiner sends its shares to another account before the distribution
+		sendJoinerShares bool
+		expCreatorReward sdk.Coins
+		expJoinerReward  sdk.Coins
+	}{
+		{
+			// the fractions of the total weight are truncated, leaving dust in the gauge
+			name:             "rewards are split by the shares held over time",
+			expCreatorReward: sdk.Coins{sdk.NewInt64Coin(defaultRewardDenom, 1999)},
+			expJoinerReward:  sdk.Coins{sdk.NewInt64Coin(defaultRewardDenom, 999)},
+		},
+		{
+			name:             "shares no longer held are not rewarded",
+			sendJoinerShares: true,
+			expCreatorReward: sdk.Coins{sdk.NewInt64Coin(defaultRewardDenom, 3000)},
+			expJoinerReward:  sdk.Coins{},
+		},
+	}
+
+	for _, tc := range tests {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			startTime := suite.Ctx.BlockTime()
+			creator := suite.TestAccs[0]
+			joiner := suite.TestAccs[1]
+			locker := suite.TestAccs[2]
+			suite.App.IncentivesKeeper.SetLastDistributionTime(suite.Ctx, startTime)
+
+			poolId := suite.PrepareBalancerPool()
+			shareDenom := gammtypes.GetPoolShareDenom(poolId)
+
+			suite.Ctx = suite.Ctx.WithBlockTime(startTime.Add(10 * time.Second))
+			suite.joinPool(joiner, poolId, gammtypes.InitPoolSharesSupply)
+			if tc.sendJoinerShares {
+				err := suite.App.BankKeeper.SendCoins(suite.Ctx, joiner, sdk.AccAddress([]byte("addr1---------------")), sdk.NewCoins(sdk.NewCoin(shareDenom, gammtypes.InitPoolSharesSupply)))
+				suite.Require().NoError(err)
+			}
+
+			// a gauge for the locks of the same denom is distributed along with the NoLock gauge
+			lockedShares := sdk.NewCoins(sdk.NewCoin(shareDenom, sdk.NewInt(100)))
+			suite.LockTokens(locker, lockedShares, defaultLockDuration)
+			gaugeAddr := sdk.AccAddress([]byte("Gauge_Creation_Addr_"))
+			noLockGaugeId, _ := suite.CreateGauge(true, gaugeAddr, rewards, lockuptypes.QueryCondition{
+				LockQueryType: lockuptypes.NoLock,
+				Denom:         shareDenom,
+			}, suite.Ctx.BlockTime(), 1)
+			lockGaugeId, _ := suite.CreateGauge(true, gaugeAddr, rewards, lockuptypes.QueryCondition{
+				LockQueryType: lockuptypes.ByDuration,
+				Denom:         shareDenom,
+				Duration:      defaultLockDuration,
+			}, suite.Ctx.BlockTime(), 1)
+
+			suite.Ctx = suite.Ctx.WithBlockTime(startTime.Add(20 * time.Second))
+			gauges, err := suite.App.IncentivesKeeper.GetGaugeFromIDs(suite.Ctx, []uint64{noLockGaugeId, lockGaugeId})
+			suite.Require().NoError(err)
+			for _, gauge := range gauges {
+				err = suite.App.IncentivesKeeper.MoveUpcomingGaugeToActiveGauge(suite.Ctx, gauge)
+				suite.Require().NoError(err)
+			}
+			creatorBalance := suite.App.BankKeeper.GetBalance(suite.Ctx, creator, defaultRewardDenom)
+
+			distrCoins, err := suite.App.IncentivesKeeper.Distribute(suite.Ctx, gauges)
+			suite.Require().NoError(err)
+			noLockDistrCoins := tc.expCreatorReward.Add(tc.expJoinerReward...)
+			suite.Require().Equal(rewards.Add(noLockDistrCoins...), distrCoins)
+
+			creatorReward := suite.App.BankKeeper.GetBalance(suite.Ctx, creator, defaultRewardDenom).Sub(creatorBalance)
+			suite.Require().Equal(tc.expCreatorReward, sdk.NewCoins(creatorReward))
+			suite.Require().Equal(tc.expJoinerReward, sdk.NewCoins(suite.App.BankKeeper.GetBalance(suite.Ctx, joiner, defaultRewardDenom)))
+			suite.Require().Equal(rewards, sdk.NewCoins(suite.App.BankKeeper.GetBalance(suite.Ctx, locker, defaultRewardDenom)))
+
+			gauge, err := suite.App.IncentivesKeeper.GetGaugeByID(suite.Ctx, noLockGaugeId)
+			suite.Require().NoError(err)
+			suite.Require().Equal(uint64(1), gauge.FilledEpochs)
+			suite.Require().Equal(noLockDistrCoins, gauge.DistributedCoins)
+		})
+	}
+}
+
+// TestPoolShareGaugeDistributionLargeAmounts tests NoLock gauges distribute large rewards to large holdings,
+// whose weights multiplied by the rewards would overflow.
+func (suite *KeeperTestSuite) TestPoolShareGaugeDistributionLargeAmounts() {
+	suite.SetupTest()
+	startTime := suite.Ctx.BlockTime()
+	holder := suite.TestAccs[1]
+	suite.App.IncentivesKeeper.SetLastDistributionTime(suite.Ctx, startTime)
+
+	poolId := suite.PrepareBalancerPool()
+	shareDenom := gammtypes.GetPoolShareDenom(poolId)
+	largeShares, ok := sdk.NewIntFromString("100000000000000000000000000000000000000000000000000") // 1e50
+	suite.Require().True(ok)
+	suite.FundAcc(holder, sdk.NewCoins(sdk.NewCoin(shareDenom, largeShares)))
+	suite.App.IncentivesKeeper.SetPoolShareHolding(suite.Ctx, types.PoolShareHolding{
+		PoolId:         poolId,
+		Address:        holder.String(),
+		Shares:         largeShares,
+		LastUpdateTime: startTime,
+		Accumulator:    sdk.ZeroInt(),
+	})
+
+	largeRewards, ok := sdk.NewIntFromString("1000000000000000000000000000000") // 1e30
+	suite.Require().True(ok)
+	rewards := sdk.NewCoins(sdk.NewCoin(defaultRewardDenom, largeRewards))
+	gaugeId, _ := suite.CreateGauge(true, sdk.AccAddress([]byte("Gauge_Creation_Addr_")), rewards, lockuptypes.QueryCondition{
+		LockQueryType: lockuptypes.NoLock,
+		Denom:         shareDenom,
+	}, suite.Ctx.BlockTime(), 1)
+
+	suite.Ctx = suite.Ctx.WithBlockTime(startTime.Add(time.Hour))
+	gauge, err := suite.App.IncentivesKeeper.GetGaugeByID(suite.Ctx, gaugeId)
+	suite.Require().NoError(err)
+	err = suite.App.IncentivesKeeper.MoveUpcomingGaugeToActiveGauge(suite.Ctx, *gauge)
+	suite.Require().NoError(err)
+	_, err = suite.App.IncentivesKeeper.Distribute(suite.Ctx, []types.Gauge{*gauge})
+	suite.Require().NoError(err)
+
+	// the holder gets all but the negligible share of the pool creator, up to truncation
+	holderReward := suite.App.BankKeeper.GetBalance(suite.Ctx, holder, defaultRewardDenom).Amount
+	suite.Require().True(holderReward.LTE(largeRewards))
+	suite.Require().True(holderReward.GTE(largeRewards.Sub(sdk.NewInt(1000000000000))), holderReward.String())
+}
+
+// TestPoolShareGaugeEpochDistribution tests the epoch distribution restarts the weighting of the pool shares held.
+func (suite *KeeperTestSuite) TestPoolShareGaugeEpochDistribution() {
+	suite.SetupTest()
+	startTime := suite.Ctx.BlockTime()
+	poolId := suite.PrepareBalancerPool()
+
+	suite.Ctx = suite.Ctx.WithBlockTime(startTime.Add(time.Hour))
+	params := suite.App.IncentivesKeeper.GetParams(suite.Ctx)
+	err := suite.App.IncentivesKeeper.AfterEpochEnd(suite.Ctx, params.DistrEpochIdentifier, 1)
+	suite.Require().NoError(err)
+	suite.Require().Equal(suite.Ctx.BlockTime(), suite.App.IncentivesKeeper.GetLastDistributionTime(suite.Ctx))
+
+	// shares held before the distribution are no longer rewarded, while the creator has held its shares since
+	rewards := sdk.Coins{sdk.NewInt64Coin(defaultRewardDenom, 1000)}
+	gaugeId, _ := suite.CreateGauge(true, sdk.AccAddress([]byte("Gauge_Creation_Addr_")), rewards, lockuptypes.QueryCondition{
+		LockQueryType: lockuptypes.NoLock,
+		Denom:         gammtypes.GetPoolShareDenom(poolId),
+	}, suite.Ctx.BlockTime(), 1)
+	suite.joinPool(suite.TestAccs[1], poolId, gammtypes.InitPoolSharesSupply)
+
+	suite.Ctx = suite.Ctx.WithBlockTime(startTime.Add(2 * time.Hour))
+	err = suite.App.IncentivesKeeper.AfterEpochEnd(suite.Ctx, params.DistrEpochIdentifier, 2)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewInt64Coin(defaultRewardDenom, 500), suite.App.BankKeeper.GetBalance(suite.Ctx, suite.TestAccs[0], defaultRewardDenom))
+	suite.Require().Equal(sdk.NewInt64Coin(defaultRewardDenom, 500), suite.App.BankKeeper.GetBalance(suite.Ctx, suite.TestAccs[1], defaultRewardDenom))
+
+	gauge, err := suite.App.IncentivesKeeper.GetGaugeByID(suite.Ctx, gaugeId)
+	suite.Require().NoError(err)
+	suite.Require().Equal(rewards, gauge.DistributedCoins)
+}
+
+// TestNoLockGaugeCreationValidation tests NoLock gauges can only be created for pool share denoms, without a duration.
+func (suite *KeeperTestSuite) TestNoLockGaugeCreationValidation() {
+	suite.SetupTest()
+	poolId := suite.PrepareBalancerPool()
+	addr := suite.TestAccs[1]
+	suite.FundAcc(addr, defaultLiquidTokens)
+
+	distrTo := lockuptypes.QueryCondition{
+		LockQueryType: lockuptypes.NoLock,
+		Denom:         "uosmo",
+	}
+	_, err := suite.App.IncentivesKeeper.CreateGauge(suite.Ctx, true, addr, defaultLiquidTokens, distrTo, time.Time{}, 1)
+	suite.Require().Error(err)
+
+	distrTo.Denom = gammtypes.GetPoolShareDenom(poolId)
+	distrTo.Duration = defaultLockDuration
+	_, err = suite.App.IncentivesKeeper.CreateGauge(suite.Ctx, true, addr, defaultLiquidTokens, distrTo, time.Time{}, 1)
+	suite.Require().Error(err)
+
+	distrTo.Duration = 0
+	_, err = suite.App.IncentivesKeeper.CreateGauge(suite.Ctx, true, addr, defaultLiquidTokens, distrTo, time.Time{}, 1)
+	suite.Require().NoError(err)
+}
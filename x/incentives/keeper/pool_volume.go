@@ -0,0 +1,85 @@
+package keeper
+
+import (
+	"github.com/gogo/protobuf/proto"
+
+	"github.com/osmosis-labs/osmosis/v13/x/incentives/types"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// poolVolumeStoreKey returns the store key of the trading volume of the provided denom in the provided pool.
+func poolVolumeStoreKey(poolId uint64, denom string) []byte {
+	return combineKeys(types.KeyPrefixPoolVolumes, sdk.Uint64ToBigEndian(poolId), []byte(denom))
+}
+
+// SetPoolVolume sets the trading volume of a denom in a pool over the current epoch.
+func (k Keeper) SetPoolVolume(ctx sdk.Context, poolVolume types.PoolVolume) {
+	store := ctx.KVStore(k.storeKey)
+	bz, err := proto.Marshal(&poolVolume)
+	if err != nil {
+		panic(err)
+	}
+	store.Set(poolVolumeStoreKey(poolVolume.PoolId, poolVolume.Denom), bz)
+}
+
+// GetPoolVolume returns the trading volume of a denom in a pool over the current epoch.
+func (k Keeper) GetPoolVolume(ctx sdk.Context, poolId uint64, denom string) sdk.Int {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(poolVolumeStoreKey(poolId, denom))
+	if bz == nil {
+		return sdk.ZeroInt()
+	}
+	poolVolume := types.PoolVolume{}
+	if err := proto.Unmarshal(bz, &poolVolume); err != nil {
+		panic(err)
+	}
+	return poolVolume.Volume
+}
+
+// GetAllPoolVolumes returns the trading volumes of all denoms in all pools over the current epoch.
+func (k Keeper) GetAllPoolVolumes(ctx sdk.Context) []types.PoolVolume {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixPoolVolumes)
+	iterator := prefixStore.Iterator(nil, nil)
+	defer iterator.Close()
+
+	poolVolumes := []types.PoolVolume{}
+	for ; iterator.Valid(); iterator.Next() {
+		poolVolume := types.PoolVolume{}
+		if err := proto.Unmarshal(iterator.Value(), &poolVolume); err != nil {
+			panic(err)
+		}
+		poolVolumes = append(poolVolumes, poolVolume)
+	}
+	return poolVolumes
+}
+
+// addPoolVolume adds the amounts of the swapped coins to the trading volumes of their denoms in the pool.
+// The volume of each denom is kept in that denom, and volumes of different denoms are never added together:
+// group gauges compare the volumes of their pools in the gauge's denom only.
+func (k Keeper) addPoolVolume(ctx sdk.Context, poolId uint64, swapped sdk.Coins) {
+	for _, coin := range swapped {
+		k.SetPoolVolume(ctx, types.PoolVolume{
+			PoolId: poolId,
+			Denom:  coin.Denom,
+			Volume: k.GetPoolVolume(ctx, poolId, coin.Denom).Add(coin.Amount),
+		})
+	}
+}
+
+// resetPoolVolumes deletes the trading volumes of all pools, once the epoch they were traded in has been distributed.
+func (k Keeper) resetPoolVolumes(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	prefixStore := prefix.NewStore(store, types.KeyPrefixPoolVolumes)
+	iterator := prefixStore.Iterator(nil, nil)
+	keys := [][]byte{}
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		prefixStore.Delete(key)
+	}
+}
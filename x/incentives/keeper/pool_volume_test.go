@@ -0,0 +1,297 @@
+package keeper_test
+
+import (
+	gammtypes "github.com/osmosis-labs/osmosis/v13/x/gamm/types"
+	"github.com/osmosis-labs/osmosis/v13/x/incentives/types"
+	lockuptypes "github.com/osmosis-labs/osmosis/v13/x/lockup/types"
+	swaproutertypes "github.com/osmosis-labs/osmosis/v13/x/swaprouter/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// swap funds the account and swaps the token in for the token out denom through the pool.
+func (suite *KeeperTestSuite) swap(addr sdk.AccAddress, poolId uint64, tokenIn sdk.Coin, tokenOutDenom string) sdk.Int {
+	suite.FundAcc(addr, sdk.NewCoins(tokenIn))
+	tokenOut, err := suite.App.SwapRouterKeeper.RouteExactAmountIn(suite.Ctx, addr,
+		[]swaproutertypes.SwapAmountInRoute{{PoolId: poolId, TokenOutDenom: tokenOutDenom}}, tokenIn, sdk.OneInt())
+	suite.Require().NoError(err)
+	return tokenOut
+}
+
+// createGroupGauge funds the address and creates a group gauge distributing to the locks of the pools' shares by their volume of the denom.
+func (suite *KeeperTestSuite) createGroupGauge(isPerpetual bool, addr sdk.AccAddress, coins sdk.Coins, denom string, poolIds []uint64, numEpoch uint64) uint64 {
+	suite.FundAcc(addr, coins)
+	distrTo := lockuptypes.QueryCondition{
+		LockQueryType: lockuptypes.ByDuration,
+		Denom:         denom,
+		Duration:      defaultLockDuration,
+	}
+	gaugeID, err := suite.App.IncentivesKeeper.CreateGroupGauge(suite.Ctx, isPerpetual, addr, coins, distrTo, poolIds, suite.Ctx.BlockTime(), numEpoch)
+	suite.Require().NoError(err)
+	return gaugeID
+}
+
+// TestPoolVolumeUpdates tests the trading volumes of pools are tracked by the gamm swap hook, and reset by the epoch distribution.
+func (suite *KeeperTestSuite) TestPoolVolumeUpdates() {
+	suite.SetupTest()
+	poolId := suite.PrepareBalancerPool()
+	otherPoolId := suite.PrepareBalancerPool()
+
+	barOut := suite.swap(suite.TestAccs[1], poolId, sdk.NewInt64Coin("foo", 1000), "bar")
+	suite.swap(suite.TestAccs[1], poolId, sdk.NewInt64Coin("foo", 500), "baz")
+	suite.Require().Equal(sdk.NewInt(1500), suite.App.IncentivesKeeper.GetPoolVolume(suite.Ctx, poolId, "foo"))
+	suite.Require().Equal(barOut, suite.App.IncentivesKeeper.GetPoolVolume(suite.Ctx, poolId, "bar"))
+	suite.Require().Equal(sdk.ZeroInt(), suite.App.IncentivesKeeper.GetPoolVolume(suite.Ctx, otherPoolId, "foo"))
+	suite.Require().Len(suite.App.IncentivesKeeper.GetAllPoolVolumes(suite.Ctx), 3)
+
+	params := suite.App.IncentivesKeeper.GetParams(suite.Ctx)
+	err := suite.App.IncentivesKeeper.AfterEpochEnd(suite.Ctx, params.DistrEpochIdentifier, 1)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.ZeroInt(), suite.App.IncentivesKeeper.GetPoolVolume(suite.Ctx, poolId, "foo"))
+	suite.Require().Len(suite.App.IncentivesKeeper.GetAllPoolVolumes(suite.Ctx), 0)
+}
+
+// TestGroupGaugeDistribution tests group gauges split their rewards across their pools by their volume of the gauge's denom,
+// and distribute the rewards of each pool to the locks of its shares.
+func (suite *KeeperTestSuite) TestGroupGaugeDistribution() {
+	rewards := sdk.Coins{sdk.NewInt64Coin(defaultRewardDenom, 4000)}
+
+	tests := []struct {
+		name        string
+		isPerpetual bool
+		numEpochs   uint64
+		// volumes of foo swapped in each pool
+		fooVolumes []int64
+		// whether the second pool's shares are locked
+		lockSecondPool bool
+		expRewards     []sdk.Coins
+	}{
+		{
+			name:           "rewards are split by volume",
+			isPerpetual:    true,
+			numEpochs:      1,
+			fooVolumes:     []int64{1000, 3000, 0},
+			lockSecondPool: true,
+			expRewards: []sdk.Coins{
+				{sdk.NewInt64Coin(defaultRewardDenom, 1000)},
+				{sdk.NewInt64Coin(defaultRewardDenom, 750)},
+				{sdk.NewInt64Coin(defaultRewardDenom, 2250)},
+			},
+		},
+		{
+			name:           "non perpetual gauges split the rewards of the epoch",
+			numEpochs:      2,
+			fooVolumes:     []int64{1000, 3000, 0},
+			lockSecondPool: true,
+			expRewards: []sdk.Coins{
+				{sdk.NewInt64Coin(defaultRewardDenom, 500)},
+				{sdk.NewInt64Coin(defaultRewardDenom, 375)},
+				{sdk.NewInt64Coin(defaultRewardDenom, 1125)},
+			},
+		},
+		{
+			name:        "pools without locks are left out",
+			isPerpetual: true,
+			numEpochs:   1,
+			fooVolumes:  []int64{1000, 3000, 0},
+			expRewards: []sdk.Coins{
+				{sdk.NewInt64Coin(defaultRewardDenom, 4000)},
+				{},
+				{},
+			},
+		},
+		{
+			name:           "nothing is distributed without volume",
+			isPerpetual:    true,
+			numEpochs:      1,
+			fooVolumes:     []int64{0, 0, 0},
+			lockSecondPool: true,
+			expRewards:     []sdk.Coins{{}, {}, {}},
+		},
+	}
+
+	for _, tc := range tests {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			poolIds := []uint64{suite.PrepareBalancerPool(), suite.PrepareBalancerPool(), suite.PrepareBalancerPool()}
+			for i, volume := range tc.fooVolumes {
+				if volume > 0 {
+					suite.swap(suite.TestAccs[1], poolIds[i], sdk.NewInt64Coin("foo", volume), "bar")
+				}
+			}
+
+			// the first pool's shares are locked by one account, and the second pool's shares by two accounts
+			lockers := []sdk.AccAddress{
+				sdk.AccAddress([]byte("addr1---------------")),
+				sdk.AccAddress([]byte("addr2---------------")),
+				sdk.AccAddress([]byte("addr3---------------")),
+			}
+			suite.LockTokens(lockers[0], sdk.NewCoins(sdk.NewInt64Coin(gammtypes.GetPoolShareDenom(poolIds[0]), 100)), defaultLockDuration)
+			if tc.lockSecondPool {
+				suite.LockTokens(lockers[1], sdk.NewCoins(sdk.NewInt64Coin(gammtypes.GetPoolShareDenom(poolIds[1]), 100)), defaultLockDuration)
+				suite.LockTokens(lockers[2], sdk.NewCoins(sdk.NewInt64Coin(gammtypes.GetPoolShareDenom(poolIds[1]), 300)), defaultLockDuration)
+			}
+
+			gaugeId := suite.createGroupGauge(tc.isPerpetual, sdk.AccAddress([]byte("Gauge_Creation_Addr_")), rewards, "foo", poolIds, tc.numEpochs)
+			gauge, err := suite.App.IncentivesKeeper.GetGaugeByID(suite.Ctx, gaugeId)
+			suite.Require().NoError(err)
+			suite.Require().True(gauge.IsGroupGauge())
+			err = suite.App.IncentivesKeeper.MoveUpcomingGaugeToActiveGauge(suite.Ctx, *gauge)
+			suite.Require().NoError(err)
+
+			distrCoins, err := suite.App.IncentivesKeeper.Distribute(suite.Ctx, []types.Gauge{*gauge})
+			suite.Require().NoError(err)
+
+			expDistrCoins := sdk.NewCoins()
+			for i, locker := range lockers {
+				suite.Require().Equal(tc.expRewards[i], sdk.NewCoins(suite.App.BankKeeper.GetBalance(suite.Ctx, locker, defaultRewardDenom)), "locker %d", i)
+				expDistrCoins = sdk.NewCoins(expDistrCoins.Add(tc.expRewards[i]...)...)
+			}
+			suite.Require().Equal(expDistrCoins, sdk.NewCoins(distrCoins...))
+
+			gauge, err = suite.App.IncentivesKeeper.GetGaugeByID(suite.Ctx, gaugeId)
+			suite.Require().NoError(err)
+			suite.Require().Equal(expDistrCoins, sdk.NewCoins(gauge.DistributedCoins...))
+		})
+	}
+}
+
+// TestGroupGaugeDistributionLargeAmounts tests group gauges distribute large rewards by large volumes to large locks,
+// whose product would overflow.
+func (suite *KeeperTestSuite) TestGroupGaugeDistributionLargeAmounts() {
+	suite.SetupTest()
+	poolIds := []uint64{suite.PrepareBalancerPool(), suite.PrepareBalancerPool()}
+	large, ok := sdk.NewIntFromString("1000000000000000000000000000000") // 1e30
+	suite.Require().True(ok)
+
+	lockers := []sdk.AccAddress{
+		sdk.AccAddress([]byte("addr1---------------")),
+		sdk.AccAddress([]byte("addr2---------------")),
+	}
+	for i, poolId := range poolIds {
+		suite.App.IncentivesKeeper.SetPoolVolume(suite.Ctx, types.PoolVolume{PoolId: poolId, Denom: "foo", Volume: large.Mul(large)})
+		suite.LockTokens(lockers[i], sdk.NewCoins(sdk.NewCoin(gammtypes.GetPoolShareDenom(poolId), large)), defaultLockDuration)
+	}
+
+	rewards := sdk.NewCoins(sdk.NewCoin(defaultRewardDenom, large))
+	gaugeId := suite.createGroupGauge(true, sdk.AccAddress([]byte("Gauge_Creation_Addr_")), rewards, "foo", poolIds, 1)
+	gauge, err := suite.App.IncentivesKeeper.GetGaugeByID(suite.Ctx, gaugeId)
+	suite.Require().NoError(err)
+	err = suite.App.IncentivesKeeper.MoveUpcomingGaugeToActiveGauge(suite.Ctx, *gauge)
+	suite.Require().NoError(err)
+
+	distrCoins, err := suite.App.IncentivesKeeper.Distribute(suite.Ctx, []types.Gauge{*gauge})
+	suite.Require().NoError(err)
+	suite.Require().Equal(rewards, distrCoins)
+	for _, locker := range lockers {
+		suite.Require().Equal(large.QuoRaw(2), suite.App.BankKeeper.GetBalance(suite.Ctx, locker, defaultRewardDenom).Amount)
+	}
+}
+
+// TestGroupGaugeDistributionMixedDenomPools tests group gauges split their rewards by the volume of the gauge's denom only,
+// when their pools hold and trade different denoms.
+func (suite *KeeperTestSuite) TestGroupGaugeDistributionMixedDenomPools() {
+	suite.SetupTest()
+	poolIds := []uint64{
+		suite.PrepareBalancerPoolWithCoins(sdk.NewInt64Coin("foo", 1000000), sdk.NewInt64Coin("bar", 1000000)),
+		suite.PrepareBalancerPoolWithCoins(sdk.NewInt64Coin("foo", 1000000), sdk.NewInt64Coin("baz", 1000000), sdk.NewInt64Coin("qux", 1000000)),
+		suite.PrepareBalancerPoolWithCoins(sdk.NewInt64Coin("bar", 1000000), sdk.NewInt64Coin("baz", 1000000)),
+	}
+
+	// the volumes of the other denoms are much larger than the volumes of foo, but are not counted
+	suite.swap(suite.TestAccs[1], poolIds[0], sdk.NewInt64Coin("foo", 1000), "bar")
+	suite.swap(suite.TestAccs[1], poolIds[1], sdk.NewInt64Coin("foo", 3000), "qux")
+	suite.swap(suite.TestAccs[1], poolIds[1], sdk.NewInt64Coin("baz", 100000), "qux")
+	suite.swap(suite.TestAccs[1], poolIds[2], sdk.NewInt64Coin("bar", 100000), "baz")
+
+	lockers := []sdk.AccAddress{
+		sdk.AccAddress([]byte("addr1---------------")),
+		sdk.AccAddress([]byte("addr2---------------")),
+		sdk.AccAddress([]byte("addr3---------------")),
+	}
+	for i, poolId := range poolIds {
+		suite.LockTokens(lockers[i], sdk.NewCoins(sdk.NewInt64Coin(gammtypes.GetPoolShareDenom(poolId), 100)), defaultLockDuration)
+	}
+
+	rewards := sdk.Coins{sdk.NewInt64Coin(defaultRewardDenom, 4000)}
+	gaugeId := suite.createGroupGauge(true, sdk.AccAddress([]byte("Gauge_Creation_Addr_")), rewards, "foo", poolIds, 1)
+	gauge, err := suite.App.IncentivesKeeper.GetGaugeByID(suite.Ctx, gaugeId)
+	suite.Require().NoError(err)
+	err = suite.App.IncentivesKeeper.MoveUpcomingGaugeToActiveGauge(suite.Ctx, *gauge)
+	suite.Require().NoError(err)
+
+	distrCoins, err := suite.App.IncentivesKeeper.Distribute(suite.Ctx, []types.Gauge{*gauge})
+	suite.Require().NoError(err)
+	suite.Require().Equal(rewards, distrCoins)
+	expRewards := []int64{1000, 3000, 0}
+	for i, locker := range lockers {
+		suite.Require().Equal(sdk.NewInt(expRewards[i]), suite.App.BankKeeper.GetBalance(suite.Ctx, locker, defaultRewardDenom).Amount)
+	}
+}
+
+// TestGroupGaugeCreationValidation tests group gauges can only be created for existing and distinct pools, distributing to locks by duration.
+func (suite *KeeperTestSuite) TestGroupGaugeCreationValidation() {
+	suite.SetupTest()
+	poolIds := []uint64{suite.PrepareBalancerPool(), suite.PrepareBalancerPool()}
+	addr := suite.TestAccs[1]
+	suite.FundAcc(addr, defaultLiquidTokens)
+
+	distrTo := lockuptypes.QueryCondition{
+		LockQueryType: lockuptypes.ByDuration,
+		Denom:         "foo",
+		Duration:      defaultLockDuration,
+	}
+	tooManyPoolIds := []uint64{}
+	for i := 0; i <= types.MaxGroupGaugePools; i++ {
+		tooManyPoolIds = append(tooManyPoolIds, suite.PrepareBalancerPool())
+	}
+	tests := []struct {
+		name    string
+		distrTo lockuptypes.QueryCondition
+		poolIds []uint64
+		expPass bool
+	}{
+		{
+			name:    "group gauge",
+			distrTo: distrTo,
+			poolIds: poolIds,
+			expPass: true,
+		},
+		{
+			name:    "no pools",
+			distrTo: distrTo,
+		},
+		{
+			name:    "duplicate pools",
+			distrTo: distrTo,
+			poolIds: []uint64{poolIds[0], poolIds[0]},
+		},
+		{
+			name:    "too many pools",
+			distrTo: distrTo,
+			poolIds: tooManyPoolIds,
+		},
+		{
+			name:    "pool does not exist",
+			distrTo: distrTo,
+			poolIds: []uint64{poolIds[0], 1000},
+		},
+		{
+			name: "no lock query condition",
+			distrTo: lockuptypes.QueryCondition{
+				LockQueryType: lockuptypes.NoLock,
+				Denom:         gammtypes.GetPoolShareDenom(poolIds[0]),
+			},
+			poolIds: poolIds,
+		},
+	}
+
+	for _, tc := range tests {
+		_, err := suite.App.IncentivesKeeper.CreateGroupGauge(suite.Ctx, true, addr, sdk.Coins{}, tc.distrTo, tc.poolIds, suite.Ctx.BlockTime(), 1)
+		if tc.expPass {
+			suite.Require().NoError(err, tc.name)
+		} else {
+			suite.Require().Error(err, tc.name)
+		}
+	}
+}
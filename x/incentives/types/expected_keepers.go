@@ -5,6 +5,7 @@ import (
 
 	epochstypes "github.com/osmosis-labs/osmosis/v13/x/epochs/types"
 	lockuptypes "github.com/osmosis-labs/osmosis/v13/x/lockup/types"
+	swaproutertypes "github.com/osmosis-labs/osmosis/v13/x/swaprouter/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -39,6 +40,11 @@ type CommunityPoolKeeper interface {
 	FundCommunityPool(ctx sdk.Context, amount sdk.Coins, sender sdk.AccAddress) error
 }
 
+// SwapRouterKeeper defines the expected interface needed to retrieve the pools of any pool module.
+type SwapRouterKeeper interface {
+	GetPool(ctx sdk.Context, poolId uint64) (swaproutertypes.PoolI, error)
+}
+
 // TxFeesKeeper defines the expected interface needed to managing transaction fees.
 type TxFeesKeeper interface {
 	GetBaseDenom(ctx sdk.Context) (denom string, err error)
@@ -46,3 +46,12 @@ func (gauge Gauge) IsActiveGauge(curTime time.Time) bool {
 func (gauge Gauge) IsFinishedGauge(curTime time.Time) bool {
 	return !gauge.IsUpcomingGauge(curTime) && !gauge.IsActiveGauge(curTime)
 }
+
+// MaxGroupGaugePools is the maximum number of pools a group gauge can split its rewards across,
+// as the locks of each of its pools are read at every distribution.
+const MaxGroupGaugePools = 20
+
+// IsGroupGauge returns true if the gauge splits its rewards across several pools by their trading volume.
+func (gauge Gauge) IsGroupGauge() bool {
+	return len(gauge.PoolIds) > 0
+}
@@ -58,6 +58,11 @@ type Gauge struct {
 	FilledEpochs uint64 `protobuf:"varint,7,opt,name=filled_epochs,json=filledEpochs,proto3" json:"filled_epochs,omitempty"`
 	// distributed_coins are coins that have been distributed already
 	DistributedCoins github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,8,rep,name=distributed_coins,json=distributedCoins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"distributed_coins"`
+	// pool_ids are the pools of a group gauge. Group gauges split each epoch's
+	// rewards across their pools by their relative trading volume of
+	// distribute_to.denom over the epoch, and distribute the rewards of each pool
+	// to the locks of its shares of at least distribute_to.duration.
+	PoolIds []uint64 `protobuf:"varint,9,rep,packed,name=pool_ids,json=poolIds,proto3" json:"pool_ids,omitempty" yaml:"pool_ids"`
 }
 
 func (m *Gauge) Reset()         { *m = Gauge{} }
@@ -149,6 +154,85 @@ func (m *Gauge) GetDistributedCoins() github_com_cosmos_cosmos_sdk_types.Coins {
 	return nil
 }
 
+func (m *Gauge) GetPoolIds() []uint64 {
+	if m != nil {
+		return m.PoolIds
+	}
+	return nil
+}
+
+// PoolShareHolding tracks the unlocked shares of a pool held by an account,
+// to distribute the rewards of NoLock gauges pro rata to the shares held over
+// the epoch.
+type PoolShareHolding struct {
+	// pool_id is the ID of the pool of the shares
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+	// address is the account holding the shares
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty" yaml:"address"`
+	// shares is the amount of unlocked shares held by the account as of
+	// last_update_time
+	Shares github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=shares,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"shares"`
+	// last_update_time is the time the holding was last updated at
+	LastUpdateTime time.Time `protobuf:"bytes,4,opt,name=last_update_time,json=lastUpdateTime,proto3,stdtime" json:"last_update_time" yaml:"last_update_time"`
+	// accumulator is the sum of the shares held multiplied by the nanoseconds
+	// they were held for, from the last distribution to last_update_time
+	Accumulator github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,5,opt,name=accumulator,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"accumulator"`
+}
+
+func (m *PoolShareHolding) Reset()         { *m = PoolShareHolding{} }
+func (m *PoolShareHolding) String() string { return proto.CompactTextString(m) }
+func (*PoolShareHolding) ProtoMessage()    {}
+func (*PoolShareHolding) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c0304e2bb0159901, []int{1}
+}
+func (m *PoolShareHolding) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PoolShareHolding) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PoolShareHolding.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PoolShareHolding) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PoolShareHolding.Merge(m, src)
+}
+func (m *PoolShareHolding) XXX_Size() int {
+	return m.Size()
+}
+func (m *PoolShareHolding) XXX_DiscardUnknown() {
+	xxx_messageInfo_PoolShareHolding.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PoolShareHolding proto.InternalMessageInfo
+
+func (m *PoolShareHolding) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+func (m *PoolShareHolding) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *PoolShareHolding) GetLastUpdateTime() time.Time {
+	if m != nil {
+		return m.LastUpdateTime
+	}
+	return time.Time{}
+}
+
 type LockableDurationsInfo struct {
 	// List of incentivised durations that gauges will pay out to
 	LockableDurations []time.Duration `protobuf:"bytes,1,rep,name=lockable_durations,json=lockableDurations,proto3,stdduration" json:"lockable_durations" yaml:"lockable_durations"`
@@ -158,7 +242,7 @@ func (m *LockableDurationsInfo) Reset()         { *m = LockableDurationsInfo{} }
 func (m *LockableDurationsInfo) String() string { return proto.CompactTextString(m) }
 func (*LockableDurationsInfo) ProtoMessage()    {}
 func (*LockableDurationsInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c0304e2bb0159901, []int{1}
+	return fileDescriptor_c0304e2bb0159901, []int{2}
 }
 func (m *LockableDurationsInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -194,50 +278,124 @@ func (m *LockableDurationsInfo) GetLockableDurations() []time.Duration {
 	return nil
 }
 
+// PoolVolume tracks the trading volume of a denom in a pool over the current
+// epoch, to split the rewards of group gauges across their pools.
+type PoolVolume struct {
+	// pool_id is the ID of the pool the denom was traded in
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+	// denom is the denom traded
+	Denom string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
+	// volume is the amount of the denom swapped in and out of the pool since the
+	// last distribution
+	Volume github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=volume,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"volume"`
+}
+
+func (m *PoolVolume) Reset()         { *m = PoolVolume{} }
+func (m *PoolVolume) String() string { return proto.CompactTextString(m) }
+func (*PoolVolume) ProtoMessage()    {}
+func (*PoolVolume) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c0304e2bb0159901, []int{3}
+}
+func (m *PoolVolume) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PoolVolume) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PoolVolume.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PoolVolume) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PoolVolume.Merge(m, src)
+}
+func (m *PoolVolume) XXX_Size() int {
+	return m.Size()
+}
+func (m *PoolVolume) XXX_DiscardUnknown() {
+	xxx_messageInfo_PoolVolume.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PoolVolume proto.InternalMessageInfo
+
+func (m *PoolVolume) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+func (m *PoolVolume) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*Gauge)(nil), "osmosis.incentives.Gauge")
+	proto.RegisterType((*PoolShareHolding)(nil), "osmosis.incentives.PoolShareHolding")
 	proto.RegisterType((*LockableDurationsInfo)(nil), "osmosis.incentives.LockableDurationsInfo")
+	proto.RegisterType((*PoolVolume)(nil), "osmosis.incentives.PoolVolume")
 }
 
 func init() { proto.RegisterFile("osmosis/incentives/gauge.proto", fileDescriptor_c0304e2bb0159901) }
 
 var fileDescriptor_c0304e2bb0159901 = []byte{
-	// 545 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x93, 0xcf, 0x6e, 0xd3, 0x30,
-	0x1c, 0xc7, 0x9b, 0xad, 0x1d, 0xc3, 0xed, 0x10, 0xb5, 0x86, 0x94, 0x56, 0x22, 0x2d, 0x45, 0x48,
-	0xbd, 0xcc, 0xa6, 0x9b, 0xc4, 0x81, 0x63, 0x07, 0x42, 0x93, 0x90, 0x28, 0xd5, 0x0e, 0x88, 0x4b,
-	0xe4, 0x24, 0x6e, 0x66, 0x35, 0xc9, 0x2f, 0x8a, 0x9d, 0x6a, 0x7d, 0x03, 0x8e, 0x13, 0x27, 0x9e,
-	0x81, 0x27, 0xd9, 0x71, 0x47, 0x4e, 0x1b, 0x6a, 0xdf, 0x80, 0x27, 0x40, 0xb1, 0x13, 0xb5, 0x2a,
-	0x57, 0x4e, 0x8e, 0x7f, 0xdf, 0xdf, 0xbf, 0xef, 0x47, 0x0e, 0x72, 0x40, 0xc6, 0x20, 0x85, 0xa4,
-	0x22, 0xf1, 0x79, 0xa2, 0xc4, 0x82, 0x4b, 0x1a, 0xb2, 0x3c, 0xe4, 0x24, 0xcd, 0x40, 0x01, 0xc6,
-	0xa5, 0x4e, 0x36, 0x7a, 0xf7, 0x38, 0x84, 0x10, 0xb4, 0x4c, 0x8b, 0x2f, 0x93, 0xd9, 0x75, 0x42,
-	0x80, 0x30, 0xe2, 0x54, 0xdf, 0xbc, 0x7c, 0x46, 0x83, 0x3c, 0x63, 0x4a, 0x40, 0x52, 0xea, 0xbd,
-	0x5d, 0x5d, 0x89, 0x98, 0x4b, 0xc5, 0xe2, 0xb4, 0x6a, 0xe0, 0xeb, 0x59, 0xd4, 0x63, 0x92, 0xd3,
-	0xc5, 0xc8, 0xe3, 0x8a, 0x8d, 0xa8, 0x0f, 0xa2, 0x6a, 0xd0, 0xa9, 0x56, 0x8d, 0xc0, 0x9f, 0xe7,
-	0xa9, 0x3e, 0x8c, 0x34, 0xf8, 0x5e, 0x47, 0x8d, 0x0f, 0xc5, 0xd6, 0xf8, 0x09, 0xda, 0x13, 0x81,
-	0x6d, 0xf5, 0xad, 0x61, 0x7d, 0xba, 0x27, 0x02, 0xfc, 0x02, 0xb5, 0x84, 0x74, 0x53, 0x9e, 0xa5,
-	0x5c, 0xe5, 0x2c, 0xb2, 0xf7, 0xfa, 0xd6, 0xf0, 0x70, 0xda, 0x14, 0x72, 0x52, 0x85, 0xf0, 0x05,
-	0x3a, 0x0a, 0x84, 0x54, 0x99, 0xf0, 0x72, 0xc5, 0x5d, 0x05, 0xf6, 0x7e, 0xdf, 0x1a, 0x36, 0x4f,
-	0x1d, 0x52, 0x59, 0x37, 0xf3, 0xc8, 0xe7, 0x9c, 0x67, 0xcb, 0x73, 0x48, 0x02, 0x51, 0xb8, 0x1a,
-	0xd7, 0x6f, 0xef, 0x7b, 0xb5, 0x69, 0x6b, 0x53, 0x7a, 0x09, 0x98, 0xa1, 0x46, 0xb1, 0xb0, 0xb4,
-	0xeb, 0xfd, 0xfd, 0x61, 0xf3, 0xb4, 0x43, 0x8c, 0x25, 0x52, 0x58, 0x22, 0xa5, 0x25, 0x72, 0x0e,
-	0x22, 0x19, 0xbf, 0x2e, 0xaa, 0x7f, 0x3e, 0xf4, 0x86, 0xa1, 0x50, 0x57, 0xb9, 0x47, 0x7c, 0x88,
-	0x69, 0xe9, 0xdf, 0x1c, 0x27, 0x32, 0x98, 0x53, 0xb5, 0x4c, 0xb9, 0xd4, 0x05, 0x72, 0x6a, 0x3a,
-	0xe3, 0x2f, 0x08, 0x49, 0xc5, 0x32, 0xe5, 0x16, 0xf8, 0xec, 0x86, 0x5e, 0xb5, 0x4b, 0x0c, 0x5b,
-	0x52, 0xb1, 0x25, 0x97, 0x15, 0xdb, 0xf1, 0xf3, 0x62, 0xd0, 0x9f, 0xfb, 0x5e, 0x7b, 0xc9, 0xe2,
-	0xe8, 0xed, 0x60, 0x53, 0x3b, 0xb8, 0x79, 0xe8, 0x59, 0xd3, 0xc7, 0x3a, 0x50, 0xa4, 0x63, 0x8a,
-	0x8e, 0x93, 0x3c, 0x76, 0x79, 0x0a, 0xfe, 0x95, 0x74, 0x53, 0x26, 0x02, 0x17, 0x16, 0x3c, 0xb3,
-	0x0f, 0x34, 0xcc, 0x76, 0x92, 0xc7, 0xef, 0xb5, 0x34, 0x61, 0x22, 0xf8, 0xb4, 0xe0, 0x19, 0x7e,
-	0x89, 0x8e, 0x66, 0x22, 0x8a, 0x78, 0x50, 0xd6, 0xd8, 0x8f, 0x74, 0x66, 0xcb, 0x04, 0x4d, 0x32,
-	0xbe, 0x46, 0xed, 0x0d, 0xa2, 0xc0, 0x35, 0x78, 0x0e, 0xff, 0x3f, 0x9e, 0xa7, 0x5b, 0x53, 0x74,
-	0x64, 0xf0, 0xcd, 0x42, 0xcf, 0x3e, 0x82, 0x3f, 0x67, 0x5e, 0xc4, 0xdf, 0x95, 0x6f, 0x51, 0x5e,
-	0x24, 0x33, 0xc0, 0x80, 0x70, 0x54, 0x0a, 0x6e, 0xf5, 0x4a, 0xa5, 0x6d, 0x95, 0x4b, 0xed, 0xb2,
-	0xac, 0x6a, 0xc7, 0xaf, 0x4a, 0x94, 0x1d, 0x83, 0xf2, 0xdf, 0x16, 0x83, 0x1f, 0x05, 0xd2, 0x76,
-	0xb4, 0x3b, 0x74, 0x3c, 0xb9, 0x5d, 0x39, 0xd6, 0xdd, 0xca, 0xb1, 0x7e, 0xaf, 0x1c, 0xeb, 0x66,
-	0xed, 0xd4, 0xee, 0xd6, 0x4e, 0xed, 0xd7, 0xda, 0xa9, 0x7d, 0x7d, 0xb3, 0x65, 0xb0, 0x7c, 0x6f,
-	0x27, 0x11, 0xf3, 0x64, 0x75, 0xa1, 0x8b, 0xd1, 0x19, 0xbd, 0xde, 0xfe, 0x3b, 0xb5, 0x69, 0xef,
-	0x40, 0xaf, 0x77, 0xf6, 0x37, 0x00, 0x00, 0xff, 0xff, 0x58, 0x36, 0xb0, 0xbf, 0xc0, 0x03, 0x00,
-	0x00,
+	// 755 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0x4f, 0x6f, 0xe3, 0x44,
+	0x14, 0xaf, 0xdb, 0xa4, 0x6d, 0x26, 0xd9, 0x92, 0x0c, 0x8b, 0x70, 0x2b, 0x61, 0x07, 0xaf, 0x58,
+	0x45, 0x82, 0xb5, 0xe9, 0xae, 0xc4, 0x81, 0xa3, 0x97, 0x7f, 0x91, 0x90, 0x08, 0x66, 0x41, 0x88,
+	0x8b, 0x35, 0xf6, 0x4c, 0xdd, 0x51, 0xc7, 0x1e, 0xcb, 0x33, 0x8e, 0xb6, 0xdf, 0x80, 0xe3, 0x1e,
+	0xf9, 0x0a, 0xf0, 0x31, 0x38, 0xed, 0x71, 0x8f, 0x88, 0x43, 0x16, 0xb5, 0xdf, 0x20, 0x9f, 0x00,
+	0xcd, 0x1f, 0x2b, 0x21, 0x1c, 0x80, 0x8a, 0x93, 0x3d, 0xef, 0xf7, 0xde, 0xef, 0xbd, 0xdf, 0x6f,
+	0x9e, 0x06, 0x78, 0x5c, 0x94, 0x5c, 0x50, 0x11, 0xd1, 0x2a, 0x27, 0x95, 0xa4, 0x4b, 0x22, 0xa2,
+	0x02, 0xb5, 0x05, 0x09, 0xeb, 0x86, 0x4b, 0x0e, 0xa1, 0xc5, 0xc3, 0x0d, 0x7e, 0x76, 0xbf, 0xe0,
+	0x05, 0xd7, 0x70, 0xa4, 0xfe, 0x4c, 0xe6, 0x99, 0x57, 0x70, 0x5e, 0x30, 0x12, 0xe9, 0x53, 0xd6,
+	0x5e, 0x44, 0xb8, 0x6d, 0x90, 0xa4, 0xbc, 0xb2, 0xb8, 0xbf, 0x8b, 0x4b, 0x5a, 0x12, 0x21, 0x51,
+	0x59, 0x77, 0x04, 0xb9, 0xee, 0x15, 0x65, 0x48, 0x90, 0x68, 0x79, 0x9e, 0x11, 0x89, 0xce, 0xa3,
+	0x9c, 0xd3, 0x8e, 0xe0, 0xb4, 0x1b, 0x95, 0xf1, 0xfc, 0xaa, 0xad, 0xf5, 0xc7, 0x40, 0xc1, 0xaf,
+	0x3d, 0xd0, 0xff, 0x5c, 0x4d, 0x0d, 0x4f, 0xc0, 0x3e, 0xc5, 0xae, 0x33, 0x75, 0x66, 0xbd, 0x64,
+	0x9f, 0x62, 0xf8, 0x2e, 0x18, 0x51, 0x91, 0xd6, 0xa4, 0xa9, 0x89, 0x6c, 0x11, 0x73, 0xf7, 0xa7,
+	0xce, 0xec, 0x38, 0x19, 0x52, 0xb1, 0xe8, 0x42, 0x70, 0x0e, 0xee, 0x61, 0x2a, 0x64, 0x43, 0xb3,
+	0x56, 0x92, 0x54, 0x72, 0xf7, 0x60, 0xea, 0xcc, 0x86, 0x8f, 0xbd, 0xb0, 0x93, 0x6e, 0xfa, 0x85,
+	0x5f, 0xb7, 0xa4, 0xb9, 0x7e, 0xca, 0x2b, 0x4c, 0x95, 0xaa, 0xb8, 0xf7, 0x72, 0xe5, 0xef, 0x25,
+	0xa3, 0x4d, 0xe9, 0x33, 0x0e, 0x11, 0xe8, 0xab, 0x81, 0x85, 0xdb, 0x9b, 0x1e, 0xcc, 0x86, 0x8f,
+	0x4f, 0x43, 0x23, 0x29, 0x54, 0x92, 0x42, 0x2b, 0x29, 0x7c, 0xca, 0x69, 0x15, 0x7f, 0xa8, 0xaa,
+	0x7f, 0x79, 0xed, 0xcf, 0x0a, 0x2a, 0x2f, 0xdb, 0x2c, 0xcc, 0x79, 0x19, 0x59, 0xfd, 0xe6, 0xf3,
+	0x48, 0xe0, 0xab, 0x48, 0x5e, 0xd7, 0x44, 0xe8, 0x02, 0x91, 0x18, 0x66, 0xf8, 0x3d, 0x00, 0x42,
+	0xa2, 0x46, 0xa6, 0xca, 0x3e, 0xb7, 0xaf, 0x47, 0x3d, 0x0b, 0x8d, 0xb7, 0x61, 0xe7, 0x6d, 0xf8,
+	0xac, 0xf3, 0x36, 0x7e, 0x47, 0x35, 0x5a, 0xaf, 0xfc, 0xc9, 0x35, 0x2a, 0xd9, 0xc7, 0xc1, 0xa6,
+	0x36, 0x78, 0xf1, 0xda, 0x77, 0x92, 0x81, 0x0e, 0xa8, 0x74, 0x18, 0x81, 0xfb, 0x55, 0x5b, 0xa6,
+	0xa4, 0xe6, 0xf9, 0xa5, 0x48, 0x6b, 0x44, 0x71, 0xca, 0x97, 0xa4, 0x71, 0x0f, 0xb5, 0x99, 0x93,
+	0xaa, 0x2d, 0x3f, 0xd5, 0xd0, 0x02, 0x51, 0xfc, 0xd5, 0x92, 0x34, 0xf0, 0x01, 0xb8, 0x77, 0x41,
+	0x19, 0x23, 0xd8, 0xd6, 0xb8, 0x47, 0x3a, 0x73, 0x64, 0x82, 0x26, 0x19, 0x3e, 0x07, 0x93, 0x8d,
+	0x45, 0x38, 0x35, 0xf6, 0x1c, 0xff, 0xff, 0xf6, 0x8c, 0xb7, 0xba, 0xe8, 0x08, 0x0c, 0xc1, 0x71,
+	0xcd, 0x39, 0x4b, 0x29, 0x16, 0xee, 0x60, 0x7a, 0x30, 0xeb, 0xc5, 0x6f, 0xae, 0x57, 0xfe, 0x1b,
+	0xc6, 0x87, 0x0e, 0x09, 0x92, 0x23, 0xf5, 0x3b, 0xc7, 0x22, 0x58, 0xef, 0x83, 0xf1, 0x82, 0x73,
+	0xf6, 0xcd, 0x25, 0x6a, 0xc8, 0x17, 0x9c, 0x61, 0x5a, 0x15, 0xf0, 0x7d, 0x70, 0x64, 0x53, 0xcd,
+	0x52, 0xc5, 0x70, 0xbd, 0xf2, 0x4f, 0xfe, 0xc2, 0x11, 0x24, 0x87, 0x86, 0x02, 0x7e, 0x00, 0x8e,
+	0x10, 0xc6, 0x0d, 0x11, 0x42, 0xef, 0xd9, 0x60, 0x3b, 0xd9, 0x02, 0x41, 0xd2, 0xa5, 0xc0, 0xcf,
+	0xc0, 0xa1, 0x50, 0xad, 0x84, 0x5e, 0xb8, 0x41, 0x1c, 0x2a, 0xcd, 0xbf, 0xaf, 0xfc, 0x87, 0xff,
+	0x42, 0xf3, 0xbc, 0x92, 0x89, 0xad, 0x86, 0x14, 0x8c, 0x19, 0x12, 0x32, 0x6d, 0x6b, 0x8c, 0x24,
+	0x31, 0x7b, 0xd1, 0xfb, 0xc7, 0xbd, 0x78, 0x60, 0xf7, 0xe2, 0x6d, 0x33, 0xde, 0x2e, 0x83, 0xd9,
+	0x8e, 0x13, 0x15, 0xfe, 0x56, 0x47, 0xf5, 0x8a, 0x2c, 0xc0, 0x10, 0xe5, 0x79, 0x5b, 0xb6, 0x0c,
+	0x49, 0xde, 0xb8, 0xfd, 0x3b, 0xcd, 0xbd, 0x4d, 0x11, 0xfc, 0xe8, 0x80, 0xb7, 0xbe, 0xe4, 0xf9,
+	0x15, 0xca, 0x18, 0xf9, 0xc4, 0x3e, 0x18, 0x62, 0x5e, 0x5d, 0x70, 0xc8, 0x01, 0x64, 0x16, 0x48,
+	0xbb, 0xa7, 0x44, 0xb8, 0x8e, 0xdd, 0x9c, 0x5d, 0x61, 0x5d, 0x6d, 0xfc, 0x9e, 0xd5, 0x75, 0x6a,
+	0x75, 0xfd, 0x8d, 0x22, 0xf8, 0x49, 0x29, 0x9b, 0xb0, 0xdd, 0xa6, 0xc1, 0xcf, 0x0e, 0x00, 0xea,
+	0xfe, 0xbf, 0xe3, 0xac, 0x2d, 0xc9, 0x7f, 0xbb, 0xf9, 0x87, 0xa0, 0x8f, 0x49, 0xc5, 0x4b, 0x7b,
+	0xef, 0xe3, 0xf5, 0xca, 0x1f, 0x99, 0x54, 0x1d, 0x0e, 0x12, 0x03, 0xab, 0x3b, 0x5f, 0x6a, 0xfa,
+	0xbb, 0xde, 0xb9, 0xa9, 0x8e, 0x17, 0x2f, 0x6f, 0x3c, 0xe7, 0xd5, 0x8d, 0xe7, 0xfc, 0x71, 0xe3,
+	0x39, 0x2f, 0x6e, 0xbd, 0xbd, 0x57, 0xb7, 0xde, 0xde, 0x6f, 0xb7, 0xde, 0xde, 0x0f, 0x1f, 0x6d,
+	0x31, 0xd9, 0x07, 0xec, 0x11, 0x43, 0x99, 0xe8, 0x0e, 0xd1, 0xf2, 0xfc, 0x49, 0xf4, 0x7c, 0xfb,
+	0xb9, 0xd7, 0xec, 0xd9, 0xa1, 0xb6, 0xf2, 0xc9, 0x9f, 0x03, 0x00, 0xb3, 0xdb, 0x7e, 0xfe, 0x11,
+	0x06, 0x00, 0x00,
 }
 
 func (m *Gauge) Marshal() (dAtA []byte, err error) {
@@ -260,6 +418,24 @@ func (m *Gauge) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.PoolIds) > 0 {
+		dAtA2 := make([]byte, len(m.PoolIds)*10)
+		var j1 int
+		for _, num := range m.PoolIds {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintGauge(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0x4a
+	}
 	if len(m.DistributedCoins) > 0 {
 		for iNdEx := len(m.DistributedCoins) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -284,12 +460,12 @@ func (m *Gauge) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x30
 	}
-	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime):])
-	if err1 != nil {
-		return 0, err1
+	n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime):])
+	if err3 != nil {
+		return 0, err3
 	}
-	i -= n1
-	i = encodeVarintGauge(dAtA, i, uint64(n1))
+	i -= n3
+	i = encodeVarintGauge(dAtA, i, uint64(n3))
 	i--
 	dAtA[i] = 0x2a
 	if len(m.Coins) > 0 {
@@ -334,6 +510,69 @@ func (m *Gauge) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *PoolShareHolding) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PoolShareHolding) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PoolShareHolding) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Accumulator.Size()
+		i -= size
+		if _, err := m.Accumulator.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGauge(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	n5, err5 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.LastUpdateTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.LastUpdateTime):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintGauge(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Shares.Size()
+		i -= size
+		if _, err := m.Shares.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGauge(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintGauge(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintGauge(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *LockableDurationsInfo) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -369,6 +608,51 @@ func (m *LockableDurationsInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *PoolVolume) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PoolVolume) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PoolVolume) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Volume.Size()
+		i -= size
+		if _, err := m.Volume.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGauge(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintGauge(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintGauge(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGauge(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGauge(v)
 	base := offset
@@ -414,6 +698,35 @@ func (m *Gauge) Size() (n int) {
 			n += 1 + l + sovGauge(uint64(l))
 		}
 	}
+	if len(m.PoolIds) > 0 {
+		l = 0
+		for _, e := range m.PoolIds {
+			l += sovGauge(uint64(e))
+		}
+		n += 1 + sovGauge(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *PoolShareHolding) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovGauge(uint64(m.PoolId))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovGauge(uint64(l))
+	}
+	l = m.Shares.Size()
+	n += 1 + l + sovGauge(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.LastUpdateTime)
+	n += 1 + l + sovGauge(uint64(l))
+	l = m.Accumulator.Size()
+	n += 1 + l + sovGauge(uint64(l))
 	return n
 }
 
@@ -432,6 +745,24 @@ func (m *LockableDurationsInfo) Size() (n int) {
 	return n
 }
 
+func (m *PoolVolume) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovGauge(uint64(m.PoolId))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovGauge(uint64(l))
+	}
+	l = m.Volume.Size()
+	n += 1 + l + sovGauge(uint64(l))
+	return n
+}
+
 func sovGauge(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -678,6 +1009,284 @@ func (m *Gauge) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 9:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGauge
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.PoolIds = append(m.PoolIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGauge
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthGauge
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthGauge
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.PoolIds) == 0 {
+					m.PoolIds = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGauge
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.PoolIds = append(m.PoolIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolIds", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGauge(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGauge
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PoolShareHolding) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGauge
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PoolShareHolding: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PoolShareHolding: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGauge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGauge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGauge
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGauge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Shares", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGauge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGauge
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGauge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Shares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastUpdateTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGauge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGauge
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGauge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.LastUpdateTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Accumulator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGauge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGauge
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGauge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Accumulator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGauge(dAtA[iNdEx:])
@@ -783,6 +1392,141 @@ func (m *LockableDurationsInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *PoolVolume) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGauge
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PoolVolume: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PoolVolume: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGauge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGauge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGauge
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGauge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Volume", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGauge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGauge
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGauge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Volume.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGauge(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGauge
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipGauge(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
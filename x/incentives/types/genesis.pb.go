@@ -40,6 +40,15 @@ type GenesisState struct {
 	// last_gauge_id is what the gauge number will increment from when creating
 	// the next gauge after genesis
 	LastGaugeId uint64 `protobuf:"varint,4,opt,name=last_gauge_id,json=lastGaugeId,proto3" json:"last_gauge_id,omitempty"`
+	// pool_share_holdings are the unlocked pool shares held by accounts, tracked
+	// for NoLock gauges
+	PoolShareHoldings []PoolShareHolding `protobuf:"bytes,5,rep,name=pool_share_holdings,json=poolShareHoldings,proto3" json:"pool_share_holdings"`
+	// last_distribution_time is the time of the last epoch distribution, from
+	// which NoLock gauges weight the pool shares held
+	LastDistributionTime time.Time `protobuf:"bytes,6,opt,name=last_distribution_time,json=lastDistributionTime,proto3,stdtime" json:"last_distribution_time" yaml:"last_distribution_time"`
+	// pool_volumes are the trading volumes of denoms in pools over the current
+	// epoch, tracked for group gauges
+	PoolVolumes []PoolVolume `protobuf:"bytes,7,rep,name=pool_volumes,json=poolVolumes,proto3" json:"pool_volumes"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -103,6 +112,27 @@ func (m *GenesisState) GetLastGaugeId() uint64 {
 	return 0
 }
 
+func (m *GenesisState) GetPoolShareHoldings() []PoolShareHolding {
+	if m != nil {
+		return m.PoolShareHoldings
+	}
+	return nil
+}
+
+func (m *GenesisState) GetLastDistributionTime() time.Time {
+	if m != nil {
+		return m.LastDistributionTime
+	}
+	return time.Time{}
+}
+
+func (m *GenesisState) GetPoolVolumes() []PoolVolume {
+	if m != nil {
+		return m.PoolVolumes
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "osmosis.incentives.GenesisState")
 }
@@ -110,29 +140,36 @@ func init() {
 func init() { proto.RegisterFile("osmosis/incentives/genesis.proto", fileDescriptor_a288ccc95d977d2d) }
 
 var fileDescriptor_a288ccc95d977d2d = []byte{
-	// 341 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x90, 0xb1, 0x6e, 0xfa, 0x30,
-	0x10, 0xc6, 0x13, 0x40, 0x0c, 0xe1, 0xff, 0x1f, 0x6a, 0x75, 0x08, 0x0c, 0x4e, 0x14, 0xa9, 0x12,
-	0x4b, 0x6d, 0x15, 0xa4, 0xb6, 0xea, 0x88, 0x2a, 0xa1, 0x6e, 0x88, 0x6e, 0x5d, 0x90, 0x03, 0xae,
-	0x6b, 0x35, 0x89, 0x11, 0xe7, 0xa0, 0xf2, 0x16, 0x9d, 0xaa, 0x3e, 0x12, 0x23, 0x63, 0x27, 0x5a,
-	0xc1, 0x1b, 0xf4, 0x09, 0xaa, 0x38, 0xb1, 0x5a, 0x09, 0x36, 0x9f, 0xbf, 0xdf, 0x7d, 0xf7, 0xdd,
-	0x79, 0xa1, 0x82, 0x54, 0x81, 0x04, 0x2a, 0xb3, 0x29, 0xcf, 0xb4, 0x5c, 0x72, 0xa0, 0x82, 0x67,
-	0x1c, 0x24, 0x90, 0xf9, 0x42, 0x69, 0x85, 0x50, 0x45, 0x90, 0x5f, 0xa2, 0x73, 0x2a, 0x94, 0x50,
-	0x46, 0xa6, 0xc5, 0xab, 0x24, 0x3b, 0x58, 0x28, 0x25, 0x12, 0x4e, 0x4d, 0x15, 0xe7, 0x8f, 0x74,
-	0x96, 0x2f, 0x98, 0x96, 0x2a, 0xab, 0xf4, 0xe0, 0xc8, 0xac, 0x39, 0x5b, 0xb0, 0x14, 0xac, 0xc1,
-	0xb1, 0x30, 0x2c, 0x17, 0xbc, 0xd4, 0xa3, 0xb7, 0x9a, 0xf7, 0x6f, 0x58, 0x86, 0xbb, 0xd7, 0x4c,
-	0x73, 0x74, 0xed, 0x35, 0x4b, 0x03, 0xdf, 0x0d, 0xdd, 0x6e, 0xab, 0xd7, 0x21, 0x87, 0x61, 0xc9,
-	0xc8, 0x10, 0x83, 0xc6, 0x7a, 0x1b, 0x38, 0xe3, 0x8a, 0x47, 0x57, 0x5e, 0xd3, 0x38, 0x83, 0x5f,
-	0x0b, 0xeb, 0xdd, 0x56, 0xaf, 0x7d, 0xac, 0x73, 0x58, 0x10, 0xb6, 0xb1, 0xc4, 0x91, 0xf2, 0x50,
-	0xa2, 0xa6, 0xcf, 0x2c, 0x4e, 0xf8, 0xc4, 0xee, 0x07, 0x7e, 0xbd, 0x32, 0x29, 0x2f, 0x40, 0xec,
-	0x05, 0xc8, 0x6d, 0x45, 0x0c, 0xce, 0x0a, 0x93, 0xef, 0x6d, 0xd0, 0x5e, 0xb1, 0x34, 0xb9, 0x89,
-	0x0e, 0x2d, 0xa2, 0xf7, 0xcf, 0xc0, 0x1d, 0x9f, 0x58, 0xc1, 0x36, 0x02, 0x8a, 0xbc, 0xff, 0x09,
-	0x03, 0x3d, 0x31, 0xf3, 0x27, 0x72, 0xe6, 0x37, 0x42, 0xb7, 0xdb, 0x18, 0xb7, 0x8a, 0x4f, 0x13,
-	0xf0, 0x6e, 0x36, 0x18, 0xad, 0x77, 0xd8, 0xdd, 0xec, 0xb0, 0xfb, 0xb5, 0xc3, 0xee, 0xeb, 0x1e,
-	0x3b, 0x9b, 0x3d, 0x76, 0x3e, 0xf6, 0xd8, 0x79, 0xb8, 0x14, 0x52, 0x3f, 0xe5, 0x31, 0x99, 0xaa,
-	0x94, 0x56, 0x1b, 0x9e, 0x27, 0x2c, 0x06, 0x5b, 0xd0, 0xe5, 0x45, 0x9f, 0xbe, 0xfc, 0x3d, 0xb8,
-	0x5e, 0xcd, 0x39, 0xc4, 0x4d, 0xb3, 0x42, 0xff, 0x27, 0x00, 0x00, 0xff, 0xff, 0x77, 0xb3, 0x0e,
-	0x48, 0x20, 0x02, 0x00, 0x00,
+	// 463 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x92, 0xc1, 0x6e, 0xd3, 0x30,
+	0x1c, 0xc6, 0x1b, 0x56, 0x8a, 0xe4, 0x8e, 0xc3, 0xcc, 0x84, 0xb2, 0x49, 0xa4, 0x55, 0x04, 0x52,
+	0x39, 0x90, 0x88, 0x4d, 0x02, 0xc4, 0xb1, 0x9a, 0x54, 0xb8, 0x4d, 0x1d, 0xe2, 0xb0, 0x4b, 0xe4,
+	0x34, 0x26, 0xb5, 0x70, 0xf2, 0x8f, 0xf2, 0x77, 0x2a, 0x26, 0x5e, 0x62, 0x47, 0x1e, 0x85, 0x47,
+	0xd8, 0x71, 0x47, 0x4e, 0x03, 0xb5, 0x6f, 0xc0, 0x13, 0x20, 0x3b, 0x36, 0x4c, 0x5d, 0xb8, 0xc5,
+	0xfe, 0x7e, 0xfe, 0xfc, 0x7d, 0x7f, 0x87, 0x8c, 0x01, 0x0b, 0x40, 0x81, 0xb1, 0x28, 0x17, 0xbc,
+	0x54, 0x62, 0xc5, 0x31, 0xce, 0x79, 0xc9, 0x51, 0x60, 0x54, 0xd5, 0xa0, 0x80, 0x52, 0x4b, 0x44,
+	0xff, 0x88, 0xc3, 0xfd, 0x1c, 0x72, 0x30, 0x72, 0xac, 0xbf, 0x5a, 0xf2, 0x30, 0xc8, 0x01, 0x72,
+	0xc9, 0x63, 0xb3, 0x4a, 0x9b, 0x4f, 0x71, 0xd6, 0xd4, 0x4c, 0x09, 0x28, 0xad, 0x3e, 0xda, 0xd6,
+	0x95, 0x28, 0x38, 0x2a, 0x56, 0x54, 0x0e, 0xe8, 0x08, 0x53, 0xb1, 0x9a, 0x15, 0xe8, 0x6e, 0xe8,
+	0x4a, 0xcb, 0x9a, 0x9c, 0xb7, 0x7a, 0xf8, 0xbd, 0x4f, 0x76, 0x67, 0x6d, 0xfa, 0x33, 0xc5, 0x14,
+	0xa7, 0x6f, 0xc8, 0xa0, 0x35, 0xf0, 0xbd, 0xb1, 0x37, 0x19, 0x1e, 0x1d, 0x46, 0x77, 0xdb, 0x44,
+	0xa7, 0x86, 0x98, 0xf6, 0xaf, 0x6e, 0x46, 0xbd, 0xb9, 0xe5, 0xe9, 0x6b, 0x32, 0x30, 0xce, 0xe8,
+	0xdf, 0x1b, 0xef, 0x4c, 0x86, 0x47, 0x07, 0x5d, 0x27, 0x67, 0x9a, 0x70, 0x07, 0x5b, 0x9c, 0x02,
+	0xa1, 0x12, 0x16, 0x9f, 0x59, 0x2a, 0x79, 0xe2, 0x06, 0x80, 0xfe, 0x8e, 0x35, 0x69, 0x47, 0x10,
+	0xb9, 0x11, 0x44, 0x27, 0x96, 0x98, 0x3e, 0xd3, 0x26, 0xbf, 0x6f, 0x46, 0x07, 0x17, 0xac, 0x90,
+	0x6f, 0xc3, 0xbb, 0x16, 0xe1, 0xb7, 0x9f, 0x23, 0x6f, 0xbe, 0xe7, 0x04, 0x77, 0x10, 0x69, 0x48,
+	0x1e, 0x4a, 0x86, 0x2a, 0x31, 0xf7, 0x27, 0x22, 0xf3, 0xfb, 0x63, 0x6f, 0xd2, 0x9f, 0x0f, 0xf5,
+	0xa6, 0x09, 0xf8, 0x3e, 0xa3, 0xe7, 0xe4, 0x51, 0x05, 0x20, 0x13, 0x5c, 0xb2, 0x9a, 0x27, 0x4b,
+	0x90, 0x99, 0x28, 0x73, 0xf4, 0xef, 0x9b, 0x54, 0x4f, 0x3b, 0x87, 0x02, 0x20, 0xcf, 0x34, 0xfd,
+	0xae, 0x85, 0x6d, 0xcb, 0xbd, 0x6a, 0x6b, 0x1f, 0xe9, 0x57, 0xf2, 0xd8, 0xdc, 0x9f, 0x09, 0x54,
+	0xb5, 0x48, 0x1b, 0x9d, 0x2a, 0xd1, 0x4f, 0xeb, 0x0f, 0xec, 0xcc, 0xb7, 0x4b, 0x7f, 0x70, 0xef,
+	0x3e, 0x7d, 0x6e, 0x5b, 0x3f, 0xb1, 0xad, 0x3b, 0x7d, 0xc2, 0x4b, 0xdd, 0x7c, 0x5f, 0x8b, 0x27,
+	0xb7, 0x34, 0xed, 0x42, 0x67, 0x64, 0xd7, 0x14, 0x5b, 0x81, 0x6c, 0x0a, 0x8e, 0xfe, 0x03, 0xd3,
+	0x28, 0xf8, 0x5f, 0xa3, 0x8f, 0x06, 0xb3, 0x5d, 0x86, 0xd5, 0xdf, 0x1d, 0x9c, 0x9e, 0x5e, 0xad,
+	0x03, 0xef, 0x7a, 0x1d, 0x78, 0xbf, 0xd6, 0x81, 0x77, 0xb9, 0x09, 0x7a, 0xd7, 0x9b, 0xa0, 0xf7,
+	0x63, 0x13, 0xf4, 0xce, 0x5f, 0xe5, 0x42, 0x2d, 0x9b, 0x34, 0x5a, 0x40, 0x11, 0x5b, 0xdb, 0x17,
+	0x92, 0xa5, 0xe8, 0x16, 0xf1, 0xea, 0xe5, 0x71, 0xfc, 0xe5, 0xf6, 0x2f, 0xa9, 0x2e, 0x2a, 0x8e,
+	0xe9, 0xc0, 0xf4, 0x3d, 0xfe, 0x33, 0x00, 0x70, 0x63, 0xe7, 0x37, 0x63, 0x03, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -155,6 +192,42 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.PoolVolumes) > 0 {
+		for iNdEx := len(m.PoolVolumes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PoolVolumes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.LastDistributionTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.LastDistributionTime):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintGenesis(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x32
+	if len(m.PoolShareHoldings) > 0 {
+		for iNdEx := len(m.PoolShareHoldings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PoolShareHoldings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	if m.LastGaugeId != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.LastGaugeId))
 		i--
@@ -233,6 +306,20 @@ func (m *GenesisState) Size() (n int) {
 	if m.LastGaugeId != 0 {
 		n += 1 + sovGenesis(uint64(m.LastGaugeId))
 	}
+	if len(m.PoolShareHoldings) > 0 {
+		for _, e := range m.PoolShareHoldings {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.LastDistributionTime)
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.PoolVolumes) > 0 {
+		for _, e := range m.PoolVolumes {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -391,6 +478,107 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolShareHoldings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PoolShareHoldings = append(m.PoolShareHoldings, PoolShareHolding{})
+			if err := m.PoolShareHoldings[len(m.PoolShareHoldings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastDistributionTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.LastDistributionTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolVolumes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PoolVolumes = append(m.PoolVolumes, PoolVolume{})
+			if err := m.PoolVolumes[len(m.PoolVolumes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
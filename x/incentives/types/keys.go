@@ -43,6 +43,15 @@ var (
 	// KeyIndexSeparator defines key for merging bytes.
 	KeyIndexSeparator = []byte{0x07}
 
+	// KeyPrefixPoolShareHoldings defines prefix key for storing the unlocked pool shares held by accounts, by pool ID and address.
+	KeyPrefixPoolShareHoldings = []byte{0x08}
+
+	// KeyLastDistributionTime defines key for storing the time of the last epoch distribution.
+	KeyLastDistributionTime = []byte{0x09}
+
+	// KeyPrefixPoolVolumes defines prefix key for storing the trading volumes of denoms in pools over the current epoch, by pool ID and denom.
+	KeyPrefixPoolVolumes = []byte{0x0A}
+
 	// LockableDurationsKey defines key for storing valid durations for giving incentives.
 	LockableDurationsKey = []byte("lockable_durations")
 )
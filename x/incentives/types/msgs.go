@@ -2,8 +2,10 @@ package types
 
 import (
 	"errors"
+	"fmt"
 	"time"
 
+	gammtypes "github.com/osmosis-labs/osmosis/v13/x/gamm/types"
 	lockuptypes "github.com/osmosis-labs/osmosis/v13/x/lockup/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -55,8 +57,37 @@ func (m MsgCreateGauge) ValidateBasic() error {
 		return errors.New("distribution period should be 1 epoch for perpetual gauge")
 	}
 
-	if lockuptypes.LockQueryType_name[int32(m.DistributeTo.LockQueryType)] != "ByDuration" {
-		return errors.New("only duration query condition is allowed. Start time distr conditions is an obsolete codepath slated for deletion")
+	if m.DistributeTo.LockQueryType == lockuptypes.NoLock {
+		if _, err := gammtypes.GetPoolIdFromShareDenom(m.DistributeTo.Denom); err != nil {
+			return errors.New("no lock query condition is only allowed for pool share denoms")
+		}
+		if m.DistributeTo.Duration != 0 {
+			return errors.New("no lock query condition should not set a duration")
+		}
+	} else if lockuptypes.LockQueryType_name[int32(m.DistributeTo.LockQueryType)] != "ByDuration" {
+		return errors.New("only duration and no lock query conditions are allowed. Start time distr conditions is an obsolete codepath slated for deletion")
+	}
+
+	if len(m.PoolIds) > 0 {
+		if m.DistributeTo.LockQueryType != lockuptypes.ByDuration {
+			return errors.New("group gauges should distribute to locks by duration")
+		}
+		if lockuptypes.IsSyntheticDenom(m.DistributeTo.Denom) {
+			return errors.New("group gauges should not distribute to synthetic denoms")
+		}
+		if len(m.PoolIds) > MaxGroupGaugePools {
+			return fmt.Errorf("group gauges should have at most %d pools, got %d", MaxGroupGaugePools, len(m.PoolIds))
+		}
+		seenPoolIds := make(map[uint64]bool, len(m.PoolIds))
+		for _, poolId := range m.PoolIds {
+			if poolId == 0 {
+				return errors.New("group gauge pool IDs should be positive")
+			}
+			if seenPoolIds[poolId] {
+				return fmt.Errorf("duplicate group gauge pool ID %d", poolId)
+			}
+			seenPoolIds[poolId] = true
+		}
 	}
 
 	return nil
@@ -105,6 +105,80 @@ func TestMsgCreatePool(t *testing.T) {
 			}),
 			expectPass: false,
 		},
+		{
+			name: "valid NoLock gauge for pool shares",
+			msg: createMsg(func(msg incentivestypes.MsgCreateGauge) incentivestypes.MsgCreateGauge {
+				msg.DistributeTo.LockQueryType = lockuptypes.NoLock
+				msg.DistributeTo.Denom = "gamm/pool/1"
+				msg.DistributeTo.Duration = 0
+				return msg
+			}),
+			expectPass: true,
+		},
+		{
+			name: "invalid NoLock gauge for a denom other than pool shares",
+			msg: createMsg(func(msg incentivestypes.MsgCreateGauge) incentivestypes.MsgCreateGauge {
+				msg.DistributeTo.LockQueryType = lockuptypes.NoLock
+				msg.DistributeTo.Duration = 0
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "invalid NoLock gauge with a duration",
+			msg: createMsg(func(msg incentivestypes.MsgCreateGauge) incentivestypes.MsgCreateGauge {
+				msg.DistributeTo.LockQueryType = lockuptypes.NoLock
+				msg.DistributeTo.Denom = "gamm/pool/1"
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "valid group gauge",
+			msg: createMsg(func(msg incentivestypes.MsgCreateGauge) incentivestypes.MsgCreateGauge {
+				msg.PoolIds = []uint64{1, 2}
+				return msg
+			}),
+			expectPass: true,
+		},
+		{
+			name: "invalid group gauge with duplicate pools",
+			msg: createMsg(func(msg incentivestypes.MsgCreateGauge) incentivestypes.MsgCreateGauge {
+				msg.PoolIds = []uint64{1, 1}
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "invalid group gauge with too many pools",
+			msg: createMsg(func(msg incentivestypes.MsgCreateGauge) incentivestypes.MsgCreateGauge {
+				msg.PoolIds = []uint64{}
+				for i := 1; i <= incentivestypes.MaxGroupGaugePools+1; i++ {
+					msg.PoolIds = append(msg.PoolIds, uint64(i))
+				}
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "invalid group gauge with a zero pool ID",
+			msg: createMsg(func(msg incentivestypes.MsgCreateGauge) incentivestypes.MsgCreateGauge {
+				msg.PoolIds = []uint64{0, 1}
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "invalid group gauge with a no lock query condition",
+			msg: createMsg(func(msg incentivestypes.MsgCreateGauge) incentivestypes.MsgCreateGauge {
+				msg.DistributeTo.LockQueryType = lockuptypes.NoLock
+				msg.DistributeTo.Denom = "gamm/pool/1"
+				msg.DistributeTo.Duration = 0
+				msg.PoolIds = []uint64{1, 2}
+				return msg
+			}),
+			expectPass: false,
+		},
 		{
 			name: "invalid distribution start time",
 			msg: createMsg(func(msg incentivestypes.MsgCreateGauge) incentivestypes.MsgCreateGauge {
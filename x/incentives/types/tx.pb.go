@@ -55,6 +55,9 @@ type MsgCreateGauge struct {
 	// num_epochs_paid_over is the number of epochs distribution will be completed
 	// over
 	NumEpochsPaidOver uint64 `protobuf:"varint,6,opt,name=num_epochs_paid_over,json=numEpochsPaidOver,proto3" json:"num_epochs_paid_over,omitempty"`
+	// pool_ids are the pools of a group gauge, which splits each epoch's rewards
+	// across them by their relative trading volume of distribute_to.denom
+	PoolIds []uint64 `protobuf:"varint,7,rep,packed,name=pool_ids,json=poolIds,proto3" json:"pool_ids,omitempty" yaml:"pool_ids"`
 }
 
 func (m *MsgCreateGauge) Reset()         { *m = MsgCreateGauge{} }
@@ -132,6 +135,13 @@ func (m *MsgCreateGauge) GetNumEpochsPaidOver() uint64 {
 	return 0
 }
 
+func (m *MsgCreateGauge) GetPoolIds() []uint64 {
+	if m != nil {
+		return m.PoolIds
+	}
+	return nil
+}
+
 type MsgCreateGaugeResponse struct {
 }
 
@@ -278,45 +288,46 @@ func init() {
 func init() { proto.RegisterFile("osmosis/incentives/tx.proto", fileDescriptor_8ea120e22291556e) }
 
 var fileDescriptor_8ea120e22291556e = []byte{
-	// 595 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0xcf, 0x6e, 0xd3, 0x30,
-	0x1c, 0xae, 0x69, 0xf7, 0xcf, 0xdd, 0xd0, 0x88, 0x06, 0x64, 0x05, 0x25, 0x5d, 0x0e, 0x28, 0x20,
-	0xcd, 0x66, 0x9b, 0xc4, 0x81, 0x1b, 0x9d, 0x10, 0xda, 0xa1, 0xa2, 0x44, 0x95, 0x90, 0x26, 0xa1,
-	0xc8, 0x49, 0x4c, 0x66, 0xad, 0x89, 0xa3, 0xd8, 0xe9, 0xd6, 0xb7, 0x98, 0xc4, 0x5b, 0xf0, 0x06,
-	0xdc, 0x38, 0xee, 0xb8, 0x23, 0xa7, 0x0e, 0xb5, 0x6f, 0xb0, 0x27, 0x40, 0x71, 0x92, 0xb5, 0x15,
-	0x7f, 0x76, 0xe1, 0xe4, 0xda, 0xdf, 0xf7, 0xfb, 0xfc, 0xf3, 0xf7, 0xfd, 0x1a, 0xf8, 0x84, 0x8b,
-	0x88, 0x0b, 0x26, 0x30, 0x8b, 0x7d, 0x1a, 0x4b, 0x36, 0xa4, 0x02, 0xcb, 0x73, 0x94, 0xa4, 0x5c,
-	0x72, 0x4d, 0x2b, 0x41, 0x34, 0x03, 0x5b, 0x5b, 0x21, 0x0f, 0xb9, 0x82, 0x71, 0xfe, 0xab, 0x60,
-	0xb6, 0xcc, 0x90, 0xf3, 0x70, 0x40, 0xb1, 0xda, 0x79, 0xd9, 0x67, 0x2c, 0x59, 0x44, 0x85, 0x24,
-	0x51, 0x52, 0x12, 0x0c, 0x5f, 0x69, 0x61, 0x8f, 0x08, 0x8a, 0x87, 0x7b, 0x1e, 0x95, 0x64, 0x0f,
-	0xfb, 0x9c, 0xc5, 0x15, 0xfe, 0x87, 0x3e, 0x42, 0x92, 0x85, 0xb4, 0xc4, 0xb7, 0x2b, 0x7c, 0xc0,
-	0xfd, 0xd3, 0x2c, 0x51, 0x4b, 0x01, 0x59, 0x5f, 0xea, 0xf0, 0x7e, 0x57, 0x84, 0x87, 0x29, 0x25,
-	0x92, 0xbe, 0xcb, 0x6b, 0xb4, 0x1d, 0xb8, 0xce, 0x84, 0x9b, 0xd0, 0x34, 0xa1, 0x32, 0x23, 0x03,
-	0x1d, 0xb4, 0x81, 0xbd, 0xea, 0x34, 0x99, 0xe8, 0x55, 0x47, 0xda, 0x33, 0xb8, 0xc4, 0xcf, 0x62,
-	0x9a, 0xea, 0xf7, 0xda, 0xc0, 0x5e, 0xeb, 0x6c, 0xde, 0x8c, 0xcd, 0xf5, 0x11, 0x89, 0x06, 0xaf,
-	0x2d, 0x75, 0x6c, 0x39, 0x05, 0xac, 0x1d, 0xc1, 0x8d, 0x80, 0x09, 0x99, 0x32, 0x2f, 0x93, 0xd4,
-	0x95, 0x5c, 0xaf, 0xb7, 0x81, 0xdd, 0xdc, 0x37, 0x50, 0xe5, 0x4d, 0xd1, 0x10, 0xfa, 0x90, 0xd1,
-	0x74, 0x74, 0xc8, 0xe3, 0x80, 0x49, 0xc6, 0xe3, 0x4e, 0xe3, 0x72, 0x6c, 0xd6, 0x9c, 0xf5, 0x59,
-	0x69, 0x9f, 0x6b, 0x04, 0x2e, 0xe5, 0x2f, 0x16, 0x7a, 0xa3, 0x5d, 0xb7, 0x9b, 0xfb, 0xdb, 0xa8,
-	0xf0, 0x04, 0xe5, 0x9e, 0xa0, 0xd2, 0x13, 0x74, 0xc8, 0x59, 0xdc, 0x79, 0x99, 0x57, 0x7f, 0xbd,
-	0x36, 0xed, 0x90, 0xc9, 0x93, 0xcc, 0x43, 0x3e, 0x8f, 0x70, 0x69, 0x60, 0xb1, 0xec, 0x8a, 0xe0,
-	0x14, 0xcb, 0x51, 0x42, 0x85, 0x2a, 0x10, 0x4e, 0xa1, 0xac, 0x7d, 0x84, 0x50, 0x48, 0x92, 0x4a,
-	0x37, 0xf7, 0x5f, 0x5f, 0x52, 0xad, 0xb6, 0x50, 0x11, 0x0e, 0xaa, 0xc2, 0x41, 0xfd, 0x2a, 0x9c,
-	0xce, 0xd3, 0xfc, 0xa2, 0x9b, 0xb1, 0xb9, 0x59, 0x3c, 0xfd, 0x36, 0x35, 0xeb, 0xe2, 0xda, 0x04,
-	0xce, 0x9a, 0xd2, 0xca, 0xd9, 0x1a, 0x86, 0x5b, 0x71, 0x16, 0xb9, 0x34, 0xe1, 0xfe, 0x89, 0x70,
-	0x13, 0xc2, 0x02, 0x97, 0x0f, 0x69, 0xaa, 0x2f, 0xb7, 0x81, 0xdd, 0x70, 0x1e, 0xc4, 0x59, 0xf4,
-	0x56, 0x41, 0x3d, 0xc2, 0x82, 0xf7, 0x43, 0x9a, 0x5a, 0x3a, 0x7c, 0xb4, 0x18, 0x8a, 0x43, 0x45,
-	0xc2, 0x63, 0x41, 0xad, 0x6f, 0x00, 0x6e, 0x74, 0x45, 0xf8, 0x26, 0x08, 0xfa, 0xbc, 0x88, 0xeb,
-	0x36, 0x0b, 0xf0, 0xef, 0x2c, 0xb6, 0xe1, 0xaa, 0x9a, 0x09, 0x97, 0x05, 0x2a, 0xb6, 0x86, 0xb3,
-	0xa2, 0xf6, 0x47, 0x81, 0x46, 0xe1, 0x4a, 0x4a, 0xcf, 0x48, 0x1a, 0x08, 0xbd, 0xfe, 0xff, 0xdd,
-	0xad, 0xb4, 0xad, 0xc7, 0xf0, 0xe1, 0x42, 0xeb, 0xd5, 0xa3, 0xf6, 0xbf, 0x03, 0x58, 0xef, 0x8a,
-	0x50, 0xfb, 0x04, 0x9b, 0xf3, 0x83, 0x68, 0xa1, 0xdf, 0xff, 0x42, 0x68, 0xd1, 0x97, 0xd6, 0x8b,
-	0xbb, 0x39, 0xd5, 0x35, 0xda, 0x31, 0x84, 0x73, 0xbe, 0xed, 0xfc, 0xa5, 0x72, 0x46, 0x69, 0x3d,
-	0xbf, 0x93, 0x52, 0x69, 0x77, 0x7a, 0x97, 0x13, 0x03, 0x5c, 0x4d, 0x0c, 0xf0, 0x73, 0x62, 0x80,
-	0x8b, 0xa9, 0x51, 0xbb, 0x9a, 0x1a, 0xb5, 0x1f, 0x53, 0xa3, 0x76, 0xfc, 0x6a, 0xce, 0xa8, 0x52,
-	0x6e, 0x77, 0x40, 0x3c, 0x51, 0x6d, 0xf0, 0x70, 0xef, 0x00, 0x9f, 0x2f, 0x7c, 0x42, 0x72, 0xf3,
-	0xbc, 0x65, 0x35, 0x71, 0x07, 0xbf, 0x02, 0x00, 0x00, 0xff, 0xff, 0x5c, 0x02, 0x5b, 0x96, 0x65,
-	0x04, 0x00, 0x00,
+	// 621 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0xcf, 0x4e, 0xd4, 0x40,
+	0x1c, 0xde, 0xba, 0x0b, 0x0b, 0xb3, 0xa0, 0x58, 0x51, 0xcb, 0x6a, 0xda, 0xa5, 0x07, 0x53, 0x4d,
+	0x98, 0x11, 0x48, 0x3c, 0x78, 0x73, 0x89, 0x31, 0x1c, 0x88, 0xd8, 0x90, 0x98, 0x90, 0x98, 0x66,
+	0xba, 0x1d, 0xcb, 0x84, 0x6d, 0xa7, 0xe9, 0x6f, 0xba, 0xc0, 0x5b, 0xf0, 0x1c, 0xbe, 0x81, 0x37,
+	0x13, 0x2f, 0x1c, 0x39, 0x7a, 0x5a, 0x0c, 0xbc, 0x01, 0x4f, 0x60, 0x3a, 0xed, 0x00, 0x1b, 0xff,
+	0x70, 0xf1, 0x34, 0x7f, 0xbe, 0xef, 0xf7, 0xcd, 0x6f, 0xbe, 0x6f, 0x5a, 0xf4, 0x44, 0x40, 0x22,
+	0x80, 0x03, 0xe1, 0xe9, 0x80, 0xa5, 0x92, 0x8f, 0x18, 0x10, 0x79, 0x88, 0xb3, 0x5c, 0x48, 0x61,
+	0x9a, 0x35, 0x88, 0xaf, 0xc1, 0xee, 0x62, 0x2c, 0x62, 0xa1, 0x60, 0x52, 0xce, 0x2a, 0x66, 0xd7,
+	0x89, 0x85, 0x88, 0x87, 0x8c, 0xa8, 0x55, 0x58, 0x7c, 0x26, 0x92, 0x27, 0x0c, 0x24, 0x4d, 0xb2,
+	0x9a, 0x60, 0x0f, 0x94, 0x16, 0x09, 0x29, 0x30, 0x32, 0x5a, 0x0d, 0x99, 0xa4, 0xab, 0x64, 0x20,
+	0x78, 0xaa, 0xf1, 0x3f, 0xf4, 0x11, 0xd3, 0x22, 0x66, 0x35, 0xbe, 0xa4, 0xf1, 0xa1, 0x18, 0xec,
+	0x17, 0x99, 0x1a, 0x2a, 0xc8, 0xfd, 0xde, 0x44, 0x77, 0xb7, 0x20, 0xde, 0xc8, 0x19, 0x95, 0xec,
+	0x5d, 0x59, 0x63, 0x2e, 0xa3, 0x39, 0x0e, 0x41, 0xc6, 0xf2, 0x8c, 0xc9, 0x82, 0x0e, 0x2d, 0xa3,
+	0x67, 0x78, 0x33, 0x7e, 0x87, 0xc3, 0xb6, 0xde, 0x32, 0x9f, 0xa1, 0x29, 0x71, 0x90, 0xb2, 0xdc,
+	0xba, 0xd3, 0x33, 0xbc, 0xd9, 0xfe, 0xc2, 0xe5, 0xd8, 0x99, 0x3b, 0xa2, 0xc9, 0xf0, 0xb5, 0xab,
+	0xb6, 0x5d, 0xbf, 0x82, 0xcd, 0x4d, 0x34, 0x1f, 0x71, 0x90, 0x39, 0x0f, 0x0b, 0xc9, 0x02, 0x29,
+	0xac, 0x66, 0xcf, 0xf0, 0x3a, 0x6b, 0x36, 0xd6, 0xde, 0x54, 0x0d, 0xe1, 0x0f, 0x05, 0xcb, 0x8f,
+	0x36, 0x44, 0x1a, 0x71, 0xc9, 0x45, 0xda, 0x6f, 0x9d, 0x8c, 0x9d, 0x86, 0x3f, 0x77, 0x5d, 0xba,
+	0x23, 0x4c, 0x8a, 0xa6, 0xca, 0x1b, 0x83, 0xd5, 0xea, 0x35, 0xbd, 0xce, 0xda, 0x12, 0xae, 0x3c,
+	0xc1, 0xa5, 0x27, 0xb8, 0xf6, 0x04, 0x6f, 0x08, 0x9e, 0xf6, 0x5f, 0x96, 0xd5, 0x5f, 0xce, 0x1c,
+	0x2f, 0xe6, 0x72, 0xaf, 0x08, 0xf1, 0x40, 0x24, 0xa4, 0x36, 0xb0, 0x1a, 0x56, 0x20, 0xda, 0x27,
+	0xf2, 0x28, 0x63, 0xa0, 0x0a, 0xc0, 0xaf, 0x94, 0xcd, 0x8f, 0x08, 0x81, 0xa4, 0xb9, 0x0c, 0x4a,
+	0xff, 0xad, 0x29, 0xd5, 0x6a, 0x17, 0x57, 0xe1, 0x60, 0x1d, 0x0e, 0xde, 0xd1, 0xe1, 0xf4, 0x9f,
+	0x96, 0x07, 0x5d, 0x8e, 0x9d, 0x85, 0xea, 0xea, 0x57, 0xa9, 0xb9, 0xc7, 0x67, 0x8e, 0xe1, 0xcf,
+	0x2a, 0xad, 0x92, 0x6d, 0x12, 0xb4, 0x98, 0x16, 0x49, 0xc0, 0x32, 0x31, 0xd8, 0x83, 0x20, 0xa3,
+	0x3c, 0x0a, 0xc4, 0x88, 0xe5, 0xd6, 0x74, 0xcf, 0xf0, 0x5a, 0xfe, 0xfd, 0xb4, 0x48, 0xde, 0x2a,
+	0x68, 0x9b, 0xf2, 0xe8, 0xfd, 0x88, 0xe5, 0x26, 0x46, 0x33, 0x99, 0x10, 0xc3, 0x80, 0x47, 0x60,
+	0xb5, 0x7b, 0x4d, 0xaf, 0xd5, 0x7f, 0x70, 0x39, 0x76, 0xee, 0x55, 0xe7, 0x68, 0xc4, 0xf5, 0xdb,
+	0xe5, 0x74, 0x33, 0x02, 0xd7, 0x42, 0x8f, 0x26, 0x43, 0xf4, 0x19, 0x64, 0x22, 0x05, 0xe6, 0x7e,
+	0x35, 0xd0, 0xfc, 0x16, 0xc4, 0x6f, 0xa2, 0x68, 0x47, 0x54, 0xf1, 0x5e, 0x65, 0x67, 0xfc, 0x3b,
+	0xbb, 0x25, 0x34, 0xa3, 0xde, 0x50, 0xc0, 0x23, 0x15, 0x73, 0xcb, 0x6f, 0xab, 0xf5, 0x66, 0x64,
+	0x32, 0xd4, 0xce, 0xd9, 0x01, 0xcd, 0x23, 0xb0, 0x9a, 0xff, 0x3f, 0x0d, 0xad, 0xed, 0x3e, 0x46,
+	0x0f, 0x27, 0x5a, 0xd7, 0x97, 0x5a, 0xfb, 0x66, 0xa0, 0xe6, 0x16, 0xc4, 0xe6, 0x27, 0xd4, 0xb9,
+	0xf9, 0x70, 0x5d, 0xfc, 0xfb, 0x27, 0x87, 0x27, 0x7d, 0xe9, 0xbe, 0xb8, 0x9d, 0xa3, 0x8f, 0x31,
+	0x77, 0x11, 0xba, 0xe1, 0xdb, 0xf2, 0x5f, 0x2a, 0xaf, 0x29, 0xdd, 0xe7, 0xb7, 0x52, 0xb4, 0x76,
+	0x7f, 0xfb, 0xe4, 0xdc, 0x36, 0x4e, 0xcf, 0x6d, 0xe3, 0xe7, 0xb9, 0x6d, 0x1c, 0x5f, 0xd8, 0x8d,
+	0xd3, 0x0b, 0xbb, 0xf1, 0xe3, 0xc2, 0x6e, 0xec, 0xbe, 0xba, 0x61, 0x54, 0x2d, 0xb7, 0x32, 0xa4,
+	0x21, 0xe8, 0x05, 0x19, 0xad, 0xae, 0x93, 0xc3, 0x89, 0x5f, 0x4e, 0x69, 0x5e, 0x38, 0xad, 0x5e,
+	0xe8, 0xfa, 0xaf, 0x01, 0x00, 0x6a, 0x9f, 0x05, 0x25, 0x95, 0x04, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -455,17 +466,35 @@ func (m *MsgCreateGauge) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.PoolIds) > 0 {
+		dAtA2 := make([]byte, len(m.PoolIds)*10)
+		var j1 int
+		for _, num := range m.PoolIds {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintTx(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0x3a
+	}
 	if m.NumEpochsPaidOver != 0 {
 		i = encodeVarintTx(dAtA, i, uint64(m.NumEpochsPaidOver))
 		i--
 		dAtA[i] = 0x30
 	}
-	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime):])
-	if err1 != nil {
-		return 0, err1
+	n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime):])
+	if err3 != nil {
+		return 0, err3
 	}
-	i -= n1
-	i = encodeVarintTx(dAtA, i, uint64(n1))
+	i -= n3
+	i = encodeVarintTx(dAtA, i, uint64(n3))
 	i--
 	dAtA[i] = 0x2a
 	if len(m.Coins) > 0 {
@@ -644,6 +673,13 @@ func (m *MsgCreateGauge) Size() (n int) {
 	if m.NumEpochsPaidOver != 0 {
 		n += 1 + sovTx(uint64(m.NumEpochsPaidOver))
 	}
+	if len(m.PoolIds) > 0 {
+		l = 0
+		for _, e := range m.PoolIds {
+			l += sovTx(uint64(e))
+		}
+		n += 1 + sovTx(uint64(l)) + l
+	}
 	return n
 }
 
@@ -893,6 +929,82 @@ func (m *MsgCreateGauge) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 7:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.PoolIds = append(m.PoolIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.PoolIds) == 0 {
+					m.PoolIds = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.PoolIds = append(m.PoolIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolIds", wireType)
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -64,6 +64,66 @@ func TestBeginUnlockingByIDCmd(t *testing.T) {
 	osmocli.RunTxTestCases(t, desc, tcs)
 }
 
+func TestSplitLockCmd(t *testing.T) {
+	desc, _ := NewSplitLockCmd()
+	tcs := map[string]osmocli.TxCliTestCase[*types.MsgSplitLock]{
+		"single amount": {
+			Cmd: "10 5 --from=" + testAddresses[0].String(),
+			ExpectedMsg: &types.MsgSplitLock{
+				Owner:   testAddresses[0].String(),
+				ID:      10,
+				Amounts: []sdk.Int{sdk.NewInt(5)},
+			},
+		},
+		"multiple amounts": {
+			Cmd: "10 5,100 --from=" + testAddresses[0].String(),
+			ExpectedMsg: &types.MsgSplitLock{
+				Owner:   testAddresses[0].String(),
+				ID:      10,
+				Amounts: []sdk.Int{sdk.NewInt(5), sdk.NewInt(100)},
+			},
+		},
+		"invalid amount": {
+			Cmd:         "10 5,abc --from=" + testAddresses[0].String(),
+			ExpectedErr: true,
+		},
+	}
+	osmocli.RunTxTestCases(t, desc, tcs)
+}
+
+func TestTransferLockCmd(t *testing.T) {
+	desc, _ := NewTransferLockCmd()
+	tcs := map[string]osmocli.TxCliTestCase[*types.MsgTransferLock]{
+		"basic test": {
+			Cmd: "10 " + testAddresses[1].String() + " --from=" + testAddresses[0].String(),
+			ExpectedMsg: &types.MsgTransferLock{
+				Owner:    testAddresses[0].String(),
+				ID:       10,
+				NewOwner: testAddresses[1].String(),
+			},
+		},
+	}
+	osmocli.RunTxTestCases(t, desc, tcs)
+}
+
+func TestMergeLocksCmd(t *testing.T) {
+	desc, _ := NewMergeLocksCmd()
+	tcs := map[string]osmocli.TxCliTestCase[*types.MsgMergeLocks]{
+		"basic test": {
+			Cmd: "10,11,12 --from=" + testAddresses[0].String(),
+			ExpectedMsg: &types.MsgMergeLocks{
+				Owner:   testAddresses[0].String(),
+				LockIds: []uint64{10, 11, 12},
+			},
+		},
+		"invalid lock id": {
+			Cmd:         "10,abc --from=" + testAddresses[0].String(),
+			ExpectedErr: true,
+		},
+	}
+	osmocli.RunTxTestCases(t, desc, tcs)
+}
+
 func TestModuleBalanceCmd(t *testing.T) {
 	desc, _ := GetCmdModuleBalance()
 	tcs := map[string]osmocli.QueryCliTestCase[*types.ModuleBalanceRequest]{
@@ -75,6 +135,17 @@ func TestModuleBalanceCmd(t *testing.T) {
 	osmocli.RunQueryTestCases(t, desc, tcs)
 }
 
+func TestNextLockIDCmd(t *testing.T) {
+	desc, _ := GetCmdNextLockID()
+	tcs := map[string]osmocli.QueryCliTestCase[*types.NextLockIDRequest]{
+		"basic test": {
+			Cmd:           "",
+			ExpectedQuery: &types.NextLockIDRequest{},
+		},
+	}
+	osmocli.RunQueryTestCases(t, desc, tcs)
+}
+
 func TestAccountUnlockingCoinsCmd(t *testing.T) {
 	desc, _ := GetCmdAccountUnlockingCoins()
 	tcs := map[string]osmocli.QueryCliTestCase[*types.AccountUnlockingCoinsRequest]{
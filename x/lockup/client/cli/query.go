@@ -32,6 +32,7 @@ func GetQueryCmd() *cobra.Command {
 	osmocli.AddQueryCmd(cmd, qcGetter, GetCmdAccountLockedPastTime)
 	osmocli.AddQueryCmd(cmd, qcGetter, GetCmdAccountLockedPastTimeNotUnlockingOnly)
 	osmocli.AddQueryCmd(cmd, qcGetter, GetCmdTotalLockedByDenom)
+	osmocli.AddQueryCmd(cmd, qcGetter, GetCmdNextLockID)
 	cmd.AddCommand(
 		GetCmdAccountUnlockableCoins(),
 		GetCmdAccountLockedCoins(),
@@ -227,6 +228,14 @@ func GetCmdAccountLockedLongerDurationDenom() *cobra.Command {
 		`{{.Short}}`, types.ModuleName, types.NewQueryClient)
 }
 
+// GetCmdNextLockID returns the ID the next created lock will have.
+func GetCmdNextLockID() (*osmocli.QueryDescriptor, *types.NextLockIDRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "next-lock-id",
+		Short: "Query the ID the next created lock will have",
+		Long:  `{{.Short}}`}, &types.NextLockIDRequest{}
+}
+
 func GetCmdTotalLockedByDenom() (*osmocli.QueryDescriptor, *types.LockedDenomRequest) {
 	return &osmocli.QueryDescriptor{
 		Use:   "total-locked-of-denom <denom>",
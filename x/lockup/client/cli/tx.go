@@ -1,9 +1,14 @@
 package cli
 
 import (
+	"strings"
+
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v13/osmoutils"
 	"github.com/osmosis-labs/osmosis/v13/osmoutils/osmocli"
 	"github.com/osmosis-labs/osmosis/v13/x/lockup/types"
 )
@@ -15,6 +20,9 @@ func GetTxCmd() *cobra.Command {
 	osmocli.AddTxCmd(cmd, NewBeginUnlockingAllCmd)
 	osmocli.AddTxCmd(cmd, NewBeginUnlockByIDCmd)
 	osmocli.AddTxCmd(cmd, NewForceUnlockByIdCmd)
+	osmocli.AddTxCmd(cmd, NewSplitLockCmd)
+	osmocli.AddTxCmd(cmd, NewTransferLockCmd)
+	osmocli.AddTxCmd(cmd, NewMergeLocksCmd)
 
 	return cmd
 }
@@ -62,3 +70,60 @@ func NewForceUnlockByIdCmd() (*osmocli.TxCliDesc, *types.MsgForceUnlock) {
 		Flags: osmocli.FlagDesc{OptionalFlags: []*pflag.FlagSet{FlagSetUnlockTokens()}},
 	}, &types.MsgForceUnlock{}
 }
+
+// NewSplitLockCmd splits amounts off an individual period lock into new locks.
+func NewSplitLockCmd() (*osmocli.TxCliDesc, *types.MsgSplitLock) {
+	return &osmocli.TxCliDesc{
+		Use:   "split-lock [id] [amounts]",
+		Short: "split amounts off individual period lock by ID into new locks of the same duration",
+		Long: `split amounts off individual period lock by ID into new locks of the same duration.
+Amounts are a comma separated list of the amounts of the locked denom to split off, one new lock each.`,
+		Example: "osmosisd tx lockup split-lock 1 1000,2500 --from=val --chain-id=osmosis-1",
+		NumArgs: 2,
+		CustomFieldParsers: map[string]osmocli.CustomFieldParserFn{
+			"Amounts": splitAmountsParser,
+		},
+	}, &types.MsgSplitLock{}
+}
+
+// NewTransferLockCmd transfers an individual period lock by ID to a new owner.
+func NewTransferLockCmd() (*osmocli.TxCliDesc, *types.MsgTransferLock) {
+	return &osmocli.TxCliDesc{
+		Use:     "transfer-lock [id] [new-owner]",
+		Short:   "transfer individual period lock by ID to a new owner",
+		Example: "osmosisd tx lockup transfer-lock 1 osmo1... --from=val --chain-id=osmosis-1",
+	}, &types.MsgTransferLock{}
+}
+
+// NewMergeLocksCmd merges period locks of the same denom and duration into a new lock.
+func NewMergeLocksCmd() (*osmocli.TxCliDesc, *types.MsgMergeLocks) {
+	return &osmocli.TxCliDesc{
+		Use:   "merge-locks [lock-ids]",
+		Short: "merge period locks by ID, of the same denom and duration, into a new lock",
+		Long: `merge period locks by ID, of the same denom and duration, into a new lock.
+Lock IDs are a comma separated list of the IDs of the locks to merge.`,
+		Example: "osmosisd tx lockup merge-locks 1,2,3 --from=val --chain-id=osmosis-1",
+		NumArgs: 1,
+		CustomFieldParsers: map[string]osmocli.CustomFieldParserFn{
+			"LockIds": lockIDsParser,
+		},
+	}, &types.MsgMergeLocks{}
+}
+
+func splitAmountsParser(arg string, _ *pflag.FlagSet) (any, osmocli.FieldReadLocation, error) {
+	amountStrs := strings.Split(arg, ",")
+	amounts := make([]sdk.Int, 0, len(amountStrs))
+	for _, amountStr := range amountStrs {
+		amount, err := osmocli.ParseSdkInt(strings.TrimSpace(amountStr), "Amounts")
+		if err != nil {
+			return nil, osmocli.UsedArg, err
+		}
+		amounts = append(amounts, amount)
+	}
+	return amounts, osmocli.UsedArg, nil
+}
+
+func lockIDsParser(arg string, _ *pflag.FlagSet) (any, osmocli.FieldReadLocation, error) {
+	lockIDs, err := osmoutils.ParseUint64SliceFromString(arg, ",")
+	return lockIDs, osmocli.UsedArg, err
+}
@@ -361,6 +361,22 @@ func (suite *KeeperTestSuite) TestLockedByID() {
 	suite.Require().Equal(res.Lock.IsUnlocking(), false)
 }
 
+func (suite *KeeperTestSuite) TestNextLockID() {
+	suite.SetupTest()
+	addr1 := sdk.AccAddress([]byte("addr1---------------"))
+
+	res, err := suite.querier.NextLockID(sdk.WrapSDKContext(suite.Ctx), &types.NextLockIDRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(1), res.LockId)
+
+	// lock coins
+	suite.LockTokens(addr1, sdk.Coins{sdk.NewInt64Coin("stake", 10)}, time.Second)
+
+	res, err = suite.querier.NextLockID(sdk.WrapSDKContext(suite.Ctx), &types.NextLockIDRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(2), res.LockId)
+}
+
 func (suite *KeeperTestSuite) TestAccountLockedLongerDuration() {
 	suite.SetupTest()
 	addr1 := sdk.AccAddress([]byte("addr1---------------"))
@@ -160,16 +160,19 @@ func (k Keeper) lock(ctx sdk.Context, lock types.PeriodLock, tokensToLock sdk.Co
 }
 
 // BeginUnlock is a utility to start unlocking coins from NotUnlocking queue.
-// Returns an error if the lock has a synthetic lock.
-func (k Keeper) BeginUnlock(ctx sdk.Context, lockID uint64, coins sdk.Coins) error {
-	// prohibit BeginUnlock if synthetic locks are referring to this
-	// TODO: In the future, make synthetic locks only get partial restrictions on the main lock.
+// Returns the ID of the lock that started unlocking, which is a new lock split off the given lock
+// when only part of its coins are unlocked.
+// Locks with synthetic lockups can only be unlocked partially. The lock split off takes its share
+// of the synthetic lockups along, which the OnStartUnlock hooks are then expected to wind down.
+// The unlocking fails if they cannot.
+func (k Keeper) BeginUnlock(ctx sdk.Context, lockID uint64, coins sdk.Coins) (uint64, error) {
 	lock, err := k.GetLockByID(ctx, lockID)
 	if err != nil {
-		return err
+		return 0, err
 	}
-	if k.HasAnySyntheticLockups(ctx, lock.ID) {
-		return fmt.Errorf("cannot BeginUnlocking a lock with synthetic lockup")
+	// prohibit unlocking a lock in full if synthetic locks are referring to this
+	if k.HasAnySyntheticLockups(ctx, lock.ID) && (len(coins) == 0 || coins.IsEqual(lock.Coins)) {
+		return 0, fmt.Errorf("cannot BeginUnlocking a lock with synthetic lockup in full")
 	}
 
 	return k.beginUnlock(ctx, *lock, coins)
@@ -183,7 +186,8 @@ func (k Keeper) BeginForceUnlock(ctx sdk.Context, lockID uint64, coins sdk.Coins
 	if err != nil {
 		return err
 	}
-	return k.beginUnlock(ctx, *lock, coins)
+	_, err = k.beginUnlock(ctx, *lock, coins)
+	return err
 }
 
 // beginUnlock unlocks specified tokens from the given lock. Existing lock refs
@@ -191,14 +195,15 @@ func (k Keeper) BeginForceUnlock(ctx sdk.Context, lockID uint64, coins sdk.Coins
 // EndTime of the lock is set within this method.
 // Coins provided as the parameter does not require to have all the tokens in the lock,
 // as we allow partial unlockings of a lock.
-func (k Keeper) beginUnlock(ctx sdk.Context, lock types.PeriodLock, coins sdk.Coins) error {
+// Returns the ID of the lock that started unlocking.
+func (k Keeper) beginUnlock(ctx sdk.Context, lock types.PeriodLock, coins sdk.Coins) (uint64, error) {
 	// sanity check
 	if !coins.IsAllLTE(lock.Coins) {
-		return fmt.Errorf("requested amount to unlock exceeds locked tokens")
+		return 0, fmt.Errorf("requested amount to unlock exceeds locked tokens")
 	}
 
 	if lock.IsUnlocking() {
-		return fmt.Errorf("trying to unlock a lock that is already unlocking")
+		return 0, fmt.Errorf("trying to unlock a lock that is already unlocking")
 	}
 
 	// If the amount were unlocking is empty, or the entire coins amount, unlock the entire lock.
@@ -207,7 +212,7 @@ func (k Keeper) beginUnlock(ctx sdk.Context, lock types.PeriodLock, coins sdk.Co
 	if len(coins) != 0 && !coins.IsEqual(lock.Coins) {
 		splitLock, err := k.splitLock(ctx, lock, coins, false)
 		if err != nil {
-			return err
+			return 0, err
 		}
 		lock = splitLock
 	}
@@ -215,31 +220,34 @@ func (k Keeper) beginUnlock(ctx sdk.Context, lock types.PeriodLock, coins sdk.Co
 	// remove existing lock refs from not unlocking queue
 	err := k.deleteLockRefs(ctx, types.KeyPrefixNotUnlocking, lock)
 	if err != nil {
-		return err
+		return 0, err
 	}
 
 	// store lock with the end time set to current block time + duration
 	lock.EndTime = ctx.BlockTime().Add(lock.Duration)
 	err = k.setLock(ctx, lock)
 	if err != nil {
-		return err
+		return 0, err
 	}
 
 	// add lock refs into unlocking queue
 	err = k.addLockRefs(ctx, lock)
 	if err != nil {
-		return err
+		return 0, err
 	}
 
 	if k.hooks != nil {
-		k.hooks.OnStartUnlock(ctx, lock.OwnerAddress(), lock.ID, lock.Coins, lock.Duration, lock.EndTime)
+		err = k.hooks.OnStartUnlock(ctx, lock.OwnerAddress(), lock.ID, lock.Coins, lock.Duration, lock.EndTime)
+		if err != nil {
+			return 0, err
+		}
 	}
 
 	ctx.EventManager().EmitEvents(sdk.Events{
 		createBeginUnlockEvent(&lock),
 	})
 
-	return nil
+	return lock.ID, nil
 }
 
 func (k Keeper) clearKeysByPrefix(ctx sdk.Context, prefix []byte) {
@@ -285,7 +293,7 @@ func (k Keeper) beginForceUnlockWithEndTime(ctx sdk.Context, lock types.PeriodLo
 	}
 
 	if k.hooks != nil {
-		k.hooks.OnStartUnlock(ctx, lock.OwnerAddress(), lock.ID, lock.Coins, lock.Duration, lock.EndTime)
+		return k.hooks.OnStartUnlock(ctx, lock.OwnerAddress(), lock.ID, lock.Coins, lock.Duration, lock.EndTime)
 	}
 
 	return nil
@@ -347,7 +355,7 @@ func (k Keeper) ForceUnlock(ctx sdk.Context, lock types.PeriodLock) error {
 	}
 
 	if !lock.IsUnlocking() {
-		err := k.BeginUnlock(ctx, lock.ID, nil)
+		_, err := k.BeginUnlock(ctx, lock.ID, nil)
 		if err != nil {
 			return err
 		}
@@ -456,6 +464,57 @@ func (k Keeper) ExtendLockup(ctx sdk.Context, lockID uint64, owner sdk.AccAddres
 	return nil
 }
 
+// MigrateLockedCoins replaces the coins locked in the given lock, keeping its ID, owner, duration and end time.
+// The locked coins are sent back to the owner and passed to migrate, which returns the coins
+// that the owner locks in their place.
+// Locks that have synthetic lockups cannot be migrated, as these refer to the locked coins.
+func (k Keeper) MigrateLockedCoins(ctx sdk.Context, lockID uint64, migrate func(lockedCoins sdk.Coins) (sdk.Coins, error)) (*types.PeriodLock, error) {
+	lock, err := k.GetLockByID(ctx, lockID)
+	if err != nil {
+		return nil, err
+	}
+
+	if k.HasAnySyntheticLockups(ctx, lock.ID) {
+		return nil, fmt.Errorf("cannot migrate lockup with synthetic lock %d", lock.ID)
+	}
+
+	// release the locked coins to the owner
+	err = k.deleteLockRefs(ctx, unlockingPrefix(lock.IsUnlocking()), *lock)
+	if err != nil {
+		return nil, err
+	}
+	for _, coin := range lock.Coins {
+		k.accumulationStore(ctx, coin.Denom).Decrease(accumulationKey(lock.Duration), coin.Amount)
+	}
+	owner := lock.OwnerAddress()
+	if err := k.bk.SendCoinsFromModuleToAccount(ctx, types.ModuleName, owner, lock.Coins); err != nil {
+		return nil, err
+	}
+
+	newCoins, err := migrate(lock.Coins)
+	if err != nil {
+		return nil, err
+	}
+	if newCoins.Empty() {
+		return nil, fmt.Errorf("cannot migrate lock %d to no coins", lock.ID)
+	}
+
+	// lock the migrated coins in place of the released coins
+	if err := k.bk.SendCoinsFromAccountToModule(ctx, owner, types.ModuleName, newCoins); err != nil {
+		return nil, err
+	}
+	lock.Coins = newCoins
+	err = k.setLockAndAddLockRefs(ctx, *lock)
+	if err != nil {
+		return nil, err
+	}
+	for _, coin := range lock.Coins {
+		k.accumulationStore(ctx, coin.Denom).Increase(accumulationKey(lock.Duration), coin.Amount)
+	}
+
+	return lock, nil
+}
+
 // InitializeAllLocks takes a set of locks, and initializes state to be storing
 // them all correctly. This utilizes batch optimizations to improve efficiency,
 // as this becomes a bottleneck at chain initialization & upgrades.
@@ -683,7 +742,218 @@ func (k Keeper) deleteLock(ctx sdk.Context, id uint64) {
 	store.Delete(lockStoreKey(id))
 }
 
+// TransferLock transfers the lock to the new owner, moving its account based lock refs to them.
+// Locks with synthetic lockups, such as superfluid delegated locks, cannot be transferred,
+// as the modules that created the synthetic lockups tie them to the lock owner.
+func (k Keeper) TransferLock(ctx sdk.Context, lockID uint64, owner, newOwner sdk.AccAddress) error {
+	lock, err := k.GetLockByID(ctx, lockID)
+	if err != nil {
+		return err
+	}
+
+	if owner.String() != lock.Owner {
+		return types.ErrNotLockOwner
+	}
+	if owner.Equals(newOwner) {
+		return fmt.Errorf("lock %d is already owned by %s", lockID, newOwner)
+	}
+
+	if k.HasAnySyntheticLockups(ctx, lock.ID) {
+		return fmt.Errorf("cannot transfer lock %d with synthetic lockup", lock.ID)
+	}
+
+	// remove the lock refs keyed by the current owner
+	err = k.deleteLockRefs(ctx, unlockingPrefix(lock.IsUnlocking()), *lock)
+	if err != nil {
+		return err
+	}
+
+	lock.Owner = newOwner.String()
+	err = k.setLockAndAddLockRefs(ctx, *lock)
+	if err != nil {
+		return err
+	}
+
+	if k.hooks != nil {
+		k.hooks.OnLockTransfer(ctx, lock.ID, owner, newOwner)
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		createTransferLockEvent(owner, lock),
+	})
+
+	return nil
+}
+
+// MergeLocks merges the given locks of the owner into a new lock, and returns the ID of the new lock.
+// The locks must lock the same single denom for the same duration and not be unlocking. Their synthetic
+// lockups must have the same synthetic denoms and durations, and not be unlocking either. These are
+// re-keyed to the new lock, so that e.g. superfluid delegated locks stay delegated.
+// As coins only move between locks of the same denom and duration, the accumulation stores are left as they are.
+// Locks with synthetic lockups can only be merged if hooks are set, as the modules that created
+// the synthetic lockups need to be notified with the OnLocksMerged hook.
+func (k Keeper) MergeLocks(ctx sdk.Context, owner sdk.AccAddress, lockIDs []uint64) (uint64, error) {
+	if len(lockIDs) < 2 {
+		return 0, fmt.Errorf("at least two locks are needed to merge, got %d", len(lockIDs))
+	}
+
+	locks := make([]types.PeriodLock, 0, len(lockIDs))
+	seen := make(map[uint64]bool, len(lockIDs))
+	var mergedCoin sdk.Coin
+	var synthLocks []types.SyntheticLock
+	for _, lockID := range lockIDs {
+		if seen[lockID] {
+			return 0, fmt.Errorf("duplicate lock %d", lockID)
+		}
+		seen[lockID] = true
+
+		lock, err := k.GetLockByID(ctx, lockID)
+		if err != nil {
+			return 0, err
+		}
+		if owner.String() != lock.Owner {
+			return 0, types.ErrNotLockOwner
+		}
+		if lock.IsUnlocking() {
+			return 0, fmt.Errorf("cannot merge unlocking lock %d", lock.ID)
+		}
+		coin, err := lock.SingleCoin()
+		if err != nil {
+			return 0, err
+		}
+
+		lockSynthLocks := k.GetAllSyntheticLockupsByLockup(ctx, lock.ID)
+		for _, synthLock := range lockSynthLocks {
+			if synthLock.IsUnlocking() {
+				return 0, fmt.Errorf("cannot merge lock %d with unlocking synthetic lockup %s", lock.ID, synthLock.SynthDenom)
+			}
+		}
+
+		if len(locks) == 0 {
+			mergedCoin = coin
+			synthLocks = lockSynthLocks
+		} else {
+			if coin.Denom != mergedCoin.Denom || lock.Duration != locks[0].Duration {
+				return 0, fmt.Errorf("cannot merge lock %d with lock %d, as they lock a different denom or duration", lock.ID, locks[0].ID)
+			}
+			if !haveSameSyntheticLockups(synthLocks, lockSynthLocks) {
+				return 0, fmt.Errorf("cannot merge lock %d with lock %d, as they have different synthetic lockups", lock.ID, locks[0].ID)
+			}
+			mergedCoin = mergedCoin.Add(coin)
+		}
+		locks = append(locks, *lock)
+	}
+
+	if len(synthLocks) != 0 && k.hooks == nil {
+		return 0, fmt.Errorf("cannot merge locks with synthetic lockup without lockup hooks")
+	}
+
+	newLockID := k.GetLastLockID(ctx) + 1
+	k.SetLastLockID(ctx, newLockID)
+	newLock := types.NewPeriodLock(newLockID, owner, locks[0].Duration, time.Time{}, sdk.NewCoins(mergedCoin))
+
+	events := sdk.Events{}
+	for _, lock := range locks {
+		lock := lock
+		for _, synthLock := range synthLocks {
+			synthLock.UnderlyingLockId = lock.ID
+			k.deleteSyntheticLockupObject(ctx, lock.ID, synthLock.SynthDenom)
+			err := k.deleteSyntheticLockRefs(ctx, lock, synthLock)
+			if err != nil {
+				return 0, err
+			}
+		}
+
+		err := k.deleteLockRefs(ctx, types.KeyPrefixNotUnlocking, lock)
+		if err != nil {
+			return 0, err
+		}
+		k.deleteLock(ctx, lock.ID)
+
+		events = events.AppendEvent(createMergeLocksEvent(&lock, newLockID))
+	}
+
+	err := k.setLockAndAddLockRefs(ctx, newLock)
+	if err != nil {
+		return 0, err
+	}
+	for _, synthLock := range synthLocks {
+		synthLock.UnderlyingLockId = newLockID
+		err = k.setSyntheticLockAndResetRefs(ctx, newLock, synthLock)
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	if k.hooks != nil {
+		k.hooks.OnLocksMerged(ctx, lockIDs, newLockID)
+	}
+
+	ctx.EventManager().EmitEvents(events)
+
+	return newLockID, nil
+}
+
+// haveSameSyntheticLockups returns true if both lists of synthetic lockups, sorted by synthetic denom,
+// have the same synthetic denoms and durations.
+func haveSameSyntheticLockups(synthLocks, otherSynthLocks []types.SyntheticLock) bool {
+	if len(synthLocks) != len(otherSynthLocks) {
+		return false
+	}
+	for i := range synthLocks {
+		if synthLocks[i].SynthDenom != otherSynthLocks[i].SynthDenom || synthLocks[i].Duration != otherSynthLocks[i].Duration {
+			return false
+		}
+	}
+	return true
+}
+
+// SplitLock splits each of the given amounts off the lock into a new lock with the same owner, denom,
+// duration and synthetic lockups. The remainder of the lock stays in it, so the amounts must add up to
+// less than the locked amount. Locks that are unlocking cannot be split.
+// Returns the IDs of the new locks, in the order of the given amounts.
+func (k Keeper) SplitLock(ctx sdk.Context, lockID uint64, owner sdk.AccAddress, amounts []sdk.Int) ([]uint64, error) {
+	lock, err := k.GetLockByID(ctx, lockID)
+	if err != nil {
+		return nil, err
+	}
+
+	if owner.String() != lock.Owner {
+		return nil, types.ErrNotLockOwner
+	}
+
+	lockedCoin, err := lock.SingleCoin()
+	if err != nil {
+		return nil, err
+	}
+
+	totalAmount := sdk.ZeroInt()
+	for _, amount := range amounts {
+		if !amount.IsPositive() {
+			return nil, fmt.Errorf("split amounts must be positive, got %s", amount)
+		}
+		totalAmount = totalAmount.Add(amount)
+	}
+	if totalAmount.GTE(lockedCoin.Amount) {
+		return nil, fmt.Errorf("split amounts must add up to less than the locked amount %s, got %s", lockedCoin.Amount, totalAmount)
+	}
+
+	newLockIDs := make([]uint64, 0, len(amounts))
+	for _, amount := range amounts {
+		splitLock, err := k.splitLock(ctx, *lock, sdk.NewCoins(sdk.NewCoin(lockedCoin.Denom, amount)), false)
+		if err != nil {
+			return nil, err
+		}
+		lock.Coins = lock.Coins.Sub(splitLock.Coins)
+		newLockIDs = append(newLockIDs, splitLock.ID)
+	}
+
+	return newLockIDs, nil
+}
+
 // splitLock splits a lock with the given amount, and stores split new lock to the state.
+// The new lock gets a copy of each synthetic lockup of the lock, with the same synthetic denom, duration
+// and end time, so that the synthetic lockups are split in proportion to the coins.
 // Returns the new lock after modifying the state of the old lock.
 func (k Keeper) splitLock(ctx sdk.Context, lock types.PeriodLock, coins sdk.Coins, forceUnlock bool) (types.PeriodLock, error) {
 	if !forceUnlock && lock.IsUnlocking() {
@@ -702,8 +972,30 @@ func (k Keeper) splitLock(ctx sdk.Context, lock types.PeriodLock, coins sdk.Coin
 
 	splitLock := types.NewPeriodLock(splitLockID, lock.OwnerAddress(), lock.Duration, lock.EndTime, coins)
 
-	err = k.setLock(ctx, splitLock)
-	return splitLock, err
+	err = k.setLockAndAddLockRefs(ctx, splitLock)
+	if err != nil {
+		return types.PeriodLock{}, err
+	}
+
+	// The accumulation stores, both of the lock and of its synthetic lockups, are left as they are,
+	// as the coins only move between two locks of the same duration.
+	for _, synthLock := range k.GetAllSyntheticLockupsByLockup(ctx, lock.ID) {
+		synthLock.UnderlyingLockId = splitLock.ID
+		err = k.setSyntheticLockAndResetRefs(ctx, splitLock, synthLock)
+		if err != nil {
+			return types.PeriodLock{}, err
+		}
+	}
+
+	if k.hooks != nil {
+		k.hooks.OnLockSplit(ctx, lock.ID, splitLock.ID, coins)
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		createSplitLockEvent(lock.ID, &splitLock),
+	})
+
+	return splitLock, nil
 }
 
 func (k Keeper) getCoinsFromLocks(locks []types.PeriodLock) sdk.Coins {
@@ -149,7 +149,7 @@ func (suite *KeeperTestSuite) TestUnlock() {
 		partialUnlocking := tc.unlockingCoins.IsAllLT(initialLockCoins) && tc.unlockingCoins != nil
 
 		// begin unlocking
-		err = lockupKeeper.BeginUnlock(ctx, lock.ID, tc.unlockingCoins)
+		unlockingLockID, err := lockupKeeper.BeginUnlock(ctx, lock.ID, tc.unlockingCoins)
 
 		if tc.expectedBeginUnlockPass {
 			suite.Require().NoError(err)
@@ -173,6 +173,7 @@ func (suite *KeeperTestSuite) TestUnlock() {
 			}
 
 			// check lock state
+			suite.Require().Equal(lock.ID, unlockingLockID)
 			suite.Require().Equal(ctx.BlockTime().Add(lock.Duration), lock.EndTime)
 			suite.Require().Equal(true, lock.IsUnlocking())
 
@@ -807,6 +808,79 @@ func (suite *KeeperTestSuite) TestSlashTokensFromLockByID() {
 	suite.Require().Error(err)
 }
 
+func (suite *KeeperTestSuite) TestMigrateLockedCoins() {
+	for _, isUnlocking := range []bool{false, true} {
+		suite.SetupTest()
+
+		addr := sdk.AccAddress([]byte("addr1---------------"))
+		coins := sdk.Coins{sdk.NewInt64Coin("stake", 10)}
+		migratedCoins := sdk.Coins{sdk.NewInt64Coin("foo", 20)}
+		suite.LockTokens(addr, coins, time.Second)
+		suite.FundAcc(addr, migratedCoins)
+		if isUnlocking {
+			_, err := suite.App.LockupKeeper.BeginUnlock(suite.Ctx, 1, nil)
+			suite.Require().NoError(err)
+		}
+		lockBefore, err := suite.App.LockupKeeper.GetLockByID(suite.Ctx, 1)
+		suite.Require().NoError(err)
+
+		// the migration fails when migrate does
+		failedCtx, _ := suite.Ctx.CacheContext()
+		_, err = suite.App.LockupKeeper.MigrateLockedCoins(failedCtx, 1, func(lockedCoins sdk.Coins) (sdk.Coins, error) {
+			return nil, fmt.Errorf("migration failed")
+		})
+		suite.Require().Error(err)
+
+		cacheCtx, _ := suite.Ctx.CacheContext()
+		lock, err := suite.App.LockupKeeper.MigrateLockedCoins(cacheCtx, 1, func(lockedCoins sdk.Coins) (sdk.Coins, error) {
+			// the locked coins are released to the owner before being migrated
+			suite.Require().Equal(coins, lockedCoins)
+			suite.Require().Equal(coins, suite.App.BankKeeper.GetAllBalances(cacheCtx, addr).Sub(migratedCoins))
+			return migratedCoins, nil
+		})
+		suite.Require().NoError(err)
+		suite.Ctx = cacheCtx
+
+		// the lock keeps its ID, duration and end time
+		suite.Require().Equal(lockBefore.ID, lock.ID)
+		suite.Require().Equal(lockBefore.Duration, lock.Duration)
+		suite.Require().Equal(lockBefore.EndTime, lock.EndTime)
+		suite.Require().Equal(migratedCoins, lock.Coins)
+		storedLock, err := suite.App.LockupKeeper.GetLockByID(suite.Ctx, 1)
+		suite.Require().NoError(err)
+		suite.Require().Equal(lock, storedLock)
+
+		suite.Require().Equal(coins, suite.App.BankKeeper.GetAllBalances(suite.Ctx, addr))
+		suite.Require().Equal(migratedCoins, suite.App.LockupKeeper.GetModuleBalance(suite.Ctx))
+
+		// the lock is referred to and accumulated under the migrated coins only
+		suite.Require().Len(suite.App.LockupKeeper.GetLocksLongerThanDurationDenom(suite.Ctx, "stake", 0), 0)
+		suite.Require().Len(suite.App.LockupKeeper.GetLocksLongerThanDurationDenom(suite.Ctx, "foo", 0), 1)
+		acc := suite.App.LockupKeeper.GetPeriodLocksAccumulation(suite.Ctx, types.QueryCondition{
+			Denom:    "stake",
+			Duration: time.Second,
+		})
+		suite.Require().Equal(int64(0), acc.Int64())
+		acc = suite.App.LockupKeeper.GetPeriodLocksAccumulation(suite.Ctx, types.QueryCondition{
+			Denom:    "foo",
+			Duration: time.Second,
+		})
+		suite.Require().Equal(int64(20), acc.Int64())
+		suite.Require().Equal(isUnlocking, len(suite.App.LockupKeeper.GetAccountUnlockingCoins(suite.Ctx, addr)) > 0)
+	}
+
+	// locks with synthetic lockups cannot be migrated
+	suite.SetupTest()
+	addr := sdk.AccAddress([]byte("addr1---------------"))
+	suite.LockTokens(addr, sdk.Coins{sdk.NewInt64Coin("stake", 10)}, time.Second)
+	err := suite.App.LockupKeeper.CreateSyntheticLockup(suite.Ctx, 1, "synthstakestakedtovalidator1", time.Second, false)
+	suite.Require().NoError(err)
+	_, err = suite.App.LockupKeeper.MigrateLockedCoins(suite.Ctx, 1, func(lockedCoins sdk.Coins) (sdk.Coins, error) {
+		return lockedCoins, nil
+	})
+	suite.Require().Error(err)
+}
+
 func (suite *KeeperTestSuite) TestEditLockup() {
 	suite.SetupTest()
 
@@ -991,3 +1065,368 @@ func (suite *KeeperTestSuite) TestPartialForceUnlock() {
 		}
 	}
 }
+
+func (suite *KeeperTestSuite) TestSplitLock() {
+	addr1 := sdk.AccAddress([]byte("addr1---------------"))
+	addr2 := sdk.AccAddress([]byte("addr2---------------"))
+	defaultCoins := sdk.Coins{sdk.NewInt64Coin("stake", 100)}
+
+	testCases := []struct {
+		name            string
+		sender          sdk.AccAddress
+		amounts         []sdk.Int
+		isSynthetic     bool
+		isUnlocking     bool
+		expectedAmounts []sdk.Int
+		expectedPass    bool
+	}{
+		{
+			name:         "split a single amount",
+			sender:       addr1,
+			amounts:      []sdk.Int{sdk.NewInt(10)},
+			expectedPass: true,
+		},
+		{
+			name:         "split several amounts",
+			sender:       addr1,
+			amounts:      []sdk.Int{sdk.NewInt(10), sdk.NewInt(20), sdk.NewInt(30)},
+			expectedPass: true,
+		},
+		{
+			name:         "split lock with synthetic lockup",
+			sender:       addr1,
+			amounts:      []sdk.Int{sdk.NewInt(10), sdk.NewInt(20)},
+			isSynthetic:  true,
+			expectedPass: true,
+		},
+		{
+			name:    "split amounts add up to the locked amount",
+			sender:  addr1,
+			amounts: []sdk.Int{sdk.NewInt(50), sdk.NewInt(50)},
+		},
+		{
+			name:    "zero split amount",
+			sender:  addr1,
+			amounts: []sdk.Int{sdk.NewInt(10), sdk.ZeroInt()},
+		},
+		{
+			name:    "sender is not the lock owner",
+			sender:  addr2,
+			amounts: []sdk.Int{sdk.NewInt(10)},
+		},
+		{
+			name:        "lock is unlocking",
+			sender:      addr1,
+			amounts:     []sdk.Int{sdk.NewInt(10)},
+			isUnlocking: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			suite.LockTokens(addr1, defaultCoins, time.Second)
+			if tc.isSynthetic {
+				err := suite.App.LockupKeeper.CreateSyntheticLockup(suite.Ctx, 1, "synthstakestakedtovalidator1", time.Second, false)
+				suite.Require().NoError(err)
+			}
+			if tc.isUnlocking {
+				_, err := suite.App.LockupKeeper.BeginUnlock(suite.Ctx, 1, nil)
+				suite.Require().NoError(err)
+			}
+
+			newLockIDs, err := suite.App.LockupKeeper.SplitLock(suite.Ctx, 1, tc.sender, tc.amounts)
+			if !tc.expectedPass {
+				suite.Require().Error(err)
+				return
+			}
+			suite.Require().NoError(err)
+			suite.Require().Len(newLockIDs, len(tc.amounts))
+			suite.AssertEventEmitted(suite.Ctx, types.TypeEvtSplitLock, len(tc.amounts))
+
+			remaining := defaultCoins[0].Amount
+			for i, newLockID := range newLockIDs {
+				newLock, err := suite.App.LockupKeeper.GetLockByID(suite.Ctx, newLockID)
+				suite.Require().NoError(err)
+				suite.Require().Equal(addr1.String(), newLock.Owner)
+				suite.Require().Equal(time.Second, newLock.Duration)
+				suite.Require().Equal(sdk.Coins{sdk.NewCoin("stake", tc.amounts[i])}, newLock.Coins)
+				remaining = remaining.Sub(tc.amounts[i])
+
+				synthLocks := suite.App.LockupKeeper.GetAllSyntheticLockupsByLockup(suite.Ctx, newLockID)
+				if tc.isSynthetic {
+					suite.Require().Len(synthLocks, 1)
+					suite.Require().Equal("synthstakestakedtovalidator1", synthLocks[0].SynthDenom)
+				} else {
+					suite.Require().Len(synthLocks, 0)
+				}
+			}
+
+			lock, err := suite.App.LockupKeeper.GetLockByID(suite.Ctx, 1)
+			suite.Require().NoError(err)
+			suite.Require().Equal(sdk.Coins{sdk.NewCoin("stake", remaining)}, lock.Coins)
+
+			// the new locks are indexed as not unlocking locks of the owner
+			locks := suite.App.LockupKeeper.GetAccountLockedLongerDurationNotUnlockingOnly(suite.Ctx, addr1, time.Second)
+			suite.Require().Len(locks, len(tc.amounts)+1)
+
+			// accumulation stores are unchanged by the split
+			acc := suite.App.LockupKeeper.GetPeriodLocksAccumulation(suite.Ctx, types.QueryCondition{
+				Denom:    "stake",
+				Duration: time.Second,
+			})
+			suite.Require().Equal(defaultCoins[0].Amount, acc)
+			if tc.isSynthetic {
+				acc = suite.App.LockupKeeper.GetPeriodLocksAccumulation(suite.Ctx, types.QueryCondition{
+					Denom:    "synthstakestakedtovalidator1",
+					Duration: time.Second,
+				})
+				suite.Require().Equal(defaultCoins[0].Amount, acc)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestTransferLock() {
+	addr1 := sdk.AccAddress([]byte("addr1---------------"))
+	addr2 := sdk.AccAddress([]byte("addr2---------------"))
+	defaultCoins := sdk.Coins{sdk.NewInt64Coin("stake", 100)}
+	synthDenom := "synthstakestakedtovalidator1"
+
+	testCases := []struct {
+		name         string
+		sender       sdk.AccAddress
+		newOwner     sdk.AccAddress
+		isSynthetic  bool
+		isUnlocking  bool
+		expectedPass bool
+	}{
+		{
+			name:         "transfer lock",
+			sender:       addr1,
+			newOwner:     addr2,
+			expectedPass: true,
+		},
+		{
+			name:         "transfer unlocking lock",
+			sender:       addr1,
+			newOwner:     addr2,
+			isUnlocking:  true,
+			expectedPass: true,
+		},
+		{
+			name:        "transfer lock with synthetic lockup",
+			sender:      addr1,
+			newOwner:    addr2,
+			isSynthetic: true,
+		},
+		{
+			name:     "sender is not the lock owner",
+			sender:   addr2,
+			newOwner: addr2,
+		},
+		{
+			name:     "transfer to the lock owner",
+			sender:   addr1,
+			newOwner: addr1,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			suite.LockTokens(addr1, defaultCoins, time.Second)
+			if tc.isSynthetic {
+				err := suite.App.LockupKeeper.CreateSyntheticLockup(suite.Ctx, 1, synthDenom, time.Second, false)
+				suite.Require().NoError(err)
+			}
+			if tc.isUnlocking {
+				_, err := suite.App.LockupKeeper.BeginUnlock(suite.Ctx, 1, nil)
+				suite.Require().NoError(err)
+			}
+
+			err := suite.App.LockupKeeper.TransferLock(suite.Ctx, 1, tc.sender, tc.newOwner)
+			if !tc.expectedPass {
+				suite.Require().Error(err)
+				suite.Require().Len(suite.App.LockupKeeper.GetAccountPeriodLocks(suite.Ctx, addr1), 1)
+				return
+			}
+			suite.Require().NoError(err)
+			suite.AssertEventEmitted(suite.Ctx, types.TypeEvtTransferLock, 1)
+
+			lock, err := suite.App.LockupKeeper.GetLockByID(suite.Ctx, 1)
+			suite.Require().NoError(err)
+			suite.Require().Equal(addr2.String(), lock.Owner)
+
+			// the account based lock refs are moved to the new owner
+			suite.Require().Len(suite.App.LockupKeeper.GetAccountPeriodLocks(suite.Ctx, addr1), 0)
+			suite.Require().Len(suite.App.LockupKeeper.GetAccountPeriodLocks(suite.Ctx, addr2), 1)
+			suite.Require().Len(suite.App.LockupKeeper.GetAccountLockedLongerDurationDenom(suite.Ctx, addr1, "stake", 0), 0)
+			suite.Require().Len(suite.App.LockupKeeper.GetAccountLockedLongerDurationDenom(suite.Ctx, addr2, "stake", 0), 1)
+			if tc.isUnlocking {
+				suite.Require().Equal(defaultCoins, suite.App.LockupKeeper.GetAccountUnlockingCoins(suite.Ctx, addr2))
+				suite.Require().True(suite.App.LockupKeeper.GetAccountUnlockingCoins(suite.Ctx, addr1).Empty())
+			} else {
+				suite.Require().Len(suite.App.LockupKeeper.GetAccountLockedLongerDurationNotUnlockingOnly(suite.Ctx, addr2, 0), 1)
+			}
+
+			// the new owner receives the coins once unlocked
+			suite.Require().NoError(suite.App.LockupKeeper.ForceUnlock(suite.Ctx, *lock))
+			suite.Require().Equal(defaultCoins, suite.App.BankKeeper.GetAllBalances(suite.Ctx, addr2))
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestMergeLocks() {
+	addr1 := sdk.AccAddress([]byte("addr1---------------"))
+	addr2 := sdk.AccAddress([]byte("addr2---------------"))
+	synthDenom := "synthstakestakedtovalidator1"
+
+	type lockSetup struct {
+		owner       sdk.AccAddress
+		coin        sdk.Coin
+		duration    time.Duration
+		synthDenoms []string
+		unlocking   bool
+	}
+	defaultLock := lockSetup{owner: addr1, coin: sdk.NewInt64Coin("stake", 100), duration: time.Second}
+	withSynth := func(lock lockSetup, synthDenoms ...string) lockSetup {
+		lock.synthDenoms = synthDenoms
+		return lock
+	}
+
+	testCases := []struct {
+		name         string
+		locks        []lockSetup
+		lockIDs      []uint64
+		expectedPass bool
+	}{
+		{
+			name:         "merge two locks",
+			locks:        []lockSetup{defaultLock, defaultLock},
+			lockIDs:      []uint64{1, 2},
+			expectedPass: true,
+		},
+		{
+			name:         "merge some of the owner's locks",
+			locks:        []lockSetup{defaultLock, defaultLock, defaultLock},
+			lockIDs:      []uint64{3, 1},
+			expectedPass: true,
+		},
+		{
+			name:         "merge locks with the same synthetic lockups",
+			locks:        []lockSetup{withSynth(defaultLock, synthDenom), withSynth(defaultLock, synthDenom)},
+			lockIDs:      []uint64{1, 2},
+			expectedPass: true,
+		},
+		{
+			name:    "merge locks with different synthetic lockups",
+			locks:   []lockSetup{withSynth(defaultLock, synthDenom), withSynth(defaultLock, synthDenom+"2")},
+			lockIDs: []uint64{1, 2},
+		},
+		{
+			name:    "merge a lock with synthetic lockup and a lock without",
+			locks:   []lockSetup{withSynth(defaultLock, synthDenom), defaultLock},
+			lockIDs: []uint64{1, 2},
+		},
+		{
+			name:    "merge locks of different durations",
+			locks:   []lockSetup{defaultLock, {owner: addr1, coin: sdk.NewInt64Coin("stake", 100), duration: time.Hour}},
+			lockIDs: []uint64{1, 2},
+		},
+		{
+			name:    "merge locks of different denoms",
+			locks:   []lockSetup{defaultLock, {owner: addr1, coin: sdk.NewInt64Coin("foo", 100), duration: time.Second}},
+			lockIDs: []uint64{1, 2},
+		},
+		{
+			name:    "merge an unlocking lock",
+			locks:   []lockSetup{defaultLock, {owner: addr1, coin: sdk.NewInt64Coin("stake", 100), duration: time.Second, unlocking: true}},
+			lockIDs: []uint64{1, 2},
+		},
+		{
+			name:    "merge a lock of another owner",
+			locks:   []lockSetup{defaultLock, {owner: addr2, coin: sdk.NewInt64Coin("stake", 100), duration: time.Second}},
+			lockIDs: []uint64{1, 2},
+		},
+		{
+			name:    "merge a lock with itself",
+			locks:   []lockSetup{defaultLock, defaultLock},
+			lockIDs: []uint64{1, 1},
+		},
+		{
+			name:    "merge a single lock",
+			locks:   []lockSetup{defaultLock},
+			lockIDs: []uint64{1},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			lockupKeeper := suite.App.LockupKeeper
+
+			for _, lock := range tc.locks {
+				// locks are created directly, as locking through the msg server adds to existing locks
+				suite.FundAcc(lock.owner, sdk.NewCoins(lock.coin))
+				periodLock, err := lockupKeeper.CreateLock(suite.Ctx, lock.owner, sdk.NewCoins(lock.coin), lock.duration)
+				suite.Require().NoError(err)
+				lockID := periodLock.ID
+				for _, synthDenom := range lock.synthDenoms {
+					err := lockupKeeper.CreateSyntheticLockup(suite.Ctx, lockID, synthDenom, time.Second, false)
+					suite.Require().NoError(err)
+				}
+				if lock.unlocking {
+					_, err := lockupKeeper.BeginUnlock(suite.Ctx, lockID, nil)
+					suite.Require().NoError(err)
+				}
+			}
+			accumulationBefore := lockupKeeper.GetPeriodLocksAccumulation(suite.Ctx, types.QueryCondition{Denom: "stake", Duration: time.Second})
+			synthAccumulationBefore := lockupKeeper.GetPeriodLocksAccumulation(suite.Ctx, types.QueryCondition{Denom: synthDenom, Duration: time.Second})
+			nextLockID := lockupKeeper.GetLastLockID(suite.Ctx) + 1
+
+			newLockID, err := lockupKeeper.MergeLocks(suite.Ctx, addr1, tc.lockIDs)
+			if !tc.expectedPass {
+				suite.Require().Error(err)
+				suite.AssertEventEmitted(suite.Ctx, types.TypeEvtMergeLocks, 0)
+				return
+			}
+			suite.Require().NoError(err)
+			suite.Require().Equal(nextLockID, newLockID)
+			suite.AssertEventEmitted(suite.Ctx, types.TypeEvtMergeLocks, len(tc.lockIDs))
+
+			// the merged locks and their synthetic lockups are replaced by the new lock
+			expectedCoins := sdk.NewCoins()
+			for _, lockID := range tc.lockIDs {
+				expectedCoins = expectedCoins.Add(tc.locks[lockID-1].coin)
+				_, err := lockupKeeper.GetLockByID(suite.Ctx, lockID)
+				suite.Require().Error(err)
+				suite.Require().Len(lockupKeeper.GetAllSyntheticLockupsByLockup(suite.Ctx, lockID), 0)
+			}
+			newLock, err := lockupKeeper.GetLockByID(suite.Ctx, newLockID)
+			suite.Require().NoError(err)
+			suite.Require().Equal(addr1.String(), newLock.Owner)
+			suite.Require().Equal(expectedCoins, newLock.Coins)
+			suite.Require().Equal(time.Second, newLock.Duration)
+			suite.Require().Len(lockupKeeper.GetAllSyntheticLockupsByLockup(suite.Ctx, newLockID), len(tc.locks[0].synthDenoms))
+
+			// lock refs point to the new lock only
+			accountLocks := lockupKeeper.GetAccountLockedDurationNotUnlockingOnly(suite.Ctx, addr1, "stake", time.Second)
+			suite.Require().Len(accountLocks, len(tc.locks)-len(tc.lockIDs)+1)
+			suite.Require().Equal(newLockID, accountLocks[len(accountLocks)-1].ID)
+			for _, synthDenom := range tc.locks[0].synthDenoms {
+				synthLocks := lockupKeeper.GetAccountLockedLongerDurationDenom(suite.Ctx, addr1, synthDenom, 0)
+				suite.Require().Len(synthLocks, 1)
+				suite.Require().Equal(newLockID, synthLocks[0].ID)
+			}
+
+			// accumulation stores are unchanged
+			suite.Require().Equal(accumulationBefore, lockupKeeper.GetPeriodLocksAccumulation(suite.Ctx, types.QueryCondition{Denom: "stake", Duration: time.Second}))
+			suite.Require().Equal(synthAccumulationBefore, lockupKeeper.GetPeriodLocksAccumulation(suite.Ctx, types.QueryCondition{Denom: synthDenom, Duration: time.Second}))
+
+			// the merged coins can be unlocked from the new lock
+			suite.Require().NoError(lockupKeeper.ForceUnlock(suite.Ctx, *newLock))
+			suite.Require().Equal(expectedCoins, suite.App.BankKeeper.GetAllBalances(suite.Ctx, addr1))
+		})
+	}
+}
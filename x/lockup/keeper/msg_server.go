@@ -91,14 +91,14 @@ func (server msgServer) BeginUnlocking(goCtx context.Context, msg *types.MsgBegi
 		return nil, sdkerrors.Wrap(types.ErrNotLockOwner, fmt.Sprintf("msg sender (%s) and lock owner (%s) does not match", msg.Owner, lock.Owner))
 	}
 
-	err = server.keeper.BeginUnlock(ctx, lock.ID, msg.Coins)
+	unlockingLockID, err := server.keeper.BeginUnlock(ctx, lock.ID, msg.Coins)
 	if err != nil {
 		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
 	}
 
-	// N.B. begin unlock event is emitted downstream in the keeper method.
+	// N.B. begin unlock and split lock events are emitted downstream in the keeper method.
 
-	return &types.MsgBeginUnlockingResponse{}, nil
+	return &types.MsgBeginUnlockingResponse{Success: true, UnlockingLockId: unlockingLockID}, nil
 }
 
 // BeginUnlockingAll begins unlocking for all the locks that the account has by iterating all the not-unlocking locks the account holds.
@@ -143,6 +143,35 @@ func createBeginUnlockEvent(lock *types.PeriodLock) sdk.Event {
 	)
 }
 
+func createSplitLockEvent(parentLockID uint64, childLock *types.PeriodLock) sdk.Event {
+	return sdk.NewEvent(
+		types.TypeEvtSplitLock,
+		sdk.NewAttribute(types.AttributeParentLockID, osmoutils.Uint64ToString(parentLockID)),
+		sdk.NewAttribute(types.AttributeChildLockID, osmoutils.Uint64ToString(childLock.ID)),
+		sdk.NewAttribute(types.AttributePeriodLockOwner, childLock.Owner),
+		sdk.NewAttribute(types.AttributePeriodLockAmount, childLock.Coins.String()),
+	)
+}
+
+func createTransferLockEvent(prevOwner sdk.AccAddress, lock *types.PeriodLock) sdk.Event {
+	return sdk.NewEvent(
+		types.TypeEvtTransferLock,
+		sdk.NewAttribute(types.AttributePeriodLockID, osmoutils.Uint64ToString(lock.ID)),
+		sdk.NewAttribute(types.AttributePeriodLockOwner, prevOwner.String()),
+		sdk.NewAttribute(types.AttributePeriodLockNewOwner, lock.Owner),
+	)
+}
+
+func createMergeLocksEvent(mergedLock *types.PeriodLock, newLockID uint64) sdk.Event {
+	return sdk.NewEvent(
+		types.TypeEvtMergeLocks,
+		sdk.NewAttribute(types.AttributeMergedLockID, osmoutils.Uint64ToString(mergedLock.ID)),
+		sdk.NewAttribute(types.AttributeNewLockID, osmoutils.Uint64ToString(newLockID)),
+		sdk.NewAttribute(types.AttributePeriodLockOwner, mergedLock.Owner),
+		sdk.NewAttribute(types.AttributePeriodLockAmount, mergedLock.Coins.String()),
+	)
+}
+
 // ExtendLockup extends the duration of the existing lock.
 // ExtendLockup would fail if the original lock's duration is longer than the new duration,
 // OR if the lock is currently unlocking OR if the original lock has a synthetic lock.
@@ -222,3 +251,65 @@ func (server msgServer) ForceUnlock(goCtx context.Context, msg *types.MsgForceUn
 
 	return &types.MsgForceUnlockResponse{Success: true}, nil
 }
+
+// SplitLock splits each of the given amounts off the lock into a new lock of the same duration.
+// Locks that are unlocking cannot be split. The synthetic lockups of the lock are split along with it.
+func (server msgServer) SplitLock(goCtx context.Context, msg *types.MsgSplitLock) (*types.MsgSplitLockResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	owner, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	newLockIDs, err := server.keeper.SplitLock(ctx, msg.ID, owner, msg.Amounts)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	// N.B. split lock events are emitted downstream in the keeper method.
+
+	return &types.MsgSplitLockResponse{NewLockIds: newLockIDs}, nil
+}
+
+// TransferLock transfers the lock to a new owner, along with its synthetic lockups.
+func (server msgServer) TransferLock(goCtx context.Context, msg *types.MsgTransferLock) (*types.MsgTransferLockResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	owner, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		return nil, err
+	}
+	newOwner, err := sdk.AccAddressFromBech32(msg.NewOwner)
+	if err != nil {
+		return nil, err
+	}
+
+	err = server.keeper.TransferLock(ctx, msg.ID, owner, newOwner)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	// N.B. transfer lock event is emitted downstream in the keeper method.
+
+	return &types.MsgTransferLockResponse{}, nil
+}
+
+// MergeLocks merges locks of the same denom, duration and synthetic lockups into a new lock.
+func (server msgServer) MergeLocks(goCtx context.Context, msg *types.MsgMergeLocks) (*types.MsgMergeLocksResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	owner, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	newLockID, err := server.keeper.MergeLocks(ctx, owner, msg.LockIds)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	// N.B. merge locks events are emitted downstream in the keeper method.
+
+	return &types.MsgMergeLocksResponse{NewLockId: newLockID}, nil
+}
@@ -112,9 +112,10 @@ func (suite *KeeperTestSuite) TestMsgBeginUnlocking() {
 	}
 
 	tests := []struct {
-		name       string
-		param      param
-		expectPass bool
+		name        string
+		param       param
+		expectPass  bool
+		expectSplit bool
 	}{
 		{
 			name: "unlock full amount of tokens via begin unlock",
@@ -138,7 +139,8 @@ func (suite *KeeperTestSuite) TestMsgBeginUnlocking() {
 				duration:            time.Second,
 				coinsInOwnerAddress: sdk.Coins{sdk.NewInt64Coin("stake", 10)},
 			},
-			expectPass: true,
+			expectPass:  true,
+			expectSplit: true,
 		},
 		{
 			name: "unlock zero amount of tokens via begin unlock",
@@ -162,6 +164,19 @@ func (suite *KeeperTestSuite) TestMsgBeginUnlocking() {
 				duration:            time.Second,
 				coinsInOwnerAddress: sdk.Coins{sdk.NewInt64Coin("stake", 10)},
 			},
+			expectPass:  true,
+			expectSplit: true,
+		},
+		{
+			name: "unlock full amount of tokens via begin unlock for lockup with synthetic versions",
+			param: param{
+				coinsToLock:         sdk.Coins{sdk.NewInt64Coin("stake", 10)}, // setup wallet
+				isSyntheticLockup:   true,
+				coinsToUnlock:       sdk.Coins{sdk.NewInt64Coin("stake", 10)},       // setup wallet
+				lockOwner:           sdk.AccAddress([]byte("addr1---------------")), // setup wallet
+				duration:            time.Second,
+				coinsInOwnerAddress: sdk.Coins{sdk.NewInt64Coin("stake", 10)},
+			},
 			expectPass: false,
 		},
 	}
@@ -181,11 +196,32 @@ func (suite *KeeperTestSuite) TestMsgBeginUnlocking() {
 			suite.Require().NoError(err)
 		}
 
-		_, err = msgServer.BeginUnlocking(goCtx, types.NewMsgBeginUnlocking(test.param.lockOwner, resp.ID, test.param.coinsToUnlock))
+		unlockResp, err := msgServer.BeginUnlocking(goCtx, types.NewMsgBeginUnlocking(test.param.lockOwner, resp.ID, test.param.coinsToUnlock))
 
 		if test.expectPass {
 			suite.Require().NoError(err)
 			suite.AssertEventEmitted(suite.Ctx, types.TypeEvtBeginUnlock, 1)
+
+			unlockingLock, err := suite.App.LockupKeeper.GetLockByID(suite.Ctx, unlockResp.UnlockingLockId)
+			suite.Require().NoError(err)
+			suite.Require().True(unlockingLock.IsUnlocking())
+			if test.expectSplit {
+				suite.AssertEventEmitted(suite.Ctx, types.TypeEvtSplitLock, 1)
+				suite.Require().NotEqual(resp.ID, unlockResp.UnlockingLockId)
+				suite.Require().Equal(test.param.coinsToUnlock, unlockingLock.Coins)
+			}
+
+			// the lock split off takes its share of the synthetic lockups along
+			if test.param.isSyntheticLockup {
+				_, err = suite.App.LockupKeeper.GetSyntheticLockup(suite.Ctx, unlockResp.UnlockingLockId, "synthetic")
+				suite.Require().NoError(err)
+				accum := suite.App.LockupKeeper.GetPeriodLocksAccumulation(suite.Ctx, types.QueryCondition{
+					LockQueryType: types.ByDuration,
+					Denom:         "synthetic",
+					Duration:      time.Second,
+				})
+				suite.Require().Equal(test.param.coinsToLock[0].Amount, accum)
+			}
 		} else {
 			suite.Require().Error(err)
 			suite.AssertEventEmitted(suite.Ctx, types.TypeEvtBeginUnlock, 0)
@@ -447,3 +483,150 @@ func (suite *KeeperTestSuite) TestMsgForceUnlock() {
 		}
 	}
 }
+
+func (suite *KeeperTestSuite) TestMsgSplitLock() {
+	addr1 := sdk.AccAddress([]byte("addr1---------------"))
+	addr2 := sdk.AccAddress([]byte("addr2---------------"))
+	coinsToLock := sdk.Coins{sdk.NewInt64Coin("stake", 100)}
+
+	tests := []struct {
+		name       string
+		sender     sdk.AccAddress
+		amounts    []sdk.Int
+		expectPass bool
+	}{
+		{
+			name:       "split lock",
+			sender:     addr1,
+			amounts:    []sdk.Int{sdk.NewInt(10), sdk.NewInt(20)},
+			expectPass: true,
+		},
+		{
+			name:    "split more than locked",
+			sender:  addr1,
+			amounts: []sdk.Int{sdk.NewInt(60), sdk.NewInt(60)},
+		},
+		{
+			name:    "split lock of another owner",
+			sender:  addr2,
+			amounts: []sdk.Int{sdk.NewInt(10)},
+		},
+	}
+
+	for _, test := range tests {
+		suite.SetupTest()
+
+		suite.FundAcc(addr1, coinsToLock)
+
+		msgServer := keeper.NewMsgServerImpl(suite.App.LockupKeeper)
+		goCtx := sdk.WrapSDKContext(suite.Ctx)
+		resp, err := msgServer.LockTokens(goCtx, types.NewMsgLockTokens(addr1, time.Second, coinsToLock))
+		suite.Require().NoError(err)
+
+		splitResp, err := msgServer.SplitLock(goCtx, types.NewMsgSplitLock(test.sender, resp.ID, test.amounts))
+
+		if test.expectPass {
+			suite.Require().NoError(err, test.name)
+			suite.Require().Equal([]uint64{resp.ID + 1, resp.ID + 2}, splitResp.NewLockIds, test.name)
+			suite.AssertEventEmitted(suite.Ctx, types.TypeEvtSplitLock, len(test.amounts))
+		} else {
+			suite.Require().Error(err, test.name)
+			suite.AssertEventEmitted(suite.Ctx, types.TypeEvtSplitLock, 0)
+		}
+	}
+}
+
+func (suite *KeeperTestSuite) TestMsgTransferLock() {
+	addr1 := sdk.AccAddress([]byte("addr1---------------"))
+	addr2 := sdk.AccAddress([]byte("addr2---------------"))
+	coinsToLock := sdk.Coins{sdk.NewInt64Coin("stake", 100)}
+
+	tests := []struct {
+		name       string
+		sender     sdk.AccAddress
+		newOwner   sdk.AccAddress
+		expectPass bool
+	}{
+		{
+			name:       "transfer lock",
+			sender:     addr1,
+			newOwner:   addr2,
+			expectPass: true,
+		},
+		{
+			name:     "transfer lock of another owner",
+			sender:   addr2,
+			newOwner: addr2,
+		},
+	}
+
+	for _, test := range tests {
+		suite.SetupTest()
+
+		suite.FundAcc(addr1, coinsToLock)
+
+		msgServer := keeper.NewMsgServerImpl(suite.App.LockupKeeper)
+		goCtx := sdk.WrapSDKContext(suite.Ctx)
+		resp, err := msgServer.LockTokens(goCtx, types.NewMsgLockTokens(addr1, time.Second, coinsToLock))
+		suite.Require().NoError(err)
+
+		_, err = msgServer.TransferLock(goCtx, types.NewMsgTransferLock(test.sender, resp.ID, test.newOwner))
+
+		if test.expectPass {
+			suite.Require().NoError(err, test.name)
+			suite.Require().Len(suite.App.LockupKeeper.GetAccountPeriodLocks(suite.Ctx, test.newOwner), 1, test.name)
+			suite.AssertEventEmitted(suite.Ctx, types.TypeEvtTransferLock, 1)
+		} else {
+			suite.Require().Error(err, test.name)
+			suite.AssertEventEmitted(suite.Ctx, types.TypeEvtTransferLock, 0)
+		}
+	}
+}
+
+func (suite *KeeperTestSuite) TestMsgMergeLocks() {
+	addr1 := sdk.AccAddress([]byte("addr1---------------"))
+	addr2 := sdk.AccAddress([]byte("addr2---------------"))
+	coinsToLock := sdk.Coins{sdk.NewInt64Coin("stake", 100)}
+
+	tests := []struct {
+		name       string
+		sender     sdk.AccAddress
+		expectPass bool
+	}{
+		{
+			name:       "merge locks",
+			sender:     addr1,
+			expectPass: true,
+		},
+		{
+			name:   "merge locks of another owner",
+			sender: addr2,
+		},
+	}
+
+	for _, test := range tests {
+		suite.SetupTest()
+
+		suite.FundAcc(addr1, coinsToLock)
+
+		msgServer := keeper.NewMsgServerImpl(suite.App.LockupKeeper)
+		goCtx := sdk.WrapSDKContext(suite.Ctx)
+		resp, err := msgServer.LockTokens(goCtx, types.NewMsgLockTokens(addr1, time.Second, coinsToLock))
+		suite.Require().NoError(err)
+		splitResp, err := msgServer.SplitLock(goCtx, types.NewMsgSplitLock(addr1, resp.ID, []sdk.Int{sdk.NewInt(10), sdk.NewInt(20)}))
+		suite.Require().NoError(err)
+		lockIDs := append([]uint64{resp.ID}, splitResp.NewLockIds...)
+
+		mergeResp, err := msgServer.MergeLocks(goCtx, types.NewMsgMergeLocks(test.sender, lockIDs))
+
+		if test.expectPass {
+			suite.Require().NoError(err, test.name)
+			suite.Require().Equal(resp.ID+3, mergeResp.NewLockId, test.name)
+			suite.Require().Len(suite.App.LockupKeeper.GetAccountPeriodLocks(suite.Ctx, addr1), 1, test.name)
+			suite.AssertEventEmitted(suite.Ctx, types.TypeEvtMergeLocks, len(lockIDs))
+		} else {
+			suite.Require().Error(err, test.name)
+			suite.AssertEventEmitted(suite.Ctx, types.TypeEvtMergeLocks, 0)
+		}
+	}
+}
@@ -6,6 +6,9 @@ const (
 	TypeEvtAddTokensToLock = "add_tokens_to_lock"
 	TypeEvtBeginUnlockAll  = "begin_unlock_all"
 	TypeEvtBeginUnlock     = "begin_unlock"
+	TypeEvtSplitLock       = "split_lock"
+	TypeEvtTransferLock    = "transfer_lock"
+	TypeEvtMergeLocks      = "merge_locks"
 
 	AttributePeriodLockID         = "period_lock_id"
 	AttributePeriodLockOwner      = "owner"
@@ -13,4 +16,9 @@ const (
 	AttributePeriodLockDuration   = "duration"
 	AttributePeriodLockUnlockTime = "unlock_time"
 	AttributeUnlockedCoins        = "unlocked_coins"
+	AttributeParentLockID         = "parent_lock_id"
+	AttributeChildLockID          = "child_lock_id"
+	AttributePeriodLockNewOwner   = "new_owner"
+	AttributeMergedLockID         = "merged_lock_id"
+	AttributeNewLockID            = "new_lock_id"
 )
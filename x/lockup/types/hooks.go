@@ -9,10 +9,14 @@ import (
 type LockupHooks interface {
 	AfterAddTokensToLock(ctx sdk.Context, address sdk.AccAddress, lockID uint64, amount sdk.Coins)
 	OnTokenLocked(ctx sdk.Context, address sdk.AccAddress, lockID uint64, amount sdk.Coins, lockDuration time.Duration, unlockTime time.Time)
-	OnStartUnlock(ctx sdk.Context, address sdk.AccAddress, lockID uint64, amount sdk.Coins, lockDuration time.Duration, unlockTime time.Time)
+	// OnStartUnlock may reject the unlocking of the lock, failing the operation that started it.
+	OnStartUnlock(ctx sdk.Context, address sdk.AccAddress, lockID uint64, amount sdk.Coins, lockDuration time.Duration, unlockTime time.Time) error
 	OnTokenUnlocked(ctx sdk.Context, address sdk.AccAddress, lockID uint64, amount sdk.Coins, lockDuration time.Duration, unlockTime time.Time)
 	OnTokenSlashed(ctx sdk.Context, lockID uint64, amount sdk.Coins)
 	OnLockupExtend(ctx sdk.Context, lockID uint64, prevDuration time.Duration, newDuration time.Duration)
+	OnLockSplit(ctx sdk.Context, parentLockID uint64, childLockID uint64, amount sdk.Coins)
+	OnLockTransfer(ctx sdk.Context, lockID uint64, prevOwner sdk.AccAddress, newOwner sdk.AccAddress)
+	OnLocksMerged(ctx sdk.Context, mergedLockIDs []uint64, newLockID uint64)
 }
 
 var _ LockupHooks = MultiLockupHooks{}
@@ -36,10 +40,14 @@ func (h MultiLockupHooks) OnTokenLocked(ctx sdk.Context, address sdk.AccAddress,
 	}
 }
 
-func (h MultiLockupHooks) OnStartUnlock(ctx sdk.Context, address sdk.AccAddress, lockID uint64, amount sdk.Coins, lockDuration time.Duration, unlockTime time.Time) {
+// OnStartUnlock runs the hooks in sequence, returning the first error.
+func (h MultiLockupHooks) OnStartUnlock(ctx sdk.Context, address sdk.AccAddress, lockID uint64, amount sdk.Coins, lockDuration time.Duration, unlockTime time.Time) error {
 	for i := range h {
-		h[i].OnStartUnlock(ctx, address, lockID, amount, lockDuration, unlockTime)
+		if err := h[i].OnStartUnlock(ctx, address, lockID, amount, lockDuration, unlockTime); err != nil {
+			return err
+		}
 	}
+	return nil
 }
 
 func (h MultiLockupHooks) OnTokenUnlocked(ctx sdk.Context, address sdk.AccAddress, lockID uint64, amount sdk.Coins, lockDuration time.Duration, unlockTime time.Time) {
@@ -59,3 +67,21 @@ func (h MultiLockupHooks) OnLockupExtend(ctx sdk.Context, lockID uint64, prevDur
 		h[i].OnLockupExtend(ctx, lockID, prevDuration, newDuration)
 	}
 }
+
+func (h MultiLockupHooks) OnLockSplit(ctx sdk.Context, parentLockID, childLockID uint64, amount sdk.Coins) {
+	for i := range h {
+		h[i].OnLockSplit(ctx, parentLockID, childLockID, amount)
+	}
+}
+
+func (h MultiLockupHooks) OnLockTransfer(ctx sdk.Context, lockID uint64, prevOwner, newOwner sdk.AccAddress) {
+	for i := range h {
+		h[i].OnLockTransfer(ctx, lockID, prevOwner, newOwner)
+	}
+}
+
+func (h MultiLockupHooks) OnLocksMerged(ctx sdk.Context, mergedLockIDs []uint64, newLockID uint64) {
+	for i := range h {
+		h[i].OnLocksMerged(ctx, mergedLockIDs, newLockID)
+	}
+}
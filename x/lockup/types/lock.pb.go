@@ -36,16 +36,21 @@ type LockQueryType int32
 const (
 	ByDuration LockQueryType = 0
 	ByTime     LockQueryType = 1
+	// NoLock queries the unlocked shares of a pool held by accounts, weighted by
+	// the time they were held for. It is only used by incentives gauges.
+	NoLock LockQueryType = 2
 )
 
 var LockQueryType_name = map[int32]string{
 	0: "ByDuration",
 	1: "ByTime",
+	2: "NoLock",
 }
 
 var LockQueryType_value = map[string]int32{
 	"ByDuration": 0,
 	"ByTime":     1,
+	"NoLock":     2,
 }
 
 func (x LockQueryType) String() string {
@@ -317,45 +322,45 @@ func init() {
 func init() { proto.RegisterFile("osmosis/lockup/lock.proto", fileDescriptor_7e9d7527a237b489) }
 
 var fileDescriptor_7e9d7527a237b489 = []byte{
-	// 595 bytes of a gzipped FileDescriptorProto
+	// 600 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x54, 0xbf, 0x6f, 0xd3, 0x40,
-	0x14, 0xb6, 0xdd, 0xa4, 0xb4, 0x57, 0x92, 0x46, 0xa7, 0x0e, 0x69, 0x00, 0x3b, 0xf2, 0x80, 0x22,
-	0xd4, 0xda, 0x24, 0xdd, 0x18, 0xdd, 0x30, 0x44, 0xea, 0x00, 0xa6, 0x62, 0x60, 0x89, 0xfc, 0xe3,
-	0x70, 0x4e, 0xb1, 0x7d, 0xc6, 0x3f, 0x0a, 0xfe, 0x0f, 0x18, 0x3b, 0x82, 0xc4, 0xc6, 0xc6, 0x5f,
-	0xd2, 0xb1, 0x23, 0x53, 0x8a, 0x12, 0xb1, 0x30, 0xf6, 0x2f, 0x40, 0x77, 0xe7, 0x4b, 0xd2, 0x22,
-	0xa4, 0x0e, 0x30, 0x9d, 0xdf, 0x7d, 0xef, 0x7d, 0xef, 0xdd, 0xf7, 0x3e, 0x19, 0xec, 0x93, 0x2c,
-	0x22, 0x19, 0xce, 0xcc, 0x90, 0x78, 0xd3, 0x22, 0x61, 0x87, 0x91, 0xa4, 0x24, 0x27, 0xb0, 0x59,
-	0x41, 0x06, 0x87, 0x3a, 0x7b, 0x01, 0x09, 0x08, 0x83, 0x4c, 0xfa, 0xc5, 0xb3, 0x3a, 0x6a, 0x40,
-	0x48, 0x10, 0x22, 0x93, 0x45, 0x6e, 0xf1, 0xd6, 0xf4, 0x8b, 0xd4, 0xc9, 0x31, 0x89, 0x2b, 0x5c,
-	0xbb, 0x8d, 0xe7, 0x38, 0x42, 0x59, 0xee, 0x44, 0x89, 0x20, 0xf0, 0x58, 0x1f, 0xd3, 0x75, 0x32,
-	0x64, 0x9e, 0xf5, 0x5d, 0x94, 0x3b, 0x7d, 0xd3, 0x23, 0xb8, 0x22, 0xd0, 0x7f, 0x2a, 0x00, 0xbc,
-	0x40, 0x29, 0x26, 0xfe, 0x09, 0xf1, 0xa6, 0xb0, 0x09, 0x94, 0xd1, 0xb0, 0x2d, 0x77, 0xe5, 0x5e,
-	0xcd, 0x56, 0x46, 0x43, 0xf8, 0x18, 0xd4, 0xc9, 0xfb, 0x18, 0xa5, 0x6d, 0xa5, 0x2b, 0xf7, 0xb6,
-	0xad, 0xd6, 0xf5, 0x4c, 0xbb, 0x5f, 0x3a, 0x51, 0xf8, 0x4c, 0x67, 0xd7, 0xba, 0xcd, 0x61, 0x38,
-	0x01, 0x5b, 0x62, 0xb2, 0xf6, 0x46, 0x57, 0xee, 0xed, 0x0c, 0xf6, 0x0d, 0x3e, 0x9a, 0x21, 0x46,
-	0x33, 0x86, 0x55, 0x82, 0xd5, 0xbf, 0x98, 0x69, 0xd2, 0xaf, 0x99, 0x06, 0x45, 0xc9, 0x01, 0x89,
-	0x70, 0x8e, 0xa2, 0x24, 0x2f, 0xaf, 0x67, 0xda, 0x2e, 0xe7, 0x17, 0x98, 0xfe, 0xe9, 0x4a, 0x93,
-	0xed, 0x25, 0x3b, 0xb4, 0xc1, 0x16, 0x8a, 0xfd, 0x31, 0x7d, 0x67, 0xbb, 0xc6, 0x3a, 0x75, 0xfe,
-	0xe8, 0x74, 0x2a, 0x44, 0xb0, 0x1e, 0xd0, 0x56, 0x2b, 0x52, 0x51, 0xa9, 0x9f, 0x53, 0xd2, 0x7b,
-	0x28, 0xf6, 0x69, 0x2a, 0x74, 0x40, 0x9d, 0x4a, 0x92, 0xb5, 0xeb, 0xdd, 0x0d, 0x36, 0x3a, 0x17,
-	0xcd, 0xa0, 0xa2, 0x19, 0x95, 0x68, 0xc6, 0x31, 0xc1, 0xb1, 0xf5, 0x94, 0xf2, 0x7d, 0xbb, 0xd2,
-	0x7a, 0x01, 0xce, 0x27, 0x85, 0x6b, 0x78, 0x24, 0x32, 0x2b, 0x85, 0xf9, 0x71, 0x98, 0xf9, 0x53,
-	0x33, 0x2f, 0x13, 0x94, 0xb1, 0x82, 0xcc, 0xe6, 0xcc, 0xfa, 0x67, 0x05, 0x34, 0x5f, 0x16, 0x28,
-	0x2d, 0x8f, 0x49, 0xec, 0x63, 0xf6, 0x92, 0xe7, 0x60, 0x97, 0xee, 0x7e, 0xfc, 0x8e, 0x5e, 0x8f,
-	0x69, 0x0d, 0x13, 0xbe, 0x39, 0x78, 0x64, 0xdc, 0xf4, 0x86, 0x41, 0x57, 0xc3, 0x8a, 0x4f, 0xcb,
-	0x04, 0xd9, 0x8d, 0x70, 0x3d, 0x84, 0x7b, 0xa0, 0xee, 0xa3, 0x98, 0x44, 0x7c, 0x45, 0x36, 0x0f,
-	0xa8, 0x4c, 0x77, 0x5f, 0xc8, 0x2d, 0x95, 0xfe, 0x26, 0xfd, 0x6b, 0xb0, 0xbd, 0xb4, 0xd7, 0x1d,
-	0xb4, 0x7f, 0x58, 0xb1, 0xb6, 0x38, 0xeb, 0xb2, 0x94, 0x8b, 0xbf, 0xa2, 0xd2, 0xbf, 0x28, 0xa0,
-	0xf1, 0xaa, 0x8c, 0xf3, 0x09, 0xca, 0xb1, 0xc7, 0x6c, 0x78, 0x00, 0x60, 0x11, 0xfb, 0x28, 0x0d,
-	0x4b, 0x1c, 0x07, 0x63, 0xa6, 0x12, 0xf6, 0x2b, 0x5b, 0xb6, 0x56, 0x08, 0xcd, 0x1d, 0xf9, 0x50,
-	0x03, 0x3b, 0x19, 0x2d, 0x1f, 0xaf, 0xeb, 0x00, 0xd8, 0xd5, 0x50, 0x88, 0xb1, 0xf4, 0xcc, 0xc6,
-	0x3f, 0xf2, 0xcc, 0xba, 0xe3, 0x6b, 0xff, 0xd3, 0xf1, 0x4f, 0xfa, 0xa0, 0x71, 0xc3, 0x00, 0xb0,
-	0x09, 0x80, 0x55, 0x0a, 0xee, 0x96, 0x04, 0x01, 0xd8, 0xb4, 0x4a, 0x3a, 0x54, 0x4b, 0xee, 0xd4,
-	0x3e, 0x7e, 0x55, 0x25, 0xeb, 0xe4, 0x62, 0xae, 0xca, 0x97, 0x73, 0x55, 0xfe, 0x31, 0x57, 0xe5,
-	0xf3, 0x85, 0x2a, 0x5d, 0x2e, 0x54, 0xe9, 0xfb, 0x42, 0x95, 0xde, 0x0c, 0xd6, 0x8c, 0x5b, 0xb9,
-	0xec, 0x30, 0x74, 0xdc, 0x4c, 0x04, 0xe6, 0x59, 0xff, 0xc8, 0xfc, 0x20, 0xfe, 0x57, 0xcc, 0xc8,
-	0xee, 0x26, 0x7b, 0xd0, 0xd1, 0xef, 0x00, 0x00, 0x00, 0xff, 0xff, 0x55, 0x7f, 0xcc, 0xd3, 0xce,
-	0x04, 0x00, 0x00,
+	0x14, 0xb6, 0x9d, 0xa4, 0xb4, 0x57, 0x92, 0x5a, 0xa7, 0x0e, 0x69, 0x00, 0x3b, 0xf2, 0x80, 0x22,
+	0xd4, 0xda, 0x24, 0xdd, 0x90, 0x58, 0xdc, 0x30, 0x44, 0xaa, 0x10, 0x98, 0x8a, 0x81, 0x25, 0xf2,
+	0x8f, 0xc3, 0x39, 0xc5, 0xf6, 0x19, 0xff, 0x28, 0xf8, 0x3f, 0x60, 0xec, 0x08, 0x12, 0x1b, 0x1b,
+	0x7f, 0x49, 0xc7, 0x8e, 0x4c, 0x29, 0x4a, 0xc4, 0xc2, 0xd8, 0xbf, 0x00, 0xdd, 0x9d, 0x9d, 0xa4,
+	0x45, 0x48, 0x1d, 0x60, 0xf2, 0xbd, 0xfb, 0xde, 0xfb, 0xde, 0xbb, 0xef, 0x7d, 0x32, 0xd8, 0x23,
+	0x69, 0x48, 0x52, 0x9c, 0x1a, 0x01, 0x71, 0xa7, 0x79, 0xcc, 0x3e, 0x7a, 0x9c, 0x90, 0x8c, 0xc0,
+	0x56, 0x09, 0xe9, 0x1c, 0xea, 0xec, 0xfa, 0xc4, 0x27, 0x0c, 0x32, 0xe8, 0x89, 0x67, 0x75, 0x14,
+	0x9f, 0x10, 0x3f, 0x40, 0x06, 0x8b, 0x9c, 0xfc, 0xad, 0xe1, 0xe5, 0x89, 0x9d, 0x61, 0x12, 0x95,
+	0xb8, 0x7a, 0x13, 0xcf, 0x70, 0x88, 0xd2, 0xcc, 0x0e, 0xe3, 0x8a, 0xc0, 0x65, 0x7d, 0x0c, 0xc7,
+	0x4e, 0x91, 0x71, 0xda, 0x77, 0x50, 0x66, 0xf7, 0x0d, 0x97, 0xe0, 0x92, 0x40, 0xfb, 0x29, 0x01,
+	0xf0, 0x02, 0x25, 0x98, 0x78, 0xc7, 0xc4, 0x9d, 0xc2, 0x16, 0x90, 0x46, 0xc3, 0xb6, 0xd8, 0x15,
+	0x7b, 0x75, 0x4b, 0x1a, 0x0d, 0xe1, 0x43, 0xd0, 0x20, 0xef, 0x23, 0x94, 0xb4, 0xa5, 0xae, 0xd8,
+	0xdb, 0x32, 0xe5, 0xab, 0x99, 0x7a, 0xb7, 0xb0, 0xc3, 0xe0, 0x89, 0xc6, 0xae, 0x35, 0x8b, 0xc3,
+	0x70, 0x02, 0x36, 0xab, 0xc9, 0xda, 0xb5, 0xae, 0xd8, 0xdb, 0x1e, 0xec, 0xe9, 0x7c, 0x34, 0xbd,
+	0x1a, 0x4d, 0x1f, 0x96, 0x09, 0x66, 0xff, 0x7c, 0xa6, 0x0a, 0xbf, 0x66, 0x2a, 0xac, 0x4a, 0xf6,
+	0x49, 0x88, 0x33, 0x14, 0xc6, 0x59, 0x71, 0x35, 0x53, 0x77, 0x38, 0x7f, 0x85, 0x69, 0x9f, 0x2e,
+	0x55, 0xd1, 0x5a, 0xb2, 0x43, 0x0b, 0x6c, 0xa2, 0xc8, 0x1b, 0xd3, 0x77, 0xb6, 0xeb, 0xac, 0x53,
+	0xe7, 0x8f, 0x4e, 0x27, 0x95, 0x08, 0xe6, 0x3d, 0xda, 0x6a, 0x45, 0x5a, 0x55, 0x6a, 0x67, 0x94,
+	0xf4, 0x0e, 0x8a, 0x3c, 0x9a, 0x0a, 0x6d, 0xd0, 0xa0, 0x92, 0xa4, 0xed, 0x46, 0xb7, 0xc6, 0x46,
+	0xe7, 0xa2, 0xe9, 0x54, 0x34, 0xbd, 0x14, 0x4d, 0x3f, 0x22, 0x38, 0x32, 0x1f, 0x53, 0xbe, 0x6f,
+	0x97, 0x6a, 0xcf, 0xc7, 0xd9, 0x24, 0x77, 0x74, 0x97, 0x84, 0x46, 0xa9, 0x30, 0xff, 0x1c, 0xa4,
+	0xde, 0xd4, 0xc8, 0x8a, 0x18, 0xa5, 0xac, 0x20, 0xb5, 0x38, 0xb3, 0xf6, 0x59, 0x02, 0xad, 0x97,
+	0x39, 0x4a, 0x8a, 0x23, 0x12, 0x79, 0x98, 0xbd, 0xe4, 0x19, 0xd8, 0xa1, 0xbb, 0x1f, 0xbf, 0xa3,
+	0xd7, 0x63, 0x5a, 0xc3, 0x84, 0x6f, 0x0d, 0x1e, 0xe8, 0xd7, 0xbd, 0xa1, 0xd3, 0xd5, 0xb0, 0xe2,
+	0x93, 0x22, 0x46, 0x56, 0x33, 0x58, 0x0f, 0xe1, 0x2e, 0x68, 0x78, 0x28, 0x22, 0x21, 0x5f, 0x91,
+	0xc5, 0x03, 0x2a, 0xd3, 0xed, 0x17, 0x72, 0x43, 0xa5, 0xbf, 0x49, 0xff, 0x1a, 0x6c, 0x2d, 0xed,
+	0x75, 0x0b, 0xed, 0xef, 0x97, 0xac, 0x32, 0x67, 0x5d, 0x96, 0x72, 0xf1, 0x57, 0x54, 0xda, 0x17,
+	0x09, 0x34, 0x5f, 0x15, 0x51, 0x36, 0x41, 0x19, 0x76, 0x99, 0x0d, 0xf7, 0x01, 0xcc, 0x23, 0x0f,
+	0x25, 0x41, 0x81, 0x23, 0x7f, 0xcc, 0x54, 0xc2, 0x5e, 0x69, 0x4b, 0x79, 0x85, 0xd0, 0xdc, 0x91,
+	0x07, 0x55, 0xb0, 0x9d, 0xd2, 0xf2, 0xf1, 0xba, 0x0e, 0x80, 0x5d, 0x0d, 0x2b, 0x31, 0x96, 0x9e,
+	0xa9, 0xfd, 0x23, 0xcf, 0xac, 0x3b, 0xbe, 0xfe, 0x3f, 0x1d, 0xff, 0xe8, 0x29, 0x68, 0x5e, 0x33,
+	0x00, 0x6c, 0x01, 0x60, 0x16, 0x15, 0xb7, 0x2c, 0x40, 0x00, 0x36, 0xcc, 0x82, 0x0e, 0x25, 0x8b,
+	0xf4, 0xfc, 0x9c, 0xd0, 0x74, 0x59, 0xea, 0xd4, 0x3f, 0x7e, 0x55, 0x04, 0xf3, 0xf8, 0x7c, 0xae,
+	0x88, 0x17, 0x73, 0x45, 0xfc, 0x31, 0x57, 0xc4, 0xb3, 0x85, 0x22, 0x5c, 0x2c, 0x14, 0xe1, 0xfb,
+	0x42, 0x11, 0xde, 0x0c, 0xd6, 0x4c, 0x5c, 0x3a, 0xee, 0x20, 0xb0, 0x9d, 0xb4, 0x0a, 0x8c, 0xd3,
+	0xfe, 0xa1, 0xf1, 0xa1, 0xfa, 0x77, 0x31, 0x53, 0x3b, 0x1b, 0xec, 0x71, 0x87, 0xbf, 0x07, 0x00,
+	0xc7, 0xb1, 0xef, 0xfa, 0xda, 0x04, 0x00, 0x00,
 }
 
 func (m *PeriodLock) Marshal() (dAtA []byte, err error) {
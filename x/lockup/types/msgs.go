@@ -15,6 +15,9 @@ const (
 	TypeMsgBeginUnlocking    = "begin_unlocking"
 	TypeMsgExtendLockup      = "edit_lockup"
 	TypeForceUnlock          = "force_unlock"
+	TypeMsgSplitLock         = "split_lock"
+	TypeMsgTransferLock      = "transfer_lock"
+	TypeMsgMergeLocks        = "merge_locks"
 )
 
 var _ sdk.Msg = &MsgLockTokens{}
@@ -204,3 +207,137 @@ func (m MsgForceUnlock) GetSigners() []sdk.AccAddress {
 	owner, _ := sdk.AccAddressFromBech32(m.Owner)
 	return []sdk.AccAddress{owner}
 }
+
+var _ sdk.Msg = &MsgSplitLock{}
+
+// NewMsgSplitLock creates a message to split the given amounts off a lock into new locks.
+func NewMsgSplitLock(owner sdk.AccAddress, id uint64, amounts []sdk.Int) *MsgSplitLock {
+	return &MsgSplitLock{
+		Owner:   owner.String(),
+		ID:      id,
+		Amounts: amounts,
+	}
+}
+
+func (m MsgSplitLock) Route() string { return RouterKey }
+func (m MsgSplitLock) Type() string  { return TypeMsgSplitLock }
+func (m MsgSplitLock) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Owner)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid owner address (%s)", err)
+	}
+
+	if m.ID == 0 {
+		return fmt.Errorf("invalid lockup ID, got %v", m.ID)
+	}
+
+	if len(m.Amounts) == 0 {
+		return fmt.Errorf("no amounts to split off the lock")
+	}
+
+	for _, amount := range m.Amounts {
+		if amount.IsNil() || !amount.IsPositive() {
+			return fmt.Errorf("cannot split off a zero or negative amount")
+		}
+	}
+
+	return nil
+}
+
+func (m MsgSplitLock) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgSplitLock) GetSigners() []sdk.AccAddress {
+	owner, _ := sdk.AccAddressFromBech32(m.Owner)
+	return []sdk.AccAddress{owner}
+}
+
+var _ sdk.Msg = &MsgTransferLock{}
+
+// NewMsgTransferLock creates a message to transfer a lock to a new owner.
+func NewMsgTransferLock(owner sdk.AccAddress, id uint64, newOwner sdk.AccAddress) *MsgTransferLock {
+	return &MsgTransferLock{
+		Owner:    owner.String(),
+		ID:       id,
+		NewOwner: newOwner.String(),
+	}
+}
+
+func (m MsgTransferLock) Route() string { return RouterKey }
+func (m MsgTransferLock) Type() string  { return TypeMsgTransferLock }
+func (m MsgTransferLock) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Owner)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid owner address (%s)", err)
+	}
+
+	if m.ID == 0 {
+		return fmt.Errorf("invalid lockup ID, got %v", m.ID)
+	}
+
+	_, err = sdk.AccAddressFromBech32(m.NewOwner)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid new owner address (%s)", err)
+	}
+
+	if m.Owner == m.NewOwner {
+		return fmt.Errorf("new owner must differ from the owner")
+	}
+
+	return nil
+}
+
+func (m MsgTransferLock) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgTransferLock) GetSigners() []sdk.AccAddress {
+	owner, _ := sdk.AccAddressFromBech32(m.Owner)
+	return []sdk.AccAddress{owner}
+}
+
+var _ sdk.Msg = &MsgMergeLocks{}
+
+// NewMsgMergeLocks creates a message to merge locks into a new lock.
+func NewMsgMergeLocks(owner sdk.AccAddress, lockIDs []uint64) *MsgMergeLocks {
+	return &MsgMergeLocks{
+		Owner:   owner.String(),
+		LockIds: lockIDs,
+	}
+}
+
+func (m MsgMergeLocks) Route() string { return RouterKey }
+func (m MsgMergeLocks) Type() string  { return TypeMsgMergeLocks }
+func (m MsgMergeLocks) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Owner)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid owner address (%s)", err)
+	}
+
+	if len(m.LockIds) < 2 {
+		return fmt.Errorf("at least two locks are needed to merge, got %d", len(m.LockIds))
+	}
+
+	seen := make(map[uint64]bool, len(m.LockIds))
+	for _, id := range m.LockIds {
+		if id == 0 {
+			return fmt.Errorf("invalid lockup ID, got %v", id)
+		}
+		if seen[id] {
+			return fmt.Errorf("duplicate lockup ID %d", id)
+		}
+		seen[id] = true
+	}
+
+	return nil
+}
+
+func (m MsgMergeLocks) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgMergeLocks) GetSigners() []sdk.AccAddress {
+	owner, _ := sdk.AccAddressFromBech32(m.Owner)
+	return []sdk.AccAddress{owner}
+}
@@ -263,6 +263,213 @@ func TestMsgExtendLockup(t *testing.T) {
 }
 
 // // Test authz serialize and de-serializes for lockup msg.
+func TestMsgSplitLock(t *testing.T) {
+	appParams.SetAddressPrefixes()
+	addr1, invalidAddr := apptesting.GenerateTestAddrs()
+
+	tests := []struct {
+		name       string
+		msg        types.MsgSplitLock
+		expectPass bool
+	}{
+		{
+			name: "proper msg",
+			msg: types.MsgSplitLock{
+				Owner:   addr1,
+				ID:      1,
+				Amounts: []sdk.Int{sdk.NewInt(100), sdk.NewInt(100)},
+			},
+			expectPass: true,
+		},
+		{
+			name: "invalid owner",
+			msg: types.MsgSplitLock{
+				Owner:   invalidAddr,
+				ID:      1,
+				Amounts: []sdk.Int{sdk.NewInt(100)},
+			},
+		},
+		{
+			name: "invalid lockup ID",
+			msg: types.MsgSplitLock{
+				Owner:   addr1,
+				ID:      0,
+				Amounts: []sdk.Int{sdk.NewInt(100)},
+			},
+		},
+		{
+			name: "no amounts",
+			msg: types.MsgSplitLock{
+				Owner: addr1,
+				ID:    1,
+			},
+		},
+		{
+			name: "zero amount",
+			msg: types.MsgSplitLock{
+				Owner:   addr1,
+				ID:      1,
+				Amounts: []sdk.Int{sdk.NewInt(100), sdk.ZeroInt()},
+			},
+		},
+		{
+			name: "negative amount",
+			msg: types.MsgSplitLock{
+				Owner:   addr1,
+				ID:      1,
+				Amounts: []sdk.Int{sdk.NewInt(-100)},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if test.expectPass {
+				require.NoError(t, test.msg.ValidateBasic(), "test: %v", test.name)
+				require.Equal(t, test.msg.Route(), types.RouterKey)
+				require.Equal(t, test.msg.Type(), "split_lock")
+				signers := test.msg.GetSigners()
+				require.Equal(t, len(signers), 1)
+				require.Equal(t, signers[0].String(), addr1)
+			} else {
+				require.Error(t, test.msg.ValidateBasic(), "test: %v", test.name)
+			}
+		})
+	}
+}
+
+func TestMsgTransferLock(t *testing.T) {
+	appParams.SetAddressPrefixes()
+	addr1, invalidAddr := apptesting.GenerateTestAddrs()
+	addr2, _ := apptesting.GenerateTestAddrs()
+
+	tests := []struct {
+		name       string
+		msg        types.MsgTransferLock
+		expectPass bool
+	}{
+		{
+			name: "proper msg",
+			msg: types.MsgTransferLock{
+				Owner:    addr1,
+				ID:       1,
+				NewOwner: addr2,
+			},
+			expectPass: true,
+		},
+		{
+			name: "invalid owner",
+			msg: types.MsgTransferLock{
+				Owner:    invalidAddr,
+				ID:       1,
+				NewOwner: addr2,
+			},
+		},
+		{
+			name: "invalid lockup ID",
+			msg: types.MsgTransferLock{
+				Owner:    addr1,
+				ID:       0,
+				NewOwner: addr2,
+			},
+		},
+		{
+			name: "invalid new owner",
+			msg: types.MsgTransferLock{
+				Owner:    addr1,
+				ID:       1,
+				NewOwner: invalidAddr,
+			},
+		},
+		{
+			name: "new owner is the owner",
+			msg: types.MsgTransferLock{
+				Owner:    addr1,
+				ID:       1,
+				NewOwner: addr1,
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if test.expectPass {
+				require.NoError(t, test.msg.ValidateBasic(), "test: %v", test.name)
+				require.Equal(t, test.msg.Route(), types.RouterKey)
+				require.Equal(t, test.msg.Type(), "transfer_lock")
+				signers := test.msg.GetSigners()
+				require.Equal(t, len(signers), 1)
+				require.Equal(t, signers[0].String(), addr1)
+			} else {
+				require.Error(t, test.msg.ValidateBasic(), "test: %v", test.name)
+			}
+		})
+	}
+}
+
+func TestMsgMergeLocks(t *testing.T) {
+	appParams.SetAddressPrefixes()
+	addr1, invalidAddr := apptesting.GenerateTestAddrs()
+
+	tests := []struct {
+		name       string
+		msg        types.MsgMergeLocks
+		expectPass bool
+	}{
+		{
+			name: "proper msg",
+			msg: types.MsgMergeLocks{
+				Owner:   addr1,
+				LockIds: []uint64{1, 2, 3},
+			},
+			expectPass: true,
+		},
+		{
+			name: "invalid owner",
+			msg: types.MsgMergeLocks{
+				Owner:   invalidAddr,
+				LockIds: []uint64{1, 2},
+			},
+		},
+		{
+			name: "single lockup ID",
+			msg: types.MsgMergeLocks{
+				Owner:   addr1,
+				LockIds: []uint64{1},
+			},
+		},
+		{
+			name: "invalid lockup ID",
+			msg: types.MsgMergeLocks{
+				Owner:   addr1,
+				LockIds: []uint64{1, 0},
+			},
+		},
+		{
+			name: "duplicate lockup ID",
+			msg: types.MsgMergeLocks{
+				Owner:   addr1,
+				LockIds: []uint64{1, 2, 1},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if test.expectPass {
+				require.NoError(t, test.msg.ValidateBasic(), "test: %v", test.name)
+				require.Equal(t, test.msg.Route(), types.RouterKey)
+				require.Equal(t, test.msg.Type(), "merge_locks")
+				signers := test.msg.GetSigners()
+				require.Equal(t, len(signers), 1)
+				require.Equal(t, signers[0].String(), addr1)
+			} else {
+				require.Error(t, test.msg.ValidateBasic(), "test: %v", test.name)
+			}
+		})
+	}
+}
+
 func TestAuthzMsg(t *testing.T) {
 	pk1 := ed25519.GenPrivKey().PubKey()
 	addr1 := sdk.AccAddress(pk1.Address()).String()
@@ -299,6 +506,29 @@ func TestAuthzMsg(t *testing.T) {
 				Owner: addr1,
 			},
 		},
+		{
+			name: "MsgSplitLock",
+			msg: &types.MsgSplitLock{
+				Owner:   addr1,
+				ID:      1,
+				Amounts: []sdk.Int{sdk.NewInt(1)},
+			},
+		},
+		{
+			name: "MsgMergeLocks",
+			msg: &types.MsgMergeLocks{
+				Owner:   addr1,
+				LockIds: []uint64{1, 2},
+			},
+		},
+		{
+			name: "MsgTransferLock",
+			msg: &types.MsgTransferLock{
+				Owner:    addr1,
+				ID:       1,
+				NewOwner: sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address()).String(),
+			},
+		},
 	}
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
@@ -1036,6 +1036,86 @@ func (m *LockedResponse) GetLock() *PeriodLock {
 	return nil
 }
 
+type NextLockIDRequest struct {
+}
+
+func (m *NextLockIDRequest) Reset()         { *m = NextLockIDRequest{} }
+func (m *NextLockIDRequest) String() string { return proto.CompactTextString(m) }
+func (*NextLockIDRequest) ProtoMessage()    {}
+func (*NextLockIDRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e906fda01cffd91a, []int{22}
+}
+func (m *NextLockIDRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NextLockIDRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_NextLockIDRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *NextLockIDRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NextLockIDRequest.Merge(m, src)
+}
+func (m *NextLockIDRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *NextLockIDRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_NextLockIDRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NextLockIDRequest proto.InternalMessageInfo
+
+type NextLockIDResponse struct {
+	LockId uint64 `protobuf:"varint,1,opt,name=lock_id,json=lockId,proto3" json:"lock_id,omitempty"`
+}
+
+func (m *NextLockIDResponse) Reset()         { *m = NextLockIDResponse{} }
+func (m *NextLockIDResponse) String() string { return proto.CompactTextString(m) }
+func (*NextLockIDResponse) ProtoMessage()    {}
+func (*NextLockIDResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e906fda01cffd91a, []int{23}
+}
+func (m *NextLockIDResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NextLockIDResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_NextLockIDResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *NextLockIDResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NextLockIDResponse.Merge(m, src)
+}
+func (m *NextLockIDResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *NextLockIDResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_NextLockIDResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NextLockIDResponse proto.InternalMessageInfo
+
+func (m *NextLockIDResponse) GetLockId() uint64 {
+	if m != nil {
+		return m.LockId
+	}
+	return 0
+}
+
 type SyntheticLockupsByLockupIDRequest struct {
 	LockId uint64 `protobuf:"varint,1,opt,name=lock_id,json=lockId,proto3" json:"lock_id,omitempty"`
 }
@@ -1044,7 +1124,7 @@ func (m *SyntheticLockupsByLockupIDRequest) Reset()         { *m = SyntheticLock
 func (m *SyntheticLockupsByLockupIDRequest) String() string { return proto.CompactTextString(m) }
 func (*SyntheticLockupsByLockupIDRequest) ProtoMessage()    {}
 func (*SyntheticLockupsByLockupIDRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e906fda01cffd91a, []int{22}
+	return fileDescriptor_e906fda01cffd91a, []int{24}
 }
 func (m *SyntheticLockupsByLockupIDRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1088,7 +1168,7 @@ func (m *SyntheticLockupsByLockupIDResponse) Reset()         { *m = SyntheticLoc
 func (m *SyntheticLockupsByLockupIDResponse) String() string { return proto.CompactTextString(m) }
 func (*SyntheticLockupsByLockupIDResponse) ProtoMessage()    {}
 func (*SyntheticLockupsByLockupIDResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e906fda01cffd91a, []int{23}
+	return fileDescriptor_e906fda01cffd91a, []int{25}
 }
 func (m *SyntheticLockupsByLockupIDResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1133,7 +1213,7 @@ func (m *AccountLockedLongerDurationRequest) Reset()         { *m = AccountLocke
 func (m *AccountLockedLongerDurationRequest) String() string { return proto.CompactTextString(m) }
 func (*AccountLockedLongerDurationRequest) ProtoMessage()    {}
 func (*AccountLockedLongerDurationRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e906fda01cffd91a, []int{24}
+	return fileDescriptor_e906fda01cffd91a, []int{26}
 }
 func (m *AccountLockedLongerDurationRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1184,7 +1264,7 @@ func (m *AccountLockedLongerDurationResponse) Reset()         { *m = AccountLock
 func (m *AccountLockedLongerDurationResponse) String() string { return proto.CompactTextString(m) }
 func (*AccountLockedLongerDurationResponse) ProtoMessage()    {}
 func (*AccountLockedLongerDurationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e906fda01cffd91a, []int{25}
+	return fileDescriptor_e906fda01cffd91a, []int{27}
 }
 func (m *AccountLockedLongerDurationResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1229,7 +1309,7 @@ func (m *AccountLockedDurationRequest) Reset()         { *m = AccountLockedDurat
 func (m *AccountLockedDurationRequest) String() string { return proto.CompactTextString(m) }
 func (*AccountLockedDurationRequest) ProtoMessage()    {}
 func (*AccountLockedDurationRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e906fda01cffd91a, []int{26}
+	return fileDescriptor_e906fda01cffd91a, []int{28}
 }
 func (m *AccountLockedDurationRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1280,7 +1360,7 @@ func (m *AccountLockedDurationResponse) Reset()         { *m = AccountLockedDura
 func (m *AccountLockedDurationResponse) String() string { return proto.CompactTextString(m) }
 func (*AccountLockedDurationResponse) ProtoMessage()    {}
 func (*AccountLockedDurationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e906fda01cffd91a, []int{27}
+	return fileDescriptor_e906fda01cffd91a, []int{29}
 }
 func (m *AccountLockedDurationResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1329,7 +1409,7 @@ func (m *AccountLockedLongerDurationNotUnlockingOnlyRequest) String() string {
 }
 func (*AccountLockedLongerDurationNotUnlockingOnlyRequest) ProtoMessage() {}
 func (*AccountLockedLongerDurationNotUnlockingOnlyRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e906fda01cffd91a, []int{28}
+	return fileDescriptor_e906fda01cffd91a, []int{30}
 }
 func (m *AccountLockedLongerDurationNotUnlockingOnlyRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1384,7 +1464,7 @@ func (m *AccountLockedLongerDurationNotUnlockingOnlyResponse) String() string {
 }
 func (*AccountLockedLongerDurationNotUnlockingOnlyResponse) ProtoMessage() {}
 func (*AccountLockedLongerDurationNotUnlockingOnlyResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e906fda01cffd91a, []int{29}
+	return fileDescriptor_e906fda01cffd91a, []int{31}
 }
 func (m *AccountLockedLongerDurationNotUnlockingOnlyResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1432,7 +1512,7 @@ func (m *AccountLockedLongerDurationDenomRequest) Reset() {
 func (m *AccountLockedLongerDurationDenomRequest) String() string { return proto.CompactTextString(m) }
 func (*AccountLockedLongerDurationDenomRequest) ProtoMessage()    {}
 func (*AccountLockedLongerDurationDenomRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e906fda01cffd91a, []int{30}
+	return fileDescriptor_e906fda01cffd91a, []int{32}
 }
 func (m *AccountLockedLongerDurationDenomRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1492,7 +1572,7 @@ func (m *AccountLockedLongerDurationDenomResponse) Reset() {
 func (m *AccountLockedLongerDurationDenomResponse) String() string { return proto.CompactTextString(m) }
 func (*AccountLockedLongerDurationDenomResponse) ProtoMessage()    {}
 func (*AccountLockedLongerDurationDenomResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e906fda01cffd91a, []int{31}
+	return fileDescriptor_e906fda01cffd91a, []int{33}
 }
 func (m *AccountLockedLongerDurationDenomResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1535,7 +1615,7 @@ func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
 func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryParamsRequest) ProtoMessage()    {}
 func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e906fda01cffd91a, []int{32}
+	return fileDescriptor_e906fda01cffd91a, []int{34}
 }
 func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1572,7 +1652,7 @@ func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
 func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryParamsResponse) ProtoMessage()    {}
 func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e906fda01cffd91a, []int{33}
+	return fileDescriptor_e906fda01cffd91a, []int{35}
 }
 func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1631,6 +1711,8 @@ func init() {
 	proto.RegisterType((*LockedDenomResponse)(nil), "osmosis.lockup.LockedDenomResponse")
 	proto.RegisterType((*LockedRequest)(nil), "osmosis.lockup.LockedRequest")
 	proto.RegisterType((*LockedResponse)(nil), "osmosis.lockup.LockedResponse")
+	proto.RegisterType((*NextLockIDRequest)(nil), "osmosis.lockup.NextLockIDRequest")
+	proto.RegisterType((*NextLockIDResponse)(nil), "osmosis.lockup.NextLockIDResponse")
 	proto.RegisterType((*SyntheticLockupsByLockupIDRequest)(nil), "osmosis.lockup.SyntheticLockupsByLockupIDRequest")
 	proto.RegisterType((*SyntheticLockupsByLockupIDResponse)(nil), "osmosis.lockup.SyntheticLockupsByLockupIDResponse")
 	proto.RegisterType((*AccountLockedLongerDurationRequest)(nil), "osmosis.lockup.AccountLockedLongerDurationRequest")
@@ -1648,101 +1730,103 @@ func init() {
 func init() { proto.RegisterFile("osmosis/lockup/query.proto", fileDescriptor_e906fda01cffd91a) }
 
 var fileDescriptor_e906fda01cffd91a = []byte{
-	// 1492 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0xcd, 0x8f, 0x14, 0x45,
-	0x14, 0xdf, 0x02, 0x76, 0x95, 0x87, 0x7c, 0xa4, 0x58, 0x70, 0xb7, 0x17, 0x66, 0x96, 0x02, 0xd6,
-	0x51, 0x77, 0xbb, 0xd9, 0x5d, 0x02, 0x48, 0x96, 0xaf, 0x61, 0xc4, 0xac, 0x8e, 0x0a, 0x03, 0x4a,
-	0xfc, 0xca, 0xa4, 0x67, 0xa6, 0x19, 0x3a, 0xcc, 0x74, 0x0d, 0xd3, 0x3d, 0xe8, 0x48, 0x90, 0x04,
-	0x3c, 0x7a, 0xc0, 0x78, 0x31, 0x1e, 0x8c, 0x7a, 0xd3, 0x83, 0xf1, 0xe2, 0x81, 0x78, 0x37, 0x44,
-	0x13, 0x43, 0xe2, 0xc5, 0x78, 0x58, 0x0c, 0xeb, 0x5f, 0xc0, 0xc9, 0x83, 0x07, 0xd3, 0x55, 0xd5,
-	0xbd, 0xd3, 0x9f, 0xd3, 0x33, 0x23, 0x9b, 0x3d, 0xed, 0x4e, 0xbf, 0x57, 0xef, 0xfd, 0x7e, 0xaf,
-	0x5e, 0xbf, 0xaa, 0x5f, 0x83, 0x44, 0xcd, 0x3a, 0x35, 0x75, 0x53, 0xa9, 0xd1, 0xf2, 0x95, 0x56,
-	0x43, 0xb9, 0xda, 0xd2, 0x9a, 0x6d, 0xb9, 0xd1, 0xa4, 0x16, 0xc5, 0x5b, 0x84, 0x4d, 0xe6, 0x36,
-	0x69, 0xb4, 0x4a, 0xab, 0x94, 0x99, 0x14, 0xfb, 0x3f, 0xee, 0x25, 0xa5, 0xca, 0xcc, 0x4d, 0x29,
-	0xa9, 0xa6, 0xa6, 0x5c, 0x9b, 0x2d, 0x69, 0x96, 0x3a, 0xab, 0x94, 0xa9, 0x6e, 0x08, 0xfb, 0xae,
-	0x2a, 0xa5, 0xd5, 0x9a, 0xa6, 0xa8, 0x0d, 0x5d, 0x51, 0x0d, 0x83, 0x5a, 0xaa, 0xa5, 0x53, 0xc3,
-	0x14, 0xd6, 0xb4, 0xb0, 0xb2, 0x5f, 0xa5, 0xd6, 0x25, 0xc5, 0xd2, 0xeb, 0x9a, 0x69, 0xa9, 0xf5,
-	0x86, 0x13, 0xde, 0xef, 0x50, 0x69, 0x35, 0x59, 0x04, 0x61, 0x1f, 0xf7, 0x11, 0xb0, 0xff, 0x08,
-	0xd3, 0x84, 0xcf, 0xd4, 0x50, 0x9b, 0x6a, 0x5d, 0x24, 0x26, 0x3b, 0x61, 0xf4, 0x55, 0x5a, 0x69,
-	0xd5, 0xb4, 0xac, 0x5a, 0x53, 0x8d, 0xb2, 0x56, 0xd0, 0xae, 0xb6, 0x34, 0xd3, 0x22, 0x1f, 0xc2,
-	0x0e, 0xdf, 0x73, 0xb3, 0x41, 0x0d, 0x53, 0xc3, 0x2a, 0x0c, 0xdb, 0xac, 0xcc, 0x31, 0x34, 0xb9,
-	0x3e, 0xb3, 0x69, 0x6e, 0x5c, 0xe6, 0xbc, 0x65, 0x9b, 0xb7, 0x2c, 0x78, 0xcb, 0xa7, 0xa9, 0x6e,
-	0x64, 0x0f, 0xdc, 0x5b, 0x4a, 0x0f, 0x7d, 0xf7, 0x20, 0x9d, 0xa9, 0xea, 0xd6, 0xe5, 0x56, 0x49,
-	0x2e, 0xd3, 0xba, 0x22, 0x8a, 0xc4, 0xff, 0xcc, 0x98, 0x95, 0x2b, 0x8a, 0xd5, 0x6e, 0x68, 0x26,
-	0x5b, 0x60, 0x16, 0x78, 0x64, 0x32, 0x01, 0xe3, 0x3c, 0x77, 0x9e, 0x96, 0xaf, 0x68, 0x95, 0x53,
-	0x75, 0xda, 0x32, 0x2c, 0x07, 0xd8, 0x4d, 0x90, 0xc2, 0x8c, 0xab, 0x87, 0xee, 0x25, 0xd8, 0x7d,
-	0xaa, 0x5c, 0xb6, 0xb3, 0xbe, 0x61, 0xd8, 0x15, 0x55, 0x4b, 0x35, 0x8d, 0x3b, 0x70, 0x84, 0x78,
-	0x0a, 0x86, 0xe9, 0xfb, 0x86, 0xd6, 0x1c, 0x43, 0x93, 0x28, 0xb3, 0x31, 0xbb, 0xed, 0xd1, 0x52,
-	0xfa, 0xa9, 0xb6, 0x5a, 0xaf, 0x1d, 0x25, 0xec, 0x31, 0x29, 0x70, 0x33, 0xb9, 0x8d, 0x20, 0x15,
-	0x15, 0x69, 0xf5, 0xe8, 0x9c, 0x81, 0x5d, 0x1e, 0x10, 0xba, 0x51, 0xed, 0x8b, 0xcd, 0x2d, 0xe4,
-	0xab, 0xcb, 0x4a, 0xa0, 0xd5, 0x23, 0x73, 0x1a, 0xc6, 0x05, 0x06, 0xde, 0x1d, 0x7d, 0x31, 0xb9,
-	0x09, 0x52, 0x58, 0x90, 0xd5, 0x63, 0xf1, 0x25, 0x72, 0xf7, 0x84, 0x23, 0x38, 0xab, 0x9a, 0xd6,
-	0x05, 0xbd, 0xae, 0xf5, 0xc8, 0x04, 0xbf, 0x09, 0x1b, 0xdd, 0x39, 0x32, 0xb6, 0x6e, 0x12, 0x65,
-	0x36, 0xcd, 0x49, 0x32, 0x1f, 0x24, 0xb2, 0x33, 0x48, 0xe4, 0x0b, 0x8e, 0x47, 0x76, 0x97, 0x0d,
-	0xf8, 0xd1, 0x52, 0x7a, 0x1b, 0x8f, 0xe5, 0x2e, 0x25, 0x77, 0x1e, 0xa4, 0x51, 0x61, 0x25, 0x14,
-	0xb9, 0xe8, 0x6e, 0xb5, 0x1f, 0x9f, 0x28, 0xd2, 0x21, 0x18, 0xb6, 0x5b, 0xc0, 0x29, 0x92, 0x24,
-	0x7b, 0x47, 0xa8, 0x7c, 0x56, 0x6b, 0xea, 0xb4, 0x62, 0x2f, 0xce, 0x6e, 0xb0, 0x93, 0x16, 0xb8,
-	0x3b, 0xf9, 0x1e, 0xc1, 0x74, 0x68, 0xe4, 0xd7, 0xe8, 0x4a, 0x57, 0xbd, 0x6e, 0xd4, 0xda, 0x6b,
-	0xa5, 0x12, 0x55, 0x98, 0x49, 0x88, 0x77, 0xc0, 0xca, 0x7c, 0x83, 0x60, 0xd2, 0xf3, 0x7a, 0x69,
-	0x95, 0xac, 0x76, 0x89, 0x36, 0xb5, 0xb5, 0xd4, 0x17, 0xef, 0xc0, 0x9e, 0x18, 0x8c, 0x03, 0x56,
-	0xe0, 0x2e, 0x72, 0xa3, 0x7b, 0x6b, 0x9d, 0xd3, 0x0c, 0x5a, 0x5f, 0x23, 0x25, 0xc0, 0xa3, 0x30,
-	0x5c, 0xb1, 0xf1, 0x8c, 0xad, 0xb7, 0xf3, 0x17, 0xf8, 0x0f, 0xf2, 0x2e, 0x90, 0x38, 0xe8, 0x03,
-	0x56, 0xe6, 0x23, 0xc0, 0x3c, 0xac, 0xa7, 0x12, 0x2e, 0x12, 0xd4, 0x81, 0x04, 0x17, 0xe0, 0x49,
-	0xe7, 0xe6, 0x20, 0x68, 0x8f, 0x07, 0x68, 0xe7, 0x84, 0x43, 0x76, 0x42, 0xb0, 0xde, 0xca, 0x59,
-	0x3b, 0x0b, 0xc9, 0xe7, 0x36, 0x69, 0x37, 0x0e, 0x31, 0x60, 0xbb, 0x27, 0xbf, 0xa0, 0x73, 0x11,
-	0x46, 0x54, 0x76, 0x3a, 0x8b, 0xbd, 0x38, 0x61, 0x47, 0xfb, 0x73, 0x29, 0x3d, 0x95, 0x60, 0x1e,
-	0x2e, 0x1a, 0xd6, 0xa3, 0xa5, 0xf4, 0x66, 0x9e, 0x97, 0x47, 0x21, 0x05, 0x11, 0x8e, 0x64, 0x60,
-	0x33, 0xcf, 0xe7, 0x50, 0x7d, 0x1a, 0x9e, 0xb0, 0x2b, 0x51, 0xd4, 0x2b, 0x2c, 0xd5, 0x86, 0xc2,
-	0x88, 0xfd, 0x73, 0xb1, 0x42, 0x4e, 0xc2, 0x16, 0xc7, 0x53, 0x80, 0x92, 0x61, 0x83, 0x6d, 0x63,
-	0x7e, 0xb1, 0x25, 0x2e, 0x30, 0x3f, 0xb2, 0x00, 0x7b, 0xce, 0xb7, 0x0d, 0xeb, 0xb2, 0x66, 0xe9,
-	0xe5, 0x3c, 0xf3, 0x31, 0xb3, 0x6d, 0xfe, 0xcf, 0x62, 0xae, 0x6b, 0xfe, 0x26, 0x90, 0xb8, 0xd5,
-	0x02, 0x53, 0x1e, 0xb6, 0x9a, 0x8e, 0x57, 0xb1, 0xb3, 0x03, 0x76, 0xfb, 0xe1, 0x79, 0x82, 0x89,
-	0x26, 0xd8, 0x62, 0x76, 0x3e, 0x34, 0xc9, 0x57, 0xc8, 0xd7, 0x6c, 0x79, 0x6a, 0x54, 0xb5, 0xa6,
-	0xb3, 0xa9, 0xbd, 0xbe, 0x28, 0x8f, 0xa3, 0x61, 0xde, 0x83, 0xbd, 0xb1, 0x08, 0x07, 0x7c, 0x1f,
-	0xbe, 0xf0, 0x9f, 0x9f, 0x6b, 0x89, 0xbb, 0xff, 0xec, 0xfc, 0xdf, 0x58, 0xff, 0x80, 0x60, 0x2e,
-	0xa6, 0xaa, 0x83, 0x9e, 0xa0, 0x8f, 0xa3, 0x16, 0x75, 0x98, 0xef, 0x09, 0xf1, 0x80, 0x15, 0xfa,
-	0x09, 0xc1, 0x33, 0x31, 0xf9, 0xfa, 0x3a, 0x47, 0x1e, 0x43, 0x59, 0x22, 0xce, 0x90, 0x12, 0x64,
-	0xba, 0x83, 0x1f, 0xb0, 0x42, 0xa3, 0x80, 0xcf, 0xd9, 0xca, 0xf7, 0x2c, 0x93, 0x88, 0x8e, 0xe4,
-	0x7a, 0x05, 0xb6, 0x7b, 0x9e, 0x8a, 0x24, 0x07, 0x61, 0x84, 0x4b, 0x49, 0x31, 0x4c, 0x77, 0x06,
-	0xb2, 0x30, 0xab, 0xc8, 0x20, 0x7c, 0xe7, 0xfe, 0x1d, 0x83, 0x61, 0x16, 0x0d, 0x7f, 0x82, 0x60,
-	0xb3, 0x47, 0x63, 0xe2, 0x7d, 0xfe, 0x08, 0x61, 0xd2, 0x54, 0xda, 0xdf, 0xc5, 0x8b, 0xc3, 0x23,
-	0xf2, 0xad, 0xdf, 0xff, 0xfe, 0x6c, 0x5d, 0x06, 0x4f, 0x29, 0x3e, 0xfd, 0xeb, 0x88, 0xf3, 0x3a,
-	0x5b, 0x56, 0x2c, 0x89, 0xe4, 0x5f, 0x23, 0xc0, 0x41, 0x65, 0x89, 0x9f, 0x0d, 0xcf, 0x16, 0x22,
-	0x4d, 0xa5, 0xe7, 0x92, 0xb8, 0x0a, 0x74, 0x07, 0x19, 0x3a, 0x19, 0x4f, 0x77, 0x41, 0xc7, 0xaf,
-	0x51, 0x45, 0x7e, 0xf2, 0xe1, 0xbb, 0x08, 0x76, 0x86, 0x4b, 0x46, 0x3c, 0xe3, 0x4f, 0x1e, 0x2b,
-	0x52, 0x25, 0x39, 0xa9, 0xbb, 0xc0, 0x7b, 0x92, 0xe1, 0x3d, 0x8a, 0x8f, 0x44, 0xe1, 0x55, 0xf9,
-	0xfa, 0x62, 0xcb, 0x0d, 0x50, 0x64, 0x6a, 0x46, 0xb9, 0xce, 0x5e, 0x94, 0x1b, 0xf8, 0x47, 0x04,
-	0x3b, 0x42, 0x05, 0x22, 0x9e, 0x8e, 0xc5, 0xe2, 0x13, 0xa4, 0xd2, 0x4c, 0x42, 0x6f, 0x01, 0xfc,
-	0x04, 0x03, 0xfe, 0x02, 0x3e, 0x9c, 0x0c, 0xb8, 0x6e, 0x54, 0x7d, 0xb8, 0xbf, 0x45, 0x80, 0x83,
-	0x7a, 0x30, 0xd8, 0x17, 0x91, 0xc2, 0x33, 0xd8, 0x17, 0xd1, 0xf2, 0x92, 0x2c, 0x30, 0xb8, 0x87,
-	0xf0, 0xc1, 0x6e, 0x70, 0x45, 0x63, 0x44, 0xd6, 0xd8, 0x7b, 0xd1, 0x8c, 0xac, 0x71, 0xa8, 0xc0,
-	0x8c, 0xac, 0x71, 0xb8, 0xdc, 0x4b, 0x5e, 0x63, 0x01, 0xba, 0xa1, 0x9a, 0x96, 0x7d, 0x65, 0x76,
-	0x71, 0xff, 0x83, 0x60, 0x7f, 0x22, 0x1d, 0x85, 0x17, 0x12, 0x21, 0x8b, 0x38, 0xec, 0xa4, 0x63,
-	0x7d, 0xae, 0x16, 0x3c, 0x0b, 0x8c, 0x67, 0x1e, 0xbf, 0xdc, 0x23, 0xcf, 0xa2, 0x41, 0x3b, 0xfb,
-	0x8b, 0x1a, 0xb5, 0xb6, 0x4b, 0xfd, 0x67, 0xe4, 0x7e, 0xb3, 0x08, 0x8a, 0x26, 0x7c, 0x20, 0xb6,
-	0xd9, 0x43, 0x34, 0xa0, 0x34, 0xdb, 0xc3, 0x0a, 0x41, 0x2b, 0xc7, 0x68, 0x1d, 0xc7, 0x0b, 0xc9,
-	0x5e, 0x11, 0xad, 0x52, 0x2c, 0xb1, 0x20, 0x45, 0xcf, 0x1e, 0xfe, 0x82, 0x7c, 0xdf, 0x4d, 0x3c,
-	0x22, 0x07, 0xcf, 0x26, 0x2a, 0x7d, 0xe7, 0x19, 0x2c, 0xcd, 0xf5, 0xb2, 0x44, 0x70, 0x79, 0x91,
-	0x71, 0x39, 0x81, 0x8f, 0xf5, 0xba, 0x45, 0xec, 0x90, 0x75, 0xc9, 0x7c, 0x8c, 0x60, 0x53, 0x87,
-	0xa6, 0xc1, 0xc4, 0x0f, 0x25, 0x28, 0xb8, 0xa4, 0xbd, 0xb1, 0x3e, 0x02, 0xdf, 0x34, 0xc3, 0x37,
-	0x85, 0xf7, 0x45, 0xe1, 0x13, 0xb8, 0xb8, 0x5a, 0xbb, 0x8d, 0x00, 0x78, 0x94, 0x6c, 0x7b, 0x31,
-	0x87, 0x77, 0x87, 0x67, 0x70, 0x00, 0xa4, 0xa2, 0xcc, 0x22, 0xf7, 0x21, 0x96, 0xfb, 0x00, 0x96,
-	0xbb, 0xe4, 0x2e, 0xb5, 0x8b, 0x7a, 0x45, 0xb9, 0x2e, 0x24, 0xcd, 0x0d, 0xfc, 0x2b, 0x02, 0x29,
-	0x5a, 0xc6, 0x04, 0x77, 0xb6, 0xab, 0x60, 0x0a, 0xee, 0x6c, 0x77, 0x95, 0x44, 0xce, 0x30, 0xf4,
-	0x27, 0xf1, 0xf1, 0x28, 0xf4, 0x5e, 0x0d, 0xd5, 0x6a, 0x98, 0x36, 0x11, 0x41, 0xa2, 0x83, 0xcd,
-	0x6f, 0x08, 0x26, 0x62, 0x2e, 0x52, 0x38, 0xbe, 0xeb, 0x42, 0xc5, 0x94, 0x34, 0xdf, 0xd3, 0x9a,
-	0xa4, 0x84, 0x7c, 0xad, 0x5a, 0x63, 0x61, 0x8a, 0xce, 0x35, 0x31, 0x7a, 0xe8, 0xbb, 0x54, 0xe2,
-	0x87, 0xbe, 0x9f, 0xc4, 0x4c, 0x42, 0xef, 0x3e, 0x87, 0x7e, 0x00, 0xf7, 0xa7, 0xeb, 0xe0, 0xf9,
-	0x1e, 0xae, 0xff, 0x38, 0xdb, 0x43, 0x91, 0xa3, 0x0e, 0x80, 0xd3, 0x03, 0xc5, 0x10, 0xcc, 0xdf,
-	0x62, 0xcc, 0xcf, 0xe3, 0x73, 0xfd, 0x6d, 0x5c, 0xdc, 0x69, 0xb0, 0xbc, 0xf2, 0x99, 0x2f, 0xf2,
-	0x96, 0x8f, 0x0f, 0xf7, 0x40, 0xc2, 0x33, 0xa1, 0x8e, 0xf4, 0xbe, 0x50, 0x50, 0xce, 0x33, 0xca,
-	0x67, 0x70, 0xae, 0x4f, 0xca, 0xde, 0xe9, 0xda, 0x86, 0x11, 0xae, 0x0d, 0x82, 0x73, 0x35, 0x28,
-	0x3f, 0x82, 0x73, 0x35, 0x44, 0x8c, 0x90, 0x29, 0x06, 0x70, 0x12, 0xa7, 0xa2, 0x00, 0x72, 0xf9,
-	0x91, 0xcd, 0xdf, 0x7b, 0x98, 0x42, 0xf7, 0x1f, 0xa6, 0xd0, 0x5f, 0x0f, 0x53, 0xe8, 0xce, 0x72,
-	0x6a, 0xe8, 0xfe, 0x72, 0x6a, 0xe8, 0x8f, 0xe5, 0xd4, 0xd0, 0xdb, 0x73, 0x1d, 0x9f, 0xa5, 0x44,
-	0x8c, 0x99, 0x9a, 0x5a, 0x32, 0xdd, 0x80, 0xd7, 0x66, 0xe7, 0x95, 0x0f, 0x9c, 0xb0, 0xec, 0x33,
-	0x55, 0x69, 0x84, 0x69, 0xbc, 0xf9, 0xff, 0x02, 0x00, 0x00, 0xff, 0xff, 0x12, 0x71, 0xac, 0x36,
-	0x3f, 0x1c, 0x00, 0x00,
+	// 1534 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0xdd, 0x73, 0x13, 0xd5,
+	0x1b, 0xee, 0x01, 0xda, 0xdf, 0x8f, 0x17, 0xf9, 0xf0, 0x50, 0xb0, 0xdd, 0xb6, 0x49, 0x59, 0xa0,
+	0x46, 0x6c, 0x76, 0x69, 0xca, 0x00, 0x32, 0xe5, 0x2b, 0x54, 0x9c, 0x6a, 0x44, 0x08, 0x28, 0xe3,
+	0xd7, 0x64, 0x36, 0xc9, 0x21, 0xec, 0x90, 0xec, 0x09, 0xd9, 0x0d, 0x12, 0x19, 0x44, 0xc1, 0x4b,
+	0x2f, 0x70, 0xbc, 0x71, 0xbc, 0x70, 0xd4, 0x3b, 0xbd, 0x70, 0xbc, 0xf1, 0x82, 0xf1, 0xde, 0x61,
+	0x74, 0xc6, 0x61, 0xc6, 0x1b, 0xc7, 0x8b, 0xe2, 0x50, 0xff, 0x02, 0xae, 0xbc, 0x74, 0xf6, 0x9c,
+	0xb3, 0x69, 0x76, 0xb3, 0xbb, 0xdd, 0x4d, 0xa4, 0xd3, 0xab, 0x36, 0x7b, 0xde, 0xf3, 0xbc, 0xcf,
+	0xf3, 0xee, 0xbb, 0xe7, 0xdd, 0x67, 0x41, 0xa2, 0x66, 0x8d, 0x9a, 0xba, 0xa9, 0x56, 0x69, 0xe9,
+	0x4a, 0xb3, 0xae, 0x5e, 0x6d, 0x92, 0x46, 0x4b, 0xa9, 0x37, 0xa8, 0x45, 0xf1, 0x16, 0xb1, 0xa6,
+	0xf0, 0x35, 0x69, 0xb8, 0x42, 0x2b, 0x94, 0x2d, 0xa9, 0xf6, 0x7f, 0x3c, 0x4a, 0x4a, 0x94, 0x58,
+	0x98, 0x5a, 0xd4, 0x4c, 0xa2, 0x5e, 0x9b, 0x29, 0x12, 0x4b, 0x9b, 0x51, 0x4b, 0x54, 0x37, 0xc4,
+	0xfa, 0x78, 0x85, 0xd2, 0x4a, 0x95, 0xa8, 0x5a, 0x5d, 0x57, 0x35, 0xc3, 0xa0, 0x96, 0x66, 0xe9,
+	0xd4, 0x30, 0xc5, 0x6a, 0x52, 0xac, 0xb2, 0x5f, 0xc5, 0xe6, 0x25, 0xd5, 0xd2, 0x6b, 0xc4, 0xb4,
+	0xb4, 0x5a, 0xdd, 0x81, 0xf7, 0x06, 0x94, 0x9b, 0x0d, 0x86, 0x20, 0xd6, 0x47, 0x3d, 0x02, 0xec,
+	0x3f, 0x62, 0x69, 0xcc, 0xb3, 0x54, 0xd7, 0x1a, 0x5a, 0x4d, 0x24, 0x96, 0x77, 0xc2, 0xf0, 0xab,
+	0xb4, 0xdc, 0xac, 0x92, 0xac, 0x56, 0xd5, 0x8c, 0x12, 0xc9, 0x93, 0xab, 0x4d, 0x62, 0x5a, 0xf2,
+	0xfb, 0xb0, 0xc3, 0x73, 0xdd, 0xac, 0x53, 0xc3, 0x24, 0x58, 0x83, 0x41, 0x5b, 0x95, 0x39, 0x82,
+	0x26, 0xd7, 0xa7, 0x36, 0x65, 0x46, 0x15, 0xae, 0x5b, 0xb1, 0x75, 0x2b, 0x42, 0xb7, 0x72, 0x8a,
+	0xea, 0x46, 0x76, 0xff, 0xfd, 0xc5, 0xe4, 0xc0, 0x77, 0x0f, 0x93, 0xa9, 0x8a, 0x6e, 0x5d, 0x6e,
+	0x16, 0x95, 0x12, 0xad, 0xa9, 0xa2, 0x48, 0xfc, 0x4f, 0xda, 0x2c, 0x5f, 0x51, 0xad, 0x56, 0x9d,
+	0x98, 0x6c, 0x83, 0x99, 0xe7, 0xc8, 0xf2, 0x18, 0x8c, 0xf2, 0xdc, 0x39, 0x5a, 0xba, 0x42, 0xca,
+	0x27, 0x6b, 0xb4, 0x69, 0x58, 0x0e, 0xb1, 0x5b, 0x20, 0xf9, 0x2d, 0xae, 0x1e, 0xbb, 0x97, 0x60,
+	0xe2, 0x64, 0xa9, 0x64, 0x67, 0x7d, 0xdd, 0xb0, 0x2b, 0xaa, 0x15, 0xab, 0x84, 0x07, 0x70, 0x86,
+	0x78, 0x0a, 0x06, 0xe9, 0x7b, 0x06, 0x69, 0x8c, 0xa0, 0x49, 0x94, 0xda, 0x98, 0xdd, 0xf6, 0x78,
+	0x31, 0xf9, 0x54, 0x4b, 0xab, 0x55, 0x8f, 0xc8, 0xec, 0xb2, 0x9c, 0xe7, 0xcb, 0xf2, 0x1d, 0x04,
+	0x89, 0x20, 0xa4, 0xd5, 0x93, 0x73, 0x1a, 0xc6, 0x5d, 0x24, 0x74, 0xa3, 0xd2, 0x93, 0x9a, 0xdb,
+	0x08, 0x26, 0x02, 0x80, 0x56, 0x4f, 0xcc, 0x29, 0x18, 0x15, 0x1c, 0x78, 0x77, 0xf4, 0xa4, 0xe4,
+	0x16, 0x48, 0x7e, 0x20, 0xab, 0xa7, 0xe2, 0x4b, 0x04, 0xe3, 0x2e, 0x06, 0x67, 0x35, 0xd3, 0xba,
+	0xa0, 0xd7, 0x48, 0x4c, 0x25, 0xf8, 0x0d, 0xd8, 0xd8, 0x3e, 0x47, 0x46, 0xd6, 0x4d, 0xa2, 0xd4,
+	0xa6, 0x8c, 0xa4, 0xf0, 0x83, 0x44, 0x71, 0x0e, 0x12, 0xe5, 0x82, 0x13, 0x91, 0x1d, 0xb7, 0x09,
+	0x3f, 0x5e, 0x4c, 0x6e, 0xe3, 0x58, 0xed, 0xad, 0xf2, 0xdd, 0x87, 0x49, 0x94, 0x5f, 0x86, 0x92,
+	0x2f, 0xc2, 0x44, 0x00, 0x3f, 0x51, 0xa4, 0x83, 0x30, 0x68, 0xb7, 0x80, 0x53, 0x24, 0x49, 0x71,
+	0x1f, 0xa1, 0xca, 0x59, 0xd2, 0xd0, 0x69, 0xd9, 0xde, 0x9c, 0xdd, 0x60, 0x27, 0xcd, 0xf3, 0x70,
+	0xf9, 0x7b, 0x04, 0xd3, 0xbe, 0xc8, 0x67, 0xe8, 0x72, 0x57, 0xbd, 0x66, 0x54, 0x5b, 0x6b, 0xa5,
+	0x12, 0x15, 0x48, 0x47, 0xe4, 0xdb, 0x67, 0x65, 0xbe, 0x41, 0x30, 0xe9, 0x7a, 0xbc, 0x48, 0x39,
+	0x4b, 0x2e, 0xd1, 0x06, 0x59, 0x4b, 0x7d, 0xf1, 0x36, 0xec, 0x0a, 0xe1, 0xd8, 0x67, 0x05, 0xee,
+	0xa1, 0x36, 0xba, 0xbb, 0xd6, 0xf3, 0xc4, 0xa0, 0xb5, 0x35, 0x52, 0x02, 0x3c, 0x0c, 0x83, 0x65,
+	0x9b, 0xcf, 0xc8, 0x7a, 0x3b, 0x7f, 0x9e, 0xff, 0x90, 0xdf, 0x01, 0x39, 0x8c, 0x7a, 0x9f, 0x95,
+	0xf9, 0x00, 0x30, 0x87, 0x75, 0x55, 0xa2, 0xcd, 0x04, 0x75, 0x30, 0xc1, 0x79, 0xf8, 0xbf, 0xf3,
+	0xe6, 0x20, 0x64, 0x8f, 0x76, 0xc9, 0x9e, 0x17, 0x01, 0xd9, 0x31, 0xa1, 0x7a, 0x2b, 0x57, 0xed,
+	0x6c, 0x94, 0x3f, 0xb7, 0x45, 0xb7, 0x71, 0x64, 0x03, 0xb6, 0xbb, 0xf2, 0x0b, 0x39, 0x17, 0x61,
+	0x48, 0x63, 0xd3, 0x59, 0xdc, 0x8b, 0xe3, 0x36, 0xda, 0x9f, 0x8b, 0xc9, 0xa9, 0x08, 0xe7, 0xe1,
+	0x82, 0x61, 0x3d, 0x5e, 0x4c, 0x6e, 0xe6, 0x79, 0x39, 0x8a, 0x9c, 0x17, 0x70, 0x72, 0x0a, 0x36,
+	0xf3, 0x7c, 0x8e, 0xd4, 0x67, 0xe0, 0x7f, 0x76, 0x25, 0x0a, 0x7a, 0x99, 0xa5, 0xda, 0x90, 0x1f,
+	0xb2, 0x7f, 0x2e, 0x94, 0xe5, 0x13, 0xb0, 0xc5, 0x89, 0x14, 0xa4, 0x14, 0xd8, 0x60, 0xaf, 0xb1,
+	0xb8, 0xd0, 0x12, 0xe7, 0x59, 0x9c, 0xbc, 0x1d, 0x9e, 0x3e, 0x43, 0xae, 0xb3, 0xdb, 0xb6, 0x30,
+	0xef, 0xbc, 0x83, 0xa4, 0x01, 0x77, 0x5e, 0x14, 0xd0, 0x81, 0x2c, 0xe6, 0x60, 0xd7, 0xf9, 0x96,
+	0x61, 0x5d, 0x26, 0x96, 0x5e, 0xca, 0xb1, 0x3c, 0x66, 0xb6, 0xc5, 0xff, 0x69, 0x63, 0x06, 0xef,
+	0x6e, 0x80, 0x1c, 0xb6, 0x5b, 0x24, 0xcf, 0xc1, 0x56, 0xd3, 0x89, 0x2a, 0x74, 0x76, 0xd1, 0x84,
+	0x57, 0xa2, 0x0b, 0x4c, 0x34, 0xd2, 0x16, 0xb3, 0xf3, 0xa2, 0x29, 0x7f, 0x85, 0x3c, 0x0d, 0x9b,
+	0xa3, 0x46, 0x85, 0x34, 0x9c, 0xc6, 0x88, 0xfb, 0xb0, 0x3d, 0x89, 0xa6, 0x7b, 0x17, 0x76, 0x87,
+	0x32, 0xec, 0xf3, 0x99, 0xfa, 0xc2, 0x3b, 0x83, 0xd7, 0x92, 0x76, 0xef, 0xfc, 0xfd, 0xcf, 0x54,
+	0xff, 0x80, 0x20, 0x13, 0x52, 0xd5, 0x7e, 0xa7, 0xf0, 0x93, 0xa8, 0x45, 0x0d, 0x66, 0x63, 0x31,
+	0xee, 0xb3, 0x42, 0x3f, 0x21, 0x78, 0x36, 0x24, 0x5f, 0x4f, 0xb3, 0xe8, 0x09, 0x94, 0x25, 0x60,
+	0x0e, 0x15, 0x21, 0xb5, 0x32, 0xf9, 0x3e, 0x2b, 0x34, 0x0c, 0xf8, 0x9c, 0xed, 0x9e, 0xcf, 0x32,
+	0x9b, 0xe9, 0x1c, 0x99, 0xaf, 0xc0, 0x76, 0xd7, 0x55, 0x91, 0xe4, 0x00, 0x0c, 0x71, 0x3b, 0x2a,
+	0x0e, 0xe4, 0x9d, 0x5d, 0x59, 0xd8, 0xaa, 0xc8, 0x20, 0x62, 0x33, 0x1f, 0x4a, 0x30, 0xc8, 0xd0,
+	0xf0, 0x27, 0x08, 0x36, 0xbb, 0x7c, 0x2a, 0xde, 0xe3, 0x45, 0xf0, 0xb3, 0xb7, 0xd2, 0xde, 0x15,
+	0xa2, 0x38, 0x3d, 0x59, 0xb9, 0xfd, 0xfb, 0xdf, 0x9f, 0xad, 0x4b, 0xe1, 0x29, 0xd5, 0xe3, 0xa1,
+	0x1d, 0x83, 0x5f, 0x63, 0xdb, 0x0a, 0x45, 0x91, 0xfc, 0x6b, 0x04, 0xb8, 0xdb, 0x9d, 0xe2, 0xe7,
+	0xfc, 0xb3, 0xf9, 0xd8, 0x5b, 0x69, 0x5f, 0x94, 0x50, 0xc1, 0xee, 0x00, 0x63, 0xa7, 0xe0, 0xe9,
+	0x15, 0xd8, 0xf1, 0x57, 0xb1, 0x02, 0x9f, 0x9e, 0xf8, 0x1e, 0x82, 0x9d, 0xfe, 0xb6, 0x13, 0xa7,
+	0xbd, 0xc9, 0x43, 0x8d, 0xae, 0xa4, 0x44, 0x0d, 0x17, 0x7c, 0x4f, 0x30, 0xbe, 0x47, 0xf0, 0xe1,
+	0x20, 0xbe, 0x1a, 0xdf, 0x5f, 0x68, 0xb6, 0x01, 0x0a, 0xcc, 0x11, 0xa9, 0x37, 0xd8, 0x83, 0x72,
+	0x13, 0xff, 0x88, 0x60, 0x87, 0xaf, 0xc9, 0xc4, 0xd3, 0xa1, 0x5c, 0x3c, 0xa6, 0x56, 0x4a, 0x47,
+	0x8c, 0x16, 0xc4, 0x8f, 0x33, 0xe2, 0x2f, 0xe0, 0x43, 0xd1, 0x88, 0xeb, 0x46, 0xc5, 0xc3, 0xfb,
+	0x5b, 0x04, 0xb8, 0xdb, 0x53, 0x76, 0xf7, 0x45, 0xa0, 0x79, 0x95, 0xf6, 0x45, 0x09, 0x15, 0x74,
+	0xe7, 0x18, 0xdd, 0x83, 0xf8, 0xc0, 0x4a, 0x74, 0x45, 0x63, 0x04, 0xd6, 0xd8, 0xfd, 0xb2, 0x1a,
+	0x58, 0x63, 0x5f, 0x93, 0x2a, 0xa5, 0x23, 0x46, 0xc7, 0xad, 0xb1, 0x20, 0x5d, 0xd7, 0x4c, 0xcb,
+	0x7e, 0xed, 0x6e, 0xf3, 0xfe, 0x07, 0xc1, 0xde, 0x48, 0x5e, 0x0c, 0xcf, 0x45, 0x62, 0x16, 0x30,
+	0xec, 0xa4, 0xa3, 0x3d, 0xee, 0x16, 0x3a, 0xf3, 0x4c, 0x67, 0x0e, 0xbf, 0x1c, 0x53, 0x67, 0xc1,
+	0xa0, 0x9d, 0xfd, 0x45, 0x8d, 0x6a, 0xab, 0x2d, 0xfd, 0x67, 0xd4, 0xfe, 0xee, 0xd1, 0x6d, 0xbc,
+	0xf0, 0xfe, 0xd0, 0x66, 0xf7, 0xf1, 0x91, 0xd2, 0x4c, 0x8c, 0x1d, 0x42, 0xd6, 0x3c, 0x93, 0x75,
+	0x0c, 0xcf, 0x45, 0x7b, 0x44, 0x48, 0xb9, 0x50, 0x64, 0x20, 0x05, 0xd7, 0x3d, 0xfc, 0x05, 0x81,
+	0xe4, 0x5b, 0x4e, 0x36, 0x9a, 0xf0, 0x4c, 0xa4, 0xd2, 0x77, 0xce, 0x60, 0x29, 0x13, 0x67, 0x8b,
+	0xd0, 0xf2, 0x22, 0xd3, 0x72, 0x1c, 0x1f, 0x8d, 0x7b, 0x8b, 0xd8, 0x90, 0x6d, 0x8b, 0xf9, 0x18,
+	0xc1, 0xa6, 0x0e, 0x5f, 0x84, 0x65, 0x2f, 0x95, 0x6e, 0xd3, 0x26, 0xed, 0x0e, 0x8d, 0x11, 0xfc,
+	0xa6, 0x19, 0xbf, 0x29, 0xbc, 0x27, 0x88, 0x9f, 0xe0, 0xc5, 0x1d, 0xdf, 0x1d, 0x04, 0xc0, 0x51,
+	0xb2, 0xad, 0x85, 0x79, 0x3c, 0xe1, 0x9f, 0xc1, 0x21, 0x90, 0x08, 0x5a, 0x16, 0xb9, 0x0f, 0xb2,
+	0xdc, 0xfb, 0xb1, 0xb2, 0x42, 0xee, 0x62, 0xab, 0xa0, 0x97, 0xd5, 0x1b, 0xc2, 0xd2, 0xdc, 0xc4,
+	0x1f, 0x21, 0x80, 0x65, 0xcf, 0x84, 0x77, 0x79, 0xd3, 0x74, 0x99, 0x2c, 0x49, 0x0e, 0x0b, 0x89,
+	0x5a, 0x09, 0x83, 0x5c, 0xe7, 0xb7, 0xa9, 0xa0, 0x97, 0xf1, 0xaf, 0x08, 0xa4, 0x60, 0x2b, 0xd5,
+	0xdd, 0x5d, 0x2b, 0x9a, 0x36, 0x29, 0x13, 0x67, 0x8b, 0xe0, 0x7c, 0x9a, 0x71, 0x3e, 0x81, 0x8f,
+	0x05, 0x71, 0x76, 0xfb, 0xb8, 0x66, 0xdd, 0xb4, 0x8b, 0x29, 0x34, 0x74, 0x54, 0xf4, 0x37, 0x04,
+	0x63, 0x21, 0x2f, 0x73, 0x38, 0xbc, 0xf3, 0x7d, 0x0d, 0x9d, 0x34, 0x1b, 0x6b, 0x4f, 0x54, 0x41,
+	0x9e, 0xc7, 0xa5, 0xca, 0x60, 0x0a, 0xce, 0xab, 0x6a, 0xf0, 0xe0, 0x69, 0x4b, 0x09, 0x1f, 0x3c,
+	0x5e, 0x11, 0xe9, 0x88, 0xd1, 0x3d, 0x0e, 0x9e, 0x2e, 0xde, 0x9f, 0xae, 0x83, 0xe7, 0x63, 0x58,
+	0x10, 0x9c, 0x8d, 0x51, 0xe4, 0xa0, 0x21, 0x74, 0xaa, 0x2f, 0x0c, 0xa1, 0xfc, 0x4d, 0xa6, 0xfc,
+	0x3c, 0x3e, 0xd7, 0xdb, 0x8d, 0x0b, 0x9b, 0x48, 0x4b, 0xcb, 0x9f, 0x2b, 0x03, 0x9d, 0x06, 0x3e,
+	0x14, 0x43, 0x84, 0xeb, 0x94, 0x3c, 0x1c, 0x7f, 0xa3, 0x90, 0x9c, 0x63, 0x92, 0x4f, 0xe3, 0xf9,
+	0x1e, 0x25, 0xbb, 0x4f, 0xf8, 0x16, 0x0c, 0x71, 0x7f, 0xd2, 0x7d, 0xb6, 0x77, 0x5b, 0x20, 0x69,
+	0x77, 0x68, 0x8c, 0x20, 0x38, 0xc5, 0x08, 0x4e, 0xe2, 0x44, 0x10, 0x41, 0x6e, 0x81, 0xb2, 0xb9,
+	0xfb, 0x8f, 0x12, 0xe8, 0xc1, 0xa3, 0x04, 0xfa, 0xeb, 0x51, 0x02, 0xdd, 0x5d, 0x4a, 0x0c, 0x3c,
+	0x58, 0x4a, 0x0c, 0xfc, 0xb1, 0x94, 0x18, 0x78, 0x2b, 0xd3, 0xf1, 0x79, 0x4d, 0x60, 0xa4, 0xab,
+	0x5a, 0xd1, 0x6c, 0x03, 0x5e, 0x9b, 0x99, 0x55, 0xaf, 0x3b, 0xb0, 0xec, 0x73, 0x5b, 0x71, 0x88,
+	0xf9, 0xcc, 0xd9, 0x7f, 0x07, 0x00, 0x86, 0x1e, 0x79, 0x7e, 0x07, 0x1d, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1780,6 +1864,8 @@ type QueryClient interface {
 	LockedDenom(ctx context.Context, in *LockedDenomRequest, opts ...grpc.CallOption) (*LockedDenomResponse, error)
 	// Returns lock record by id
 	LockedByID(ctx context.Context, in *LockedRequest, opts ...grpc.CallOption) (*LockedResponse, error)
+	// Returns the ID the next created lock will have
+	NextLockID(ctx context.Context, in *NextLockIDRequest, opts ...grpc.CallOption) (*NextLockIDResponse, error)
 	// Returns synthetic lockups by native lockup id
 	SyntheticLockupsByLockupID(ctx context.Context, in *SyntheticLockupsByLockupIDRequest, opts ...grpc.CallOption) (*SyntheticLockupsByLockupIDResponse, error)
 	// Returns account locked records with longer duration
@@ -1902,6 +1988,15 @@ func (c *queryClient) LockedByID(ctx context.Context, in *LockedRequest, opts ..
 	return out, nil
 }
 
+func (c *queryClient) NextLockID(ctx context.Context, in *NextLockIDRequest, opts ...grpc.CallOption) (*NextLockIDResponse, error) {
+	out := new(NextLockIDResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.lockup.Query/NextLockID", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) SyntheticLockupsByLockupID(ctx context.Context, in *SyntheticLockupsByLockupIDRequest, opts ...grpc.CallOption) (*SyntheticLockupsByLockupIDResponse, error) {
 	out := new(SyntheticLockupsByLockupIDResponse)
 	err := c.cc.Invoke(ctx, "/osmosis.lockup.Query/SyntheticLockupsByLockupID", in, out, opts...)
@@ -1981,6 +2076,8 @@ type QueryServer interface {
 	LockedDenom(context.Context, *LockedDenomRequest) (*LockedDenomResponse, error)
 	// Returns lock record by id
 	LockedByID(context.Context, *LockedRequest) (*LockedResponse, error)
+	// Returns the ID the next created lock will have
+	NextLockID(context.Context, *NextLockIDRequest) (*NextLockIDResponse, error)
 	// Returns synthetic lockups by native lockup id
 	SyntheticLockupsByLockupID(context.Context, *SyntheticLockupsByLockupIDRequest) (*SyntheticLockupsByLockupIDResponse, error)
 	// Returns account locked records with longer duration
@@ -2033,6 +2130,9 @@ func (*UnimplementedQueryServer) LockedDenom(ctx context.Context, req *LockedDen
 func (*UnimplementedQueryServer) LockedByID(ctx context.Context, req *LockedRequest) (*LockedResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method LockedByID not implemented")
 }
+func (*UnimplementedQueryServer) NextLockID(ctx context.Context, req *NextLockIDRequest) (*NextLockIDResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NextLockID not implemented")
+}
 func (*UnimplementedQueryServer) SyntheticLockupsByLockupID(ctx context.Context, req *SyntheticLockupsByLockupIDRequest) (*SyntheticLockupsByLockupIDResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SyntheticLockupsByLockupID not implemented")
 }
@@ -2254,6 +2354,24 @@ func _Query_LockedByID_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_NextLockID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NextLockIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).NextLockID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.lockup.Query/NextLockID",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).NextLockID(ctx, req.(*NextLockIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_SyntheticLockupsByLockupID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(SyntheticLockupsByLockupIDRequest)
 	if err := dec(in); err != nil {
@@ -2410,6 +2528,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "LockedByID",
 			Handler:    _Query_LockedByID_Handler,
 		},
+		{
+			MethodName: "NextLockID",
+			Handler:    _Query_NextLockID_Handler,
+		},
 		{
 			MethodName: "SyntheticLockupsByLockupID",
 			Handler:    _Query_SyntheticLockupsByLockupID_Handler,
@@ -3201,6 +3323,57 @@ func (m *LockedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *NextLockIDRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NextLockIDRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NextLockIDRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *NextLockIDResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NextLockIDResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NextLockIDResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LockId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.LockId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *SyntheticLockupsByLockupIDRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -3947,6 +4120,27 @@ func (m *LockedResponse) Size() (n int) {
 	return n
 }
 
+func (m *NextLockIDRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *NextLockIDResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LockId != 0 {
+		n += 1 + sovQuery(uint64(m.LockId))
+	}
+	return n
+}
+
 func (m *SyntheticLockupsByLockupIDRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -6072,6 +6266,125 @@ func (m *LockedResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *NextLockIDRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NextLockIDRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NextLockIDRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NextLockIDResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NextLockIDResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NextLockIDResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockId", wireType)
+			}
+			m.LockId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LockId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *SyntheticLockupsByLockupIDRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -289,6 +289,9 @@ func (m *MsgBeginUnlocking) GetCoins() github_com_cosmos_cosmos_sdk_types.Coins
 
 type MsgBeginUnlockingResponse struct {
 	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	// ID of the lock that started unlocking. This is a new lock split off the
+	// given lock when only part of its coins are unlocked.
+	UnlockingLockId uint64 `protobuf:"varint,2,opt,name=unlocking_lock_id,json=unlockingLockId,proto3" json:"unlocking_lock_id,omitempty"`
 }
 
 func (m *MsgBeginUnlockingResponse) Reset()         { *m = MsgBeginUnlockingResponse{} }
@@ -331,6 +334,13 @@ func (m *MsgBeginUnlockingResponse) GetSuccess() bool {
 	return false
 }
 
+func (m *MsgBeginUnlockingResponse) GetUnlockingLockId() uint64 {
+	if m != nil {
+		return m.UnlockingLockId
+	}
+	return 0
+}
+
 // MsgExtendLockup extends the existing lockup's duration.
 // The new duration is longer than the original.
 type MsgExtendLockup struct {
@@ -546,6 +556,303 @@ func (m *MsgForceUnlockResponse) GetSuccess() bool {
 	return false
 }
 
+// MsgSplitLock splits each of the given amounts off a lock into a new lock
+// with the same owner, denom and duration. The remainder stays in the lock.
+type MsgSplitLock struct {
+	Owner   string                                   `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty" yaml:"owner"`
+	ID      uint64                                   `protobuf:"varint,2,opt,name=ID,proto3" json:"ID,omitempty"`
+	Amounts []github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,rep,name=amounts,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amounts" yaml:"amounts"`
+}
+
+func (m *MsgSplitLock) Reset()         { *m = MsgSplitLock{} }
+func (m *MsgSplitLock) String() string { return proto.CompactTextString(m) }
+func (*MsgSplitLock) ProtoMessage()    {}
+func (*MsgSplitLock) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bcdad5af0d24735f, []int{10}
+}
+func (m *MsgSplitLock) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSplitLock) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSplitLock.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSplitLock) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSplitLock.Merge(m, src)
+}
+func (m *MsgSplitLock) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSplitLock) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSplitLock.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSplitLock proto.InternalMessageInfo
+
+func (m *MsgSplitLock) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *MsgSplitLock) GetID() uint64 {
+	if m != nil {
+		return m.ID
+	}
+	return 0
+}
+
+type MsgSplitLockResponse struct {
+	// IDs of the new locks, in the order of the split amounts
+	NewLockIds []uint64 `protobuf:"varint,1,rep,packed,name=new_lock_ids,json=newLockIds,proto3" json:"new_lock_ids,omitempty" yaml:"new_lock_ids"`
+}
+
+func (m *MsgSplitLockResponse) Reset()         { *m = MsgSplitLockResponse{} }
+func (m *MsgSplitLockResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSplitLockResponse) ProtoMessage()    {}
+func (*MsgSplitLockResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bcdad5af0d24735f, []int{11}
+}
+func (m *MsgSplitLockResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSplitLockResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSplitLockResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSplitLockResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSplitLockResponse.Merge(m, src)
+}
+func (m *MsgSplitLockResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSplitLockResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSplitLockResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSplitLockResponse proto.InternalMessageInfo
+
+func (m *MsgSplitLockResponse) GetNewLockIds() []uint64 {
+	if m != nil {
+		return m.NewLockIds
+	}
+	return nil
+}
+
+// MsgTransferLock transfers the lock with the given ID to a new owner,
+// along with its synthetic lockups.
+type MsgTransferLock struct {
+	Owner    string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty" yaml:"owner"`
+	ID       uint64 `protobuf:"varint,2,opt,name=ID,proto3" json:"ID,omitempty"`
+	NewOwner string `protobuf:"bytes,3,opt,name=new_owner,json=newOwner,proto3" json:"new_owner,omitempty" yaml:"new_owner"`
+}
+
+func (m *MsgTransferLock) Reset()         { *m = MsgTransferLock{} }
+func (m *MsgTransferLock) String() string { return proto.CompactTextString(m) }
+func (*MsgTransferLock) ProtoMessage()    {}
+func (*MsgTransferLock) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bcdad5af0d24735f, []int{12}
+}
+func (m *MsgTransferLock) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTransferLock) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTransferLock.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgTransferLock) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTransferLock.Merge(m, src)
+}
+func (m *MsgTransferLock) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTransferLock) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTransferLock.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgTransferLock proto.InternalMessageInfo
+
+func (m *MsgTransferLock) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *MsgTransferLock) GetID() uint64 {
+	if m != nil {
+		return m.ID
+	}
+	return 0
+}
+
+func (m *MsgTransferLock) GetNewOwner() string {
+	if m != nil {
+		return m.NewOwner
+	}
+	return ""
+}
+
+type MsgTransferLockResponse struct {
+}
+
+func (m *MsgTransferLockResponse) Reset()         { *m = MsgTransferLockResponse{} }
+func (m *MsgTransferLockResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgTransferLockResponse) ProtoMessage()    {}
+func (*MsgTransferLockResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bcdad5af0d24735f, []int{13}
+}
+func (m *MsgTransferLockResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTransferLockResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTransferLockResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgTransferLockResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTransferLockResponse.Merge(m, src)
+}
+func (m *MsgTransferLockResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTransferLockResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTransferLockResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgTransferLockResponse proto.InternalMessageInfo
+
+// MsgMergeLocks merges the locks with the given IDs, which must have the same
+// denom, duration and synthetic lockups, into a new lock.
+type MsgMergeLocks struct {
+	Owner   string   `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty" yaml:"owner"`
+	LockIds []uint64 `protobuf:"varint,2,rep,packed,name=lock_ids,json=lockIds,proto3" json:"lock_ids,omitempty" yaml:"lock_ids"`
+}
+
+func (m *MsgMergeLocks) Reset()         { *m = MsgMergeLocks{} }
+func (m *MsgMergeLocks) String() string { return proto.CompactTextString(m) }
+func (*MsgMergeLocks) ProtoMessage()    {}
+func (*MsgMergeLocks) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bcdad5af0d24735f, []int{14}
+}
+func (m *MsgMergeLocks) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgMergeLocks) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgMergeLocks.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgMergeLocks) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgMergeLocks.Merge(m, src)
+}
+func (m *MsgMergeLocks) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgMergeLocks) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgMergeLocks.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgMergeLocks proto.InternalMessageInfo
+
+func (m *MsgMergeLocks) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *MsgMergeLocks) GetLockIds() []uint64 {
+	if m != nil {
+		return m.LockIds
+	}
+	return nil
+}
+
+type MsgMergeLocksResponse struct {
+	// ID of the lock the locks were merged into
+	NewLockId uint64 `protobuf:"varint,1,opt,name=new_lock_id,json=newLockId,proto3" json:"new_lock_id,omitempty" yaml:"new_lock_id"`
+}
+
+func (m *MsgMergeLocksResponse) Reset()         { *m = MsgMergeLocksResponse{} }
+func (m *MsgMergeLocksResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgMergeLocksResponse) ProtoMessage()    {}
+func (*MsgMergeLocksResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bcdad5af0d24735f, []int{15}
+}
+func (m *MsgMergeLocksResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgMergeLocksResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgMergeLocksResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgMergeLocksResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgMergeLocksResponse.Merge(m, src)
+}
+func (m *MsgMergeLocksResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgMergeLocksResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgMergeLocksResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgMergeLocksResponse proto.InternalMessageInfo
+
+func (m *MsgMergeLocksResponse) GetNewLockId() uint64 {
+	if m != nil {
+		return m.NewLockId
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*MsgLockTokens)(nil), "osmosis.lockup.MsgLockTokens")
 	proto.RegisterType((*MsgLockTokensResponse)(nil), "osmosis.lockup.MsgLockTokensResponse")
@@ -557,52 +864,74 @@ func init() {
 	proto.RegisterType((*MsgExtendLockupResponse)(nil), "osmosis.lockup.MsgExtendLockupResponse")
 	proto.RegisterType((*MsgForceUnlock)(nil), "osmosis.lockup.MsgForceUnlock")
 	proto.RegisterType((*MsgForceUnlockResponse)(nil), "osmosis.lockup.MsgForceUnlockResponse")
+	proto.RegisterType((*MsgSplitLock)(nil), "osmosis.lockup.MsgSplitLock")
+	proto.RegisterType((*MsgSplitLockResponse)(nil), "osmosis.lockup.MsgSplitLockResponse")
+	proto.RegisterType((*MsgTransferLock)(nil), "osmosis.lockup.MsgTransferLock")
+	proto.RegisterType((*MsgTransferLockResponse)(nil), "osmosis.lockup.MsgTransferLockResponse")
+	proto.RegisterType((*MsgMergeLocks)(nil), "osmosis.lockup.MsgMergeLocks")
+	proto.RegisterType((*MsgMergeLocksResponse)(nil), "osmosis.lockup.MsgMergeLocksResponse")
 }
 
 func init() { proto.RegisterFile("osmosis/lockup/tx.proto", fileDescriptor_bcdad5af0d24735f) }
 
 var fileDescriptor_bcdad5af0d24735f = []byte{
-	// 631 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x55, 0xcd, 0x6e, 0xd3, 0x40,
-	0x10, 0x8e, 0x13, 0x4a, 0xcb, 0xb4, 0xa4, 0xd4, 0x2a, 0x34, 0xb5, 0xc0, 0x2e, 0x16, 0xb4, 0x41,
-	0x6a, 0xbd, 0x24, 0x81, 0x0b, 0x07, 0x24, 0x42, 0x40, 0xaa, 0xd4, 0x48, 0xc8, 0x6a, 0x25, 0xc4,
-	0x01, 0xc9, 0x76, 0x96, 0xad, 0x15, 0xc7, 0x6b, 0x65, 0xed, 0x92, 0xdc, 0x79, 0x00, 0x8e, 0x3c,
-	0x03, 0x48, 0x5c, 0x78, 0x89, 0x1e, 0x2b, 0x71, 0xe1, 0x94, 0xa2, 0xe4, 0xc6, 0xb1, 0x4f, 0x80,
-	0xbc, 0x8e, 0x2d, 0xe7, 0x47, 0x4d, 0x84, 0x04, 0xe2, 0xe4, 0xac, 0xbf, 0x99, 0x6f, 0xe6, 0xfb,
-	0x76, 0x26, 0x86, 0x0d, 0xca, 0x5a, 0x94, 0xd9, 0x0c, 0x39, 0xd4, 0x6a, 0x06, 0x1e, 0xf2, 0x3b,
-	0x9a, 0xd7, 0xa6, 0x3e, 0x15, 0xf3, 0x43, 0x40, 0x8b, 0x00, 0x69, 0x9d, 0x50, 0x42, 0x39, 0x84,
-	0xc2, 0x5f, 0x51, 0x94, 0x24, 0x13, 0x4a, 0x89, 0x83, 0x11, 0x3f, 0x99, 0xc1, 0x3b, 0xd4, 0x08,
-	0xda, 0x86, 0x6f, 0x53, 0x37, 0xc6, 0x2d, 0x4e, 0x83, 0x4c, 0x83, 0x61, 0x74, 0x52, 0x32, 0xb1,
-	0x6f, 0x94, 0x90, 0x45, 0xed, 0x18, 0xdf, 0x1c, 0x2b, 0x1f, 0x3e, 0x22, 0x48, 0xfd, 0x90, 0x85,
-	0xeb, 0x75, 0x46, 0x0e, 0xa8, 0xd5, 0x3c, 0xa4, 0x4d, 0xec, 0x32, 0x71, 0x1b, 0x16, 0xe8, 0x7b,
-	0x17, 0xb7, 0x0b, 0xc2, 0x96, 0x50, 0xbc, 0x56, 0xbd, 0x71, 0xd1, 0x53, 0x56, 0xba, 0x46, 0xcb,
-	0x79, 0xa2, 0xf2, 0xd7, 0xaa, 0x1e, 0xc1, 0xe2, 0x31, 0x2c, 0xc5, 0x6d, 0x14, 0xb2, 0x5b, 0x42,
-	0x71, 0xb9, 0xbc, 0xa9, 0x45, 0x7d, 0x6a, 0x71, 0x9f, 0x5a, 0x6d, 0x18, 0x50, 0x2d, 0x9d, 0xf6,
-	0x94, 0xcc, 0xaf, 0x9e, 0x22, 0xc6, 0x29, 0xbb, 0xb4, 0x65, 0xfb, 0xb8, 0xe5, 0xf9, 0xdd, 0x8b,
-	0x9e, 0xb2, 0x1a, 0xf1, 0xc7, 0x98, 0xfa, 0xe9, 0x5c, 0x11, 0xf4, 0x84, 0x5d, 0x34, 0x60, 0x21,
-	0x14, 0xc3, 0x0a, 0xb9, 0xad, 0x1c, 0x2f, 0x13, 0xc9, 0xd5, 0x42, 0xb9, 0xda, 0x50, 0xae, 0xf6,
-	0x9c, 0xda, 0x6e, 0xf5, 0x61, 0x58, 0xe6, 0xf3, 0xb9, 0x52, 0x24, 0xb6, 0x7f, 0x1c, 0x98, 0x9a,
-	0x45, 0x5b, 0x68, 0xe8, 0x4d, 0xf4, 0xd8, 0x63, 0x8d, 0x26, 0xf2, 0xbb, 0x1e, 0x66, 0x3c, 0x81,
-	0xe9, 0x11, 0xb3, 0xba, 0x03, 0x37, 0x47, 0x5c, 0xd0, 0x31, 0xf3, 0xa8, 0xcb, 0xb0, 0x98, 0x87,
-	0xec, 0x7e, 0x8d, 0x5b, 0x71, 0x45, 0xcf, 0xee, 0xd7, 0xd4, 0xa7, 0xb0, 0x5e, 0x67, 0xa4, 0x8a,
-	0x89, 0xed, 0x1e, 0xb9, 0xa1, 0x8f, 0xb6, 0x4b, 0x9e, 0x39, 0xce, 0xbc, 0xae, 0xa9, 0x87, 0x70,
-	0x7b, 0x5a, 0x7e, 0x52, 0xef, 0x11, 0x2c, 0x06, 0xfc, 0x3d, 0x2b, 0x08, 0x5c, 0xad, 0xa4, 0x8d,
-	0x8e, 0x88, 0xf6, 0x0a, 0xb7, 0x6d, 0xda, 0x08, 0x5b, 0xd5, 0xe3, 0x50, 0xf5, 0xab, 0x00, 0x6b,
-	0x13, 0xb4, 0x73, 0xdf, 0x64, 0xa4, 0x31, 0x1b, 0x6b, 0xfc, 0x17, 0x7e, 0x3f, 0x86, 0xcd, 0x89,
-	0x7e, 0x13, 0x0f, 0x0a, 0xb0, 0xc8, 0x02, 0xcb, 0xc2, 0x8c, 0xf1, 0xce, 0x97, 0xf4, 0xf8, 0xa8,
-	0x7e, 0x13, 0x60, 0xb5, 0xce, 0xc8, 0x8b, 0x8e, 0x8f, 0x5d, 0x6e, 0x41, 0xe0, 0xfd, 0xb1, 0xca,
-	0xf4, 0xfc, 0xe6, 0xfe, 0xe6, 0xfc, 0xaa, 0x15, 0xd8, 0x18, 0x6b, 0x7a, 0x0e, 0xa9, 0x5f, 0x04,
-	0xc8, 0xd7, 0x19, 0x79, 0x49, 0xdb, 0x16, 0x8e, 0x2c, 0xfa, 0x9f, 0xef, 0xb3, 0x0c, 0xb7, 0x46,
-	0x9b, 0x9d, 0xad, 0xb0, 0xfc, 0x3d, 0x07, 0xb9, 0x3a, 0x23, 0xa2, 0x0e, 0x90, 0xfa, 0xfb, 0xb9,
-	0x33, 0x3e, 0xef, 0x23, 0x7b, 0x29, 0xdd, 0xbf, 0x14, 0x4e, 0xaa, 0x12, 0x58, 0x9b, 0xdc, 0xd1,
-	0x7b, 0x53, 0x72, 0x27, 0xa2, 0xa4, 0xdd, 0x79, 0xa2, 0x92, 0x42, 0x6f, 0x21, 0x3f, 0xb6, 0x75,
-	0x77, 0x67, 0xe6, 0x4b, 0x0f, 0x66, 0x86, 0x24, 0xfc, 0xaf, 0x61, 0x65, 0x64, 0xda, 0x95, 0x29,
-	0xa9, 0xe9, 0x00, 0x69, 0x67, 0x46, 0x40, 0xc2, 0x7c, 0x04, 0xcb, 0xe9, 0xe1, 0x92, 0xa7, 0xe4,
-	0xa5, 0x70, 0x69, 0xfb, 0x72, 0x3c, 0xa6, 0xad, 0x1e, 0x9c, 0xf6, 0x65, 0xe1, 0xac, 0x2f, 0x0b,
-	0x3f, 0xfb, 0xb2, 0xf0, 0x71, 0x20, 0x67, 0xce, 0x06, 0x72, 0xe6, 0xc7, 0x40, 0xce, 0xbc, 0x29,
-	0xa7, 0x86, 0x6a, 0xc8, 0xb5, 0xe7, 0x18, 0x26, 0x8b, 0x0f, 0xe8, 0xa4, 0x54, 0x41, 0x9d, 0xe4,
-	0x13, 0x19, 0x0e, 0x99, 0x79, 0x95, 0xaf, 0x62, 0xe5, 0x77, 0x00, 0x00, 0x00, 0xff, 0xff, 0xf5,
-	0x2c, 0xd8, 0xaf, 0x41, 0x07, 0x00, 0x00,
+	// 881 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x56, 0x41, 0x6f, 0xe3, 0x44,
+	0x14, 0xae, 0x93, 0x2d, 0x6d, 0x5e, 0x4b, 0xba, 0xf5, 0x76, 0xb7, 0xa9, 0xb5, 0xc4, 0x65, 0xb4,
+	0xdb, 0x16, 0xb4, 0x6b, 0x93, 0x16, 0x21, 0xc1, 0x01, 0x81, 0x29, 0x48, 0x95, 0x1a, 0x15, 0x4c,
+	0x57, 0x42, 0x7b, 0x60, 0xe5, 0x38, 0xb3, 0xb3, 0x56, 0x1d, 0x4f, 0xe4, 0xb1, 0xb7, 0xad, 0xc4,
+	0x91, 0x1f, 0xc0, 0x91, 0x33, 0x47, 0x90, 0xb8, 0xf0, 0x27, 0xf6, 0xb8, 0xe2, 0x84, 0x38, 0x78,
+	0x51, 0x7b, 0xe3, 0x98, 0x5f, 0x80, 0x3c, 0x63, 0x4f, 0xec, 0x26, 0x4a, 0x22, 0x2a, 0xd0, 0x9e,
+	0x1c, 0xfb, 0x7b, 0xef, 0x7b, 0xef, 0xfb, 0xfc, 0xfc, 0x26, 0xb0, 0x4e, 0x59, 0x8f, 0x32, 0x8f,
+	0x99, 0x3e, 0x75, 0x4f, 0xe2, 0xbe, 0x19, 0x9d, 0x19, 0xfd, 0x90, 0x46, 0x54, 0xad, 0x67, 0x80,
+	0x21, 0x00, 0x6d, 0x8d, 0x50, 0x42, 0x39, 0x64, 0xa6, 0xbf, 0x44, 0x94, 0xd6, 0x24, 0x94, 0x12,
+	0x1f, 0x9b, 0xfc, 0xae, 0x13, 0x3f, 0x35, 0xbb, 0x71, 0xe8, 0x44, 0x1e, 0x0d, 0x72, 0xdc, 0xe5,
+	0x34, 0x66, 0xc7, 0x61, 0xd8, 0x7c, 0xde, 0xea, 0xe0, 0xc8, 0x69, 0x99, 0x2e, 0xf5, 0x72, 0x7c,
+	0xe3, 0x4a, 0xf9, 0xf4, 0x22, 0x20, 0xf4, 0x7d, 0x05, 0xde, 0x6c, 0x33, 0x72, 0x48, 0xdd, 0x93,
+	0x63, 0x7a, 0x82, 0x03, 0xa6, 0x6e, 0xc1, 0x3c, 0x3d, 0x0d, 0x70, 0xd8, 0x50, 0x36, 0x95, 0x9d,
+	0x9a, 0x75, 0x73, 0x90, 0xe8, 0xcb, 0xe7, 0x4e, 0xcf, 0xff, 0x08, 0xf1, 0xc7, 0xc8, 0x16, 0xb0,
+	0xfa, 0x0c, 0x16, 0xf3, 0x36, 0x1a, 0x95, 0x4d, 0x65, 0x67, 0x69, 0x77, 0xc3, 0x10, 0x7d, 0x1a,
+	0x79, 0x9f, 0xc6, 0x7e, 0x16, 0x60, 0xb5, 0x5e, 0x24, 0xfa, 0xdc, 0xdf, 0x89, 0xae, 0xe6, 0x29,
+	0x0f, 0x68, 0xcf, 0x8b, 0x70, 0xaf, 0x1f, 0x9d, 0x0f, 0x12, 0x7d, 0x45, 0xf0, 0xe7, 0x18, 0xfa,
+	0xf1, 0x95, 0xae, 0xd8, 0x92, 0x5d, 0x75, 0x60, 0x3e, 0x15, 0xc3, 0x1a, 0xd5, 0xcd, 0x2a, 0x2f,
+	0x23, 0xe4, 0x1a, 0xa9, 0x5c, 0x23, 0x93, 0x6b, 0x7c, 0x46, 0xbd, 0xc0, 0x7a, 0x2f, 0x2d, 0xf3,
+	0xf3, 0x2b, 0x7d, 0x87, 0x78, 0xd1, 0xb3, 0xb8, 0x63, 0xb8, 0xb4, 0x67, 0x66, 0xde, 0x88, 0xcb,
+	0x43, 0xd6, 0x3d, 0x31, 0xa3, 0xf3, 0x3e, 0x66, 0x3c, 0x81, 0xd9, 0x82, 0x19, 0x6d, 0xc3, 0xed,
+	0x92, 0x0b, 0x36, 0x66, 0x7d, 0x1a, 0x30, 0xac, 0xd6, 0xa1, 0x72, 0xb0, 0xcf, 0xad, 0xb8, 0x61,
+	0x57, 0x0e, 0xf6, 0xd1, 0xc7, 0xb0, 0xd6, 0x66, 0xc4, 0xc2, 0xc4, 0x0b, 0x1e, 0x05, 0xa9, 0x8f,
+	0x5e, 0x40, 0x3e, 0xf5, 0xfd, 0x59, 0x5d, 0x43, 0xc7, 0x70, 0x77, 0x5c, 0xbe, 0xac, 0xf7, 0x3e,
+	0x2c, 0xc4, 0xfc, 0x39, 0x6b, 0x28, 0x5c, 0xad, 0x66, 0x94, 0x47, 0xc4, 0xf8, 0x12, 0x87, 0x1e,
+	0xed, 0xa6, 0xad, 0xda, 0x79, 0x28, 0xfa, 0x55, 0x81, 0xd5, 0x11, 0xda, 0x99, 0xdf, 0xa4, 0xd0,
+	0x58, 0xc9, 0x35, 0xfe, 0x1f, 0x7e, 0x3b, 0xb0, 0x31, 0xd2, 0xaf, 0xf4, 0xa0, 0x01, 0x0b, 0x2c,
+	0x76, 0x5d, 0xcc, 0x18, 0xef, 0x7c, 0xd1, 0xce, 0x6f, 0xd5, 0x77, 0x61, 0x35, 0xce, 0xc3, 0x9f,
+	0xa4, 0xd7, 0x27, 0x5e, 0x37, 0x6b, 0x7c, 0x45, 0x02, 0xa9, 0x35, 0x07, 0x5d, 0xf4, 0x9b, 0x02,
+	0x2b, 0x6d, 0x46, 0x3e, 0x3f, 0x8b, 0x70, 0xc0, 0xed, 0x8a, 0xfb, 0xff, 0xda, 0x91, 0xe2, 0xac,
+	0x57, 0xff, 0xcb, 0x59, 0x47, 0x7b, 0xb0, 0x7e, 0xa5, 0xe9, 0xe9, 0xb6, 0xa0, 0x5f, 0x14, 0xa8,
+	0xb7, 0x19, 0xf9, 0x82, 0x86, 0x2e, 0x16, 0x76, 0xbe, 0xce, 0xef, 0x7e, 0x17, 0xee, 0x94, 0x9b,
+	0x9d, 0x41, 0xe1, 0x4f, 0x0a, 0x2c, 0xb7, 0x19, 0xf9, 0xba, 0xef, 0x7b, 0xd1, 0xe1, 0x75, 0xf4,
+	0x3d, 0x86, 0x05, 0xa7, 0x47, 0xe3, 0x20, 0x12, 0x0a, 0x6b, 0xd6, 0x27, 0xa9, 0x8c, 0x3f, 0x13,
+	0x7d, 0x6b, 0x06, 0x19, 0x07, 0x41, 0x34, 0x48, 0xf4, 0xba, 0xa8, 0x93, 0xd1, 0x20, 0x3b, 0x27,
+	0x44, 0x5f, 0xc1, 0x5a, 0xb1, 0x47, 0x29, 0xeb, 0x43, 0x58, 0x0e, 0xf0, 0x69, 0x3e, 0xaf, 0xe2,
+	0xc3, 0xbe, 0x61, 0xad, 0x0f, 0x12, 0xfd, 0x96, 0xa0, 0x2a, 0xa2, 0xc8, 0x86, 0x00, 0x9f, 0x8a,
+	0x19, 0x66, 0xe8, 0x3b, 0x3e, 0xc3, 0xc7, 0xa1, 0x13, 0xb0, 0xa7, 0x38, 0xbc, 0x96, 0xf2, 0x16,
+	0xd4, 0xd2, 0x3a, 0x22, 0xb7, 0xca, 0x73, 0xd7, 0x06, 0x89, 0x7e, 0x73, 0xd8, 0x42, 0x96, 0xbf,
+	0x18, 0xe0, 0xd3, 0x23, 0xfe, 0x73, 0x03, 0xd6, 0xaf, 0x54, 0xcf, 0x35, 0x21, 0xc2, 0x8f, 0x8d,
+	0x36, 0x0e, 0x09, 0x4e, 0x9f, 0xcf, 0x7e, 0x6c, 0x18, 0xb0, 0x28, 0x8d, 0xa8, 0x70, 0x23, 0x6e,
+	0x0d, 0xbf, 0x8a, 0xa1, 0x09, 0x0b, 0x7e, 0xe6, 0xc0, 0x11, 0xdc, 0x2e, 0x15, 0x92, 0xae, 0x7e,
+	0x00, 0x4b, 0x05, 0xdf, 0xc4, 0x8a, 0xb6, 0xee, 0x0c, 0x12, 0x5d, 0x1d, 0x31, 0x15, 0xd9, 0x35,
+	0xe9, 0xe9, 0xee, 0xef, 0xf3, 0x50, 0x6d, 0x33, 0xa2, 0xda, 0x00, 0x85, 0x53, 0xef, 0xad, 0xab,
+	0x6b, 0xb6, 0x74, 0x1c, 0x68, 0xf7, 0x27, 0xc2, 0xb2, 0x27, 0x02, 0xab, 0xa3, 0x47, 0xc3, 0xbd,
+	0x31, 0xb9, 0x23, 0x51, 0xda, 0x83, 0x59, 0xa2, 0x64, 0xa1, 0x6f, 0xa1, 0x5e, 0x06, 0xd5, 0xb7,
+	0xa7, 0xe6, 0x6b, 0xef, 0x4c, 0x0d, 0x91, 0xfc, 0xdf, 0xc0, 0x72, 0x69, 0x71, 0xea, 0x63, 0x52,
+	0x8b, 0x01, 0xda, 0xf6, 0x94, 0x00, 0xc9, 0xfc, 0x08, 0x96, 0x8a, 0x7b, 0xaa, 0x39, 0x26, 0xaf,
+	0x80, 0x6b, 0x5b, 0x93, 0x71, 0x49, 0x7b, 0x04, 0xb5, 0xe1, 0x72, 0xb8, 0x3b, 0x26, 0x49, 0xa2,
+	0xda, 0xbd, 0x49, 0x68, 0xd1, 0x81, 0xd2, 0x67, 0x37, 0xce, 0x81, 0x62, 0x80, 0xb6, 0x3d, 0x25,
+	0x40, 0x32, 0xdb, 0x00, 0x85, 0xef, 0x66, 0xdc, 0xe0, 0x0d, 0x61, 0xed, 0xfe, 0x44, 0x38, 0xe7,
+	0xb4, 0x0e, 0x5f, 0x5c, 0x34, 0x95, 0x97, 0x17, 0x4d, 0xe5, 0xaf, 0x8b, 0xa6, 0xf2, 0xc3, 0x65,
+	0x73, 0xee, 0xe5, 0x65, 0x73, 0xee, 0x8f, 0xcb, 0xe6, 0xdc, 0xe3, 0xdd, 0xc2, 0x5e, 0xcb, 0xa8,
+	0x1e, 0xfa, 0x4e, 0x87, 0xe5, 0x37, 0xe6, 0xf3, 0xd6, 0x9e, 0x79, 0x26, 0xff, 0x98, 0xa6, 0x7b,
+	0xae, 0xf3, 0x06, 0x3f, 0xd4, 0xf6, 0xfe, 0x19, 0x00, 0xa7, 0x5c, 0x72, 0x94, 0xb7, 0x0a, 0x00,
+	0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -626,6 +955,12 @@ type MsgClient interface {
 	// MsgEditLockup edits the existing lockups by lock ID
 	ExtendLockup(ctx context.Context, in *MsgExtendLockup, opts ...grpc.CallOption) (*MsgExtendLockupResponse, error)
 	ForceUnlock(ctx context.Context, in *MsgForceUnlock, opts ...grpc.CallOption) (*MsgForceUnlockResponse, error)
+	// SplitLock splits the given amounts off a lock into new locks
+	SplitLock(ctx context.Context, in *MsgSplitLock, opts ...grpc.CallOption) (*MsgSplitLockResponse, error)
+	// TransferLock transfers a lock to another owner
+	TransferLock(ctx context.Context, in *MsgTransferLock, opts ...grpc.CallOption) (*MsgTransferLockResponse, error)
+	// MergeLocks merges locks of the same denom and duration into a new lock
+	MergeLocks(ctx context.Context, in *MsgMergeLocks, opts ...grpc.CallOption) (*MsgMergeLocksResponse, error)
 }
 
 type msgClient struct {
@@ -681,6 +1016,33 @@ func (c *msgClient) ForceUnlock(ctx context.Context, in *MsgForceUnlock, opts ..
 	return out, nil
 }
 
+func (c *msgClient) SplitLock(ctx context.Context, in *MsgSplitLock, opts ...grpc.CallOption) (*MsgSplitLockResponse, error) {
+	out := new(MsgSplitLockResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.lockup.Msg/SplitLock", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) TransferLock(ctx context.Context, in *MsgTransferLock, opts ...grpc.CallOption) (*MsgTransferLockResponse, error) {
+	out := new(MsgTransferLockResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.lockup.Msg/TransferLock", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) MergeLocks(ctx context.Context, in *MsgMergeLocks, opts ...grpc.CallOption) (*MsgMergeLocksResponse, error) {
+	out := new(MsgMergeLocksResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.lockup.Msg/MergeLocks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// LockTokens lock tokens
@@ -692,6 +1054,12 @@ type MsgServer interface {
 	// MsgEditLockup edits the existing lockups by lock ID
 	ExtendLockup(context.Context, *MsgExtendLockup) (*MsgExtendLockupResponse, error)
 	ForceUnlock(context.Context, *MsgForceUnlock) (*MsgForceUnlockResponse, error)
+	// SplitLock splits the given amounts off a lock into new locks
+	SplitLock(context.Context, *MsgSplitLock) (*MsgSplitLockResponse, error)
+	// TransferLock transfers a lock to another owner
+	TransferLock(context.Context, *MsgTransferLock) (*MsgTransferLockResponse, error)
+	// MergeLocks merges locks of the same denom and duration into a new lock
+	MergeLocks(context.Context, *MsgMergeLocks) (*MsgMergeLocksResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -713,6 +1081,15 @@ func (*UnimplementedMsgServer) ExtendLockup(ctx context.Context, req *MsgExtendL
 func (*UnimplementedMsgServer) ForceUnlock(ctx context.Context, req *MsgForceUnlock) (*MsgForceUnlockResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ForceUnlock not implemented")
 }
+func (*UnimplementedMsgServer) SplitLock(ctx context.Context, req *MsgSplitLock) (*MsgSplitLockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SplitLock not implemented")
+}
+func (*UnimplementedMsgServer) TransferLock(ctx context.Context, req *MsgTransferLock) (*MsgTransferLockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferLock not implemented")
+}
+func (*UnimplementedMsgServer) MergeLocks(ctx context.Context, req *MsgMergeLocks) (*MsgMergeLocksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MergeLocks not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -808,6 +1185,60 @@ func _Msg_ForceUnlock_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SplitLock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSplitLock)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SplitLock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.lockup.Msg/SplitLock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SplitLock(ctx, req.(*MsgSplitLock))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_TransferLock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgTransferLock)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).TransferLock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.lockup.Msg/TransferLock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).TransferLock(ctx, req.(*MsgTransferLock))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_MergeLocks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgMergeLocks)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).MergeLocks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.lockup.Msg/MergeLocks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).MergeLocks(ctx, req.(*MsgMergeLocks))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "osmosis.lockup.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -832,6 +1263,18 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ForceUnlock",
 			Handler:    _Msg_ForceUnlock_Handler,
 		},
+		{
+			MethodName: "SplitLock",
+			Handler:    _Msg_SplitLock_Handler,
+		},
+		{
+			MethodName: "TransferLock",
+			Handler:    _Msg_TransferLock_Handler,
+		},
+		{
+			MethodName: "MergeLocks",
+			Handler:    _Msg_MergeLocks_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "osmosis/lockup/tx.proto",
@@ -1053,6 +1496,11 @@ func (m *MsgBeginUnlockingResponse) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	_ = i
 	var l int
 	_ = l
+	if m.UnlockingLockId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.UnlockingLockId))
+		i--
+		dAtA[i] = 0x10
+	}
 	if m.Success {
 		i--
 		if m.Success {
@@ -1224,12 +1672,243 @@ func (m *MsgForceUnlockResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
+func (m *MsgSplitLock) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSplitLock) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSplitLock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amounts) > 0 {
+		for iNdEx := len(m.Amounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.Amounts[iNdEx].Size()
+				i -= size
+				if _, err := m.Amounts[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.ID != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSplitLockResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSplitLockResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSplitLockResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NewLockIds) > 0 {
+		dAtA4 := make([]byte, len(m.NewLockIds)*10)
+		var j3 int
+		for _, num := range m.NewLockIds {
+			for num >= 1<<7 {
+				dAtA4[j3] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j3++
+			}
+			dAtA4[j3] = uint8(num)
+			j3++
+		}
+		i -= j3
+		copy(dAtA[i:], dAtA4[:j3])
+		i = encodeVarintTx(dAtA, i, uint64(j3))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTransferLock) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTransferLock) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTransferLock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NewOwner) > 0 {
+		i -= len(m.NewOwner)
+		copy(dAtA[i:], m.NewOwner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.NewOwner)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ID != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTransferLockResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTransferLockResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTransferLockResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgMergeLocks) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgMergeLocks) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMergeLocks) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.LockIds) > 0 {
+		dAtA6 := make([]byte, len(m.LockIds)*10)
+		var j5 int
+		for _, num := range m.LockIds {
+			for num >= 1<<7 {
+				dAtA6[j5] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j5++
+			}
+			dAtA6[j5] = uint8(num)
+			j5++
+		}
+		i -= j5
+		copy(dAtA[i:], dAtA6[:j5])
+		i = encodeVarintTx(dAtA, i, uint64(j5))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgMergeLocksResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgMergeLocksResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMergeLocksResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NewLockId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.NewLockId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
 		offset++
 	}
 	dAtA[offset] = uint8(v)
@@ -1327,6 +2006,9 @@ func (m *MsgBeginUnlockingResponse) Size() (n int) {
 	if m.Success {
 		n += 2
 	}
+	if m.UnlockingLockId != 0 {
+		n += 1 + sovTx(uint64(m.UnlockingLockId))
+	}
 	return n
 }
 
@@ -1394,6 +2076,105 @@ func (m *MsgForceUnlockResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgSplitLock) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ID != 0 {
+		n += 1 + sovTx(uint64(m.ID))
+	}
+	if len(m.Amounts) > 0 {
+		for _, e := range m.Amounts {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgSplitLockResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.NewLockIds) > 0 {
+		l = 0
+		for _, e := range m.NewLockIds {
+			l += sovTx(uint64(e))
+		}
+		n += 1 + sovTx(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *MsgTransferLock) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ID != 0 {
+		n += 1 + sovTx(uint64(m.ID))
+	}
+	l = len(m.NewOwner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgTransferLockResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgMergeLocks) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.LockIds) > 0 {
+		l = 0
+		for _, e := range m.LockIds {
+			l += sovTx(uint64(e))
+		}
+		n += 1 + sovTx(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *MsgMergeLocksResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.NewLockId != 0 {
+		n += 1 + sovTx(uint64(m.NewLockId))
+	}
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1475,28 +2256,622 @@ func (m *MsgLockTokens) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Duration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Coins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Coins = append(m.Coins, types1.Coin{})
+			if err := m.Coins[len(m.Coins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgLockTokensResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgLockTokensResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgLockTokensResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			m.ID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgBeginUnlockingAll) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgBeginUnlockingAll: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgBeginUnlockingAll: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgBeginUnlockingAllResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgBeginUnlockingAllResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgBeginUnlockingAllResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Unlocks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Unlocks = append(m.Unlocks, &PeriodLock{})
+			if err := m.Unlocks[len(m.Unlocks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgBeginUnlocking) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgBeginUnlocking: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgBeginUnlocking: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			m.ID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Coins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Coins = append(m.Coins, types1.Coin{})
+			if err := m.Coins[len(m.Coins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgBeginUnlockingResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgBeginUnlockingResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgBeginUnlockingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Success", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Success = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnlockingLockId", wireType)
+			}
+			m.UnlockingLockId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnlockingLockId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgExtendLockup) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgExtendLockup: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgExtendLockup: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			m.ID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ID |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Duration, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Coins", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1523,8 +2898,7 @@ func (m *MsgLockTokens) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Coins = append(m.Coins, types1.Coin{})
-			if err := m.Coins[len(m.Coins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Duration, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1549,7 +2923,7 @@ func (m *MsgLockTokens) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgLockTokensResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgExtendLockupResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1572,17 +2946,17 @@ func (m *MsgLockTokensResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgLockTokensResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgExtendLockupResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgLockTokensResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgExtendLockupResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Success", wireType)
 			}
-			m.ID = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1592,11 +2966,12 @@ func (m *MsgLockTokensResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ID |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.Success = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1618,7 +2993,7 @@ func (m *MsgLockTokensResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgBeginUnlockingAll) Unmarshal(dAtA []byte) error {
+func (m *MsgForceUnlock) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1641,10 +3016,10 @@ func (m *MsgBeginUnlockingAll) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgBeginUnlockingAll: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgForceUnlock: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgBeginUnlockingAll: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgForceUnlock: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1679,6 +3054,59 @@ func (m *MsgBeginUnlockingAll) Unmarshal(dAtA []byte) error {
 			}
 			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			m.ID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Coins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Coins = append(m.Coins, types1.Coin{})
+			if err := m.Coins[len(m.Coins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1700,7 +3128,7 @@ func (m *MsgBeginUnlockingAll) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgBeginUnlockingAllResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgForceUnlockResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1723,17 +3151,17 @@ func (m *MsgBeginUnlockingAllResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgBeginUnlockingAllResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgForceUnlockResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgBeginUnlockingAllResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgForceUnlockResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Unlocks", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Success", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1743,26 +3171,12 @@ func (m *MsgBeginUnlockingAllResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Unlocks = append(m.Unlocks, &PeriodLock{})
-			if err := m.Unlocks[len(m.Unlocks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.Success = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1784,7 +3198,7 @@ func (m *MsgBeginUnlockingAllResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgBeginUnlocking) Unmarshal(dAtA []byte) error {
+func (m *MsgSplitLock) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1807,10 +3221,10 @@ func (m *MsgBeginUnlocking) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgBeginUnlocking: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSplitLock: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgBeginUnlocking: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSplitLock: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1866,9 +3280,9 @@ func (m *MsgBeginUnlocking) Unmarshal(dAtA []byte) error {
 			}
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Coins", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amounts", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1878,23 +3292,25 @@ func (m *MsgBeginUnlocking) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Coins = append(m.Coins, types1.Coin{})
-			if err := m.Coins[len(m.Coins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			var v github_com_cosmos_cosmos_sdk_types.Int
+			m.Amounts = append(m.Amounts, v)
+			if err := m.Amounts[len(m.Amounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1919,7 +3335,7 @@ func (m *MsgBeginUnlocking) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgBeginUnlockingResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSplitLockResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1942,32 +3358,88 @@ func (m *MsgBeginUnlockingResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgBeginUnlockingResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSplitLockResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgBeginUnlockingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSplitLockResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Success", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.NewLockIds = append(m.NewLockIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
 					return io.ErrUnexpectedEOF
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.NewLockIds) == 0 {
+					m.NewLockIds = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.NewLockIds = append(m.NewLockIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewLockIds", wireType)
 			}
-			m.Success = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1989,7 +3461,7 @@ func (m *MsgBeginUnlockingResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgExtendLockup) Unmarshal(dAtA []byte) error {
+func (m *MsgTransferLock) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2012,10 +3484,10 @@ func (m *MsgExtendLockup) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgExtendLockup: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgTransferLock: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgExtendLockup: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgTransferLock: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2071,9 +3543,9 @@ func (m *MsgExtendLockup) Unmarshal(dAtA []byte) error {
 			}
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewOwner", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2083,24 +3555,23 @@ func (m *MsgExtendLockup) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Duration, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.NewOwner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2123,7 +3594,7 @@ func (m *MsgExtendLockup) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgExtendLockupResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgTransferLockResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2146,32 +3617,12 @@ func (m *MsgExtendLockupResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgExtendLockupResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgTransferLockResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgExtendLockupResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgTransferLockResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Success", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Success = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2193,7 +3644,7 @@ func (m *MsgExtendLockupResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgForceUnlock) Unmarshal(dAtA []byte) error {
+func (m *MsgMergeLocks) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2216,10 +3667,10 @@ func (m *MsgForceUnlock) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgForceUnlock: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgMergeLocks: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgForceUnlock: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgMergeLocks: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2255,58 +3706,81 @@ func (m *MsgForceUnlock) Unmarshal(dAtA []byte) error {
 			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
-			}
-			m.ID = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ID |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Coins", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.LockIds = append(m.LockIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
 					return io.ErrUnexpectedEOF
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Coins = append(m.Coins, types1.Coin{})
-			if err := m.Coins[len(m.Coins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.LockIds) == 0 {
+					m.LockIds = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.LockIds = append(m.LockIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockIds", wireType)
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2328,7 +3802,7 @@ func (m *MsgForceUnlock) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgForceUnlockResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgMergeLocksResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2351,17 +3825,17 @@ func (m *MsgForceUnlockResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgForceUnlockResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgMergeLocksResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgForceUnlockResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgMergeLocksResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Success", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewLockId", wireType)
 			}
-			var v int
+			m.NewLockId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2371,12 +3845,11 @@ func (m *MsgForceUnlockResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.NewLockId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Success = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -34,6 +34,15 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 			panic(err)
 		}
 	}
+
+	// Init the developer account if it is set
+	if genState.DeveloperAccount != "" {
+		developerAccount, err := sdk.AccAddressFromBech32(genState.DeveloperAccount)
+		if err != nil {
+			panic(err)
+		}
+		k.SetDeveloperAccount(ctx, developerAccount)
+	}
 }
 
 // ExportGenesis returns the module's exported genesis
@@ -41,5 +50,9 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	genesis := types.DefaultGenesis()
 	genesis.Params = k.GetParams(ctx)
 
+	if developerAccount, err := k.GetDeveloperAccount(ctx); err == nil {
+		genesis.DeveloperAccount = developerAccount.String()
+	}
+
 	return genesis
 }
@@ -0,0 +1,23 @@
+package protorev
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/osmosis-labs/osmosis/v13/x/protorev/keeper"
+	"github.com/osmosis-labs/osmosis/v13/x/protorev/types"
+)
+
+func NewProtoRevProposalHandler(k keeper.Keeper) govtypes.Handler {
+	return func(ctx sdk.Context, content govtypes.Content) error {
+		switch c := content.(type) {
+		case *types.SetProtoRevEnabledProposal:
+			return k.HandleSetProtoRevEnabledProposal(ctx, c)
+		case *types.SetProtoRevAdminAccountProposal:
+			return k.HandleSetProtoRevAdminAccountProposal(ctx, c)
+		default:
+			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized protorev proposal content type: %T", c)
+		}
+	}
+}
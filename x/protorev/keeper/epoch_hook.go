@@ -66,7 +66,7 @@ func (k Keeper) UpdatePools(ctx sdk.Context) error {
 // and Osmo/Atom
 func (k Keeper) GetHighestLiquidityPools(ctx sdk.Context) (map[string]LiquidityPoolStruct, map[string]LiquidityPoolStruct, error) {
 	// Get all pools
-	pools, err := k.gammKeeper.GetPoolsAndPoke(ctx)
+	pools, err := k.swaprouterKeeper.AllPools(ctx)
 	if err != nil {
 		return nil, nil, err
 	}
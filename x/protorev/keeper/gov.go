@@ -0,0 +1,22 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v13/x/protorev/types"
+)
+
+// HandleSetProtoRevEnabledProposal enables or disables the module
+func (k Keeper) HandleSetProtoRevEnabledProposal(ctx sdk.Context, p *types.SetProtoRevEnabledProposal) error {
+	params := k.GetParams(ctx)
+	params.Enabled = p.Enabled
+	k.SetParams(ctx, params)
+	return nil
+}
+
+// HandleSetProtoRevAdminAccountProposal sets the admin account of the module
+func (k Keeper) HandleSetProtoRevAdminAccountProposal(ctx sdk.Context, p *types.SetProtoRevAdminAccountProposal) error {
+	params := k.GetParams(ctx)
+	params.Admin = p.Account
+	return k.validateAndSetParams(ctx, params)
+}
@@ -0,0 +1,29 @@
+package keeper_test
+
+import (
+	"github.com/osmosis-labs/osmosis/v13/x/protorev/types"
+)
+
+func (suite *KeeperTestSuite) TestHandleSetProtoRevEnabledProposal() {
+	proposal := types.SetProtoRevEnabledProposal{Title: "title", Description: "description", Enabled: false}
+	err := suite.App.ProtoRevKeeper.HandleSetProtoRevEnabledProposal(suite.Ctx, &proposal)
+	suite.Require().NoError(err)
+	suite.Require().False(suite.App.ProtoRevKeeper.GetParams(suite.Ctx).Enabled)
+
+	proposal.Enabled = true
+	err = suite.App.ProtoRevKeeper.HandleSetProtoRevEnabledProposal(suite.Ctx, &proposal)
+	suite.Require().NoError(err)
+	suite.Require().True(suite.App.ProtoRevKeeper.GetParams(suite.Ctx).Enabled)
+}
+
+func (suite *KeeperTestSuite) TestHandleSetProtoRevAdminAccountProposal() {
+	proposal := types.SetProtoRevAdminAccountProposal{Title: "title", Description: "description", Account: "admin"}
+	err := suite.App.ProtoRevKeeper.HandleSetProtoRevAdminAccountProposal(suite.Ctx, &proposal)
+	suite.Require().Error(err)
+	suite.Require().Equal(types.DefaultAdmin, suite.App.ProtoRevKeeper.GetParams(suite.Ctx).Admin)
+
+	proposal.Account = suite.TestAccs[0].String()
+	err = suite.App.ProtoRevKeeper.HandleSetProtoRevAdminAccountProposal(suite.Ctx, &proposal)
+	suite.Require().NoError(err)
+	suite.Require().Equal(suite.TestAccs[0].String(), suite.App.ProtoRevKeeper.GetParams(suite.Ctx).Admin)
+}
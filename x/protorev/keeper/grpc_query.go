@@ -0,0 +1,164 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/osmosis-labs/osmosis/v13/x/protorev/types"
+)
+
+var _ types.QueryServer = Querier{}
+
+// Querier defines a wrapper around the x/protorev keeper providing gRPC method
+// handlers.
+type Querier struct {
+	Keeper
+}
+
+func NewQuerier(k Keeper) Querier {
+	return Querier{Keeper: k}
+}
+
+// Params queries the parameters of the module.
+func (q Querier) Params(c context.Context, req *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	params := q.Keeper.GetParams(ctx)
+
+	return &types.QueryParamsResponse{Params: params}, nil
+}
+
+// GetProtoRevNumberOfTrades queries the number of trades the module has executed
+func (q Querier) GetProtoRevNumberOfTrades(c context.Context, req *types.QueryGetProtoRevNumberOfTradesRequest) (*types.QueryGetProtoRevNumberOfTradesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	numberOfTrades, err := q.Keeper.GetNumberOfTrades(ctx)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	return &types.QueryGetProtoRevNumberOfTradesResponse{NumberOfTrades: numberOfTrades}, nil
+}
+
+// GetProtoRevProfitsByDenom queries the profits of the module by denom
+func (q Querier) GetProtoRevProfitsByDenom(c context.Context, req *types.QueryGetProtoRevProfitsByDenomRequest) (*types.QueryGetProtoRevProfitsByDenomResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	profits, err := q.Keeper.GetProfitsByDenom(ctx, req.Denom)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	return &types.QueryGetProtoRevProfitsByDenomResponse{Profit: &profits}, nil
+}
+
+// GetProtoRevAllProfits queries all of the profits from the module
+func (q Querier) GetProtoRevAllProfits(c context.Context, req *types.QueryGetProtoRevAllProfitsRequest) (*types.QueryGetProtoRevAllProfitsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	profits, err := q.Keeper.GetAllProfits(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	response := make([]*sdk.Coin, len(profits))
+	for index := range profits {
+		response[index] = &profits[index]
+	}
+
+	return &types.QueryGetProtoRevAllProfitsResponse{Profits: response}, nil
+}
+
+// GetProtoRevStatisticsByPool queries the number of trades and profits the module has made after swaps on a given pool
+func (q Querier) GetProtoRevStatisticsByPool(c context.Context, req *types.QueryGetProtoRevStatisticsByPoolRequest) (*types.QueryGetProtoRevStatisticsByPoolResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	statistics, err := q.Keeper.GetPoolStatistics(ctx, req.PoolId)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	return &types.QueryGetProtoRevStatisticsByPoolResponse{Statistics: statistics}, nil
+}
+
+// GetProtoRevAllStatistics queries the number of trades and profits the module has made after swaps on every pool
+func (q Querier) GetProtoRevAllStatistics(c context.Context, req *types.QueryGetProtoRevAllStatisticsRequest) (*types.QueryGetProtoRevAllStatisticsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	statistics, err := q.Keeper.GetAllPoolStatistics(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryGetProtoRevAllStatisticsResponse{Statistics: statistics}, nil
+}
+
+// GetProtoRevTokenPairArbRoutes queries all of the hot routes the module is currently arbitraging
+func (q Querier) GetProtoRevTokenPairArbRoutes(c context.Context, req *types.QueryGetProtoRevTokenPairArbRoutesRequest) (*types.QueryGetProtoRevTokenPairArbRoutesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	routes, err := q.Keeper.GetAllTokenPairArbRoutes(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryGetProtoRevTokenPairArbRoutesResponse{Routes: routes}, nil
+}
+
+// GetProtoRevDeveloperAccount queries the developer account of the module
+func (q Querier) GetProtoRevDeveloperAccount(c context.Context, req *types.QueryGetProtoRevDeveloperAccountRequest) (*types.QueryGetProtoRevDeveloperAccountResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	developerAccount, err := q.Keeper.GetDeveloperAccount(ctx)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	return &types.QueryGetProtoRevDeveloperAccountResponse{DeveloperAccount: developerAccount.String()}, nil
+}
+
+// GetProtoRevBaseDenomPools queries the osmo and atom pools the module uses to build routes
+func (q Querier) GetProtoRevBaseDenomPools(c context.Context, req *types.QueryGetProtoRevBaseDenomPoolsRequest) (*types.QueryGetProtoRevBaseDenomPoolsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryGetProtoRevBaseDenomPoolsResponse{
+		OsmoPools: q.Keeper.GetAllOsmoPools(ctx),
+		AtomPools: q.Keeper.GetAllAtomPools(ctx),
+	}, nil
+}
+
+// GetProtoRevRoutesSimulated queries the routes simulated and pool points consumed in the latest block the module was active in
+func (q Querier) GetProtoRevRoutesSimulated(c context.Context, req *types.QueryGetProtoRevRoutesSimulatedRequest) (*types.QueryGetProtoRevRoutesSimulatedResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	usage, err := q.Keeper.GetBlockRouteUsage(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryGetProtoRevRoutesSimulatedResponse{Usage: usage}, nil
+}
@@ -0,0 +1,92 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v13/x/protorev/types"
+)
+
+func (suite *KeeperTestSuite) TestParams() {
+	res, err := suite.queryClient.Params(sdk.WrapSDKContext(suite.Ctx), &types.QueryParamsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.DefaultParams(), res.Params)
+}
+
+func (suite *KeeperTestSuite) TestGetProtoRevStatistics() {
+	ctx := sdk.WrapSDKContext(suite.Ctx)
+
+	// Nothing is found before any trade is executed
+	_, err := suite.queryClient.GetProtoRevNumberOfTrades(ctx, &types.QueryGetProtoRevNumberOfTradesRequest{})
+	suite.Require().Error(err)
+	_, err = suite.queryClient.GetProtoRevProfitsByDenom(ctx, &types.QueryGetProtoRevProfitsByDenomRequest{Denom: types.OsmosisDenomination})
+	suite.Require().Error(err)
+	_, err = suite.queryClient.GetProtoRevStatisticsByPool(ctx, &types.QueryGetProtoRevStatisticsByPoolRequest{PoolId: 1})
+	suite.Require().Error(err)
+
+	profit := sdk.NewCoin(types.OsmosisDenomination, sdk.NewInt(100))
+	suite.Require().NoError(suite.App.ProtoRevKeeper.UpdateStatistics(suite.Ctx, 1, profit))
+
+	numberOfTrades, err := suite.queryClient.GetProtoRevNumberOfTrades(ctx, &types.QueryGetProtoRevNumberOfTradesRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.OneInt(), numberOfTrades.NumberOfTrades)
+
+	profitsByDenom, err := suite.queryClient.GetProtoRevProfitsByDenom(ctx, &types.QueryGetProtoRevProfitsByDenomRequest{Denom: types.OsmosisDenomination})
+	suite.Require().NoError(err)
+	suite.Require().Equal(&profit, profitsByDenom.Profit)
+
+	allProfits, err := suite.queryClient.GetProtoRevAllProfits(ctx, &types.QueryGetProtoRevAllProfitsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]*sdk.Coin{&profit}, allProfits.Profits)
+
+	statisticsByPool, err := suite.queryClient.GetProtoRevStatisticsByPool(ctx, &types.QueryGetProtoRevStatisticsByPoolRequest{PoolId: 1})
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.OneInt(), statisticsByPool.Statistics.NumberOfTrades)
+	suite.Require().Equal([]*sdk.Coin{&profit}, statisticsByPool.Statistics.Profits)
+
+	allStatistics, err := suite.queryClient.GetProtoRevAllStatistics(ctx, &types.QueryGetProtoRevAllStatisticsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(1, len(allStatistics.Statistics))
+	suite.Require().Equal(uint64(1), allStatistics.Statistics[0].PoolId)
+}
+
+func (suite *KeeperTestSuite) TestGetProtoRevTokenPairArbRoutes() {
+	res, err := suite.queryClient.GetProtoRevTokenPairArbRoutes(sdk.WrapSDKContext(suite.Ctx), &types.QueryGetProtoRevTokenPairArbRoutesRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(suite.tokenPairArbRoutes, res.Routes)
+}
+
+func (suite *KeeperTestSuite) TestGetProtoRevDeveloperAccount() {
+	_, err := suite.queryClient.GetProtoRevDeveloperAccount(sdk.WrapSDKContext(suite.Ctx), &types.QueryGetProtoRevDeveloperAccountRequest{})
+	suite.Require().Error(err)
+
+	suite.App.ProtoRevKeeper.SetDeveloperAccount(suite.Ctx, suite.TestAccs[0])
+	res, err := suite.queryClient.GetProtoRevDeveloperAccount(sdk.WrapSDKContext(suite.Ctx), &types.QueryGetProtoRevDeveloperAccountRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(suite.TestAccs[0].String(), res.DeveloperAccount)
+}
+
+func (suite *KeeperTestSuite) TestGetProtoRevBaseDenomPools() {
+	res, err := suite.queryClient.GetProtoRevBaseDenomPools(sdk.WrapSDKContext(suite.Ctx), &types.QueryGetProtoRevBaseDenomPoolsRequest{})
+	suite.Require().NoError(err)
+
+	suite.Require().Contains(res.OsmoPools, types.BaseDenomPool{Denom: denom0EF1, PoolId: 24})
+	suite.Require().Contains(res.AtomPools, types.BaseDenomPool{Denom: "akash", PoolId: 1})
+	for _, pool := range res.OsmoPools {
+		poolId, err := suite.App.ProtoRevKeeper.GetOsmoPool(suite.Ctx, pool.Denom)
+		suite.Require().NoError(err)
+		suite.Require().Equal(poolId, pool.PoolId)
+	}
+	for _, pool := range res.AtomPools {
+		poolId, err := suite.App.ProtoRevKeeper.GetAtomPool(suite.Ctx, pool.Denom)
+		suite.Require().NoError(err)
+		suite.Require().Equal(poolId, pool.PoolId)
+	}
+}
+
+func (suite *KeeperTestSuite) TestGetProtoRevRoutesSimulated() {
+	suite.Require().NoError(suite.App.ProtoRevKeeper.ConsumePoolPoints(suite.Ctx, 2, 12))
+
+	res, err := suite.queryClient.GetProtoRevRoutesSimulated(sdk.WrapSDKContext(suite.Ctx), &types.QueryGetProtoRevRoutesSimulatedRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.BlockRouteUsage{BlockHeight: uint64(suite.Ctx.BlockHeight()), NumberOfRoutes: 2, PoolPoints: 12}, res.Usage)
+}
@@ -19,10 +19,10 @@ type (
 		storeKey   storetypes.StoreKey
 		paramstore paramtypes.Subspace
 
-		accountKeeper types.AccountKeeper
-		bankKeeper    types.BankKeeper
-		gammKeeper    types.GAMMKeeper
-		epochKeeper   types.EpochKeeper
+		accountKeeper    types.AccountKeeper
+		bankKeeper       types.BankKeeper
+		swaprouterKeeper types.SwapRouterKeeper
+		epochKeeper      types.EpochKeeper
 	}
 )
 
@@ -32,7 +32,7 @@ func NewKeeper(
 	ps paramtypes.Subspace,
 	accountKeeper types.AccountKeeper,
 	bankKeeper types.BankKeeper,
-	gammKeeper types.GAMMKeeper,
+	swaprouterKeeper types.SwapRouterKeeper,
 	epochKeeper types.EpochKeeper,
 ) Keeper {
 	// set KeyTable if it has not already been set
@@ -41,13 +41,13 @@ func NewKeeper(
 	}
 
 	return Keeper{
-		cdc:           cdc,
-		storeKey:      storeKey,
-		paramstore:    ps,
-		accountKeeper: accountKeeper,
-		bankKeeper:    bankKeeper,
-		gammKeeper:    gammKeeper,
-		epochKeeper:   epochKeeper,
+		cdc:              cdc,
+		storeKey:         storeKey,
+		paramstore:       ps,
+		accountKeeper:    accountKeeper,
+		bankKeeper:       bankKeeper,
+		swaprouterKeeper: swaprouterKeeper,
+		epochKeeper:      epochKeeper,
 	}
 }
 
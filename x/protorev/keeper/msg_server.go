@@ -0,0 +1,130 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/osmosis-labs/osmosis/v13/x/protorev/types"
+)
+
+type msgServer struct {
+	Keeper
+}
+
+// NewMsgServerImpl returns an implementation of the MsgServer interface
+// for the provided Keeper.
+func NewMsgServerImpl(keeper Keeper) types.MsgServer {
+	return &msgServer{Keeper: keeper}
+}
+
+var _ types.MsgServer = msgServer{}
+
+// SetHotRoutes replaces all of the hot routes with the given hot routes
+func (server msgServer) SetHotRoutes(goCtx context.Context, msg *types.MsgSetHotRoutes) (*types.MsgSetHotRoutesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := server.AdminCheck(ctx, msg.Admin); err != nil {
+		return nil, err
+	}
+
+	server.DeleteAllTokenPairArbRoutes(ctx)
+
+	for _, tokenPairArbRoutes := range msg.HotRoutes {
+		if _, err := server.SetTokenPairArbRoutes(ctx, tokenPairArbRoutes.TokenIn, tokenPairArbRoutes.TokenOut, tokenPairArbRoutes); err != nil {
+			return nil, err
+		}
+	}
+
+	return &types.MsgSetHotRoutesResponse{}, nil
+}
+
+// SetDeveloperAccount sets the account that receives a portion of the profits of the module
+func (server msgServer) SetDeveloperAccount(goCtx context.Context, msg *types.MsgSetDeveloperAccount) (*types.MsgSetDeveloperAccountResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := server.AdminCheck(ctx, msg.Admin); err != nil {
+		return nil, err
+	}
+
+	developerAccount, err := sdk.AccAddressFromBech32(msg.DeveloperAccount)
+	if err != nil {
+		return nil, err
+	}
+
+	server.Keeper.SetDeveloperAccount(ctx, developerAccount)
+
+	return &types.MsgSetDeveloperAccountResponse{}, nil
+}
+
+// SetMaxPoolPointsPerTx sets the maximum number of pool points that can be consumed per transaction
+func (server msgServer) SetMaxPoolPointsPerTx(goCtx context.Context, msg *types.MsgSetMaxPoolPointsPerTx) (*types.MsgSetMaxPoolPointsPerTxResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := server.AdminCheck(ctx, msg.Admin); err != nil {
+		return nil, err
+	}
+
+	params := server.GetParams(ctx)
+	params.MaxPoolPointsPerTx = msg.MaxPoolPointsPerTx
+	if err := server.validateAndSetParams(ctx, params); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetMaxPoolPointsPerTxResponse{}, nil
+}
+
+// SetMaxPoolPointsPerBlock sets the maximum number of pool points that can be consumed per block
+func (server msgServer) SetMaxPoolPointsPerBlock(goCtx context.Context, msg *types.MsgSetMaxPoolPointsPerBlock) (*types.MsgSetMaxPoolPointsPerBlockResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := server.AdminCheck(ctx, msg.Admin); err != nil {
+		return nil, err
+	}
+
+	params := server.GetParams(ctx)
+	params.MaxPoolPointsPerBlock = msg.MaxPoolPointsPerBlock
+	if err := server.validateAndSetParams(ctx, params); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetMaxPoolPointsPerBlockResponse{}, nil
+}
+
+// SetPoolWeights sets the number of pool points each pool type costs to simulate
+func (server msgServer) SetPoolWeights(goCtx context.Context, msg *types.MsgSetPoolWeights) (*types.MsgSetPoolWeightsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := server.AdminCheck(ctx, msg.Admin); err != nil {
+		return nil, err
+	}
+
+	params := server.GetParams(ctx)
+	params.PoolWeights = msg.PoolWeights
+	if err := server.validateAndSetParams(ctx, params); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetPoolWeightsResponse{}, nil
+}
+
+// AdminCheck ensures that the given account is the admin account set through governance
+func (k Keeper) AdminCheck(ctx sdk.Context, admin string) error {
+	expectedAdmin := k.GetParams(ctx).Admin
+	if expectedAdmin == "" || admin != expectedAdmin {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "sender %s is not the protorev admin", admin)
+	}
+
+	return nil
+}
+
+// validateAndSetParams sets the params if they are valid
+func (k Keeper) validateAndSetParams(ctx sdk.Context, params types.Params) error {
+	if err := params.Validate(); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	k.SetParams(ctx, params)
+	return nil
+}
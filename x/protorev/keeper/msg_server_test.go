@@ -0,0 +1,122 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v13/x/protorev/keeper"
+	"github.com/osmosis-labs/osmosis/v13/x/protorev/types"
+)
+
+// setAdmin sets the admin account of the module through governance
+func (suite *KeeperTestSuite) setAdmin(admin sdk.AccAddress) {
+	proposal := types.SetProtoRevAdminAccountProposal{Title: "title", Description: "description", Account: admin.String()}
+	err := suite.App.ProtoRevKeeper.HandleSetProtoRevAdminAccountProposal(suite.Ctx, &proposal)
+	suite.Require().NoError(err)
+}
+
+func (suite *KeeperTestSuite) TestMsgSetHotRoutes() {
+	admin := suite.TestAccs[0]
+	hotRoutes := types.CreateSeacherRoutes(3, types.OsmosisDenomination, "ethereum", types.AtomDenomination, types.AtomDenomination)
+
+	cases := []struct {
+		description string
+		setAdmin    bool
+		sender      sdk.AccAddress
+		pass        bool
+	}{
+		{
+			description: "No admin set through governance",
+			sender:      admin,
+			pass:        false,
+		},
+		{
+			description: "Sender is not the admin",
+			setAdmin:    true,
+			sender:      suite.TestAccs[1],
+			pass:        false,
+		},
+		{
+			description: "Sender is the admin",
+			setAdmin:    true,
+			sender:      admin,
+			pass:        true,
+		},
+	}
+
+	for _, tc := range cases {
+		suite.Run(tc.description, func() {
+			suite.SetupTest()
+			if tc.setAdmin {
+				suite.setAdmin(admin)
+			}
+
+			server := keeper.NewMsgServerImpl(*suite.App.ProtoRevKeeper)
+			msg := types.NewMsgSetHotRoutes(tc.sender.String(), []*types.TokenPairArbRoutes{&hotRoutes})
+			_, err := server.SetHotRoutes(sdk.WrapSDKContext(suite.Ctx), msg)
+
+			routes, getErr := suite.App.ProtoRevKeeper.GetAllTokenPairArbRoutes(suite.Ctx)
+			suite.Require().NoError(getErr)
+			if tc.pass {
+				suite.Require().NoError(err)
+				// The previous hot routes are replaced
+				suite.Require().Equal([]*types.TokenPairArbRoutes{&hotRoutes}, routes)
+			} else {
+				suite.Require().Error(err)
+				suite.Require().Equal(suite.tokenPairArbRoutes, routes)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestMsgSetDeveloperAccount() {
+	admin := suite.TestAccs[0]
+	developer := suite.TestAccs[2]
+	server := keeper.NewMsgServerImpl(*suite.App.ProtoRevKeeper)
+
+	_, err := server.SetDeveloperAccount(sdk.WrapSDKContext(suite.Ctx), types.NewMsgSetDeveloperAccount(admin.String(), developer.String()))
+	suite.Require().Error(err)
+
+	suite.setAdmin(admin)
+	_, err = server.SetDeveloperAccount(sdk.WrapSDKContext(suite.Ctx), types.NewMsgSetDeveloperAccount(suite.TestAccs[1].String(), developer.String()))
+	suite.Require().Error(err)
+
+	_, err = server.SetDeveloperAccount(sdk.WrapSDKContext(suite.Ctx), types.NewMsgSetDeveloperAccount(admin.String(), developer.String()))
+	suite.Require().NoError(err)
+
+	developerAccount, err := suite.App.ProtoRevKeeper.GetDeveloperAccount(suite.Ctx)
+	suite.Require().NoError(err)
+	suite.Require().Equal(developer, developerAccount)
+}
+
+func (suite *KeeperTestSuite) TestMsgSetPoolPointBudgets() {
+	admin := suite.TestAccs[0]
+	suite.setAdmin(admin)
+	server := keeper.NewMsgServerImpl(*suite.App.ProtoRevKeeper)
+	ctx := sdk.WrapSDKContext(suite.Ctx)
+
+	// Only the admin can update the budgets
+	_, err := server.SetMaxPoolPointsPerTx(ctx, types.NewMsgSetMaxPoolPointsPerTx(suite.TestAccs[1].String(), 10))
+	suite.Require().Error(err)
+	_, err = server.SetMaxPoolPointsPerBlock(ctx, types.NewMsgSetMaxPoolPointsPerBlock(suite.TestAccs[1].String(), 50))
+	suite.Require().Error(err)
+	_, err = server.SetPoolWeights(ctx, types.NewMsgSetPoolWeights(suite.TestAccs[1].String(), types.PoolWeights{StableWeight: 3, BalancerWeight: 1}))
+	suite.Require().Error(err)
+
+	_, err = server.SetMaxPoolPointsPerTx(ctx, types.NewMsgSetMaxPoolPointsPerTx(admin.String(), 10))
+	suite.Require().NoError(err)
+	_, err = server.SetMaxPoolPointsPerBlock(ctx, types.NewMsgSetMaxPoolPointsPerBlock(admin.String(), 50))
+	suite.Require().NoError(err)
+	_, err = server.SetPoolWeights(ctx, types.NewMsgSetPoolWeights(admin.String(), types.PoolWeights{StableWeight: 3, BalancerWeight: 1}))
+	suite.Require().NoError(err)
+
+	params := suite.App.ProtoRevKeeper.GetParams(suite.Ctx)
+	suite.Require().Equal(uint64(10), params.MaxPoolPointsPerTx)
+	suite.Require().Equal(uint64(50), params.MaxPoolPointsPerBlock)
+	suite.Require().Equal(types.PoolWeights{StableWeight: 3, BalancerWeight: 1}, params.PoolWeights)
+
+	// The per tx budget cannot exceed the per block budget
+	_, err = server.SetMaxPoolPointsPerTx(ctx, types.NewMsgSetMaxPoolPointsPerTx(admin.String(), 51))
+	suite.Require().Error(err)
+	_, err = server.SetMaxPoolPointsPerBlock(ctx, types.NewMsgSetMaxPoolPointsPerBlock(admin.String(), 9))
+	suite.Require().Error(err)
+}
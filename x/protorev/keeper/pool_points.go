@@ -0,0 +1,136 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v13/x/protorev/types"
+	swaproutertypes "github.com/osmosis-labs/osmosis/v13/x/swaprouter/types"
+)
+
+// GetBlockRouteUsage returns the number of routes simulated and pool points consumed in the latest
+// block the module was active in
+func (k Keeper) GetBlockRouteUsage(ctx sdk.Context) (types.BlockRouteUsage, error) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixBlockRouteUsage)
+
+	bz := store.Get(types.KeyPrefixBlockRouteUsage)
+	if len(bz) == 0 {
+		return types.BlockRouteUsage{}, nil
+	}
+
+	usage := types.BlockRouteUsage{}
+	if err := usage.Unmarshal(bz); err != nil {
+		return types.BlockRouteUsage{}, err
+	}
+
+	return usage, nil
+}
+
+// SetBlockRouteUsage sets the number of routes simulated and pool points consumed in the current block
+func (k Keeper) SetBlockRouteUsage(ctx sdk.Context, usage types.BlockRouteUsage) error {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixBlockRouteUsage)
+
+	bz, err := usage.Marshal()
+	if err != nil {
+		return err
+	}
+
+	store.Set(types.KeyPrefixBlockRouteUsage, bz)
+	return nil
+}
+
+// getCurrentBlockRouteUsage returns the route usage of the current block, which is empty if the
+// module has not simulated any routes in the current block yet
+func (k Keeper) getCurrentBlockRouteUsage(ctx sdk.Context) (types.BlockRouteUsage, error) {
+	usage, err := k.GetBlockRouteUsage(ctx)
+	if err != nil {
+		return types.BlockRouteUsage{}, err
+	}
+
+	if usage.BlockHeight != uint64(ctx.BlockHeight()) {
+		return types.BlockRouteUsage{BlockHeight: uint64(ctx.BlockHeight())}, nil
+	}
+
+	return usage, nil
+}
+
+// GetRemainingPoolPoints returns the number of pool points that can still be consumed by the current
+// transaction, which is bounded both by the per transaction and the per block budget
+func (k Keeper) GetRemainingPoolPoints(ctx sdk.Context) (uint64, error) {
+	params := k.GetParams(ctx)
+
+	usage, err := k.getCurrentBlockRouteUsage(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	if usage.PoolPoints >= params.MaxPoolPointsPerBlock {
+		return 0, nil
+	}
+
+	remainingPoolPoints := params.MaxPoolPointsPerBlock - usage.PoolPoints
+	if remainingPoolPoints > params.MaxPoolPointsPerTx {
+		remainingPoolPoints = params.MaxPoolPointsPerTx
+	}
+
+	return remainingPoolPoints, nil
+}
+
+// ConsumePoolPoints records that numberOfRoutes routes costing poolPoints pool points were simulated
+// in the current block
+func (k Keeper) ConsumePoolPoints(ctx sdk.Context, numberOfRoutes, poolPoints uint64) error {
+	usage, err := k.getCurrentBlockRouteUsage(ctx)
+	if err != nil {
+		return err
+	}
+
+	usage.NumberOfRoutes += numberOfRoutes
+	usage.PoolPoints += poolPoints
+
+	return k.SetBlockRouteUsage(ctx, usage)
+}
+
+// CalculateRoutePoolPoints returns the number of pool points it costs to simulate the route, which is
+// the sum of the weights of the pools in the route
+func (k Keeper) CalculateRoutePoolPoints(ctx sdk.Context, route Route, poolWeights types.PoolWeights) (uint64, error) {
+	poolPoints := uint64(0)
+	for _, trade := range route.Trades {
+		poolType, err := k.swaprouterKeeper.GetPoolType(ctx, trade.Pool.GetId())
+		if err != nil {
+			return 0, err
+		}
+
+		switch poolType {
+		case swaproutertypes.Balancer:
+			poolPoints += poolWeights.BalancerWeight
+		case swaproutertypes.Stableswap:
+			poolPoints += poolWeights.StableWeight
+		default:
+			return 0, fmt.Errorf("unsupported pool type %s for pool %d", poolType, trade.Pool.GetId())
+		}
+	}
+
+	return poolPoints, nil
+}
+
+// FilterRoutesByPoolPoints returns the routes, in order, that fit in the remaining pool point budget
+// along with the number of pool points they cost. Routes that do not fit are skipped.
+func (k Keeper) FilterRoutesByPoolPoints(ctx sdk.Context, routes []Route, remainingPoolPoints uint64) ([]Route, uint64) {
+	poolWeights := k.GetParams(ctx).PoolWeights
+
+	filteredRoutes := make([]Route, 0, len(routes))
+	consumedPoolPoints := uint64(0)
+	for _, route := range routes {
+		routePoolPoints, err := k.CalculateRoutePoolPoints(ctx, route, poolWeights)
+		if err != nil || consumedPoolPoints+routePoolPoints > remainingPoolPoints {
+			continue
+		}
+
+		filteredRoutes = append(filteredRoutes, route)
+		consumedPoolPoints += routePoolPoints
+	}
+
+	return filteredRoutes, consumedPoolPoints
+}
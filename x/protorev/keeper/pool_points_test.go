@@ -0,0 +1,144 @@
+package keeper_test
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v13/x/protorev/keeper"
+	"github.com/osmosis-labs/osmosis/v13/x/protorev/types"
+)
+
+func (suite *KeeperTestSuite) TestCalculateRoutePoolPoints() {
+	weights := types.PoolWeights{StableWeight: 5, BalancerWeight: 2}
+
+	// Pools 24, 23 and 22 are balancer pools
+	routes := suite.App.ProtoRevKeeper.BuildRoutes(suite.Ctx, denomBE1B, denom0EF1, 23)
+	suite.Require().Equal(1, len(routes))
+	poolPoints, err := suite.App.ProtoRevKeeper.CalculateRoutePoolPoints(suite.Ctx, routes[0], weights)
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(6), poolPoints)
+
+	// Pool 34 is a stableswap pool
+	stablePool, err := suite.App.ProtoRevKeeper.GetAndCheckPool(suite.Ctx, 34)
+	suite.Require().NoError(err)
+	route := keeper.Route{Trades: []keeper.TradeInfo{routes[0].Trades[0], {Pool: stablePool}}}
+	poolPoints, err = suite.App.ProtoRevKeeper.CalculateRoutePoolPoints(suite.Ctx, route, weights)
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(7), poolPoints)
+}
+
+func (suite *KeeperTestSuite) TestGetRemainingPoolPoints() {
+	params := suite.App.ProtoRevKeeper.GetParams(suite.Ctx)
+	params.MaxPoolPointsPerTx = 10
+	params.MaxPoolPointsPerBlock = 25
+	suite.App.ProtoRevKeeper.SetParams(suite.Ctx, params)
+
+	// Bounded by the per tx budget
+	remaining, err := suite.App.ProtoRevKeeper.GetRemainingPoolPoints(suite.Ctx)
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(10), remaining)
+
+	suite.Require().NoError(suite.App.ProtoRevKeeper.ConsumePoolPoints(suite.Ctx, 2, 10))
+	suite.Require().NoError(suite.App.ProtoRevKeeper.ConsumePoolPoints(suite.Ctx, 1, 8))
+
+	// Bounded by the per block budget
+	remaining, err = suite.App.ProtoRevKeeper.GetRemainingPoolPoints(suite.Ctx)
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(7), remaining)
+
+	usage, err := suite.App.ProtoRevKeeper.GetBlockRouteUsage(suite.Ctx)
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.BlockRouteUsage{BlockHeight: uint64(suite.Ctx.BlockHeight()), NumberOfRoutes: 3, PoolPoints: 18}, usage)
+
+	suite.Require().NoError(suite.App.ProtoRevKeeper.ConsumePoolPoints(suite.Ctx, 1, 7))
+	remaining, err = suite.App.ProtoRevKeeper.GetRemainingPoolPoints(suite.Ctx)
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(0), remaining)
+
+	// The block budget resets in the next block
+	suite.Ctx = suite.Ctx.WithBlockHeight(suite.Ctx.BlockHeight() + 1)
+	remaining, err = suite.App.ProtoRevKeeper.GetRemainingPoolPoints(suite.Ctx)
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(10), remaining)
+}
+
+func (suite *KeeperTestSuite) TestProtoRevTradePoolPointBudget() {
+	swaps := []keeper.SwapToBackrun{{PoolId: 23, TokenInDenom: denomBE1B, TokenOutDenom: denom0EF1}}
+
+	cases := []struct {
+		description           string
+		maxPoolPointsPerTx    uint64
+		maxPoolPointsPerBlock uint64
+		consumedPoolPoints    uint64
+		// whether the trade panics, by storing profits of another denom under the profit denom
+		tradePanics    bool
+		expectedProfit sdk.Int
+		expectedUsage  types.BlockRouteUsage
+	}{
+		{
+			description:           "Route fits in the budget",
+			maxPoolPointsPerTx:    6,
+			maxPoolPointsPerBlock: 100,
+			expectedProfit:        sdk.NewInt(94_525),
+			expectedUsage:         types.BlockRouteUsage{NumberOfRoutes: 1, PoolPoints: 6},
+		},
+		{
+			description:           "Route does not fit in the per tx budget",
+			maxPoolPointsPerTx:    5,
+			maxPoolPointsPerBlock: 100,
+			expectedProfit:        sdk.ZeroInt(),
+			expectedUsage:         types.BlockRouteUsage{},
+		},
+		{
+			description:           "Route does not fit in the remaining per block budget",
+			maxPoolPointsPerTx:    18,
+			maxPoolPointsPerBlock: 100,
+			consumedPoolPoints:    95,
+			expectedProfit:        sdk.ZeroInt(),
+			expectedUsage:         types.BlockRouteUsage{NumberOfRoutes: 1, PoolPoints: 95},
+		},
+		{
+			description:           "Trade panics; pool points are still consumed",
+			maxPoolPointsPerTx:    6,
+			maxPoolPointsPerBlock: 100,
+			tradePanics:           true,
+			expectedProfit:        sdk.ZeroInt(),
+			expectedUsage:         types.BlockRouteUsage{NumberOfRoutes: 1, PoolPoints: 6},
+		},
+	}
+
+	for _, tc := range cases {
+		suite.Run(tc.description, func() {
+			suite.SetupTest()
+			params := suite.App.ProtoRevKeeper.GetParams(suite.Ctx)
+			params.MaxPoolPointsPerTx = tc.maxPoolPointsPerTx
+			params.MaxPoolPointsPerBlock = tc.maxPoolPointsPerBlock
+			suite.App.ProtoRevKeeper.SetParams(suite.Ctx, params)
+			if tc.consumedPoolPoints > 0 {
+				suite.Require().NoError(suite.App.ProtoRevKeeper.ConsumePoolPoints(suite.Ctx, 1, tc.consumedPoolPoints))
+			}
+			if tc.tradePanics {
+				otherProfit := sdk.NewInt64Coin("other", 1)
+				bz, err := otherProfit.Marshal()
+				suite.Require().NoError(err)
+				store := prefix.NewStore(suite.Ctx.KVStore(suite.App.GetKey(types.StoreKey)), types.KeyPrefixProfitsByDenom)
+				store.Set(types.GetKeyPrefixProfitByDenom(types.OsmosisDenomination), bz)
+			}
+
+			suite.swapOnPool23(10_000_000_000)
+			err := suite.App.ProtoRevKeeper.ProtoRevTrade(suite.Ctx, swaps)
+			suite.Require().NoError(err)
+
+			moduleAddress := suite.App.AccountKeeper.GetModuleAddress(types.ModuleName)
+			balance := suite.App.BankKeeper.GetBalance(suite.Ctx, moduleAddress, types.OsmosisDenomination)
+			suite.Require().Equal(tc.expectedProfit, balance.Amount)
+
+			usage, err := suite.App.ProtoRevKeeper.GetBlockRouteUsage(suite.Ctx)
+			suite.Require().NoError(err)
+			if tc.expectedUsage.NumberOfRoutes > 0 {
+				tc.expectedUsage.BlockHeight = uint64(suite.Ctx.BlockHeight())
+			}
+			suite.Require().Equal(tc.expectedUsage, usage)
+		})
+	}
+}
@@ -0,0 +1,127 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v13/osmoutils"
+	gammtypes "github.com/osmosis-labs/osmosis/v13/x/gamm/types"
+	swaproutertypes "github.com/osmosis-labs/osmosis/v13/x/swaprouter/types"
+)
+
+// SwapToBackrun contains the information of a single hop of a user's swap
+type SwapToBackrun struct {
+	PoolId        uint64
+	TokenInDenom  string
+	TokenOutDenom string
+}
+
+// ProtoRevDecorator is a post handler that attempts to backrun the swaps in a transaction with
+// a profitable cyclic arbitrage trade
+type ProtoRevDecorator struct {
+	ProtoRevKeeper Keeper
+}
+
+func NewProtoRevDecorator(protoRevKeeper Keeper) ProtoRevDecorator {
+	return ProtoRevDecorator{
+		ProtoRevKeeper: protoRevKeeper,
+	}
+}
+
+// AnteHandle executes cyclic arbitrage trades after the swaps in the transaction. The trades are
+// executed with an infinite gas meter, so they neither cost the user gas nor fail the user's transaction.
+func (protoRevDec ProtoRevDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	if ctx.IsCheckTx() || simulate || !protoRevDec.ProtoRevKeeper.GetParams(ctx).Enabled {
+		return next(ctx, tx, simulate)
+	}
+
+	swappedPools := ExtractSwappedPools(tx)
+	if len(swappedPools) == 0 {
+		return next(ctx, tx, simulate)
+	}
+
+	protoRevDec.backrunSwaps(ctx, swappedPools)
+
+	return next(ctx, tx, simulate)
+}
+
+// backrunSwaps runs the arbitrage trades with an infinite gas meter. The pool points of every backrun
+// are consumed even if it fails, while the state changes and events of each trade are only written if
+// it succeeds, see ProtoRevTrade.
+func (protoRevDec ProtoRevDecorator) backrunSwaps(ctx sdk.Context, swappedPools []SwapToBackrun) {
+	defer func() {
+		if recoveryError := recover(); recoveryError != nil {
+			osmoutils.PrintPanicRecoveryError(ctx, recoveryError)
+		}
+	}()
+
+	protoRevCtx := ctx.
+		WithGasMeter(sdk.NewInfiniteGasMeter()).
+		WithEventManager(sdk.NewEventManager())
+
+	if err := protoRevDec.ProtoRevKeeper.ProtoRevTrade(protoRevCtx, swappedPools); err != nil {
+		protoRevDec.ProtoRevKeeper.Logger(ctx).Error("ProtoRevTrade failed with error: " + err.Error())
+	}
+
+	ctx.EventManager().EmitEvents(protoRevCtx.EventManager().Events())
+}
+
+// ExtractSwappedPools returns every hop of the swaps in the transaction
+func ExtractSwappedPools(tx sdk.Tx) []SwapToBackrun {
+	swappedPools := make([]SwapToBackrun, 0)
+
+	for _, msg := range tx.GetMsgs() {
+		switch msg := msg.(type) {
+		case *gammtypes.MsgSwapExactAmountIn:
+			routes := gammtypes.SwapAmountInRoutes(msg.Routes).ToSwaprouterRoutes()
+			swappedPools = append(swappedPools, extractSwappedPoolsExactAmountIn(routes, msg.TokenIn.Denom)...)
+		case *gammtypes.MsgSwapExactAmountOut:
+			routes := gammtypes.SwapAmountOutRoutes(msg.Routes).ToSwaprouterRoutes()
+			swappedPools = append(swappedPools, extractSwappedPoolsExactAmountOut(routes, msg.TokenOut.Denom)...)
+		case *swaproutertypes.MsgSwapExactAmountIn:
+			swappedPools = append(swappedPools, extractSwappedPoolsExactAmountIn(msg.Routes, msg.TokenIn.Denom)...)
+		case *swaproutertypes.MsgSwapExactAmountOut:
+			swappedPools = append(swappedPools, extractSwappedPoolsExactAmountOut(msg.Routes, msg.TokenOut.Denom)...)
+		case *swaproutertypes.MsgSplitRouteSwapExactAmountIn:
+			for _, route := range msg.Routes {
+				swappedPools = append(swappedPools, extractSwappedPoolsExactAmountIn(route.Pools, msg.TokenInDenom)...)
+			}
+		case *swaproutertypes.MsgSplitRouteSwapExactAmountOut:
+			for _, route := range msg.Routes {
+				swappedPools = append(swappedPools, extractSwappedPoolsExactAmountOut(route.Pools, msg.TokenOutDenom)...)
+			}
+		}
+	}
+
+	return swappedPools
+}
+
+// extractSwappedPoolsExactAmountIn returns every hop of an exact amount in swap through the given routes
+func extractSwappedPoolsExactAmountIn(routes []swaproutertypes.SwapAmountInRoute, tokenInDenom string) []SwapToBackrun {
+	swappedPools := make([]SwapToBackrun, 0, len(routes))
+	for _, route := range routes {
+		swappedPools = append(swappedPools, SwapToBackrun{
+			PoolId:        route.PoolId,
+			TokenInDenom:  tokenInDenom,
+			TokenOutDenom: route.TokenOutDenom,
+		})
+		tokenInDenom = route.TokenOutDenom
+	}
+	return swappedPools
+}
+
+// extractSwappedPoolsExactAmountOut returns every hop of an exact amount out swap through the given routes
+func extractSwappedPoolsExactAmountOut(routes []swaproutertypes.SwapAmountOutRoute, tokenOutDenom string) []SwapToBackrun {
+	swappedPools := make([]SwapToBackrun, 0, len(routes))
+	for index, route := range routes {
+		hopTokenOutDenom := tokenOutDenom
+		if index < len(routes)-1 {
+			hopTokenOutDenom = routes[index+1].TokenInDenom
+		}
+		swappedPools = append(swappedPools, SwapToBackrun{
+			PoolId:        route.PoolId,
+			TokenInDenom:  route.TokenInDenom,
+			TokenOutDenom: hopTokenOutDenom,
+		})
+	}
+	return swappedPools
+}
@@ -0,0 +1,153 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	gammtypes "github.com/osmosis-labs/osmosis/v13/x/gamm/types"
+	"github.com/osmosis-labs/osmosis/v13/x/protorev/keeper"
+	"github.com/osmosis-labs/osmosis/v13/x/protorev/types"
+)
+
+func (suite *KeeperTestSuite) TestExtractSwappedPools() {
+	cases := []struct {
+		description string
+		msgs        []sdk.Msg
+		expected    []keeper.SwapToBackrun
+	}{
+		{
+			description: "No swaps",
+			msgs: []sdk.Msg{
+				&gammtypes.MsgExitPool{Sender: suite.TestAccs[0].String(), PoolId: 1},
+			},
+			expected: []keeper.SwapToBackrun{},
+		},
+		{
+			description: "Multihop swap exact amount in",
+			msgs: []sdk.Msg{
+				&gammtypes.MsgSwapExactAmountIn{
+					Sender: suite.TestAccs[0].String(),
+					Routes: []gammtypes.SwapAmountInRoute{
+						{PoolId: 7, TokenOutDenom: types.OsmosisDenomination},
+						{PoolId: 25, TokenOutDenom: types.AtomDenomination},
+					},
+					TokenIn:           sdk.NewCoin("akash", sdk.NewInt(100)),
+					TokenOutMinAmount: sdk.OneInt(),
+				},
+			},
+			expected: []keeper.SwapToBackrun{
+				{PoolId: 7, TokenInDenom: "akash", TokenOutDenom: types.OsmosisDenomination},
+				{PoolId: 25, TokenInDenom: types.OsmosisDenomination, TokenOutDenom: types.AtomDenomination},
+			},
+		},
+		{
+			description: "Multihop swap exact amount out and swap exact amount in",
+			msgs: []sdk.Msg{
+				&gammtypes.MsgSwapExactAmountOut{
+					Sender: suite.TestAccs[0].String(),
+					Routes: []gammtypes.SwapAmountOutRoute{
+						{PoolId: 7, TokenInDenom: "akash"},
+						{PoolId: 25, TokenInDenom: types.OsmosisDenomination},
+					},
+					TokenInMaxAmount: sdk.NewInt(100),
+					TokenOut:         sdk.NewCoin(types.AtomDenomination, sdk.NewInt(10)),
+				},
+				&gammtypes.MsgSwapExactAmountIn{
+					Sender:            suite.TestAccs[0].String(),
+					Routes:            []gammtypes.SwapAmountInRoute{{PoolId: 23, TokenOutDenom: denom0EF1}},
+					TokenIn:           sdk.NewCoin(denomBE1B, sdk.NewInt(100)),
+					TokenOutMinAmount: sdk.OneInt(),
+				},
+			},
+			expected: []keeper.SwapToBackrun{
+				{PoolId: 7, TokenInDenom: "akash", TokenOutDenom: types.OsmosisDenomination},
+				{PoolId: 25, TokenInDenom: types.OsmosisDenomination, TokenOutDenom: types.AtomDenomination},
+				{PoolId: 23, TokenInDenom: denomBE1B, TokenOutDenom: denom0EF1},
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		suite.Run(tc.description, func() {
+			tx := suite.buildTx(tc.msgs...)
+			suite.Require().Equal(tc.expected, keeper.ExtractSwappedPools(tx))
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestProtoRevDecorator() {
+	swapMsg := &gammtypes.MsgSwapExactAmountIn{
+		Sender:            suite.TestAccs[0].String(),
+		Routes:            []gammtypes.SwapAmountInRoute{{PoolId: 23, TokenOutDenom: denom0EF1}},
+		TokenIn:           sdk.NewCoin(denomBE1B, sdk.NewInt(10_000_000_000)),
+		TokenOutMinAmount: sdk.OneInt(),
+	}
+
+	cases := []struct {
+		description    string
+		enabled        bool
+		checkTx        bool
+		expectedProfit sdk.Int
+	}{
+		{
+			description:    "Trade is executed after a swap",
+			enabled:        true,
+			expectedProfit: sdk.NewInt(94_525),
+		},
+		{
+			description:    "No trade when the module is disabled",
+			enabled:        false,
+			expectedProfit: sdk.ZeroInt(),
+		},
+		{
+			description:    "No trade in CheckTx",
+			enabled:        true,
+			checkTx:        true,
+			expectedProfit: sdk.ZeroInt(),
+		},
+	}
+
+	for _, tc := range cases {
+		suite.Run(tc.description, func() {
+			suite.SetupTest()
+			params := types.DefaultParams()
+			params.Enabled = tc.enabled
+			suite.App.ProtoRevKeeper.SetParams(suite.Ctx, params)
+			suite.swapOnPool23(swapMsg.TokenIn.Amount.Int64())
+
+			nextCalled := false
+			next := func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) {
+				nextCalled = true
+				return ctx, nil
+			}
+
+			ctx := suite.Ctx.WithIsCheckTx(tc.checkTx).WithEventManager(sdk.NewEventManager())
+			decorator := keeper.NewProtoRevDecorator(*suite.App.ProtoRevKeeper)
+			_, err := decorator.AnteHandle(ctx, suite.buildTx(swapMsg), false, next)
+			suite.Require().NoError(err)
+			suite.Require().True(nextCalled)
+
+			moduleAddress := suite.App.AccountKeeper.GetModuleAddress(types.ModuleName)
+			balance := suite.App.BankKeeper.GetBalance(suite.Ctx, moduleAddress, types.OsmosisDenomination)
+			suite.Require().Equal(tc.expectedProfit, balance.Amount)
+
+			backrunEvents := 0
+			for _, event := range ctx.EventManager().Events() {
+				if event.Type == types.TypeEvtBackrun {
+					backrunEvents++
+				}
+			}
+			if tc.expectedProfit.IsPositive() {
+				suite.Require().Equal(1, backrunEvents)
+			} else {
+				suite.Require().Equal(0, backrunEvents)
+			}
+		})
+	}
+}
+
+// buildTx builds a transaction containing the given messages
+func (suite *KeeperTestSuite) buildTx(msgs ...sdk.Msg) sdk.Tx {
+	txBuilder := suite.clientCtx.TxConfig.NewTxBuilder()
+	suite.Require().NoError(txBuilder.SetMsgs(msgs...))
+	return txBuilder.GetTx()
+}
@@ -93,6 +93,11 @@ func (k Keeper) SetOsmoPool(ctx sdk.Context, denom string, poolId uint64) {
 	store.Set(key, sdk.Uint64ToBigEndian(poolId))
 }
 
+// GetAllOsmoPools returns all of the Osmo pools the module uses to build routes
+func (k Keeper) GetAllOsmoPools(ctx sdk.Context) []types.BaseDenomPool {
+	return k.getAllBaseDenomPools(ctx, types.KeyPrefixOsmoPools)
+}
+
 // DeleteAllOsmoPools deletes all the Osmo pools from modules store
 func (k Keeper) DeleteAllOsmoPools(ctx sdk.Context) {
 	k.DeleteAllEntriesForKeyPrefix(ctx, types.KeyPrefixOsmoPools)
@@ -120,11 +125,34 @@ func (k Keeper) SetAtomPool(ctx sdk.Context, denom string, poolId uint64) {
 	store.Set(key, sdk.Uint64ToBigEndian(poolId))
 }
 
+// GetAllAtomPools returns all of the Atom pools the module uses to build routes
+func (k Keeper) GetAllAtomPools(ctx sdk.Context) []types.BaseDenomPool {
+	return k.getAllBaseDenomPools(ctx, types.KeyPrefixAtomPools)
+}
+
 // DeleteAllAtomPools deletes all the Atom pools from modules store
 func (k Keeper) DeleteAllAtomPools(ctx sdk.Context) {
 	k.DeleteAllEntriesForKeyPrefix(ctx, types.KeyPrefixAtomPools)
 }
 
+// getAllBaseDenomPools returns all of the pools stored under the given base denom (osmo or atom) pool prefix
+func (k Keeper) getAllBaseDenomPools(ctx sdk.Context, keyPrefix []byte) []types.BaseDenomPool {
+	pools := make([]types.BaseDenomPool, 0)
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), keyPrefix)
+	iterator := sdk.KVStorePrefixIterator(store, keyPrefix)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		pools = append(pools, types.BaseDenomPool{
+			Denom:  string(iterator.Key()[len(keyPrefix):]),
+			PoolId: sdk.BigEndianToUint64(iterator.Value()),
+		})
+	}
+
+	return pools
+}
+
 // DeleteAllEntriesForKeyPrefix deletes all the entries from the store for the given key prefix
 func (k Keeper) DeleteAllEntriesForKeyPrefix(ctx sdk.Context, keyPrefix []byte) {
 	store := ctx.KVStore(k.storeKey)
@@ -135,3 +163,23 @@ func (k Keeper) DeleteAllEntriesForKeyPrefix(ctx sdk.Context, keyPrefix []byte)
 		store.Delete(iterator.Key())
 	}
 }
+
+// ---------------------- Configuration Stores  ---------------------- //
+
+// GetDeveloperAccount returns the account that receives a portion of the profits of the module
+func (k Keeper) GetDeveloperAccount(ctx sdk.Context) (sdk.AccAddress, error) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixDeveloperAccount)
+
+	bz := store.Get(types.KeyPrefixDeveloperAccount)
+	if len(bz) == 0 {
+		return nil, fmt.Errorf("developer account not found, it can be set using MsgSetDeveloperAccount")
+	}
+
+	return sdk.AccAddress(bz), nil
+}
+
+// SetDeveloperAccount sets the account that receives a portion of the profits of the module
+func (k Keeper) SetDeveloperAccount(ctx sdk.Context, developerAccount sdk.AccAddress) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixDeveloperAccount)
+	store.Set(types.KeyPrefixDeveloperAccount, developerAccount)
+}
@@ -0,0 +1,258 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v13/osmomath"
+	"github.com/osmosis-labs/osmosis/v13/osmoutils"
+	"github.com/osmosis-labs/osmosis/v13/x/protorev/types"
+	swaproutertypes "github.com/osmosis-labs/osmosis/v13/x/swaprouter/types"
+)
+
+// SwapAmountInRoutes converts the cyclic arbitrage route into the routes expected by the x/swaprouter multihop swaps
+func (r Route) SwapAmountInRoutes() []swaproutertypes.SwapAmountInRoute {
+	routes := make([]swaproutertypes.SwapAmountInRoute, len(r.Trades))
+	for index, trade := range r.Trades {
+		routes[index] = swaproutertypes.SwapAmountInRoute{
+			PoolId:        trade.Pool.GetId(),
+			TokenOutDenom: trade.OutputDenom,
+		}
+	}
+	return routes
+}
+
+// InputDenom returns the denom that the cyclic arbitrage route starts and ends with
+func (r Route) InputDenom() string {
+	return r.Trades[0].InputDenom
+}
+
+// EstimateMultihopProfit estimates the profit of swapping inputCoin through the cyclic arbitrage route
+func (k Keeper) EstimateMultihopProfit(ctx sdk.Context, route Route, inputCoin sdk.Coin) (sdk.Int, error) {
+	amountOut, err := k.swaprouterKeeper.MultihopEstimateOutGivenExactAmountIn(ctx, route.SwapAmountInRoutes(), inputCoin)
+	if err != nil {
+		return sdk.ZeroInt(), err
+	}
+
+	return amountOut.Sub(inputCoin.Amount), nil
+}
+
+// FindMaxProfitForRoute binary searches for the input amount that maximizes the profit of the cyclic arbitrage route.
+// Input amounts are searched in increments of types.StepSize up to types.MaxInputAmount steps. Returns the optimal
+// input coin along with the estimated profit.
+func (k Keeper) FindMaxProfitForRoute(ctx sdk.Context, route Route) (sdk.Coin, sdk.Int, error) {
+	if len(route.Trades) == 0 {
+		return sdk.Coin{}, sdk.ZeroInt(), fmt.Errorf("route has no trades")
+	}
+	inputDenom := route.InputDenom()
+
+	profitAt := func(steps sdk.Int) (sdk.Int, error) {
+		if steps.IsZero() {
+			return sdk.ZeroInt(), nil
+		}
+		return k.EstimateMultihopProfit(ctx, route, sdk.NewCoin(inputDenom, steps.Mul(types.StepSize)))
+	}
+
+	// slope returns 1 if the profit is decreasing at the given number of steps, -1 if it is
+	// increasing and 0 if the given number of steps is a local maximum. Input amounts that cannot
+	// be swapped through the route are treated as unprofitable. The profit of a cyclic route is
+	// concave in the input amount, so the local maximum is also the global maximum.
+	slope := func(steps sdk.Int) (sdk.Int, error) {
+		profit, err := profitAt(steps)
+		if err != nil {
+			return sdk.OneInt(), nil
+		}
+
+		if steps.IsPositive() {
+			if lower, err := profitAt(steps.Sub(sdk.OneInt())); err == nil && lower.GT(profit) {
+				return sdk.OneInt(), nil
+			}
+		}
+
+		if steps.LT(types.MaxInputAmount) {
+			if higher, err := profitAt(steps.Add(sdk.OneInt())); err == nil && higher.GT(profit) {
+				return sdk.OneInt().Neg(), nil
+			}
+		}
+
+		return sdk.ZeroInt(), nil
+	}
+
+	errTolerance := osmomath.ErrTolerance{AdditiveTolerance: sdk.ZeroDec()}
+	steps, err := osmomath.BinarySearch(slope, sdk.ZeroInt(), types.MaxInputAmount.Add(sdk.OneInt()), sdk.ZeroInt(), errTolerance, types.MaxIterations)
+	if err != nil {
+		return sdk.Coin{}, sdk.ZeroInt(), err
+	}
+
+	profit, err := profitAt(steps)
+	if err != nil {
+		return sdk.Coin{}, sdk.ZeroInt(), err
+	}
+
+	return sdk.NewCoin(inputDenom, steps.Mul(types.StepSize)), profit, nil
+}
+
+// ConvertProfits converts the profit denominated in inputDenom to uosmo so that routes starting with
+// different denoms can be compared
+func (k Keeper) ConvertProfits(ctx sdk.Context, inputDenom string, profit sdk.Int) (sdk.Int, error) {
+	if inputDenom == types.OsmosisDenomination {
+		return profit, nil
+	}
+
+	poolId, err := k.GetOsmoPool(ctx, inputDenom)
+	if err != nil {
+		return sdk.ZeroInt(), err
+	}
+
+	spotPrice, err := k.swaprouterKeeper.RouteCalculateSpotPrice(ctx, poolId, types.OsmosisDenomination, inputDenom)
+	if err != nil {
+		return sdk.ZeroInt(), err
+	}
+
+	return profit.ToDec().Mul(spotPrice).TruncateInt(), nil
+}
+
+// IterateRoutes finds the most profitable route out of the given routes. Returns the optimal input coin,
+// the profit denominated in the input denom and the route. The returned profit is zero if none of the
+// routes are profitable.
+func (k Keeper) IterateRoutes(ctx sdk.Context, routes []Route) (sdk.Coin, sdk.Int, Route) {
+	var (
+		maxProfitInputCoin sdk.Coin
+		maxProfitRoute     Route
+	)
+	maxProfit := sdk.ZeroInt()
+	maxProfitInOsmo := sdk.ZeroInt()
+
+	for _, route := range routes {
+		inputCoin, profit, err := k.FindMaxProfitForRoute(ctx, route)
+		if err != nil || !profit.IsPositive() {
+			continue
+		}
+
+		profitInOsmo, err := k.ConvertProfits(ctx, inputCoin.Denom, profit)
+		if err != nil {
+			continue
+		}
+
+		if profitInOsmo.GT(maxProfitInOsmo) {
+			maxProfitInputCoin = inputCoin
+			maxProfit = profit
+			maxProfitInOsmo = profitInOsmo
+			maxProfitRoute = route
+		}
+	}
+
+	return maxProfitInputCoin, maxProfit, maxProfitRoute
+}
+
+// ExecuteTrade executes the cyclic arbitrage route with the given input coin from the module account. The input
+// coin is minted before the swap and burned after it, so the module account only keeps the profits, less the
+// developer fee, see SendDeveloperFee. Only the base
+// denoms that routes start with (osmo and atom) are ever minted. The trade runs in its own cache context, so the
+// mint and burn are either both applied or not at all. The trade fails if it is not profitable.
+func (k Keeper) ExecuteTrade(ctx sdk.Context, route Route, inputCoin sdk.Coin, userPoolId uint64) error {
+	if inputCoin.Denom != types.OsmosisDenomination && inputCoin.Denom != types.AtomDenomination {
+		return fmt.Errorf("cannot execute trade with input denom %s, must be %s or %s", inputCoin.Denom, types.OsmosisDenomination, types.AtomDenomination)
+	}
+
+	return osmoutils.ApplyFuncIfNoError(ctx, func(cacheCtx sdk.Context) error {
+		return k.executeTrade(cacheCtx, route, inputCoin, userPoolId)
+	})
+}
+
+// executeTrade mints the input coin, swaps it through the route and burns it. See ExecuteTrade.
+func (k Keeper) executeTrade(ctx sdk.Context, route Route, inputCoin sdk.Coin, userPoolId uint64) error {
+	moduleAddress := k.accountKeeper.GetModuleAddress(types.ModuleName)
+	inputCoins := sdk.NewCoins(inputCoin)
+
+	if err := k.bankKeeper.MintCoins(ctx, types.ModuleName, inputCoins); err != nil {
+		return err
+	}
+
+	amountOut, err := k.swaprouterKeeper.RouteExactAmountIn(ctx, moduleAddress, route.SwapAmountInRoutes(), inputCoin, inputCoin.Amount.Add(sdk.OneInt()))
+	if err != nil {
+		return err
+	}
+
+	if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, inputCoins); err != nil {
+		return err
+	}
+
+	profit := sdk.NewCoin(inputCoin.Denom, amountOut.Sub(inputCoin.Amount))
+	if err := k.SendDeveloperFee(ctx, profit); err != nil {
+		return err
+	}
+
+	if err := k.UpdateStatistics(ctx, userPoolId, profit); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.TypeEvtBackrun,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeyUserPoolId, fmt.Sprintf("%d", userPoolId)),
+			sdk.NewAttribute(types.AttributeKeyTokenIn, inputCoin.String()),
+			sdk.NewAttribute(types.AttributeKeyProfit, profit.String()),
+		),
+	)
+
+	return nil
+}
+
+// SendDeveloperFee sends types.DeveloperProfitShare of the profit of a trade from the module account to the
+// developer account. The rest of the profit is kept by the module account, as is all of it if no developer
+// account is set.
+func (k Keeper) SendDeveloperFee(ctx sdk.Context, profit sdk.Coin) error {
+	developerAccount, err := k.GetDeveloperAccount(ctx)
+	if err != nil {
+		return nil
+	}
+
+	developerFee := sdk.NewCoin(profit.Denom, profit.Amount.ToDec().Mul(types.DeveloperProfitShare).TruncateInt())
+	if !developerFee.IsPositive() {
+		return nil
+	}
+
+	return k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, developerAccount, sdk.NewCoins(developerFee))
+}
+
+// ProtoRevTrade builds the cyclic arbitrage routes for each of the given swaps and executes the most
+// profitable one, if any. Only the routes that fit in the remaining pool point budget are simulated.
+// The pool points are consumed in the given context before the routes are simulated, while the simulation
+// and trade of each swap run in their own cache context. Hence, a failing backrun still consumes its points.
+func (k Keeper) ProtoRevTrade(ctx sdk.Context, swappedPools []SwapToBackrun) error {
+	remainingPoolPoints, err := k.GetRemainingPoolPoints(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, swap := range swappedPools {
+		if remainingPoolPoints == 0 {
+			break
+		}
+
+		routes := k.BuildRoutes(ctx, swap.TokenInDenom, swap.TokenOutDenom, swap.PoolId)
+		routes, poolPoints := k.FilterRoutesByPoolPoints(ctx, routes, remainingPoolPoints)
+		if len(routes) == 0 {
+			continue
+		}
+
+		remainingPoolPoints -= poolPoints
+		if err := k.ConsumePoolPoints(ctx, uint64(len(routes)), poolPoints); err != nil {
+			return err
+		}
+
+		// the error is logged, and the next swap is backrun regardless.
+		_ = osmoutils.ApplyFuncIfNoError(ctx, func(cacheCtx sdk.Context) error {
+			inputCoin, profit, route := k.IterateRoutes(cacheCtx, routes)
+			if !profit.IsPositive() {
+				return nil
+			}
+			return k.ExecuteTrade(cacheCtx, route, inputCoin, swap.PoolId)
+		})
+	}
+
+	return nil
+}
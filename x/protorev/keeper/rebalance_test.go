@@ -0,0 +1,168 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v13/x/protorev/types"
+)
+
+const (
+	// Denoms of pools 22, 23 and 24, which form a cyclic arbitrage route with osmo
+	denomBE1B = "ibc/BE1BB42D4BE3C30D50B68D7C41DB4DFCE9678E8EF8C539F6E6A9345048894FCC"
+	denom0EF1 = "ibc/0EF15DF2F02480ADE0BB6E85D9EBB5DAEA2836D3860E9F97F9AADE4F57A31AA0"
+)
+
+// swapOnPool23 imbalances pool 23 by swapping amount of denomBE1B for denom0EF1
+func (suite *KeeperTestSuite) swapOnPool23(amount int64) {
+	pool, err := suite.App.GAMMKeeper.GetPool(suite.Ctx, 23)
+	suite.Require().NoError(err)
+	_, err = suite.App.GAMMKeeper.SwapExactAmountIn(suite.Ctx, suite.TestAccs[0], pool, sdk.NewCoin(denomBE1B, sdk.NewInt(amount)), denom0EF1, sdk.OneInt(), pool.GetSwapFee(suite.Ctx))
+	suite.Require().NoError(err)
+}
+
+func (suite *KeeperTestSuite) TestFindMaxProfitForRoute() {
+	cases := []struct {
+		description       string
+		swapAmount        int64
+		expectedInputCoin sdk.Coin
+		expectedProfit    sdk.Int
+	}{
+		{
+			description:       "Balanced pools have no profitable arbitrage",
+			swapAmount:        0,
+			expectedInputCoin: sdk.NewCoin(types.OsmosisDenomination, sdk.ZeroInt()),
+			expectedProfit:    sdk.ZeroInt(),
+		},
+		{
+			description:       "Small swap does not create a profitable arbitrage",
+			swapAmount:        1_000_000,
+			expectedInputCoin: sdk.NewCoin(types.OsmosisDenomination, sdk.ZeroInt()),
+			expectedProfit:    sdk.ZeroInt(),
+		},
+		{
+			description:       "Large swap creates a profitable arbitrage",
+			swapAmount:        10_000_000_000,
+			expectedInputCoin: sdk.NewCoin(types.OsmosisDenomination, sdk.NewInt(20_000_000)),
+			expectedProfit:    sdk.NewInt(94_525),
+		},
+	}
+
+	for _, tc := range cases {
+		suite.Run(tc.description, func() {
+			suite.SetupTest()
+			if tc.swapAmount > 0 {
+				suite.swapOnPool23(tc.swapAmount)
+			}
+
+			routes := suite.App.ProtoRevKeeper.BuildRoutes(suite.Ctx, denomBE1B, denom0EF1, 23)
+			suite.Require().Equal(1, len(routes))
+
+			inputCoin, profit, err := suite.App.ProtoRevKeeper.FindMaxProfitForRoute(suite.Ctx, routes[0])
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.expectedInputCoin, inputCoin)
+			suite.Require().Equal(tc.expectedProfit, profit)
+
+			// The optimal input amount must be at least as profitable as its neighbouring steps
+			for _, neighbour := range []sdk.Int{inputCoin.Amount.Sub(types.StepSize), inputCoin.Amount.Add(types.StepSize)} {
+				if !neighbour.IsPositive() {
+					continue
+				}
+				neighbourProfit, err := suite.App.ProtoRevKeeper.EstimateMultihopProfit(suite.Ctx, routes[0], sdk.NewCoin(inputCoin.Denom, neighbour))
+				suite.Require().NoError(err)
+				suite.Require().True(profit.GTE(neighbourProfit))
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestIterateRoutes() {
+	suite.swapOnPool23(10_000_000_000)
+
+	routes := suite.App.ProtoRevKeeper.BuildRoutes(suite.Ctx, denomBE1B, denom0EF1, 23)
+	// An unprofitable route must not be picked over the profitable one
+	unprofitableRoutes := suite.App.ProtoRevKeeper.BuildRoutes(suite.Ctx, "akash", types.AtomDenomination, 1)
+	routes = append(unprofitableRoutes, routes...)
+
+	inputCoin, profit, route := suite.App.ProtoRevKeeper.IterateRoutes(suite.Ctx, routes)
+	suite.Require().Equal(sdk.NewCoin(types.OsmosisDenomination, sdk.NewInt(20_000_000)), inputCoin)
+	suite.Require().Equal(sdk.NewInt(94_525), profit)
+	suite.Require().Equal(uint64(23), route.Trades[1].Pool.GetId())
+
+	// No routes are profitable before the swap is placed
+	suite.SetupTest()
+	_, profit, _ = suite.App.ProtoRevKeeper.IterateRoutes(suite.Ctx, routes)
+	suite.Require().True(profit.IsZero())
+}
+
+func (suite *KeeperTestSuite) TestExecuteTrade() {
+	suite.swapOnPool23(10_000_000_000)
+
+	routes := suite.App.ProtoRevKeeper.BuildRoutes(suite.Ctx, denomBE1B, denom0EF1, 23)
+	suite.Require().Equal(1, len(routes))
+
+	supplyBefore := suite.App.BankKeeper.GetSupply(suite.Ctx, types.OsmosisDenomination)
+	moduleAddress := suite.App.AccountKeeper.GetModuleAddress(types.ModuleName)
+
+	// Executing a trade that is not profitable fails, and its minted input is not kept
+	err := suite.App.ProtoRevKeeper.ExecuteTrade(suite.Ctx, routes[0], sdk.NewCoin(types.OsmosisDenomination, sdk.NewInt(100_000_000)), 23)
+	suite.Require().Error(err)
+	suite.Require().Equal(supplyBefore, suite.App.BankKeeper.GetSupply(suite.Ctx, types.OsmosisDenomination))
+	suite.Require().True(suite.App.BankKeeper.GetAllBalances(suite.Ctx, moduleAddress).IsZero())
+
+	// Only the base denoms are minted
+	supplyBE1BBefore := suite.App.BankKeeper.GetSupply(suite.Ctx, denomBE1B)
+	err = suite.App.ProtoRevKeeper.ExecuteTrade(suite.Ctx, routes[0], sdk.NewCoin(denomBE1B, sdk.NewInt(20_000_000)), 23)
+	suite.Require().Error(err)
+	suite.Require().Equal(supplyBE1BBefore, suite.App.BankKeeper.GetSupply(suite.Ctx, denomBE1B))
+
+	inputCoin := sdk.NewCoin(types.OsmosisDenomination, sdk.NewInt(20_000_000))
+	err = suite.App.ProtoRevKeeper.ExecuteTrade(suite.Ctx, routes[0], inputCoin, 23)
+	suite.Require().NoError(err)
+
+	// The module account keeps the profits and the minted input is burned
+	expectedProfit := sdk.NewCoin(types.OsmosisDenomination, sdk.NewInt(94_525))
+	suite.Require().Equal(expectedProfit, suite.App.BankKeeper.GetBalance(suite.Ctx, moduleAddress, types.OsmosisDenomination))
+	suite.Require().Equal(supplyBefore, suite.App.BankKeeper.GetSupply(suite.Ctx, types.OsmosisDenomination))
+
+	// The statistics are updated
+	numberOfTrades, err := suite.App.ProtoRevKeeper.GetNumberOfTrades(suite.Ctx)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.OneInt(), numberOfTrades)
+
+	profits, err := suite.App.ProtoRevKeeper.GetProfitsByDenom(suite.Ctx, types.OsmosisDenomination)
+	suite.Require().NoError(err)
+	suite.Require().Equal(expectedProfit, profits)
+
+	statistics, err := suite.App.ProtoRevKeeper.GetPoolStatistics(suite.Ctx, 23)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.OneInt(), statistics.NumberOfTrades)
+	suite.Require().Equal([]*sdk.Coin{&expectedProfit}, statistics.Profits)
+}
+
+func (suite *KeeperTestSuite) TestExecuteTradeDeveloperFee() {
+	suite.swapOnPool23(10_000_000_000)
+
+	routes := suite.App.ProtoRevKeeper.BuildRoutes(suite.Ctx, denomBE1B, denom0EF1, 23)
+	suite.Require().Equal(1, len(routes))
+
+	developerAccount := suite.TestAccs[1]
+	developerBalanceBefore := suite.App.BankKeeper.GetBalance(suite.Ctx, developerAccount, types.OsmosisDenomination)
+	suite.App.ProtoRevKeeper.SetDeveloperAccount(suite.Ctx, developerAccount)
+
+	err := suite.App.ProtoRevKeeper.ExecuteTrade(suite.Ctx, routes[0], sdk.NewCoin(types.OsmosisDenomination, sdk.NewInt(20_000_000)), 23)
+	suite.Require().NoError(err)
+
+	// The developer account receives 20% of the profit of 94_525, and the module account keeps the rest
+	developerFee := sdk.NewInt(18_905)
+	developerBalance := suite.App.BankKeeper.GetBalance(suite.Ctx, developerAccount, types.OsmosisDenomination)
+	suite.Require().Equal(developerBalanceBefore.Amount.Add(developerFee), developerBalance.Amount)
+
+	moduleAddress := suite.App.AccountKeeper.GetModuleAddress(types.ModuleName)
+	moduleBalance := suite.App.BankKeeper.GetBalance(suite.Ctx, moduleAddress, types.OsmosisDenomination)
+	suite.Require().Equal(sdk.NewInt(94_525).Sub(developerFee), moduleBalance.Amount)
+
+	// The statistics record the whole profit
+	profits, err := suite.App.ProtoRevKeeper.GetProfitsByDenom(suite.Ctx, types.OsmosisDenomination)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewCoin(types.OsmosisDenomination, sdk.NewInt(94_525)), profits)
+}
@@ -5,15 +5,15 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
-	gammtypes "github.com/osmosis-labs/osmosis/v13/x/gamm/types"
 	"github.com/osmosis-labs/osmosis/v13/x/protorev/types"
+	swaproutertypes "github.com/osmosis-labs/osmosis/v13/x/swaprouter/types"
 )
 
 type TradeInfo struct {
 	InputDenom  string
 	OutputDenom string
 	SwapFee     sdk.Dec
-	Pool        gammtypes.CFMMPoolI
+	Pool        swaproutertypes.PoolI
 }
 
 type Route struct {
@@ -183,9 +183,9 @@ func (k Keeper) BuildTradeInfoRoute(ctx sdk.Context, swapDenom, tokenIn, tokenOu
 	return Route{Trades: []TradeInfo{entryTrade, middleTrade, exitTrade}}, nil
 }
 
-// GetAndCheckPool retrieves the pool from the x/gamm module given a poolId and ensures that the pool can be traded on
-func (k Keeper) GetAndCheckPool(ctx sdk.Context, poolId uint64) (gammtypes.CFMMPoolI, error) {
-	pool, err := k.gammKeeper.GetPoolAndPoke(ctx, poolId)
+// GetAndCheckPool retrieves the pool from x/swaprouter given a poolId and ensures that the pool can be traded on
+func (k Keeper) GetAndCheckPool(ctx sdk.Context, poolId uint64) (swaproutertypes.PoolI, error) {
+	pool, err := k.swaprouterKeeper.GetPool(ctx, poolId)
 	if err != nil {
 		return pool, err
 	}
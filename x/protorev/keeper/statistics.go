@@ -0,0 +1,191 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v13/x/protorev/types"
+)
+
+// ---------------------- Statistics Stores  ---------------------- //
+
+// GetNumberOfTrades returns the number of trades executed by the module
+func (k Keeper) GetNumberOfTrades(ctx sdk.Context) (sdk.Int, error) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixNumberOfTrades)
+
+	bz := store.Get(types.KeyPrefixNumberOfTrades)
+	if len(bz) == 0 {
+		return sdk.ZeroInt(), fmt.Errorf("no trades have been executed by the protorev module")
+	}
+
+	trades := sdk.Int{}
+	if err := trades.Unmarshal(bz); err != nil {
+		return sdk.ZeroInt(), err
+	}
+
+	return trades, nil
+}
+
+// IncrementNumberOfTrades increments the number of trades executed by the module
+func (k Keeper) IncrementNumberOfTrades(ctx sdk.Context) error {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixNumberOfTrades)
+
+	numberOfTrades, _ := k.GetNumberOfTrades(ctx)
+	numberOfTrades = numberOfTrades.Add(sdk.OneInt())
+
+	bz, err := numberOfTrades.Marshal()
+	if err != nil {
+		return err
+	}
+
+	store.Set(types.KeyPrefixNumberOfTrades, bz)
+	return nil
+}
+
+// GetProfitsByDenom returns the profits made by the module for a given denom
+func (k Keeper) GetProfitsByDenom(ctx sdk.Context, denom string) (sdk.Coin, error) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixProfitsByDenom)
+	key := types.GetKeyPrefixProfitByDenom(denom)
+
+	bz := store.Get(key)
+	if len(bz) == 0 {
+		return sdk.NewCoin(denom, sdk.ZeroInt()), fmt.Errorf("no profits for denom %s", denom)
+	}
+
+	profits := sdk.Coin{}
+	if err := profits.Unmarshal(bz); err != nil {
+		return sdk.NewCoin(denom, sdk.ZeroInt()), err
+	}
+
+	return profits, nil
+}
+
+// GetAllProfits returns all of the profits made by the module
+func (k Keeper) GetAllProfits(ctx sdk.Context) ([]sdk.Coin, error) {
+	profits := make([]sdk.Coin, 0)
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyPrefixProfitsByDenom)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		profit := sdk.Coin{}
+		if err := profit.Unmarshal(iterator.Value()); err != nil {
+			return nil, err
+		}
+
+		profits = append(profits, profit)
+	}
+
+	return profits, nil
+}
+
+// UpdateProfitsByDenom adds the given profit to the profits made by the module for its denom
+func (k Keeper) UpdateProfitsByDenom(ctx sdk.Context, profit sdk.Coin) error {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixProfitsByDenom)
+	key := types.GetKeyPrefixProfitByDenom(profit.Denom)
+
+	profits, _ := k.GetProfitsByDenom(ctx, profit.Denom)
+	profits = profits.Add(profit)
+
+	bz, err := profits.Marshal()
+	if err != nil {
+		return err
+	}
+
+	store.Set(key, bz)
+	return nil
+}
+
+// GetPoolStatistics returns the number of trades and profits made by the module
+// after swaps on the given pool
+func (k Keeper) GetPoolStatistics(ctx sdk.Context, poolId uint64) (*types.PoolStatistics, error) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixPoolStatistics)
+	key := types.GetKeyPrefixPoolStatistics(poolId)
+
+	bz := store.Get(key)
+	if len(bz) == 0 {
+		return nil, fmt.Errorf("no statistics for pool %d", poolId)
+	}
+
+	statistics := &types.PoolStatistics{}
+	if err := statistics.Unmarshal(bz); err != nil {
+		return nil, err
+	}
+
+	return statistics, nil
+}
+
+// GetAllPoolStatistics returns the statistics of all pools the module has executed trades after
+func (k Keeper) GetAllPoolStatistics(ctx sdk.Context) ([]types.PoolStatistics, error) {
+	statistics := make([]types.PoolStatistics, 0)
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyPrefixPoolStatistics)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		poolStatistics := types.PoolStatistics{}
+		if err := poolStatistics.Unmarshal(iterator.Value()); err != nil {
+			return nil, err
+		}
+
+		statistics = append(statistics, poolStatistics)
+	}
+
+	return statistics, nil
+}
+
+// UpdatePoolStatistics increments the number of trades and adds the given profit
+// to the statistics of the given pool
+func (k Keeper) UpdatePoolStatistics(ctx sdk.Context, poolId uint64, profit sdk.Coin) error {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixPoolStatistics)
+	key := types.GetKeyPrefixPoolStatistics(poolId)
+
+	statistics, err := k.GetPoolStatistics(ctx, poolId)
+	if err != nil {
+		statistics = &types.PoolStatistics{
+			Profits:        []*sdk.Coin{},
+			NumberOfTrades: sdk.ZeroInt(),
+			PoolId:         poolId,
+		}
+	}
+
+	statistics.NumberOfTrades = statistics.NumberOfTrades.Add(sdk.OneInt())
+
+	updated := false
+	for _, coin := range statistics.Profits {
+		if coin.Denom == profit.Denom {
+			*coin = coin.Add(profit)
+			updated = true
+			break
+		}
+	}
+	if !updated {
+		statistics.Profits = append(statistics.Profits, &profit)
+	}
+
+	bz, err := statistics.Marshal()
+	if err != nil {
+		return err
+	}
+
+	store.Set(key, bz)
+	return nil
+}
+
+// UpdateStatistics updates all of the statistics stores after a trade was executed
+// after a swap on the given pool
+func (k Keeper) UpdateStatistics(ctx sdk.Context, poolId uint64, profit sdk.Coin) error {
+	if err := k.IncrementNumberOfTrades(ctx); err != nil {
+		return err
+	}
+
+	if err := k.UpdateProfitsByDenom(ctx, profit); err != nil {
+		return err
+	}
+
+	return k.UpdatePoolStatistics(ctx, poolId, profit)
+}
@@ -0,0 +1,56 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v13/x/protorev/types"
+)
+
+func (suite *KeeperTestSuite) TestUpdateStatistics() {
+	// No statistics are stored before any trade is executed
+	_, err := suite.App.ProtoRevKeeper.GetNumberOfTrades(suite.Ctx)
+	suite.Require().Error(err)
+	_, err = suite.App.ProtoRevKeeper.GetProfitsByDenom(suite.Ctx, types.OsmosisDenomination)
+	suite.Require().Error(err)
+	_, err = suite.App.ProtoRevKeeper.GetPoolStatistics(suite.Ctx, 1)
+	suite.Require().Error(err)
+
+	osmoProfit := sdk.NewCoin(types.OsmosisDenomination, sdk.NewInt(100))
+	atomProfit := sdk.NewCoin(types.AtomDenomination, sdk.NewInt(50))
+	suite.Require().NoError(suite.App.ProtoRevKeeper.UpdateStatistics(suite.Ctx, 1, osmoProfit))
+	suite.Require().NoError(suite.App.ProtoRevKeeper.UpdateStatistics(suite.Ctx, 1, atomProfit))
+	suite.Require().NoError(suite.App.ProtoRevKeeper.UpdateStatistics(suite.Ctx, 1, osmoProfit))
+	suite.Require().NoError(suite.App.ProtoRevKeeper.UpdateStatistics(suite.Ctx, 2, atomProfit))
+
+	numberOfTrades, err := suite.App.ProtoRevKeeper.GetNumberOfTrades(suite.Ctx)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewInt(4), numberOfTrades)
+
+	profits, err := suite.App.ProtoRevKeeper.GetProfitsByDenom(suite.Ctx, types.OsmosisDenomination)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewCoin(types.OsmosisDenomination, sdk.NewInt(200)), profits)
+
+	allProfits, err := suite.App.ProtoRevKeeper.GetAllProfits(suite.Ctx)
+	suite.Require().NoError(err)
+	suite.Require().ElementsMatch([]sdk.Coin{
+		sdk.NewCoin(types.OsmosisDenomination, sdk.NewInt(200)),
+		sdk.NewCoin(types.AtomDenomination, sdk.NewInt(100)),
+	}, allProfits)
+
+	pool1Osmo := sdk.NewCoin(types.OsmosisDenomination, sdk.NewInt(200))
+	pool1Atom := sdk.NewCoin(types.AtomDenomination, sdk.NewInt(50))
+	pool1Statistics, err := suite.App.ProtoRevKeeper.GetPoolStatistics(suite.Ctx, 1)
+	suite.Require().NoError(err)
+	suite.Require().Equal(&types.PoolStatistics{
+		Profits:        []*sdk.Coin{&pool1Osmo, &pool1Atom},
+		NumberOfTrades: sdk.NewInt(3),
+		PoolId:         1,
+	}, pool1Statistics)
+
+	allStatistics, err := suite.App.ProtoRevKeeper.GetAllPoolStatistics(suite.Ctx)
+	suite.Require().NoError(err)
+	suite.Require().Equal(2, len(allStatistics))
+	suite.Require().Equal(uint64(1), allStatistics[0].PoolId)
+	suite.Require().Equal(uint64(2), allStatistics[1].PoolId)
+	suite.Require().Equal(sdk.OneInt(), allStatistics[1].NumberOfTrades)
+}
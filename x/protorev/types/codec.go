@@ -15,8 +15,11 @@ var (
 )
 
 const (
-	setHotRoutes        = "osmosis/MsgSetHotRoutes"
-	setDeveloperAccount = "osmosis/MsgSetDeveloperAccount"
+	setHotRoutes             = "osmosis/MsgSetHotRoutes"
+	setDeveloperAccount      = "osmosis/MsgSetDeveloperAccount"
+	setMaxPoolPointsPerTx    = "osmosis/MsgSetMaxPoolPointsPerTx"
+	setMaxPoolPointsPerBlock = "osmosis/MsgSetMaxPoolPointsPerBlock"
+	setPoolWeights           = "osmosis/MsgSetPoolWeights"
 )
 
 func init() {
@@ -27,12 +30,18 @@ func init() {
 func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgSetHotRoutes{}, setHotRoutes, nil)
 	cdc.RegisterConcrete(&MsgSetDeveloperAccount{}, setDeveloperAccount, nil)
+	cdc.RegisterConcrete(&MsgSetMaxPoolPointsPerTx{}, setMaxPoolPointsPerTx, nil)
+	cdc.RegisterConcrete(&MsgSetMaxPoolPointsPerBlock{}, setMaxPoolPointsPerBlock, nil)
+	cdc.RegisterConcrete(&MsgSetPoolWeights{}, setPoolWeights, nil)
 }
 
 func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgSetHotRoutes{},
 		&MsgSetDeveloperAccount{},
+		&MsgSetMaxPoolPointsPerTx{},
+		&MsgSetMaxPoolPointsPerBlock{},
+		&MsgSetPoolWeights{},
 	)
 	registry.RegisterImplementations(
 		(*govtypes.Content)(nil),
@@ -0,0 +1,19 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// StepSize is the granularity of the input amounts searched when finding the optimal input amount of
+// a cyclic arbitrage route. All searched input amounts are multiples of StepSize.
+var StepSize = sdk.NewInt(1_000_000)
+
+// MaxInputAmount is the max input amount searched, in multiples of StepSize.
+var MaxInputAmount = sdk.NewInt(5_000)
+
+// MaxIterations is the max number of binary search iterations used to find the optimal input amount.
+// It must be at least log2(MaxInputAmount) + 1.
+const MaxIterations = 20
+
+// DeveloperProfitShare is the share of the profit of each trade that is sent to the developer account, if it is set.
+var DeveloperProfitShare = sdk.NewDecWithPrec(20, 2)
@@ -0,0 +1,10 @@
+package types
+
+const (
+	TypeEvtBackrun = "protorev_backrun"
+
+	AttributeValueCategory = ModuleName
+	AttributeKeyUserPoolId = "user_pool_id"
+	AttributeKeyTokenIn    = "token_in"
+	AttributeKeyProfit     = "profit"
+)
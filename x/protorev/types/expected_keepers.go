@@ -4,7 +4,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	epochtypes "github.com/osmosis-labs/osmosis/v13/x/epochs/types"
-	gammtypes "github.com/osmosis-labs/osmosis/v13/x/gamm/types"
+	swaproutertypes "github.com/osmosis-labs/osmosis/v13/x/swaprouter/types"
 )
 
 // AccountKeeper defines the account contract that must be fulfilled when
@@ -21,14 +21,15 @@ type BankKeeper interface {
 	BurnCoins(ctx sdk.Context, name string, amt sdk.Coins) error
 }
 
-// GAMMKeeper defines the Gamm contract that must be fulfilled when
+// SwapRouterKeeper defines the SwapRouter contract that must be fulfilled when
 // creating a x/protorev keeper.
-type GAMMKeeper interface {
-	GetPoolAndPoke(ctx sdk.Context, poolId uint64) (gammtypes.CFMMPoolI, error)
-	GetPoolsAndPoke(ctx sdk.Context) (res []gammtypes.CFMMPoolI, err error)
-	GetPoolDenoms(ctx sdk.Context, poolId uint64) ([]string, error)
-	SwapExactAmountIn(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, tokenIn sdk.Coin, tokenOutDenom string, tokenOutMinAmount sdk.Int) (sdk.Int, error)
-	MultihopSwapExactAmountIn(ctx sdk.Context, sender sdk.AccAddress, routes []gammtypes.SwapAmountInRoute, tokenIn sdk.Coin, tokenOutMinAmount sdk.Int) (tokenOutAmount sdk.Int, err error)
+type SwapRouterKeeper interface {
+	GetPool(ctx sdk.Context, poolId uint64) (swaproutertypes.PoolI, error)
+	AllPools(ctx sdk.Context) ([]swaproutertypes.PoolI, error)
+	GetPoolType(ctx sdk.Context, poolId uint64) (swaproutertypes.PoolType, error)
+	RouteExactAmountIn(ctx sdk.Context, sender sdk.AccAddress, routes []swaproutertypes.SwapAmountInRoute, tokenIn sdk.Coin, tokenOutMinAmount sdk.Int) (tokenOutAmount sdk.Int, err error)
+	MultihopEstimateOutGivenExactAmountIn(ctx sdk.Context, routes []swaproutertypes.SwapAmountInRoute, tokenIn sdk.Coin) (tokenOutAmount sdk.Int, err error)
+	RouteCalculateSpotPrice(ctx sdk.Context, poolId uint64, quoteAssetDenom string, baseAssetDenom string) (spotPrice sdk.Dec, err error)
 }
 
 // EpochKeeper defines the Epoch contract that must be fulfilled when
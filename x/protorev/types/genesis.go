@@ -6,10 +6,6 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
-// InputAmountList contains a list of input amounts to test when creating the optimal amount to swap in the
-// binary search method
-var InputAmountList []sdk.Int
-
 // AtomDenomination stores the native denom name for Atom on chain used for route building
 var AtomDenomination string = "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2"
 
@@ -36,15 +32,14 @@ func (gs GenesisState) Validate() error {
 		return err
 	}
 
-	return gs.Params.Validate()
-}
-
-func init() {
-	// Init all of the input amounts
-	InputAmountList = make([]sdk.Int, 0)
-	for i := 0; i < 1000000000; i += 100000 {
-		InputAmountList = append(InputAmountList, sdk.NewInt(int64(i)))
+	// Validate the developer account if it is set
+	if gs.DeveloperAccount != "" {
+		if _, err := sdk.AccAddressFromBech32(gs.DeveloperAccount); err != nil {
+			return fmt.Errorf("invalid developer account address (must be bech32): %w", err)
+		}
 	}
+
+	return gs.Params.Validate()
 }
 
 // Routes entered into the genesis state must start and end with the same denomination and
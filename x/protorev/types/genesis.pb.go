@@ -29,6 +29,8 @@ type GenesisState struct {
 	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
 	// Hot routes that are configured on genesis
 	TokenPairs []TokenPairArbRoutes `protobuf:"bytes,2,rep,name=token_pairs,json=tokenPairs,proto3" json:"token_pairs"`
+	// The account that receives a portion of the profits of the module
+	DeveloperAccount string `protobuf:"bytes,3,opt,name=developer_account,json=developerAccount,proto3" json:"developer_account,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -78,6 +80,13 @@ func (m *GenesisState) GetTokenPairs() []TokenPairArbRoutes {
 	return nil
 }
 
+func (m *GenesisState) GetDeveloperAccount() string {
+	if m != nil {
+		return m.DeveloperAccount
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "osmosis.protorev.v1beta1.GenesisState")
 }
@@ -87,24 +96,25 @@ func init() {
 }
 
 var fileDescriptor_3c77fc2da5752af2 = []byte{
-	// 260 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0xcb, 0x2f, 0xce, 0xcd,
-	0x2f, 0xce, 0x2c, 0xd6, 0x2f, 0x28, 0xca, 0x2f, 0xc9, 0x2f, 0x4a, 0x2d, 0xd3, 0x2f, 0x33, 0x4c,
-	0x4a, 0x2d, 0x49, 0x34, 0xd4, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x03, 0x4b, 0x08,
-	0x49, 0x40, 0xd5, 0xe9, 0xc1, 0xd4, 0xe9, 0x41, 0xd5, 0x49, 0x89, 0xa4, 0xe7, 0xa7, 0xe7, 0x83,
-	0x45, 0xf5, 0x41, 0x2c, 0x88, 0x02, 0x29, 0x75, 0x9c, 0xe6, 0xc2, 0x0d, 0x80, 0x28, 0x54, 0xc5,
-	0xad, 0x30, 0xb1, 0x28, 0x31, 0x17, 0x6a, 0xa1, 0xd2, 0x62, 0x46, 0x2e, 0x1e, 0x77, 0x88, 0x8b,
-	0x82, 0x4b, 0x12, 0x4b, 0x52, 0x85, 0xec, 0xb8, 0xd8, 0x20, 0x0a, 0x24, 0x18, 0x15, 0x18, 0x35,
-	0xb8, 0x8d, 0x14, 0xf4, 0x70, 0xb9, 0x50, 0x2f, 0x00, 0xac, 0xce, 0x89, 0xe5, 0xc4, 0x3d, 0x79,
-	0x86, 0x20, 0xa8, 0x2e, 0xa1, 0x60, 0x2e, 0xee, 0x92, 0xfc, 0xec, 0xd4, 0xbc, 0xf8, 0x82, 0xc4,
-	0xcc, 0xa2, 0x62, 0x09, 0x26, 0x05, 0x66, 0x0d, 0x6e, 0x23, 0x1d, 0xdc, 0x86, 0x84, 0x80, 0x14,
-	0x07, 0x24, 0x66, 0x16, 0x39, 0x16, 0x25, 0x05, 0xe5, 0x97, 0x96, 0xa4, 0xc2, 0x0c, 0xe4, 0x2a,
-	0x81, 0xc9, 0x14, 0x3b, 0xf9, 0x9d, 0x78, 0x24, 0xc7, 0x78, 0xe1, 0x91, 0x1c, 0xe3, 0x83, 0x47,
-	0x72, 0x8c, 0x13, 0x1e, 0xcb, 0x31, 0x5c, 0x78, 0x2c, 0xc7, 0x70, 0xe3, 0xb1, 0x1c, 0x43, 0x94,
-	0x49, 0x7a, 0x66, 0x49, 0x46, 0x69, 0x92, 0x5e, 0x72, 0x7e, 0xae, 0x3e, 0xd4, 0x0e, 0xdd, 0x9c,
-	0xc4, 0xa4, 0x62, 0x18, 0x47, 0xbf, 0xcc, 0xd0, 0x58, 0xbf, 0x02, 0x11, 0x08, 0x25, 0x95, 0x05,
-	0xa9, 0xc5, 0x49, 0x6c, 0x60, 0xbe, 0x31, 0x20, 0x00, 0x00, 0xff, 0xff, 0x90, 0xb9, 0x5f, 0x13,
-	0xa6, 0x01, 0x00, 0x00,
+	// 288 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x90, 0x4f, 0x4b, 0xc3, 0x30,
+	0x18, 0xc6, 0x1b, 0x27, 0x03, 0x53, 0x0f, 0x5a, 0x3c, 0x94, 0x1d, 0x62, 0x11, 0xd4, 0x82, 0x9a,
+	0xd0, 0xcd, 0xb3, 0xb0, 0x5d, 0xbc, 0xc9, 0xe8, 0x3c, 0x79, 0x19, 0x69, 0x0d, 0xb5, 0xb8, 0xf6,
+	0x2d, 0x49, 0x5a, 0xf4, 0x5b, 0xf8, 0xb1, 0x76, 0xec, 0xd1, 0x93, 0x48, 0xfb, 0x45, 0x64, 0xfd,
+	0x33, 0x4f, 0xbd, 0xe5, 0x7d, 0x9f, 0x5f, 0x7e, 0x79, 0x08, 0xbe, 0x02, 0x95, 0x80, 0x8a, 0x15,
+	0xcb, 0x24, 0x68, 0x90, 0xa2, 0x60, 0x85, 0x17, 0x08, 0xcd, 0x3d, 0x16, 0x89, 0x54, 0xa8, 0x58,
+	0xd1, 0x26, 0xb0, 0xec, 0x8e, 0xa3, 0x3d, 0x47, 0x3b, 0x6e, 0x72, 0x16, 0x41, 0x04, 0xcd, 0x96,
+	0xed, 0x4e, 0x2d, 0x30, 0xb9, 0x1e, 0xf4, 0xee, 0x05, 0x2d, 0x78, 0x39, 0x0c, 0x72, 0xc9, 0x93,
+	0xee, 0xc1, 0x8b, 0x12, 0xe1, 0xe3, 0xc7, 0xb6, 0xd1, 0x4a, 0x73, 0x2d, 0xac, 0x07, 0x3c, 0x6e,
+	0x01, 0x1b, 0x39, 0xc8, 0x35, 0xa7, 0x0e, 0x1d, 0x6a, 0x48, 0x97, 0x0d, 0xb7, 0x38, 0xdc, 0xfe,
+	0x9c, 0x1b, 0x7e, 0x77, 0xcb, 0x5a, 0x61, 0x53, 0xc3, 0xbb, 0x48, 0xd7, 0x19, 0x8f, 0xa5, 0xb2,
+	0x0f, 0x9c, 0x91, 0x6b, 0x4e, 0x6f, 0x87, 0x25, 0xcf, 0x3b, 0x78, 0xc9, 0x63, 0x39, 0x97, 0x81,
+	0x0f, 0xb9, 0x16, 0xbd, 0x10, 0xeb, 0x3e, 0x51, 0xd6, 0x0d, 0x3e, 0x7d, 0x15, 0x85, 0xd8, 0x40,
+	0x26, 0xe4, 0x9a, 0x87, 0x21, 0xe4, 0xa9, 0xb6, 0x47, 0x0e, 0x72, 0x8f, 0xfc, 0x93, 0x7d, 0x30,
+	0x6f, 0xf7, 0x8b, 0xa7, 0x6d, 0x45, 0x50, 0x59, 0x11, 0xf4, 0x5b, 0x11, 0xf4, 0x55, 0x13, 0xa3,
+	0xac, 0x89, 0xf1, 0x5d, 0x13, 0xe3, 0xe5, 0x3e, 0x8a, 0xf5, 0x5b, 0x1e, 0xd0, 0x10, 0x12, 0xd6,
+	0x15, 0xba, 0xdb, 0xf0, 0x40, 0xf5, 0x03, 0x2b, 0xbc, 0x19, 0xfb, 0xf8, 0xff, 0x31, 0xfd, 0x99,
+	0x09, 0x15, 0x8c, 0x9b, 0x79, 0xf6, 0x37, 0x00, 0x71, 0x6d, 0xf6, 0x21, 0xd3, 0x01, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -127,6 +137,13 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.DeveloperAccount) > 0 {
+		i -= len(m.DeveloperAccount)
+		copy(dAtA[i:], m.DeveloperAccount)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.DeveloperAccount)))
+		i--
+		dAtA[i] = 0x1a
+	}
 	if len(m.TokenPairs) > 0 {
 		for iNdEx := len(m.TokenPairs) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -179,6 +196,10 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	l = len(m.DeveloperAccount)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
 	return n
 }
 
@@ -284,6 +305,38 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeveloperAccount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeveloperAccount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
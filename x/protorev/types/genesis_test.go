@@ -77,6 +77,43 @@ func TestGenesisStateValidate(t *testing.T) {
 			},
 			valid: false,
 		},
+		{
+			description: "Default parameters with valid developer account",
+			genState: &types.GenesisState{
+				Params:           types.DefaultParams(),
+				DeveloperAccount: createAccount().String(),
+			},
+			valid: true,
+		},
+		{
+			description: "Default parameters with invalid developer account",
+			genState: &types.GenesisState{
+				Params:           types.DefaultParams(),
+				DeveloperAccount: "developer",
+			},
+			valid: false,
+		},
+		{
+			description: "Invalid parameters (invalid admin)",
+			genState: &types.GenesisState{
+				Params: types.NewParams(true, "admin", types.DefaultMaxPoolPointsPerTx, types.DefaultMaxPoolPointsPerBlock, types.DefaultPoolWeights),
+			},
+			valid: false,
+		},
+		{
+			description: "Invalid parameters (max pool points per tx above max pool points per block)",
+			genState: &types.GenesisState{
+				Params: types.NewParams(true, createAccount().String(), 101, 100, types.DefaultPoolWeights),
+			},
+			valid: false,
+		},
+		{
+			description: "Invalid parameters (zero pool weight)",
+			genState: &types.GenesisState{
+				Params: types.NewParams(true, createAccount().String(), types.DefaultMaxPoolPointsPerTx, types.DefaultMaxPoolPointsPerBlock, types.PoolWeights{StableWeight: 1}),
+			},
+			valid: false,
+		},
 	}
 
 	for _, tc := range cases {
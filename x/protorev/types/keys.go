@@ -1,5 +1,9 @@
 package types
 
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
 const (
 	// ModuleName defines the module name
 	ModuleName = "protorev"
@@ -15,6 +19,11 @@ const (
 	prefixTokenPairRoutes = iota + 1
 	prefixOsmoPools
 	prefixAtomPools
+	prefixNumberOfTrades
+	prefixProfitsByDenom
+	prefixPoolStatistics
+	prefixDeveloperAccount
+	prefixBlockRouteUsage
 )
 
 var (
@@ -27,6 +36,24 @@ var (
 
 	// KeyPrefixAtomPools is the prefix for the atom pool store
 	KeyPrefixAtomPools = []byte{prefixAtomPools}
+
+	// -------------- Keys for statistics stores -------------- //
+	// KeyPrefixNumberOfTrades is the prefix for the store that keeps track of the number of trades executed
+	KeyPrefixNumberOfTrades = []byte{prefixNumberOfTrades}
+
+	// KeyPrefixProfitsByDenom is the prefix for the store that keeps track of the profits made by denom
+	KeyPrefixProfitsByDenom = []byte{prefixProfitsByDenom}
+
+	// KeyPrefixPoolStatistics is the prefix for the store that keeps track of the trades and profits by pool
+	KeyPrefixPoolStatistics = []byte{prefixPoolStatistics}
+
+	// -------------- Keys for configuration stores -------------- //
+	// KeyPrefixDeveloperAccount is the prefix for the store that keeps track of the developer account
+	KeyPrefixDeveloperAccount = []byte{prefixDeveloperAccount}
+
+	// KeyPrefixBlockRouteUsage is the prefix for the store that keeps track of the routes simulated
+	// and pool points consumed in the latest block
+	KeyPrefixBlockRouteUsage = []byte{prefixBlockRouteUsage}
 )
 
 // Returns the key needed to fetch the osmo pool for a given denom
@@ -43,3 +70,13 @@ func GetKeyPrefixAtomPool(denom string) []byte {
 func GetKeyPrefixRouteForTokenPair(tokenA, tokenB string) []byte {
 	return append(KeyPrefixTokenPairRoutes, []byte(tokenA+"|"+tokenB)...)
 }
+
+// Returns the key needed to fetch the profits made in a given denom
+func GetKeyPrefixProfitByDenom(denom string) []byte {
+	return append(KeyPrefixProfitsByDenom, []byte(denom)...)
+}
+
+// Returns the key needed to fetch the statistics of a given pool
+func GetKeyPrefixPoolStatistics(poolId uint64) []byte {
+	return append(KeyPrefixPoolStatistics, sdk.Uint64ToBigEndian(poolId)...)
+}
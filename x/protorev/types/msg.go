@@ -10,11 +10,17 @@ import (
 var (
 	_ sdk.Msg = &MsgSetHotRoutes{}
 	_ sdk.Msg = &MsgSetDeveloperAccount{}
+	_ sdk.Msg = &MsgSetMaxPoolPointsPerTx{}
+	_ sdk.Msg = &MsgSetMaxPoolPointsPerBlock{}
+	_ sdk.Msg = &MsgSetPoolWeights{}
 )
 
 const (
-	TypeMsgSetHotRoutes        = "set_hot_routes"
-	TypeMsgSetDeveloperAccount = "set_developer_account"
+	TypeMsgSetHotRoutes             = "set_hot_routes"
+	TypeMsgSetDeveloperAccount      = "set_developer_account"
+	TypeMsgSetMaxPoolPointsPerTx    = "set_max_pool_points_per_tx"
+	TypeMsgSetMaxPoolPointsPerBlock = "set_max_pool_points_per_block"
+	TypeMsgSetPoolWeights           = "set_pool_weights"
 )
 
 // ---------------------- Interface for MsgSetHotRoutes ---------------------- //
@@ -124,3 +130,126 @@ func (msg MsgSetDeveloperAccount) GetSigners() []sdk.AccAddress {
 	addr := sdk.MustAccAddressFromBech32(msg.Admin)
 	return []sdk.AccAddress{addr}
 }
+
+// ---------------------- Interface for MsgSetMaxPoolPointsPerTx ---------------------- //
+// NewMsgSetMaxPoolPointsPerTx creates a new MsgSetMaxPoolPointsPerTx instance
+func NewMsgSetMaxPoolPointsPerTx(admin string, maxPoolPointsPerTx uint64) *MsgSetMaxPoolPointsPerTx {
+	return &MsgSetMaxPoolPointsPerTx{
+		Admin:              admin,
+		MaxPoolPointsPerTx: maxPoolPointsPerTx,
+	}
+}
+
+// Route returns the name of the module
+func (msg MsgSetMaxPoolPointsPerTx) Route() string {
+	return RouterKey
+}
+
+// Type returns the type of the message
+func (msg MsgSetMaxPoolPointsPerTx) Type() string {
+	return TypeMsgSetMaxPoolPointsPerTx
+}
+
+// ValidateBasic validates the MsgSetMaxPoolPointsPerTx
+func (msg MsgSetMaxPoolPointsPerTx) ValidateBasic() error {
+	// Account must be a valid bech32 address
+	_, err := sdk.AccAddressFromBech32(msg.Admin)
+	if err != nil {
+		return sdkerrors.Wrap(err, "invalid admin address (must be bech32)")
+	}
+
+	return ValidateMaxPoolPoints(msg.MaxPoolPointsPerTx)
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgSetMaxPoolPointsPerTx) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgSetMaxPoolPointsPerTx) GetSigners() []sdk.AccAddress {
+	addr := sdk.MustAccAddressFromBech32(msg.Admin)
+	return []sdk.AccAddress{addr}
+}
+
+// ---------------------- Interface for MsgSetMaxPoolPointsPerBlock ---------------------- //
+// NewMsgSetMaxPoolPointsPerBlock creates a new MsgSetMaxPoolPointsPerBlock instance
+func NewMsgSetMaxPoolPointsPerBlock(admin string, maxPoolPointsPerBlock uint64) *MsgSetMaxPoolPointsPerBlock {
+	return &MsgSetMaxPoolPointsPerBlock{
+		Admin:                 admin,
+		MaxPoolPointsPerBlock: maxPoolPointsPerBlock,
+	}
+}
+
+// Route returns the name of the module
+func (msg MsgSetMaxPoolPointsPerBlock) Route() string {
+	return RouterKey
+}
+
+// Type returns the type of the message
+func (msg MsgSetMaxPoolPointsPerBlock) Type() string {
+	return TypeMsgSetMaxPoolPointsPerBlock
+}
+
+// ValidateBasic validates the MsgSetMaxPoolPointsPerBlock
+func (msg MsgSetMaxPoolPointsPerBlock) ValidateBasic() error {
+	// Account must be a valid bech32 address
+	_, err := sdk.AccAddressFromBech32(msg.Admin)
+	if err != nil {
+		return sdkerrors.Wrap(err, "invalid admin address (must be bech32)")
+	}
+
+	return ValidateMaxPoolPoints(msg.MaxPoolPointsPerBlock)
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgSetMaxPoolPointsPerBlock) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgSetMaxPoolPointsPerBlock) GetSigners() []sdk.AccAddress {
+	addr := sdk.MustAccAddressFromBech32(msg.Admin)
+	return []sdk.AccAddress{addr}
+}
+
+// ---------------------- Interface for MsgSetPoolWeights ---------------------- //
+// NewMsgSetPoolWeights creates a new MsgSetPoolWeights instance
+func NewMsgSetPoolWeights(admin string, poolWeights PoolWeights) *MsgSetPoolWeights {
+	return &MsgSetPoolWeights{
+		Admin:       admin,
+		PoolWeights: poolWeights,
+	}
+}
+
+// Route returns the name of the module
+func (msg MsgSetPoolWeights) Route() string {
+	return RouterKey
+}
+
+// Type returns the type of the message
+func (msg MsgSetPoolWeights) Type() string {
+	return TypeMsgSetPoolWeights
+}
+
+// ValidateBasic validates the MsgSetPoolWeights
+func (msg MsgSetPoolWeights) ValidateBasic() error {
+	// Account must be a valid bech32 address
+	_, err := sdk.AccAddressFromBech32(msg.Admin)
+	if err != nil {
+		return sdkerrors.Wrap(err, "invalid admin address (must be bech32)")
+	}
+
+	return ValidatePoolWeights(msg.PoolWeights)
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgSetPoolWeights) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgSetPoolWeights) GetSigners() []sdk.AccAddress {
+	addr := sdk.MustAccAddressFromBech32(msg.Admin)
+	return []sdk.AccAddress{addr}
+}
@@ -130,6 +130,96 @@ func (suite *MsgsTestSuite) TestMsgSetDeveloperAccount() {
 	}
 }
 
+func (suite *MsgsTestSuite) TestMsgSetMaxPoolPoints() {
+	cases := []struct {
+		description   string
+		admin         string
+		maxPoolPoints uint64
+		pass          bool
+	}{
+		{
+			"Invalid message (invalid admin)",
+			"admin",
+			10,
+			false,
+		},
+		{
+			"Invalid message (zero max pool points)",
+			createAccount().String(),
+			0,
+			false,
+		},
+		{
+			"Valid message",
+			createAccount().String(),
+			10,
+			true,
+		},
+	}
+
+	for _, tc := range cases {
+		suite.Run(tc.description, func() {
+			for _, msg := range []sdk.Msg{
+				types.NewMsgSetMaxPoolPointsPerTx(tc.admin, tc.maxPoolPoints),
+				types.NewMsgSetMaxPoolPointsPerBlock(tc.admin, tc.maxPoolPoints),
+			} {
+				err := msg.ValidateBasic()
+				if tc.pass {
+					suite.Require().NoError(err)
+				} else {
+					suite.Require().Error(err)
+				}
+			}
+		})
+	}
+}
+
+func (suite *MsgsTestSuite) TestMsgSetPoolWeights() {
+	cases := []struct {
+		description string
+		admin       string
+		poolWeights types.PoolWeights
+		pass        bool
+	}{
+		{
+			"Invalid message (invalid admin)",
+			"admin",
+			types.DefaultPoolWeights,
+			false,
+		},
+		{
+			"Invalid message (zero stable weight)",
+			createAccount().String(),
+			types.PoolWeights{StableWeight: 0, BalancerWeight: 1},
+			false,
+		},
+		{
+			"Invalid message (zero balancer weight)",
+			createAccount().String(),
+			types.PoolWeights{StableWeight: 1, BalancerWeight: 0},
+			false,
+		},
+		{
+			"Valid message",
+			createAccount().String(),
+			types.PoolWeights{StableWeight: 3, BalancerWeight: 1},
+			true,
+		},
+	}
+
+	for _, tc := range cases {
+		suite.Run(tc.description, func() {
+			msg := types.NewMsgSetPoolWeights(tc.admin, tc.poolWeights)
+			err := msg.ValidateBasic()
+			if tc.pass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
 func createAccount() sdk.AccAddress {
 	pk := ed25519.GenPrivKey().PubKey()
 	return sdk.AccAddress(pk.Address())
@@ -3,13 +3,25 @@ package types
 import (
 	"fmt"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 )
 
 var (
-	DefaultEnableModule = true
+	DefaultEnableModule          = true
+	DefaultAdmin                 = ""
+	DefaultMaxPoolPointsPerTx    = uint64(18)
+	DefaultMaxPoolPointsPerBlock = uint64(100)
+	DefaultPoolWeights           = PoolWeights{
+		StableWeight:   5,
+		BalancerWeight: 2,
+	}
 
-	ParamStoreKeyEnableModule = []byte("EnableProtoRevModule")
+	ParamStoreKeyEnableModule          = []byte("EnableProtoRevModule")
+	ParamStoreKeyAdmin                 = []byte("Admin")
+	ParamStoreKeyMaxPoolPointsPerTx    = []byte("MaxPoolPointsPerTx")
+	ParamStoreKeyMaxPoolPointsPerBlock = []byte("MaxPoolPointsPerBlock")
+	ParamStoreKeyPoolWeights           = []byte("PoolWeights")
 )
 
 // ParamKeyTable the param key table for launch module
@@ -18,27 +30,51 @@ func ParamKeyTable() paramtypes.KeyTable {
 }
 
 // NewParams creates a new Params instance
-func NewParams(enable bool) Params {
+func NewParams(enable bool, admin string, maxPoolPointsPerTx, maxPoolPointsPerBlock uint64, poolWeights PoolWeights) Params {
 	return Params{
-		Enabled: enable,
+		Enabled:               enable,
+		Admin:                 admin,
+		MaxPoolPointsPerTx:    maxPoolPointsPerTx,
+		MaxPoolPointsPerBlock: maxPoolPointsPerBlock,
+		PoolWeights:           poolWeights,
 	}
 }
 
 // DefaultParams returns a default set of parameters
 func DefaultParams() Params {
-	return NewParams(DefaultEnableModule)
+	return NewParams(DefaultEnableModule, DefaultAdmin, DefaultMaxPoolPointsPerTx, DefaultMaxPoolPointsPerBlock, DefaultPoolWeights)
 }
 
 // ParamSetPairs get the params.ParamSet
 func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 	return paramtypes.ParamSetPairs{
 		paramtypes.NewParamSetPair(ParamStoreKeyEnableModule, &p.Enabled, ValidateBoolean),
+		paramtypes.NewParamSetPair(ParamStoreKeyAdmin, &p.Admin, ValidateAdmin),
+		paramtypes.NewParamSetPair(ParamStoreKeyMaxPoolPointsPerTx, &p.MaxPoolPointsPerTx, ValidateMaxPoolPoints),
+		paramtypes.NewParamSetPair(ParamStoreKeyMaxPoolPointsPerBlock, &p.MaxPoolPointsPerBlock, ValidateMaxPoolPoints),
+		paramtypes.NewParamSetPair(ParamStoreKeyPoolWeights, &p.PoolWeights, ValidatePoolWeights),
 	}
 }
 
 // Validate validates the set of params
 func (p Params) Validate() error {
-	return nil
+	if err := ValidateAdmin(p.Admin); err != nil {
+		return err
+	}
+
+	if err := ValidateMaxPoolPoints(p.MaxPoolPointsPerTx); err != nil {
+		return err
+	}
+
+	if err := ValidateMaxPoolPoints(p.MaxPoolPointsPerBlock); err != nil {
+		return err
+	}
+
+	if p.MaxPoolPointsPerTx > p.MaxPoolPointsPerBlock {
+		return fmt.Errorf("max pool points per tx (%d) must be less than or equal to max pool points per block (%d)", p.MaxPoolPointsPerTx, p.MaxPoolPointsPerBlock)
+	}
+
+	return ValidatePoolWeights(p.PoolWeights)
 }
 
 func ValidateBoolean(i interface{}) error {
@@ -48,3 +84,46 @@ func ValidateBoolean(i interface{}) error {
 	}
 	return nil
 }
+
+// ValidateAdmin validates that the admin is either unset or a valid bech32 address
+func ValidateAdmin(i interface{}) error {
+	admin, ok := i.(string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if admin == "" {
+		return nil
+	}
+
+	if _, err := sdk.AccAddressFromBech32(admin); err != nil {
+		return fmt.Errorf("invalid admin address (must be bech32): %w", err)
+	}
+	return nil
+}
+
+// ValidateMaxPoolPoints validates that a pool point budget is positive
+func ValidateMaxPoolPoints(i interface{}) error {
+	maxPoolPoints, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if maxPoolPoints == 0 {
+		return fmt.Errorf("max pool points must be positive")
+	}
+	return nil
+}
+
+// ValidatePoolWeights validates that every pool type has a positive weight
+func ValidatePoolWeights(i interface{}) error {
+	poolWeights, ok := i.(PoolWeights)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if poolWeights.StableWeight == 0 || poolWeights.BalancerWeight == 0 {
+		return fmt.Errorf("pool weights must be positive: %+v", poolWeights)
+	}
+	return nil
+}
@@ -27,6 +27,15 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type Params struct {
 	// Boolean whether the module is going to be enabled
 	Enabled bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// The admin account that is authorized to set the hot routes, the developer
+	// account and the pool point budgets. Set through governance.
+	Admin string `protobuf:"bytes,2,opt,name=admin,proto3" json:"admin,omitempty" yaml:"admin"`
+	// The maximum number of pool points that can be consumed per transaction
+	MaxPoolPointsPerTx uint64 `protobuf:"varint,3,opt,name=max_pool_points_per_tx,json=maxPoolPointsPerTx,proto3" json:"max_pool_points_per_tx,omitempty" yaml:"max_pool_points_per_tx"`
+	// The maximum number of pool points that can be consumed per block
+	MaxPoolPointsPerBlock uint64 `protobuf:"varint,4,opt,name=max_pool_points_per_block,json=maxPoolPointsPerBlock,proto3" json:"max_pool_points_per_block,omitempty" yaml:"max_pool_points_per_block"`
+	// The number of pool points each pool type costs to simulate
+	PoolWeights PoolWeights `protobuf:"bytes,5,opt,name=pool_weights,json=poolWeights,proto3" json:"pool_weights" yaml:"pool_weights"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -69,6 +78,34 @@ func (m *Params) GetEnabled() bool {
 	return false
 }
 
+func (m *Params) GetAdmin() string {
+	if m != nil {
+		return m.Admin
+	}
+	return ""
+}
+
+func (m *Params) GetMaxPoolPointsPerTx() uint64 {
+	if m != nil {
+		return m.MaxPoolPointsPerTx
+	}
+	return 0
+}
+
+func (m *Params) GetMaxPoolPointsPerBlock() uint64 {
+	if m != nil {
+		return m.MaxPoolPointsPerBlock
+	}
+	return 0
+}
+
+func (m *Params) GetPoolWeights() PoolWeights {
+	if m != nil {
+		return m.PoolWeights
+	}
+	return PoolWeights{}
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "osmosis.protorev.v1beta1.Params")
 }
@@ -78,19 +115,30 @@ func init() {
 }
 
 var fileDescriptor_72168e5a5a65ae7e = []byte{
-	// 177 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0xcd, 0x2f, 0xce, 0xcd,
-	0x2f, 0xce, 0x2c, 0xd6, 0x2f, 0x28, 0xca, 0x2f, 0xc9, 0x2f, 0x4a, 0x2d, 0xd3, 0x2f, 0x33, 0x4c,
-	0x4a, 0x2d, 0x49, 0x34, 0xd4, 0x2f, 0x48, 0x2c, 0x4a, 0xcc, 0x2d, 0xd6, 0x03, 0x8b, 0x0b, 0x49,
-	0x40, 0x95, 0xe9, 0xc1, 0x94, 0xe9, 0x41, 0x95, 0x49, 0x89, 0xa4, 0xe7, 0xa7, 0xe7, 0x83, 0x45,
-	0xf5, 0x41, 0x2c, 0x88, 0x02, 0x25, 0x25, 0x2e, 0xb6, 0x00, 0xb0, 0x7e, 0x21, 0x09, 0x2e, 0xf6,
-	0xd4, 0xbc, 0xc4, 0xa4, 0x9c, 0xd4, 0x14, 0x09, 0x46, 0x05, 0x46, 0x0d, 0x8e, 0x20, 0x18, 0xd7,
-	0xc9, 0xef, 0xc4, 0x23, 0x39, 0xc6, 0x0b, 0x8f, 0xe4, 0x18, 0x1f, 0x3c, 0x92, 0x63, 0x9c, 0xf0,
-	0x58, 0x8e, 0xe1, 0xc2, 0x63, 0x39, 0x86, 0x1b, 0x8f, 0xe5, 0x18, 0xa2, 0x4c, 0xd2, 0x33, 0x4b,
-	0x32, 0x4a, 0x93, 0xf4, 0x92, 0xf3, 0x73, 0xf5, 0xa1, 0x16, 0xeb, 0xe6, 0x24, 0x26, 0x15, 0xc3,
-	0x38, 0xfa, 0x65, 0x86, 0xc6, 0xfa, 0x15, 0x08, 0x27, 0x97, 0x54, 0x16, 0xa4, 0x16, 0x27, 0xb1,
-	0x81, 0xf9, 0xc6, 0x80, 0x00, 0x00, 0x00, 0xff, 0xff, 0x4c, 0x45, 0xbb, 0x7a, 0xd3, 0x00, 0x00,
-	0x00,
+	// 354 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x91, 0xcf, 0x4e, 0xab, 0x40,
+	0x14, 0xc6, 0x99, 0xde, 0xb6, 0xf7, 0x5e, 0xda, 0x85, 0xc1, 0x3f, 0xc1, 0x1a, 0x01, 0x89, 0x55,
+	0x36, 0x42, 0x6a, 0x5d, 0xb9, 0xe4, 0x01, 0x0c, 0x21, 0x1a, 0x13, 0x17, 0x92, 0xa1, 0x9d, 0x50,
+	0x22, 0xd3, 0x99, 0x30, 0x63, 0xa5, 0x6f, 0xe1, 0x63, 0x75, 0xd9, 0xa5, 0x2b, 0x62, 0xda, 0x8d,
+	0x6b, 0x9e, 0xc0, 0x74, 0x28, 0xb1, 0x31, 0xed, 0xee, 0x9c, 0x8f, 0xdf, 0xf9, 0x7d, 0x09, 0x23,
+	0x77, 0x09, 0xc3, 0x84, 0xc5, 0xcc, 0xa1, 0x29, 0xe1, 0x24, 0x45, 0x13, 0x67, 0xd2, 0x0b, 0x11,
+	0x87, 0x3d, 0x87, 0xc2, 0x14, 0x62, 0x66, 0x8b, 0x5c, 0x51, 0xd7, 0x98, 0x5d, 0x61, 0xf6, 0x1a,
+	0xeb, 0x1c, 0x44, 0x24, 0x22, 0x22, 0x75, 0x56, 0x53, 0x09, 0x74, 0x2e, 0x77, 0x6b, 0x2b, 0x81,
+	0x18, 0xcc, 0xaf, 0x9a, 0xdc, 0xf4, 0x44, 0x93, 0xa2, 0xca, 0x7f, 0xd1, 0x18, 0x86, 0x09, 0x1a,
+	0xaa, 0xc0, 0x00, 0xd6, 0x3f, 0xbf, 0x5a, 0x95, 0x0b, 0xb9, 0x01, 0x87, 0x38, 0x1e, 0xab, 0x35,
+	0x03, 0x58, 0xff, 0xdd, 0xbd, 0x22, 0xd7, 0xdb, 0x53, 0x88, 0x93, 0x5b, 0x53, 0xc4, 0xa6, 0x5f,
+	0x7e, 0x56, 0x1e, 0xe4, 0x23, 0x0c, 0xb3, 0x80, 0x12, 0x92, 0x04, 0x94, 0xc4, 0x63, 0xce, 0x02,
+	0x8a, 0xd2, 0x80, 0x67, 0xea, 0x1f, 0x03, 0x58, 0x75, 0xf7, 0xac, 0xc8, 0xf5, 0xd3, 0xf2, 0x70,
+	0x3b, 0x67, 0xfa, 0x0a, 0x86, 0x99, 0x47, 0x48, 0xe2, 0x89, 0xd8, 0x43, 0xe9, 0x7d, 0xa6, 0x3c,
+	0xcb, 0xc7, 0xdb, 0xf0, 0x30, 0x21, 0x83, 0x17, 0xb5, 0x2e, 0xcc, 0xe7, 0x45, 0xae, 0x1b, 0xbb,
+	0xcd, 0x02, 0x35, 0xfd, 0xc3, 0xdf, 0x72, 0x77, 0x95, 0x2b, 0x48, 0x6e, 0x8b, 0x83, 0x37, 0x14,
+	0x47, 0x23, 0xce, 0xd4, 0x86, 0x01, 0xac, 0xd6, 0x75, 0xd7, 0xde, 0xf5, 0xcf, 0xed, 0x95, 0xe3,
+	0xb1, 0x84, 0xdd, 0x93, 0x59, 0xae, 0x4b, 0x45, 0xae, 0xef, 0x97, 0xed, 0x9b, 0x22, 0xd3, 0x6f,
+	0xd1, 0x0d, 0xf2, 0x6e, 0xb6, 0xd0, 0xc0, 0x7c, 0xa1, 0x81, 0xcf, 0x85, 0x06, 0xde, 0x97, 0x9a,
+	0x34, 0x5f, 0x6a, 0xd2, 0xc7, 0x52, 0x93, 0x9e, 0x6e, 0xa2, 0x98, 0x8f, 0x5e, 0x43, 0x7b, 0x40,
+	0xb0, 0xb3, 0x2e, 0xbd, 0x4a, 0x60, 0xc8, 0xaa, 0xc5, 0x99, 0xf4, 0xfa, 0x4e, 0xf6, 0xf3, 0x96,
+	0x7c, 0x4a, 0x11, 0x0b, 0x9b, 0x62, 0xef, 0x7f, 0x0f, 0x00, 0x9c, 0x4b, 0xf8, 0x2c, 0x43, 0x02,
+	0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -113,6 +161,33 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.PoolWeights.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.MaxPoolPointsPerBlock != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxPoolPointsPerBlock))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.MaxPoolPointsPerTx != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxPoolPointsPerTx))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Admin) > 0 {
+		i -= len(m.Admin)
+		copy(dAtA[i:], m.Admin)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.Admin)))
+		i--
+		dAtA[i] = 0x12
+	}
 	if m.Enabled {
 		i--
 		if m.Enabled {
@@ -146,6 +221,18 @@ func (m *Params) Size() (n int) {
 	if m.Enabled {
 		n += 2
 	}
+	l = len(m.Admin)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if m.MaxPoolPointsPerTx != 0 {
+		n += 1 + sovParams(uint64(m.MaxPoolPointsPerTx))
+	}
+	if m.MaxPoolPointsPerBlock != 0 {
+		n += 1 + sovParams(uint64(m.MaxPoolPointsPerBlock))
+	}
+	l = m.PoolWeights.Size()
+	n += 1 + l + sovParams(uint64(l))
 	return n
 }
 
@@ -204,6 +291,109 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.Enabled = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Admin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPoolPointsPerTx", wireType)
+			}
+			m.MaxPoolPointsPerTx = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxPoolPointsPerTx |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPoolPointsPerBlock", wireType)
+			}
+			m.MaxPoolPointsPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxPoolPointsPerBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolWeights", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PoolWeights.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
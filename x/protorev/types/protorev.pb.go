@@ -260,11 +260,192 @@ func (m *PoolStatistics) GetPoolId() uint64 {
 	return 0
 }
 
+// PoolWeights contains the number of pool points each pool type costs to
+// simulate. The cost of simulating a route is the sum of the weights of its
+// pools.
+type PoolWeights struct {
+	// The weight of a stableswap pool
+	StableWeight uint64 `protobuf:"varint,1,opt,name=stable_weight,json=stableWeight,proto3" json:"stable_weight,omitempty"`
+	// The weight of a balancer pool
+	BalancerWeight uint64 `protobuf:"varint,2,opt,name=balancer_weight,json=balancerWeight,proto3" json:"balancer_weight,omitempty"`
+}
+
+func (m *PoolWeights) Reset()         { *m = PoolWeights{} }
+func (m *PoolWeights) String() string { return proto.CompactTextString(m) }
+func (*PoolWeights) ProtoMessage()    {}
+func (*PoolWeights) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1e9f2391fd9fec01, []int{4}
+}
+func (m *PoolWeights) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PoolWeights) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PoolWeights.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PoolWeights) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PoolWeights.Merge(m, src)
+}
+func (m *PoolWeights) XXX_Size() int {
+	return m.Size()
+}
+func (m *PoolWeights) XXX_DiscardUnknown() {
+	xxx_messageInfo_PoolWeights.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PoolWeights proto.InternalMessageInfo
+
+func (m *PoolWeights) GetStableWeight() uint64 {
+	if m != nil {
+		return m.StableWeight
+	}
+	return 0
+}
+
+func (m *PoolWeights) GetBalancerWeight() uint64 {
+	if m != nil {
+		return m.BalancerWeight
+	}
+	return 0
+}
+
+// BlockRouteUsage tracks the routes simulated and the pool points consumed by
+// the module in a block
+type BlockRouteUsage struct {
+	// block_height is the height of the block
+	BlockHeight uint64 `protobuf:"varint,1,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	// number_of_routes is the number of routes simulated in the block
+	NumberOfRoutes uint64 `protobuf:"varint,2,opt,name=number_of_routes,json=numberOfRoutes,proto3" json:"number_of_routes,omitempty"`
+	// pool_points is the number of pool points consumed in the block
+	PoolPoints uint64 `protobuf:"varint,3,opt,name=pool_points,json=poolPoints,proto3" json:"pool_points,omitempty"`
+}
+
+func (m *BlockRouteUsage) Reset()         { *m = BlockRouteUsage{} }
+func (m *BlockRouteUsage) String() string { return proto.CompactTextString(m) }
+func (*BlockRouteUsage) ProtoMessage()    {}
+func (*BlockRouteUsage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1e9f2391fd9fec01, []int{5}
+}
+func (m *BlockRouteUsage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BlockRouteUsage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BlockRouteUsage.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BlockRouteUsage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockRouteUsage.Merge(m, src)
+}
+func (m *BlockRouteUsage) XXX_Size() int {
+	return m.Size()
+}
+func (m *BlockRouteUsage) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockRouteUsage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlockRouteUsage proto.InternalMessageInfo
+
+func (m *BlockRouteUsage) GetBlockHeight() uint64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+func (m *BlockRouteUsage) GetNumberOfRoutes() uint64 {
+	if m != nil {
+		return m.NumberOfRoutes
+	}
+	return 0
+}
+
+func (m *BlockRouteUsage) GetPoolPoints() uint64 {
+	if m != nil {
+		return m.PoolPoints
+	}
+	return 0
+}
+
+// BaseDenomPool is the pool with the highest liquidity between a base denom
+// (osmo or atom) and denom
+type BaseDenomPool struct {
+	// denom is the denom paired with the base denom
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// pool_id is the id of the pool
+	PoolId uint64 `protobuf:"varint,2,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+}
+
+func (m *BaseDenomPool) Reset()         { *m = BaseDenomPool{} }
+func (m *BaseDenomPool) String() string { return proto.CompactTextString(m) }
+func (*BaseDenomPool) ProtoMessage()    {}
+func (*BaseDenomPool) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1e9f2391fd9fec01, []int{6}
+}
+func (m *BaseDenomPool) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BaseDenomPool) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BaseDenomPool.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BaseDenomPool) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BaseDenomPool.Merge(m, src)
+}
+func (m *BaseDenomPool) XXX_Size() int {
+	return m.Size()
+}
+func (m *BaseDenomPool) XXX_DiscardUnknown() {
+	xxx_messageInfo_BaseDenomPool.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BaseDenomPool proto.InternalMessageInfo
+
+func (m *BaseDenomPool) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *BaseDenomPool) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*TokenPairArbRoutes)(nil), "osmosis.protorev.v1beta1.TokenPairArbRoutes")
 	proto.RegisterType((*Route)(nil), "osmosis.protorev.v1beta1.Route")
 	proto.RegisterType((*Trade)(nil), "osmosis.protorev.v1beta1.Trade")
 	proto.RegisterType((*PoolStatistics)(nil), "osmosis.protorev.v1beta1.PoolStatistics")
+	proto.RegisterType((*PoolWeights)(nil), "osmosis.protorev.v1beta1.PoolWeights")
+	proto.RegisterType((*BlockRouteUsage)(nil), "osmosis.protorev.v1beta1.BlockRouteUsage")
+	proto.RegisterType((*BaseDenomPool)(nil), "osmosis.protorev.v1beta1.BaseDenomPool")
 }
 
 func init() {
@@ -272,34 +453,42 @@ func init() {
 }
 
 var fileDescriptor_1e9f2391fd9fec01 = []byte{
-	// 428 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x52, 0xcf, 0x8a, 0xd3, 0x40,
-	0x18, 0xcf, 0xb8, 0xd9, 0xd6, 0x8e, 0xb0, 0xc8, 0x20, 0x98, 0xae, 0x90, 0x94, 0x3d, 0x68, 0x2f,
-	0x3b, 0xa1, 0x56, 0x10, 0x3c, 0x08, 0xae, 0x20, 0xf4, 0xe2, 0x2e, 0xe3, 0x1e, 0xd4, 0x4b, 0x98,
-	0x49, 0xd2, 0x3a, 0x6c, 0x9b, 0x2f, 0xcc, 0x4c, 0x8a, 0xbe, 0x85, 0xf8, 0x04, 0x3e, 0x86, 0x8f,
-	0xb0, 0xc7, 0x3d, 0x8a, 0x87, 0x45, 0xda, 0x8b, 0x8f, 0x21, 0x99, 0x99, 0x46, 0x2f, 0x82, 0xb0,
-	0xa7, 0x7c, 0xbf, 0x3f, 0xf3, 0x7d, 0xbf, 0x6f, 0x26, 0xf8, 0x11, 0xe8, 0x15, 0x68, 0xa9, 0xd3,
-	0x5a, 0x81, 0x01, 0x55, 0xae, 0xd3, 0xf5, 0x44, 0x94, 0x86, 0x4f, 0x3a, 0x82, 0xda, 0x82, 0x44,
-	0xde, 0x48, 0x3b, 0xde, 0x1b, 0x0f, 0x87, 0xb9, 0x95, 0x32, 0x2b, 0xa4, 0x0e, 0x38, 0xd7, 0xe1,
-	0xbd, 0x05, 0x2c, 0xc0, 0xf1, 0x6d, 0xe5, 0xd9, 0xd8, 0x79, 0x52, 0xc1, 0x75, 0xd9, 0x8d, 0xcb,
-	0x41, 0x56, 0x4e, 0x3f, 0xfa, 0x82, 0x30, 0x39, 0x87, 0x8b, 0xb2, 0x3a, 0xe3, 0x52, 0xbd, 0x50,
-	0x82, 0x41, 0x63, 0x4a, 0x4d, 0x9e, 0x63, 0xcc, 0x95, 0xc8, 0x94, 0x45, 0x11, 0x1a, 0xed, 0x8d,
-	0xef, 0x3c, 0x4e, 0xe8, 0xbf, 0x62, 0x51, 0x7b, 0x8a, 0x0d, 0x78, 0x77, 0x7e, 0x88, 0x6f, 0x9b,
-	0xb6, 0x6b, 0x26, 0xab, 0xe8, 0xd6, 0x08, 0x8d, 0x07, 0xac, 0x6f, 0xf1, 0xac, 0x22, 0x0f, 0xf0,
-	0xc0, 0x49, 0xd0, 0x98, 0x68, 0xcf, 0x6a, 0xce, 0x7b, 0xda, 0x98, 0x67, 0xe1, 0xaf, 0xaf, 0x09,
-	0x3a, 0x7a, 0x85, 0xf7, 0x6d, 0x1f, 0xf2, 0x14, 0xf7, 0x8c, 0xe2, 0xc5, 0xff, 0x44, 0x38, 0x6f,
-	0x7d, 0xcc, 0xdb, 0x7d, 0x9f, 0x77, 0x78, 0xdf, 0xd2, 0x84, 0xe0, 0xb0, 0x06, 0x58, 0x46, 0x68,
-	0x84, 0xc6, 0x21, 0xb3, 0xf5, 0x0d, 0x23, 0x7e, 0x43, 0xf8, 0xe0, 0x0c, 0x60, 0xf9, 0xc6, 0x70,
-	0x23, 0xb5, 0x91, 0xb9, 0x26, 0x53, 0xdc, 0xaf, 0x15, 0xcc, 0xa5, 0xd9, 0xa5, 0x1d, 0x52, 0xff,
-	0x40, 0xed, 0xe5, 0x77, 0x41, 0x5f, 0x82, 0xac, 0xd8, 0xce, 0x49, 0xde, 0xe2, 0xbb, 0x55, 0xb3,
-	0x12, 0xa5, 0xca, 0x60, 0x9e, 0xf9, 0x5d, 0x6d, 0x9a, 0x13, 0x7a, 0x79, 0x9d, 0x04, 0x3f, 0xae,
-	0x93, 0x87, 0x0b, 0x69, 0x3e, 0x34, 0x82, 0xe6, 0xb0, 0xf2, 0x0f, 0xee, 0x3f, 0xc7, 0xba, 0xb8,
-	0x48, 0xcd, 0xa7, 0xba, 0xd4, 0x74, 0x56, 0x19, 0x76, 0xe0, 0xfa, 0x9c, 0xce, 0xed, 0xca, 0x9a,
-	0xdc, 0xc7, 0xfd, 0x76, 0xcf, 0x4c, 0x16, 0x76, 0x85, 0x90, 0xf5, 0x5a, 0x38, 0x2b, 0x4e, 0x5e,
-	0x5f, 0x6e, 0x62, 0x74, 0xb5, 0x89, 0xd1, 0xcf, 0x4d, 0x8c, 0x3e, 0x6f, 0xe3, 0xe0, 0x6a, 0x1b,
-	0x07, 0xdf, 0xb7, 0x71, 0xf0, 0xfe, 0xc9, 0x5f, 0xa3, 0xfc, 0x45, 0x1f, 0x2f, 0xb9, 0xd0, 0x3b,
-	0x90, 0xae, 0x27, 0xd3, 0xf4, 0xe3, 0x9f, 0xdf, 0xd7, 0x0e, 0x17, 0x3d, 0x8b, 0xa7, 0xbf, 0x03,
-	0x00, 0x00, 0xff, 0xff, 0xfc, 0xf5, 0x62, 0xae, 0xdf, 0x02, 0x00, 0x00,
+	// 558 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x53, 0xcd, 0x8a, 0x13, 0x41,
+	0x10, 0xce, 0x64, 0xf3, 0x63, 0x2a, 0xbb, 0x59, 0x69, 0x16, 0x4c, 0x56, 0x98, 0xc4, 0x08, 0x6e,
+	0x2e, 0x3b, 0x43, 0x8c, 0x20, 0x78, 0x58, 0x30, 0x8a, 0x98, 0x8b, 0x1b, 0xc6, 0x15, 0x7f, 0x2e,
+	0x43, 0xcf, 0xa4, 0x93, 0x34, 0x99, 0x4c, 0x87, 0xe9, 0x4e, 0xd4, 0x93, 0xaf, 0x20, 0x3e, 0x81,
+	0x8f, 0xe1, 0x23, 0xec, 0x71, 0x8f, 0xe2, 0x61, 0x91, 0xe4, 0xe2, 0x63, 0xc8, 0x54, 0xf7, 0xc4,
+	0x5c, 0x04, 0xc1, 0xd3, 0xf4, 0xf7, 0xd5, 0xd7, 0x55, 0xf5, 0x55, 0xf5, 0xc0, 0x89, 0x90, 0x73,
+	0x21, 0xb9, 0x74, 0x17, 0x89, 0x50, 0x22, 0x61, 0x2b, 0x77, 0xd5, 0x0d, 0x98, 0xa2, 0xdd, 0x2d,
+	0xe1, 0xe0, 0x81, 0xd4, 0x8d, 0xd0, 0xd9, 0xf2, 0x46, 0x78, 0xdc, 0x08, 0x31, 0xe4, 0x63, 0xc0,
+	0xd5, 0x40, 0xab, 0x8e, 0x8f, 0x26, 0x62, 0x22, 0x34, 0x9f, 0x9e, 0x0c, 0x6b, 0x6b, 0x8d, 0x1b,
+	0x50, 0xc9, 0xb6, 0xe5, 0x42, 0xc1, 0x63, 0x1d, 0x6f, 0x7f, 0xb1, 0x80, 0x5c, 0x88, 0x19, 0x8b,
+	0x87, 0x94, 0x27, 0x8f, 0x93, 0xc0, 0x13, 0x4b, 0xc5, 0x24, 0x39, 0x03, 0xa0, 0x49, 0xe0, 0x27,
+	0x88, 0xea, 0x56, 0x6b, 0xaf, 0x53, 0xbd, 0xdf, 0x74, 0xfe, 0xd6, 0x96, 0x83, 0xb7, 0xbc, 0x0a,
+	0xdd, 0xde, 0x6f, 0xc0, 0x0d, 0x95, 0x66, 0xf5, 0x79, 0x5c, 0xcf, 0xb7, 0xac, 0x4e, 0xc5, 0x2b,
+	0x23, 0x1e, 0xc4, 0xe4, 0x36, 0x54, 0x74, 0x48, 0x2c, 0x55, 0x7d, 0x0f, 0x63, 0x5a, 0x7b, 0xbe,
+	0x54, 0x8f, 0x0a, 0xbf, 0xbe, 0x36, 0xad, 0xf6, 0x33, 0x28, 0x62, 0x1e, 0xf2, 0x10, 0x4a, 0x2a,
+	0xa1, 0xa3, 0x7f, 0x69, 0xe1, 0x22, 0xd5, 0x79, 0x46, 0x6e, 0xf2, 0xbc, 0x85, 0x22, 0xd2, 0x84,
+	0x40, 0x61, 0x21, 0x44, 0x54, 0xb7, 0x5a, 0x56, 0xa7, 0xe0, 0xe1, 0xf9, 0x3f, 0x5b, 0xfc, 0x66,
+	0x41, 0x6d, 0x28, 0x44, 0xf4, 0x52, 0x51, 0xc5, 0xa5, 0xe2, 0xa1, 0x24, 0x3d, 0x28, 0x2f, 0x12,
+	0x31, 0xe6, 0x2a, 0xeb, 0xb6, 0xe1, 0x98, 0x05, 0xa5, 0xc3, 0xdf, 0x36, 0xfa, 0x44, 0xf0, 0xd8,
+	0xcb, 0x94, 0xe4, 0x0d, 0xdc, 0x8c, 0x97, 0xf3, 0x80, 0x25, 0xbe, 0x18, 0xfb, 0xc6, 0x2b, 0x76,
+	0xd3, 0x77, 0x2e, 0xaf, 0x9b, 0xb9, 0x1f, 0xd7, 0xcd, 0x7b, 0x13, 0xae, 0xa6, 0xcb, 0xc0, 0x09,
+	0xc5, 0xdc, 0x2c, 0xdc, 0x7c, 0x4e, 0xe5, 0x68, 0xe6, 0xaa, 0x8f, 0x0b, 0x26, 0x9d, 0x41, 0xac,
+	0xbc, 0x9a, 0xce, 0x73, 0x3e, 0x46, 0xcb, 0x92, 0xdc, 0x82, 0x72, 0xea, 0xd3, 0xe7, 0x23, 0xb4,
+	0x50, 0xf0, 0x4a, 0x29, 0x1c, 0x8c, 0xda, 0x14, 0xaa, 0x69, 0xe7, 0xaf, 0x19, 0x9f, 0x4c, 0x95,
+	0x24, 0x77, 0xe1, 0x40, 0x2a, 0x1a, 0x44, 0xcc, 0x7f, 0x8f, 0x8c, 0x19, 0xd2, 0xbe, 0x26, 0xb5,
+	0x8a, 0x9c, 0xc0, 0x61, 0x40, 0x23, 0x1a, 0x87, 0x2c, 0xc9, 0x64, 0x79, 0x94, 0xd5, 0x32, 0x5a,
+	0x0b, 0xcd, 0x74, 0x3e, 0xc1, 0x61, 0x3f, 0x12, 0xe1, 0x0c, 0xb7, 0xf8, 0x4a, 0xd2, 0x09, 0x23,
+	0x77, 0x60, 0x3f, 0x48, 0x29, 0x7f, 0xba, 0x5b, 0xa5, 0x8a, 0xdc, 0x73, 0x5d, 0xa4, 0xb3, 0x3b,
+	0x0b, 0xf3, 0xf4, 0x4c, 0x95, 0xcc, 0x9b, 0x79, 0x5e, 0x4d, 0xa8, 0xa2, 0xb7, 0x85, 0xe0, 0xb1,
+	0x92, 0xc6, 0x1f, 0xa4, 0xd4, 0x10, 0x99, 0xf6, 0x19, 0x1c, 0xf4, 0xa9, 0x64, 0x4f, 0x59, 0x2c,
+	0xe6, 0xa9, 0x59, 0x72, 0x04, 0xc5, 0x51, 0x0a, 0xb0, 0x6e, 0xc5, 0xd3, 0x60, 0x77, 0x46, 0xf9,
+	0xdd, 0x19, 0xf5, 0x5f, 0x5c, 0xae, 0x6d, 0xeb, 0x6a, 0x6d, 0x5b, 0x3f, 0xd7, 0xb6, 0xf5, 0x79,
+	0x63, 0xe7, 0xae, 0x36, 0x76, 0xee, 0xfb, 0xc6, 0xce, 0xbd, 0x7b, 0xb0, 0xb3, 0x0e, 0xf3, 0x18,
+	0x4f, 0x23, 0x1a, 0xc8, 0x0c, 0xb8, 0xab, 0x6e, 0xcf, 0xfd, 0xf0, 0xe7, 0x17, 0xc7, 0x05, 0x05,
+	0x25, 0xc4, 0xbd, 0xdf, 0x03, 0x00, 0x63, 0x00, 0x87, 0xdb, 0x03, 0x04, 0x00, 0x00,
 }
 
 func (this *TokenPairArbRoutes) Equal(that interface{}) bool {
@@ -396,6 +585,33 @@ func (this *Trade) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *PoolWeights) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PoolWeights)
+	if !ok {
+		that2, ok := that.(PoolWeights)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.StableWeight != that1.StableWeight {
+		return false
+	}
+	if this.BalancerWeight != that1.BalancerWeight {
+		return false
+	}
+	return true
+}
 func (m *TokenPairArbRoutes) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -578,6 +794,112 @@ func (m *PoolStatistics) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *PoolWeights) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PoolWeights) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PoolWeights) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BalancerWeight != 0 {
+		i = encodeVarintProtorev(dAtA, i, uint64(m.BalancerWeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.StableWeight != 0 {
+		i = encodeVarintProtorev(dAtA, i, uint64(m.StableWeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BlockRouteUsage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BlockRouteUsage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlockRouteUsage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PoolPoints != 0 {
+		i = encodeVarintProtorev(dAtA, i, uint64(m.PoolPoints))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.NumberOfRoutes != 0 {
+		i = encodeVarintProtorev(dAtA, i, uint64(m.NumberOfRoutes))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintProtorev(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BaseDenomPool) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BaseDenomPool) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BaseDenomPool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		i = encodeVarintProtorev(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintProtorev(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintProtorev(dAtA []byte, offset int, v uint64) int {
 	offset -= sovProtorev(v)
 	base := offset
@@ -667,6 +989,55 @@ func (m *PoolStatistics) Size() (n int) {
 	return n
 }
 
+func (m *PoolWeights) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StableWeight != 0 {
+		n += 1 + sovProtorev(uint64(m.StableWeight))
+	}
+	if m.BalancerWeight != 0 {
+		n += 1 + sovProtorev(uint64(m.BalancerWeight))
+	}
+	return n
+}
+
+func (m *BlockRouteUsage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlockHeight != 0 {
+		n += 1 + sovProtorev(uint64(m.BlockHeight))
+	}
+	if m.NumberOfRoutes != 0 {
+		n += 1 + sovProtorev(uint64(m.NumberOfRoutes))
+	}
+	if m.PoolPoints != 0 {
+		n += 1 + sovProtorev(uint64(m.PoolPoints))
+	}
+	return n
+}
+
+func (m *BaseDenomPool) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovProtorev(uint64(l))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovProtorev(uint64(m.PoolId))
+	}
+	return n
+}
+
 func sovProtorev(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1175,6 +1546,302 @@ func (m *PoolStatistics) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *PoolWeights) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProtorev
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PoolWeights: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PoolWeights: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StableWeight", wireType)
+			}
+			m.StableWeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProtorev
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StableWeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BalancerWeight", wireType)
+			}
+			m.BalancerWeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProtorev
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BalancerWeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProtorev(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProtorev
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BlockRouteUsage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProtorev
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BlockRouteUsage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BlockRouteUsage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProtorev
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumberOfRoutes", wireType)
+			}
+			m.NumberOfRoutes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProtorev
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumberOfRoutes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolPoints", wireType)
+			}
+			m.PoolPoints = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProtorev
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolPoints |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProtorev(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProtorev
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BaseDenomPool) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProtorev
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BaseDenomPool: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BaseDenomPool: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProtorev
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProtorev
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProtorev
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProtorev
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProtorev(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProtorev
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipProtorev(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -655,6 +655,284 @@ func (m *QueryGetProtoRevTokenPairArbRoutesResponse) GetRoutes() []*TokenPairArb
 	return nil
 }
 
+// QueryGetProtoRevDeveloperAccountRequest is request type for the
+// Query/GetProtoRevDeveloperAccount RPC method.
+type QueryGetProtoRevDeveloperAccountRequest struct {
+}
+
+func (m *QueryGetProtoRevDeveloperAccountRequest) Reset() {
+	*m = QueryGetProtoRevDeveloperAccountRequest{}
+}
+func (m *QueryGetProtoRevDeveloperAccountRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetProtoRevDeveloperAccountRequest) ProtoMessage()    {}
+func (*QueryGetProtoRevDeveloperAccountRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f5e7ac9973cce389, []int{14}
+}
+func (m *QueryGetProtoRevDeveloperAccountRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetProtoRevDeveloperAccountRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetProtoRevDeveloperAccountRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetProtoRevDeveloperAccountRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetProtoRevDeveloperAccountRequest.Merge(m, src)
+}
+func (m *QueryGetProtoRevDeveloperAccountRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetProtoRevDeveloperAccountRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetProtoRevDeveloperAccountRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetProtoRevDeveloperAccountRequest proto.InternalMessageInfo
+
+// QueryGetProtoRevDeveloperAccountResponse is response type for the
+// Query/GetProtoRevDeveloperAccount RPC method.
+type QueryGetProtoRevDeveloperAccountResponse struct {
+	// developer_account is the account that receives a portion of the profits of
+	// the module
+	DeveloperAccount string `protobuf:"bytes,1,opt,name=developer_account,json=developerAccount,proto3" json:"developer_account,omitempty"`
+}
+
+func (m *QueryGetProtoRevDeveloperAccountResponse) Reset() {
+	*m = QueryGetProtoRevDeveloperAccountResponse{}
+}
+func (m *QueryGetProtoRevDeveloperAccountResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetProtoRevDeveloperAccountResponse) ProtoMessage()    {}
+func (*QueryGetProtoRevDeveloperAccountResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f5e7ac9973cce389, []int{15}
+}
+func (m *QueryGetProtoRevDeveloperAccountResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetProtoRevDeveloperAccountResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetProtoRevDeveloperAccountResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetProtoRevDeveloperAccountResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetProtoRevDeveloperAccountResponse.Merge(m, src)
+}
+func (m *QueryGetProtoRevDeveloperAccountResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetProtoRevDeveloperAccountResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetProtoRevDeveloperAccountResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetProtoRevDeveloperAccountResponse proto.InternalMessageInfo
+
+func (m *QueryGetProtoRevDeveloperAccountResponse) GetDeveloperAccount() string {
+	if m != nil {
+		return m.DeveloperAccount
+	}
+	return ""
+}
+
+// QueryGetProtoRevBaseDenomPoolsRequest is request type for the
+// Query/GetProtoRevBaseDenomPools RPC method.
+type QueryGetProtoRevBaseDenomPoolsRequest struct {
+}
+
+func (m *QueryGetProtoRevBaseDenomPoolsRequest) Reset()         { *m = QueryGetProtoRevBaseDenomPoolsRequest{} }
+func (m *QueryGetProtoRevBaseDenomPoolsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetProtoRevBaseDenomPoolsRequest) ProtoMessage()    {}
+func (*QueryGetProtoRevBaseDenomPoolsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f5e7ac9973cce389, []int{16}
+}
+func (m *QueryGetProtoRevBaseDenomPoolsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetProtoRevBaseDenomPoolsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetProtoRevBaseDenomPoolsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetProtoRevBaseDenomPoolsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetProtoRevBaseDenomPoolsRequest.Merge(m, src)
+}
+func (m *QueryGetProtoRevBaseDenomPoolsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetProtoRevBaseDenomPoolsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetProtoRevBaseDenomPoolsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetProtoRevBaseDenomPoolsRequest proto.InternalMessageInfo
+
+// QueryGetProtoRevBaseDenomPoolsResponse is response type for the
+// Query/GetProtoRevBaseDenomPools RPC method.
+type QueryGetProtoRevBaseDenomPoolsResponse struct {
+	// osmo_pools are the pools with the highest liquidity for each denom paired
+	// with osmo
+	OsmoPools []BaseDenomPool `protobuf:"bytes,1,rep,name=osmo_pools,json=osmoPools,proto3" json:"osmo_pools"`
+	// atom_pools are the pools with the highest liquidity for each denom paired
+	// with atom
+	AtomPools []BaseDenomPool `protobuf:"bytes,2,rep,name=atom_pools,json=atomPools,proto3" json:"atom_pools"`
+}
+
+func (m *QueryGetProtoRevBaseDenomPoolsResponse) Reset() {
+	*m = QueryGetProtoRevBaseDenomPoolsResponse{}
+}
+func (m *QueryGetProtoRevBaseDenomPoolsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetProtoRevBaseDenomPoolsResponse) ProtoMessage()    {}
+func (*QueryGetProtoRevBaseDenomPoolsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f5e7ac9973cce389, []int{17}
+}
+func (m *QueryGetProtoRevBaseDenomPoolsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetProtoRevBaseDenomPoolsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetProtoRevBaseDenomPoolsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetProtoRevBaseDenomPoolsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetProtoRevBaseDenomPoolsResponse.Merge(m, src)
+}
+func (m *QueryGetProtoRevBaseDenomPoolsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetProtoRevBaseDenomPoolsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetProtoRevBaseDenomPoolsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetProtoRevBaseDenomPoolsResponse proto.InternalMessageInfo
+
+func (m *QueryGetProtoRevBaseDenomPoolsResponse) GetOsmoPools() []BaseDenomPool {
+	if m != nil {
+		return m.OsmoPools
+	}
+	return nil
+}
+
+func (m *QueryGetProtoRevBaseDenomPoolsResponse) GetAtomPools() []BaseDenomPool {
+	if m != nil {
+		return m.AtomPools
+	}
+	return nil
+}
+
+// QueryGetProtoRevRoutesSimulatedRequest is request type for the
+// Query/GetProtoRevRoutesSimulated RPC method.
+type QueryGetProtoRevRoutesSimulatedRequest struct {
+}
+
+func (m *QueryGetProtoRevRoutesSimulatedRequest) Reset() {
+	*m = QueryGetProtoRevRoutesSimulatedRequest{}
+}
+func (m *QueryGetProtoRevRoutesSimulatedRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetProtoRevRoutesSimulatedRequest) ProtoMessage()    {}
+func (*QueryGetProtoRevRoutesSimulatedRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f5e7ac9973cce389, []int{18}
+}
+func (m *QueryGetProtoRevRoutesSimulatedRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetProtoRevRoutesSimulatedRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetProtoRevRoutesSimulatedRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetProtoRevRoutesSimulatedRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetProtoRevRoutesSimulatedRequest.Merge(m, src)
+}
+func (m *QueryGetProtoRevRoutesSimulatedRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetProtoRevRoutesSimulatedRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetProtoRevRoutesSimulatedRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetProtoRevRoutesSimulatedRequest proto.InternalMessageInfo
+
+// QueryGetProtoRevRoutesSimulatedResponse is response type for the
+// Query/GetProtoRevRoutesSimulated RPC method.
+type QueryGetProtoRevRoutesSimulatedResponse struct {
+	// usage contains the number of routes simulated and the pool points consumed
+	// in the latest block the module was active in
+	Usage BlockRouteUsage `protobuf:"bytes,1,opt,name=usage,proto3" json:"usage"`
+}
+
+func (m *QueryGetProtoRevRoutesSimulatedResponse) Reset() {
+	*m = QueryGetProtoRevRoutesSimulatedResponse{}
+}
+func (m *QueryGetProtoRevRoutesSimulatedResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetProtoRevRoutesSimulatedResponse) ProtoMessage()    {}
+func (*QueryGetProtoRevRoutesSimulatedResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f5e7ac9973cce389, []int{19}
+}
+func (m *QueryGetProtoRevRoutesSimulatedResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetProtoRevRoutesSimulatedResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetProtoRevRoutesSimulatedResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetProtoRevRoutesSimulatedResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetProtoRevRoutesSimulatedResponse.Merge(m, src)
+}
+func (m *QueryGetProtoRevRoutesSimulatedResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetProtoRevRoutesSimulatedResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetProtoRevRoutesSimulatedResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetProtoRevRoutesSimulatedResponse proto.InternalMessageInfo
+
+func (m *QueryGetProtoRevRoutesSimulatedResponse) GetUsage() BlockRouteUsage {
+	if m != nil {
+		return m.Usage
+	}
+	return BlockRouteUsage{}
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "osmosis.protorev.v1beta1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "osmosis.protorev.v1beta1.QueryParamsResponse")
@@ -670,6 +948,12 @@ func init() {
 	proto.RegisterType((*QueryGetProtoRevAllStatisticsResponse)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevAllStatisticsResponse")
 	proto.RegisterType((*QueryGetProtoRevTokenPairArbRoutesRequest)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevTokenPairArbRoutesRequest")
 	proto.RegisterType((*QueryGetProtoRevTokenPairArbRoutesResponse)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevTokenPairArbRoutesResponse")
+	proto.RegisterType((*QueryGetProtoRevDeveloperAccountRequest)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevDeveloperAccountRequest")
+	proto.RegisterType((*QueryGetProtoRevDeveloperAccountResponse)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevDeveloperAccountResponse")
+	proto.RegisterType((*QueryGetProtoRevBaseDenomPoolsRequest)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevBaseDenomPoolsRequest")
+	proto.RegisterType((*QueryGetProtoRevBaseDenomPoolsResponse)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevBaseDenomPoolsResponse")
+	proto.RegisterType((*QueryGetProtoRevRoutesSimulatedRequest)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevRoutesSimulatedRequest")
+	proto.RegisterType((*QueryGetProtoRevRoutesSimulatedResponse)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevRoutesSimulatedResponse")
 }
 
 func init() {
@@ -677,59 +961,73 @@ func init() {
 }
 
 var fileDescriptor_f5e7ac9973cce389 = []byte{
-	// 829 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0x5d, 0x4f, 0xd3, 0x50,
-	0x18, 0x5e, 0x05, 0x46, 0x3c, 0x26, 0xc6, 0x1c, 0x31, 0x42, 0xc5, 0x02, 0x65, 0x8c, 0xf1, 0xb1,
-	0xd6, 0x31, 0xef, 0x54, 0x90, 0x41, 0xa2, 0xdc, 0xe0, 0xac, 0x98, 0xf8, 0x71, 0xd1, 0xb4, 0x5b,
-	0x99, 0x0d, 0x5d, 0x4f, 0x69, 0xbb, 0xe9, 0x6e, 0xb9, 0xf5, 0xc6, 0xc4, 0xdf, 0xe3, 0x3d, 0xde,
-	0x18, 0x12, 0x13, 0x63, 0xbc, 0x40, 0x03, 0xfe, 0x0e, 0x63, 0x7a, 0xfa, 0x76, 0x1f, 0x5d, 0xbb,
-	0x0f, 0xb8, 0xda, 0xce, 0x39, 0xcf, 0xfb, 0xbc, 0xcf, 0xf3, 0x9e, 0xf6, 0x49, 0x51, 0x8a, 0x38,
-	0x55, 0xe2, 0xe8, 0x8e, 0x68, 0xd9, 0xc4, 0x25, 0xb6, 0x56, 0x17, 0xeb, 0x39, 0x55, 0x73, 0x95,
-	0x9c, 0x78, 0x58, 0xd3, 0xec, 0x86, 0x40, 0xb7, 0xf1, 0x24, 0xa0, 0x84, 0x00, 0x25, 0x00, 0x8a,
-	0x9d, 0xa8, 0x90, 0x0a, 0xa1, 0xbb, 0xa2, 0xf7, 0xcf, 0x07, 0xb0, 0xd3, 0x15, 0x42, 0x2a, 0x86,
-	0x26, 0x2a, 0x96, 0x2e, 0x2a, 0xa6, 0x49, 0x5c, 0xc5, 0xd5, 0x89, 0x09, 0xe5, 0xec, 0x72, 0x89,
-	0xd2, 0x89, 0xaa, 0xe2, 0x68, 0x7e, 0x9b, 0x66, 0x53, 0x4b, 0xa9, 0xe8, 0x26, 0x05, 0x03, 0x76,
-	0x21, 0x56, 0x9f, 0xa5, 0xd8, 0x4a, 0x35, 0xa0, 0x5c, 0x8c, 0x87, 0x05, 0x8a, 0x7d, 0x20, 0xd7,
-	0xde, 0x3b, 0xc0, 0x94, 0x88, 0x0e, 0xfd, 0xf8, 0x09, 0x84, 0x9f, 0x7b, 0x8a, 0x8a, 0x94, 0x5d,
-	0xd2, 0x0e, 0x6b, 0x9a, 0xe3, 0xf2, 0x2f, 0xd1, 0xcd, 0x8e, 0x5d, 0xc7, 0x22, 0xa6, 0xa3, 0xe1,
-	0x75, 0x94, 0xf4, 0x55, 0x4c, 0x32, 0xb3, 0x4c, 0xe6, 0xda, 0xda, 0xac, 0x10, 0x37, 0x27, 0xc1,
-	0xaf, 0x2c, 0x8c, 0x1e, 0x9f, 0xce, 0x24, 0x24, 0xa8, 0xe2, 0x17, 0xd1, 0x02, 0xa5, 0x7d, 0xa2,
-	0xb9, 0x45, 0xaf, 0x40, 0xd2, 0xea, 0xbb, 0xb5, 0xaa, 0xaa, 0xd9, 0xcf, 0xf6, 0xf7, 0x6c, 0xa5,
-	0xac, 0x35, 0xfb, 0x1f, 0x31, 0x28, 0xdd, 0x0f, 0x09, 0x9a, 0x5e, 0xa1, 0x1b, 0x26, 0x3d, 0x91,
-	0xc9, 0xbe, 0xec, 0xd2, 0x33, 0xaa, 0xee, 0x6a, 0x41, 0xf0, 0x7a, 0xff, 0x3a, 0x9d, 0x49, 0x57,
-	0x74, 0xf7, 0x5d, 0x4d, 0x15, 0x4a, 0xa4, 0x2a, 0xc2, 0x34, 0xfc, 0x9f, 0xac, 0x53, 0x3e, 0x10,
-	0xdd, 0x86, 0xa5, 0x39, 0xc2, 0x8e, 0xe9, 0x4a, 0xd7, 0xcd, 0x8e, 0x0e, 0xfc, 0xa3, 0x6e, 0xb5,
-	0x45, 0x9b, 0xec, 0xeb, 0xae, 0x53, 0x68, 0x6c, 0x6b, 0x26, 0xa9, 0x82, 0x5a, 0x3c, 0x81, 0xc6,
-	0xca, 0xde, 0xda, 0xef, 0x2b, 0xf9, 0x0b, 0xfe, 0x6d, 0xb7, 0x85, 0x70, 0x39, 0x58, 0xc8, 0xa1,
-	0xa4, 0x45, 0x4f, 0x60, 0xac, 0x53, 0x82, 0xaf, 0x4f, 0xf0, 0x2e, 0xad, 0x39, 0xd1, 0x2d, 0xa2,
-	0x9b, 0x12, 0x00, 0xf9, 0x79, 0x34, 0x17, 0x26, 0xdf, 0x34, 0x0c, 0xe0, 0x0f, 0xa6, 0xf8, 0x1a,
-	0xf1, 0xbd, 0x40, 0xd0, 0x3d, 0x8f, 0xc6, 0x7d, 0x52, 0x6f, 0x6e, 0x23, 0xbd, 0xdb, 0x07, 0x48,
-	0xbe, 0x80, 0x16, 0xc3, 0xd4, 0x2f, 0xbc, 0x87, 0xde, 0x71, 0xf5, 0x92, 0x53, 0x68, 0x14, 0x09,
-	0x31, 0x82, 0xe9, 0xdc, 0x46, 0xe3, 0x16, 0x21, 0x86, 0xac, 0x97, 0xa9, 0xbd, 0x51, 0x29, 0xe9,
-	0x2d, 0x77, 0xca, 0xbc, 0x8b, 0x32, 0xfd, 0x39, 0x40, 0xe4, 0x53, 0x84, 0x9c, 0xe6, 0x19, 0x8c,
-	0x29, 0xd3, 0xe3, 0xe9, 0x23, 0xc4, 0x68, 0x71, 0x49, 0x6d, 0xb5, 0x7c, 0x1a, 0xa5, 0x22, 0x86,
-	0xd2, 0x06, 0x86, 0xe1, 0xbd, 0xef, 0xbe, 0xfd, 0x10, 0x0e, 0xa4, 0xed, 0x86, 0xa4, 0x8d, 0x0c,
-	0x23, 0x0d, 0x5e, 0x90, 0x76, 0x81, 0x2b, 0x68, 0x29, 0xdc, 0x78, 0x8f, 0x1c, 0x68, 0x66, 0x51,
-	0xd1, 0xed, 0x4d, 0x5b, 0x95, 0x48, 0xcd, 0x6d, 0xbd, 0x28, 0x36, 0x5a, 0x1e, 0x04, 0x0c, 0x52,
-	0xb7, 0x51, 0xd2, 0xa6, 0x3b, 0x20, 0x73, 0x35, 0x5e, 0x66, 0x04, 0x0b, 0xd4, 0xae, 0xfd, 0x43,
-	0x68, 0x8c, 0x36, 0xc5, 0x1f, 0x19, 0x94, 0xf4, 0x5f, 0x74, 0xdc, 0x83, 0xaa, 0x3b, 0x5f, 0xd8,
-	0xec, 0x80, 0x68, 0x5f, 0x37, 0x9f, 0x3a, 0xfa, 0xfe, 0xf7, 0xf3, 0x15, 0x0e, 0x4f, 0x8b, 0x41,
-	0xec, 0xd5, 0x73, 0xf9, 0x56, 0xf4, 0xf9, 0xe9, 0x82, 0xbf, 0x31, 0x68, 0x2a, 0x36, 0x2f, 0xf0,
-	0x46, 0x9f, 0x96, 0xfd, 0x32, 0x89, 0x7d, 0x7c, 0x71, 0x02, 0xb0, 0x21, 0x50, 0x1b, 0x19, 0x9c,
-	0x8e, 0xb6, 0x11, 0x8e, 0xb1, 0xb0, 0xa1, 0xce, 0xf4, 0x18, 0xc6, 0x50, 0x64, 0x6c, 0x0d, 0x63,
-	0x28, 0x3a, 0xb8, 0xfa, 0x19, 0x82, 0xb0, 0x90, 0xd5, 0x86, 0x4c, 0x23, 0x11, 0x7f, 0x61, 0xd0,
-	0xad, 0xc8, 0x30, 0xc2, 0x0f, 0x06, 0xd7, 0xd2, 0x95, 0x73, 0xec, 0xc3, 0x8b, 0x15, 0x83, 0x89,
-	0x25, 0x6a, 0x62, 0x1e, 0xcf, 0x45, 0x9b, 0x50, 0x0c, 0x43, 0x06, 0x23, 0xf8, 0x07, 0x83, 0xee,
-	0xf4, 0x48, 0x2b, 0xbc, 0x39, 0xb8, 0x90, 0x98, 0xb4, 0x64, 0x0b, 0x97, 0xa1, 0x00, 0x47, 0xf7,
-	0xa8, 0xa3, 0x65, 0x9c, 0x89, 0x76, 0xd4, 0xca, 0x1a, 0xef, 0x66, 0xbc, 0x30, 0xc6, 0x5f, 0x19,
-	0x34, 0x19, 0x17, 0x74, 0x78, 0x7d, 0xa8, 0xf1, 0x76, 0x25, 0x29, 0xbb, 0x71, 0xe1, 0x7a, 0xf0,
-	0xb3, 0x4a, 0xfd, 0xa4, 0x71, 0x2a, 0xfe, 0x86, 0x5a, 0x9e, 0xf0, 0x6f, 0x06, 0xdd, 0xed, 0x19,
-	0x87, 0x78, 0x6b, 0x70, 0x41, 0xb1, 0xc9, 0xcb, 0x6e, 0x5f, 0x8e, 0x04, 0xac, 0xe5, 0xa9, 0xb5,
-	0x2c, 0x5e, 0x89, 0xb6, 0xe6, 0x7a, 0x95, 0xb2, 0xa5, 0xe8, 0xb6, 0xac, 0xd8, 0xaa, 0xec, 0x07,
-	0x70, 0x61, 0xf7, 0xf8, 0x8c, 0x63, 0x4e, 0xce, 0x38, 0xe6, 0xcf, 0x19, 0xc7, 0x7c, 0x3a, 0xe7,
-	0x12, 0x27, 0xe7, 0x5c, 0xe2, 0xe7, 0x39, 0x97, 0x78, 0x73, 0xbf, 0xed, 0x53, 0x07, 0x08, 0xb3,
-	0x86, 0xa2, 0x3a, 0x1d, 0xec, 0x1f, 0xda, 0xf8, 0xbd, 0x8f, 0x1f, 0x35, 0x49, 0xd7, 0xf9, 0xff,
-	0x01, 0x00, 0x00, 0xff, 0xff, 0xd9, 0xe8, 0xcf, 0xe3, 0x1c, 0x0b, 0x00, 0x00,
+	// 1051 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x57, 0x4d, 0x6f, 0xdc, 0x44,
+	0x18, 0x8e, 0xdb, 0x66, 0xab, 0xbe, 0x48, 0x50, 0x86, 0x20, 0x52, 0x53, 0xb6, 0xad, 0x9b, 0xec,
+	0x47, 0xd2, 0xd8, 0xa4, 0xe1, 0x06, 0xb4, 0xd9, 0x6d, 0x10, 0x54, 0x42, 0x61, 0xd9, 0xb6, 0xe2,
+	0xeb, 0x60, 0xcd, 0xee, 0x4e, 0x16, 0x2b, 0x5e, 0x8f, 0x6b, 0x7b, 0x17, 0xf6, 0xda, 0x2b, 0x17,
+	0x24, 0x7e, 0x0f, 0x37, 0x0e, 0xe5, 0x82, 0x8a, 0x10, 0x08, 0x71, 0x08, 0x28, 0xe1, 0x5f, 0x70,
+	0x41, 0x33, 0xf3, 0x7a, 0x3f, 0xbc, 0xf6, 0x7e, 0xe5, 0x94, 0xd8, 0x7e, 0xde, 0xe7, 0x7d, 0x9e,
+	0x77, 0xc6, 0xf3, 0x78, 0x61, 0x83, 0x87, 0x1d, 0x1e, 0x3a, 0xa1, 0xe5, 0x07, 0x3c, 0xe2, 0x01,
+	0xeb, 0x59, 0xbd, 0xdd, 0x06, 0x8b, 0xe8, 0xae, 0xf5, 0xb4, 0xcb, 0x82, 0xbe, 0x29, 0x6f, 0x93,
+	0x75, 0x44, 0x99, 0x31, 0xca, 0x44, 0x94, 0xbe, 0xd6, 0xe6, 0x6d, 0x2e, 0xef, 0x5a, 0xe2, 0x3f,
+	0x05, 0xd0, 0xaf, 0xb7, 0x39, 0x6f, 0xbb, 0xcc, 0xa2, 0xbe, 0x63, 0x51, 0xcf, 0xe3, 0x11, 0x8d,
+	0x1c, 0xee, 0x61, 0xb9, 0xbe, 0xd5, 0x94, 0x74, 0x56, 0x83, 0x86, 0x4c, 0xb5, 0x19, 0x34, 0xf5,
+	0x69, 0xdb, 0xf1, 0x24, 0x18, 0xb1, 0x9b, 0x99, 0xfa, 0x7c, 0x1a, 0xd0, 0x4e, 0x4c, 0x59, 0xcc,
+	0x86, 0xc5, 0x8a, 0x15, 0x30, 0x3f, 0xda, 0x3b, 0xc6, 0x34, 0xb9, 0x83, 0xfd, 0x8c, 0x35, 0x20,
+	0x9f, 0x0a, 0x45, 0x35, 0xc9, 0x5e, 0x67, 0x4f, 0xbb, 0x2c, 0x8c, 0x8c, 0x27, 0xf0, 0xda, 0xd8,
+	0xdd, 0xd0, 0xe7, 0x5e, 0xc8, 0xc8, 0x3d, 0xc8, 0x29, 0x15, 0xeb, 0xda, 0x4d, 0xad, 0xf4, 0xd2,
+	0xdd, 0x9b, 0x66, 0xd6, 0x9c, 0x4c, 0x55, 0x59, 0xbd, 0xf4, 0xfc, 0xe4, 0xc6, 0x4a, 0x1d, 0xab,
+	0x8c, 0x22, 0x6c, 0x4a, 0xda, 0x0f, 0x59, 0x54, 0x13, 0x05, 0x75, 0xd6, 0x3b, 0xec, 0x76, 0x1a,
+	0x2c, 0xf8, 0xe4, 0xe8, 0x71, 0x40, 0x5b, 0x6c, 0xd0, 0xff, 0x99, 0x06, 0x85, 0x59, 0x48, 0xd4,
+	0xf4, 0x39, 0x5c, 0xf5, 0xe4, 0x13, 0x9b, 0x1f, 0xd9, 0x91, 0x7c, 0x26, 0xd5, 0x5d, 0xa9, 0x9a,
+	0xa2, 0xf7, 0x5f, 0x27, 0x37, 0x0a, 0x6d, 0x27, 0xfa, 0xba, 0xdb, 0x30, 0x9b, 0xbc, 0x63, 0xe1,
+	0x34, 0xd4, 0x9f, 0x9d, 0xb0, 0x75, 0x6c, 0x45, 0x7d, 0x9f, 0x85, 0xe6, 0x43, 0x2f, 0xaa, 0xbf,
+	0xec, 0x8d, 0x75, 0x30, 0xde, 0x9f, 0x54, 0x5b, 0x0b, 0xf8, 0x91, 0x13, 0x85, 0xd5, 0xfe, 0x01,
+	0xf3, 0x78, 0x07, 0xd5, 0x92, 0x35, 0x58, 0x6d, 0x89, 0x6b, 0xd5, 0xb7, 0xae, 0x2e, 0x8c, 0xaf,
+	0xa0, 0x30, 0xab, 0x1c, 0x2d, 0xec, 0x42, 0xce, 0x97, 0x4f, 0x70, 0xac, 0xd7, 0x4c, 0xa5, 0xcf,
+	0x14, 0x8b, 0x36, 0x98, 0xe8, 0x03, 0xee, 0x78, 0x75, 0x04, 0x1a, 0xb7, 0xe1, 0x56, 0x92, 0xbc,
+	0xe2, 0xba, 0xc8, 0x1f, 0x4f, 0xf1, 0x0b, 0x30, 0xa6, 0x81, 0xb0, 0xfb, 0x1e, 0x5c, 0x56, 0xa4,
+	0x62, 0x6e, 0x17, 0xa7, 0xb7, 0x8f, 0x91, 0x46, 0x15, 0x8a, 0x49, 0xea, 0x47, 0x62, 0xd3, 0x87,
+	0x91, 0xd3, 0x0c, 0xab, 0xfd, 0x1a, 0xe7, 0x6e, 0x3c, 0x9d, 0x37, 0xe0, 0xb2, 0xcf, 0xb9, 0x6b,
+	0x3b, 0x2d, 0x69, 0xef, 0x52, 0x3d, 0x27, 0x2e, 0x1f, 0xb6, 0x8c, 0x08, 0x4a, 0xb3, 0x39, 0x50,
+	0xe4, 0x47, 0x00, 0xe1, 0xe0, 0x19, 0x8e, 0xa9, 0x34, 0x65, 0xf7, 0x71, 0xee, 0x0e, 0xb9, 0xea,
+	0x23, 0xb5, 0x46, 0x01, 0x36, 0x52, 0x86, 0x32, 0x02, 0xc6, 0xe1, 0x7d, 0x03, 0x9b, 0x33, 0x70,
+	0x28, 0xed, 0x30, 0x21, 0xed, 0xe2, 0x22, 0xd2, 0xf0, 0x05, 0x19, 0x15, 0xb8, 0x0d, 0xe5, 0x64,
+	0xe3, 0xc7, 0xfc, 0x98, 0x79, 0x35, 0xea, 0x04, 0x95, 0xa0, 0x51, 0xe7, 0xdd, 0x68, 0xf8, 0xa2,
+	0x04, 0xb0, 0x35, 0x0f, 0x18, 0xa5, 0x1e, 0x40, 0x2e, 0x90, 0x77, 0x50, 0xe6, 0x9d, 0x6c, 0x99,
+	0x29, 0x2c, 0x58, 0x6b, 0x94, 0x27, 0xd7, 0xfe, 0x80, 0xf5, 0x98, 0xcb, 0x7d, 0x16, 0x54, 0x9a,
+	0x4d, 0xde, 0xf5, 0xa2, 0x58, 0xde, 0x67, 0x50, 0x9a, 0x0d, 0x45, 0x71, 0xdb, 0xf0, 0x6a, 0x2b,
+	0x7e, 0x66, 0x53, 0xf5, 0x10, 0xdf, 0xa8, 0xab, 0xad, 0x44, 0x51, 0xda, 0x49, 0x52, 0xa5, 0x21,
+	0x93, 0xef, 0x95, 0x98, 0xf0, 0x60, 0x40, 0x3f, 0xa5, 0x9c, 0x24, 0x49, 0x24, 0x0a, 0xf8, 0x18,
+	0x40, 0x8c, 0xc3, 0x16, 0xdb, 0x33, 0x9e, 0x50, 0x31, 0x7b, 0x42, 0x63, 0x2c, 0xb8, 0x8e, 0x57,
+	0x04, 0x5a, 0xb2, 0x0a, 0x36, 0x1a, 0xf1, 0x0e, 0xb2, 0x5d, 0x58, 0x8a, 0x8d, 0x46, 0xea, 0x3a,
+	0x34, 0x4a, 0x93, 0x2e, 0xd4, 0xaa, 0x3c, 0x72, 0x3a, 0x5d, 0x97, 0x46, 0xac, 0x15, 0x1b, 0xf6,
+	0xa1, 0x38, 0x13, 0x89, 0x86, 0x3f, 0x80, 0xd5, 0x6e, 0x48, 0xdb, 0x0c, 0xdf, 0xa7, 0xf2, 0x14,
+	0x75, 0x2e, 0x6f, 0x1e, 0x4b, 0x9a, 0x27, 0xa2, 0x00, 0xf5, 0xa9, 0xea, 0xbb, 0xff, 0xbd, 0x02,
+	0xab, 0xb2, 0x25, 0xf9, 0x4e, 0x83, 0x9c, 0x3a, 0xf8, 0xc9, 0x94, 0xad, 0x35, 0x99, 0x37, 0xfa,
+	0xce, 0x9c, 0x68, 0x25, 0xdc, 0xd8, 0x78, 0xf6, 0xdb, 0xbf, 0x3f, 0x5c, 0xc8, 0x93, 0xeb, 0x56,
+	0x1c, 0x83, 0xbd, 0xdd, 0xbd, 0x61, 0x14, 0xaa, 0xb4, 0x21, 0xbf, 0x68, 0x70, 0x2d, 0x33, 0x3f,
+	0xc8, 0xfd, 0x19, 0x2d, 0x67, 0x65, 0x94, 0xbe, 0xbf, 0x3c, 0x01, 0xda, 0x30, 0xa5, 0x8d, 0x12,
+	0x29, 0xa4, 0xdb, 0x48, 0xc6, 0x5a, 0xd2, 0xd0, 0x78, 0x9a, 0x2c, 0x62, 0x28, 0x35, 0xc6, 0xf4,
+	0xfd, 0xe5, 0x09, 0xe6, 0x33, 0x84, 0xe1, 0x61, 0x37, 0xfa, 0xb6, 0x8c, 0x48, 0xf2, 0xa3, 0x06,
+	0xaf, 0xa7, 0x86, 0x13, 0x79, 0x77, 0x7e, 0x2d, 0x13, 0xb9, 0xa7, 0xbf, 0xb7, 0x5c, 0x31, 0x9a,
+	0x28, 0x4b, 0x13, 0xb7, 0xc9, 0xad, 0x74, 0x13, 0xd4, 0x75, 0x6d, 0x34, 0x42, 0xfe, 0xd0, 0xe0,
+	0xcd, 0x29, 0xe9, 0x45, 0x2a, 0xf3, 0x0b, 0xc9, 0x48, 0x4f, 0xbd, 0x7a, 0x1e, 0x0a, 0x74, 0xf4,
+	0xb6, 0x74, 0xb4, 0x45, 0x4a, 0xe9, 0x8e, 0x86, 0xd9, 0x23, 0x56, 0x46, 0x9c, 0x57, 0xe4, 0x67,
+	0x0d, 0xd6, 0xb3, 0x82, 0x8f, 0xdc, 0x5b, 0x68, 0xbc, 0x13, 0xc9, 0xaa, 0xdf, 0x5f, 0xba, 0x1e,
+	0xfd, 0xdc, 0x91, 0x7e, 0x0a, 0x64, 0x23, 0x7b, 0x85, 0x86, 0x9e, 0xc8, 0xdf, 0x1a, 0xbc, 0x35,
+	0x35, 0x1e, 0xc9, 0x83, 0xf9, 0x05, 0x65, 0x26, 0xb1, 0x7e, 0x70, 0x3e, 0x12, 0xb4, 0xb6, 0x27,
+	0xad, 0xed, 0x90, 0xed, 0x74, 0x6b, 0x91, 0xa8, 0xb4, 0x7d, 0xea, 0x04, 0x36, 0x0d, 0x1a, 0xb6,
+	0x0a, 0x64, 0xf2, 0xfb, 0xf8, 0x36, 0x4c, 0x26, 0xec, 0x22, 0xdb, 0x30, 0x23, 0xc8, 0xf5, 0xea,
+	0x79, 0x28, 0xd0, 0x9b, 0x25, 0xbd, 0x95, 0x49, 0x31, 0xdd, 0xdb, 0x44, 0xf8, 0x27, 0xcf, 0xbb,
+	0xf1, 0xd8, 0x5e, 0xe4, 0xbc, 0x4b, 0xfd, 0x34, 0xd0, 0xf7, 0x97, 0x27, 0x98, 0xef, 0xbc, 0x13,
+	0xdf, 0xcf, 0xea, 0xa4, 0x53, 0x5f, 0x01, 0xe4, 0x57, 0x0d, 0xf4, 0xec, 0x5c, 0x26, 0x0b, 0x08,
+	0x4a, 0x0f, 0x7f, 0xbd, 0x72, 0x0e, 0x86, 0xf9, 0x3c, 0xa9, 0x2d, 0x67, 0x87, 0x71, 0x5d, 0xf5,
+	0xf0, 0xf9, 0x69, 0x5e, 0x7b, 0x71, 0x9a, 0xd7, 0xfe, 0x39, 0xcd, 0x6b, 0xdf, 0x9f, 0xe5, 0x57,
+	0x5e, 0x9c, 0xe5, 0x57, 0xfe, 0x3c, 0xcb, 0xaf, 0x7c, 0xf9, 0xce, 0xc8, 0xef, 0x2e, 0xe4, 0xda,
+	0x71, 0x69, 0x23, 0x1c, 0x23, 0xfe, 0x76, 0x48, 0x2d, 0x7f, 0x89, 0x35, 0x72, 0xf2, 0x7a, 0xef,
+	0xff, 0x01, 0x00, 0x3f, 0xde, 0x81, 0xf6, 0xa9, 0x0f, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -763,6 +1061,15 @@ type QueryClient interface {
 	// GetProtoRevTokenPairArbRoutes queries all of the hot routes that the module
 	// is currently arbitraging
 	GetProtoRevTokenPairArbRoutes(ctx context.Context, in *QueryGetProtoRevTokenPairArbRoutesRequest, opts ...grpc.CallOption) (*QueryGetProtoRevTokenPairArbRoutesResponse, error)
+	// GetProtoRevDeveloperAccount queries the developer account of the module
+	GetProtoRevDeveloperAccount(ctx context.Context, in *QueryGetProtoRevDeveloperAccountRequest, opts ...grpc.CallOption) (*QueryGetProtoRevDeveloperAccountResponse, error)
+	// GetProtoRevBaseDenomPools queries the pools with the highest liquidity
+	// between osmo or atom and every other denom, which are used to build
+	// arbitrage routes
+	GetProtoRevBaseDenomPools(ctx context.Context, in *QueryGetProtoRevBaseDenomPoolsRequest, opts ...grpc.CallOption) (*QueryGetProtoRevBaseDenomPoolsResponse, error)
+	// GetProtoRevRoutesSimulated queries the number of routes simulated and the
+	// pool points consumed by the module in the latest block it was active in
+	GetProtoRevRoutesSimulated(ctx context.Context, in *QueryGetProtoRevRoutesSimulatedRequest, opts ...grpc.CallOption) (*QueryGetProtoRevRoutesSimulatedResponse, error)
 }
 
 type queryClient struct {
@@ -836,6 +1143,33 @@ func (c *queryClient) GetProtoRevTokenPairArbRoutes(ctx context.Context, in *Que
 	return out, nil
 }
 
+func (c *queryClient) GetProtoRevDeveloperAccount(ctx context.Context, in *QueryGetProtoRevDeveloperAccountRequest, opts ...grpc.CallOption) (*QueryGetProtoRevDeveloperAccountResponse, error) {
+	out := new(QueryGetProtoRevDeveloperAccountResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.protorev.v1beta1.Query/GetProtoRevDeveloperAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetProtoRevBaseDenomPools(ctx context.Context, in *QueryGetProtoRevBaseDenomPoolsRequest, opts ...grpc.CallOption) (*QueryGetProtoRevBaseDenomPoolsResponse, error) {
+	out := new(QueryGetProtoRevBaseDenomPoolsResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.protorev.v1beta1.Query/GetProtoRevBaseDenomPools", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetProtoRevRoutesSimulated(ctx context.Context, in *QueryGetProtoRevRoutesSimulatedRequest, opts ...grpc.CallOption) (*QueryGetProtoRevRoutesSimulatedResponse, error) {
+	out := new(QueryGetProtoRevRoutesSimulatedResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.protorev.v1beta1.Query/GetProtoRevRoutesSimulated", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Params queries the parameters of the module.
@@ -857,6 +1191,15 @@ type QueryServer interface {
 	// GetProtoRevTokenPairArbRoutes queries all of the hot routes that the module
 	// is currently arbitraging
 	GetProtoRevTokenPairArbRoutes(context.Context, *QueryGetProtoRevTokenPairArbRoutesRequest) (*QueryGetProtoRevTokenPairArbRoutesResponse, error)
+	// GetProtoRevDeveloperAccount queries the developer account of the module
+	GetProtoRevDeveloperAccount(context.Context, *QueryGetProtoRevDeveloperAccountRequest) (*QueryGetProtoRevDeveloperAccountResponse, error)
+	// GetProtoRevBaseDenomPools queries the pools with the highest liquidity
+	// between osmo or atom and every other denom, which are used to build
+	// arbitrage routes
+	GetProtoRevBaseDenomPools(context.Context, *QueryGetProtoRevBaseDenomPoolsRequest) (*QueryGetProtoRevBaseDenomPoolsResponse, error)
+	// GetProtoRevRoutesSimulated queries the number of routes simulated and the
+	// pool points consumed by the module in the latest block it was active in
+	GetProtoRevRoutesSimulated(context.Context, *QueryGetProtoRevRoutesSimulatedRequest) (*QueryGetProtoRevRoutesSimulatedResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -884,6 +1227,15 @@ func (*UnimplementedQueryServer) GetProtoRevAllStatistics(ctx context.Context, r
 func (*UnimplementedQueryServer) GetProtoRevTokenPairArbRoutes(ctx context.Context, req *QueryGetProtoRevTokenPairArbRoutesRequest) (*QueryGetProtoRevTokenPairArbRoutesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetProtoRevTokenPairArbRoutes not implemented")
 }
+func (*UnimplementedQueryServer) GetProtoRevDeveloperAccount(ctx context.Context, req *QueryGetProtoRevDeveloperAccountRequest) (*QueryGetProtoRevDeveloperAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProtoRevDeveloperAccount not implemented")
+}
+func (*UnimplementedQueryServer) GetProtoRevBaseDenomPools(ctx context.Context, req *QueryGetProtoRevBaseDenomPoolsRequest) (*QueryGetProtoRevBaseDenomPoolsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProtoRevBaseDenomPools not implemented")
+}
+func (*UnimplementedQueryServer) GetProtoRevRoutesSimulated(ctx context.Context, req *QueryGetProtoRevRoutesSimulatedRequest) (*QueryGetProtoRevRoutesSimulatedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProtoRevRoutesSimulated not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -1015,6 +1367,60 @@ func _Query_GetProtoRevTokenPairArbRoutes_Handler(srv interface{}, ctx context.C
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_GetProtoRevDeveloperAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetProtoRevDeveloperAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetProtoRevDeveloperAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.protorev.v1beta1.Query/GetProtoRevDeveloperAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetProtoRevDeveloperAccount(ctx, req.(*QueryGetProtoRevDeveloperAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetProtoRevBaseDenomPools_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetProtoRevBaseDenomPoolsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetProtoRevBaseDenomPools(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.protorev.v1beta1.Query/GetProtoRevBaseDenomPools",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetProtoRevBaseDenomPools(ctx, req.(*QueryGetProtoRevBaseDenomPoolsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetProtoRevRoutesSimulated_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetProtoRevRoutesSimulatedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetProtoRevRoutesSimulated(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.protorev.v1beta1.Query/GetProtoRevRoutesSimulated",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetProtoRevRoutesSimulated(ctx, req.(*QueryGetProtoRevRoutesSimulatedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "osmosis.protorev.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -1047,6 +1453,18 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetProtoRevTokenPairArbRoutes",
 			Handler:    _Query_GetProtoRevTokenPairArbRoutes_Handler,
 		},
+		{
+			MethodName: "GetProtoRevDeveloperAccount",
+			Handler:    _Query_GetProtoRevDeveloperAccount_Handler,
+		},
+		{
+			MethodName: "GetProtoRevBaseDenomPools",
+			Handler:    _Query_GetProtoRevBaseDenomPools_Handler,
+		},
+		{
+			MethodName: "GetProtoRevRoutesSimulated",
+			Handler:    _Query_GetProtoRevRoutesSimulated_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "osmosis/protorev/v1beta1/query.proto",
@@ -1472,42 +1890,225 @@ func (m *QueryGetProtoRevTokenPairArbRoutesResponse) MarshalToSizedBuffer(dAtA [
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryGetProtoRevDeveloperAccountRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+
+func (m *QueryGetProtoRevDeveloperAccountRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryGetProtoRevDeveloperAccountRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetProtoRevNumberOfTradesRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
+func (m *QueryGetProtoRevDeveloperAccountResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetProtoRevDeveloperAccountResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetProtoRevDeveloperAccountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DeveloperAccount) > 0 {
+		i -= len(m.DeveloperAccount)
+		copy(dAtA[i:], m.DeveloperAccount)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DeveloperAccount)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetProtoRevBaseDenomPoolsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetProtoRevBaseDenomPoolsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetProtoRevBaseDenomPoolsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetProtoRevBaseDenomPoolsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetProtoRevBaseDenomPoolsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetProtoRevBaseDenomPoolsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AtomPools) > 0 {
+		for iNdEx := len(m.AtomPools) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AtomPools[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.OsmoPools) > 0 {
+		for iNdEx := len(m.OsmoPools) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OsmoPools[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetProtoRevRoutesSimulatedRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetProtoRevRoutesSimulatedRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetProtoRevRoutesSimulatedRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetProtoRevRoutesSimulatedResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetProtoRevRoutesSimulatedResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetProtoRevRoutesSimulatedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Usage.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryGetProtoRevNumberOfTradesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
 	_ = l
 	return n
 }
@@ -1643,16 +2244,523 @@ func (m *QueryGetProtoRevTokenPairArbRoutesResponse) Size() (n int) {
 			n += 1 + l + sovQuery(uint64(l))
 		}
 	}
-	return n
-}
+	return n
+}
+
+func (m *QueryGetProtoRevDeveloperAccountRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryGetProtoRevDeveloperAccountResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DeveloperAccount)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetProtoRevBaseDenomPoolsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryGetProtoRevBaseDenomPoolsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.OsmoPools) > 0 {
+		for _, e := range m.OsmoPools {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.AtomPools) > 0 {
+		for _, e := range m.AtomPools {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryGetProtoRevRoutesSimulatedRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryGetProtoRevRoutesSimulatedResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Usage.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetProtoRevNumberOfTradesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetProtoRevNumberOfTradesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetProtoRevNumberOfTradesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetProtoRevNumberOfTradesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetProtoRevNumberOfTradesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetProtoRevNumberOfTradesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumberOfTrades", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.NumberOfTrades.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetProtoRevProfitsByDenomRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetProtoRevProfitsByDenomRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetProtoRevProfitsByDenomRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetProtoRevProfitsByDenomResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetProtoRevProfitsByDenomResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetProtoRevProfitsByDenomResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Profit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Profit == nil {
+				m.Profit = &types.Coin{}
+			}
+			if err := m.Profit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetProtoRevAllProfitsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1675,10 +2783,10 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetProtoRevAllProfitsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetProtoRevAllProfitsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1702,7 +2810,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetProtoRevAllProfitsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1725,15 +2833,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetProtoRevAllProfitsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetProtoRevAllProfitsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Profits", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1760,7 +2868,8 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Profits = append(m.Profits, &types.Coin{})
+			if err := m.Profits[len(m.Profits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1785,7 +2894,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetProtoRevNumberOfTradesRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetProtoRevStatisticsByPoolRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1808,12 +2917,31 @@ func (m *QueryGetProtoRevNumberOfTradesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetProtoRevNumberOfTradesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetProtoRevStatisticsByPoolRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetProtoRevNumberOfTradesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetProtoRevStatisticsByPoolRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1835,7 +2963,7 @@ func (m *QueryGetProtoRevNumberOfTradesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetProtoRevNumberOfTradesResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetProtoRevStatisticsByPoolResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1858,17 +2986,17 @@ func (m *QueryGetProtoRevNumberOfTradesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetProtoRevNumberOfTradesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetProtoRevStatisticsByPoolResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetProtoRevNumberOfTradesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetProtoRevStatisticsByPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NumberOfTrades", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Statistics", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1878,23 +3006,25 @@ func (m *QueryGetProtoRevNumberOfTradesResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.NumberOfTrades.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Statistics == nil {
+				m.Statistics = &PoolStatistics{}
+			}
+			if err := m.Statistics.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1919,7 +3049,7 @@ func (m *QueryGetProtoRevNumberOfTradesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetProtoRevProfitsByDenomRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetProtoRevAllStatisticsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1942,44 +3072,12 @@ func (m *QueryGetProtoRevProfitsByDenomRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetProtoRevProfitsByDenomRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetProtoRevAllStatisticsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetProtoRevProfitsByDenomRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetProtoRevAllStatisticsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2001,7 +3099,7 @@ func (m *QueryGetProtoRevProfitsByDenomRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetProtoRevProfitsByDenomResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetProtoRevAllStatisticsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2024,15 +3122,15 @@ func (m *QueryGetProtoRevProfitsByDenomResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetProtoRevProfitsByDenomResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetProtoRevAllStatisticsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetProtoRevProfitsByDenomResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetProtoRevAllStatisticsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Profit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Statistics", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2059,10 +3157,8 @@ func (m *QueryGetProtoRevProfitsByDenomResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Profit == nil {
-				m.Profit = &types.Coin{}
-			}
-			if err := m.Profit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Statistics = append(m.Statistics, PoolStatistics{})
+			if err := m.Statistics[len(m.Statistics)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2087,7 +3183,7 @@ func (m *QueryGetProtoRevProfitsByDenomResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetProtoRevAllProfitsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetProtoRevTokenPairArbRoutesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2110,10 +3206,10 @@ func (m *QueryGetProtoRevAllProfitsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetProtoRevAllProfitsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetProtoRevTokenPairArbRoutesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetProtoRevAllProfitsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetProtoRevTokenPairArbRoutesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2137,7 +3233,7 @@ func (m *QueryGetProtoRevAllProfitsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetProtoRevAllProfitsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetProtoRevTokenPairArbRoutesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2160,15 +3256,15 @@ func (m *QueryGetProtoRevAllProfitsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetProtoRevAllProfitsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetProtoRevTokenPairArbRoutesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetProtoRevAllProfitsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetProtoRevTokenPairArbRoutesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Profits", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Routes", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2195,8 +3291,8 @@ func (m *QueryGetProtoRevAllProfitsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Profits = append(m.Profits, &types.Coin{})
-			if err := m.Profits[len(m.Profits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Routes = append(m.Routes, &TokenPairArbRoutes{})
+			if err := m.Routes[len(m.Routes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2221,7 +3317,7 @@ func (m *QueryGetProtoRevAllProfitsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetProtoRevStatisticsByPoolRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetProtoRevDeveloperAccountRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2244,31 +3340,12 @@ func (m *QueryGetProtoRevStatisticsByPoolRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetProtoRevStatisticsByPoolRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetProtoRevDeveloperAccountRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetProtoRevStatisticsByPoolRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetProtoRevDeveloperAccountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
-			}
-			m.PoolId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.PoolId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2290,7 +3367,7 @@ func (m *QueryGetProtoRevStatisticsByPoolRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetProtoRevStatisticsByPoolResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetProtoRevDeveloperAccountResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2313,17 +3390,17 @@ func (m *QueryGetProtoRevStatisticsByPoolResponse) Unmarshal(dAtA []byte) error
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetProtoRevStatisticsByPoolResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetProtoRevDeveloperAccountResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetProtoRevStatisticsByPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetProtoRevDeveloperAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Statistics", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DeveloperAccount", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2333,27 +3410,23 @@ func (m *QueryGetProtoRevStatisticsByPoolResponse) Unmarshal(dAtA []byte) error
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Statistics == nil {
-				m.Statistics = &PoolStatistics{}
-			}
-			if err := m.Statistics.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.DeveloperAccount = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2376,7 +3449,7 @@ func (m *QueryGetProtoRevStatisticsByPoolResponse) Unmarshal(dAtA []byte) error
 	}
 	return nil
 }
-func (m *QueryGetProtoRevAllStatisticsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetProtoRevBaseDenomPoolsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2399,10 +3472,10 @@ func (m *QueryGetProtoRevAllStatisticsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetProtoRevAllStatisticsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetProtoRevBaseDenomPoolsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetProtoRevAllStatisticsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetProtoRevBaseDenomPoolsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2426,7 +3499,7 @@ func (m *QueryGetProtoRevAllStatisticsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetProtoRevAllStatisticsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetProtoRevBaseDenomPoolsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2449,15 +3522,15 @@ func (m *QueryGetProtoRevAllStatisticsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetProtoRevAllStatisticsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetProtoRevBaseDenomPoolsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetProtoRevAllStatisticsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetProtoRevBaseDenomPoolsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Statistics", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OsmoPools", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2484,8 +3557,42 @@ func (m *QueryGetProtoRevAllStatisticsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Statistics = append(m.Statistics, PoolStatistics{})
-			if err := m.Statistics[len(m.Statistics)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.OsmoPools = append(m.OsmoPools, BaseDenomPool{})
+			if err := m.OsmoPools[len(m.OsmoPools)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AtomPools", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AtomPools = append(m.AtomPools, BaseDenomPool{})
+			if err := m.AtomPools[len(m.AtomPools)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2510,7 +3617,7 @@ func (m *QueryGetProtoRevAllStatisticsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetProtoRevTokenPairArbRoutesRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetProtoRevRoutesSimulatedRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2533,10 +3640,10 @@ func (m *QueryGetProtoRevTokenPairArbRoutesRequest) Unmarshal(dAtA []byte) error
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetProtoRevTokenPairArbRoutesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetProtoRevRoutesSimulatedRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetProtoRevTokenPairArbRoutesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetProtoRevRoutesSimulatedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2560,7 +3667,7 @@ func (m *QueryGetProtoRevTokenPairArbRoutesRequest) Unmarshal(dAtA []byte) error
 	}
 	return nil
 }
-func (m *QueryGetProtoRevTokenPairArbRoutesResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetProtoRevRoutesSimulatedResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2583,15 +3690,15 @@ func (m *QueryGetProtoRevTokenPairArbRoutesResponse) Unmarshal(dAtA []byte) erro
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetProtoRevTokenPairArbRoutesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetProtoRevRoutesSimulatedResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetProtoRevTokenPairArbRoutesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetProtoRevRoutesSimulatedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Routes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Usage", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2618,8 +3725,7 @@ func (m *QueryGetProtoRevTokenPairArbRoutesResponse) Unmarshal(dAtA []byte) erro
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Routes = append(m.Routes, &TokenPairArbRoutes{})
-			if err := m.Routes[len(m.Routes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Usage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
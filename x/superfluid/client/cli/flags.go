@@ -5,4 +5,8 @@ const (
 	FlagSuperfluidAssets = "superfluid-assets"
 	FlagPoolIds          = "pool-ids"
 	FlagOverwrite        = "is-overwrite"
+	FlagRiskFactor       = "risk-factor"
+	FlagPriceDenom       = "price-denom"
+	FlagPriceRoutePools  = "price-route-pool-ids"
+	FlagPriceRouteDenoms = "price-route-denoms"
 )
@@ -20,6 +20,7 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryParams(),
 		GetCmdAllSuperfluidAssets(),
 		GetCmdAssetMultiplier(),
+		GetCmdAssetMultiplierHistory(),
 		GetCmdAllIntermediaryAccounts(),
 		GetCmdConnectedIntermediaryAccount(),
 		GetCmdSuperfluidDelegationAmount(),
@@ -85,6 +86,17 @@ func GetCmdAssetMultiplier() *cobra.Command {
 	)
 }
 
+func GetCmdAssetMultiplierHistory() *cobra.Command {
+	return osmocli.SimpleQueryCmd[*types.AssetMultiplierHistoryRequest](
+		"asset-multiplier-history [denom]",
+		"Query the asset multipliers of each epoch by denom",
+		`{{.Short}}{{.ExampleHeader}}
+{{.CommandPrefix}} asset-multiplier-history gamm/pool/1
+`,
+		types.ModuleName, types.NewQueryClient,
+	)
+}
+
 func GetCmdAllIntermediaryAccounts() *cobra.Command {
 	return osmocli.SimpleQueryCmd[*types.AllIntermediaryAccountsRequest](
 		"all-intermediary-accounts",
@@ -78,6 +78,12 @@ func (s *QueryTestSuite) TestQueriesNeverAlterState() {
 			&types.AssetMultiplierRequest{Denom: "gamm/pool/1"},
 			&types.AssetMultiplierResponse{},
 		},
+		{
+			"Query osmo equivalent multiplier history of an asset",
+			"/osmosis.superfluid.Query/AssetMultiplierHistory",
+			&types.AssetMultiplierHistoryRequest{Denom: "gamm/pool/1"},
+			&types.AssetMultiplierHistoryResponse{},
+		},
 		{
 			"Query asset type",
 			"/osmosis.superfluid.Query/AssetType",
@@ -1,6 +1,7 @@
 package cli
 
 import (
+	"fmt"
 	"strconv"
 	"strings"
 
@@ -10,6 +11,7 @@ import (
 	"github.com/osmosis-labs/osmosis/v13/osmoutils"
 	"github.com/osmosis-labs/osmosis/v13/osmoutils/osmocli"
 	"github.com/osmosis-labs/osmosis/v13/x/superfluid/types"
+	swaproutertypes "github.com/osmosis-labs/osmosis/v13/x/swaprouter/types"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
@@ -26,7 +28,8 @@ func GetTxCmd() *cobra.Command {
 		NewSuperfluidDelegateCmd(),
 		NewSuperfluidUndelegateCmd(),
 		NewSuperfluidUnbondLockCmd(),
-		// NewSuperfluidRedelegateCmd(),
+		NewSuperfluidRedelegateCmd(),
+		NewSuperfluidDelegateToValidatorSetCmd(),
 		NewCmdLockAndSuperfluidDelegate(),
 		NewCmdUnPoolWhitelistedPool(),
 	)
@@ -86,6 +89,20 @@ func NewSuperfluidUnbondLockCmd() *cobra.Command {
 	})
 }
 
+func NewSuperfluidRedelegateCmd() *cobra.Command {
+	return osmocli.BuildTxCli[*types.MsgSuperfluidRedelegate](&osmocli.TxCliDesc{
+		Use:   "redelegate [lock_id] [new_val_addr] [flags]",
+		Short: "superfluid redelegate a lock to a new validator",
+	})
+}
+
+func NewSuperfluidDelegateToValidatorSetCmd() *cobra.Command {
+	return osmocli.BuildTxCli[*types.MsgSuperfluidDelegateToValidatorSet](&osmocli.TxCliDesc{
+		Use:   "delegate-to-validator-set [lock_id] [flags]",
+		Short: "superfluid delegate a lock split across the sender's validator set preferences",
+	})
+}
+
 // NewCmdSubmitSetSuperfluidAssetsProposal implements a command handler for submitting a superfluid asset set proposal transaction.
 func NewCmdSubmitSetSuperfluidAssetsProposal() *cobra.Command {
 	cmd := &cobra.Command{
@@ -131,6 +148,10 @@ func NewCmdSubmitSetSuperfluidAssetsProposal() *cobra.Command {
 	cmd.Flags().String(govcli.FlagDescription, "", "description of proposal")
 	cmd.Flags().String(govcli.FlagDeposit, "", "deposit of proposal")
 	cmd.Flags().String(FlagSuperfluidAssets, "", "The superfluid asset array")
+	cmd.Flags().String(FlagRiskFactor, "", "The risk factor of the superfluid assets, if higher than the minimum risk factor")
+	cmd.Flags().String(FlagPriceDenom, "", "The pool asset to price the pool's assets in, for LP shares of pools without OSMO")
+	cmd.Flags().String(FlagPriceRoutePools, "", "The comma separated pool ids of the route pricing the price denom in OSMO")
+	cmd.Flags().String(FlagPriceRouteDenoms, "", "The comma separated token out denoms of the route pricing the price denom in OSMO")
 
 	return cmd
 }
@@ -202,11 +223,36 @@ func parseSetSuperfluidAssetsArgsToContent(cmd *cobra.Command) (govtypes.Content
 
 	assets := strings.Split(assetsStr, ",")
 
+	var riskFactor *sdk.Dec
+	riskFactorStr, err := cmd.Flags().GetString(FlagRiskFactor)
+	if err != nil {
+		return nil, err
+	}
+	if riskFactorStr != "" {
+		dec, err := sdk.NewDecFromStr(riskFactorStr)
+		if err != nil {
+			return nil, err
+		}
+		riskFactor = &dec
+	}
+
+	priceDenom, err := cmd.Flags().GetString(FlagPriceDenom)
+	if err != nil {
+		return nil, err
+	}
+	priceRoutes, err := parsePriceRoutes(cmd.Flags())
+	if err != nil {
+		return nil, err
+	}
+
 	superfluidAssets := []types.SuperfluidAsset{}
 	for _, asset := range assets {
 		superfluidAssets = append(superfluidAssets, types.SuperfluidAsset{
-			Denom:     asset,
-			AssetType: types.SuperfluidAssetTypeLPShare,
+			Denom:       asset,
+			AssetType:   types.SuperfluidAssetTypeLPShare,
+			RiskFactor:  riskFactor,
+			PriceDenom:  priceDenom,
+			PriceRoutes: priceRoutes,
 		})
 	}
 
@@ -218,6 +264,39 @@ func parseSetSuperfluidAssetsArgsToContent(cmd *cobra.Command) (govtypes.Content
 	return content, nil
 }
 
+// parsePriceRoutes parses the comma separated pool ids and token out denoms of the price routes.
+func parsePriceRoutes(fs *flag.FlagSet) ([]swaproutertypes.SwapAmountInRoute, error) {
+	poolIdsStr, err := fs.GetString(FlagPriceRoutePools)
+	if err != nil {
+		return nil, err
+	}
+	denomsStr, err := fs.GetString(FlagPriceRouteDenoms)
+	if err != nil {
+		return nil, err
+	}
+	if poolIdsStr == "" && denomsStr == "" {
+		return nil, nil
+	}
+
+	poolIds, err := osmoutils.ParseUint64SliceFromString(poolIdsStr, ",")
+	if err != nil {
+		return nil, err
+	}
+	denoms := strings.Split(denomsStr, ",")
+	if len(poolIds) != len(denoms) {
+		return nil, fmt.Errorf("price route pool ids and denoms mismatch")
+	}
+
+	routes := make([]swaproutertypes.SwapAmountInRoute, 0, len(poolIds))
+	for i, poolId := range poolIds {
+		routes = append(routes, swaproutertypes.SwapAmountInRoute{
+			PoolId:        poolId,
+			TokenOutDenom: strings.TrimSpace(denoms[i]),
+		})
+	}
+	return routes, nil
+}
+
 func parseRemoveSuperfluidAssetsArgsToContent(cmd *cobra.Command) (govtypes.Content, error) {
 	title, err := cmd.Flags().GetString(govcli.FlagTitle)
 	if err != nil {
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"errors"
+	"fmt"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -37,11 +38,10 @@ func (k Keeper) AfterEpochStartBeginBlock(ctx sdk.Context) {
 	for _, asset := range k.GetAllSuperfluidAssets(ctx) {
 		err := k.UpdateOsmoEquivalentMultipliers(ctx, asset, curEpoch)
 		if err != nil {
-			// TODO: Revisit what we do here. (halt all distr, only skip this asset)
-			// Since at MVP of feature, we only have one pool of superfluid staking,
-			// we can punt this question.
-			// each of the errors feels like significant misconfig
-			return
+			// The asset is either unwound, or keeps its last multiplier if it could not be priced,
+			// so we skip it and keep updating the other assets.
+			k.Logger(ctx).Error(err.Error())
+			continue
 		}
 	}
 
@@ -49,6 +49,12 @@ func (k Keeper) AfterEpochStartBeginBlock(ctx sdk.Context) {
 	// making staking rewards follow the updated multiplier numbers.
 	ctx.Logger().Info("Refresh all superfluid delegation amounts")
 	k.RefreshIntermediaryDelegationAmounts(ctx)
+
+	// Prune the superfluid redelegations of locks that have completed.
+	k.deleteCompletedLockRedelegations(ctx)
+
+	// Retry rebalancing the validator set preference locks that could not be moved yet.
+	k.rebalanceValidatorSetPreferenceDelegators(ctx, types.MaxValidatorSetPreferenceRebalancesPerEpoch)
 }
 
 func (k Keeper) MoveSuperfluidDelegationRewardToGauges(ctx sdk.Context) {
@@ -106,21 +112,33 @@ func (k Keeper) UpdateOsmoEquivalentMultipliers(ctx sdk.Context, asset types.Sup
 	if asset.AssetType == types.SuperfluidAssetTypeLPShare {
 		// LP_token_Osmo_equivalent = OSMO_amount_on_pool / LP_token_supply
 		poolId := gammtypes.MustGetPoolIdFromShareDenom(asset.Denom)
-		pool, err := k.gk.GetPoolAndPoke(ctx, poolId)
+		pool, err := k.srk.GetPool(ctx, poolId)
 		if err != nil {
 			// Pool has been unexpectedly deleted
 			k.Logger(ctx).Error(err.Error())
-			k.BeginUnwindSuperfluidAsset(ctx, 0, asset)
+			k.BeginUnwindSuperfluidAsset(ctx, newEpochNumber, asset)
 			return err
 		}
 
+		// LP shares of pools without OSMO are priced through TWAPs.
+		// On error, e.g. if there are no TWAP records over the epoch yet, the asset keeps its last multiplier.
+		if len(asset.PriceRoutes) != 0 {
+			multiplier, err := k.calculateOsmoBackingPerShareByTwap(ctx, pool, asset)
+			if err != nil {
+				return err
+			}
+			k.SetOsmoEquivalentMultiplier(ctx, newEpochNumber, asset.Denom, multiplier)
+			return nil
+		}
+
 		// get OSMO amount
 		bondDenom := k.sk.BondDenom(ctx)
 		osmoPoolAsset := pool.GetTotalPoolLiquidity(ctx).AmountOf(bondDenom)
 		if osmoPoolAsset.IsZero() {
 			// Pool has unexpectedly removed Osmo from its assets.
+			err = fmt.Errorf("pool %d has no %s", poolId, bondDenom)
 			k.Logger(ctx).Error(err.Error())
-			k.BeginUnwindSuperfluidAsset(ctx, 0, asset)
+			k.BeginUnwindSuperfluidAsset(ctx, newEpochNumber, asset)
 			return err
 		}
 
@@ -1,6 +1,14 @@
 package keeper
 
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
 var (
 	StakingSyntheticDenom   = stakingSyntheticDenom
 	UnstakingSyntheticDenom = unstakingSyntheticDenom
 )
+
+func (k Keeper) RebalanceValidatorSetPreferenceDelegators(ctx sdk.Context, limit int) {
+	k.rebalanceValidatorSetPreferenceDelegators(ctx, limit)
+}
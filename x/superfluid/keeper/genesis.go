@@ -16,6 +16,11 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState types.GenesisState) {
 		k.SetSuperfluidAsset(ctx, asset)
 	}
 
+	// initialize osmo equivalent multiplier history, before the current multipliers that add to it
+	for _, multiplierRecord := range genState.OsmoEquivalentMultiplierHistory {
+		k.setOsmoEquivalentMultiplierHistoryRecord(ctx, multiplierRecord)
+	}
+
 	// initialize osmo equivalent multipliers
 	for _, multiplierRecord := range genState.OsmoEquivalentMultipliers {
 		k.SetOsmoEquivalentMultiplier(ctx, multiplierRecord.EpochNumber, multiplierRecord.Denom, multiplierRecord.Multiplier)
@@ -37,15 +42,34 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState types.GenesisState) {
 		}
 		k.SetLockIdIntermediaryAccountConnection(ctx, connection.LockId, intermediaryAcc)
 	}
+
+	// initialize superfluid redelegations of locks
+	for _, redelegation := range genState.LockRedelegations {
+		k.SetLockRedelegation(ctx, redelegation)
+	}
+
+	// initialize locks superfluid delegated by validator set preferences
+	for _, lockID := range genState.ValidatorSetPreferenceLockIds {
+		k.SetValidatorSetPreferenceLock(ctx, lockID)
+	}
+
+	// initialize delegators whose validator set preference locks are still to rebalance
+	for _, delegator := range genState.ValidatorSetPreferenceRebalanceDelegators {
+		k.SetValidatorSetPreferenceRebalanceDelegator(ctx, sdk.MustAccAddressFromBech32(delegator))
+	}
 }
 
 // ExportGenesis returns the capability module's exported genesis.
 func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 	return &types.GenesisState{
-		Params:                        k.GetParams(ctx),
-		SuperfluidAssets:              k.GetAllSuperfluidAssets(ctx),
-		OsmoEquivalentMultipliers:     k.GetAllOsmoEquivalentMultipliers(ctx),
-		IntermediaryAccounts:          k.GetAllIntermediaryAccounts(ctx),
-		IntemediaryAccountConnections: k.GetAllLockIdIntermediaryAccountConnections(ctx),
+		Params:                                    k.GetParams(ctx),
+		SuperfluidAssets:                          k.GetAllSuperfluidAssets(ctx),
+		OsmoEquivalentMultipliers:                 k.GetAllOsmoEquivalentMultipliers(ctx),
+		IntermediaryAccounts:                      k.GetAllIntermediaryAccounts(ctx),
+		IntemediaryAccountConnections:             k.GetAllLockIdIntermediaryAccountConnections(ctx),
+		OsmoEquivalentMultiplierHistory:           k.GetAllOsmoEquivalentMultiplierHistory(ctx),
+		LockRedelegations:                         k.GetAllLockRedelegations(ctx),
+		ValidatorSetPreferenceLockIds:             k.GetAllValidatorSetPreferenceLockIds(ctx),
+		ValidatorSetPreferenceRebalanceDelegators: k.GetAllValidatorSetPreferenceRebalanceDelegators(ctx),
 	}
 }
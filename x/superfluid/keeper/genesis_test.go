@@ -34,6 +34,18 @@ var testGenesis = types.GenesisState{
 			Multiplier:  sdk.NewDec(1000),
 		},
 	},
+	OsmoEquivalentMultiplierHistory: []types.OsmoEquivalentMultiplierRecord{
+		{
+			EpochNumber: 0,
+			Denom:       "gamm/pool/1",
+			Multiplier:  sdk.NewDec(900),
+		},
+		{
+			EpochNumber: 1,
+			Denom:       "gamm/pool/1",
+			Multiplier:  sdk.NewDec(1000),
+		},
+	},
 	IntermediaryAccounts: []types.SuperfluidIntermediaryAccount{
 		{
 			Denom:   "gamm/pool/1",
@@ -47,6 +59,17 @@ var testGenesis = types.GenesisState{
 			IntermediaryAccount: "osmo1hpgapnfl3thkevvl0jp3wqtk8jw7mpqumuuc2f",
 		},
 	},
+	LockRedelegations: []types.LockRedelegation{
+		{
+			LockId:         1,
+			SrcValAddr:     "osmovaloper1wd6hqetjvek826ty94ehycedweskctfdx289l8",
+			DstValAddr:     "osmovaloper1cyw4vw20el8e7ez8080md0r8psg25n0cq98a9n",
+			CreationHeight: 1,
+			CompletionTime: now.Add(time.Hour),
+		},
+	},
+	ValidatorSetPreferenceLockIds:             []uint64{1},
+	ValidatorSetPreferenceRebalanceDelegators: []string{"osmo1hpgapnfl3thkevvl0jp3wqtk8jw7mpqumuuc2f"},
 }
 
 func TestMarshalUnmarshalGenesis(t *testing.T) {
@@ -86,11 +109,23 @@ func TestInitGenesis(t *testing.T) {
 	multipliers := app.SuperfluidKeeper.GetAllOsmoEquivalentMultipliers(ctx)
 	require.Equal(t, multipliers, genesis.OsmoEquivalentMultipliers)
 
+	history := app.SuperfluidKeeper.GetAllOsmoEquivalentMultiplierHistory(ctx)
+	require.Equal(t, history, genesis.OsmoEquivalentMultiplierHistory)
+
 	accounts := app.SuperfluidKeeper.GetAllIntermediaryAccounts(ctx)
 	require.Equal(t, accounts, genesis.IntermediaryAccounts)
 
 	connections := app.SuperfluidKeeper.GetAllLockIdIntermediaryAccountConnections(ctx)
 	require.Equal(t, connections, genesis.IntemediaryAccountConnections)
+
+	redelegations := app.SuperfluidKeeper.GetAllLockRedelegations(ctx)
+	require.Equal(t, redelegations, genesis.LockRedelegations)
+
+	validatorSetPreferenceLockIds := app.SuperfluidKeeper.GetAllValidatorSetPreferenceLockIds(ctx)
+	require.Equal(t, validatorSetPreferenceLockIds, genesis.ValidatorSetPreferenceLockIds)
+
+	rebalanceDelegators := app.SuperfluidKeeper.GetAllValidatorSetPreferenceRebalanceDelegators(ctx)
+	require.Equal(t, rebalanceDelegators, genesis.ValidatorSetPreferenceRebalanceDelegators)
 }
 
 func TestExportGenesis(t *testing.T) {
@@ -112,6 +147,10 @@ func TestExportGenesis(t *testing.T) {
 	require.Equal(t, genesisExported.Params, genesis.Params)
 	require.Equal(t, genesisExported.SuperfluidAssets, append(genesis.SuperfluidAssets, asset))
 	require.Equal(t, genesis.OsmoEquivalentMultipliers, genesis.OsmoEquivalentMultipliers)
+	require.Equal(t, genesisExported.OsmoEquivalentMultiplierHistory, genesis.OsmoEquivalentMultiplierHistory)
 	require.Equal(t, genesis.IntermediaryAccounts, genesis.IntermediaryAccounts)
 	require.Equal(t, genesis.IntemediaryAccountConnections, genesis.IntemediaryAccountConnections)
+	require.Equal(t, genesisExported.LockRedelegations, genesis.LockRedelegations)
+	require.Equal(t, genesisExported.ValidatorSetPreferenceLockIds, genesis.ValidatorSetPreferenceLockIds)
+	require.Equal(t, genesisExported.ValidatorSetPreferenceRebalanceDelegators, genesis.ValidatorSetPreferenceRebalanceDelegators)
 }
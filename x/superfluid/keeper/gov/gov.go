@@ -22,14 +22,14 @@ func HandleSetSuperfluidAssetsProposal(ctx sdk.Context, k keeper.Keeper, ek type
 	return nil
 }
 
-func HandleRemoveSuperfluidAssetsProposal(ctx sdk.Context, k keeper.Keeper, p *types.RemoveSuperfluidAssetsProposal) error {
+func HandleRemoveSuperfluidAssetsProposal(ctx sdk.Context, k keeper.Keeper, ek types.EpochKeeper, p *types.RemoveSuperfluidAssetsProposal) error {
+	currentEpoch := ek.GetEpochInfo(ctx, k.GetEpochIdentifier(ctx)).CurrentEpoch
 	for _, denom := range p.SuperfluidAssetDenoms {
 		asset := k.GetSuperfluidAsset(ctx, denom)
-		dummyAsset := types.SuperfluidAsset{}
-		if asset == dummyAsset {
+		if asset.Denom == "" {
 			return fmt.Errorf("superfluid asset %s doesn't exist", denom)
 		}
-		k.BeginUnwindSuperfluidAsset(ctx, 0, asset)
+		k.BeginUnwindSuperfluidAsset(ctx, currentEpoch, asset)
 		events.EmitRemoveSuperfluidAsset(ctx, denom)
 	}
 	return nil
@@ -124,7 +124,7 @@ func (suite *KeeperTestSuite) TestHandleSetSuperfluidAssetsProposal() {
 					})
 				} else {
 					// remove existing superfluid asset via proposal
-					err = gov.HandleRemoveSuperfluidAssetsProposal(suite.Ctx, *suite.App.SuperfluidKeeper, &types.RemoveSuperfluidAssetsProposal{
+					err = gov.HandleRemoveSuperfluidAssetsProposal(suite.Ctx, *suite.App.SuperfluidKeeper, *suite.App.EpochsKeeper, &types.RemoveSuperfluidAssetsProposal{
 						Title:                 "title",
 						Description:           "description",
 						SuperfluidAssetDenoms: govDenoms,
@@ -5,9 +5,12 @@ import (
 	"strings"
 	"time"
 
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/gogo/protobuf/proto"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
@@ -85,6 +88,37 @@ func (q Querier) AssetMultiplier(goCtx context.Context, req *types.AssetMultipli
 	}, nil
 }
 
+// AssetMultiplierHistory returns the osmo equivalent multipliers set for the given denom, by ascending epoch number.
+func (q Querier) AssetMultiplierHistory(goCtx context.Context, req *types.AssetMultiplierHistoryRequest) (*types.AssetMultiplierHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if len(req.Denom) == 0 {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "empty denom")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	historyStore := prefix.NewStore(ctx.KVStore(q.Keeper.storeKey), types.GetKeyPrefixTokenMultiplierHistory(req.Denom))
+
+	records := []types.OsmoEquivalentMultiplierRecord{}
+	pageRes, err := query.Paginate(historyStore, req.Pagination, func(_, value []byte) error {
+		record := types.OsmoEquivalentMultiplierRecord{}
+		if err := proto.Unmarshal(value, &record); err != nil {
+			return err
+		}
+		records = append(records, record)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.AssetMultiplierHistoryResponse{
+		OsmoEquivalentMultipliers: records,
+		Pagination:                pageRes,
+	}, nil
+}
+
 // AllIntermediaryAccounts returns all superfluid intermediary accounts.
 func (q Querier) AllIntermediaryAccounts(goCtx context.Context, _ *types.AllIntermediaryAccountsRequest) (*types.AllIntermediaryAccountsResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
@@ -385,7 +419,7 @@ func (q Querier) EstimateSuperfluidDelegatedAmountByValidatorDenom(goCtx context
 	}
 
 	syntheticOsmoAmt := delegation.Shares.Quo(val.DelegatorShares).MulInt(val.Tokens)
-	baseAmount := q.Keeper.UnriskAdjustOsmoValue(ctx, syntheticOsmoAmt).Quo(q.Keeper.GetOsmoEquivalentMultiplier(ctx, req.Denom)).RoundInt()
+	baseAmount := q.Keeper.UnriskAdjustOsmoValue(ctx, q.Keeper.GetSuperfluidAsset(ctx, req.Denom), syntheticOsmoAmt).Quo(q.Keeper.GetOsmoEquivalentMultiplier(ctx, req.Denom)).RoundInt()
 
 	return &types.EstimateSuperfluidDelegatedAmountByValidatorDenomResponse{
 		TotalDelegatedCoins: sdk.NewCoins(sdk.NewCoin(req.Denom, baseAmount)),
@@ -5,6 +5,8 @@ import (
 
 	epochstypes "github.com/osmosis-labs/osmosis/v13/x/epochs/types"
 	"github.com/osmosis-labs/osmosis/v13/x/superfluid/keeper/internal/events"
+	"github.com/osmosis-labs/osmosis/v13/x/superfluid/types"
+	valsetpreftypes "github.com/osmosis-labs/osmosis/v13/x/valset-pref/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -14,7 +16,10 @@ type Hooks struct {
 	k Keeper
 }
 
-var _ epochstypes.EpochHooks = Hooks{}
+var (
+	_ epochstypes.EpochHooks                      = Hooks{}
+	_ valsetpreftypes.ValidatorSetPreferenceHooks = Hooks{}
+)
 
 // Return the wrapper struct.
 func (k Keeper) Hooks() Hooks {
@@ -51,7 +56,19 @@ func (h Hooks) AfterAddTokensToLock(ctx sdk.Context, address sdk.AccAddress, loc
 func (h Hooks) OnTokenLocked(ctx sdk.Context, address sdk.AccAddress, lockID uint64, amount sdk.Coins, lockDuration time.Duration, unlockTime time.Time) {
 }
 
-func (h Hooks) OnStartUnlock(ctx sdk.Context, address sdk.AccAddress, lockID uint64, amount sdk.Coins, lockDuration time.Duration, unlockTime time.Time) {
+// if a lock starts unlocking while superfluid bonded, which happens when part of a superfluid bonded lock
+// is split off and unlocked, the lock gets superfluid undelegated.
+// If it cannot be, the unlocking fails, so that the lock never unlocks while superfluid delegated.
+func (h Hooks) OnStartUnlock(ctx sdk.Context, address sdk.AccAddress, lockID uint64, amount sdk.Coins, lockDuration time.Duration, unlockTime time.Time) error {
+	intermediaryAccAddr := h.k.GetLockIdIntermediaryAccountConnection(ctx, lockID)
+	if intermediaryAccAddr.Empty() {
+		return nil
+	}
+	if err := h.k.superfluidUndelegateUnlockingLock(ctx, lockID); err != nil {
+		return err
+	}
+	events.EmitSuperfluidUndelegateEvent(ctx, lockID)
+	return nil
 }
 
 func (h Hooks) OnTokenUnlocked(ctx sdk.Context, address sdk.AccAddress, lockID uint64, amount sdk.Coins, lockDuration time.Duration, unlockTime time.Time) {
@@ -63,6 +80,75 @@ func (h Hooks) OnTokenSlashed(ctx sdk.Context, lockID uint64, amount sdk.Coins)
 func (h Hooks) OnLockupExtend(ctx sdk.Context, lockID uint64, oldDuration, newDuration time.Duration) {
 }
 
+// a lock split off a superfluid bonded lock takes its share of the delegation,
+// so it gets connected to the same intermediary account, and takes on the parent's incomplete redelegation.
+// It also keeps following its owner's validator set preferences if the parent does.
+func (h Hooks) OnLockSplit(ctx sdk.Context, parentLockID, childLockID uint64, amount sdk.Coins) {
+	intermediaryAcc, found := h.k.GetIntermediaryAccountFromLockId(ctx, parentLockID)
+	if found {
+		h.k.SetLockIdIntermediaryAccountConnection(ctx, childLockID, intermediaryAcc)
+	}
+	if h.k.IsValidatorSetPreferenceLock(ctx, parentLockID) {
+		h.k.SetValidatorSetPreferenceLock(ctx, childLockID)
+	}
+	redelegation, found := h.k.getIncompleteLockRedelegation(ctx, parentLockID)
+	if found {
+		redelegation.LockId = childLockID
+		h.k.SetLockRedelegation(ctx, redelegation)
+	}
+}
+
+// lockup rejects transfers of locks with synthetic lockups, so a transferred lock is neither
+// superfluid delegated nor superfluid undelegating. It no longer follows its previous owner's
+// validator set preferences either.
+func (h Hooks) OnLockTransfer(ctx sdk.Context, lockID uint64, prevOwner, newOwner sdk.AccAddress) {
+	h.k.DeleteValidatorSetPreferenceLock(ctx, lockID)
+}
+
+// the locks merged share their synthetic lockups, so they are either all connected to the same
+// intermediary account, or none of them are. The connection is moved to the new lock,
+// along with the incomplete redelegation of the merged locks that completes last.
+// The new lock follows its owner's validator set preferences if any of the merged locks did.
+func (h Hooks) OnLocksMerged(ctx sdk.Context, mergedLockIDs []uint64, newLockID uint64) {
+	isValidatorSetPreferenceLock := false
+	for _, lockID := range mergedLockIDs {
+		if h.k.IsValidatorSetPreferenceLock(ctx, lockID) {
+			isValidatorSetPreferenceLock = true
+			h.k.DeleteValidatorSetPreferenceLock(ctx, lockID)
+		}
+	}
+	if isValidatorSetPreferenceLock {
+		h.k.SetValidatorSetPreferenceLock(ctx, newLockID)
+	}
+
+	intermediaryAcc, found := h.k.GetIntermediaryAccountFromLockId(ctx, mergedLockIDs[0])
+	if !found {
+		return
+	}
+	var lastRedelegation *types.LockRedelegation
+	for _, lockID := range mergedLockIDs {
+		h.k.DeleteLockIdIntermediaryAccountConnection(ctx, lockID)
+		redelegation, found := h.k.getIncompleteLockRedelegation(ctx, lockID)
+		if found && (lastRedelegation == nil || redelegation.CompletionTime.After(lastRedelegation.CompletionTime)) {
+			lastRedelegation = &redelegation
+		}
+		h.k.DeleteLockRedelegation(ctx, lockID)
+	}
+	h.k.SetLockIdIntermediaryAccountConnection(ctx, newLockID, intermediaryAcc)
+	if lastRedelegation != nil {
+		lastRedelegation.LockId = newLockID
+		h.k.SetLockRedelegation(ctx, *lastRedelegation)
+	}
+}
+
+// validator set preference hooks
+// the locks superfluid delegated by the delegator's validator set preferences are rebalanced
+// to follow the new preferences.
+func (h Hooks) AfterValidatorSetPreferenceSet(ctx sdk.Context, delegator sdk.AccAddress) error {
+	h.k.RebalanceValidatorSetPreferenceLocks(ctx, delegator)
+	return nil
+}
+
 // staking hooks.
 func (h Hooks) AfterValidatorCreated(ctx sdk.Context, valAddr sdk.ValAddress)   {}
 func (h Hooks) BeforeValidatorModified(ctx sdk.Context, valAddr sdk.ValAddress) {}
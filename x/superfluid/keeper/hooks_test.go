@@ -8,6 +8,7 @@ import (
 
 	lockupkeeper "github.com/osmosis-labs/osmosis/v13/x/lockup/keeper"
 	lockuptypes "github.com/osmosis-labs/osmosis/v13/x/lockup/types"
+	"github.com/osmosis-labs/osmosis/v13/x/superfluid/keeper"
 	"github.com/osmosis-labs/osmosis/v13/x/superfluid/types"
 )
 
@@ -364,3 +365,208 @@ func (suite *KeeperTestSuite) TestAfterAddTokensToLock_Event() {
 		suite.AssertEventEmitted(suite.Ctx, types.TypeEvtSuperfluidIncreaseDelegation, 1)
 	}
 }
+
+// TestSplitSuperfluidDelegatedLock tests that a lock split off a superfluid delegated lock stays superfluid delegated,
+// unless it is split off to be unlocked, in which case it gets superfluid undelegated, or the unlocking fails.
+func (suite *KeeperTestSuite) TestSplitSuperfluidDelegatedLock() {
+	testCases := []struct {
+		name     string
+		unlocked bool
+		// undelegationFails leaves the intermediary account too little delegation to undelegate the lock split off.
+		undelegationFails bool
+	}{
+		{
+			name: "split lock",
+		},
+		{
+			name:     "partially unlock",
+			unlocked: true,
+		},
+		{
+			name:              "partially unlock fails if the lock split off cannot be superfluid undelegated",
+			unlocked:          true,
+			undelegationFails: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			valAddrs := suite.SetupValidators([]stakingtypes.BondStatus{stakingtypes.Bonded})
+			denoms, _ := suite.SetupGammPoolsAndSuperfluidAssets([]sdk.Dec{sdk.NewDec(20)})
+			_, intermediaryAccs, locks := suite.setupSuperfluidDelegations(valAddrs, []superfluidDelegation{{0, 0, 0, 1000000}}, denoms)
+			lock, intermediaryAcc := locks[0], intermediaryAccs[0]
+			sender, err := sdk.AccAddressFromBech32(lock.Owner)
+			suite.Require().NoError(err)
+			stakingSynthDenom := keeper.StakingSyntheticDenom(denoms[0], valAddrs[0].String())
+			unstakingSynthDenom := keeper.UnstakingSyntheticDenom(denoms[0], valAddrs[0].String())
+
+			delegationBefore, found := suite.App.StakingKeeper.GetDelegation(suite.Ctx, intermediaryAcc.GetAccAddress(), valAddrs[0])
+			suite.Require().True(found)
+
+			lockupMsgServer := lockupkeeper.NewMsgServerImpl(suite.App.LockupKeeper)
+			c := sdk.WrapSDKContext(suite.Ctx)
+			if tc.undelegationFails {
+				_, err = suite.App.StakingKeeper.InstantUndelegate(suite.Ctx, intermediaryAcc.GetAccAddress(), valAddrs[0], delegationBefore.Shares.Sub(sdk.OneDec()))
+				suite.Require().NoError(err)
+
+				// the tx fails, so its state changes are discarded
+				cacheCtx, _ := suite.Ctx.CacheContext()
+				_, err := lockupMsgServer.BeginUnlocking(sdk.WrapSDKContext(cacheCtx), lockuptypes.NewMsgBeginUnlocking(sender, lock.ID, sdk.NewCoins(sdk.NewInt64Coin(denoms[0], 400000))))
+				suite.Require().Error(err)
+				return
+			}
+			var childLockID uint64
+			if tc.unlocked {
+				resp, err := lockupMsgServer.BeginUnlocking(c, lockuptypes.NewMsgBeginUnlocking(sender, lock.ID, sdk.NewCoins(sdk.NewInt64Coin(denoms[0], 400000))))
+				suite.Require().NoError(err)
+				childLockID = resp.UnlockingLockId
+			} else {
+				resp, err := lockupMsgServer.SplitLock(c, lockuptypes.NewMsgSplitLock(sender, lock.ID, []sdk.Int{sdk.NewInt(400000)}))
+				suite.Require().NoError(err)
+				childLockID = resp.NewLockIds[0]
+			}
+
+			// the remainder of the lock stays superfluid delegated
+			suite.Require().Equal(intermediaryAcc.GetAccAddress(), suite.App.SuperfluidKeeper.GetLockIdIntermediaryAccountConnection(suite.Ctx, lock.ID))
+			_, err = suite.App.LockupKeeper.GetSyntheticLockup(suite.Ctx, lock.ID, stakingSynthDenom)
+			suite.Require().NoError(err)
+
+			childLock, err := suite.App.LockupKeeper.GetLockByID(suite.Ctx, childLockID)
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.unlocked, childLock.IsUnlocking())
+
+			delegationAfter, found := suite.App.StakingKeeper.GetDelegation(suite.Ctx, intermediaryAcc.GetAccAddress(), valAddrs[0])
+			suite.Require().True(found)
+
+			if tc.unlocked {
+				// the lock split off is superfluid undelegating
+				suite.Require().True(suite.App.SuperfluidKeeper.GetLockIdIntermediaryAccountConnection(suite.Ctx, childLockID).Empty())
+				_, err = suite.App.LockupKeeper.GetSyntheticLockup(suite.Ctx, childLockID, stakingSynthDenom)
+				suite.Require().Error(err)
+				_, err = suite.App.LockupKeeper.GetSyntheticLockup(suite.Ctx, childLockID, unstakingSynthDenom)
+				suite.Require().NoError(err)
+				suite.Require().True(delegationAfter.Shares.LT(delegationBefore.Shares))
+			} else {
+				// the lock split off is superfluid delegated, and can be undelegated on its own
+				suite.Require().Equal(intermediaryAcc.GetAccAddress(), suite.App.SuperfluidKeeper.GetLockIdIntermediaryAccountConnection(suite.Ctx, childLockID))
+				_, err = suite.App.LockupKeeper.GetSyntheticLockup(suite.Ctx, childLockID, stakingSynthDenom)
+				suite.Require().NoError(err)
+				suite.Require().Equal(delegationBefore.Shares, delegationAfter.Shares)
+
+				err = suite.App.SuperfluidKeeper.SuperfluidUndelegate(suite.Ctx, lock.Owner, childLockID)
+				suite.Require().NoError(err)
+			}
+
+			// check invariant is fine
+			reason, broken := keeper.AllInvariants(*suite.App.SuperfluidKeeper)(suite.Ctx)
+			suite.Require().False(broken, reason)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestTransferSuperfluidDelegatedLock() {
+	suite.SetupTest()
+
+	valAddrs := suite.SetupValidators([]stakingtypes.BondStatus{stakingtypes.Bonded})
+	denoms, _ := suite.SetupGammPoolsAndSuperfluidAssets([]sdk.Dec{sdk.NewDec(20)})
+	_, _, locks := suite.setupSuperfluidDelegations(valAddrs, []superfluidDelegation{{0, 0, 0, 1000000}}, denoms)
+	lock := locks[0]
+	sender, err := sdk.AccAddressFromBech32(lock.Owner)
+	suite.Require().NoError(err)
+	newOwner := suite.TestAccs[1]
+
+	// a superfluid delegated lock cannot be transferred
+	lockupMsgServer := lockupkeeper.NewMsgServerImpl(suite.App.LockupKeeper)
+	_, err = lockupMsgServer.TransferLock(sdk.WrapSDKContext(suite.Ctx), lockuptypes.NewMsgTransferLock(sender, lock.ID, newOwner))
+	suite.Require().Error(err)
+
+	// nor can it be once superfluid undelegated, while it is still superfluid unbonding
+	err = suite.App.SuperfluidKeeper.SuperfluidUndelegate(suite.Ctx, sender.String(), lock.ID)
+	suite.Require().NoError(err)
+	_, err = lockupMsgServer.TransferLock(sdk.WrapSDKContext(suite.Ctx), lockuptypes.NewMsgTransferLock(sender, lock.ID, newOwner))
+	suite.Require().Error(err)
+
+	lockAfter, err := suite.App.LockupKeeper.GetLockByID(suite.Ctx, lock.ID)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sender.String(), lockAfter.Owner)
+	suite.Require().True(suite.App.SuperfluidKeeper.GetLockIdIntermediaryAccountConnection(suite.Ctx, lock.ID).Empty())
+
+	// check invariant is fine
+	reason, broken := keeper.AllInvariants(*suite.App.SuperfluidKeeper)(suite.Ctx)
+	suite.Require().False(broken, reason)
+}
+
+func (suite *KeeperTestSuite) TestTransferValidatorSetPreferenceLock() {
+	suite.SetupTest()
+
+	valAddrs := suite.SetupValidators([]stakingtypes.BondStatus{stakingtypes.Bonded})
+	denoms, _ := suite.SetupGammPoolsAndSuperfluidAssets([]sdk.Dec{sdk.NewDec(20)})
+	delAddr := suite.TestAccs[0]
+	err := suite.setValidatorSetPreference(delAddr, valAddrs, []sdk.Dec{sdk.OneDec()})
+	suite.Require().NoError(err)
+
+	unbondingDuration := suite.App.StakingKeeper.GetParams(suite.Ctx).UnbondingTime
+	lockID := suite.LockTokens(delAddr, sdk.NewCoins(sdk.NewInt64Coin(denoms[0], 1000000)), unbondingDuration)
+	_, err = suite.App.SuperfluidKeeper.SuperfluidDelegateToValidatorSet(suite.Ctx, delAddr.String(), lockID)
+	suite.Require().NoError(err)
+
+	// a lock superfluid delegated by validator set preferences cannot be transferred
+	lockupMsgServer := lockupkeeper.NewMsgServerImpl(suite.App.LockupKeeper)
+	_, err = lockupMsgServer.TransferLock(sdk.WrapSDKContext(suite.Ctx), lockuptypes.NewMsgTransferLock(delAddr, lockID, suite.TestAccs[1]))
+	suite.Require().Error(err)
+	suite.Require().True(suite.App.SuperfluidKeeper.IsValidatorSetPreferenceLock(suite.Ctx, lockID))
+
+	// a transferred lock no longer follows the validator set preferences of its previous owner
+	plainLockID := suite.LockTokens(delAddr, sdk.NewCoins(sdk.NewInt64Coin(denoms[0], 1000000)), unbondingDuration+time.Hour)
+	suite.App.SuperfluidKeeper.SetValidatorSetPreferenceLock(suite.Ctx, plainLockID)
+	_, err = lockupMsgServer.TransferLock(sdk.WrapSDKContext(suite.Ctx), lockuptypes.NewMsgTransferLock(delAddr, plainLockID, suite.TestAccs[1]))
+	suite.Require().NoError(err)
+	suite.Require().False(suite.App.SuperfluidKeeper.IsValidatorSetPreferenceLock(suite.Ctx, plainLockID))
+}
+
+func (suite *KeeperTestSuite) TestMergeSuperfluidDelegatedLocks() {
+	suite.SetupTest()
+
+	valAddrs := suite.SetupValidators([]stakingtypes.BondStatus{stakingtypes.Bonded})
+	denoms, _ := suite.SetupGammPoolsAndSuperfluidAssets([]sdk.Dec{sdk.NewDec(20)})
+	_, intermediaryAccs, locks := suite.setupSuperfluidDelegations(valAddrs, []superfluidDelegation{{0, 0, 0, 1000000}}, denoms)
+	lock, intermediaryAcc := locks[0], intermediaryAccs[0]
+	sender, err := sdk.AccAddressFromBech32(lock.Owner)
+	suite.Require().NoError(err)
+	stakingSynthDenom := keeper.StakingSyntheticDenom(denoms[0], valAddrs[0].String())
+
+	delegationBefore, found := suite.App.StakingKeeper.GetDelegation(suite.Ctx, intermediaryAcc.GetAccAddress(), valAddrs[0])
+	suite.Require().True(found)
+
+	// split the lock, keeping both locks superfluid delegated, and merge them back
+	lockupMsgServer := lockupkeeper.NewMsgServerImpl(suite.App.LockupKeeper)
+	c := sdk.WrapSDKContext(suite.Ctx)
+	splitResp, err := lockupMsgServer.SplitLock(c, lockuptypes.NewMsgSplitLock(sender, lock.ID, []sdk.Int{sdk.NewInt(400000)}))
+	suite.Require().NoError(err)
+	mergedLockIDs := []uint64{lock.ID, splitResp.NewLockIds[0]}
+	mergeResp, err := lockupMsgServer.MergeLocks(c, lockuptypes.NewMsgMergeLocks(sender, mergedLockIDs))
+	suite.Require().NoError(err)
+
+	// the connection to the intermediary account is moved to the new lock, along with the synthetic lockup
+	for _, lockID := range mergedLockIDs {
+		suite.Require().True(suite.App.SuperfluidKeeper.GetLockIdIntermediaryAccountConnection(suite.Ctx, lockID).Empty())
+	}
+	suite.Require().Equal(intermediaryAcc.GetAccAddress(), suite.App.SuperfluidKeeper.GetLockIdIntermediaryAccountConnection(suite.Ctx, mergeResp.NewLockId))
+	_, err = suite.App.LockupKeeper.GetSyntheticLockup(suite.Ctx, mergeResp.NewLockId, stakingSynthDenom)
+	suite.Require().NoError(err)
+
+	delegationAfter, found := suite.App.StakingKeeper.GetDelegation(suite.Ctx, intermediaryAcc.GetAccAddress(), valAddrs[0])
+	suite.Require().True(found)
+	suite.Require().Equal(delegationBefore.Shares, delegationAfter.Shares)
+
+	// check invariant is fine
+	reason, broken := keeper.AllInvariants(*suite.App.SuperfluidKeeper)(suite.Ctx)
+	suite.Require().False(broken, reason)
+
+	// the new lock can be undelegated
+	err = suite.App.SuperfluidKeeper.SuperfluidUndelegate(suite.Ctx, lock.Owner, mergeResp.NewLockId)
+	suite.Require().NoError(err)
+}
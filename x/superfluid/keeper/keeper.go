@@ -18,14 +18,17 @@ type Keeper struct {
 	storeKey   sdk.StoreKey
 	paramSpace paramtypes.Subspace
 
-	ak authkeeper.AccountKeeper
-	bk types.BankKeeper
-	sk types.StakingKeeper
-	ck types.CommunityPoolKeeper
-	ek types.EpochKeeper
-	lk types.LockupKeeper
-	gk types.GammKeeper
-	ik types.IncentivesKeeper
+	ak   authkeeper.AccountKeeper
+	bk   types.BankKeeper
+	sk   types.StakingKeeper
+	ck   types.CommunityPoolKeeper
+	ek   types.EpochKeeper
+	lk   types.LockupKeeper
+	gk   types.GammKeeper
+	srk  types.SwapRouterKeeper
+	ik   types.IncentivesKeeper
+	tk   types.TwapKeeper
+	vspk types.ValidatorSetPreferenceKeeper
 
 	lms types.LockupMsgServer
 }
@@ -33,7 +36,7 @@ type Keeper struct {
 var _ govtypes.StakingKeeper = (*Keeper)(nil)
 
 // NewKeeper returns an instance of Keeper.
-func NewKeeper(storeKey sdk.StoreKey, paramSpace paramtypes.Subspace, ak authkeeper.AccountKeeper, bk types.BankKeeper, sk types.StakingKeeper, dk types.CommunityPoolKeeper, ek types.EpochKeeper, lk types.LockupKeeper, gk types.GammKeeper, ik types.IncentivesKeeper, lms types.LockupMsgServer) *Keeper {
+func NewKeeper(storeKey sdk.StoreKey, paramSpace paramtypes.Subspace, ak authkeeper.AccountKeeper, bk types.BankKeeper, sk types.StakingKeeper, dk types.CommunityPoolKeeper, ek types.EpochKeeper, lk types.LockupKeeper, gk types.GammKeeper, srk types.SwapRouterKeeper, ik types.IncentivesKeeper, tk types.TwapKeeper, vspk types.ValidatorSetPreferenceKeeper, lms types.LockupMsgServer) *Keeper {
 	// set KeyTable if it has not already been set
 	if !paramSpace.HasKeyTable() {
 		paramSpace = paramSpace.WithKeyTable(types.ParamKeyTable())
@@ -49,7 +52,10 @@ func NewKeeper(storeKey sdk.StoreKey, paramSpace paramtypes.Subspace, ak authkee
 		ek:         ek,
 		lk:         lk,
 		gk:         gk,
+		srk:        srk,
 		ik:         ik,
+		tk:         tk,
+		vspk:       vspk,
 
 		lms: lms,
 	}
@@ -0,0 +1,83 @@
+package keeper
+
+import (
+	"github.com/gogo/protobuf/proto"
+
+	"github.com/osmosis-labs/osmosis/v13/x/superfluid/types"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SetLockRedelegation sets the latest superfluid redelegation of a lock.
+func (k Keeper) SetLockRedelegation(ctx sdk.Context, redelegation types.LockRedelegation) {
+	store := ctx.KVStore(k.storeKey)
+	prefixStore := prefix.NewStore(store, types.KeyPrefixLockRedelegation)
+	bz, err := proto.Marshal(&redelegation)
+	if err != nil {
+		panic(err)
+	}
+	prefixStore.Set(sdk.Uint64ToBigEndian(redelegation.LockId), bz)
+}
+
+// GetLockRedelegation returns the latest superfluid redelegation of a lock, and a bool if found / not found.
+func (k Keeper) GetLockRedelegation(ctx sdk.Context, lockID uint64) (types.LockRedelegation, bool) {
+	redelegation := types.LockRedelegation{}
+	store := ctx.KVStore(k.storeKey)
+	prefixStore := prefix.NewStore(store, types.KeyPrefixLockRedelegation)
+	bz := prefixStore.Get(sdk.Uint64ToBigEndian(lockID))
+	if bz == nil {
+		return redelegation, false
+	}
+	err := proto.Unmarshal(bz, &redelegation)
+	if err != nil {
+		panic(err)
+	}
+	return redelegation, true
+}
+
+// DeleteLockRedelegation deletes the superfluid redelegation record of a lock.
+func (k Keeper) DeleteLockRedelegation(ctx sdk.Context, lockID uint64) {
+	store := ctx.KVStore(k.storeKey)
+	prefixStore := prefix.NewStore(store, types.KeyPrefixLockRedelegation)
+	prefixStore.Delete(sdk.Uint64ToBigEndian(lockID))
+}
+
+// GetAllLockRedelegations returns the superfluid redelegation records of all locks.
+func (k Keeper) GetAllLockRedelegations(ctx sdk.Context) []types.LockRedelegation {
+	store := ctx.KVStore(k.storeKey)
+	prefixStore := prefix.NewStore(store, types.KeyPrefixLockRedelegation)
+	iterator := prefixStore.Iterator(nil, nil)
+	defer iterator.Close()
+
+	redelegations := []types.LockRedelegation{}
+	for ; iterator.Valid(); iterator.Next() {
+		redelegation := types.LockRedelegation{}
+
+		err := proto.Unmarshal(iterator.Value(), &redelegation)
+		if err != nil {
+			panic(err)
+		}
+
+		redelegations = append(redelegations, redelegation)
+	}
+	return redelegations
+}
+
+// getIncompleteLockRedelegation returns the latest superfluid redelegation of a lock if it has not completed yet.
+func (k Keeper) getIncompleteLockRedelegation(ctx sdk.Context, lockID uint64) (types.LockRedelegation, bool) {
+	redelegation, found := k.GetLockRedelegation(ctx, lockID)
+	if !found || !redelegation.CompletionTime.After(ctx.BlockTime()) {
+		return types.LockRedelegation{}, false
+	}
+	return redelegation, true
+}
+
+// deleteCompletedLockRedelegations deletes the superfluid redelegations of locks that have completed.
+func (k Keeper) deleteCompletedLockRedelegations(ctx sdk.Context) {
+	for _, redelegation := range k.GetAllLockRedelegations(ctx) {
+		if !redelegation.CompletionTime.After(ctx.BlockTime()) {
+			k.DeleteLockRedelegation(ctx, redelegation.LockId)
+		}
+	}
+}
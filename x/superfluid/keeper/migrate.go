@@ -0,0 +1,98 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	gammtypes "github.com/osmosis-labs/osmosis/v13/x/gamm/types"
+	lockuptypes "github.com/osmosis-labs/osmosis/v13/x/lockup/types"
+
+	"github.com/osmosis-labs/osmosis/v13/x/superfluid/types"
+)
+
+var _ gammtypes.LockedLiquidityMigrator = Keeper{}
+
+// MigrateLockedLiquidity migrates the shares of pool #{poolIdLeaving} held in the given lock to pool #{poolIdEntering}.
+// The lock keeps its ID, duration and remaining unlocking time, and holds the shares of the pool entered.
+// If the lock is superfluid delegated, the delegation is moved to the shares of the pool entered
+// on the same validator, which thus must be a superfluid asset as well, and the lock keeps following
+// its owner's validator set preferences if it did.
+// Only whole locks can be migrated, and locks that are superfluid undelegating cannot be migrated.
+func (k Keeper) MigrateLockedLiquidity(
+	ctx sdk.Context,
+	sender sdk.AccAddress,
+	lockId uint64,
+	poolIdLeaving, poolIdEntering uint64,
+	sharesToMigrate sdk.Int,
+	tokenOutMins sdk.Coins,
+	shareOutMinAmount sdk.Int,
+) (sharesOut sdk.Int, err error) {
+	// 1) Check that the lock holds exactly sharesToMigrate shares of the pool leaving.
+	lock, err := k.validateLockForMigration(ctx, sender, lockId, poolIdLeaving, sharesToMigrate)
+	if err != nil {
+		return sdk.Int{}, err
+	}
+
+	// 2) If superfluid delegated, undelegate instantly, remembering the validator to delegate to again,
+	// and whether the lock was delegated by its owner's validator set preferences.
+	valAddr := ""
+	isValidatorSetPreferenceLock := k.IsValidatorSetPreferenceLock(ctx, lockId)
+	if intermediaryAcc, found := k.GetIntermediaryAccountFromLockId(ctx, lockId); found {
+		valAddr = intermediaryAcc.ValAddr
+		err = k.undelegateCommon(ctx, lock, intermediaryAcc)
+		if err != nil {
+			return sdk.Int{}, err
+		}
+	} else if k.alreadySuperfluidStaking(ctx, lockId) {
+		return sdk.Int{}, sdkerrors.Wrapf(types.ErrLockMigrationNotAllowed, "lock %d is superfluid undelegating", lockId)
+	}
+
+	// 3) Migrate the locked shares to the pool entering, keeping them in the same lock.
+	_, err = k.lk.MigrateLockedCoins(ctx, lockId, func(lockedCoins sdk.Coins) (sdk.Coins, error) {
+		sharesOut, err = k.gk.MigrateLiquidity(ctx, sender, poolIdLeaving, poolIdEntering, lockedCoins[0].Amount, tokenOutMins, shareOutMinAmount)
+		if err != nil {
+			return nil, err
+		}
+		return sdk.NewCoins(sdk.NewCoin(gammtypes.GetPoolShareDenom(poolIdEntering), sharesOut)), nil
+	})
+	if err != nil {
+		return sdk.Int{}, err
+	}
+
+	// 4) Superfluid delegate the migrated lock to the validator it was delegated to.
+	if valAddr != "" {
+		err = k.SuperfluidDelegate(ctx, sender.String(), lockId, valAddr)
+		if err != nil {
+			return sdk.Int{}, err
+		}
+		if isValidatorSetPreferenceLock {
+			k.SetValidatorSetPreferenceLock(ctx, lockId)
+		}
+	}
+
+	return sharesOut, nil
+}
+
+// validateLockForMigration checks that the lock is owned by sender, and holds exactly
+// sharesToMigrate shares of the given pool.
+func (k Keeper) validateLockForMigration(ctx sdk.Context, sender sdk.AccAddress, lockId, poolId uint64, sharesToMigrate sdk.Int) (*lockuptypes.PeriodLock, error) {
+	lock, err := k.lk.GetLockByID(ctx, lockId)
+	if err != nil {
+		return nil, err
+	}
+
+	err = k.validateLockForSF(ctx, lock, sender.String())
+	if err != nil {
+		return nil, err
+	}
+
+	lockedShares := lock.Coins[0]
+	if lockedShares.Denom != gammtypes.GetPoolShareDenom(poolId) {
+		return nil, sdkerrors.Wrapf(types.ErrLockMigrationNotAllowed, "lock %d holds %s, not shares of pool %d", lockId, lockedShares.Denom, poolId)
+	}
+	if !lockedShares.Amount.Equal(sharesToMigrate) {
+		return nil, sdkerrors.Wrapf(types.ErrLockMigrationNotAllowed, "only whole locks can be migrated, lock %d holds %s shares", lockId, lockedShares.Amount)
+	}
+
+	return lock, nil
+}
@@ -0,0 +1,177 @@
+package keeper_test
+
+import (
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	gammkeeper "github.com/osmosis-labs/osmosis/v13/x/gamm/keeper"
+	"github.com/osmosis-labs/osmosis/v13/x/gamm/pool-models/balancer"
+	gammtypes "github.com/osmosis-labs/osmosis/v13/x/gamm/types"
+	"github.com/osmosis-labs/osmosis/v13/x/superfluid/keeper"
+	"github.com/osmosis-labs/osmosis/v13/x/superfluid/types"
+)
+
+// TestMigrateLockedLiquidity tests that migrating the liquidity of a lock through MsgMigrateLiquidity
+// keeps the lock, and any superfluid delegation of it, on the shares of the pool entered.
+func (suite *KeeperTestSuite) TestMigrateLockedLiquidity() {
+	testCases := map[string]struct {
+		superfluidDelegated    bool
+		validatorSetPreference bool
+		superfluidUndelegating bool
+		unlocking              bool
+		enteringNotSuperfluid  bool
+		partialShares          bool
+		expectedErr            error
+	}{
+		"lock that is not superfluid delegated, not unlocking": {},
+		"lock that is not superfluid delegated, unlocking": {
+			unlocking: true,
+		},
+		"lock that is superfluid delegated": {
+			superfluidDelegated: true,
+		},
+		"lock that is superfluid delegated by validator set preferences": {
+			superfluidDelegated:    true,
+			validatorSetPreference: true,
+		},
+		"lock that is superfluid delegated, to a pool that is not a superfluid asset": {
+			superfluidDelegated:   true,
+			enteringNotSuperfluid: true,
+			expectedErr:           types.ErrNonSuperfluidAsset,
+		},
+		"lock that is superfluid undelegating": {
+			superfluidDelegated:    true,
+			superfluidUndelegating: true,
+			expectedErr:            types.ErrLockMigrationNotAllowed,
+		},
+		"part of the shares of a lock": {
+			partialShares: true,
+			expectedErr:   types.ErrLockMigrationNotAllowed,
+		},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+		suite.Run(name, func() {
+			suite.SetupTest()
+			ctx := suite.Ctx
+			bankKeeper := suite.App.BankKeeper
+			superfluidKeeper := suite.App.SuperfluidKeeper
+			lockupKeeper := suite.App.LockupKeeper
+			stakingKeeper := suite.App.StakingKeeper
+			msgServer := gammkeeper.NewMsgServerImpl(suite.App.GAMMKeeper)
+
+			delAddrs := CreateRandomAccounts(2)
+			poolCreateAcc := delAddrs[0]
+			poolJoinAcc := delAddrs[1]
+			for _, acc := range delAddrs {
+				err := simapp.FundAccount(bankKeeper, ctx, acc, defaultAcctFunds)
+				suite.Require().NoError(err)
+			}
+			valAddr := suite.SetupValidator(stakingtypes.Bonded)
+
+			// create two pools of "stake" and "foo", and join the first one
+			poolIds := make([]uint64, 2)
+			for i := range poolIds {
+				msg := balancer.NewMsgCreateBalancerPool(poolCreateAcc, balancer.PoolParams{
+					SwapFee: sdk.NewDecWithPrec(1, 2),
+					ExitFee: sdk.NewDec(0),
+				}, defaultPoolAssets, defaultFutureGovernor)
+				poolId, err := suite.App.SwapRouterKeeper.CreatePool(ctx, msg)
+				suite.Require().NoError(err)
+				poolIds[i] = poolId
+			}
+			poolIdLeaving, poolIdEntering := poolIds[0], poolIds[1]
+			_, _, err := suite.App.GAMMKeeper.JoinPoolNoSwap(ctx, poolJoinAcc, poolIdLeaving, gammtypes.OneShare.MulRaw(50), sdk.Coins{})
+			suite.Require().NoError(err)
+
+			leavingDenom := gammtypes.GetPoolShareDenom(poolIdLeaving)
+			enteringDenom := gammtypes.GetPoolShareDenom(poolIdEntering)
+			superfluidDenoms := []string{leavingDenom}
+			if !tc.enteringNotSuperfluid {
+				superfluidDenoms = append(superfluidDenoms, enteringDenom)
+			}
+			for _, denom := range superfluidDenoms {
+				err = superfluidKeeper.AddNewSuperfluidAsset(ctx, types.SuperfluidAsset{
+					Denom:     denom,
+					AssetType: types.SuperfluidAssetTypeLPShare,
+				})
+				suite.Require().NoError(err)
+			}
+
+			lockedShares := bankKeeper.GetBalance(ctx, poolJoinAcc, leavingDenom)
+			unbondingDuration := stakingKeeper.GetParams(ctx).UnbondingTime
+			lockID := suite.LockTokens(poolJoinAcc, sdk.NewCoins(lockedShares), unbondingDuration)
+
+			if tc.superfluidDelegated && tc.validatorSetPreference {
+				err = suite.setValidatorSetPreference(poolJoinAcc, []sdk.ValAddress{valAddr}, []sdk.Dec{sdk.OneDec()})
+				suite.Require().NoError(err)
+				_, err = superfluidKeeper.SuperfluidDelegateToValidatorSet(ctx, poolJoinAcc.String(), lockID)
+				suite.Require().NoError(err)
+			} else if tc.superfluidDelegated {
+				err = superfluidKeeper.SuperfluidDelegate(ctx, poolJoinAcc.String(), lockID, valAddr.String())
+				suite.Require().NoError(err)
+			}
+			if tc.superfluidUndelegating {
+				err = superfluidKeeper.SuperfluidUndelegate(ctx, poolJoinAcc.String(), lockID)
+				suite.Require().NoError(err)
+			}
+			if tc.unlocking {
+				_, err = lockupKeeper.BeginUnlock(ctx, lockID, nil)
+				suite.Require().NoError(err)
+			}
+			lockBefore, err := lockupKeeper.GetLockByID(ctx, lockID)
+			suite.Require().NoError(err)
+			balancesBefore := bankKeeper.GetAllBalances(ctx, poolJoinAcc)
+
+			sharesToMigrate := lockedShares.Amount
+			if tc.partialShares {
+				sharesToMigrate = sharesToMigrate.QuoRaw(2)
+			}
+			res, err := msgServer.MigrateLiquidity(sdk.WrapSDKContext(ctx), &gammtypes.MsgMigrateLiquidity{
+				Sender:            poolJoinAcc.String(),
+				PoolIdLeaving:     poolIdLeaving,
+				PoolIdEntering:    poolIdEntering,
+				SharesToMigrate:   sharesToMigrate,
+				ShareOutMinAmount: sdk.OneInt(),
+				LockId:            lockID,
+			})
+			if tc.expectedErr != nil {
+				suite.Require().ErrorIs(err, tc.expectedErr)
+				return
+			}
+			suite.Require().NoError(err)
+
+			// the lock keeps its duration and end time, and holds the shares of the pool entered
+			lock, err := lockupKeeper.GetLockByID(ctx, lockID)
+			suite.Require().NoError(err)
+			suite.Require().Equal(sdk.NewCoins(sdk.NewCoin(enteringDenom, res.ShareOutAmount)), lock.Coins)
+			suite.Require().Equal(lockBefore.Duration, lock.Duration)
+			suite.Require().Equal(lockBefore.EndTime, lock.EndTime)
+			suite.Require().Equal(balancesBefore, bankKeeper.GetAllBalances(ctx, poolJoinAcc))
+
+			// the lock keeps following its owner's validator set preferences if it did
+			suite.Require().Equal(tc.validatorSetPreference, superfluidKeeper.IsValidatorSetPreferenceLock(ctx, lockID))
+
+			if tc.superfluidDelegated {
+				// the superfluid delegation is moved to the intermediary account of the pool entered
+				leavingAcc := types.NewSuperfluidIntermediaryAccount(leavingDenom, valAddr.String(), 0)
+				_, found := stakingKeeper.GetDelegation(ctx, leavingAcc.GetAccAddress(), valAddr)
+				suite.Require().False(found)
+				_, err = lockupKeeper.GetSyntheticLockup(ctx, lockID, keeper.StakingSyntheticDenom(leavingDenom, valAddr.String()))
+				suite.Require().Error(err)
+
+				enteringAcc, found := superfluidKeeper.GetIntermediaryAccountFromLockId(ctx, lockID)
+				suite.Require().True(found)
+				suite.Require().Equal(enteringDenom, enteringAcc.Denom)
+				suite.Require().Equal(valAddr.String(), enteringAcc.ValAddr)
+				delegation, found := stakingKeeper.GetDelegation(ctx, enteringAcc.GetAccAddress(), valAddr)
+				suite.Require().True(found)
+				suite.Require().True(delegation.Shares.IsPositive())
+				_, err = lockupKeeper.GetSyntheticLockup(ctx, lockID, keeper.StakingSyntheticDenom(enteringDenom, valAddr.String()))
+				suite.Require().NoError(err)
+			}
+		})
+	}
+}
@@ -66,13 +66,34 @@ func (server msgServer) SuperfluidUndelegate(goCtx context.Context, msg *types.M
 }
 
 // SuperfluidRedelegate is a method to redelegate superfluid staked asset into a different validator.
-// Currently this feature is not supported.
-// func (server msgServer) SuperfluidRedelegate(goCtx context.Context, msg *types.MsgSuperfluidRedelegate) (*types.MsgSuperfluidRedelegateResponse, error) {
-// 	ctx := sdk.UnwrapSDKContext(goCtx)
+// The lock is moved to the intermediary account of the new validator, and the staking module redelegates
+// the lock's delegation without unbonding. The redelegation is subject to the slashing of the old validator
+// until it completes, and the lock cannot be redelegated again until then.
+func (server msgServer) SuperfluidRedelegate(goCtx context.Context, msg *types.MsgSuperfluidRedelegate) (*types.MsgSuperfluidRedelegateResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	err := server.keeper.SuperfluidRedelegate(ctx, msg.Sender, msg.LockId, msg.NewValAddr)
+	if err == nil {
+		events.EmitSuperfluidRedelegateEvent(ctx, msg.LockId, msg.NewValAddr)
+	}
+	return &types.MsgSuperfluidRedelegateResponse{}, err
+}
+
+// SuperfluidDelegateToValidatorSet splits the lock across the sender's validator set preferences,
+// superfluid delegating one lock per validator. The locks are rebalanced whenever the preferences change.
+func (server msgServer) SuperfluidDelegateToValidatorSet(goCtx context.Context, msg *types.MsgSuperfluidDelegateToValidatorSet) (*types.MsgSuperfluidDelegateToValidatorSetResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
 
-// 	err := server.keeper.SuperfluidRedelegate(ctx, msg.Sender, msg.LockId, msg.NewValAddr)
-// 	return &types.MsgSuperfluidRedelegateResponse{}, err
-// }
+	lockIDs, err := server.keeper.SuperfluidDelegateToValidatorSet(ctx, msg.Sender, msg.LockId)
+	if err != nil {
+		return nil, err
+	}
+	for _, lockID := range lockIDs {
+		intermediaryAcc, _ := server.keeper.GetIntermediaryAccountFromLockId(ctx, lockID)
+		events.EmitSuperfluidDelegateEvent(ctx, lockID, intermediaryAcc.ValAddr)
+	}
+	return &types.MsgSuperfluidDelegateToValidatorSetResponse{LockIds: lockIDs}, nil
+}
 
 // SuperfluidUnbondLock starts unbonding for currently superfluid undelegating lock.
 // This method would return an error when the underlying lock is not in an superfluid undelegating state,
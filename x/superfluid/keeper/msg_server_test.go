@@ -276,6 +276,34 @@ func (suite *KeeperTestSuite) TestMsgSuperfluidUndelegate_Event() {
 	}
 }
 
+// TestMsgSuperfluidRedelegate_Event tests that events are correctly emitted
+// when calling SuperfluidRedelegate.
+func (suite *KeeperTestSuite) TestMsgSuperfluidRedelegate_Event() {
+	suite.SetupTest()
+	msgServer := keeper.NewMsgServerImpl(suite.App.SuperfluidKeeper)
+
+	// setup validators
+	valAddrs := suite.SetupValidators([]stakingtypes.BondStatus{stakingtypes.Bonded, stakingtypes.Bonded})
+
+	denoms, _ := suite.SetupGammPoolsAndSuperfluidAssets([]sdk.Dec{sdk.NewDec(20)})
+
+	// setup superfluid delegations
+	_, _, locks := suite.setupSuperfluidDelegations(valAddrs, []superfluidDelegation{{0, 0, 0, 1000000}}, denoms)
+
+	for _, lock := range locks {
+		sender, _ := sdk.AccAddressFromBech32(lock.Owner)
+
+		// redelegating to the same validator fails without emitting the event
+		_, err := msgServer.SuperfluidRedelegate(sdk.WrapSDKContext(suite.Ctx), types.NewMsgSuperfluidRedelegate(sender, lock.ID, valAddrs[0]))
+		suite.Require().Error(err)
+		suite.AssertEventEmitted(suite.Ctx, types.TypeEvtSuperfluidRedelegate, 0)
+
+		_, err = msgServer.SuperfluidRedelegate(sdk.WrapSDKContext(suite.Ctx), types.NewMsgSuperfluidRedelegate(sender, lock.ID, valAddrs[1]))
+		suite.Require().NoError(err)
+		suite.AssertEventEmitted(suite.Ctx, types.TypeEvtSuperfluidRedelegate, 1)
+	}
+}
+
 // TestMsgSuperfluidUnbondLock_Event tests that events are correctly emitted
 // when calling SuperfluidUnbondLock.
 func (suite *KeeperTestSuite) TestMsgSuperfluidUnbondLock_Event() {
@@ -54,11 +54,30 @@ func (k Keeper) SlashLockupsForValidatorSlash(ctx sdk.Context, valAddr sdk.ValAd
 			k.slashSynthLock(ctx, synthLock, slashFactor)
 		}
 	}
+
+	// Locks superfluid redelegated away from the validator after the infraction are slashed as well,
+	// as the staking module slashes the redelegation entries of their intermediary accounts.
+	for _, redelegation := range k.GetAllLockRedelegations(ctx) {
+		if redelegation.SrcValAddr != valAddr.String() || redelegation.CreationHeight < infractionHeight {
+			continue
+		}
+		if !redelegation.CompletionTime.After(ctx.BlockTime()) {
+			continue
+		}
+		k.slashLock(ctx, redelegation.LockId, slashFactor)
+	}
 }
 
 func (k Keeper) slashSynthLock(ctx sdk.Context, synthLock *lockuptypes.SyntheticLock, slashFactor sdk.Dec) {
+	k.slashLock(ctx, synthLock.UnderlyingLockId, slashFactor)
+}
+
+func (k Keeper) slashLock(ctx sdk.Context, lockID uint64, slashFactor sdk.Dec) {
 	// Only single token lock is allowed here
-	lock, _ := k.lk.GetLockByID(ctx, synthLock.UnderlyingLockId)
+	lock, err := k.lk.GetLockByID(ctx, lockID)
+	if err != nil {
+		return
+	}
 	slashAmt := lock.Coins[0].Amount.ToDec().Mul(slashFactor).TruncateInt()
 	slashCoins := sdk.NewCoins(sdk.NewCoin(lock.Coins[0].Denom, slashAmt))
 	_ = osmoutils.ApplyFuncIfNoError(ctx, func(cacheCtx sdk.Context) error {
@@ -198,3 +198,71 @@ func (suite *KeeperTestSuite) TestSlashLockupsForUnbondingDelegationSlash() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestSlashLockupsForRedelegatedLock() {
+	testCases := []struct {
+		name             string
+		infractionHeight int64
+		expSlashed       bool
+	}{
+		{
+			"infraction before the redelegation",
+			10,
+			true,
+		},
+		{
+			"infraction after the redelegation",
+			50,
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			// setup validators
+			valAddrs := suite.SetupValidators([]stakingtypes.BondStatus{stakingtypes.Bonded, stakingtypes.Bonded})
+
+			denoms, _ := suite.SetupGammPoolsAndSuperfluidAssets([]sdk.Dec{sdk.NewDec(20), sdk.NewDec(20)})
+
+			// setup superfluid delegations to the first validator
+			_, _, locks := suite.setupSuperfluidDelegations(valAddrs, []superfluidDelegation{{0, 0, 0, 1000000}, {1, 0, 0, 1000000}}, denoms)
+
+			// redelegate the first lock to the second validator
+			suite.Ctx = suite.Ctx.WithBlockHeight(10)
+			err := suite.App.SuperfluidKeeper.SuperfluidRedelegate(suite.Ctx, locks[0].Owner, locks[0].ID, valAddrs[1].String())
+			suite.Require().NoError(err)
+
+			// slash the first validator
+			slashFactor := sdk.NewDecWithPrec(5, 2)
+			validator, found := suite.App.StakingKeeper.GetValidator(suite.Ctx, valAddrs[0])
+			suite.Require().True(found)
+			suite.Ctx = suite.Ctx.WithBlockHeight(100)
+			consAddr, err := validator.GetConsAddr()
+			suite.Require().NoError(err)
+			power := sdk.TokensToConsensusPower(validator.Tokens, sdk.DefaultPowerReduction)
+			suite.App.StakingKeeper.Slash(suite.Ctx, consAddr, tc.infractionHeight, power, slashFactor)
+
+			// check invariant is fine
+			reason, broken := keeper.AllInvariants(*suite.App.SuperfluidKeeper)(suite.Ctx)
+			suite.Require().False(broken, reason)
+
+			// the lock remaining on the slashed validator is always slashed
+			gotLock, err := suite.App.LockupKeeper.GetLockByID(suite.Ctx, locks[1].ID)
+			suite.Require().NoError(err)
+			suite.Require().Equal(sdk.NewInt(950000).String(), gotLock.Coins[0].Amount.String())
+
+			// the redelegated lock is slashed only if the infraction happened before the redelegation
+			expAmount := sdk.NewInt(1000000)
+			if tc.expSlashed {
+				expAmount = sdk.NewInt(950000)
+			}
+			gotLock, err = suite.App.LockupKeeper.GetLockByID(suite.Ctx, locks[0].ID)
+			suite.Require().NoError(err)
+			suite.Require().Equal(expAmount.String(), gotLock.Coins[0].Amount.String())
+		})
+	}
+}
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"fmt"
+	"time"
 
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
@@ -141,8 +142,7 @@ func (k Keeper) validateLockForSFDelegate(ctx sdk.Context, lock *lockuptypes.Per
 	if err != nil {
 		return err
 	}
-	defaultSuperfluidAsset := types.SuperfluidAsset{}
-	if k.GetSuperfluidAsset(ctx, lock.Coins[0].Denom) == defaultSuperfluidAsset {
+	if k.GetSuperfluidAsset(ctx, lock.Coins[0].Denom).Denom == "" {
 		return sdkerrors.Wrapf(types.ErrNonSuperfluidAsset, "denom: %s", lock.Coins[0].Denom)
 	}
 
@@ -239,33 +239,123 @@ func (k Keeper) SuperfluidUndelegate(ctx sdk.Context, sender string, lockID uint
 	if err != nil {
 		return err
 	}
-	lockedCoin := lock.Coins[0]
 
-	// get the intermediate acct asscd. with lock id, and delete the connection.
+	// get the intermediate acct asscd. with lock id.
 	intermediaryAcc, found := k.GetIntermediaryAccountFromLockId(ctx, lockID)
 	if !found {
 		return types.ErrNotSuperfluidUsedLockup
 	}
-	k.DeleteLockIdIntermediaryAccountConnection(ctx, lockID)
 
-	// Delete the old synthetic lockup, and create a new synthetic lockup representing the unstaking
+	err = k.undelegateCommon(ctx, lock, intermediaryAcc)
+	if err != nil {
+		return err
+	}
+
+	// Create a new synthetic lockup representing the unstaking side.
+	return k.createSyntheticLockup(ctx, lockID, intermediaryAcc, unlockingStatus)
+}
+
+// undelegateCommon instantly undelegates the superfluid delegation of the lock, and burns the minted osmo.
+// It deletes the connection to the intermediary account and the synthetic lockup of the delegation,
+// without creating the synthetic lockup that represents superfluid unbonding.
+func (k Keeper) undelegateCommon(ctx sdk.Context, lock *lockuptypes.PeriodLock, intermediaryAcc types.SuperfluidIntermediaryAccount) error {
+	lockedCoin := lock.Coins[0]
+	k.DeleteLockIdIntermediaryAccountConnection(ctx, lock.ID)
+	k.DeleteValidatorSetPreferenceLock(ctx, lock.ID)
+
+	// Delete the old synthetic lockup
 	synthdenom := stakingSyntheticDenom(lockedCoin.Denom, intermediaryAcc.ValAddr)
-	err = k.lk.DeleteSyntheticLockup(ctx, lockID, synthdenom)
+	err := k.lk.DeleteSyntheticLockup(ctx, lock.ID, synthdenom)
 	if err != nil {
 		return err
 	}
 
 	// undelegate this lock's delegation amount, and burn the minted osmo.
 	amount := k.GetSuperfluidOSMOTokens(ctx, intermediaryAcc.Denom, lockedCoin.Amount)
-	err = k.forceUndelegateAndBurnOsmoTokens(ctx, amount, intermediaryAcc)
+	return k.forceUndelegateAndBurnOsmoTokens(ctx, amount, intermediaryAcc)
+}
+
+// superfluidUndelegateUnlockingLock superfluid undelegates a lock that started unlocking while superfluid bonded,
+// which is the case for the part of a superfluid bonded lock that is split off and unlocked.
+// The lock ends up superfluid undelegating, with the synthetic lockup representing unstaking maturing
+// no later than the lock itself.
+func (k Keeper) superfluidUndelegateUnlockingLock(ctx sdk.Context, lockID uint64) error {
+	lock, err := k.lk.GetLockByID(ctx, lockID)
+	if err != nil {
+		return err
+	}
+
+	intermediaryAcc, found := k.GetIntermediaryAccountFromLockId(ctx, lockID)
+	if !found {
+		return types.ErrNotSuperfluidUsedLockup
+	}
+
+	err = k.undelegateCommon(ctx, lock, intermediaryAcc)
 	if err != nil {
 		return err
 	}
 
-	// Create a new synthetic lockup representing the unstaking side.
 	return k.createSyntheticLockup(ctx, lockID, intermediaryAcc, unlockingStatus)
 }
 
+// SuperfluidRedelegate moves the superfluid delegation of the given lock to a new validator, without unbonding.
+// The lock gets connected to the intermediary account of the (denom, new validator) pair, creating it if needed,
+// and its synthetic lockup is moved accordingly. The osmo delegated for the lock is then redelegated
+// from the old intermediary account's validator to the new one's, like the staking module's redelegations.
+// As the intermediary accounts are shared by every lock of the same denom and validator, the staking module's
+// redelegation limits are applied per lock, from the redelegation recorded for it, so that the redelegations of
+// other locks cannot block it: a lock cannot be redelegated again until its last redelegation completes.
+// This also keeps each lock to a single incomplete redelegation, within the staking module's max redelegation entries.
+func (k Keeper) SuperfluidRedelegate(ctx sdk.Context, sender string, lockID uint64, newValAddr string) error {
+	lock, err := k.lk.GetLockByID(ctx, lockID)
+	if err != nil {
+		return err
+	}
+	err = k.validateLockForSF(ctx, lock, sender)
+	if err != nil {
+		return err
+	}
+
+	oldAcc, found := k.GetIntermediaryAccountFromLockId(ctx, lockID)
+	if !found {
+		return types.ErrNotSuperfluidUsedLockup
+	}
+	if oldAcc.ValAddr == newValAddr {
+		return types.ErrSameValidatorRedelegation
+	}
+	if _, found := k.getIncompleteLockRedelegation(ctx, lockID); found {
+		return sdkerrors.Wrapf(stakingtypes.ErrTransitiveRedelegation, "lock id : %d", lockID)
+	}
+	if _, err := k.validateValAddrForDelegate(ctx, newValAddr); err != nil {
+		return err
+	}
+
+	newAcc, err := k.GetOrCreateIntermediaryAccount(ctx, oldAcc.Denom, newValAddr)
+	if err != nil {
+		return err
+	}
+
+	// move the connection and the synthetic lockup to the new intermediary account
+	lockedCoin := lock.Coins[0]
+	k.DeleteLockIdIntermediaryAccountConnection(ctx, lockID)
+	err = k.lk.DeleteSyntheticLockup(ctx, lockID, stakingSyntheticDenom(lockedCoin.Denom, oldAcc.ValAddr))
+	if err != nil {
+		return err
+	}
+	k.SetLockIdIntermediaryAccountConnection(ctx, lockID, newAcc)
+	err = k.createSyntheticLockup(ctx, lockID, newAcc, bondedStatus)
+	if err != nil {
+		return err
+	}
+
+	// redelegate this lock's delegation amount to the new validator.
+	amount := k.GetSuperfluidOSMOTokens(ctx, oldAcc.Denom, lockedCoin.Amount)
+	if amount.IsZero() {
+		return nil
+	}
+	return k.redelegateOsmoTokens(ctx, lockID, amount, oldAcc, newAcc)
+}
+
 // SuperfluidUnbondLock unbonds the lock that has been used for superfluid staking.
 // This method would return an error if the underlying lock is not superfluid undelegating.
 func (k Keeper) SuperfluidUnbondLock(ctx sdk.Context, underlyingLockId uint64, sender string) error {
@@ -381,6 +471,72 @@ func (k Keeper) forceUndelegateAndBurnOsmoTokens(ctx sdk.Context,
 	return err
 }
 
+// redelegateOsmoTokens redelegates osmoAmount worth of delegation shares of the source intermediary account
+// to the validator of the destination intermediary account, on behalf of the destination intermediary account.
+// This follows the staking module's BeginRedelegation, where the delegator is the same on both sides.
+// Unless the source validator is unbonded, a staking redelegation entry is created for the destination
+// intermediary account, so that the redelegated tokens are slashed for infractions of the source validator,
+// and the lock's redelegation is recorded until it completes.
+func (k Keeper) redelegateOsmoTokens(ctx sdk.Context, lockID uint64, osmoAmount sdk.Int,
+	srcAcc, dstAcc types.SuperfluidIntermediaryAccount,
+) error {
+	srcValAddr, err := sdk.ValAddressFromBech32(srcAcc.ValAddr)
+	if err != nil {
+		return err
+	}
+	srcValidator, found := k.sk.GetValidator(ctx, srcValAddr)
+	if !found {
+		return stakingtypes.ErrNoValidatorFound
+	}
+	dstValidator, err := k.validateValAddrForDelegate(ctx, dstAcc.ValAddr)
+	if err != nil {
+		return err
+	}
+
+	shares, err := k.sk.ValidateUnbondAmount(ctx, srcAcc.GetAccAddress(), srcValAddr, osmoAmount)
+	if err != nil {
+		return err
+	}
+	returnAmount, err := k.sk.Unbond(ctx, srcAcc.GetAccAddress(), srcValAddr, shares)
+	if err != nil {
+		return err
+	}
+	if returnAmount.IsZero() {
+		return stakingtypes.ErrTinyRedelegationAmount
+	}
+	sharesCreated, err := k.sk.Delegate(ctx, dstAcc.GetAccAddress(), returnAmount, srcValidator.GetStatus(), dstValidator, false)
+	if err != nil {
+		return err
+	}
+
+	// redelegations from unbonded validators complete right away
+	var completionTime time.Time
+	var height int64
+	switch {
+	case srcValidator.IsBonded():
+		completionTime = ctx.BlockTime().Add(k.sk.UnbondingTime(ctx))
+		height = ctx.BlockHeight()
+	case srcValidator.IsUnbonding():
+		completionTime = srcValidator.UnbondingTime
+		height = srcValidator.UnbondingHeight
+	default:
+		return nil
+	}
+
+	red := k.sk.SetRedelegationEntry(ctx, dstAcc.GetAccAddress(), srcValAddr, dstValidator.GetOperator(),
+		height, completionTime, returnAmount, shares, sharesCreated)
+	k.sk.InsertRedelegationQueue(ctx, red, completionTime)
+
+	k.SetLockRedelegation(ctx, types.LockRedelegation{
+		LockId:         lockID,
+		SrcValAddr:     srcAcc.ValAddr,
+		DstValAddr:     dstAcc.ValAddr,
+		CreationHeight: height,
+		CompletionTime: completionTime,
+	})
+	return nil
+}
+
 // TODO: Need to (eventually) override the existing staking messages and queries, for undelegating, delegating, rewards, and redelegating, to all be going through all superfluid module.
 // Want integrators to be able to use the same staking queries and messages
 // Eugen’s point: Only rewards message needs to be updated. Rest of messages are fine
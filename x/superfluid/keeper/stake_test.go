@@ -546,158 +546,232 @@ func (suite *KeeperTestSuite) TestRefreshIntermediaryDelegationAmounts() {
 	}
 }
 
-// type superfluidRedelegation struct {
-// 	lockId      uint64
-// 	oldValIndex int64
-// 	newValIndex int64
-// }
-
-// func (suite *KeeperTestSuite) TestSuperfluidRedelegate() {
-// 	testCases := []struct {
-// 		name                    string
-// 		validatorStats          []stakingtypes.BondStatus
-// 		superDelegations        []superfluidDelegation
-// 		superRedelegations      []superfluidRedelegation
-// 		expSuperRedelegationErr []bool
-// 	}{
-// 		{
-// 			"with single validator and single superfluid delegation with single redelegation",
-// 			[]stakingtypes.BondStatus{stakingtypes.Bonded, stakingtypes.Bonded},
-// 			[]superfluidDelegation{{0, "gamm/pool/1", 1000000}},
-// 			[]superfluidRedelegation{{1, 0, 1}}, // lock1 => val0 -> val1
-// 			[]bool{false},
-// 		},
-// 		{
-// 			"with multiple superfluid delegations with single redelegation",
-// 			[]stakingtypes.BondStatus{stakingtypes.Bonded, stakingtypes.Bonded},
-// 			[]superfluidDelegation{{0, "gamm/pool/1", 1000000}, {0, "gamm/pool/1", 1000000}},
-// 			[]superfluidRedelegation{{1, 0, 1}}, // lock1 => val0 -> val1
-// 			[]bool{false},
-// 		},
-// 		{
-// 			"with multiple superfluid delegations with multiple redelegations",
-// 			[]stakingtypes.BondStatus{stakingtypes.Bonded, stakingtypes.Bonded},
-// 			[]superfluidDelegation{{0, "gamm/pool/1", 1000000}, {0, "gamm/pool/1", 1000000}},
-// 			[]superfluidRedelegation{{1, 0, 1}, {2, 0, 1}}, // lock1 => val0 -> val1, lock2 => val0 -> val1
-// 			[]bool{false, false},
-// 		},
-// 		{
-// 			"try redelegating back from new validator to original validator",
-// 			[]stakingtypes.BondStatus{stakingtypes.Bonded, stakingtypes.Bonded},
-// 			[]superfluidDelegation{{0, "gamm/pool/1", 1000000}, {0, "gamm/pool/1", 1000000}},
-// 			[]superfluidRedelegation{{1, 0, 1}, {1, 1, 0}}, // lock1 => val0 -> val1, lock1 => val1 -> val0
-// 			[]bool{false, true},
-// 		},
-// 		{
-// 			"not available lock id redelegation",
-// 			[]stakingtypes.BondStatus{stakingtypes.Bonded, stakingtypes.Bonded},
-// 			[]superfluidDelegation{{0, "gamm/pool/1", 1000000}},
-// 			[]superfluidRedelegation{{2, 0, 1}}, // lock1 => val0 -> val1
-// 			[]bool{true},
-// 		},
-// 		{
-// 			"redelegation for same validator",
-// 			[]stakingtypes.BondStatus{stakingtypes.Bonded, stakingtypes.Bonded},
-// 			[]superfluidDelegation{{0, "gamm/pool/1", 1000000}},
-// 			[]superfluidRedelegation{{1, 0, 0}}, // lock1 => val0 -> val0
-// 			[]bool{true},
-// 		},
-// 	}
-
-// 	for _, tc := range testCases {
-// 		tc := tc
-// 		suite.Run(tc.name, func() {
-// 			suite.SetupTest()
-
-// 			poolId := suite.createGammPool([]string{appparams.BaseCoinUnit, "foo"})
-// 			suite.Require().Equal(poolId, uint64(1))
-
-// 			// setup validators
-// 			valAddrs := suite.SetupValidators(tc.validatorStats)
-
-// 			// setup superfluid delegations
-// 			intermediaryAccs, _ := suite.SetupSuperfluidDelegations(valAddrs, tc.superDelegations)
-// 			suite.checkIntermediaryAccountDelegations(intermediaryAccs)
-
-// 			// execute redelegation and check changes on store
-// 			for index, srd := range tc.superRedelegations {
-// 				lock, err := suite.App.LockupKeeper.GetLockByID(suite.Ctx, srd.lockId)
-// 				if err != nil {
-// 					lock = &lockuptypes.PeriodLock{}
-// 				}
-
-// 				// superfluid redelegate
-// 				err = suite.App.SuperfluidKeeper.SuperfluidRedelegate(suite.Ctx, lock.Owner, srd.lockId, valAddrs[srd.newValIndex].String())
-// 				if tc.expSuperRedelegationErr[index] {
-// 					suite.Require().Error(err)
-// 					continue
-// 				}
-// 				suite.Require().NoError(err)
-
-// 				// check previous validator bonding synthetic lockup deletion
-// 				_, err = suite.App.LockupKeeper.GetSyntheticLockup(suite.Ctx, srd.lockId, keeper.StakingSuffix(valAddrs[srd.oldValIndex].String()))
-// 				suite.Require().Error(err)
-
-// 				// check unbonding synthetic lockup creation
-// 				params := suite.App.SuperfluidKeeper.GetParams(suite.Ctx)
-// 				synthLock, err := suite.App.LockupKeeper.GetSyntheticLockup(suite.Ctx, srd.lockId, keeper.UnstakingSuffix(valAddrs[srd.oldValIndex].String()))
-// 				suite.Require().NoError(err)
-// 				suite.Require().Equal(synthLock.UnderlyingLockId, srd.lockId)
-// 				suite.Require().Equal(synthLock.Suffix, keeper.UnstakingSuffix(valAddrs[srd.oldValIndex].String()))
-// 				suite.Require().Equal(synthLock.EndTime, suite.Ctx.BlockTime().Add(params.UnbondingDuration))
-
-// 				// check synthetic lockup creation
-// 				synthLock2, err := suite.App.LockupKeeper.GetSyntheticLockup(suite.Ctx, srd.lockId, keeper.StakingSuffix(valAddrs[srd.newValIndex].String()))
-// 				suite.Require().NoError(err)
-// 				suite.Require().Equal(synthLock2.UnderlyingLockId, srd.lockId)
-// 				suite.Require().Equal(synthLock2.Suffix, keeper.StakingSuffix(valAddrs[srd.newValIndex].String()))
-// 				suite.Require().Equal(synthLock2.EndTime, time.Time{})
-
-// 				// check intermediary account creation
-// 				lock, err = suite.App.LockupKeeper.GetLockByID(suite.Ctx, srd.lockId)
-// 				suite.Require().NoError(err)
-
-// 				expAcc := types.NewSuperfluidIntermediaryAccount(lock.Coins[0].Denom, valAddrs[srd.newValIndex].String(), 1)
-// 				gotAcc := suite.App.SuperfluidKeeper.GetIntermediaryAccount(suite.Ctx, expAcc.GetAccAddress())
-// 				suite.Require().Equal(gotAcc.Denom, expAcc.Denom)
-// 				suite.Require().Equal(gotAcc.ValAddr, expAcc.ValAddr)
-
-// 				// check gauge creation
-// 				gauge, err := suite.App.IncentivesKeeper.GetGaugeByID(suite.Ctx, gotAcc.GaugeId)
-// 				suite.Require().NoError(err)
-// 				suite.Require().Equal(gauge.Id, gotAcc.GaugeId)
-// 				suite.Require().Equal(gauge.IsPerpetual, true)
-// 				suite.Require().Equal(gauge.DistributeTo, lockuptypes.QueryCondition{
-// 					LockQueryType: lockuptypes.ByDuration,
-// 					Denom:         expAcc.Denom + keeper.StakingSuffix(valAddrs[srd.newValIndex].String()),
-// 					Duration:      params.UnbondingDuration,
-// 				})
-// 				suite.Require().Equal(gauge.Coins, sdk.Coins(nil))
-// 				suite.Require().Equal(gauge.StartTime, suite.Ctx.BlockTime())
-// 				suite.Require().Equal(gauge.NumEpochsPaidOver, uint64(1))
-// 				suite.Require().Equal(gauge.FilledEpochs, uint64(0))
-// 				suite.Require().Equal(gauge.DistributedCoins, sdk.Coins(nil))
-
-// 				// Check lockID connection with intermediary account
-// 				intAcc := suite.App.SuperfluidKeeper.GetLockIdIntermediaryAccountConnection(suite.Ctx, srd.lockId)
-// 				suite.Require().Equal(intAcc.String(), expAcc.GetAccAddress().String())
-
-// 				// check delegation from intermediary account to validator
-// 				_, found := suite.App.StakingKeeper.GetDelegation(suite.Ctx, expAcc.GetAccAddress(), valAddrs[srd.newValIndex])
-// 				suite.Require().True(found)
-// 			}
-
-// 			// try redelegating twice
-// 			for index, srd := range tc.superRedelegations {
-// 				if tc.expSuperRedelegationErr[index] {
-// 					continue
-// 				}
-// 				cacheCtx, _ := suite.Ctx.CacheContext()
-// 				lock, err := suite.App.LockupKeeper.GetLockByID(suite.Ctx, srd.lockId)
-// 				suite.Require().NoError(err)
-// 				err = suite.App.SuperfluidKeeper.SuperfluidRedelegate(cacheCtx, lock.Owner, srd.lockId, valAddrs[srd.newValIndex].String())
-// 				suite.Require().Error(err)
-// 			}
-// 		})
-// 	}
-// }
+type superfluidRedelegation struct {
+	lockId      uint64
+	oldValIndex int64
+	newValIndex int64
+}
+
+func (suite *KeeperTestSuite) TestSuperfluidRedelegate() {
+	testCases := []struct {
+		name                    string
+		validatorStats          []stakingtypes.BondStatus
+		superDelegations        []superfluidDelegation
+		superRedelegations      []superfluidRedelegation
+		expSuperRedelegationErr []bool
+		expRedelegationRecord   bool
+	}{
+		{
+			"with single superfluid delegation with single redelegation",
+			[]stakingtypes.BondStatus{stakingtypes.Bonded, stakingtypes.Bonded},
+			[]superfluidDelegation{{0, 0, 0, 1000000}},
+			[]superfluidRedelegation{{1, 0, 1}}, // lock1 => val0 -> val1
+			[]bool{false},
+			true,
+		},
+		{
+			"with multiple superfluid delegations with single redelegation",
+			[]stakingtypes.BondStatus{stakingtypes.Bonded, stakingtypes.Bonded},
+			[]superfluidDelegation{{0, 0, 0, 1000000}, {1, 0, 0, 1000000}},
+			[]superfluidRedelegation{{1, 0, 1}}, // lock1 => val0 -> val1
+			[]bool{false},
+			true,
+		},
+		{
+			"with multiple superfluid delegations with multiple redelegations",
+			[]stakingtypes.BondStatus{stakingtypes.Bonded, stakingtypes.Bonded},
+			[]superfluidDelegation{{0, 0, 0, 1000000}, {1, 0, 0, 1000000}},
+			[]superfluidRedelegation{{1, 0, 1}, {2, 0, 1}}, // lock1 => val0 -> val1, lock2 => val0 -> val1
+			[]bool{false, false},
+			true,
+		},
+		{
+			"redelegation from unbonded validator completes right away",
+			[]stakingtypes.BondStatus{stakingtypes.Unbonded, stakingtypes.Bonded},
+			[]superfluidDelegation{{0, 0, 0, 1000000}},
+			[]superfluidRedelegation{{1, 0, 1}}, // lock1 => val0 -> val1
+			[]bool{false},
+			false,
+		},
+		{
+			"try redelegating back from new validator to original validator",
+			[]stakingtypes.BondStatus{stakingtypes.Bonded, stakingtypes.Bonded},
+			[]superfluidDelegation{{0, 0, 0, 1000000}},
+			[]superfluidRedelegation{{1, 0, 1}, {1, 1, 0}}, // lock1 => val0 -> val1, lock1 => val1 -> val0
+			[]bool{false, true},
+			true,
+		},
+		{
+			"redelegating out of an intermediary account receiving the redelegation of another lock",
+			[]stakingtypes.BondStatus{stakingtypes.Bonded, stakingtypes.Bonded, stakingtypes.Bonded},
+			[]superfluidDelegation{{0, 0, 0, 1000000}, {1, 1, 0, 1000000}},
+			[]superfluidRedelegation{{1, 0, 1}, {2, 1, 2}}, // lock1 => val0 -> val1, lock2 => val1 -> val2
+			[]bool{false, false},
+			true,
+		},
+		{
+			"not available lock id redelegation",
+			[]stakingtypes.BondStatus{stakingtypes.Bonded, stakingtypes.Bonded},
+			[]superfluidDelegation{{0, 0, 0, 1000000}},
+			[]superfluidRedelegation{{2, 0, 1}}, // lock2 => val0 -> val1
+			[]bool{true},
+			false,
+		},
+		{
+			"redelegation for same validator",
+			[]stakingtypes.BondStatus{stakingtypes.Bonded, stakingtypes.Bonded},
+			[]superfluidDelegation{{0, 0, 0, 1000000}},
+			[]superfluidRedelegation{{1, 0, 0}}, // lock1 => val0 -> val0
+			[]bool{true},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			// setup validators
+			valAddrs := suite.SetupValidators(tc.validatorStats)
+
+			denoms, _ := suite.SetupGammPoolsAndSuperfluidAssets([]sdk.Dec{sdk.NewDec(20), sdk.NewDec(20)})
+
+			// setup superfluid delegations
+			_, intermediaryAccs, _ := suite.setupSuperfluidDelegations(valAddrs, tc.superDelegations, denoms)
+			suite.checkIntermediaryAccountDelegations(intermediaryAccs)
+
+			unbondingDuration := suite.App.StakingKeeper.GetParams(suite.Ctx).UnbondingTime
+
+			// execute redelegation and check changes on store
+			for index, srd := range tc.superRedelegations {
+				lock, err := suite.App.LockupKeeper.GetLockByID(suite.Ctx, srd.lockId)
+				if err != nil {
+					lock = &lockuptypes.PeriodLock{}
+				}
+
+				// superfluid redelegate
+				err = suite.App.SuperfluidKeeper.SuperfluidRedelegate(suite.Ctx, lock.Owner, srd.lockId, valAddrs[srd.newValIndex].String())
+				if tc.expSuperRedelegationErr[index] {
+					suite.Require().Error(err)
+					continue
+				}
+				suite.Require().NoError(err)
+
+				oldValAddr := valAddrs[srd.oldValIndex]
+				newValAddr := valAddrs[srd.newValIndex]
+
+				// check previous validator bonding synthetic lockup deletion, without an unbonding one
+				_, err = suite.App.LockupKeeper.GetSyntheticLockup(suite.Ctx, srd.lockId, keeper.StakingSyntheticDenom(lock.Coins[0].Denom, oldValAddr.String()))
+				suite.Require().Error(err)
+				_, err = suite.App.LockupKeeper.GetSyntheticLockup(suite.Ctx, srd.lockId, keeper.UnstakingSyntheticDenom(lock.Coins[0].Denom, oldValAddr.String()))
+				suite.Require().Error(err)
+
+				// check synthetic lockup creation
+				synthLock, err := suite.App.LockupKeeper.GetSyntheticLockup(suite.Ctx, srd.lockId, keeper.StakingSyntheticDenom(lock.Coins[0].Denom, newValAddr.String()))
+				suite.Require().NoError(err)
+				suite.Require().Equal(synthLock.UnderlyingLockId, srd.lockId)
+				suite.Require().Equal(synthLock.EndTime, time.Time{})
+
+				// check lockID connection with the new intermediary account
+				expAcc := types.NewSuperfluidIntermediaryAccount(lock.Coins[0].Denom, newValAddr.String(), 0)
+				intAcc := suite.App.SuperfluidKeeper.GetLockIdIntermediaryAccountConnection(suite.Ctx, srd.lockId)
+				suite.Require().Equal(intAcc.String(), expAcc.GetAccAddress().String())
+
+				// check delegation from the new intermediary account to the new validator
+				_, found := suite.App.StakingKeeper.GetDelegation(suite.Ctx, expAcc.GetAccAddress(), newValAddr)
+				suite.Require().True(found)
+
+				// check the redelegation is recorded until it completes
+				redelegation, found := suite.App.SuperfluidKeeper.GetLockRedelegation(suite.Ctx, srd.lockId)
+				suite.Require().Equal(tc.expRedelegationRecord, found)
+				_, found = suite.App.StakingKeeper.GetRedelegation(suite.Ctx, expAcc.GetAccAddress(), oldValAddr, newValAddr)
+				suite.Require().Equal(tc.expRedelegationRecord, found)
+				if tc.expRedelegationRecord {
+					suite.Require().Equal(types.LockRedelegation{
+						LockId:         srd.lockId,
+						SrcValAddr:     oldValAddr.String(),
+						DstValAddr:     newValAddr.String(),
+						CreationHeight: suite.Ctx.BlockHeight(),
+						CompletionTime: suite.Ctx.BlockTime().Add(unbondingDuration),
+					}, redelegation)
+				}
+			}
+
+			// check invariant is fine
+			reason, broken := keeper.AllInvariants(*suite.App.SuperfluidKeeper)(suite.Ctx)
+			suite.Require().False(broken, reason)
+
+			// try redelegating twice
+			for index, srd := range tc.superRedelegations {
+				if tc.expSuperRedelegationErr[index] {
+					continue
+				}
+				cacheCtx, _ := suite.Ctx.CacheContext()
+				lock, err := suite.App.LockupKeeper.GetLockByID(suite.Ctx, srd.lockId)
+				suite.Require().NoError(err)
+				err = suite.App.SuperfluidKeeper.SuperfluidRedelegate(cacheCtx, lock.Owner, srd.lockId, valAddrs[srd.oldValIndex].String())
+				if tc.expRedelegationRecord {
+					suite.Require().ErrorIs(err, stakingtypes.ErrTransitiveRedelegation)
+					continue
+				}
+				suite.Require().NoError(err)
+			}
+
+			// redelegating again is allowed once the redelegations complete
+			suite.Ctx = suite.Ctx.WithBlockTime(suite.Ctx.BlockTime().Add(unbondingDuration))
+			suite.App.StakingKeeper.BlockValidatorUpdates(suite.Ctx)
+			for index, srd := range tc.superRedelegations {
+				if tc.expSuperRedelegationErr[index] {
+					continue
+				}
+				cacheCtx, _ := suite.Ctx.CacheContext()
+				lock, err := suite.App.LockupKeeper.GetLockByID(suite.Ctx, srd.lockId)
+				suite.Require().NoError(err)
+				err = suite.App.SuperfluidKeeper.SuperfluidRedelegate(cacheCtx, lock.Owner, srd.lockId, valAddrs[srd.oldValIndex].String())
+				suite.Require().NoError(err)
+			}
+		})
+	}
+}
+
+// TestSuperfluidRedelegateSharedIntermediaryAccount tests that the redelegation limits are applied per lock,
+// so that the redelegations of a locker cannot block those of other lockers sharing their intermediary accounts.
+func (suite *KeeperTestSuite) TestSuperfluidRedelegateSharedIntermediaryAccount() {
+	suite.SetupTest()
+
+	params := suite.App.StakingKeeper.GetParams(suite.Ctx)
+	params.MaxEntries = 1
+	suite.App.StakingKeeper.SetParams(suite.Ctx, params)
+
+	valAddrs := suite.SetupValidators([]stakingtypes.BondStatus{stakingtypes.Bonded, stakingtypes.Bonded, stakingtypes.Bonded})
+	denoms, _ := suite.SetupGammPoolsAndSuperfluidAssets([]sdk.Dec{sdk.NewDec(20)})
+	// the locks of two lockers share the intermediary account of val0, and a third locker has a lock on val1
+	_, intermediaryAccs, locks := suite.setupSuperfluidDelegations(valAddrs,
+		[]superfluidDelegation{{0, 0, 0, 1000000}, {1, 0, 0, 1000000}, {2, 1, 0, 1000000}}, denoms)
+
+	// both lockers redelegate to val1, although the intermediary account of val1 has more than the max staking entries
+	err := suite.App.SuperfluidKeeper.SuperfluidRedelegate(suite.Ctx, locks[0].Owner, locks[0].ID, valAddrs[1].String())
+	suite.Require().NoError(err)
+	err = suite.App.SuperfluidKeeper.SuperfluidRedelegate(suite.Ctx, locks[1].Owner, locks[1].ID, valAddrs[1].String())
+	suite.Require().NoError(err)
+
+	// the staking entries are still kept for slashing
+	redelegation, found := suite.App.StakingKeeper.GetRedelegation(suite.Ctx, intermediaryAccs[1].GetAccAddress(), valAddrs[0], valAddrs[1])
+	suite.Require().True(found)
+	suite.Require().Len(redelegation.Entries, 2)
+
+	// the lock on val1 is not redelegated tokens, so it can be redelegated although its intermediary account received redelegations
+	err = suite.App.SuperfluidKeeper.SuperfluidRedelegate(suite.Ctx, locks[2].Owner, locks[2].ID, valAddrs[2].String())
+	suite.Require().NoError(err)
+
+	// whereas the redelegated locks cannot be redelegated again until their redelegations complete
+	cacheCtx, _ := suite.Ctx.CacheContext()
+	err = suite.App.SuperfluidKeeper.SuperfluidRedelegate(cacheCtx, locks[0].Owner, locks[0].ID, valAddrs[2].String())
+	suite.Require().ErrorIs(err, stakingtypes.ErrTransitiveRedelegation)
+
+	suite.Ctx = suite.Ctx.WithBlockTime(suite.Ctx.BlockTime().Add(params.UnbondingTime))
+	suite.App.StakingKeeper.BlockValidatorUpdates(suite.Ctx)
+	err = suite.App.SuperfluidKeeper.SuperfluidRedelegate(suite.Ctx, locks[0].Owner, locks[0].ID, valAddrs[2].String())
+	suite.Require().NoError(err)
+}
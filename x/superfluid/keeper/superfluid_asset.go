@@ -21,21 +21,29 @@ func (k Keeper) BeginUnwindSuperfluidAsset(ctx sdk.Context, epochNum int64, asse
 	k.DeleteSuperfluidAsset(ctx, asset.Denom)
 }
 
-// Returns amount * (1 - k.RiskFactor(asset))
-// Fow now, the risk factor is a global constant.
-// It will move towards per pool functions.
-func (k Keeper) GetRiskAdjustedOsmoValue(ctx sdk.Context, asset types.SuperfluidAsset, amount sdk.Int) sdk.Int {
+// GetRiskFactor returns the risk factor of the asset set by governance,
+// or the minimum risk factor if the asset's risk factor is lower or unset.
+func (k Keeper) GetRiskFactor(ctx sdk.Context, asset types.SuperfluidAsset) sdk.Dec {
 	minRiskFactor := k.GetParams(ctx).MinimumRiskFactor
-	return amount.Sub(amount.ToDec().Mul(minRiskFactor).RoundInt())
+	if asset.RiskFactor == nil || asset.RiskFactor.LT(minRiskFactor) {
+		return minRiskFactor
+	}
+	return *asset.RiskFactor
 }
 
-// y = x - (x * minRisk)
-// y = x (1 - minRisk)
-// y / (1 - minRisk) = x
+// Returns amount * (1 - k.GetRiskFactor(asset))
+func (k Keeper) GetRiskAdjustedOsmoValue(ctx sdk.Context, asset types.SuperfluidAsset, amount sdk.Int) sdk.Int {
+	riskFactor := k.GetRiskFactor(ctx, asset)
+	return amount.Sub(amount.ToDec().Mul(riskFactor).RoundInt())
+}
 
-func (k Keeper) UnriskAdjustOsmoValue(ctx sdk.Context, amount sdk.Dec) sdk.Dec {
-	minRiskFactor := k.GetParams(ctx).MinimumRiskFactor
-	return amount.Quo(sdk.OneDec().Sub(minRiskFactor))
+// y = x - (x * risk)
+// y = x (1 - risk)
+// y / (1 - risk) = x
+
+func (k Keeper) UnriskAdjustOsmoValue(ctx sdk.Context, asset types.SuperfluidAsset, amount sdk.Dec) sdk.Dec {
+	riskFactor := k.GetRiskFactor(ctx, asset)
+	return amount.Quo(sdk.OneDec().Sub(riskFactor))
 }
 
 func (k Keeper) AddNewSuperfluidAsset(ctx sdk.Context, asset types.SuperfluidAsset) error {
@@ -1,10 +1,12 @@
 package keeper
 
 import (
+	"fmt"
+
 	"github.com/gogo/protobuf/proto"
 
-	gammtypes "github.com/osmosis-labs/osmosis/v13/x/gamm/types"
 	"github.com/osmosis-labs/osmosis/v13/x/superfluid/types"
+	swaproutertypes "github.com/osmosis-labs/osmosis/v13/x/swaprouter/types"
 
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -13,11 +15,57 @@ import (
 // This function calculates the osmo equivalent worth of an LP share.
 // It is intended to eventually use the TWAP of the worth of an LP share
 // once that is exposed from the gamm module.
-func (k Keeper) calculateOsmoBackingPerShare(pool gammtypes.CFMMPoolI, osmoInPool sdk.Int) sdk.Dec {
+func (k Keeper) calculateOsmoBackingPerShare(pool swaproutertypes.PoolI, osmoInPool sdk.Int) sdk.Dec {
 	twap := osmoInPool.ToDec().Quo(pool.GetTotalShares().ToDec())
 	return twap
 }
 
+// calculateOsmoBackingPerShareByTwap calculates the osmo equivalent worth of an LP share
+// of a pool that need not hold OSMO, using the price denom and price routes of the asset.
+// The pool's liquidity is valued in the price denom using the pool's own arithmetic TWAPs,
+// which is then valued in OSMO using the product of the arithmetic TWAPs of the price routes.
+// All TWAPs are taken over the duration of the superfluid epoch.
+func (k Keeper) calculateOsmoBackingPerShareByTwap(ctx sdk.Context, pool swaproutertypes.PoolI, asset types.SuperfluidAsset) (sdk.Dec, error) {
+	epochInfo := k.ek.GetEpochInfo(ctx, k.GetEpochIdentifier(ctx))
+	startTime := ctx.BlockTime().Add(-epochInfo.Duration)
+
+	liquidity := pool.GetTotalPoolLiquidity(ctx)
+	if !liquidity.AmountOf(asset.PriceDenom).IsPositive() {
+		return sdk.Dec{}, fmt.Errorf("pool %d has no %s to price its assets in", pool.GetId(), asset.PriceDenom)
+	}
+
+	liquidityValue := sdk.ZeroDec()
+	for _, coin := range liquidity {
+		if coin.Denom == asset.PriceDenom {
+			liquidityValue = liquidityValue.Add(coin.Amount.ToDec())
+			continue
+		}
+		twap, err := k.tk.GetArithmeticTwapToNow(ctx, pool.GetId(), coin.Denom, asset.PriceDenom, startTime)
+		if err != nil {
+			return sdk.Dec{}, err
+		}
+		liquidityValue = liquidityValue.Add(coin.Amount.ToDec().Mul(twap))
+	}
+
+	price := sdk.OneDec()
+	denomIn := asset.PriceDenom
+	for _, route := range asset.PriceRoutes {
+		twap, err := k.tk.GetArithmeticTwapToNow(ctx, route.PoolId, denomIn, route.TokenOutDenom, startTime)
+		if err != nil {
+			return sdk.Dec{}, err
+		}
+		price = price.Mul(twap)
+		denomIn = route.TokenOutDenom
+	}
+	if bondDenom := k.sk.BondDenom(ctx); denomIn != bondDenom {
+		return sdk.Dec{}, fmt.Errorf("price routes of %s must end in %s, got %s", asset.Denom, bondDenom, denomIn)
+	}
+
+	return liquidityValue.Mul(price).Quo(pool.GetTotalShares().ToDec()), nil
+}
+
+// SetOsmoEquivalentMultiplier sets the multiplier of the denom, and records it in the denom's multiplier history.
+// Multipliers recorded more than types.OsmoEquivalentMultiplierHistoryEpochs epochs ago are pruned from the history.
 func (k Keeper) SetOsmoEquivalentMultiplier(ctx sdk.Context, epoch int64, denom string, multiplier sdk.Dec) {
 	store := ctx.KVStore(k.storeKey)
 	prefixStore := prefix.NewStore(store, types.KeyPrefixTokenMultiplier)
@@ -31,6 +79,45 @@ func (k Keeper) SetOsmoEquivalentMultiplier(ctx sdk.Context, epoch int64, denom
 		panic(err)
 	}
 	prefixStore.Set([]byte(denom), bz)
+	k.setOsmoEquivalentMultiplierHistoryRecord(ctx, priceRecord)
+	k.pruneOsmoEquivalentMultiplierHistory(ctx, denom, epoch-types.OsmoEquivalentMultiplierHistoryEpochs+1)
+}
+
+func (k Keeper) setOsmoEquivalentMultiplierHistoryRecord(ctx sdk.Context, priceRecord types.OsmoEquivalentMultiplierRecord) {
+	store := ctx.KVStore(k.storeKey)
+	bz, err := proto.Marshal(&priceRecord)
+	if err != nil {
+		panic(err)
+	}
+	store.Set(types.GetKeyTokenMultiplierHistory(priceRecord.Denom, priceRecord.EpochNumber), bz)
+}
+
+// pruneOsmoEquivalentMultiplierHistory deletes the multipliers of the denom recorded before the given epoch.
+func (k Keeper) pruneOsmoEquivalentMultiplierHistory(ctx sdk.Context, denom string, beforeEpoch int64) {
+	if beforeEpoch <= 0 {
+		return
+	}
+	store := ctx.KVStore(k.storeKey)
+	iter := store.Iterator(types.GetKeyPrefixTokenMultiplierHistory(denom), types.GetKeyTokenMultiplierHistory(denom, beforeEpoch))
+	keys := [][]byte{}
+	for ; iter.Valid(); iter.Next() {
+		keys = append(keys, iter.Key())
+	}
+	iter.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// GetOsmoEquivalentMultiplierHistory returns the multipliers set for the denom, by ascending epoch number.
+func (k Keeper) GetOsmoEquivalentMultiplierHistory(ctx sdk.Context, denom string) []types.OsmoEquivalentMultiplierRecord {
+	return k.getOsmoEquivalentMultiplierRecords(ctx, types.GetKeyPrefixTokenMultiplierHistory(denom))
+}
+
+// GetAllOsmoEquivalentMultiplierHistory returns the multiplier history of all denoms.
+func (k Keeper) GetAllOsmoEquivalentMultiplierHistory(ctx sdk.Context) []types.OsmoEquivalentMultiplierRecord {
+	return k.getOsmoEquivalentMultiplierRecords(ctx, types.KeyPrefixTokenMultiplierHistory)
 }
 
 func (k Keeper) GetSuperfluidOSMOTokens(ctx sdk.Context, denom string, amount sdk.Int) sdk.Int {
@@ -66,8 +153,12 @@ func (k Keeper) GetOsmoEquivalentMultiplier(ctx sdk.Context, denom string) sdk.D
 }
 
 func (k Keeper) GetAllOsmoEquivalentMultipliers(ctx sdk.Context) []types.OsmoEquivalentMultiplierRecord {
+	return k.getOsmoEquivalentMultiplierRecords(ctx, types.KeyPrefixTokenMultiplier)
+}
+
+func (k Keeper) getOsmoEquivalentMultiplierRecords(ctx sdk.Context, keyPrefix []byte) []types.OsmoEquivalentMultiplierRecord {
 	store := ctx.KVStore(k.storeKey)
-	prefixStore := prefix.NewStore(store, types.KeyPrefixTokenMultiplier)
+	prefixStore := prefix.NewStore(store, keyPrefix)
 	iterator := prefixStore.Iterator(nil, nil)
 	defer iterator.Close()
 
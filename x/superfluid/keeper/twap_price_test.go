@@ -1,7 +1,9 @@
 package keeper_test
 
 import (
+	gammtypes "github.com/osmosis-labs/osmosis/v13/x/gamm/types"
 	"github.com/osmosis-labs/osmosis/v13/x/superfluid/types"
+	swaproutertypes "github.com/osmosis-labs/osmosis/v13/x/swaprouter/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -50,3 +52,83 @@ func (suite *KeeperTestSuite) TestOsmoEquivalentMultiplierSetGetDeleteFlow() {
 	multiplier = suite.App.SuperfluidKeeper.GetOsmoEquivalentMultiplier(suite.Ctx, "gamm/pool/1")
 	suite.Require().Equal(multiplier, sdk.NewDec(0))
 }
+
+func (suite *KeeperTestSuite) TestOsmoEquivalentMultiplierHistory() {
+	suite.SetupTest()
+
+	suite.App.SuperfluidKeeper.SetOsmoEquivalentMultiplier(suite.Ctx, 1, "gamm/pool/1", sdk.NewDec(2))
+	suite.App.SuperfluidKeeper.SetOsmoEquivalentMultiplier(suite.Ctx, 2, "gamm/pool/1", sdk.NewDec(3))
+	suite.App.SuperfluidKeeper.SetOsmoEquivalentMultiplier(suite.Ctx, 1, "gamm/pool/2", sdk.NewDec(4))
+
+	expectedHistory := []types.OsmoEquivalentMultiplierRecord{
+		{EpochNumber: 1, Denom: "gamm/pool/1", Multiplier: sdk.NewDec(2)},
+		{EpochNumber: 2, Denom: "gamm/pool/1", Multiplier: sdk.NewDec(3)},
+	}
+	suite.Require().Equal(expectedHistory, suite.App.SuperfluidKeeper.GetOsmoEquivalentMultiplierHistory(suite.Ctx, "gamm/pool/1"))
+	suite.Require().Len(suite.App.SuperfluidKeeper.GetAllOsmoEquivalentMultiplierHistory(suite.Ctx), 3)
+
+	// deleting the multiplier keeps its history
+	suite.App.SuperfluidKeeper.DeleteOsmoEquivalentMultiplier(suite.Ctx, "gamm/pool/1")
+	suite.Require().Equal(expectedHistory, suite.App.SuperfluidKeeper.GetOsmoEquivalentMultiplierHistory(suite.Ctx, "gamm/pool/1"))
+
+	res, err := suite.querier.AssetMultiplierHistory(sdk.WrapSDKContext(suite.Ctx), &types.AssetMultiplierHistoryRequest{Denom: "gamm/pool/1"})
+	suite.Require().NoError(err)
+	suite.Require().Equal(expectedHistory, res.OsmoEquivalentMultipliers)
+
+	// multipliers older than the retention window are pruned when a new one is set
+	lastEpoch := 2 + types.OsmoEquivalentMultiplierHistoryEpochs - 1
+	suite.App.SuperfluidKeeper.SetOsmoEquivalentMultiplier(suite.Ctx, lastEpoch, "gamm/pool/1", sdk.NewDec(5))
+	expectedHistory = []types.OsmoEquivalentMultiplierRecord{
+		{EpochNumber: 2, Denom: "gamm/pool/1", Multiplier: sdk.NewDec(3)},
+		{EpochNumber: lastEpoch, Denom: "gamm/pool/1", Multiplier: sdk.NewDec(5)},
+	}
+	suite.Require().Equal(expectedHistory, suite.App.SuperfluidKeeper.GetOsmoEquivalentMultiplierHistory(suite.Ctx, "gamm/pool/1"))
+
+	suite.App.SuperfluidKeeper.SetOsmoEquivalentMultiplier(suite.Ctx, lastEpoch+1, "gamm/pool/1", sdk.NewDec(6))
+	expectedHistory = []types.OsmoEquivalentMultiplierRecord{
+		{EpochNumber: lastEpoch, Denom: "gamm/pool/1", Multiplier: sdk.NewDec(5)},
+		{EpochNumber: lastEpoch + 1, Denom: "gamm/pool/1", Multiplier: sdk.NewDec(6)},
+	}
+	suite.Require().Equal(expectedHistory, suite.App.SuperfluidKeeper.GetOsmoEquivalentMultiplierHistory(suite.Ctx, "gamm/pool/1"))
+
+	// the history of other denoms is kept
+	suite.Require().Len(suite.App.SuperfluidKeeper.GetOsmoEquivalentMultiplierHistory(suite.Ctx, "gamm/pool/2"), 1)
+}
+
+func (suite *KeeperTestSuite) TestUpdateOsmoEquivalentMultipliersByTwap() {
+	suite.SetupTest()
+	bondDenom := suite.App.StakingKeeper.BondDenom(suite.Ctx)
+
+	// a pool without OSMO, whose shares are priced in uusdc, and uusdc in OSMO by a second pool
+	poolId := suite.createGammPool([]string{"uusdc", "uatom"})
+	routePoolId := suite.createGammPool([]string{"uusdc", bondDenom})
+	asset := types.SuperfluidAsset{
+		Denom:       gammtypes.GetPoolShareDenom(poolId),
+		AssetType:   types.SuperfluidAssetTypeLPShare,
+		PriceDenom:  "uusdc",
+		PriceRoutes: []swaproutertypes.SwapAmountInRoute{{PoolId: routePoolId, TokenOutDenom: bondDenom}},
+	}
+
+	// there are no TWAP records over the epoch yet, so the asset keeps its multiplier
+	err := suite.App.SuperfluidKeeper.UpdateOsmoEquivalentMultipliers(suite.Ctx, asset, 1)
+	suite.Require().Error(err)
+	suite.Require().True(suite.App.SuperfluidKeeper.GetOsmoEquivalentMultiplier(suite.Ctx, asset.Denom).IsZero())
+
+	epochInfo := suite.App.EpochsKeeper.GetEpochInfo(suite.Ctx, suite.App.SuperfluidKeeper.GetEpochIdentifier(suite.Ctx))
+	suite.Ctx = suite.Ctx.WithBlockTime(suite.Ctx.BlockTime().Add(epochInfo.Duration))
+
+	err = suite.App.SuperfluidKeeper.UpdateOsmoEquivalentMultipliers(suite.Ctx, asset, 2)
+	suite.Require().NoError(err)
+
+	// all assets trade at par, so the pool's shares are backed by all of its liquidity in OSMO
+	pool, err := suite.App.GAMMKeeper.GetPoolAndPoke(suite.Ctx, poolId)
+	suite.Require().NoError(err)
+	expectedMultiplier := sdk.NewDec(2 * 1000000000000000000).Quo(pool.GetTotalShares().ToDec())
+	suite.Require().Equal(expectedMultiplier, suite.App.SuperfluidKeeper.GetOsmoEquivalentMultiplier(suite.Ctx, asset.Denom))
+
+	// a route that does not end in OSMO cannot price the asset
+	asset.PriceRoutes = []swaproutertypes.SwapAmountInRoute{{PoolId: poolId, TokenOutDenom: "uatom"}}
+	err = suite.App.SuperfluidKeeper.UpdateOsmoEquivalentMultipliers(suite.Ctx, asset, 3)
+	suite.Require().Error(err)
+	suite.Require().Equal(expectedMultiplier, suite.App.SuperfluidKeeper.GetOsmoEquivalentMultiplier(suite.Ctx, asset.Denom))
+}
@@ -0,0 +1,357 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/osmosis-labs/osmosis/v13/osmoutils"
+	lockuptypes "github.com/osmosis-labs/osmosis/v13/x/lockup/types"
+	"github.com/osmosis-labs/osmosis/v13/x/superfluid/keeper/internal/events"
+	"github.com/osmosis-labs/osmosis/v13/x/superfluid/types"
+	valsetpreftypes "github.com/osmosis-labs/osmosis/v13/x/valset-pref/types"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// SetValidatorSetPreferenceLock marks a lock as superfluid delegated by its owner's validator set preferences.
+func (k Keeper) SetValidatorSetPreferenceLock(ctx sdk.Context, lockID uint64) {
+	store := ctx.KVStore(k.storeKey)
+	prefixStore := prefix.NewStore(store, types.KeyPrefixValidatorSetPreferenceLock)
+	prefixStore.Set(sdk.Uint64ToBigEndian(lockID), []byte{1})
+}
+
+// IsValidatorSetPreferenceLock returns true if the lock is superfluid delegated by its owner's validator set preferences.
+func (k Keeper) IsValidatorSetPreferenceLock(ctx sdk.Context, lockID uint64) bool {
+	store := ctx.KVStore(k.storeKey)
+	prefixStore := prefix.NewStore(store, types.KeyPrefixValidatorSetPreferenceLock)
+	return prefixStore.Has(sdk.Uint64ToBigEndian(lockID))
+}
+
+// DeleteValidatorSetPreferenceLock unmarks a lock as superfluid delegated by its owner's validator set preferences.
+func (k Keeper) DeleteValidatorSetPreferenceLock(ctx sdk.Context, lockID uint64) {
+	store := ctx.KVStore(k.storeKey)
+	prefixStore := prefix.NewStore(store, types.KeyPrefixValidatorSetPreferenceLock)
+	prefixStore.Delete(sdk.Uint64ToBigEndian(lockID))
+}
+
+// GetAllValidatorSetPreferenceLockIds returns the IDs of all locks superfluid delegated by their owner's validator set preferences.
+func (k Keeper) GetAllValidatorSetPreferenceLockIds(ctx sdk.Context) []uint64 {
+	store := ctx.KVStore(k.storeKey)
+	prefixStore := prefix.NewStore(store, types.KeyPrefixValidatorSetPreferenceLock)
+	iterator := prefixStore.Iterator(nil, nil)
+	defer iterator.Close()
+
+	lockIDs := []uint64{}
+	for ; iterator.Valid(); iterator.Next() {
+		lockIDs = append(lockIDs, sdk.BigEndianToUint64(iterator.Key()))
+	}
+	return lockIDs
+}
+
+// SuperfluidDelegateToValidatorSet splits the given lock by the weights of the sender's validator set preferences,
+// into one lock per validator, and superfluid delegates each of them to its validator.
+// The given lock keeps the amount of the first validator, and the amounts of the other validators are split off it.
+// Validators whose share of the lock would be zero are skipped.
+// Returns the IDs of the locks superfluid delegated, the first one being the given lock.
+func (k Keeper) SuperfluidDelegateToValidatorSet(ctx sdk.Context, sender string, lockID uint64) ([]uint64, error) {
+	delegator, err := sdk.AccAddressFromBech32(sender)
+	if err != nil {
+		return nil, err
+	}
+	preferences, found := k.vspk.GetValidatorSetPreference(ctx, sender)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrNoValidatorSetPreference, "delegator %s", sender)
+	}
+
+	lock, err := k.lk.GetLockByID(ctx, lockID)
+	if err != nil {
+		return nil, err
+	}
+	err = k.validateLockForSFDelegate(ctx, lock, sender)
+	if err != nil {
+		return nil, err
+	}
+
+	valAddrs := []string{}
+	splitAmounts := []sdk.Int{}
+	for i, amount := range validatorSetPreferenceAmounts(lock.Coins[0].Amount, preferences.Preferences) {
+		if amount.IsZero() {
+			continue
+		}
+		// the first amount stays in the lock
+		if len(valAddrs) != 0 {
+			splitAmounts = append(splitAmounts, amount)
+		}
+		valAddrs = append(valAddrs, preferences.Preferences[i].ValOperAddress)
+	}
+
+	lockIDs := []uint64{lockID}
+	if len(splitAmounts) != 0 {
+		splitLockIDs, err := k.lk.SplitLock(ctx, lockID, delegator, splitAmounts)
+		if err != nil {
+			return nil, err
+		}
+		lockIDs = append(lockIDs, splitLockIDs...)
+	}
+
+	for i, id := range lockIDs {
+		err = k.SuperfluidDelegate(ctx, sender, id, valAddrs[i])
+		if err != nil {
+			return nil, err
+		}
+		k.SetValidatorSetPreferenceLock(ctx, id)
+	}
+	return lockIDs, nil
+}
+
+// SetValidatorSetPreferenceRebalanceDelegator marks a delegator as having locks superfluid delegated by their validator set preferences
+// that could not be rebalanced yet.
+func (k Keeper) SetValidatorSetPreferenceRebalanceDelegator(ctx sdk.Context, delegator sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	prefixStore := prefix.NewStore(store, types.KeyPrefixValidatorSetPreferenceRebalanceDelegator)
+	prefixStore.Set(delegator, []byte{1})
+}
+
+// DeleteValidatorSetPreferenceRebalanceDelegator unmarks a delegator as having locks to rebalance.
+func (k Keeper) DeleteValidatorSetPreferenceRebalanceDelegator(ctx sdk.Context, delegator sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	prefixStore := prefix.NewStore(store, types.KeyPrefixValidatorSetPreferenceRebalanceDelegator)
+	prefixStore.Delete(delegator)
+}
+
+// GetAllValidatorSetPreferenceRebalanceDelegators returns the delegators having locks that could not be rebalanced yet.
+func (k Keeper) GetAllValidatorSetPreferenceRebalanceDelegators(ctx sdk.Context) []string {
+	store := ctx.KVStore(k.storeKey)
+	prefixStore := prefix.NewStore(store, types.KeyPrefixValidatorSetPreferenceRebalanceDelegator)
+	iterator := prefixStore.Iterator(nil, nil)
+	defer iterator.Close()
+
+	delegators := []string{}
+	for ; iterator.Valid(); iterator.Next() {
+		delegators = append(delegators, sdk.AccAddress(iterator.Key()).String())
+	}
+	return delegators
+}
+
+// RebalanceValidatorSetPreferenceLocks rebalances the superfluid delegations of the delegator's locks
+// superfluid delegated by their validator set preferences, to follow the delegator's current preferences.
+// Locks of the same denom and duration are rebalanced together: the amounts by which validators are
+// over their share are split off their locks and superfluid redelegated to the validators under their share,
+// after which the locks delegated to the same validator are merged.
+// Locks that cannot be moved or merged yet, e.g. as they have a redelegation that has not completed yet, are skipped,
+// and the delegator is marked to be rebalanced again at the next superfluid epochs, until all their locks are.
+func (k Keeper) RebalanceValidatorSetPreferenceLocks(ctx sdk.Context, delegator sdk.AccAddress) {
+	preferences, found := k.vspk.GetValidatorSetPreference(ctx, delegator.String())
+	if !found {
+		k.DeleteValidatorSetPreferenceRebalanceDelegator(ctx, delegator)
+		return
+	}
+
+	// group the locks by denom and duration, in the order they are first seen
+	groupKeys := []string{}
+	groups := map[string][]lockuptypes.PeriodLock{}
+	for _, lock := range k.lk.GetAccountPeriodLocks(ctx, delegator) {
+		if !k.IsValidatorSetPreferenceLock(ctx, lock.ID) {
+			continue
+		}
+		groupKey := fmt.Sprintf("%s/%s", lock.Coins[0].Denom, lock.Duration)
+		if _, ok := groups[groupKey]; !ok {
+			groupKeys = append(groupKeys, groupKey)
+		}
+		groups[groupKey] = append(groups[groupKey], lock)
+	}
+
+	rebalanced := true
+	for _, groupKey := range groupKeys {
+		if !k.rebalanceLocks(ctx, delegator, groups[groupKey], preferences.Preferences) {
+			rebalanced = false
+		}
+	}
+	if rebalanced {
+		k.DeleteValidatorSetPreferenceRebalanceDelegator(ctx, delegator)
+	} else {
+		k.SetValidatorSetPreferenceRebalanceDelegator(ctx, delegator)
+	}
+}
+
+// rebalanceValidatorSetPreferenceDelegators rebalances the locks of at most limit of the delegators whose locks could not all
+// be rebalanced yet. The delegators are taken in turn, starting after the last one rebalanced at the previous call,
+// so that the delegators whose locks cannot be moved for a while do not keep the others from being rebalanced.
+func (k Keeper) rebalanceValidatorSetPreferenceDelegators(ctx sdk.Context, limit int) {
+	store := ctx.KVStore(k.storeKey)
+	cursor := store.Get(types.KeyValidatorSetPreferenceRebalanceCursor)
+
+	var delegators []sdk.AccAddress
+	if cursor == nil {
+		delegators = k.getValidatorSetPreferenceRebalanceDelegators(ctx, nil, nil, limit)
+	} else {
+		// the delegators after the cursor, then the ones up to the cursor
+		afterCursor := append(append([]byte{}, cursor...), 0)
+		delegators = k.getValidatorSetPreferenceRebalanceDelegators(ctx, afterCursor, nil, limit)
+		delegators = append(delegators, k.getValidatorSetPreferenceRebalanceDelegators(ctx, nil, afterCursor, limit-len(delegators))...)
+	}
+
+	for _, delegator := range delegators {
+		k.RebalanceValidatorSetPreferenceLocks(ctx, delegator)
+	}
+
+	if len(delegators) < limit {
+		store.Delete(types.KeyValidatorSetPreferenceRebalanceCursor)
+	} else {
+		store.Set(types.KeyValidatorSetPreferenceRebalanceCursor, delegators[len(delegators)-1])
+	}
+}
+
+// getValidatorSetPreferenceRebalanceDelegators returns at most limit of the delegators having locks that could not be rebalanced yet,
+// with addresses in the range [start, end).
+func (k Keeper) getValidatorSetPreferenceRebalanceDelegators(ctx sdk.Context, start, end []byte, limit int) []sdk.AccAddress {
+	store := ctx.KVStore(k.storeKey)
+	prefixStore := prefix.NewStore(store, types.KeyPrefixValidatorSetPreferenceRebalanceDelegator)
+	iterator := prefixStore.Iterator(start, end)
+	defer iterator.Close()
+
+	delegators := []sdk.AccAddress{}
+	for ; iterator.Valid() && len(delegators) < limit; iterator.Next() {
+		delegators = append(delegators, sdk.AccAddress(iterator.Key()))
+	}
+	return delegators
+}
+
+// rebalanceLocks rebalances the superfluid delegations of locks of the same owner, denom and duration by the given preferences.
+// Each lock is split and redelegated, and the locks of each validator merged, in a cache context, so that the locks that
+// cannot be moved or merged yet are skipped. Returns false if any were, leaving the locks partially rebalanced.
+func (k Keeper) rebalanceLocks(ctx sdk.Context, owner sdk.AccAddress, locks []lockuptypes.PeriodLock, preferences []valsetpreftypes.ValidatorPreference) bool {
+	// the locks and amount delegated to each validator, in the order the validators are first seen
+	valAddrs := []string{}
+	lockIDsByVal := map[string][]uint64{}
+	amountByVal := map[string]sdk.Int{}
+	amountByLock := map[uint64]sdk.Int{}
+	total := sdk.ZeroInt()
+	for _, lock := range locks {
+		intermediaryAcc, found := k.GetIntermediaryAccountFromLockId(ctx, lock.ID)
+		if !found {
+			continue
+		}
+		valAddr := intermediaryAcc.ValAddr
+		if _, ok := amountByVal[valAddr]; !ok {
+			valAddrs = append(valAddrs, valAddr)
+			amountByVal[valAddr] = sdk.ZeroInt()
+		}
+		amount := lock.Coins[0].Amount
+		lockIDsByVal[valAddr] = append(lockIDsByVal[valAddr], lock.ID)
+		amountByVal[valAddr] = amountByVal[valAddr].Add(amount)
+		amountByLock[lock.ID] = amount
+		total = total.Add(amount)
+	}
+	if total.IsZero() {
+		return true
+	}
+
+	// the amount each validator should have
+	targetByVal := map[string]sdk.Int{}
+	for i, amount := range validatorSetPreferenceAmounts(total, preferences) {
+		valAddr := preferences[i].ValOperAddress
+		targetByVal[valAddr] = amount
+		if _, ok := amountByVal[valAddr]; !ok {
+			valAddrs = append(valAddrs, valAddr)
+			amountByVal[valAddr] = sdk.ZeroInt()
+		}
+	}
+	excess := func(valAddr string) sdk.Int {
+		target, ok := targetByVal[valAddr]
+		if !ok {
+			target = sdk.ZeroInt()
+		}
+		return amountByVal[valAddr].Sub(target)
+	}
+
+	// move the excess of the validators over their share to the validators under their share
+	rebalanced := true
+	skippedLockIDs := map[uint64]bool{}
+	for _, dstValAddr := range valAddrs {
+		for excess(dstValAddr).IsNegative() {
+			// the last lock not skipped yet of the first validator over its share
+			srcValAddr, srcIndex := "", -1
+			for _, valAddr := range valAddrs {
+				if !excess(valAddr).IsPositive() {
+					continue
+				}
+				for i := len(lockIDsByVal[valAddr]) - 1; i >= 0; i-- {
+					if !skippedLockIDs[lockIDsByVal[valAddr][i]] {
+						srcValAddr, srcIndex = valAddr, i
+						break
+					}
+				}
+				if srcIndex != -1 {
+					break
+				}
+			}
+			if srcIndex == -1 {
+				rebalanced = false
+				break
+			}
+
+			lockID := lockIDsByVal[srcValAddr][srcIndex]
+			moveAmount := sdk.MinInt(sdk.MinInt(excess(dstValAddr).Neg(), excess(srcValAddr)), amountByLock[lockID])
+			movedLockID := lockID
+			err := osmoutils.ApplyFuncIfNoError(ctx, func(cacheCtx sdk.Context) error {
+				if moveAmount.LT(amountByLock[lockID]) {
+					splitLockIDs, err := k.lk.SplitLock(cacheCtx, lockID, owner, []sdk.Int{moveAmount})
+					if err != nil {
+						return err
+					}
+					movedLockID = splitLockIDs[0]
+				}
+				return k.SuperfluidRedelegate(cacheCtx, owner.String(), movedLockID, dstValAddr)
+			})
+			if err != nil {
+				skippedLockIDs[lockID] = true
+				rebalanced = false
+				continue
+			}
+			events.EmitSuperfluidRedelegateEvent(ctx, movedLockID, dstValAddr)
+
+			if movedLockID == lockID {
+				srcLockIDs := lockIDsByVal[srcValAddr]
+				lockIDsByVal[srcValAddr] = append(srcLockIDs[:srcIndex:srcIndex], srcLockIDs[srcIndex+1:]...)
+			} else {
+				amountByLock[lockID] = amountByLock[lockID].Sub(moveAmount)
+				amountByLock[movedLockID] = moveAmount
+			}
+			lockIDsByVal[dstValAddr] = append(lockIDsByVal[dstValAddr], movedLockID)
+			amountByVal[srcValAddr] = amountByVal[srcValAddr].Sub(moveAmount)
+			amountByVal[dstValAddr] = amountByVal[dstValAddr].Add(moveAmount)
+		}
+	}
+
+	// merge the locks delegated to the same validator
+	for _, valAddr := range valAddrs {
+		if len(lockIDsByVal[valAddr]) < 2 {
+			continue
+		}
+		err := osmoutils.ApplyFuncIfNoError(ctx, func(cacheCtx sdk.Context) error {
+			_, err := k.lk.MergeLocks(cacheCtx, owner, lockIDsByVal[valAddr])
+			return err
+		})
+		if err != nil {
+			rebalanced = false
+		}
+	}
+	return rebalanced
+}
+
+// validatorSetPreferenceAmounts splits the amount by the weights of the preferences,
+// with the remainder of the truncated amounts going to the first preference.
+func validatorSetPreferenceAmounts(total sdk.Int, preferences []valsetpreftypes.ValidatorPreference) []sdk.Int {
+	amounts := make([]sdk.Int, len(preferences))
+	remainder := total
+	for i, preference := range preferences {
+		amounts[i] = preference.Weight.MulInt(total).TruncateInt()
+		remainder = remainder.Sub(amounts[i])
+	}
+	if len(amounts) != 0 {
+		amounts[0] = amounts[0].Add(remainder)
+	}
+	return amounts
+}
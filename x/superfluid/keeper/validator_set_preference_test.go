@@ -0,0 +1,283 @@
+package keeper_test
+
+import (
+	"bytes"
+
+	"github.com/tendermint/tendermint/crypto/ed25519"
+
+	"github.com/osmosis-labs/osmosis/v13/x/superfluid/keeper"
+	"github.com/osmosis-labs/osmosis/v13/x/superfluid/types"
+	valsetpref "github.com/osmosis-labs/osmosis/v13/x/valset-pref"
+	valsetpreftypes "github.com/osmosis-labs/osmosis/v13/x/valset-pref/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// setValidatorSetPreference sets the delegator's validator set preferences through the valset-pref msg server,
+// which rebalances the locks superfluid delegated by the previous preferences.
+func (suite *KeeperTestSuite) setValidatorSetPreference(delAddr sdk.AccAddress, valAddrs []sdk.ValAddress, weights []sdk.Dec) error {
+	preferences := []valsetpreftypes.ValidatorPreference{}
+	for i, weight := range weights {
+		preferences = append(preferences, valsetpreftypes.ValidatorPreference{
+			ValOperAddress: valAddrs[i].String(),
+			Weight:         weight,
+		})
+	}
+	msgServer := valsetpref.NewMsgServerImpl(suite.App.ValidatorSetPreferenceKeeper)
+	_, err := msgServer.SetValidatorSetPreference(sdk.WrapSDKContext(suite.Ctx), &valsetpreftypes.MsgSetValidatorSetPreference{
+		Delegator:   delAddr.String(),
+		Preferences: preferences,
+	})
+	return err
+}
+
+// checkValidatorSetPreferenceLocks checks the delegator's locks marked as superfluid delegated by validator set preferences
+// are one per validator, with the expected amounts.
+func (suite *KeeperTestSuite) checkValidatorSetPreferenceLocks(delAddr sdk.AccAddress, valAddrs []sdk.ValAddress, expAmounts []int64) {
+	amountByVal := map[string]int64{}
+	for _, lock := range suite.App.LockupKeeper.GetAccountPeriodLocks(suite.Ctx, delAddr) {
+		suite.Require().True(suite.App.SuperfluidKeeper.IsValidatorSetPreferenceLock(suite.Ctx, lock.ID))
+
+		intermediaryAcc, found := suite.App.SuperfluidKeeper.GetIntermediaryAccountFromLockId(suite.Ctx, lock.ID)
+		suite.Require().True(found)
+		_, found = amountByVal[intermediaryAcc.ValAddr]
+		suite.Require().False(found, "more than one lock delegated to validator %s", intermediaryAcc.ValAddr)
+		amountByVal[intermediaryAcc.ValAddr] = lock.Coins[0].Amount.Int64()
+
+		_, err := suite.App.LockupKeeper.GetSyntheticLockup(suite.Ctx, lock.ID, keeper.StakingSyntheticDenom(lock.Coins[0].Denom, intermediaryAcc.ValAddr))
+		suite.Require().NoError(err)
+	}
+
+	for i, valAddr := range valAddrs {
+		suite.Require().Equal(expAmounts[i], amountByVal[valAddr.String()], "validator %d", i)
+	}
+
+	reason, broken := keeper.AllInvariants(*suite.App.SuperfluidKeeper)(suite.Ctx)
+	suite.Require().False(broken, reason)
+}
+
+func (suite *KeeperTestSuite) TestSuperfluidDelegateToValidatorSet() {
+	testCases := []struct {
+		name          string
+		weights       []sdk.Dec
+		lockAmount    int64
+		expLockIDs    []uint64
+		expAmounts    []int64
+		noPreferences bool
+		expErr        error
+	}{
+		{
+			name:       "split across three validators",
+			weights:    []sdk.Dec{sdk.NewDecWithPrec(5, 1), sdk.NewDecWithPrec(3, 1), sdk.NewDecWithPrec(2, 1)},
+			lockAmount: 1000000,
+			expLockIDs: []uint64{1, 2, 3},
+			expAmounts: []int64{500000, 300000, 200000},
+		},
+		{
+			name:       "remainder goes to the first validator",
+			weights:    []sdk.Dec{sdk.NewDecWithPrec(34, 2), sdk.NewDecWithPrec(33, 2), sdk.NewDecWithPrec(33, 2)},
+			lockAmount: 1000001,
+			expLockIDs: []uint64{1, 2, 3},
+			expAmounts: []int64{340001, 330000, 330000},
+		},
+		{
+			name:       "validators with a zero share are skipped",
+			weights:    []sdk.Dec{sdk.NewDecWithPrec(999999, 6), sdk.NewDecWithPrec(1, 6)},
+			lockAmount: 10,
+			expLockIDs: []uint64{1},
+			expAmounts: []int64{10, 0, 0},
+		},
+		{
+			name:          "delegator without validator set preferences",
+			lockAmount:    1000000,
+			noPreferences: true,
+			expErr:        types.ErrNoValidatorSetPreference,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			valAddrs := suite.SetupValidators([]stakingtypes.BondStatus{stakingtypes.Bonded, stakingtypes.Bonded, stakingtypes.Bonded})
+			denoms, _ := suite.SetupGammPoolsAndSuperfluidAssets([]sdk.Dec{sdk.NewDec(20)})
+
+			delAddr := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+			if !tc.noPreferences {
+				err := suite.setValidatorSetPreference(delAddr, valAddrs, tc.weights)
+				suite.Require().NoError(err)
+			}
+
+			unbondingDuration := suite.App.StakingKeeper.GetParams(suite.Ctx).UnbondingTime
+			lockID := suite.LockTokens(delAddr, sdk.NewCoins(sdk.NewInt64Coin(denoms[0], tc.lockAmount)), unbondingDuration)
+
+			lockIDs, err := suite.App.SuperfluidKeeper.SuperfluidDelegateToValidatorSet(suite.Ctx, delAddr.String(), lockID)
+			if tc.expErr != nil {
+				suite.Require().ErrorIs(err, tc.expErr)
+				return
+			}
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.expLockIDs, lockIDs)
+
+			suite.checkValidatorSetPreferenceLocks(delAddr, valAddrs, tc.expAmounts)
+
+			// a lock already superfluid delegated cannot be delegated again
+			_, err = suite.App.SuperfluidKeeper.SuperfluidDelegateToValidatorSet(suite.Ctx, delAddr.String(), lockID)
+			suite.Require().Error(err)
+
+			// undelegating a lock unmarks it
+			err = suite.App.SuperfluidKeeper.SuperfluidUndelegate(suite.Ctx, delAddr.String(), lockID)
+			suite.Require().NoError(err)
+			suite.Require().False(suite.App.SuperfluidKeeper.IsValidatorSetPreferenceLock(suite.Ctx, lockID))
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestRebalanceValidatorSetPreferenceLocks() {
+	testCases := []struct {
+		name       string
+		weights    []sdk.Dec
+		newWeights []sdk.Dec
+		expAmounts []int64
+	}{
+		{
+			name:       "weights change across the same validators",
+			weights:    []sdk.Dec{sdk.NewDecWithPrec(5, 1), sdk.NewDecWithPrec(3, 1), sdk.NewDecWithPrec(2, 1)},
+			newWeights: []sdk.Dec{sdk.NewDecWithPrec(2, 1), sdk.NewDecWithPrec(3, 1), sdk.NewDecWithPrec(5, 1)},
+			expAmounts: []int64{200000, 300000, 500000, 0},
+		},
+		{
+			name:       "validator added to the preferences",
+			weights:    []sdk.Dec{sdk.NewDecWithPrec(5, 1), sdk.NewDecWithPrec(5, 1)},
+			newWeights: []sdk.Dec{sdk.NewDecWithPrec(25, 2), sdk.NewDecWithPrec(25, 2), sdk.NewDecWithPrec(25, 2), sdk.NewDecWithPrec(25, 2)},
+			expAmounts: []int64{250000, 250000, 250000, 250000},
+		},
+		{
+			name:       "validator removed from the preferences",
+			weights:    []sdk.Dec{sdk.NewDecWithPrec(5, 1), sdk.NewDecWithPrec(3, 1), sdk.NewDecWithPrec(2, 1)},
+			newWeights: []sdk.Dec{sdk.NewDecWithPrec(5, 1), sdk.NewDecWithPrec(5, 1)},
+			expAmounts: []int64{500000, 500000, 0, 0},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			valAddrs := suite.SetupValidators([]stakingtypes.BondStatus{stakingtypes.Bonded, stakingtypes.Bonded, stakingtypes.Bonded, stakingtypes.Bonded})
+			denoms, _ := suite.SetupGammPoolsAndSuperfluidAssets([]sdk.Dec{sdk.NewDec(20)})
+
+			delAddr := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+			err := suite.setValidatorSetPreference(delAddr, valAddrs, tc.weights)
+			suite.Require().NoError(err)
+
+			unbondingDuration := suite.App.StakingKeeper.GetParams(suite.Ctx).UnbondingTime
+			lockID := suite.LockTokens(delAddr, sdk.NewCoins(sdk.NewInt64Coin(denoms[0], 1000000)), unbondingDuration)
+			_, err = suite.App.SuperfluidKeeper.SuperfluidDelegateToValidatorSet(suite.Ctx, delAddr.String(), lockID)
+			suite.Require().NoError(err)
+
+			// changing the preferences rebalances the locks
+			err = suite.setValidatorSetPreference(delAddr, valAddrs, tc.newWeights)
+			suite.Require().NoError(err)
+			suite.checkValidatorSetPreferenceLocks(delAddr, valAddrs, tc.expAmounts)
+
+			suite.Require().Empty(suite.App.SuperfluidKeeper.GetAllValidatorSetPreferenceRebalanceDelegators(suite.Ctx))
+
+			// the redelegated locks cannot be redelegated again until their redelegations complete,
+			// so they are skipped, and the delegator is rebalanced again at the next superfluid epochs
+			err = suite.setValidatorSetPreference(delAddr, valAddrs[3:], []sdk.Dec{sdk.OneDec()})
+			suite.Require().NoError(err)
+			suite.Require().Equal([]string{delAddr.String()}, suite.App.SuperfluidKeeper.GetAllValidatorSetPreferenceRebalanceDelegators(suite.Ctx))
+
+			suite.App.SuperfluidKeeper.AfterEpochStartBeginBlock(suite.Ctx)
+			suite.Require().Equal([]string{delAddr.String()}, suite.App.SuperfluidKeeper.GetAllValidatorSetPreferenceRebalanceDelegators(suite.Ctx))
+
+			suite.Ctx = suite.Ctx.WithBlockTime(suite.Ctx.BlockTime().Add(unbondingDuration))
+			suite.App.StakingKeeper.BlockValidatorUpdates(suite.Ctx)
+			suite.App.SuperfluidKeeper.AfterEpochStartBeginBlock(suite.Ctx)
+			suite.checkValidatorSetPreferenceLocks(delAddr, valAddrs, []int64{0, 0, 0, 1000000})
+			suite.Require().Empty(suite.App.SuperfluidKeeper.GetAllValidatorSetPreferenceRebalanceDelegators(suite.Ctx))
+
+			// rebalancing by unchanged preferences does nothing
+			locksBefore := suite.App.LockupKeeper.GetAccountPeriodLocks(suite.Ctx, delAddr)
+			suite.App.SuperfluidKeeper.RebalanceValidatorSetPreferenceLocks(suite.Ctx, delAddr)
+			suite.Require().Equal(locksBefore, suite.App.LockupKeeper.GetAccountPeriodLocks(suite.Ctx, delAddr))
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestRebalanceValidatorSetPreferenceDelegatorsLimit() {
+	suite.SetupTest()
+
+	valAddrs := suite.SetupValidators([]stakingtypes.BondStatus{stakingtypes.Bonded, stakingtypes.Bonded})
+	denoms, _ := suite.SetupGammPoolsAndSuperfluidAssets([]sdk.Dec{sdk.NewDec(20)})
+
+	// the locks of the first delegator cannot be rebalanced until their redelegations complete
+	stuckDelAddr := sdk.AccAddress(bytes.Repeat([]byte{0x01}, 20))
+	err := suite.setValidatorSetPreference(stuckDelAddr, valAddrs[:1], []sdk.Dec{sdk.OneDec()})
+	suite.Require().NoError(err)
+	unbondingDuration := suite.App.StakingKeeper.GetParams(suite.Ctx).UnbondingTime
+	lockID := suite.LockTokens(stuckDelAddr, sdk.NewCoins(sdk.NewInt64Coin(denoms[0], 1000000)), unbondingDuration)
+	_, err = suite.App.SuperfluidKeeper.SuperfluidDelegateToValidatorSet(suite.Ctx, stuckDelAddr.String(), lockID)
+	suite.Require().NoError(err)
+	err = suite.setValidatorSetPreference(stuckDelAddr, valAddrs[1:], []sdk.Dec{sdk.OneDec()})
+	suite.Require().NoError(err)
+	err = suite.setValidatorSetPreference(stuckDelAddr, valAddrs[:1], []sdk.Dec{sdk.OneDec()})
+	suite.Require().NoError(err)
+
+	// the second delegator has no preferences anymore, so they are unmarked once rebalanced
+	otherDelAddr := sdk.AccAddress(bytes.Repeat([]byte{0xFF}, 20))
+	suite.App.SuperfluidKeeper.SetValidatorSetPreferenceRebalanceDelegator(suite.Ctx, otherDelAddr)
+	suite.Require().Equal([]string{stuckDelAddr.String(), otherDelAddr.String()}, suite.App.SuperfluidKeeper.GetAllValidatorSetPreferenceRebalanceDelegators(suite.Ctx))
+
+	// only the first delegator is rebalanced, which still cannot be
+	suite.App.SuperfluidKeeper.RebalanceValidatorSetPreferenceDelegators(suite.Ctx, 1)
+	suite.Require().Equal([]string{stuckDelAddr.String(), otherDelAddr.String()}, suite.App.SuperfluidKeeper.GetAllValidatorSetPreferenceRebalanceDelegators(suite.Ctx))
+
+	// the next call carries on with the second delegator, rather than the first one again
+	suite.App.SuperfluidKeeper.RebalanceValidatorSetPreferenceDelegators(suite.Ctx, 1)
+	suite.Require().Equal([]string{stuckDelAddr.String()}, suite.App.SuperfluidKeeper.GetAllValidatorSetPreferenceRebalanceDelegators(suite.Ctx))
+
+	// and the one after wraps around to the first delegator
+	suite.Ctx = suite.Ctx.WithBlockTime(suite.Ctx.BlockTime().Add(unbondingDuration))
+	suite.App.StakingKeeper.BlockValidatorUpdates(suite.Ctx)
+	suite.App.SuperfluidKeeper.RebalanceValidatorSetPreferenceDelegators(suite.Ctx, 1)
+	suite.Require().Empty(suite.App.SuperfluidKeeper.GetAllValidatorSetPreferenceRebalanceDelegators(suite.Ctx))
+	suite.checkValidatorSetPreferenceLocks(stuckDelAddr, valAddrs, []int64{1000000, 0})
+}
+
+// TestRebalanceValidatorSetPreferenceLocksSharedIntermediaryAccount tests that the rebalances of delegators sharing
+// intermediary accounts do not block each other.
+func (suite *KeeperTestSuite) TestRebalanceValidatorSetPreferenceLocksSharedIntermediaryAccount() {
+	suite.SetupTest()
+
+	params := suite.App.StakingKeeper.GetParams(suite.Ctx)
+	params.MaxEntries = 1
+	suite.App.StakingKeeper.SetParams(suite.Ctx, params)
+
+	valAddrs := suite.SetupValidators([]stakingtypes.BondStatus{stakingtypes.Bonded, stakingtypes.Bonded})
+	denoms, _ := suite.SetupGammPoolsAndSuperfluidAssets([]sdk.Dec{sdk.NewDec(20)})
+
+	delAddrs := []sdk.AccAddress{
+		sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address()),
+		sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address()),
+	}
+	for _, delAddr := range delAddrs {
+		err := suite.setValidatorSetPreference(delAddr, valAddrs[:1], []sdk.Dec{sdk.OneDec()})
+		suite.Require().NoError(err)
+		lockID := suite.LockTokens(delAddr, sdk.NewCoins(sdk.NewInt64Coin(denoms[0], 1000000)), params.UnbondingTime)
+		_, err = suite.App.SuperfluidKeeper.SuperfluidDelegateToValidatorSet(suite.Ctx, delAddr.String(), lockID)
+		suite.Require().NoError(err)
+	}
+
+	// both delegators are redelegated between the same intermediary accounts
+	for _, delAddr := range delAddrs {
+		err := suite.setValidatorSetPreference(delAddr, valAddrs[1:], []sdk.Dec{sdk.OneDec()})
+		suite.Require().NoError(err)
+		suite.checkValidatorSetPreferenceLocks(delAddr, valAddrs, []int64{0, 1000000})
+	}
+	suite.Require().Empty(suite.App.SuperfluidKeeper.GetAllValidatorSetPreferenceRebalanceDelegators(suite.Ctx))
+}
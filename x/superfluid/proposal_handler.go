@@ -16,7 +16,7 @@ func NewSuperfluidProposalHandler(k keeper.Keeper, ek types.EpochKeeper, gk type
 		case *types.SetSuperfluidAssetsProposal:
 			return handleSetSuperfluidAssetsProposal(ctx, k, ek, c)
 		case *types.RemoveSuperfluidAssetsProposal:
-			return handleRemoveSuperfluidAssetsProposal(ctx, k, c)
+			return handleRemoveSuperfluidAssetsProposal(ctx, k, ek, c)
 		case *types.UpdateUnpoolWhiteListProposal:
 			return handleUnpoolWhitelistChange(ctx, k, gk, c)
 
@@ -30,8 +30,8 @@ func handleSetSuperfluidAssetsProposal(ctx sdk.Context, k keeper.Keeper, ek type
 	return gov.HandleSetSuperfluidAssetsProposal(ctx, k, ek, p)
 }
 
-func handleRemoveSuperfluidAssetsProposal(ctx sdk.Context, k keeper.Keeper, p *types.RemoveSuperfluidAssetsProposal) error {
-	return gov.HandleRemoveSuperfluidAssetsProposal(ctx, k, p)
+func handleRemoveSuperfluidAssetsProposal(ctx sdk.Context, k keeper.Keeper, ek types.EpochKeeper, p *types.RemoveSuperfluidAssetsProposal) error {
+	return gov.HandleRemoveSuperfluidAssetsProposal(ctx, k, ek, p)
 }
 
 func handleUnpoolWhitelistChange(ctx sdk.Context, k keeper.Keeper, gammKeeper types.GammKeeper, p *types.UpdateUnpoolWhiteListProposal) error {
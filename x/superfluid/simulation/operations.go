@@ -40,7 +40,7 @@ func WeightedOperations(
 	var (
 		weightMsgSuperfluidDelegate   int
 		weightMsgSuperfluidUndelegate int
-		// weightMsgSuperfluidRedelegate int
+		weightMsgSuperfluidRedelegate int
 	)
 
 	appParams.GetOrGenerate(cdc, OpWeightMsgSuperfluidDelegate, &weightMsgSuperfluidDelegate, nil,
@@ -55,11 +55,11 @@ func WeightedOperations(
 		},
 	)
 
-	// appParams.GetOrGenerate(cdc, OpWeightMsgSuperfluidRedelegate, &weightMsgSuperfluidRedelegate, nil,
-	// 	func(_ *rand.Rand) {
-	// 		weightMsgSuperfluidRedelegate = DefaultWeightMsgSuperfluidRedelegate
-	// 	},
-	// )
+	appParams.GetOrGenerate(cdc, OpWeightMsgSuperfluidRedelegate, &weightMsgSuperfluidRedelegate, nil,
+		func(_ *rand.Rand) {
+			weightMsgSuperfluidRedelegate = DefaultWeightMsgSuperfluidRedelegate
+		},
+	)
 
 	return simulation.WeightedOperations{
 		simulation.NewWeightedOperation(
@@ -70,10 +70,10 @@ func WeightedOperations(
 			weightMsgSuperfluidUndelegate,
 			SimulateMsgSuperfluidUndelegate(ak, bk, lk, k),
 		),
-		// simulation.NewWeightedOperation(
-		// 	weightMsgSuperfluidRedelegate,
-		// 	SimulateMsgSuperfluidRedelegate(ak, bk, sk, lk, k),
-		// ),
+		simulation.NewWeightedOperation(
+			weightMsgSuperfluidRedelegate,
+			SimulateMsgSuperfluidRedelegate(ak, bk, sk, lk, k),
+		),
 	}
 }
 
@@ -145,41 +145,45 @@ func SimulateMsgSuperfluidUndelegate(ak stakingtypes.AccountKeeper, bk stakingty
 	}
 }
 
-// func SimulateMsgSuperfluidRedelegate(ak stakingtypes.AccountKeeper, bk stakingtypes.BankKeeper, sk types.StakingKeeper, lk types.LockupKeeper, k keeper.Keeper) simtypes.Operation {
-// 	return func(
-// 		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
-// 	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
-// 		simAccount, _ := simtypes.RandomAcc(r, accs)
-
-// 		// select random validator
-// 		validator := RandomValidator(ctx, r, sk)
-// 		if validator == nil {
-// 			return simtypes.NoOpMsg(
-// 				types.ModuleName, types.TypeMsgSuperfluidRedelegate, "No validator"), nil, nil
-// 		}
-
-// 		lock, simAccount := RandomLockAndAccount(ctx, r, lk, accs)
-// 		if lock == nil {
-// 			return simtypes.NoOpMsg(
-// 				types.ModuleName, types.TypeMsgSuperfluidRedelegate, "Account have no period lock"), nil, nil
-// 		}
-
-// 		if k.GetLockIdIntermediaryAccountConnection(ctx, lock.ID).Empty() {
-// 			return simtypes.NoOpMsg(
-// 				types.ModuleName, types.TypeMsgSuperfluidRedelegate, "Lock is not used for superfluid staking"), nil, nil
-// 		}
-
-// 		msg := types.MsgSuperfluidRedelegate{
-// 			Sender:     lock.Owner,
-// 			LockId:     lock.ID,
-// 			NewValAddr: validator.OperatorAddress,
-// 		}
-
-// 		txGen := simappparams.MakeTestEncodingConfig().TxConfig
-// 		return osmosimtypes.GenAndDeliverTxWithRandFees(
-// 			r, app, txGen, &msg, nil, ctx, simAccount, ak, bk, types.ModuleName)
-// 	}
-// }
+func SimulateMsgSuperfluidRedelegate(ak stakingtypes.AccountKeeper, bk stakingtypes.BankKeeper, sk types.StakingKeeper, lk types.LockupKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		// select random validator
+		validator := RandomValidator(ctx, r, sk)
+		if validator == nil {
+			return simtypes.NoOpMsg(
+				types.ModuleName, types.TypeMsgSuperfluidRedelegate, "No validator"), nil, nil
+		}
+
+		lock, simAccount := RandomLockAndAccount(ctx, r, lk, accs)
+		if lock == nil {
+			return simtypes.NoOpMsg(
+				types.ModuleName, types.TypeMsgSuperfluidRedelegate, "Account have no period lock"), nil, nil
+		}
+
+		intermediaryAcc := k.GetLockIdIntermediaryAccountConnection(ctx, lock.ID)
+		if intermediaryAcc.Empty() {
+			return simtypes.NoOpMsg(
+				types.ModuleName, types.TypeMsgSuperfluidRedelegate, "Lock is not used for superfluid staking"), nil, nil
+		}
+
+		if k.GetIntermediaryAccount(ctx, intermediaryAcc).ValAddr == validator.OperatorAddress {
+			return simtypes.NoOpMsg(
+				types.ModuleName, types.TypeMsgSuperfluidRedelegate, "Lock is already delegated to the validator"), nil, nil
+		}
+
+		msg := types.MsgSuperfluidRedelegate{
+			Sender:     lock.Owner,
+			LockId:     lock.ID,
+			NewValAddr: validator.OperatorAddress,
+		}
+
+		txGen := simappparams.MakeTestEncodingConfig().TxConfig
+		return osmosimtypes.GenAndDeliverTxWithRandFees(
+			r, app, txGen, &msg, nil, ctx, simAccount, ak, bk, types.ModuleName)
+	}
+}
 
 func RandomLockAndAccount(ctx sdk.Context, r *rand.Rand, lk types.LockupKeeper, accs []simtypes.Account) (*lockuptypes.PeriodLock, simtypes.Account) {
 	simAccount, _ := simtypes.RandomAcc(r, accs)
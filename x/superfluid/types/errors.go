@@ -21,4 +21,8 @@ var (
 	ErrPoolNotWhitelisted   = sdkerrors.Register(ModuleName, 41, "pool not whitelisted to unpool")
 	ErrLockUnpoolNotAllowed = sdkerrors.Register(ModuleName, 42, "lock not eligible for unpooling")
 	ErrLockLengthMismatch   = sdkerrors.Register(ModuleName, 43, "lock has more than one asset")
+
+	ErrLockMigrationNotAllowed = sdkerrors.Register(ModuleName, 44, "lock not eligible for liquidity migration")
+
+	ErrNoValidatorSetPreference = sdkerrors.Register(ModuleName, 45, "delegator has no validator set preference")
 )
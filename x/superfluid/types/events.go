@@ -7,6 +7,7 @@ const (
 	TypeEvtSuperfluidDelegate           = "superfluid_delegate"
 	TypeEvtSuperfluidIncreaseDelegation = "superfluid_increase_delegation"
 	TypeEvtSuperfluidUndelegate         = "superfluid_undelegate"
+	TypeEvtSuperfluidRedelegate         = "superfluid_redelegate"
 	TypeEvtSuperfluidUnbondLock         = "superfluid_unbond_lock"
 
 	TypeEvtUnpoolId     = "unpool_pool_id"
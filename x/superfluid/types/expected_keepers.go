@@ -11,6 +11,8 @@ import (
 	gammtypes "github.com/osmosis-labs/osmosis/v13/x/gamm/types"
 	incentivestypes "github.com/osmosis-labs/osmosis/v13/x/incentives/types"
 	lockuptypes "github.com/osmosis-labs/osmosis/v13/x/lockup/types"
+	swaproutertypes "github.com/osmosis-labs/osmosis/v13/x/swaprouter/types"
+	valsetpreftypes "github.com/osmosis-labs/osmosis/v13/x/valset-pref/types"
 )
 
 // LockupKeeper defines the expected interface needed to retrieve locks.
@@ -30,12 +32,16 @@ type LockupKeeper interface {
 	CreateLock(ctx sdk.Context, owner sdk.AccAddress, coins sdk.Coins, duration time.Duration) (lockuptypes.PeriodLock, error)
 
 	SlashTokensFromLockByID(ctx sdk.Context, lockID uint64, coins sdk.Coins) (*lockuptypes.PeriodLock, error)
+	MigrateLockedCoins(ctx sdk.Context, lockID uint64, migrate func(lockedCoins sdk.Coins) (sdk.Coins, error)) (*lockuptypes.PeriodLock, error)
 
 	GetSyntheticLockup(ctx sdk.Context, lockID uint64, suffix string) (*lockuptypes.SyntheticLock, error)
 	GetAllSyntheticLockupsByAddr(ctx sdk.Context, owner sdk.AccAddress) []lockuptypes.SyntheticLock
 	CreateSyntheticLockup(ctx sdk.Context, lockID uint64, suffix string, unlockDuration time.Duration, isUnlocking bool) error
 	DeleteSyntheticLockup(ctx sdk.Context, lockID uint64, suffix string) error
 	GetAllSyntheticLockupsByLockup(ctx sdk.Context, lockID uint64) []lockuptypes.SyntheticLock
+
+	SplitLock(ctx sdk.Context, lockID uint64, owner sdk.AccAddress, amounts []sdk.Int) ([]uint64, error)
+	MergeLocks(ctx sdk.Context, owner sdk.AccAddress, lockIDs []uint64) (uint64, error)
 }
 
 type LockupMsgServer interface {
@@ -47,6 +53,13 @@ type GammKeeper interface {
 	GetPoolAndPoke(ctx sdk.Context, poolId uint64) (gammtypes.CFMMPoolI, error)
 	GetPoolsAndPoke(ctx sdk.Context) (res []gammtypes.CFMMPoolI, err error)
 	ExitPool(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, shareInAmount sdk.Int, tokenOutMins sdk.Coins) (exitCoins sdk.Coins, err error)
+	MigrateLiquidity(ctx sdk.Context, sender sdk.AccAddress, poolIdLeaving, poolIdEntering uint64, sharesToMigrate sdk.Int, tokenOutMins sdk.Coins, shareOutMinAmount sdk.Int) (sharesOut sdk.Int, err error)
+}
+
+// SwapRouterKeeper defines the expected interface needed to look up pools of any pool module
+// for superfluid module.
+type SwapRouterKeeper interface {
+	GetPool(ctx sdk.Context, poolId uint64) (swaproutertypes.PoolI, error)
 }
 
 type BankKeeper interface {
@@ -65,6 +78,9 @@ type StakingKeeper interface {
 	GetValidator(ctx sdk.Context, addr sdk.ValAddress) (validator stakingtypes.Validator, found bool)
 	ValidateUnbondAmount(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, amt sdk.Int) (shares sdk.Dec, err error)
 	Delegate(ctx sdk.Context, delAddr sdk.AccAddress, bondAmt sdk.Int, tokenSrc stakingtypes.BondStatus, validator stakingtypes.Validator, subtractAccount bool) (newShares sdk.Dec, err error)
+	Unbond(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, shares sdk.Dec) (amount sdk.Int, err error)
+	SetRedelegationEntry(ctx sdk.Context, delegatorAddr sdk.AccAddress, validatorSrcAddr, validatorDstAddr sdk.ValAddress, creationHeight int64, minTime time.Time, balance sdk.Int, sharesSrc, sharesDst sdk.Dec) stakingtypes.Redelegation
+	InsertRedelegationQueue(ctx sdk.Context, red stakingtypes.Redelegation, completionTime time.Time)
 	InstantUndelegate(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, sharesAmount sdk.Dec) (sdk.Coins, error)
 	GetDelegation(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) (delegation stakingtypes.Delegation, found bool)
 	UnbondingTime(ctx sdk.Context) time.Duration
@@ -95,3 +111,19 @@ type EpochKeeper interface {
 	GetEpochInfo(ctx sdk.Context, identifier string) epochstypes.EpochInfo
 	NumBlocksSinceEpochStart(ctx sdk.Context, identifier string) (int64, error)
 }
+
+// TwapKeeper defines the expected interface needed to price the assets of pools without OSMO.
+type TwapKeeper interface {
+	GetArithmeticTwapToNow(
+		ctx sdk.Context,
+		poolId uint64,
+		baseAssetDenom string,
+		quoteAssetDenom string,
+		startTime time.Time,
+	) (sdk.Dec, error)
+}
+
+// ValidatorSetPreferenceKeeper defines the expected interface needed to read the validator set preferences of delegators.
+type ValidatorSetPreferenceKeeper interface {
+	GetValidatorSetPreference(ctx sdk.Context, delegator string) (valsetpreftypes.ValidatorSetPreferences, bool)
+}
@@ -36,6 +36,19 @@ type GenesisState struct {
 	// plays an intermediary role between validators and the delegators.
 	IntermediaryAccounts          []SuperfluidIntermediaryAccount       `protobuf:"bytes,4,rep,name=intermediary_accounts,json=intermediaryAccounts,proto3" json:"intermediary_accounts"`
 	IntemediaryAccountConnections []LockIdIntermediaryAccountConnection `protobuf:"bytes,5,rep,name=intemediary_account_connections,json=intemediaryAccountConnections,proto3" json:"intemediary_account_connections"`
+	// osmo_equivalent_multiplier_history is the records of the osmo equivalent
+	// multipliers set for each superfluid asset, by epoch.
+	OsmoEquivalentMultiplierHistory []OsmoEquivalentMultiplierRecord `protobuf:"bytes,6,rep,name=osmo_equivalent_multiplier_history,json=osmoEquivalentMultiplierHistory,proto3" json:"osmo_equivalent_multiplier_history"`
+	// lock_redelegations is the superfluid redelegations of locks that have not
+	// completed yet.
+	LockRedelegations []LockRedelegation `protobuf:"bytes,7,rep,name=lock_redelegations,json=lockRedelegations,proto3" json:"lock_redelegations"`
+	// validator_set_preference_lock_ids is the IDs of the locks superfluid
+	// delegated by their owner's validator set preferences.
+	ValidatorSetPreferenceLockIds []uint64 `protobuf:"varint,8,rep,packed,name=validator_set_preference_lock_ids,json=validatorSetPreferenceLockIds,proto3" json:"validator_set_preference_lock_ids,omitempty"`
+	// validator_set_preference_rebalance_delegators is the delegators whose
+	// locks superfluid delegated by their validator set preferences could not
+	// all be rebalanced yet.
+	ValidatorSetPreferenceRebalanceDelegators []string `protobuf:"bytes,9,rep,name=validator_set_preference_rebalance_delegators,json=validatorSetPreferenceRebalanceDelegators,proto3" json:"validator_set_preference_rebalance_delegators,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -106,6 +119,34 @@ func (m *GenesisState) GetIntemediaryAccountConnections() []LockIdIntermediaryAc
 	return nil
 }
 
+func (m *GenesisState) GetOsmoEquivalentMultiplierHistory() []OsmoEquivalentMultiplierRecord {
+	if m != nil {
+		return m.OsmoEquivalentMultiplierHistory
+	}
+	return nil
+}
+
+func (m *GenesisState) GetLockRedelegations() []LockRedelegation {
+	if m != nil {
+		return m.LockRedelegations
+	}
+	return nil
+}
+
+func (m *GenesisState) GetValidatorSetPreferenceLockIds() []uint64 {
+	if m != nil {
+		return m.ValidatorSetPreferenceLockIds
+	}
+	return nil
+}
+
+func (m *GenesisState) GetValidatorSetPreferenceRebalanceDelegators() []string {
+	if m != nil {
+		return m.ValidatorSetPreferenceRebalanceDelegators
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "osmosis.superfluid.GenesisState")
 }
@@ -113,31 +154,39 @@ func init() {
 func init() { proto.RegisterFile("osmosis/superfluid/genesis.proto", fileDescriptor_d5256ebb7c83fff3) }
 
 var fileDescriptor_d5256ebb7c83fff3 = []byte{
-	// 382 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x92, 0x31, 0x4f, 0xc2, 0x40,
-	0x14, 0xc7, 0x5b, 0x41, 0x86, 0xe2, 0xa0, 0x0d, 0x26, 0x15, 0x63, 0x21, 0xb2, 0xb0, 0xd8, 0x06,
-	0x48, 0xd4, 0x15, 0x8c, 0x31, 0x24, 0x1a, 0x09, 0x24, 0x0e, 0x2e, 0xcd, 0x51, 0xce, 0x7a, 0xb1,
-	0xed, 0xd5, 0x7b, 0x57, 0x02, 0x1f, 0xc0, 0x9d, 0x8f, 0xc5, 0xc8, 0xe8, 0x64, 0x0c, 0x7c, 0x11,
-	0xd3, 0xf6, 0x2c, 0x28, 0xd5, 0xed, 0xb5, 0xef, 0xf7, 0x7f, 0xbf, 0x77, 0xc9, 0x53, 0xaa, 0x14,
-	0x3c, 0x0a, 0x04, 0x4c, 0x08, 0x03, 0xcc, 0x9e, 0xdc, 0x90, 0x8c, 0x4c, 0x07, 0xfb, 0x18, 0x08,
-	0x18, 0x01, 0xa3, 0x9c, 0xaa, 0xaa, 0x20, 0x8c, 0x35, 0x51, 0x2e, 0x39, 0xd4, 0xa1, 0x71, 0xdb,
-	0x8c, 0xaa, 0x84, 0x2c, 0xd7, 0x32, 0x66, 0xad, 0x4b, 0x01, 0x55, 0x32, 0xa0, 0x00, 0x31, 0xe4,
-	0x09, 0xdf, 0xe9, 0x2c, 0xaf, 0xec, 0xdd, 0x24, 0x1b, 0x0c, 0x38, 0xe2, 0x58, 0xbd, 0x54, 0x0a,
-	0x09, 0xa0, 0xc9, 0x55, 0xb9, 0x5e, 0x6c, 0x96, 0x8d, 0xed, 0x8d, 0x8c, 0x5e, 0x4c, 0x74, 0xf2,
-	0xf3, 0x8f, 0x8a, 0xd4, 0x17, 0xbc, 0xfa, 0xa0, 0x1c, 0xac, 0x11, 0x0b, 0x01, 0x60, 0x0e, 0xda,
-	0x4e, 0x35, 0x57, 0x2f, 0x36, 0x6b, 0x59, 0x43, 0x06, 0x69, 0xd9, 0x8e, 0x58, 0x31, 0x6d, 0x1f,
-	0x7e, 0xfe, 0x06, 0x75, 0xa2, 0x1c, 0x47, 0x69, 0x0b, 0xbf, 0x86, 0x64, 0x8c, 0x5c, 0xec, 0x73,
-	0xcb, 0x0b, 0x5d, 0x4e, 0x02, 0x97, 0x60, 0x06, 0x5a, 0x2e, 0x36, 0x34, 0xb3, 0x0c, 0xf7, 0xe0,
-	0xd1, 0xeb, 0x34, 0x75, 0x97, 0x86, 0xfa, 0xd8, 0xa6, 0x6c, 0x24, 0x84, 0x47, 0xf4, 0x0f, 0x0a,
-	0x54, 0x57, 0x39, 0x24, 0x3e, 0xc7, 0xcc, 0xc3, 0x23, 0x82, 0xd8, 0xd4, 0x42, 0xb6, 0x4d, 0x43,
-	0x9f, 0x83, 0x96, 0x8f, 0x9d, 0x8d, 0xff, 0x5f, 0xd5, 0xdd, 0x88, 0xb6, 0x93, 0xa4, 0x50, 0x96,
-	0xc8, 0x76, 0x0b, 0xd4, 0x37, 0x59, 0xa9, 0x44, 0x8d, 0x5f, 0x36, 0xcb, 0xa6, 0xbe, 0x8f, 0x6d,
-	0x4e, 0xa8, 0x0f, 0xda, 0x6e, 0x2c, 0xbe, 0xc8, 0x12, 0xdf, 0x52, 0xfb, 0xa5, 0x9b, 0x25, 0xbd,
-	0x4a, 0xf3, 0x42, 0x7f, 0xb2, 0x61, 0xd9, 0x62, 0xa0, 0xd3, 0x9b, 0x2f, 0x75, 0x79, 0xb1, 0xd4,
-	0xe5, 0xcf, 0xa5, 0x2e, 0xcf, 0x56, 0xba, 0xb4, 0x58, 0xe9, 0xd2, 0xfb, 0x4a, 0x97, 0x1e, 0xcf,
-	0x1d, 0xc2, 0x9f, 0xc3, 0xa1, 0x61, 0x53, 0xcf, 0x14, 0x1b, 0x9c, 0xb9, 0x68, 0x08, 0xdf, 0x1f,
-	0xe6, 0xb8, 0xd1, 0x32, 0x27, 0x9b, 0xb7, 0xc6, 0xa7, 0x01, 0x86, 0x61, 0x21, 0xbe, 0xb5, 0xd6,
-	0x57, 0x00, 0x00, 0x00, 0xff, 0xff, 0x22, 0x7c, 0xe9, 0x81, 0xff, 0x02, 0x00, 0x00,
+	// 510 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x93, 0xc1, 0x6e, 0xd3, 0x30,
+	0x18, 0xc7, 0x1b, 0x3a, 0x3a, 0x96, 0x71, 0x60, 0xd6, 0x90, 0x42, 0xd1, 0xd2, 0xb2, 0x71, 0x28,
+	0x87, 0x35, 0x5a, 0x27, 0x01, 0xd7, 0x0d, 0x10, 0x9b, 0x04, 0xa2, 0x4a, 0x25, 0x24, 0xb8, 0x18,
+	0x37, 0xf9, 0xd6, 0x59, 0x73, 0xe2, 0xe0, 0xcf, 0xa9, 0xd6, 0x07, 0xe0, 0xce, 0x1b, 0xf0, 0x3a,
+	0x3b, 0xee, 0xc8, 0x09, 0xa1, 0xf6, 0x45, 0x50, 0x13, 0x2f, 0x2d, 0x6b, 0xc2, 0x85, 0xdb, 0xd7,
+	0xf8, 0xf7, 0xff, 0x7e, 0xee, 0x5f, 0xb2, 0xdd, 0x96, 0x18, 0x49, 0xe4, 0xe8, 0x61, 0x9a, 0x80,
+	0x3a, 0x13, 0x29, 0x0f, 0xbd, 0x11, 0xc4, 0x80, 0x1c, 0xbb, 0x89, 0x92, 0x5a, 0x12, 0x62, 0x88,
+	0xee, 0x82, 0x68, 0x6e, 0x8f, 0xe4, 0x48, 0x66, 0xc7, 0xde, 0x7c, 0xca, 0xc9, 0xe6, 0x5e, 0xc9,
+	0xae, 0xc5, 0x68, 0xa0, 0x56, 0x09, 0x94, 0x30, 0xc5, 0x22, 0xe3, 0xdb, 0xfd, 0xb1, 0x6e, 0xdf,
+	0x7f, 0x9b, 0xdf, 0x60, 0xa0, 0x99, 0x06, 0xf2, 0xd2, 0x6e, 0xe4, 0x80, 0x63, 0xb5, 0xad, 0xce,
+	0x66, 0xaf, 0xd9, 0x5d, 0xbd, 0x51, 0xb7, 0x9f, 0x11, 0xc7, 0x6b, 0x57, 0xbf, 0x5a, 0x35, 0xdf,
+	0xf0, 0xe4, 0xa3, 0xbd, 0xb5, 0x40, 0x28, 0x43, 0x04, 0x8d, 0xce, 0x9d, 0x76, 0xbd, 0xb3, 0xd9,
+	0xdb, 0x2b, 0x5b, 0x32, 0x28, 0xc6, 0xa3, 0x39, 0x6b, 0xb6, 0x3d, 0xc0, 0xbf, 0x3f, 0x23, 0xb9,
+	0xb4, 0x1f, 0xcf, 0xd3, 0x14, 0xbe, 0xa6, 0x7c, 0xcc, 0x04, 0xc4, 0x9a, 0x46, 0xa9, 0xd0, 0x3c,
+	0x11, 0x1c, 0x14, 0x3a, 0xf5, 0xcc, 0xd0, 0x2b, 0x33, 0x7c, 0xc0, 0x48, 0xbe, 0x29, 0x52, 0xef,
+	0x8b, 0x90, 0x0f, 0x81, 0x54, 0xa1, 0x11, 0x3e, 0x92, 0x15, 0x14, 0x12, 0x61, 0x3f, 0xe4, 0xb1,
+	0x06, 0x15, 0x41, 0xc8, 0x99, 0x9a, 0x50, 0x16, 0x04, 0x32, 0x8d, 0x35, 0x3a, 0x6b, 0x99, 0xf3,
+	0xe0, 0xdf, 0xff, 0xea, 0x74, 0x29, 0x7a, 0x94, 0x27, 0x8d, 0x72, 0x9b, 0xaf, 0x1e, 0x21, 0xf9,
+	0x66, 0xd9, 0xad, 0xf9, 0xc1, 0x2d, 0x1b, 0x0d, 0x64, 0x1c, 0x43, 0xa0, 0xb9, 0x8c, 0xd1, 0xb9,
+	0x9b, 0x89, 0x5f, 0x94, 0x89, 0xdf, 0xc9, 0xe0, 0xe2, 0xb4, 0x4c, 0xfa, 0xaa, 0xc8, 0x1b, 0xfd,
+	0xce, 0x92, 0x65, 0x85, 0xc9, 0xee, 0xb1, 0x5b, 0x5d, 0x38, 0x3d, 0xe7, 0xa8, 0xa5, 0x9a, 0x38,
+	0x8d, 0xff, 0xec, 0xbd, 0x55, 0xd5, 0xfb, 0x49, 0x2e, 0x20, 0x9f, 0x6c, 0x22, 0x64, 0x70, 0x41,
+	0x15, 0x84, 0x20, 0x60, 0xc4, 0xf2, 0x06, 0xd6, 0x33, 0xed, 0xd3, 0xaa, 0x06, 0xfc, 0x25, 0xd8,
+	0x88, 0xb6, 0xc4, 0xad, 0xef, 0x48, 0x4e, 0xec, 0x27, 0x63, 0x26, 0x78, 0xc8, 0xb4, 0x54, 0x14,
+	0x41, 0xd3, 0x44, 0xc1, 0x19, 0x28, 0x88, 0x03, 0xa0, 0x99, 0x93, 0x87, 0xe8, 0xdc, 0x6b, 0xd7,
+	0x3b, 0x6b, 0xfe, 0x4e, 0x01, 0x0e, 0x40, 0xf7, 0x0b, 0x2c, 0x6f, 0x1b, 0xc9, 0x17, 0x7b, 0xbf,
+	0x72, 0x93, 0x82, 0x21, 0x13, 0x6c, 0x3e, 0x19, 0xb7, 0x54, 0xe8, 0x6c, 0xb4, 0xeb, 0x9d, 0x0d,
+	0xff, 0x59, 0xf9, 0x56, 0xff, 0x26, 0xf1, 0xba, 0x08, 0x1c, 0xf7, 0xaf, 0xa6, 0xae, 0x75, 0x3d,
+	0x75, 0xad, 0xdf, 0x53, 0xd7, 0xfa, 0x3e, 0x73, 0x6b, 0xd7, 0x33, 0xb7, 0xf6, 0x73, 0xe6, 0xd6,
+	0x3e, 0x3f, 0x1f, 0x71, 0x7d, 0x9e, 0x0e, 0xbb, 0x81, 0x8c, 0x3c, 0x53, 0xc7, 0xbe, 0x60, 0x43,
+	0xbc, 0xf9, 0xe1, 0x8d, 0x0f, 0x0e, 0xbd, 0xcb, 0xe5, 0xa7, 0xaf, 0x27, 0x09, 0xe0, 0xb0, 0x91,
+	0x3d, 0xfd, 0xc3, 0x3f, 0x03, 0x00, 0x75, 0x65, 0x63, 0x06, 0x8e, 0x04, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -160,6 +209,61 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ValidatorSetPreferenceRebalanceDelegators) > 0 {
+		for iNdEx := len(m.ValidatorSetPreferenceRebalanceDelegators) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ValidatorSetPreferenceRebalanceDelegators[iNdEx])
+			copy(dAtA[i:], m.ValidatorSetPreferenceRebalanceDelegators[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.ValidatorSetPreferenceRebalanceDelegators[iNdEx])))
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.ValidatorSetPreferenceLockIds) > 0 {
+		dAtA2 := make([]byte, len(m.ValidatorSetPreferenceLockIds)*10)
+		var j1 int
+		for _, num := range m.ValidatorSetPreferenceLockIds {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintGenesis(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.LockRedelegations) > 0 {
+		for iNdEx := len(m.LockRedelegations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LockRedelegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.OsmoEquivalentMultiplierHistory) > 0 {
+		for iNdEx := len(m.OsmoEquivalentMultiplierHistory) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OsmoEquivalentMultiplierHistory[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
 	if len(m.IntemediaryAccountConnections) > 0 {
 		for iNdEx := len(m.IntemediaryAccountConnections) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -272,6 +376,31 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.OsmoEquivalentMultiplierHistory) > 0 {
+		for _, e := range m.OsmoEquivalentMultiplierHistory {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.LockRedelegations) > 0 {
+		for _, e := range m.LockRedelegations {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ValidatorSetPreferenceLockIds) > 0 {
+		l = 0
+		for _, e := range m.ValidatorSetPreferenceLockIds {
+			l += sovGenesis(uint64(e))
+		}
+		n += 1 + sovGenesis(uint64(l)) + l
+	}
+	if len(m.ValidatorSetPreferenceRebalanceDelegators) > 0 {
+		for _, s := range m.ValidatorSetPreferenceRebalanceDelegators {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -479,6 +608,182 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OsmoEquivalentMultiplierHistory", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OsmoEquivalentMultiplierHistory = append(m.OsmoEquivalentMultiplierHistory, OsmoEquivalentMultiplierRecord{})
+			if err := m.OsmoEquivalentMultiplierHistory[len(m.OsmoEquivalentMultiplierHistory)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockRedelegations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LockRedelegations = append(m.LockRedelegations, LockRedelegation{})
+			if err := m.LockRedelegations[len(m.LockRedelegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenesis
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.ValidatorSetPreferenceLockIds = append(m.ValidatorSetPreferenceLockIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenesis
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthGenesis
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthGenesis
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.ValidatorSetPreferenceLockIds) == 0 {
+					m.ValidatorSetPreferenceLockIds = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenesis
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.ValidatorSetPreferenceLockIds = append(m.ValidatorSetPreferenceLockIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorSetPreferenceLockIds", wireType)
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorSetPreferenceRebalanceDelegators", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorSetPreferenceRebalanceDelegators = append(m.ValidatorSetPreferenceRebalanceDelegators, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -6,6 +6,7 @@ import (
 
 	gammtypes "github.com/osmosis-labs/osmosis/v13/x/gamm/types"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
@@ -63,11 +64,44 @@ func (p *SetSuperfluidAssetsProposal) ValidateBasic() error {
 		default:
 			return fmt.Errorf("unsupported superfluid asset type")
 		}
+		if err = validateSuperfluidAssetPricing(asset); err != nil {
+			return err
+		}
 	}
 
 	return nil
 }
 
+// validateSuperfluidAssetPricing checks the governance set risk factor and
+// price route of a superfluid asset.
+func validateSuperfluidAssetPricing(asset SuperfluidAsset) error {
+	if asset.RiskFactor != nil && (asset.RiskFactor.IsNegative() || asset.RiskFactor.GTE(sdk.OneDec())) {
+		return fmt.Errorf("risk factor of %s must be in [0, 1), got %s", asset.Denom, asset.RiskFactor)
+	}
+
+	if asset.PriceDenom == "" {
+		if len(asset.PriceRoutes) != 0 {
+			return fmt.Errorf("price routes of %s require a price denom", asset.Denom)
+		}
+		return nil
+	}
+	if err := sdk.ValidateDenom(asset.PriceDenom); err != nil {
+		return err
+	}
+	if len(asset.PriceRoutes) == 0 {
+		return fmt.Errorf("price denom of %s requires price routes", asset.Denom)
+	}
+	for _, route := range asset.PriceRoutes {
+		if route.PoolId == 0 {
+			return fmt.Errorf("price route of %s has an invalid pool id 0", asset.Denom)
+		}
+		if err := sdk.ValidateDenom(route.TokenOutDenom); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (p SetSuperfluidAssetsProposal) String() string {
 	return fmt.Sprintf(`Set Superfluid Assets Proposal:
 	Title:       %s
@@ -0,0 +1,82 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/osmosis-labs/osmosis/v13/x/superfluid/types"
+	swaproutertypes "github.com/osmosis-labs/osmosis/v13/x/swaprouter/types"
+)
+
+func TestSetSuperfluidAssetsProposalValidateBasic(t *testing.T) {
+	riskFactor := func(s string) *sdk.Dec {
+		dec := sdk.MustNewDecFromStr(s)
+		return &dec
+	}
+	priceRoutes := []swaproutertypes.SwapAmountInRoute{{PoolId: 2, TokenOutDenom: "uosmo"}}
+
+	tests := []struct {
+		name      string
+		asset     types.SuperfluidAsset
+		expectErr bool
+	}{
+		{
+			name:  "default risk factor and OSMO pricing",
+			asset: types.SuperfluidAsset{Denom: "gamm/pool/1", AssetType: types.SuperfluidAssetTypeLPShare},
+		},
+		{
+			name: "risk factor and price route",
+			asset: types.SuperfluidAsset{
+				Denom:       "gamm/pool/1",
+				AssetType:   types.SuperfluidAssetTypeLPShare,
+				RiskFactor:  riskFactor("0.6"),
+				PriceDenom:  "uusdc",
+				PriceRoutes: priceRoutes,
+			},
+		},
+		{
+			name:      "negative risk factor",
+			asset:     types.SuperfluidAsset{Denom: "gamm/pool/1", AssetType: types.SuperfluidAssetTypeLPShare, RiskFactor: riskFactor("-0.1")},
+			expectErr: true,
+		},
+		{
+			name:      "risk factor of one",
+			asset:     types.SuperfluidAsset{Denom: "gamm/pool/1", AssetType: types.SuperfluidAssetTypeLPShare, RiskFactor: riskFactor("1")},
+			expectErr: true,
+		},
+		{
+			name:      "price routes without price denom",
+			asset:     types.SuperfluidAsset{Denom: "gamm/pool/1", AssetType: types.SuperfluidAssetTypeLPShare, PriceRoutes: priceRoutes},
+			expectErr: true,
+		},
+		{
+			name:      "price denom without price routes",
+			asset:     types.SuperfluidAsset{Denom: "gamm/pool/1", AssetType: types.SuperfluidAssetTypeLPShare, PriceDenom: "uusdc"},
+			expectErr: true,
+		},
+		{
+			name: "price route with pool id 0",
+			asset: types.SuperfluidAsset{
+				Denom:       "gamm/pool/1",
+				AssetType:   types.SuperfluidAssetTypeLPShare,
+				PriceDenom:  "uusdc",
+				PriceRoutes: []swaproutertypes.SwapAmountInRoute{{PoolId: 0, TokenOutDenom: "uosmo"}},
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			proposal := types.NewSetSuperfluidAssetsProposal("title", "description", []types.SuperfluidAsset{test.asset})
+			err := proposal.ValidateBasic()
+			if test.expectErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
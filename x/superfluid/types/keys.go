@@ -1,5 +1,10 @@
 package types
 
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
 var (
 	// ModuleName defines the module name.
 	ModuleName = "superfluid"
@@ -27,4 +32,36 @@ var (
 
 	// KeyUnpoolAllowedPools defines key to unpool allowed pools.
 	KeyUnpoolAllowedPools = []byte{0x06}
+
+	// KeyPrefixTokenMultiplierHistory defines prefix key for the multipliers of each denom, by epoch.
+	KeyPrefixTokenMultiplierHistory = []byte{0x07}
+
+	// KeyPrefixLockRedelegation defines prefix key for the latest superfluid redelegation of each lock.
+	KeyPrefixLockRedelegation = []byte{0x08}
+
+	// KeyPrefixValidatorSetPreferenceLock defines prefix key for the locks superfluid delegated by their owner's validator set preferences.
+	KeyPrefixValidatorSetPreferenceLock = []byte{0x09}
+
+	// KeyPrefixValidatorSetPreferenceRebalanceDelegator defines prefix key for the delegators whose validator set preference locks are still to rebalance.
+	KeyPrefixValidatorSetPreferenceRebalanceDelegator = []byte{0x0A}
+
+	// KeyValidatorSetPreferenceRebalanceCursor defines key for the last delegator rebalanced at the previous superfluid epoch.
+	KeyValidatorSetPreferenceRebalanceCursor = []byte{0x0B}
 )
+
+// MaxValidatorSetPreferenceRebalancesPerEpoch is the maximum number of delegators whose validator set preference locks
+// are rebalanced again at each superfluid epoch.
+const MaxValidatorSetPreferenceRebalancesPerEpoch = 100
+
+// OsmoEquivalentMultiplierHistoryEpochs is the number of epochs the multipliers of a denom are kept in its multiplier history.
+const OsmoEquivalentMultiplierHistoryEpochs int64 = 30
+
+// GetKeyPrefixTokenMultiplierHistory returns the prefix key for the multipliers of the given denom.
+func GetKeyPrefixTokenMultiplierHistory(denom string) []byte {
+	return append(KeyPrefixTokenMultiplierHistory, address.MustLengthPrefix([]byte(denom))...)
+}
+
+// GetKeyTokenMultiplierHistory returns the key for the multiplier of the given denom for the given epoch.
+func GetKeyTokenMultiplierHistory(denom string, epoch int64) []byte {
+	return append(GetKeyPrefixTokenMultiplierHistory(denom), sdk.Uint64ToBigEndian(uint64(epoch))...)
+}
@@ -56,6 +56,21 @@ func TestAuthzMsg(t *testing.T) {
 				LockId: 1,
 			},
 		},
+		{
+			name: "MsgSuperfluidRedelegate",
+			msg: &types.MsgSuperfluidRedelegate{
+				Sender:     addr1,
+				LockId:     1,
+				NewValAddr: "valoper1xyz",
+			},
+		},
+		{
+			name: "MsgSuperfluidDelegateToValidatorSet",
+			msg: &types.MsgSuperfluidDelegateToValidatorSet{
+				Sender: addr1,
+				LockId: 1,
+			},
+		},
 		{
 			name: "MsgUnPoolWhitelistedPool",
 			msg: &types.MsgUnPoolWhitelistedPool{
@@ -15,6 +15,8 @@ const (
 	TypeMsgSuperfluidUnbondLock      = "superfluid_unbond_underlying_lock"
 	TypeMsgLockAndSuperfluidDelegate = "lock_and_superfluid_delegate"
 	TypeMsgUnPoolWhitelistedPool     = "unpool_whitelisted_pool"
+
+	TypeMsgSuperfluidDelegateToValidatorSet = "superfluid_delegate_to_validator_set"
 )
 
 var _ sdk.Msg = &MsgSuperfluidDelegate{}
@@ -83,38 +85,74 @@ func (m MsgSuperfluidUndelegate) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{sender}
 }
 
-// var _ sdk.Msg = &MsgSuperfluidRedelegate{}
-
-// // NewMsgSuperfluidRedelegate creates a message to do superfluid redelegation
-// func NewMsgSuperfluidRedelegate(sender sdk.AccAddress, lockId uint64, newValAddr sdk.ValAddress) *MsgSuperfluidRedelegate {
-// 	return &MsgSuperfluidRedelegate{
-// 		Sender:     sender.String(),
-// 		LockId:     lockId,
-// 		NewValAddr: newValAddr.String(),
-// 	}
-// }
-
-// func (m MsgSuperfluidRedelegate) Route() string { return RouterKey }
-// func (m MsgSuperfluidRedelegate) Type() string  { return TypeMsgSuperfluidRedelegate }
-// func (m MsgSuperfluidRedelegate) ValidateBasic() error {
-// 	if m.Sender == "" {
-// 		return fmt.Errorf("sender should not be an empty address")
-// 	}
-// 	if m.LockId == 0 {
-// 		return fmt.Errorf("lock id should be positive: %d < 0", m.LockId)
-// 	}
-// 	if m.NewValAddr == "" {
-// 		return fmt.Errorf("NewValAddr should not be empty")
-// 	}
-// 	return nil
-// }
-// func (m MsgSuperfluidRedelegate) GetSignBytes() []byte {
-// 	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
-// }
-// func (m MsgSuperfluidRedelegate) GetSigners() []sdk.AccAddress {
-// 	sender, _ := sdk.AccAddressFromBech32(m.Sender)
-// 	return []sdk.AccAddress{sender}
-// }
+var _ sdk.Msg = &MsgSuperfluidRedelegate{}
+
+// NewMsgSuperfluidRedelegate creates a message to do superfluid redelegation.
+func NewMsgSuperfluidRedelegate(sender sdk.AccAddress, lockId uint64, newValAddr sdk.ValAddress) *MsgSuperfluidRedelegate {
+	return &MsgSuperfluidRedelegate{
+		Sender:     sender.String(),
+		LockId:     lockId,
+		NewValAddr: newValAddr.String(),
+	}
+}
+
+func (m MsgSuperfluidRedelegate) Route() string { return RouterKey }
+func (m MsgSuperfluidRedelegate) Type() string  { return TypeMsgSuperfluidRedelegate }
+func (m MsgSuperfluidRedelegate) ValidateBasic() error {
+	if m.Sender == "" {
+		return fmt.Errorf("sender should not be an empty address")
+	}
+	if m.LockId == 0 {
+		return fmt.Errorf("lock id should be positive: %d < 0", m.LockId)
+	}
+	if m.NewValAddr == "" {
+		return fmt.Errorf("NewValAddr should not be empty")
+	}
+	return nil
+}
+
+func (m MsgSuperfluidRedelegate) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgSuperfluidRedelegate) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgSuperfluidDelegateToValidatorSet{}
+
+// NewMsgSuperfluidDelegateToValidatorSet creates a message to do superfluid delegation
+// split across the sender's validator set preferences.
+func NewMsgSuperfluidDelegateToValidatorSet(sender sdk.AccAddress, lockId uint64) *MsgSuperfluidDelegateToValidatorSet {
+	return &MsgSuperfluidDelegateToValidatorSet{
+		Sender: sender.String(),
+		LockId: lockId,
+	}
+}
+
+func (m MsgSuperfluidDelegateToValidatorSet) Route() string { return RouterKey }
+func (m MsgSuperfluidDelegateToValidatorSet) Type() string {
+	return TypeMsgSuperfluidDelegateToValidatorSet
+}
+func (m MsgSuperfluidDelegateToValidatorSet) ValidateBasic() error {
+	if m.Sender == "" {
+		return fmt.Errorf("sender should not be an empty address")
+	}
+	if m.LockId == 0 {
+		return fmt.Errorf("lock id should be positive: %d < 0", m.LockId)
+	}
+	return nil
+}
+
+func (m MsgSuperfluidDelegateToValidatorSet) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgSuperfluidDelegateToValidatorSet) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{sender}
+}
 
 var _ sdk.Msg = &MsgSuperfluidUnbondLock{}
 
@@ -372,6 +372,110 @@ func (m *AssetMultiplierResponse) GetOsmoEquivalentMultiplier() *OsmoEquivalentM
 	return nil
 }
 
+type AssetMultiplierHistoryRequest struct {
+	Denom      string             `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *AssetMultiplierHistoryRequest) Reset()         { *m = AssetMultiplierHistoryRequest{} }
+func (m *AssetMultiplierHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*AssetMultiplierHistoryRequest) ProtoMessage()    {}
+func (*AssetMultiplierHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e3d9448e4ed3943f, []int{8}
+}
+func (m *AssetMultiplierHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AssetMultiplierHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AssetMultiplierHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AssetMultiplierHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AssetMultiplierHistoryRequest.Merge(m, src)
+}
+func (m *AssetMultiplierHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *AssetMultiplierHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AssetMultiplierHistoryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AssetMultiplierHistoryRequest proto.InternalMessageInfo
+
+func (m *AssetMultiplierHistoryRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *AssetMultiplierHistoryRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type AssetMultiplierHistoryResponse struct {
+	OsmoEquivalentMultipliers []OsmoEquivalentMultiplierRecord `protobuf:"bytes,1,rep,name=osmo_equivalent_multipliers,json=osmoEquivalentMultipliers,proto3" json:"osmo_equivalent_multipliers"`
+	Pagination                *query.PageResponse              `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *AssetMultiplierHistoryResponse) Reset()         { *m = AssetMultiplierHistoryResponse{} }
+func (m *AssetMultiplierHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*AssetMultiplierHistoryResponse) ProtoMessage()    {}
+func (*AssetMultiplierHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e3d9448e4ed3943f, []int{9}
+}
+func (m *AssetMultiplierHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AssetMultiplierHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AssetMultiplierHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AssetMultiplierHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AssetMultiplierHistoryResponse.Merge(m, src)
+}
+func (m *AssetMultiplierHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *AssetMultiplierHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AssetMultiplierHistoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AssetMultiplierHistoryResponse proto.InternalMessageInfo
+
+func (m *AssetMultiplierHistoryResponse) GetOsmoEquivalentMultipliers() []OsmoEquivalentMultiplierRecord {
+	if m != nil {
+		return m.OsmoEquivalentMultipliers
+	}
+	return nil
+}
+
+func (m *AssetMultiplierHistoryResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 type SuperfluidIntermediaryAccountInfo struct {
 	Denom   string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
 	ValAddr string `protobuf:"bytes,2,opt,name=val_addr,json=valAddr,proto3" json:"val_addr,omitempty"`
@@ -383,7 +487,7 @@ func (m *SuperfluidIntermediaryAccountInfo) Reset()         { *m = SuperfluidInt
 func (m *SuperfluidIntermediaryAccountInfo) String() string { return proto.CompactTextString(m) }
 func (*SuperfluidIntermediaryAccountInfo) ProtoMessage()    {}
 func (*SuperfluidIntermediaryAccountInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e3d9448e4ed3943f, []int{8}
+	return fileDescriptor_e3d9448e4ed3943f, []int{10}
 }
 func (m *SuperfluidIntermediaryAccountInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -448,7 +552,7 @@ func (m *AllIntermediaryAccountsRequest) Reset()         { *m = AllIntermediaryA
 func (m *AllIntermediaryAccountsRequest) String() string { return proto.CompactTextString(m) }
 func (*AllIntermediaryAccountsRequest) ProtoMessage()    {}
 func (*AllIntermediaryAccountsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e3d9448e4ed3943f, []int{9}
+	return fileDescriptor_e3d9448e4ed3943f, []int{11}
 }
 func (m *AllIntermediaryAccountsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -493,7 +597,7 @@ func (m *AllIntermediaryAccountsResponse) Reset()         { *m = AllIntermediary
 func (m *AllIntermediaryAccountsResponse) String() string { return proto.CompactTextString(m) }
 func (*AllIntermediaryAccountsResponse) ProtoMessage()    {}
 func (*AllIntermediaryAccountsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e3d9448e4ed3943f, []int{10}
+	return fileDescriptor_e3d9448e4ed3943f, []int{12}
 }
 func (m *AllIntermediaryAccountsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -544,7 +648,7 @@ func (m *ConnectedIntermediaryAccountRequest) Reset()         { *m = ConnectedIn
 func (m *ConnectedIntermediaryAccountRequest) String() string { return proto.CompactTextString(m) }
 func (*ConnectedIntermediaryAccountRequest) ProtoMessage()    {}
 func (*ConnectedIntermediaryAccountRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e3d9448e4ed3943f, []int{11}
+	return fileDescriptor_e3d9448e4ed3943f, []int{13}
 }
 func (m *ConnectedIntermediaryAccountRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -588,7 +692,7 @@ func (m *ConnectedIntermediaryAccountResponse) Reset()         { *m = ConnectedI
 func (m *ConnectedIntermediaryAccountResponse) String() string { return proto.CompactTextString(m) }
 func (*ConnectedIntermediaryAccountResponse) ProtoMessage()    {}
 func (*ConnectedIntermediaryAccountResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e3d9448e4ed3943f, []int{12}
+	return fileDescriptor_e3d9448e4ed3943f, []int{14}
 }
 func (m *ConnectedIntermediaryAccountResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -636,7 +740,7 @@ func (m *QueryTotalDelegationByValidatorForDenomRequest) String() string {
 }
 func (*QueryTotalDelegationByValidatorForDenomRequest) ProtoMessage() {}
 func (*QueryTotalDelegationByValidatorForDenomRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e3d9448e4ed3943f, []int{13}
+	return fileDescriptor_e3d9448e4ed3943f, []int{15}
 }
 func (m *QueryTotalDelegationByValidatorForDenomRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -684,7 +788,7 @@ func (m *QueryTotalDelegationByValidatorForDenomResponse) String() string {
 }
 func (*QueryTotalDelegationByValidatorForDenomResponse) ProtoMessage() {}
 func (*QueryTotalDelegationByValidatorForDenomResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e3d9448e4ed3943f, []int{14}
+	return fileDescriptor_e3d9448e4ed3943f, []int{16}
 }
 func (m *QueryTotalDelegationByValidatorForDenomResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -730,7 +834,7 @@ func (m *Delegations) Reset()         { *m = Delegations{} }
 func (m *Delegations) String() string { return proto.CompactTextString(m) }
 func (*Delegations) ProtoMessage()    {}
 func (*Delegations) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e3d9448e4ed3943f, []int{15}
+	return fileDescriptor_e3d9448e4ed3943f, []int{17}
 }
 func (m *Delegations) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -773,7 +877,7 @@ func (m *TotalSuperfluidDelegationsRequest) Reset()         { *m = TotalSuperflu
 func (m *TotalSuperfluidDelegationsRequest) String() string { return proto.CompactTextString(m) }
 func (*TotalSuperfluidDelegationsRequest) ProtoMessage()    {}
 func (*TotalSuperfluidDelegationsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e3d9448e4ed3943f, []int{16}
+	return fileDescriptor_e3d9448e4ed3943f, []int{18}
 }
 func (m *TotalSuperfluidDelegationsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -810,7 +914,7 @@ func (m *TotalSuperfluidDelegationsResponse) Reset()         { *m = TotalSuperfl
 func (m *TotalSuperfluidDelegationsResponse) String() string { return proto.CompactTextString(m) }
 func (*TotalSuperfluidDelegationsResponse) ProtoMessage()    {}
 func (*TotalSuperfluidDelegationsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e3d9448e4ed3943f, []int{17}
+	return fileDescriptor_e3d9448e4ed3943f, []int{19}
 }
 func (m *TotalSuperfluidDelegationsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -849,7 +953,7 @@ func (m *SuperfluidDelegationAmountRequest) Reset()         { *m = SuperfluidDel
 func (m *SuperfluidDelegationAmountRequest) String() string { return proto.CompactTextString(m) }
 func (*SuperfluidDelegationAmountRequest) ProtoMessage()    {}
 func (*SuperfluidDelegationAmountRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e3d9448e4ed3943f, []int{18}
+	return fileDescriptor_e3d9448e4ed3943f, []int{20}
 }
 func (m *SuperfluidDelegationAmountRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -907,7 +1011,7 @@ func (m *SuperfluidDelegationAmountResponse) Reset()         { *m = SuperfluidDe
 func (m *SuperfluidDelegationAmountResponse) String() string { return proto.CompactTextString(m) }
 func (*SuperfluidDelegationAmountResponse) ProtoMessage()    {}
 func (*SuperfluidDelegationAmountResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e3d9448e4ed3943f, []int{19}
+	return fileDescriptor_e3d9448e4ed3943f, []int{21}
 }
 func (m *SuperfluidDelegationAmountResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -953,7 +1057,7 @@ func (m *SuperfluidDelegationsByDelegatorRequest) Reset() {
 func (m *SuperfluidDelegationsByDelegatorRequest) String() string { return proto.CompactTextString(m) }
 func (*SuperfluidDelegationsByDelegatorRequest) ProtoMessage()    {}
 func (*SuperfluidDelegationsByDelegatorRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e3d9448e4ed3943f, []int{20}
+	return fileDescriptor_e3d9448e4ed3943f, []int{22}
 }
 func (m *SuperfluidDelegationsByDelegatorRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1001,7 +1105,7 @@ func (m *SuperfluidDelegationsByDelegatorResponse) Reset() {
 func (m *SuperfluidDelegationsByDelegatorResponse) String() string { return proto.CompactTextString(m) }
 func (*SuperfluidDelegationsByDelegatorResponse) ProtoMessage()    {}
 func (*SuperfluidDelegationsByDelegatorResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e3d9448e4ed3943f, []int{21}
+	return fileDescriptor_e3d9448e4ed3943f, []int{23}
 }
 func (m *SuperfluidDelegationsByDelegatorResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1064,7 +1168,7 @@ func (m *SuperfluidUndelegationsByDelegatorRequest) String() string {
 }
 func (*SuperfluidUndelegationsByDelegatorRequest) ProtoMessage() {}
 func (*SuperfluidUndelegationsByDelegatorRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e3d9448e4ed3943f, []int{22}
+	return fileDescriptor_e3d9448e4ed3943f, []int{24}
 }
 func (m *SuperfluidUndelegationsByDelegatorRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1121,7 +1225,7 @@ func (m *SuperfluidUndelegationsByDelegatorResponse) String() string {
 }
 func (*SuperfluidUndelegationsByDelegatorResponse) ProtoMessage() {}
 func (*SuperfluidUndelegationsByDelegatorResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e3d9448e4ed3943f, []int{23}
+	return fileDescriptor_e3d9448e4ed3943f, []int{25}
 }
 func (m *SuperfluidUndelegationsByDelegatorResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1184,7 +1288,7 @@ func (m *SuperfluidDelegationsByValidatorDenomRequest) String() string {
 }
 func (*SuperfluidDelegationsByValidatorDenomRequest) ProtoMessage() {}
 func (*SuperfluidDelegationsByValidatorDenomRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e3d9448e4ed3943f, []int{24}
+	return fileDescriptor_e3d9448e4ed3943f, []int{26}
 }
 func (m *SuperfluidDelegationsByValidatorDenomRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1239,7 +1343,7 @@ func (m *SuperfluidDelegationsByValidatorDenomResponse) String() string {
 }
 func (*SuperfluidDelegationsByValidatorDenomResponse) ProtoMessage() {}
 func (*SuperfluidDelegationsByValidatorDenomResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e3d9448e4ed3943f, []int{25}
+	return fileDescriptor_e3d9448e4ed3943f, []int{27}
 }
 func (m *SuperfluidDelegationsByValidatorDenomResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1288,7 +1392,7 @@ func (m *EstimateSuperfluidDelegatedAmountByValidatorDenomRequest) String() stri
 }
 func (*EstimateSuperfluidDelegatedAmountByValidatorDenomRequest) ProtoMessage() {}
 func (*EstimateSuperfluidDelegatedAmountByValidatorDenomRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e3d9448e4ed3943f, []int{26}
+	return fileDescriptor_e3d9448e4ed3943f, []int{28}
 }
 func (m *EstimateSuperfluidDelegatedAmountByValidatorDenomRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1343,7 +1447,7 @@ func (m *EstimateSuperfluidDelegatedAmountByValidatorDenomResponse) String() str
 }
 func (*EstimateSuperfluidDelegatedAmountByValidatorDenomResponse) ProtoMessage() {}
 func (*EstimateSuperfluidDelegatedAmountByValidatorDenomResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e3d9448e4ed3943f, []int{27}
+	return fileDescriptor_e3d9448e4ed3943f, []int{29}
 }
 func (m *EstimateSuperfluidDelegatedAmountByValidatorDenomResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1389,7 +1493,7 @@ func (m *QueryTotalDelegationByDelegatorRequest) Reset() {
 func (m *QueryTotalDelegationByDelegatorRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryTotalDelegationByDelegatorRequest) ProtoMessage()    {}
 func (*QueryTotalDelegationByDelegatorRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e3d9448e4ed3943f, []int{28}
+	return fileDescriptor_e3d9448e4ed3943f, []int{30}
 }
 func (m *QueryTotalDelegationByDelegatorRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1438,7 +1542,7 @@ func (m *QueryTotalDelegationByDelegatorResponse) Reset() {
 func (m *QueryTotalDelegationByDelegatorResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryTotalDelegationByDelegatorResponse) ProtoMessage()    {}
 func (*QueryTotalDelegationByDelegatorResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e3d9448e4ed3943f, []int{29}
+	return fileDescriptor_e3d9448e4ed3943f, []int{31}
 }
 func (m *QueryTotalDelegationByDelegatorResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1502,7 +1606,7 @@ func (m *QueryUnpoolWhitelistRequest) Reset()         { *m = QueryUnpoolWhitelis
 func (m *QueryUnpoolWhitelistRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryUnpoolWhitelistRequest) ProtoMessage()    {}
 func (*QueryUnpoolWhitelistRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e3d9448e4ed3943f, []int{30}
+	return fileDescriptor_e3d9448e4ed3943f, []int{32}
 }
 func (m *QueryUnpoolWhitelistRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1539,7 +1643,7 @@ func (m *QueryUnpoolWhitelistResponse) Reset()         { *m = QueryUnpoolWhiteli
 func (m *QueryUnpoolWhitelistResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryUnpoolWhitelistResponse) ProtoMessage()    {}
 func (*QueryUnpoolWhitelistResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e3d9448e4ed3943f, []int{31}
+	return fileDescriptor_e3d9448e4ed3943f, []int{33}
 }
 func (m *QueryUnpoolWhitelistResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1584,6 +1688,8 @@ func init() {
 	proto.RegisterType((*AllAssetsResponse)(nil), "osmosis.superfluid.AllAssetsResponse")
 	proto.RegisterType((*AssetMultiplierRequest)(nil), "osmosis.superfluid.AssetMultiplierRequest")
 	proto.RegisterType((*AssetMultiplierResponse)(nil), "osmosis.superfluid.AssetMultiplierResponse")
+	proto.RegisterType((*AssetMultiplierHistoryRequest)(nil), "osmosis.superfluid.AssetMultiplierHistoryRequest")
+	proto.RegisterType((*AssetMultiplierHistoryResponse)(nil), "osmosis.superfluid.AssetMultiplierHistoryResponse")
 	proto.RegisterType((*SuperfluidIntermediaryAccountInfo)(nil), "osmosis.superfluid.SuperfluidIntermediaryAccountInfo")
 	proto.RegisterType((*AllIntermediaryAccountsRequest)(nil), "osmosis.superfluid.AllIntermediaryAccountsRequest")
 	proto.RegisterType((*AllIntermediaryAccountsResponse)(nil), "osmosis.superfluid.AllIntermediaryAccountsResponse")
@@ -1613,124 +1719,128 @@ func init() {
 func init() { proto.RegisterFile("osmosis/superfluid/query.proto", fileDescriptor_e3d9448e4ed3943f) }
 
 var fileDescriptor_e3d9448e4ed3943f = []byte{
-	// 1858 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x59, 0x4b, 0x6c, 0xd4, 0xdc,
-	0x15, 0x8e, 0x27, 0xf9, 0x13, 0x72, 0x22, 0x91, 0xe4, 0x42, 0xff, 0x24, 0x06, 0x26, 0xfc, 0x4e,
-	0x48, 0xd2, 0x00, 0x36, 0x09, 0x05, 0x02, 0x14, 0xc4, 0x0c, 0x21, 0x10, 0x29, 0x69, 0xe8, 0x84,
-	0x04, 0xa9, 0x0f, 0x59, 0xce, 0xf8, 0x66, 0x62, 0xc5, 0x63, 0x4f, 0xe6, 0x7a, 0x02, 0x23, 0x84,
-	0x2a, 0xa5, 0xaa, 0x54, 0xd4, 0x45, 0x2b, 0xb1, 0xea, 0xae, 0x5b, 0x58, 0xb4, 0xcb, 0x6e, 0xba,
-	0xa9, 0xba, 0x41, 0xaa, 0x2a, 0x21, 0x75, 0x53, 0x75, 0x01, 0x15, 0x74, 0xd9, 0x6e, 0xba, 0x6c,
-	0xa5, 0xaa, 0xf2, 0xbd, 0xd7, 0x8f, 0x99, 0xb1, 0x3d, 0x9e, 0x40, 0xe1, 0x5f, 0x65, 0xec, 0x7b,
-	0x5e, 0xdf, 0x79, 0x5d, 0x9f, 0x13, 0xc8, 0xda, 0xa4, 0x6c, 0x13, 0x83, 0x28, 0xa4, 0x56, 0xc1,
-	0xd5, 0x6d, 0xb3, 0x66, 0xe8, 0xca, 0x5e, 0x0d, 0x57, 0xeb, 0x72, 0xa5, 0x6a, 0x3b, 0x36, 0x42,
-	0xfc, 0x5c, 0x0e, 0xce, 0xc5, 0xe3, 0x25, 0xbb, 0x64, 0xd3, 0x63, 0xc5, 0xfd, 0xc5, 0x28, 0xc5,
-	0x6c, 0x91, 0x92, 0x2a, 0x5b, 0x1a, 0xc1, 0xca, 0xfe, 0xdc, 0x16, 0x76, 0xb4, 0x39, 0xa5, 0x68,
-	0x1b, 0x16, 0x3f, 0x3f, 0x59, 0xb2, 0xed, 0x92, 0x89, 0x15, 0xad, 0x62, 0x28, 0x9a, 0x65, 0xd9,
-	0x8e, 0xe6, 0x18, 0xb6, 0x45, 0xf8, 0xe9, 0x38, 0x3f, 0xa5, 0x4f, 0x5b, 0xb5, 0x6d, 0xc5, 0x31,
-	0xca, 0x98, 0x38, 0x5a, 0xb9, 0xe2, 0x89, 0x6f, 0x26, 0xd0, 0x6b, 0x55, 0x2a, 0x81, 0x9f, 0x4f,
-	0x44, 0x00, 0x09, 0x7e, 0x7a, 0x5a, 0x22, 0x88, 0x2a, 0x5a, 0x55, 0x2b, 0x7b, 0x66, 0x8c, 0x79,
-	0x04, 0xa6, 0x5d, 0xdc, 0xad, 0x55, 0xe8, 0x1f, 0x7e, 0x34, 0x1b, 0xc6, 0x47, 0x5d, 0xe4, 0xa3,
-	0xac, 0x68, 0x25, 0xc3, 0x0a, 0x1b, 0x33, 0xc9, 0x69, 0x89, 0xa3, 0xed, 0x1a, 0x56, 0xc9, 0x27,
-	0xe4, 0xcf, 0x8c, 0x4a, 0x3a, 0x0e, 0xe8, 0xbb, 0xae, 0x9c, 0xfb, 0xd4, 0x82, 0x02, 0xde, 0xab,
-	0x61, 0xe2, 0x48, 0x6b, 0x70, 0xac, 0xe1, 0x2d, 0xa9, 0xd8, 0x16, 0xc1, 0x68, 0x01, 0x7a, 0x99,
-	0xa5, 0xa3, 0xc2, 0x69, 0x61, 0x66, 0x60, 0x5e, 0x94, 0x5b, 0x23, 0x23, 0x33, 0x9e, 0x7c, 0xcf,
-	0xab, 0x37, 0xe3, 0x5d, 0x05, 0x4e, 0x2f, 0xcd, 0xc0, 0x50, 0x8e, 0x10, 0xec, 0x3c, 0xa8, 0x57,
-	0x30, 0x57, 0x82, 0x8e, 0xc3, 0x17, 0x3a, 0xb6, 0xec, 0x32, 0x15, 0xd6, 0x5f, 0x60, 0x0f, 0xd2,
-	0xf7, 0x61, 0x38, 0x44, 0xc9, 0x15, 0x2f, 0x01, 0x68, 0xee, 0x4b, 0xd5, 0xa9, 0x57, 0x30, 0xa5,
-	0x3f, 0x3a, 0x3f, 0x1d, 0xa5, 0x7c, 0xdd, 0xff, 0x19, 0x08, 0xe9, 0xd7, 0xbc, 0x9f, 0x12, 0x82,
-	0xa1, 0x9c, 0x69, 0xd2, 0x23, 0x1f, 0xeb, 0x26, 0x0c, 0x87, 0xde, 0x71, 0x85, 0x39, 0xe8, 0xa5,
-	0x5c, 0x2e, 0xd2, 0xee, 0x99, 0x81, 0xf9, 0x89, 0x14, 0xca, 0x3c, 0xc8, 0x8c, 0x51, 0x92, 0xe1,
-	0x4b, 0xfa, 0x7a, 0xb5, 0x66, 0x3a, 0x46, 0xc5, 0x34, 0x70, 0x35, 0x19, 0xf8, 0xcf, 0x04, 0x18,
-	0x69, 0x61, 0xe0, 0xe6, 0x54, 0x40, 0x74, 0xf5, 0xab, 0x78, 0xaf, 0x66, 0xec, 0x6b, 0x26, 0xb6,
-	0x1c, 0xb5, 0xec, 0x53, 0xf1, 0x60, 0xcc, 0x47, 0x99, 0xb8, 0x46, 0xca, 0xf6, 0x1d, 0x9f, 0x29,
-	0x2c, 0xb9, 0x68, 0x57, 0xf5, 0xc2, 0xa8, 0x1d, 0x73, 0x2e, 0x3d, 0x13, 0xe0, 0xab, 0x00, 0xdf,
-	0xb2, 0xe5, 0xe0, 0x6a, 0x19, 0xeb, 0x86, 0x56, 0xad, 0xe7, 0x8a, 0x45, 0xbb, 0x66, 0x39, 0xcb,
-	0xd6, 0xb6, 0x1d, 0x8d, 0x04, 0x8d, 0xc1, 0x91, 0x7d, 0xcd, 0x54, 0x35, 0x5d, 0xaf, 0x8e, 0x66,
-	0xe8, 0x41, 0xdf, 0xbe, 0x66, 0xe6, 0x74, 0xbd, 0xea, 0x1e, 0x95, 0xb4, 0x5a, 0x09, 0xab, 0x86,
-	0x3e, 0xda, 0x7d, 0x5a, 0x98, 0xe9, 0x29, 0xf4, 0xd1, 0xe7, 0x65, 0x1d, 0x8d, 0x42, 0x9f, 0xcb,
-	0x81, 0x09, 0x19, 0xed, 0x61, 0x4c, 0xfc, 0x51, 0xda, 0x81, 0x6c, 0xce, 0x34, 0x23, 0x6c, 0xf0,
-	0x62, 0xe8, 0xe6, 0x47, 0x90, 0xff, 0xdc, 0x1f, 0x53, 0x32, 0x2b, 0x00, 0xd9, 0x2d, 0x16, 0x99,
-	0xf5, 0x13, 0x5e, 0x03, 0xf2, 0x7d, 0xad, 0xe4, 0xa5, 0x61, 0x21, 0xc4, 0x29, 0xfd, 0x41, 0x80,
-	0xf1, 0x58, 0x55, 0x3c, 0x16, 0x0f, 0xe1, 0x88, 0xc6, 0xdf, 0xf1, 0xe4, 0xb8, 0x94, 0x9c, 0x1c,
-	0x31, 0xce, 0xe3, 0xe9, 0xe2, 0x0b, 0x43, 0x77, 0x1b, 0x40, 0x64, 0x28, 0x88, 0xe9, 0xb6, 0x20,
-	0x98, 0x55, 0x0d, 0x28, 0x6e, 0xc2, 0xc4, 0x6d, 0xdb, 0xb2, 0x70, 0xd1, 0xc1, 0x51, 0xca, 0x3d,
-	0xa7, 0x8d, 0x40, 0x9f, 0xdb, 0x5a, 0xdc, 0x50, 0x08, 0x34, 0x14, 0xbd, 0xee, 0xe3, 0xb2, 0x2e,
-	0x3d, 0x82, 0xc9, 0x64, 0x7e, 0xee, 0x89, 0x35, 0xe8, 0xe3, 0xc6, 0x73, 0x97, 0x1f, 0xce, 0x11,
-	0x05, 0x4f, 0x8a, 0xb4, 0x04, 0x32, 0x6d, 0x3b, 0x0f, 0x6c, 0x47, 0x33, 0x17, 0xb1, 0x89, 0x4b,
-	0x14, 0x50, 0xbe, 0xbe, 0xa9, 0x99, 0x86, 0xae, 0x39, 0x76, 0x75, 0xc9, 0xae, 0x2e, 0xba, 0x39,
-	0x96, 0x5c, 0x4a, 0x15, 0x50, 0x52, 0xcb, 0xe1, 0x58, 0x6e, 0x34, 0x15, 0xfc, 0x78, 0x14, 0x94,
-	0x40, 0x14, 0x69, 0x2a, 0xf6, 0x83, 0x0c, 0x0c, 0x84, 0x4e, 0x1b, 0x4a, 0x40, 0x68, 0x2c, 0x01,
-	0x0c, 0x03, 0x5a, 0xd9, 0x85, 0xab, 0x92, 0x6d, 0xa2, 0xb3, 0x02, 0xc9, 0x2f, 0xba, 0xd2, 0xfe,
-	0xfa, 0x66, 0x7c, 0xaa, 0x64, 0x38, 0x3b, 0xb5, 0x2d, 0xb9, 0x68, 0x97, 0x15, 0xde, 0xbf, 0xd9,
-	0x9f, 0xf3, 0x44, 0xdf, 0x55, 0xdc, 0xee, 0x47, 0xe4, 0x65, 0xcb, 0xf9, 0xd7, 0x9b, 0x71, 0x54,
-	0xd7, 0xca, 0xe6, 0x35, 0x29, 0x24, 0x4a, 0x2a, 0x00, 0x7b, 0x5a, 0xdf, 0x26, 0x3a, 0xda, 0x83,
-	0xc1, 0xa6, 0x96, 0x41, 0x0b, 0xae, 0x3f, 0x7f, 0xaf, 0x63, 0x55, 0x5f, 0x32, 0x55, 0x4d, 0xe2,
-	0xa4, 0xc2, 0xd1, 0xc6, 0xee, 0x21, 0x4d, 0xc0, 0x57, 0xd4, 0xe3, 0x41, 0xc4, 0x43, 0x2e, 0xf1,
-	0xda, 0xed, 0x0b, 0x01, 0xa4, 0x24, 0x2a, 0x1e, 0x8f, 0x03, 0x01, 0x86, 0x1d, 0x97, 0x4c, 0xd5,
-	0x83, 0x53, 0xe6, 0xca, 0xfc, 0x46, 0xc7, 0x08, 0x26, 0x18, 0x02, 0x26, 0x30, 0x08, 0x68, 0x58,
-	0xb6, 0x54, 0x18, 0x72, 0x1a, 0xd3, 0x85, 0x48, 0xcf, 0x1b, 0x9a, 0x60, 0x70, 0x92, 0x2b, 0x87,
-	0xeb, 0xe8, 0x2c, 0x0c, 0x73, 0x39, 0x76, 0x55, 0xf5, 0x5a, 0x18, 0x0b, 0xfa, 0x90, 0x7f, 0x90,
-	0x63, 0xef, 0x5d, 0xe2, 0x7d, 0x2f, 0x09, 0x7d, 0x62, 0xd6, 0x24, 0x87, 0xfc, 0x03, 0x8f, 0xd8,
-	0xcf, 0xee, 0xee, 0x70, 0x76, 0x3f, 0x13, 0x40, 0x4a, 0xb2, 0x8a, 0x7b, 0xb0, 0x08, 0xbd, 0x2c,
-	0x1d, 0x78, 0x46, 0x8f, 0x35, 0xb4, 0x12, 0xaf, 0x89, 0xdc, 0xb6, 0x0d, 0x2b, 0x7f, 0xc1, 0x75,
-	0xe8, 0xcb, 0xb7, 0xe3, 0x33, 0x29, 0x1c, 0xea, 0x32, 0x90, 0x02, 0x17, 0x2d, 0x6d, 0xc2, 0x74,
-	0x64, 0x1c, 0xf3, 0xf5, 0x45, 0x0f, 0xf9, 0x61, 0xdc, 0x24, 0xfd, 0xb6, 0x1b, 0x66, 0xda, 0x0b,
-	0xe6, 0x48, 0x1f, 0xc3, 0xa9, 0xc8, 0x98, 0xaa, 0x55, 0x7a, 0xcb, 0x79, 0x25, 0x2d, 0x27, 0x77,
-	0xa7, 0x40, 0x09, 0xbb, 0x1c, 0x79, 0x85, 0x9f, 0x20, 0xb1, 0x14, 0x04, 0xfd, 0x08, 0xbe, 0xd1,
-	0x90, 0xa4, 0x58, 0x57, 0xdd, 0xaf, 0x4d, 0x37, 0xa2, 0x1f, 0xdd, 0xe5, 0xc7, 0xc2, 0xe9, 0x89,
-	0x75, 0xfa, 0x12, 0xfd, 0x5c, 0x80, 0x2c, 0xb3, 0x20, 0xf4, 0x69, 0xe0, 0x7e, 0xe1, 0x61, 0x5d,
-	0xe5, 0xd1, 0xef, 0xa6, 0xad, 0x39, 0xc1, 0x14, 0x85, 0x9b, 0x32, 0x9d, 0xd2, 0x94, 0xc2, 0x09,
-	0xaa, 0x31, 0x28, 0xfc, 0x75, 0xaa, 0x8f, 0xa5, 0x9f, 0x64, 0xc1, 0x37, 0x03, 0x9f, 0x6e, 0x58,
-	0xfa, 0x47, 0xcb, 0x89, 0xa0, 0x1a, 0x32, 0xe1, 0x6a, 0xf8, 0x77, 0x06, 0x66, 0xd3, 0x28, 0xfc,
-	0xec, 0xb9, 0xf2, 0x63, 0x01, 0x46, 0x58, 0xa8, 0x6a, 0xd6, 0x27, 0x48, 0x17, 0x96, 0x98, 0x1b,
-	0x81, 0x2a, 0x96, 0x30, 0x2b, 0x30, 0x48, 0xea, 0x96, 0xb3, 0x83, 0x1d, 0xa3, 0xa8, 0xba, 0xf7,
-	0x3d, 0x19, 0xed, 0xa6, 0xca, 0x4f, 0xf9, 0x88, 0xd9, 0xd8, 0x21, 0xaf, 0x7b, 0x64, 0x2b, 0x76,
-	0x71, 0x97, 0x03, 0x3c, 0x4a, 0xc2, 0x2f, 0x89, 0xb4, 0x07, 0xe7, 0x62, 0xaa, 0xd4, 0xbf, 0x69,
-	0x1b, 0xae, 0xeb, 0xc8, 0xee, 0x27, 0xb4, 0xeb, 0x7e, 0x0d, 0xf1, 0x7e, 0x21, 0xc0, 0xf9, 0x94,
-	0x3a, 0x3f, 0x77, 0xc8, 0xa5, 0xa7, 0xb0, 0x70, 0x87, 0x38, 0x46, 0x59, 0x73, 0x70, 0x8b, 0x20,
-	0xaf, 0x60, 0xfe, 0x8f, 0xae, 0xfa, 0x9d, 0x00, 0x57, 0x0f, 0xa1, 0x9f, 0xbb, 0x2d, 0xb6, 0xb7,
-	0x09, 0x9f, 0xa6, 0xb7, 0x49, 0x1b, 0x30, 0x15, 0xfd, 0x15, 0xf7, 0x61, 0x57, 0xcb, 0x2f, 0x7b,
-	0x60, 0xba, 0xad, 0xdc, 0xcf, 0xde, 0x2d, 0x34, 0x38, 0xd6, 0xa0, 0x8e, 0x19, 0xc4, 0x1b, 0xc5,
-	0xac, 0xe7, 0x7b, 0x6f, 0x96, 0xf7, 0xdc, 0x1f, 0x96, 0xc3, 0x38, 0xb8, 0x2e, 0xa4, 0xb7, 0x9c,
-	0xc4, 0x07, 0xb8, 0xfb, 0xeb, 0x73, 0x79, 0xf5, 0x7c, 0xda, 0xcb, 0xeb, 0x14, 0x9c, 0xa0, 0xa9,
-	0xb1, 0x61, 0x55, 0x6c, 0xdb, 0x7c, 0xb8, 0x63, 0x38, 0xd8, 0x34, 0x88, 0xf7, 0xa5, 0x27, 0x5d,
-	0x85, 0x93, 0xd1, 0xc7, 0xdc, 0xa3, 0x63, 0x70, 0xc4, 0x3d, 0x50, 0x0d, 0x9e, 0x19, 0x3d, 0x85,
-	0x3e, 0xf7, 0x79, 0x59, 0x27, 0xf3, 0xff, 0x1d, 0x81, 0x2f, 0x28, 0x2f, 0xfa, 0x89, 0x00, 0xbd,
-	0x6c, 0x47, 0x82, 0xa6, 0xa2, 0xf2, 0xa6, 0x75, 0x1d, 0x23, 0x4e, 0xb7, 0xa5, 0x63, 0x06, 0x48,
-	0xb3, 0x07, 0x7f, 0xfe, 0xfb, 0xf3, 0xcc, 0x24, 0x92, 0x94, 0x88, 0x25, 0x53, 0xb0, 0x29, 0xa2,
-	0xca, 0x7f, 0x2a, 0x40, 0xbf, 0xbf, 0x24, 0x41, 0x93, 0x51, 0x2a, 0x9a, 0x57, 0x36, 0xe2, 0x99,
-	0x36, 0x54, 0xdc, 0x0c, 0x99, 0x9a, 0x31, 0x83, 0xa6, 0x92, 0xcc, 0x08, 0x16, 0x3a, 0xcc, 0x14,
-	0x6f, 0x07, 0x13, 0x63, 0x4a, 0xd3, 0xda, 0x26, 0xc6, 0x94, 0xe6, 0x45, 0x4e, 0x4a, 0x53, 0x4c,
-	0x53, 0x65, 0x83, 0x1c, 0xfa, 0x95, 0x00, 0x83, 0x4d, 0x5b, 0x18, 0x34, 0x1b, 0x8b, 0xba, 0x65,
-	0xb7, 0x23, 0x9e, 0x4d, 0x45, 0xcb, 0x8d, 0xfb, 0x16, 0x35, 0x4e, 0x46, 0xe7, 0xda, 0xfb, 0x29,
-	0x58, 0xf7, 0xa0, 0xdf, 0x0b, 0x30, 0x12, 0xb3, 0xa4, 0x40, 0xf3, 0x31, 0x5e, 0x49, 0x58, 0x9e,
-	0x88, 0x17, 0x3b, 0xe2, 0xe1, 0xa6, 0xdf, 0xa0, 0xa6, 0x5f, 0x41, 0x97, 0xda, 0xf9, 0xd5, 0x08,
-	0x49, 0x51, 0xfd, 0x5d, 0xc7, 0x5b, 0x01, 0x4e, 0x26, 0xed, 0x18, 0xd0, 0x95, 0x28, 0xa3, 0x52,
-	0x6c, 0x35, 0xc4, 0x85, 0xce, 0x19, 0x39, 0xa4, 0x15, 0x0a, 0x69, 0x09, 0x2d, 0x26, 0x41, 0x2a,
-	0x7a, 0x92, 0x22, 0x81, 0x29, 0x4f, 0xf8, 0x46, 0xe5, 0x29, 0xfa, 0x8d, 0x37, 0xe7, 0x26, 0xee,
-	0x1f, 0x50, 0x3e, 0xb6, 0xb4, 0x53, 0x2f, 0x41, 0xc4, 0xdb, 0x1f, 0x24, 0x83, 0xa3, 0xef, 0x42,
-	0x7f, 0x14, 0x40, 0x8c, 0x9f, 0xcc, 0x51, 0xe4, 0x72, 0xa7, 0xed, 0xbc, 0x2f, 0x5e, 0xee, 0x94,
-	0x8d, 0xdb, 0x73, 0x93, 0x46, 0x63, 0x01, 0x5d, 0x6e, 0x97, 0x60, 0xd1, 0xe3, 0x3c, 0xfa, 0x93,
-	0x00, 0x62, 0xfc, 0x94, 0x8c, 0x2e, 0xa5, 0xbd, 0xb2, 0x1b, 0x66, 0xfd, 0x68, 0x34, 0xed, 0x87,
-	0x71, 0xe9, 0x16, 0x45, 0x73, 0x0d, 0x2d, 0x24, 0xa1, 0x89, 0xfe, 0xd4, 0x60, 0x37, 0x21, 0xfa,
-	0xa7, 0x00, 0xa7, 0xdb, 0x4d, 0xc4, 0xe8, 0x7a, 0x5a, 0xf3, 0x22, 0x86, 0x31, 0xf1, 0xdb, 0x87,
-	0x63, 0xe6, 0x08, 0xbf, 0x43, 0x11, 0xde, 0x43, 0x4b, 0x1d, 0x23, 0x24, 0xca, 0x93, 0x96, 0xaf,
-	0xb7, 0xa7, 0xe8, 0x20, 0x13, 0xde, 0x72, 0xc4, 0xcd, 0x75, 0xe8, 0x46, 0xb2, 0xd1, 0x6d, 0x06,
-	0x50, 0xf1, 0xe6, 0x61, 0xd9, 0x39, 0xea, 0x1f, 0x52, 0xd4, 0x0f, 0xd1, 0x46, 0x4a, 0xd4, 0xb5,
-	0xb0, 0x40, 0x75, 0xab, 0xae, 0xfa, 0xc8, 0x23, 0x9d, 0xf0, 0x1f, 0x01, 0xce, 0xa4, 0x1a, 0x76,
-	0xd0, 0xad, 0x0e, 0x82, 0x17, 0x39, 0x70, 0x88, 0xb9, 0x0f, 0x90, 0xc0, 0xbd, 0xb1, 0x4a, 0xbd,
-	0x71, 0x17, 0xdd, 0xe9, 0x3c, 0x07, 0x5c, 0x5f, 0x04, 0xf3, 0x0e, 0xfb, 0x3f, 0xc2, 0xaf, 0x33,
-	0x30, 0xd7, 0xf1, 0xfc, 0x82, 0x56, 0xa2, 0x70, 0x1c, 0x76, 0x0c, 0x13, 0x57, 0x3f, 0x92, 0x34,
-	0xee, 0xa1, 0x1f, 0x50, 0x0f, 0x6d, 0xa2, 0x07, 0x49, 0x1e, 0xc2, 0x5c, 0xbc, 0x9a, 0xd4, 0x10,
-	0xa2, 0x1c, 0xf6, 0x0f, 0xaf, 0x83, 0x47, 0x4e, 0x35, 0xe8, 0x5a, 0xfa, 0x7b, 0xa2, 0xa5, 0x50,
-	0xae, 0x1f, 0x8a, 0x97, 0xa3, 0xde, 0xa0, 0xa8, 0xd7, 0xd0, 0x6a, 0x12, 0xea, 0xe6, 0x6d, 0x6f,
-	0xfb, 0xea, 0x78, 0x29, 0xc0, 0x60, 0xd3, 0xa7, 0x38, 0x52, 0x62, 0xed, 0x8c, 0xfe, 0xa6, 0x17,
-	0x2f, 0xa4, 0x67, 0xe8, 0xe4, 0xab, 0xad, 0x46, 0x99, 0xd5, 0x47, 0x1e, 0x77, 0xfe, 0xfe, 0xab,
-	0x77, 0x59, 0xe1, 0xf5, 0xbb, 0xac, 0xf0, 0xb7, 0x77, 0x59, 0xe1, 0x17, 0xef, 0xb3, 0x5d, 0xaf,
-	0xdf, 0x67, 0xbb, 0xfe, 0xf2, 0x3e, 0xdb, 0xf5, 0xbd, 0xcb, 0xa1, 0xd1, 0x85, 0x4b, 0x3c, 0x6f,
-	0x6a, 0x5b, 0xc4, 0x17, 0xbf, 0x3f, 0x77, 0x51, 0x79, 0x1c, 0x56, 0x42, 0xc7, 0x99, 0xad, 0x5e,
-	0xfa, 0x1f, 0xdc, 0x8b, 0xff, 0x0b, 0x00, 0x00, 0xff, 0xff, 0xfb, 0xb9, 0x77, 0x9e, 0x3f, 0x1f,
-	0x00, 0x00,
+	// 1933 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x59, 0x4d, 0x6c, 0xdc, 0xc6,
+	0x15, 0xd6, 0x48, 0x8a, 0x64, 0x3d, 0x03, 0xb6, 0x3c, 0x76, 0x6d, 0x89, 0xb6, 0x57, 0x0e, 0x65,
+	0x4b, 0xaa, 0x12, 0x93, 0x91, 0x1c, 0x2b, 0x8a, 0x13, 0x1b, 0xd9, 0xb5, 0xac, 0x58, 0x80, 0x5d,
+	0xbb, 0x2b, 0x4b, 0x06, 0xfa, 0x03, 0x82, 0x5a, 0x8e, 0x56, 0x84, 0xb8, 0xe4, 0x8a, 0xc3, 0x55,
+	0xb2, 0x08, 0x84, 0x02, 0x2a, 0x0a, 0x34, 0xe8, 0xa1, 0x05, 0x72, 0xea, 0xad, 0xd7, 0xe4, 0xd0,
+	0x1e, 0x7b, 0x69, 0x0b, 0x14, 0xbd, 0x04, 0x28, 0x0a, 0x04, 0xe8, 0xa5, 0xe8, 0xc1, 0x29, 0xac,
+	0x1e, 0xdb, 0x4b, 0x8f, 0xed, 0xa5, 0xe0, 0xcc, 0xf0, 0x67, 0x77, 0x49, 0x2e, 0x77, 0xad, 0xda,
+	0x3d, 0x69, 0xc9, 0x79, 0x3f, 0xdf, 0xf7, 0xde, 0x9b, 0x37, 0x9c, 0x27, 0x28, 0x38, 0xb4, 0xe6,
+	0x50, 0x93, 0xaa, 0xb4, 0x51, 0x27, 0xee, 0xb6, 0xd5, 0x30, 0x0d, 0x75, 0xaf, 0x41, 0xdc, 0xa6,
+	0x52, 0x77, 0x1d, 0xcf, 0xc1, 0x58, 0xac, 0x2b, 0xd1, 0xba, 0x74, 0xae, 0xea, 0x54, 0x1d, 0xb6,
+	0xac, 0xfa, 0xbf, 0xb8, 0xa4, 0x54, 0xa8, 0x30, 0x51, 0x75, 0x4b, 0xa7, 0x44, 0xdd, 0x5f, 0xd8,
+	0x22, 0x9e, 0xbe, 0xa0, 0x56, 0x1c, 0xd3, 0x16, 0xeb, 0x97, 0xaa, 0x8e, 0x53, 0xb5, 0x88, 0xaa,
+	0xd7, 0x4d, 0x55, 0xb7, 0x6d, 0xc7, 0xd3, 0x3d, 0xd3, 0xb1, 0xa9, 0x58, 0x9d, 0x12, 0xab, 0xec,
+	0x69, 0xab, 0xb1, 0xad, 0x7a, 0x66, 0x8d, 0x50, 0x4f, 0xaf, 0xd5, 0x03, 0xf3, 0xed, 0x02, 0x46,
+	0xc3, 0x65, 0x16, 0xc4, 0xfa, 0x74, 0x02, 0x91, 0xe8, 0x67, 0xe0, 0x25, 0x41, 0xa8, 0xae, 0xbb,
+	0x7a, 0x2d, 0x80, 0x31, 0x19, 0x08, 0x58, 0x4e, 0x65, 0xb7, 0x51, 0x67, 0x7f, 0xc4, 0xd2, 0x7c,
+	0x9c, 0x1f, 0x0b, 0x51, 0xc8, 0xb2, 0xae, 0x57, 0x4d, 0x3b, 0x0e, 0xe6, 0xaa, 0x90, 0xa5, 0x9e,
+	0xbe, 0x6b, 0xda, 0xd5, 0x50, 0x50, 0x3c, 0x73, 0x29, 0xf9, 0x1c, 0xe0, 0x6f, 0xfb, 0x76, 0x1e,
+	0x33, 0x04, 0x65, 0xb2, 0xd7, 0x20, 0xd4, 0x93, 0x1f, 0xc1, 0xd9, 0x96, 0xb7, 0xb4, 0xee, 0xd8,
+	0x94, 0xe0, 0x65, 0x18, 0xe1, 0x48, 0x27, 0xd0, 0x15, 0x34, 0x77, 0x72, 0x51, 0x52, 0x3a, 0x33,
+	0xa3, 0x70, 0x9d, 0xd2, 0xf0, 0x97, 0xcf, 0xa6, 0x06, 0xca, 0x42, 0x5e, 0x9e, 0x83, 0xf1, 0x22,
+	0xa5, 0xc4, 0x7b, 0xd2, 0xac, 0x13, 0xe1, 0x04, 0x9f, 0x83, 0xd7, 0x0c, 0x62, 0x3b, 0x35, 0x66,
+	0x6c, 0xac, 0xcc, 0x1f, 0xe4, 0xef, 0xc2, 0x99, 0x98, 0xa4, 0x70, 0xbc, 0x0a, 0xa0, 0xfb, 0x2f,
+	0x35, 0xaf, 0x59, 0x27, 0x4c, 0xfe, 0xd4, 0xe2, 0x6c, 0x92, 0xf3, 0xf5, 0xf0, 0x67, 0x64, 0x64,
+	0x4c, 0x0f, 0x7e, 0xca, 0x18, 0xc6, 0x8b, 0x96, 0xc5, 0x96, 0x42, 0xae, 0x9b, 0x70, 0x26, 0xf6,
+	0x4e, 0x38, 0x2c, 0xc2, 0x08, 0xd3, 0xf2, 0x99, 0x0e, 0xcd, 0x9d, 0x5c, 0x9c, 0xce, 0xe1, 0x2c,
+	0xa0, 0xcc, 0x15, 0x65, 0x05, 0xce, 0xb3, 0xd7, 0x0f, 0x1b, 0x96, 0x67, 0xd6, 0x2d, 0x93, 0xb8,
+	0xd9, 0xc4, 0x7f, 0x82, 0xe0, 0x42, 0x87, 0x82, 0x80, 0x53, 0x07, 0xc9, 0xf7, 0xaf, 0x91, 0xbd,
+	0x86, 0xb9, 0xaf, 0x5b, 0xc4, 0xf6, 0xb4, 0x5a, 0x28, 0x25, 0x92, 0xb1, 0x98, 0x04, 0xf1, 0x11,
+	0xad, 0x39, 0xf7, 0x42, 0xa5, 0xb8, 0xe5, 0x8a, 0xe3, 0x1a, 0xe5, 0x09, 0x27, 0x65, 0x5d, 0x3e,
+	0x80, 0xcb, 0x6d, 0x60, 0xee, 0x9b, 0xd4, 0x73, 0xdc, 0x66, 0x26, 0x09, 0x3f, 0x51, 0x51, 0x21,
+	0x4e, 0x0c, 0x32, 0x60, 0x33, 0x0a, 0xaf, 0x44, 0xc5, 0xaf, 0x5a, 0x85, 0x6f, 0x6c, 0x51, 0x8c,
+	0xca, 0x63, 0xbd, 0x1a, 0xd4, 0x43, 0x39, 0xa6, 0x29, 0x1f, 0x21, 0x28, 0xa4, 0xf9, 0x17, 0x31,
+	0xf9, 0x18, 0x2e, 0xa6, 0xc7, 0x24, 0xc8, 0x5b, 0x1f, 0x41, 0x11, 0x69, 0x9c, 0x4c, 0x0b, 0x0d,
+	0xc5, 0x1f, 0x26, 0x90, 0x9c, 0xed, 0x4a, 0x92, 0xc3, 0x6e, 0x61, 0xf9, 0x29, 0x82, 0xd7, 0xa3,
+	0x22, 0x5a, 0xb3, 0x3d, 0xe2, 0xd6, 0x88, 0x61, 0xea, 0x6e, 0xb3, 0x58, 0xa9, 0x38, 0x0d, 0xdb,
+	0x5b, 0xb3, 0xb7, 0x9d, 0x94, 0x48, 0x4f, 0xc2, 0x89, 0x7d, 0xdd, 0xd2, 0x74, 0xc3, 0x70, 0x19,
+	0x84, 0xb1, 0xf2, 0xe8, 0xbe, 0x6e, 0x15, 0x0d, 0xc3, 0xf5, 0x97, 0xaa, 0x7a, 0xa3, 0x4a, 0x34,
+	0xd3, 0x98, 0x18, 0xba, 0x82, 0xe6, 0x86, 0xcb, 0xa3, 0xec, 0x79, 0xcd, 0xc0, 0x13, 0x30, 0xea,
+	0x6b, 0x10, 0x4a, 0x27, 0x86, 0xb9, 0x92, 0x78, 0x94, 0x77, 0xa0, 0x50, 0xb4, 0xac, 0x04, 0x0c,
+	0xc1, 0x46, 0x69, 0xcb, 0x2d, 0xea, 0x3b, 0xb7, 0x7f, 0x40, 0x30, 0x95, 0xea, 0x4a, 0x24, 0xf7,
+	0x29, 0x9c, 0xd0, 0xc5, 0x3b, 0x91, 0xc9, 0x9b, 0xd9, 0x3b, 0x30, 0x25, 0x78, 0x22, 0x99, 0xa1,
+	0xb1, 0xe3, 0xcb, 0xdd, 0x1d, 0x98, 0xbe, 0xeb, 0xd8, 0x36, 0xa9, 0x78, 0x24, 0xc9, 0x79, 0x10,
+	0xb4, 0x0b, 0x30, 0xea, 0xf7, 0x6f, 0x3f, 0x15, 0x88, 0xa5, 0x62, 0xc4, 0x7f, 0x5c, 0x33, 0xe4,
+	0x8f, 0xe0, 0x6a, 0xb6, 0xbe, 0x88, 0xc4, 0x23, 0x18, 0x15, 0xe0, 0x45, 0xc8, 0xfb, 0x0b, 0x44,
+	0x39, 0xb0, 0x22, 0xaf, 0x82, 0xc2, 0x7a, 0xfb, 0x13, 0xc7, 0xd3, 0xad, 0x15, 0x62, 0x91, 0x2a,
+	0x23, 0x54, 0x6a, 0x6e, 0xea, 0x96, 0x69, 0xe8, 0x9e, 0xe3, 0xae, 0x3a, 0xee, 0x8a, 0x5f, 0x63,
+	0xd9, 0xfd, 0xaa, 0x0e, 0x6a, 0x6e, 0x3b, 0x82, 0xcb, 0xed, 0xb6, 0xae, 0x3a, 0x95, 0x44, 0x25,
+	0x32, 0x45, 0xdb, 0x3a, 0xea, 0xe1, 0x20, 0x9c, 0x8c, 0xad, 0xb6, 0x6c, 0x01, 0xd4, 0xba, 0x05,
+	0x08, 0x9c, 0xd4, 0x6b, 0x3e, 0x5d, 0x8d, 0x6e, 0x53, 0x83, 0x6f, 0x90, 0xd2, 0x8a, 0x6f, 0xed,
+	0xaf, 0xcf, 0xa6, 0x66, 0xaa, 0xa6, 0xb7, 0xd3, 0xd8, 0x52, 0x2a, 0x4e, 0x4d, 0x15, 0x87, 0x24,
+	0xff, 0x73, 0x9d, 0x1a, 0xbb, 0xaa, 0x7f, 0xc4, 0x50, 0x65, 0xcd, 0xf6, 0xfe, 0xf5, 0x6c, 0x0a,
+	0x37, 0xf5, 0x9a, 0x75, 0x4b, 0x8e, 0x99, 0x92, 0xcb, 0xc0, 0x9f, 0xd6, 0xb7, 0xa9, 0x81, 0xf7,
+	0xe0, 0x74, 0x5b, 0x0f, 0x62, 0x1b, 0x6e, 0xac, 0x74, 0xbf, 0x67, 0x57, 0xe7, 0xb9, 0xab, 0x36,
+	0x73, 0x72, 0xf9, 0x54, 0x6b, 0x1f, 0x92, 0xa7, 0xe1, 0x75, 0x16, 0xf1, 0x28, 0xe3, 0xb1, 0x90,
+	0x04, 0x67, 0xda, 0xe7, 0x08, 0xe4, 0x2c, 0x29, 0x91, 0x8f, 0x43, 0x04, 0x67, 0x3c, 0x5f, 0x4c,
+	0x33, 0xa2, 0x55, 0x1e, 0xca, 0xd2, 0x46, 0xcf, 0x0c, 0xa6, 0x39, 0x03, 0x6e, 0x30, 0x4a, 0x68,
+	0xdc, 0xb6, 0x5c, 0x1e, 0xf7, 0x5a, 0xcb, 0x85, 0xca, 0x9f, 0xb5, 0x34, 0xc1, 0x68, 0xa5, 0x58,
+	0x8b, 0xef, 0xa3, 0x37, 0xe0, 0x8c, 0xb0, 0xe3, 0xb8, 0x5a, 0xd0, 0xc2, 0x78, 0xd2, 0xc7, 0xc3,
+	0x85, 0x22, 0x7f, 0xef, 0x0b, 0xef, 0x07, 0x45, 0x18, 0x0a, 0xf3, 0x26, 0x39, 0x1e, 0x2e, 0x04,
+	0xc2, 0x61, 0x75, 0x0f, 0xc5, 0xab, 0xfb, 0x53, 0x04, 0x72, 0x16, 0x2a, 0x11, 0xc1, 0x0a, 0x8c,
+	0xf0, 0x72, 0x10, 0x15, 0x3d, 0xd9, 0xd2, 0x4a, 0x82, 0x26, 0x72, 0xd7, 0x31, 0xed, 0xd2, 0x5b,
+	0x7e, 0x40, 0xbf, 0xf8, 0x7a, 0x6a, 0x2e, 0x47, 0x40, 0x7d, 0x05, 0x5a, 0x16, 0xa6, 0xe5, 0x4d,
+	0x98, 0x4d, 0xcc, 0x63, 0xa9, 0xb9, 0x12, 0x30, 0xef, 0x27, 0x4c, 0xf2, 0xaf, 0x87, 0x60, 0xae,
+	0xbb, 0xe1, 0xf0, 0xb8, 0xbd, 0x9c, 0x98, 0x53, 0xcd, 0x65, 0xa7, 0x66, 0xb0, 0xa5, 0x95, 0xec,
+	0xee, 0x14, 0x39, 0x69, 0x39, 0x6c, 0x2f, 0xd2, 0x54, 0x09, 0x8a, 0x7f, 0x00, 0xdf, 0x68, 0x29,
+	0x52, 0x62, 0x68, 0xfe, 0x27, 0xbd, 0x9f, 0xd1, 0x63, 0x0f, 0xf9, 0xd9, 0x78, 0x79, 0x12, 0x83,
+	0xbd, 0xc4, 0x3f, 0x45, 0x50, 0xe0, 0x08, 0x62, 0xdf, 0x1a, 0xfe, 0x67, 0x34, 0x31, 0x34, 0x91,
+	0xfd, 0xa1, 0x2b, 0x28, 0x1b, 0x8a, 0x2a, 0xa0, 0xcc, 0xe6, 0x84, 0x52, 0xbe, 0xc8, 0x3c, 0x46,
+	0x1b, 0x7f, 0x9d, 0xf9, 0xe3, 0xe5, 0x27, 0xdb, 0xf0, 0xcd, 0x28, 0xa6, 0x1b, 0xb6, 0x71, 0x6c,
+	0x35, 0x11, 0xed, 0x86, 0xc1, 0xf8, 0x6e, 0xf8, 0xf7, 0x20, 0xcc, 0xe7, 0x71, 0xf8, 0xca, 0x6b,
+	0xe5, 0x87, 0x08, 0x2e, 0xf0, 0x54, 0x35, 0xec, 0x97, 0x50, 0x2e, 0xbc, 0x30, 0x37, 0x22, 0x57,
+	0xbc, 0x60, 0x1e, 0xc0, 0x69, 0xda, 0xb4, 0xbd, 0x1d, 0xe2, 0x99, 0x15, 0xcd, 0x3f, 0xef, 0xe9,
+	0xc4, 0x10, 0x73, 0x7e, 0x39, 0x64, 0xcc, 0xef, 0x76, 0xca, 0x7a, 0x20, 0xf6, 0xc0, 0xa9, 0xec,
+	0x0a, 0x82, 0xa7, 0x68, 0xfc, 0x25, 0x95, 0xf7, 0xe0, 0xcd, 0x94, 0x5d, 0x1a, 0x9e, 0xb4, 0x2d,
+	0xc7, 0x75, 0x62, 0xf7, 0x43, 0xdd, 0xba, 0x5f, 0x4b, 0xbe, 0x3f, 0x47, 0x70, 0x3d, 0xa7, 0xcf,
+	0x57, 0x9d, 0x72, 0xf9, 0x00, 0x96, 0xef, 0x51, 0xcf, 0xac, 0xe9, 0x1e, 0xe9, 0x30, 0x14, 0x6c,
+	0x98, 0xff, 0x61, 0xa8, 0x7e, 0x83, 0xe0, 0xdd, 0x3e, 0xfc, 0x8b, 0xb0, 0xa5, 0xf6, 0x36, 0xf4,
+	0x72, 0x7a, 0x9b, 0xbc, 0x01, 0x33, 0xc9, 0x5f, 0x71, 0x2f, 0x76, 0xb4, 0xfc, 0x7c, 0x18, 0x66,
+	0xbb, 0xda, 0x7d, 0xe5, 0xdd, 0x42, 0x87, 0xb3, 0x2d, 0xee, 0x38, 0x20, 0xd1, 0x28, 0xe6, 0x83,
+	0xd8, 0x07, 0x03, 0x93, 0x20, 0xfc, 0x71, 0x3b, 0x5c, 0x43, 0xf8, 0xc2, 0x46, 0xc7, 0x4a, 0x7a,
+	0x82, 0x87, 0xfe, 0x7f, 0x0e, 0xaf, 0xe1, 0x97, 0x7b, 0x78, 0x5d, 0x86, 0x8b, 0xac, 0x34, 0x36,
+	0xec, 0xba, 0xe3, 0x58, 0x4f, 0x77, 0x4c, 0x8f, 0x58, 0x26, 0x0d, 0xbe, 0xf4, 0xe4, 0x77, 0xe1,
+	0x52, 0xf2, 0xb2, 0x88, 0xe8, 0x24, 0x9c, 0xf0, 0x17, 0x34, 0x53, 0x54, 0xc6, 0x70, 0x79, 0xd4,
+	0x7f, 0x5e, 0x33, 0xe8, 0xe2, 0xef, 0x26, 0xe1, 0x35, 0xa6, 0x8b, 0x7f, 0x84, 0x60, 0x84, 0x0f,
+	0xa2, 0xf0, 0x4c, 0x52, 0xdd, 0x74, 0xce, 0xbc, 0xa4, 0xd9, 0xae, 0x72, 0x1c, 0x80, 0x3c, 0x7f,
+	0xf8, 0xe7, 0xbf, 0x7f, 0x36, 0x78, 0x15, 0xcb, 0x6a, 0xc2, 0x24, 0x2f, 0x1a, 0xc7, 0x31, 0xe7,
+	0x3f, 0x46, 0x30, 0x16, 0x4e, 0xa2, 0xf0, 0xd5, 0x24, 0x17, 0xed, 0x73, 0x31, 0xe9, 0x5a, 0x17,
+	0x29, 0x01, 0x43, 0x61, 0x30, 0xe6, 0xf0, 0x4c, 0x16, 0x8c, 0x68, 0x6a, 0xc6, 0xa1, 0x04, 0x83,
+	0xae, 0x14, 0x28, 0x6d, 0xb3, 0x31, 0xe9, 0x5a, 0x17, 0xa9, 0x9e, 0xa0, 0x58, 0x96, 0xa6, 0x73,
+	0xe7, 0xbf, 0x40, 0x70, 0xba, 0x6d, 0xba, 0x83, 0xe7, 0x53, 0x59, 0x77, 0x0c, 0xd0, 0xa4, 0x37,
+	0x72, 0xc9, 0x0a, 0x70, 0x6f, 0x33, 0x70, 0x0a, 0x7e, 0xb3, 0x7b, 0x9c, 0xa2, 0xf9, 0x11, 0xfe,
+	0x2d, 0x82, 0xf3, 0xc9, 0x03, 0x28, 0xbc, 0x90, 0xc3, 0x7b, 0xeb, 0xb0, 0x4c, 0x5a, 0xec, 0x45,
+	0x45, 0xe0, 0x7e, 0x9f, 0xe1, 0x5e, 0xc2, 0x6f, 0xf7, 0x82, 0x5b, 0xdb, 0x11, 0x20, 0x7f, 0xef,
+	0x4f, 0x13, 0x93, 0x87, 0x2c, 0x78, 0x31, 0x25, 0xab, 0x19, 0xc3, 0x1f, 0xe9, 0x46, 0x4f, 0x3a,
+	0x82, 0xc2, 0x6d, 0x46, 0xe1, 0x1d, 0x7c, 0xb3, 0x5b, 0x5d, 0x98, 0x31, 0x2b, 0x5a, 0x38, 0xab,
+	0xf9, 0x1a, 0xc1, 0xa5, 0xac, 0x19, 0x09, 0x7e, 0x27, 0x09, 0x54, 0x8e, 0xa9, 0x8c, 0xb4, 0xdc,
+	0xbb, 0xa2, 0xa0, 0xf4, 0x80, 0x51, 0x5a, 0xc5, 0x2b, 0x59, 0x94, 0x2a, 0x81, 0xa5, 0x44, 0x62,
+	0xea, 0x27, 0x62, 0x22, 0x74, 0x80, 0x7f, 0x15, 0xdc, 0xd3, 0x33, 0xe7, 0x27, 0xb8, 0x94, 0xda,
+	0x9a, 0x72, 0x0f, 0x71, 0xa4, 0xbb, 0x2f, 0x64, 0x43, 0xb0, 0x1f, 0xc0, 0x7f, 0x44, 0x20, 0xa5,
+	0x4f, 0x16, 0x70, 0xe2, 0x70, 0xaa, 0xeb, 0xbc, 0x42, 0x5a, 0xea, 0x55, 0x4d, 0xe0, 0xb9, 0xc3,
+	0xb2, 0xb1, 0x8c, 0x97, 0xba, 0x15, 0x58, 0xf2, 0x38, 0x02, 0xff, 0x09, 0x81, 0x94, 0x7e, 0xcb,
+	0xc7, 0x37, 0xf3, 0x7e, 0x72, 0xb4, 0xcc, 0x2a, 0xa4, 0xa5, 0x5e, 0xd5, 0x04, 0x9b, 0x0f, 0x18,
+	0x9b, 0x5b, 0x78, 0x39, 0x8b, 0x4d, 0xf2, 0xa7, 0x12, 0x3f, 0xc9, 0xf1, 0x3f, 0x11, 0x5c, 0xe9,
+	0x76, 0xa3, 0xc7, 0xef, 0xe5, 0x85, 0x97, 0x70, 0x99, 0x94, 0xde, 0xef, 0x4f, 0x59, 0x30, 0xfc,
+	0x16, 0x63, 0x78, 0x1f, 0xaf, 0xf6, 0xcc, 0x90, 0xaa, 0x9f, 0x74, 0x7c, 0x7d, 0x1e, 0xe0, 0xc3,
+	0xc1, 0xf8, 0x94, 0x26, 0xed, 0x5e, 0x8a, 0x6f, 0x67, 0x83, 0xee, 0x72, 0x81, 0x96, 0xee, 0xf4,
+	0xab, 0x2e, 0x58, 0x7f, 0x9f, 0xb1, 0x7e, 0x8a, 0x37, 0x72, 0xb2, 0x6e, 0xc4, 0x0d, 0x6a, 0x5b,
+	0x4d, 0x2d, 0x64, 0x9e, 0x18, 0x84, 0xff, 0x20, 0xb8, 0x96, 0xeb, 0xb2, 0x86, 0x3f, 0xe8, 0x21,
+	0x79, 0x89, 0x17, 0x26, 0xa9, 0xf8, 0x02, 0x16, 0x44, 0x34, 0x1e, 0xb2, 0x68, 0x7c, 0x88, 0xef,
+	0xf5, 0x5e, 0x03, 0x7e, 0x2c, 0xa2, 0xfb, 0x1a, 0xff, 0x3f, 0xc8, 0x2f, 0x07, 0x61, 0xa1, 0xe7,
+	0xfb, 0x17, 0x7e, 0x90, 0xc4, 0xa3, 0xdf, 0x6b, 0xa4, 0xf4, 0xf0, 0x98, 0xac, 0x89, 0x08, 0x7d,
+	0x8f, 0x45, 0x68, 0x13, 0x3f, 0xc9, 0x8a, 0x10, 0x11, 0xe6, 0xb5, 0xac, 0x86, 0x90, 0x14, 0xb0,
+	0x7f, 0x04, 0x1d, 0x3c, 0xf1, 0x56, 0x86, 0x6f, 0xe5, 0x3f, 0x27, 0x3a, 0x36, 0xca, 0x7b, 0x7d,
+	0xe9, 0x0a, 0xd6, 0x1b, 0x8c, 0xf5, 0x23, 0xfc, 0x30, 0x8b, 0x75, 0xfb, 0xb4, 0xba, 0xfb, 0xee,
+	0xf8, 0x02, 0xc1, 0xe9, 0xb6, 0xab, 0x04, 0x56, 0x53, 0x71, 0x26, 0xdf, 0x49, 0xa4, 0xb7, 0xf2,
+	0x2b, 0xf4, 0xf2, 0xd5, 0xd9, 0x60, 0xca, 0xda, 0x47, 0x81, 0x76, 0xe9, 0xf1, 0x97, 0xcf, 0x0b,
+	0xe8, 0xab, 0xe7, 0x05, 0xf4, 0xb7, 0xe7, 0x05, 0xf4, 0xb3, 0xa3, 0xc2, 0xc0, 0x57, 0x47, 0x85,
+	0x81, 0xbf, 0x1c, 0x15, 0x06, 0xbe, 0xb3, 0x14, 0xbb, 0x7a, 0x09, 0x8b, 0xd7, 0x2d, 0x7d, 0x8b,
+	0x86, 0xe6, 0xf7, 0x17, 0x6e, 0xa8, 0x1f, 0xc7, 0x9d, 0xb0, 0xeb, 0xd8, 0xd6, 0x08, 0xfb, 0x37,
+	0xff, 0x8d, 0xff, 0x0e, 0x00, 0x22, 0xcd, 0x4b, 0xd9, 0x64, 0x21, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1754,6 +1864,9 @@ type QueryClient interface {
 	AllAssets(ctx context.Context, in *AllAssetsRequest, opts ...grpc.CallOption) (*AllAssetsResponse, error)
 	// Returns the osmo equivalent multiplier used in the most recent epoch.
 	AssetMultiplier(ctx context.Context, in *AssetMultiplierRequest, opts ...grpc.CallOption) (*AssetMultiplierResponse, error)
+	// Returns the osmo equivalent multipliers set for a superfluid asset, by
+	// epoch.
+	AssetMultiplierHistory(ctx context.Context, in *AssetMultiplierHistoryRequest, opts ...grpc.CallOption) (*AssetMultiplierHistoryResponse, error)
 	// Returns all superfluid intermediary accounts.
 	AllIntermediaryAccounts(ctx context.Context, in *AllIntermediaryAccountsRequest, opts ...grpc.CallOption) (*AllIntermediaryAccountsResponse, error)
 	// Returns intermediary account connected to a superfluid staked lock by id
@@ -1827,6 +1940,15 @@ func (c *queryClient) AssetMultiplier(ctx context.Context, in *AssetMultiplierRe
 	return out, nil
 }
 
+func (c *queryClient) AssetMultiplierHistory(ctx context.Context, in *AssetMultiplierHistoryRequest, opts ...grpc.CallOption) (*AssetMultiplierHistoryResponse, error) {
+	out := new(AssetMultiplierHistoryResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.superfluid.Query/AssetMultiplierHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) AllIntermediaryAccounts(ctx context.Context, in *AllIntermediaryAccountsRequest, opts ...grpc.CallOption) (*AllIntermediaryAccountsResponse, error) {
 	out := new(AllIntermediaryAccountsResponse)
 	err := c.cc.Invoke(ctx, "/osmosis.superfluid.Query/AllIntermediaryAccounts", in, out, opts...)
@@ -1937,6 +2059,9 @@ type QueryServer interface {
 	AllAssets(context.Context, *AllAssetsRequest) (*AllAssetsResponse, error)
 	// Returns the osmo equivalent multiplier used in the most recent epoch.
 	AssetMultiplier(context.Context, *AssetMultiplierRequest) (*AssetMultiplierResponse, error)
+	// Returns the osmo equivalent multipliers set for a superfluid asset, by
+	// epoch.
+	AssetMultiplierHistory(context.Context, *AssetMultiplierHistoryRequest) (*AssetMultiplierHistoryResponse, error)
 	// Returns all superfluid intermediary accounts.
 	AllIntermediaryAccounts(context.Context, *AllIntermediaryAccountsRequest) (*AllIntermediaryAccountsResponse, error)
 	// Returns intermediary account connected to a superfluid staked lock by id
@@ -1982,6 +2107,9 @@ func (*UnimplementedQueryServer) AllAssets(ctx context.Context, req *AllAssetsRe
 func (*UnimplementedQueryServer) AssetMultiplier(ctx context.Context, req *AssetMultiplierRequest) (*AssetMultiplierResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AssetMultiplier not implemented")
 }
+func (*UnimplementedQueryServer) AssetMultiplierHistory(ctx context.Context, req *AssetMultiplierHistoryRequest) (*AssetMultiplierHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AssetMultiplierHistory not implemented")
+}
 func (*UnimplementedQueryServer) AllIntermediaryAccounts(ctx context.Context, req *AllIntermediaryAccountsRequest) (*AllIntermediaryAccountsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AllIntermediaryAccounts not implemented")
 }
@@ -2092,6 +2220,24 @@ func _Query_AssetMultiplier_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_AssetMultiplierHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AssetMultiplierHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AssetMultiplierHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.superfluid.Query/AssetMultiplierHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AssetMultiplierHistory(ctx, req.(*AssetMultiplierHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_AllIntermediaryAccounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(AllIntermediaryAccountsRequest)
 	if err := dec(in); err != nil {
@@ -2310,6 +2456,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "AssetMultiplier",
 			Handler:    _Query_AssetMultiplier_Handler,
 		},
+		{
+			MethodName: "AssetMultiplierHistory",
+			Handler:    _Query_AssetMultiplierHistory_Handler,
+		},
 		{
 			MethodName: "AllIntermediaryAccounts",
 			Handler:    _Query_AllIntermediaryAccounts_Handler,
@@ -2598,6 +2748,97 @@ func (m *AssetMultiplierResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *AssetMultiplierHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AssetMultiplierHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AssetMultiplierHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AssetMultiplierHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AssetMultiplierHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AssetMultiplierHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.OsmoEquivalentMultipliers) > 0 {
+		for iNdEx := len(m.OsmoEquivalentMultipliers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OsmoEquivalentMultipliers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *SuperfluidIntermediaryAccountInfo) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -3538,20 +3779,20 @@ func (m *QueryUnpoolWhitelistResponse) MarshalToSizedBuffer(dAtA []byte) (int, e
 	var l int
 	_ = l
 	if len(m.PoolIds) > 0 {
-		dAtA9 := make([]byte, len(m.PoolIds)*10)
-		var j8 int
+		dAtA11 := make([]byte, len(m.PoolIds)*10)
+		var j10 int
 		for _, num := range m.PoolIds {
 			for num >= 1<<7 {
-				dAtA9[j8] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA11[j10] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j8++
+				j10++
 			}
-			dAtA9[j8] = uint8(num)
-			j8++
+			dAtA11[j10] = uint8(num)
+			j10++
 		}
-		i -= j8
-		copy(dAtA[i:], dAtA9[:j8])
-		i = encodeVarintQuery(dAtA, i, uint64(j8))
+		i -= j10
+		copy(dAtA[i:], dAtA11[:j10])
+		i = encodeVarintQuery(dAtA, i, uint64(j10))
 		i--
 		dAtA[i] = 0xa
 	}
@@ -3664,6 +3905,42 @@ func (m *AssetMultiplierResponse) Size() (n int) {
 	return n
 }
 
+func (m *AssetMultiplierHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *AssetMultiplierHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.OsmoEquivalentMultipliers) > 0 {
+		for _, e := range m.OsmoEquivalentMultipliers {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func (m *SuperfluidIntermediaryAccountInfo) Size() (n int) {
 	if m == nil {
 		return 0
@@ -4649,6 +4926,244 @@ func (m *AssetMultiplierResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *AssetMultiplierHistoryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AssetMultiplierHistoryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AssetMultiplierHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AssetMultiplierHistoryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AssetMultiplierHistoryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AssetMultiplierHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OsmoEquivalentMultipliers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OsmoEquivalentMultipliers = append(m.OsmoEquivalentMultipliers, OsmoEquivalentMultiplierRecord{})
+			if err := m.OsmoEquivalentMultipliers[len(m.OsmoEquivalentMultipliers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *SuperfluidIntermediaryAccountInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -6,19 +6,23 @@ package types
 import (
 	fmt "fmt"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
-	types "github.com/cosmos/cosmos-sdk/types"
+	types1 "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	_ "github.com/gogo/protobuf/types"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	types "github.com/osmosis-labs/osmosis/v13/x/swaprouter/types"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -59,6 +63,17 @@ type SuperfluidAsset struct {
 	// AssetType indicates whether the superfluid asset is a native token or an lp
 	// share
 	AssetType SuperfluidAssetType `protobuf:"varint,2,opt,name=asset_type,json=assetType,proto3,enum=osmosis.superfluid.SuperfluidAssetType" json:"asset_type,omitempty"`
+	// risk_factor is the share of the OSMO equivalent value of the asset that is
+	// not counted towards superfluid staking. The minimum_risk_factor param
+	// applies instead if it is greater or if risk_factor is unset.
+	RiskFactor *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=risk_factor,json=riskFactor,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"risk_factor,omitempty" yaml:"risk_factor"`
+	// price_denom and price_routes value the LP shares of pools without OSMO.
+	// The pool's assets are priced in price_denom, one of the pool's assets, by
+	// the pool's arithmetic TWAPs, and price_denom is priced in OSMO by the
+	// product of the arithmetic TWAPs of the pools of price_routes. TWAPs are
+	// taken over the duration of the superfluid epoch.
+	PriceDenom  string                    `protobuf:"bytes,4,opt,name=price_denom,json=priceDenom,proto3" json:"price_denom,omitempty" yaml:"price_denom"`
+	PriceRoutes []types.SwapAmountInRoute `protobuf:"bytes,5,rep,name=price_routes,json=priceRoutes,proto3" json:"price_routes" yaml:"price_routes"`
 }
 
 func (m *SuperfluidAsset) Reset()         { *m = SuperfluidAsset{} }
@@ -223,10 +238,10 @@ func (m *OsmoEquivalentMultiplierRecord) GetDenom() string {
 // SuperfluidDelegationRecord is a struct used to indicate superfluid
 // delegations of an account in the state machine in a user friendly form.
 type SuperfluidDelegationRecord struct {
-	DelegatorAddress       string      `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
-	ValidatorAddress       string      `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
-	DelegationAmount       types.Coin  `protobuf:"bytes,3,opt,name=delegation_amount,json=delegationAmount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coin" json:"delegation_amount"`
-	EquivalentStakedAmount *types.Coin `protobuf:"bytes,4,opt,name=equivalent_staked_amount,json=equivalentStakedAmount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coin" json:"equivalent_staked_amount,omitempty"`
+	DelegatorAddress       string       `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	ValidatorAddress       string       `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	DelegationAmount       types1.Coin  `protobuf:"bytes,3,opt,name=delegation_amount,json=delegationAmount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coin" json:"delegation_amount"`
+	EquivalentStakedAmount *types1.Coin `protobuf:"bytes,4,opt,name=equivalent_staked_amount,json=equivalentStakedAmount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coin" json:"equivalent_staked_amount,omitempty"`
 }
 
 func (m *SuperfluidDelegationRecord) Reset()         { *m = SuperfluidDelegationRecord{} }
@@ -276,14 +291,14 @@ func (m *SuperfluidDelegationRecord) GetValidatorAddress() string {
 	return ""
 }
 
-func (m *SuperfluidDelegationRecord) GetDelegationAmount() types.Coin {
+func (m *SuperfluidDelegationRecord) GetDelegationAmount() types1.Coin {
 	if m != nil {
 		return m.DelegationAmount
 	}
-	return types.Coin{}
+	return types1.Coin{}
 }
 
-func (m *SuperfluidDelegationRecord) GetEquivalentStakedAmount() *types.Coin {
+func (m *SuperfluidDelegationRecord) GetEquivalentStakedAmount() *types1.Coin {
 	if m != nil {
 		return m.EquivalentStakedAmount
 	}
@@ -389,6 +404,86 @@ func (m *UnpoolWhitelistedPools) GetIds() []uint64 {
 	return nil
 }
 
+// LockRedelegation is the latest superfluid redelegation of a lock, kept until
+// it completes. Until then, the lock cannot be redelegated again, and it is
+// slashed for infractions of the validator it was redelegated from that
+// happened before the redelegation.
+type LockRedelegation struct {
+	LockId         uint64    `protobuf:"varint,1,opt,name=lock_id,json=lockId,proto3" json:"lock_id,omitempty"`
+	SrcValAddr     string    `protobuf:"bytes,2,opt,name=src_val_addr,json=srcValAddr,proto3" json:"src_val_addr,omitempty"`
+	DstValAddr     string    `protobuf:"bytes,3,opt,name=dst_val_addr,json=dstValAddr,proto3" json:"dst_val_addr,omitempty"`
+	CreationHeight int64     `protobuf:"varint,4,opt,name=creation_height,json=creationHeight,proto3" json:"creation_height,omitempty"`
+	CompletionTime time.Time `protobuf:"bytes,5,opt,name=completion_time,json=completionTime,proto3,stdtime" json:"completion_time" yaml:"completion_time"`
+}
+
+func (m *LockRedelegation) Reset()         { *m = LockRedelegation{} }
+func (m *LockRedelegation) String() string { return proto.CompactTextString(m) }
+func (*LockRedelegation) ProtoMessage()    {}
+func (*LockRedelegation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_79d3c29d82dbb734, []int{6}
+}
+func (m *LockRedelegation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LockRedelegation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LockRedelegation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LockRedelegation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LockRedelegation.Merge(m, src)
+}
+func (m *LockRedelegation) XXX_Size() int {
+	return m.Size()
+}
+func (m *LockRedelegation) XXX_DiscardUnknown() {
+	xxx_messageInfo_LockRedelegation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LockRedelegation proto.InternalMessageInfo
+
+func (m *LockRedelegation) GetLockId() uint64 {
+	if m != nil {
+		return m.LockId
+	}
+	return 0
+}
+
+func (m *LockRedelegation) GetSrcValAddr() string {
+	if m != nil {
+		return m.SrcValAddr
+	}
+	return ""
+}
+
+func (m *LockRedelegation) GetDstValAddr() string {
+	if m != nil {
+		return m.DstValAddr
+	}
+	return ""
+}
+
+func (m *LockRedelegation) GetCreationHeight() int64 {
+	if m != nil {
+		return m.CreationHeight
+	}
+	return 0
+}
+
+func (m *LockRedelegation) GetCompletionTime() time.Time {
+	if m != nil {
+		return m.CompletionTime
+	}
+	return time.Time{}
+}
+
 func init() {
 	proto.RegisterEnum("osmosis.superfluid.SuperfluidAssetType", SuperfluidAssetType_name, SuperfluidAssetType_value)
 	proto.RegisterType((*SuperfluidAsset)(nil), "osmosis.superfluid.SuperfluidAsset")
@@ -397,6 +492,7 @@ func init() {
 	proto.RegisterType((*SuperfluidDelegationRecord)(nil), "osmosis.superfluid.SuperfluidDelegationRecord")
 	proto.RegisterType((*LockIdIntermediaryAccountConnection)(nil), "osmosis.superfluid.LockIdIntermediaryAccountConnection")
 	proto.RegisterType((*UnpoolWhitelistedPools)(nil), "osmosis.superfluid.UnpoolWhitelistedPools")
+	proto.RegisterType((*LockRedelegation)(nil), "osmosis.superfluid.LockRedelegation")
 }
 
 func init() {
@@ -404,51 +500,65 @@ func init() {
 }
 
 var fileDescriptor_79d3c29d82dbb734 = []byte{
-	// 696 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0x4f, 0x4f, 0xd4, 0x4e,
-	0x18, 0xde, 0xb2, 0xfb, 0x03, 0x76, 0xf8, 0x45, 0x97, 0x42, 0x70, 0xd9, 0x84, 0x2e, 0x96, 0x44,
-	0x36, 0x10, 0xda, 0x2c, 0x24, 0x1e, 0xb8, 0x2d, 0xa0, 0x09, 0x09, 0x22, 0x29, 0x1a, 0x13, 0x2e,
-	0x9b, 0x69, 0x67, 0xe8, 0x4e, 0x76, 0xda, 0x29, 0x9d, 0x69, 0x75, 0x6f, 0x1e, 0x39, 0xfa, 0x11,
-	0x48, 0xbc, 0xf9, 0x21, 0x3c, 0x73, 0xe4, 0x68, 0x3c, 0xa0, 0x81, 0x8b, 0x67, 0x3e, 0x81, 0xe9,
-	0xb4, 0xdb, 0xad, 0x80, 0x51, 0x4f, 0x9d, 0x79, 0x9f, 0xf7, 0x7d, 0xde, 0xe7, 0xfd, 0xd3, 0x01,
-	0x4b, 0x8c, 0x7b, 0x8c, 0x13, 0x6e, 0xf2, 0x28, 0xc0, 0xe1, 0x31, 0x8d, 0x08, 0x2a, 0x1c, 0x8d,
-	0x20, 0x64, 0x82, 0xa9, 0x6a, 0xe6, 0x64, 0x8c, 0x90, 0xc6, 0xac, 0xcb, 0x5c, 0x26, 0x61, 0x33,
-	0x39, 0xa5, 0x9e, 0x0d, 0xcd, 0x65, 0xcc, 0xa5, 0xd8, 0x94, 0x37, 0x3b, 0x3a, 0x36, 0x51, 0x14,
-	0x42, 0x41, 0x98, 0x9f, 0xe1, 0xcd, 0xdb, 0xb8, 0x20, 0x1e, 0xe6, 0x02, 0x7a, 0xc1, 0x90, 0xc0,
-	0x91, 0xb9, 0x4c, 0x1b, 0x72, 0x6c, 0xc6, 0x6d, 0x1b, 0x0b, 0xd8, 0x36, 0x1d, 0x46, 0x32, 0x02,
-	0x7d, 0x00, 0x1e, 0x1e, 0xe6, 0x22, 0x3a, 0x9c, 0x63, 0xa1, 0xce, 0x82, 0xff, 0x10, 0xf6, 0x99,
-	0x57, 0x57, 0x16, 0x95, 0x56, 0xd5, 0x4a, 0x2f, 0xea, 0x73, 0x00, 0x60, 0x02, 0x77, 0xc5, 0x20,
-	0xc0, 0xf5, 0xb1, 0x45, 0xa5, 0xf5, 0x60, 0x7d, 0xd9, 0xb8, 0x5b, 0x88, 0x71, 0x8b, 0xee, 0xd5,
-	0x20, 0xc0, 0x56, 0x15, 0x0e, 0x8f, 0x9b, 0x93, 0xa7, 0x67, 0xcd, 0xd2, 0x8f, 0xb3, 0xa6, 0xa2,
-	0xf7, 0xc1, 0xc2, 0xc8, 0x77, 0xd7, 0x17, 0x38, 0xf4, 0x30, 0x22, 0x30, 0x1c, 0x74, 0x1c, 0x87,
-	0x45, 0xfe, 0xef, 0x84, 0xcc, 0x83, 0xc9, 0x18, 0xd2, 0x2e, 0x44, 0x28, 0x94, 0x32, 0xaa, 0xd6,
-	0x44, 0x0c, 0x69, 0x07, 0xa1, 0x30, 0x81, 0x5c, 0x18, 0xb9, 0xb8, 0x4b, 0x50, 0xbd, 0xbc, 0xa8,
-	0xb4, 0x2a, 0xd6, 0x84, 0xbc, 0xef, 0x22, 0xfd, 0xb3, 0x02, 0xb4, 0x97, 0xdc, 0x63, 0xcf, 0x4e,
-	0x22, 0x12, 0x43, 0x8a, 0x7d, 0xf1, 0x22, 0xa2, 0x82, 0x04, 0x94, 0xe0, 0xd0, 0xc2, 0x0e, 0x0b,
-	0x91, 0xfa, 0x18, 0xfc, 0x8f, 0x03, 0xe6, 0xf4, 0xba, 0x7e, 0xe4, 0xd9, 0x38, 0x94, 0x59, 0xcb,
-	0xd6, 0x94, 0xb4, 0xed, 0x4b, 0xd3, 0x48, 0xd1, 0x58, 0x51, 0x91, 0x03, 0x80, 0x97, 0x93, 0xc9,
-	0xc4, 0xd5, 0xad, 0xed, 0xf3, 0xcb, 0x66, 0xe9, 0xeb, 0x65, 0xf3, 0x89, 0x4b, 0x44, 0x2f, 0xb2,
-	0x0d, 0x87, 0x79, 0x66, 0x36, 0x8a, 0xf4, 0xb3, 0xc6, 0x51, 0xdf, 0x4c, 0x7a, 0xc9, 0x8d, 0x1d,
-	0xec, 0xdc, 0x5c, 0x36, 0xa7, 0x07, 0xd0, 0xa3, 0x9b, 0xfa, 0x88, 0x49, 0xb7, 0x0a, 0xb4, 0xfa,
-	0xcd, 0x18, 0x68, 0x8c, 0xda, 0xb5, 0x83, 0x29, 0x76, 0xe5, 0x22, 0x64, 0xe2, 0x57, 0xc1, 0x34,
-	0x4a, 0x6d, 0x2c, 0x94, 0xbd, 0xc1, 0x9c, 0x67, 0x7d, 0xab, 0xe5, 0x40, 0x27, 0xb5, 0x27, 0xce,
-	0x31, 0xa4, 0x04, 0xfd, 0xe2, 0x9c, 0x96, 0x54, 0xcb, 0x81, 0xa1, 0xf3, 0xdb, 0x9c, 0x99, 0x30,
-	0xbf, 0x0b, 0xbd, 0x64, 0x34, 0xb2, 0xc8, 0xa9, 0xf5, 0x79, 0x23, 0xad, 0xc5, 0x48, 0xb6, 0xcb,
-	0xc8, 0xb6, 0xcb, 0xd8, 0x66, 0xc4, 0xdf, 0x32, 0x93, 0xfa, 0x3f, 0x7d, 0x6b, 0x2e, 0xff, 0x45,
-	0xfd, 0x49, 0x40, 0xae, 0x92, 0x30, 0xbf, 0x23, 0x73, 0xa8, 0xef, 0x15, 0x50, 0xc7, 0xf9, 0xb8,
-	0xba, 0x5c, 0xc0, 0x3e, 0x46, 0x43, 0x01, 0x95, 0x3f, 0x09, 0x58, 0xfd, 0x97, 0xe4, 0x73, 0xa3,
-	0x3c, 0x87, 0x32, 0x4d, 0x2a, 0x41, 0x3f, 0x01, 0x4b, 0x7b, 0xcc, 0xe9, 0xef, 0xde, 0xb7, 0x9e,
-	0xdb, 0xcc, 0xf7, 0xb1, 0x93, 0xe8, 0x55, 0x1f, 0x81, 0x09, 0xca, 0x9c, 0x7e, 0xb2, 0x76, 0x8a,
-	0x5c, 0xbb, 0x71, 0x2a, 0xa3, 0xd4, 0x36, 0x98, 0x25, 0x85, 0xc8, 0x2e, 0x4c, 0x43, 0xb3, 0x5e,
-	0xcf, 0x90, 0xbb, 0xac, 0xfa, 0x0a, 0x98, 0x7b, 0xed, 0x07, 0x8c, 0xd1, 0x37, 0x3d, 0x22, 0x30,
-	0x25, 0x5c, 0x60, 0x74, 0xc0, 0x18, 0xe5, 0x6a, 0x0d, 0x94, 0x09, 0x4a, 0x86, 0x5a, 0x6e, 0x55,
-	0xac, 0xe4, 0xb8, 0x72, 0x04, 0x66, 0xee, 0xf9, 0xdb, 0xd4, 0x05, 0x30, 0x7f, 0x8f, 0x79, 0x1f,
-	0x0a, 0x12, 0xe3, 0x5a, 0x49, 0xd5, 0x8a, 0x8b, 0x94, 0xc3, 0x7b, 0x07, 0x87, 0x3d, 0x18, 0xe2,
-	0x9a, 0xd2, 0xa8, 0x9c, 0x7e, 0xd4, 0x4a, 0x5b, 0x07, 0xe7, 0x57, 0x9a, 0x72, 0x71, 0xa5, 0x29,
-	0xdf, 0xaf, 0x34, 0xe5, 0xc3, 0xb5, 0x56, 0xba, 0xb8, 0xd6, 0x4a, 0x5f, 0xae, 0xb5, 0xd2, 0xd1,
-	0xd3, 0x42, 0x57, 0xb3, 0xff, 0x7f, 0x8d, 0x42, 0x9b, 0x0f, 0x2f, 0x66, 0xdc, 0xde, 0x30, 0xdf,
-	0x15, 0x1f, 0x40, 0xd9, 0x69, 0x7b, 0x5c, 0xbe, 0x38, 0x1b, 0x3f, 0x03, 0x00, 0x00, 0xff, 0xff,
-	0xf4, 0xa0, 0x01, 0xda, 0x23, 0x05, 0x00, 0x00,
+	// 923 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x55, 0xcf, 0x4f, 0xdc, 0xc6,
+	0x17, 0x5f, 0xb3, 0x10, 0xc2, 0x2c, 0x82, 0x8d, 0x41, 0x7c, 0x97, 0xfd, 0x2a, 0xf6, 0xd6, 0x91,
+	0x0a, 0x0a, 0xc2, 0x16, 0x44, 0x6a, 0xa5, 0xdc, 0x76, 0xa1, 0x51, 0x91, 0xd2, 0x14, 0x99, 0xb4,
+	0x95, 0x72, 0xb1, 0x66, 0x3d, 0x83, 0x77, 0xb4, 0xb6, 0xc7, 0x99, 0x19, 0x2f, 0xe5, 0xd6, 0xde,
+	0x38, 0xe6, 0x4f, 0x88, 0xd4, 0x5b, 0xff, 0x88, 0x9e, 0x39, 0xe6, 0x58, 0xf5, 0xb0, 0xa9, 0xe0,
+	0xd2, 0x33, 0x7f, 0x41, 0x35, 0x33, 0xfe, 0xb1, 0x25, 0x44, 0x6d, 0x4e, 0xeb, 0xf7, 0xfb, 0xf3,
+	0x3e, 0xef, 0xcd, 0x5b, 0xf0, 0x88, 0xf2, 0x84, 0x72, 0xc2, 0x3d, 0x9e, 0x67, 0x98, 0x9d, 0xc6,
+	0x39, 0x41, 0x33, 0x9f, 0x6e, 0xc6, 0xa8, 0xa0, 0xa6, 0x59, 0x38, 0xb9, 0xb5, 0xa5, 0xbb, 0x1e,
+	0xd1, 0x88, 0x2a, 0xb3, 0x27, 0xbf, 0xb4, 0x67, 0xd7, 0x8a, 0x28, 0x8d, 0x62, 0xec, 0x29, 0x69,
+	0x98, 0x9f, 0x7a, 0x28, 0x67, 0x50, 0x10, 0x9a, 0x16, 0x76, 0xfb, 0xb6, 0x5d, 0x90, 0x04, 0x73,
+	0x01, 0x93, 0xac, 0x4c, 0x10, 0xaa, 0x5a, 0xde, 0x10, 0x72, 0xec, 0x4d, 0xf6, 0x86, 0x58, 0xc0,
+	0x3d, 0x2f, 0xa4, 0xa4, 0x4c, 0xb0, 0x53, 0xe1, 0x3d, 0x83, 0x19, 0xa3, 0xb9, 0xc0, 0xac, 0x72,
+	0x93, 0xaa, 0x40, 0xe9, 0xb4, 0xb3, 0x73, 0xd1, 0x04, 0xab, 0x27, 0x15, 0xe4, 0x3e, 0xe7, 0x58,
+	0x98, 0xeb, 0x60, 0x01, 0xe1, 0x94, 0x26, 0x1d, 0xa3, 0x67, 0x6c, 0x2f, 0xf9, 0x5a, 0x30, 0x9f,
+	0x01, 0x00, 0xa5, 0x39, 0x10, 0xe7, 0x19, 0xee, 0xcc, 0xf5, 0x8c, 0xed, 0x95, 0xfd, 0x2d, 0xf7,
+	0xc3, 0xb6, 0xdd, 0x5b, 0xe9, 0x5e, 0x9e, 0x67, 0xd8, 0x5f, 0x82, 0xe5, 0xa7, 0x89, 0x41, 0x8b,
+	0x11, 0x3e, 0x0e, 0x4e, 0x61, 0x28, 0x28, 0xeb, 0x34, 0x65, 0x8d, 0xc1, 0xe1, 0xe5, 0xd4, 0x36,
+	0xfe, 0x98, 0xda, 0x9f, 0x47, 0x44, 0x8c, 0xf2, 0xa1, 0x1b, 0xd2, 0xc4, 0x2b, 0xda, 0xd4, 0x3f,
+	0xbb, 0x1c, 0x8d, 0x3d, 0x59, 0x99, 0xbb, 0x87, 0x38, 0xbc, 0x99, 0xda, 0xe6, 0x39, 0x4c, 0xe2,
+	0xa7, 0xce, 0x4c, 0x2a, 0xc7, 0x07, 0x52, 0x7a, 0xa6, 0x04, 0xf3, 0x4b, 0xd0, 0xca, 0x18, 0x09,
+	0x71, 0xa0, 0x5b, 0x99, 0x57, 0x65, 0x36, 0xea, 0xc0, 0x19, 0xa3, 0xe3, 0x03, 0x25, 0x1d, 0xaa,
+	0x3e, 0x13, 0xb0, 0xac, 0x6d, 0x8a, 0x26, 0xde, 0x59, 0xe8, 0x35, 0xb7, 0x5b, 0xfb, 0xbb, 0x75,
+	0xa7, 0x15, 0xab, 0x6e, 0xc1, 0xaa, 0x7b, 0x72, 0x06, 0xb3, 0x7e, 0x42, 0xf3, 0x54, 0x1c, 0xa5,
+	0xbe, 0x34, 0x0d, 0xfe, 0x7f, 0x39, 0xb5, 0x1b, 0x37, 0x53, 0x7b, 0x6d, 0xb6, 0x98, 0x4e, 0xe8,
+	0xf8, 0x1a, 0x98, 0x72, 0xe4, 0x4f, 0xef, 0x5f, 0xbc, 0xb5, 0x1b, 0x7f, 0xbd, 0xb5, 0x0d, 0x67,
+	0x0c, 0x1e, 0xd6, 0xd4, 0x1d, 0xa5, 0x02, 0xb3, 0x04, 0x23, 0x02, 0xd9, 0x79, 0x3f, 0x0c, 0x65,
+	0xee, 0x8f, 0xcc, 0x65, 0x13, 0xdc, 0x9f, 0xc0, 0x38, 0x80, 0x08, 0x31, 0x35, 0x95, 0x25, 0x7f,
+	0x71, 0x02, 0xe3, 0x3e, 0x42, 0x4c, 0x9a, 0x22, 0x98, 0x47, 0x38, 0x20, 0x48, 0xf1, 0x3c, 0xef,
+	0x2f, 0x2a, 0xf9, 0x08, 0x39, 0xbf, 0x19, 0xc0, 0xfa, 0x96, 0x27, 0xf4, 0xab, 0xd7, 0x39, 0x99,
+	0xc0, 0x18, 0xa7, 0xe2, 0x9b, 0x3c, 0x16, 0x24, 0x8b, 0x09, 0x66, 0x3e, 0x0e, 0x29, 0x43, 0xe6,
+	0x67, 0x60, 0x19, 0x67, 0x34, 0x1c, 0x05, 0x69, 0x9e, 0x0c, 0x31, 0x53, 0x55, 0x9b, 0x7e, 0x4b,
+	0xe9, 0x5e, 0x28, 0x55, 0x8d, 0x68, 0x6e, 0x16, 0x51, 0x08, 0x40, 0x52, 0x25, 0x2b, 0x06, 0x7c,
+	0x20, 0x09, 0xf9, 0xa4, 0x01, 0x3f, 0xd0, 0xd4, 0xd5, 0x99, 0x1c, 0x7f, 0x26, 0xad, 0x73, 0x33,
+	0x07, 0xba, 0x35, 0x5d, 0x87, 0x38, 0xc6, 0x91, 0x7a, 0x45, 0x05, 0xf8, 0x1d, 0xf0, 0x00, 0x69,
+	0x1d, 0x65, 0x8a, 0x1b, 0xcc, 0x79, 0xc1, 0x5b, 0xbb, 0x32, 0xf4, 0xb5, 0x5e, 0x3a, 0x4f, 0x60,
+	0x4c, 0xd0, 0x3f, 0x9c, 0x75, 0x4b, 0xed, 0xca, 0x50, 0x3a, 0x9f, 0x55, 0x99, 0x09, 0x4d, 0x03,
+	0xa8, 0xc6, 0xae, 0x9a, 0x6c, 0xed, 0x6f, 0xba, 0xba, 0x17, 0x57, 0x3e, 0xcd, 0x6a, 0x3b, 0x0e,
+	0x28, 0x49, 0x07, 0x9e, 0xec, 0xff, 0xd7, 0xf7, 0xf6, 0xd6, 0x7f, 0xe8, 0x5f, 0x06, 0x54, 0x28,
+	0x09, 0x4d, 0xf5, 0x6a, 0x99, 0x3f, 0x19, 0xa0, 0x83, 0xab, 0x71, 0x05, 0x5c, 0xc0, 0x31, 0x46,
+	0x25, 0x80, 0xf9, 0x7f, 0x03, 0xb0, 0xf3, 0x29, 0xc5, 0x37, 0xea, 0x3a, 0x27, 0xaa, 0x8c, 0x86,
+	0xe0, 0xbc, 0x06, 0x8f, 0x9e, 0xd3, 0x70, 0x7c, 0x74, 0xd7, 0x7a, 0x1e, 0xd0, 0x34, 0xc5, 0xa1,
+	0xc4, 0x6b, 0xfe, 0x0f, 0x2c, 0xc6, 0x34, 0x1c, 0xcb, 0xb5, 0x33, 0xd4, 0xda, 0xdd, 0x8b, 0x55,
+	0x94, 0xb9, 0x07, 0xd6, 0xc9, 0x4c, 0x64, 0x00, 0x75, 0x68, 0xc1, 0xf5, 0x1a, 0xf9, 0x30, 0xab,
+	0xf3, 0x18, 0x6c, 0x7c, 0x97, 0x66, 0x94, 0xc6, 0x3f, 0x8c, 0x88, 0xc0, 0x31, 0xe1, 0x02, 0xa3,
+	0x63, 0x4a, 0x63, 0x6e, 0xb6, 0x41, 0x93, 0x20, 0x39, 0xd4, 0xe6, 0xf6, 0xbc, 0x2f, 0x3f, 0x9d,
+	0x9f, 0xe7, 0x40, 0x5b, 0xe2, 0xf3, 0x71, 0x4d, 0xde, 0xc7, 0xc1, 0xf4, 0xc0, 0x32, 0x67, 0x61,
+	0x70, 0xeb, 0xf1, 0x00, 0xce, 0xc2, 0xef, 0x8b, 0xf7, 0xd3, 0x03, 0xcb, 0x88, 0x8b, 0xda, 0xa3,
+	0xa9, 0x3d, 0x10, 0x17, 0xa5, 0xc7, 0x16, 0x58, 0x0d, 0x19, 0xd6, 0xab, 0x30, 0xc2, 0x24, 0x1a,
+	0xe9, 0x49, 0x34, 0xfd, 0x95, 0x52, 0xfd, 0xb5, 0xd2, 0x9a, 0x11, 0x58, 0x0d, 0x69, 0x92, 0xc5,
+	0x58, 0xb9, 0xca, 0x93, 0xde, 0x59, 0x50, 0x23, 0xeb, 0xba, 0xfa, 0xde, 0xbb, 0xe5, 0xbd, 0x77,
+	0x5f, 0x96, 0xf7, 0x7e, 0xe0, 0x14, 0x57, 0x64, 0x43, 0x3f, 0x85, 0x5b, 0x09, 0x9c, 0x37, 0xef,
+	0x6d, 0xc3, 0x5f, 0xa9, 0xb5, 0x32, 0xf0, 0xf1, 0x2b, 0xb0, 0x76, 0xc7, 0x01, 0x36, 0x1f, 0x82,
+	0xcd, 0x3b, 0xd4, 0x2f, 0xa0, 0x20, 0x13, 0xdc, 0x6e, 0x98, 0x16, 0xe8, 0xde, 0x61, 0x7e, 0x7e,
+	0x7c, 0x32, 0x82, 0x0c, 0xb7, 0x8d, 0xee, 0xfc, 0xc5, 0x2f, 0x56, 0x63, 0x70, 0x7c, 0x79, 0x65,
+	0x19, 0xef, 0xae, 0x2c, 0xe3, 0xcf, 0x2b, 0xcb, 0x78, 0x73, 0x6d, 0x35, 0xde, 0x5d, 0x5b, 0x8d,
+	0xdf, 0xaf, 0xad, 0xc6, 0xab, 0x2f, 0x66, 0x36, 0xab, 0x38, 0x94, 0xbb, 0x31, 0x1c, 0xf2, 0x52,
+	0xf0, 0x26, 0x7b, 0x4f, 0xbc, 0x1f, 0x67, 0xff, 0x41, 0xd5, 0xb6, 0x0d, 0xef, 0xa9, 0xae, 0x9f,
+	0xfc, 0x3d, 0x00, 0x15, 0x1b, 0xe4, 0xdc, 0x64, 0x07, 0x00, 0x00,
 }
 
 func (this *SuperfluidAsset) Equal(that interface{}) bool {
@@ -476,6 +586,24 @@ func (this *SuperfluidAsset) Equal(that interface{}) bool {
 	if this.AssetType != that1.AssetType {
 		return false
 	}
+	if that1.RiskFactor == nil {
+		if this.RiskFactor != nil {
+			return false
+		}
+	} else if !this.RiskFactor.Equal(*that1.RiskFactor) {
+		return false
+	}
+	if this.PriceDenom != that1.PriceDenom {
+		return false
+	}
+	if len(this.PriceRoutes) != len(that1.PriceRoutes) {
+		return false
+	}
+	for i := range this.PriceRoutes {
+		if !this.PriceRoutes[i].Equal(&that1.PriceRoutes[i]) {
+			return false
+		}
+	}
 	return true
 }
 func (m *SuperfluidAsset) Marshal() (dAtA []byte, err error) {
@@ -498,6 +626,39 @@ func (m *SuperfluidAsset) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.PriceRoutes) > 0 {
+		for iNdEx := len(m.PriceRoutes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PriceRoutes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSuperfluid(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.PriceDenom) > 0 {
+		i -= len(m.PriceDenom)
+		copy(dAtA[i:], m.PriceDenom)
+		i = encodeVarintSuperfluid(dAtA, i, uint64(len(m.PriceDenom)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.RiskFactor != nil {
+		{
+			size := m.RiskFactor.Size()
+			i -= size
+			if _, err := m.RiskFactor.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintSuperfluid(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
 	if m.AssetType != 0 {
 		i = encodeVarintSuperfluid(dAtA, i, uint64(m.AssetType))
 		i--
@@ -735,6 +896,61 @@ func (m *UnpoolWhitelistedPools) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *LockRedelegation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LockRedelegation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LockRedelegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n5, err5 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CompletionTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CompletionTime):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintSuperfluid(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0x2a
+	if m.CreationHeight != 0 {
+		i = encodeVarintSuperfluid(dAtA, i, uint64(m.CreationHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.DstValAddr) > 0 {
+		i -= len(m.DstValAddr)
+		copy(dAtA[i:], m.DstValAddr)
+		i = encodeVarintSuperfluid(dAtA, i, uint64(len(m.DstValAddr)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.SrcValAddr) > 0 {
+		i -= len(m.SrcValAddr)
+		copy(dAtA[i:], m.SrcValAddr)
+		i = encodeVarintSuperfluid(dAtA, i, uint64(len(m.SrcValAddr)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.LockId != 0 {
+		i = encodeVarintSuperfluid(dAtA, i, uint64(m.LockId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintSuperfluid(dAtA []byte, offset int, v uint64) int {
 	offset -= sovSuperfluid(v)
 	base := offset
@@ -759,6 +975,20 @@ func (m *SuperfluidAsset) Size() (n int) {
 	if m.AssetType != 0 {
 		n += 1 + sovSuperfluid(uint64(m.AssetType))
 	}
+	if m.RiskFactor != nil {
+		l = m.RiskFactor.Size()
+		n += 1 + l + sovSuperfluid(uint64(l))
+	}
+	l = len(m.PriceDenom)
+	if l > 0 {
+		n += 1 + l + sovSuperfluid(uint64(l))
+	}
+	if len(m.PriceRoutes) > 0 {
+		for _, e := range m.PriceRoutes {
+			l = e.Size()
+			n += 1 + l + sovSuperfluid(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -855,6 +1085,31 @@ func (m *UnpoolWhitelistedPools) Size() (n int) {
 	return n
 }
 
+func (m *LockRedelegation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LockId != 0 {
+		n += 1 + sovSuperfluid(uint64(m.LockId))
+	}
+	l = len(m.SrcValAddr)
+	if l > 0 {
+		n += 1 + l + sovSuperfluid(uint64(l))
+	}
+	l = len(m.DstValAddr)
+	if l > 0 {
+		n += 1 + l + sovSuperfluid(uint64(l))
+	}
+	if m.CreationHeight != 0 {
+		n += 1 + sovSuperfluid(uint64(m.CreationHeight))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.CompletionTime)
+	n += 1 + l + sovSuperfluid(uint64(l))
+	return n
+}
+
 func sovSuperfluid(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -941,6 +1196,108 @@ func (m *SuperfluidAsset) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RiskFactor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSuperfluid
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSuperfluid
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSuperfluid
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.RiskFactor = &v
+			if err := m.RiskFactor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSuperfluid
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSuperfluid
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSuperfluid
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PriceDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceRoutes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSuperfluid
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSuperfluid
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSuperfluid
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PriceRoutes = append(m.PriceRoutes, types.SwapAmountInRoute{})
+			if err := m.PriceRoutes[len(m.PriceRoutes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSuperfluid(dAtA[iNdEx:])
@@ -1386,7 +1743,7 @@ func (m *SuperfluidDelegationRecord) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.EquivalentStakedAmount == nil {
-				m.EquivalentStakedAmount = &types.Coin{}
+				m.EquivalentStakedAmount = &types1.Coin{}
 			}
 			if err := m.EquivalentStakedAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -1640,6 +1997,191 @@ func (m *UnpoolWhitelistedPools) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *LockRedelegation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSuperfluid
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LockRedelegation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LockRedelegation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockId", wireType)
+			}
+			m.LockId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSuperfluid
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LockId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SrcValAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSuperfluid
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSuperfluid
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSuperfluid
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SrcValAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DstValAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSuperfluid
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSuperfluid
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSuperfluid
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DstValAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreationHeight", wireType)
+			}
+			m.CreationHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSuperfluid
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreationHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletionTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSuperfluid
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSuperfluid
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSuperfluid
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.CompletionTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSuperfluid(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSuperfluid
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipSuperfluid(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -303,6 +303,210 @@ func (m *MsgSuperfluidUnbondLockResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgSuperfluidUnbondLockResponse proto.InternalMessageInfo
 
+// MsgSuperfluidRedelegate moves the superfluid delegation of a lock to a new
+// validator, without unbonding the lock.
+type MsgSuperfluidRedelegate struct {
+	Sender     string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
+	LockId     uint64 `protobuf:"varint,2,opt,name=lock_id,json=lockId,proto3" json:"lock_id,omitempty"`
+	NewValAddr string `protobuf:"bytes,3,opt,name=new_val_addr,json=newValAddr,proto3" json:"new_val_addr,omitempty"`
+}
+
+func (m *MsgSuperfluidRedelegate) Reset()         { *m = MsgSuperfluidRedelegate{} }
+func (m *MsgSuperfluidRedelegate) String() string { return proto.CompactTextString(m) }
+func (*MsgSuperfluidRedelegate) ProtoMessage()    {}
+func (*MsgSuperfluidRedelegate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_55b645f187d22814, []int{6}
+}
+func (m *MsgSuperfluidRedelegate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSuperfluidRedelegate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSuperfluidRedelegate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSuperfluidRedelegate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSuperfluidRedelegate.Merge(m, src)
+}
+func (m *MsgSuperfluidRedelegate) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSuperfluidRedelegate) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSuperfluidRedelegate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSuperfluidRedelegate proto.InternalMessageInfo
+
+func (m *MsgSuperfluidRedelegate) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgSuperfluidRedelegate) GetLockId() uint64 {
+	if m != nil {
+		return m.LockId
+	}
+	return 0
+}
+
+func (m *MsgSuperfluidRedelegate) GetNewValAddr() string {
+	if m != nil {
+		return m.NewValAddr
+	}
+	return ""
+}
+
+type MsgSuperfluidRedelegateResponse struct {
+}
+
+func (m *MsgSuperfluidRedelegateResponse) Reset()         { *m = MsgSuperfluidRedelegateResponse{} }
+func (m *MsgSuperfluidRedelegateResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSuperfluidRedelegateResponse) ProtoMessage()    {}
+func (*MsgSuperfluidRedelegateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_55b645f187d22814, []int{7}
+}
+func (m *MsgSuperfluidRedelegateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSuperfluidRedelegateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSuperfluidRedelegateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSuperfluidRedelegateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSuperfluidRedelegateResponse.Merge(m, src)
+}
+func (m *MsgSuperfluidRedelegateResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSuperfluidRedelegateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSuperfluidRedelegateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSuperfluidRedelegateResponse proto.InternalMessageInfo
+
+// MsgSuperfluidDelegateToValidatorSet splits a lock into one lock per
+// validator of the sender's validator set preferences, by their weights, and
+// superfluid delegates each of them to its validator. The locks are
+// rebalanced whenever the sender's preferences change.
+type MsgSuperfluidDelegateToValidatorSet struct {
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
+	LockId uint64 `protobuf:"varint,2,opt,name=lock_id,json=lockId,proto3" json:"lock_id,omitempty"`
+}
+
+func (m *MsgSuperfluidDelegateToValidatorSet) Reset()         { *m = MsgSuperfluidDelegateToValidatorSet{} }
+func (m *MsgSuperfluidDelegateToValidatorSet) String() string { return proto.CompactTextString(m) }
+func (*MsgSuperfluidDelegateToValidatorSet) ProtoMessage()    {}
+func (*MsgSuperfluidDelegateToValidatorSet) Descriptor() ([]byte, []int) {
+	return fileDescriptor_55b645f187d22814, []int{8}
+}
+func (m *MsgSuperfluidDelegateToValidatorSet) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSuperfluidDelegateToValidatorSet) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSuperfluidDelegateToValidatorSet.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSuperfluidDelegateToValidatorSet) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSuperfluidDelegateToValidatorSet.Merge(m, src)
+}
+func (m *MsgSuperfluidDelegateToValidatorSet) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSuperfluidDelegateToValidatorSet) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSuperfluidDelegateToValidatorSet.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSuperfluidDelegateToValidatorSet proto.InternalMessageInfo
+
+func (m *MsgSuperfluidDelegateToValidatorSet) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgSuperfluidDelegateToValidatorSet) GetLockId() uint64 {
+	if m != nil {
+		return m.LockId
+	}
+	return 0
+}
+
+// MsgSuperfluidDelegateToValidatorSetResponse returns the IDs of the locks
+// superfluid delegated, the first one being the lock given.
+type MsgSuperfluidDelegateToValidatorSetResponse struct {
+	LockIds []uint64 `protobuf:"varint,1,rep,packed,name=lock_ids,json=lockIds,proto3" json:"lock_ids,omitempty"`
+}
+
+func (m *MsgSuperfluidDelegateToValidatorSetResponse) Reset() {
+	*m = MsgSuperfluidDelegateToValidatorSetResponse{}
+}
+func (m *MsgSuperfluidDelegateToValidatorSetResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*MsgSuperfluidDelegateToValidatorSetResponse) ProtoMessage() {}
+func (*MsgSuperfluidDelegateToValidatorSetResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_55b645f187d22814, []int{9}
+}
+func (m *MsgSuperfluidDelegateToValidatorSetResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSuperfluidDelegateToValidatorSetResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSuperfluidDelegateToValidatorSetResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSuperfluidDelegateToValidatorSetResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSuperfluidDelegateToValidatorSetResponse.Merge(m, src)
+}
+func (m *MsgSuperfluidDelegateToValidatorSetResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSuperfluidDelegateToValidatorSetResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSuperfluidDelegateToValidatorSetResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSuperfluidDelegateToValidatorSetResponse proto.InternalMessageInfo
+
+func (m *MsgSuperfluidDelegateToValidatorSetResponse) GetLockIds() []uint64 {
+	if m != nil {
+		return m.LockIds
+	}
+	return nil
+}
+
 // MsgLockAndSuperfluidDelegate locks coins with the unbonding period duration,
 // and then does a superfluid lock from the newly created lockup, to the
 // specified validator addr.
@@ -316,7 +520,7 @@ func (m *MsgLockAndSuperfluidDelegate) Reset()         { *m = MsgLockAndSuperflu
 func (m *MsgLockAndSuperfluidDelegate) String() string { return proto.CompactTextString(m) }
 func (*MsgLockAndSuperfluidDelegate) ProtoMessage()    {}
 func (*MsgLockAndSuperfluidDelegate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_55b645f187d22814, []int{6}
+	return fileDescriptor_55b645f187d22814, []int{10}
 }
 func (m *MsgLockAndSuperfluidDelegate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -374,7 +578,7 @@ func (m *MsgLockAndSuperfluidDelegateResponse) Reset()         { *m = MsgLockAnd
 func (m *MsgLockAndSuperfluidDelegateResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgLockAndSuperfluidDelegateResponse) ProtoMessage()    {}
 func (*MsgLockAndSuperfluidDelegateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_55b645f187d22814, []int{7}
+	return fileDescriptor_55b645f187d22814, []int{11}
 }
 func (m *MsgLockAndSuperfluidDelegateResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -427,7 +631,7 @@ func (m *MsgUnPoolWhitelistedPool) Reset()         { *m = MsgUnPoolWhitelistedPo
 func (m *MsgUnPoolWhitelistedPool) String() string { return proto.CompactTextString(m) }
 func (*MsgUnPoolWhitelistedPool) ProtoMessage()    {}
 func (*MsgUnPoolWhitelistedPool) Descriptor() ([]byte, []int) {
-	return fileDescriptor_55b645f187d22814, []int{8}
+	return fileDescriptor_55b645f187d22814, []int{12}
 }
 func (m *MsgUnPoolWhitelistedPool) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -478,7 +682,7 @@ func (m *MsgUnPoolWhitelistedPoolResponse) Reset()         { *m = MsgUnPoolWhite
 func (m *MsgUnPoolWhitelistedPoolResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgUnPoolWhitelistedPoolResponse) ProtoMessage()    {}
 func (*MsgUnPoolWhitelistedPoolResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_55b645f187d22814, []int{9}
+	return fileDescriptor_55b645f187d22814, []int{13}
 }
 func (m *MsgUnPoolWhitelistedPoolResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -521,6 +725,10 @@ func init() {
 	proto.RegisterType((*MsgSuperfluidUndelegateResponse)(nil), "osmosis.superfluid.MsgSuperfluidUndelegateResponse")
 	proto.RegisterType((*MsgSuperfluidUnbondLock)(nil), "osmosis.superfluid.MsgSuperfluidUnbondLock")
 	proto.RegisterType((*MsgSuperfluidUnbondLockResponse)(nil), "osmosis.superfluid.MsgSuperfluidUnbondLockResponse")
+	proto.RegisterType((*MsgSuperfluidRedelegate)(nil), "osmosis.superfluid.MsgSuperfluidRedelegate")
+	proto.RegisterType((*MsgSuperfluidRedelegateResponse)(nil), "osmosis.superfluid.MsgSuperfluidRedelegateResponse")
+	proto.RegisterType((*MsgSuperfluidDelegateToValidatorSet)(nil), "osmosis.superfluid.MsgSuperfluidDelegateToValidatorSet")
+	proto.RegisterType((*MsgSuperfluidDelegateToValidatorSetResponse)(nil), "osmosis.superfluid.MsgSuperfluidDelegateToValidatorSetResponse")
 	proto.RegisterType((*MsgLockAndSuperfluidDelegate)(nil), "osmosis.superfluid.MsgLockAndSuperfluidDelegate")
 	proto.RegisterType((*MsgLockAndSuperfluidDelegateResponse)(nil), "osmosis.superfluid.MsgLockAndSuperfluidDelegateResponse")
 	proto.RegisterType((*MsgUnPoolWhitelistedPool)(nil), "osmosis.superfluid.MsgUnPoolWhitelistedPool")
@@ -530,45 +738,52 @@ func init() {
 func init() { proto.RegisterFile("osmosis/superfluid/tx.proto", fileDescriptor_55b645f187d22814) }
 
 var fileDescriptor_55b645f187d22814 = []byte{
-	// 608 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x55, 0xcd, 0x6e, 0xd3, 0x40,
-	0x10, 0x8e, 0x93, 0x90, 0xc0, 0xa2, 0x16, 0x61, 0xb5, 0xaa, 0x63, 0xc0, 0x0e, 0x06, 0xa1, 0xa0,
-	0x52, 0x6f, 0xd3, 0xa0, 0x0a, 0x71, 0x6b, 0xc8, 0x25, 0xa8, 0x91, 0x2a, 0xa3, 0x0a, 0x09, 0x09,
-	0x45, 0x76, 0x76, 0xeb, 0x5a, 0xd9, 0x78, 0x23, 0xaf, 0x63, 0xa5, 0xe2, 0x01, 0xb8, 0xf2, 0x1c,
-	0xbc, 0x08, 0x3d, 0xf6, 0xc8, 0x29, 0xa0, 0xe4, 0x0d, 0x2a, 0x1e, 0x00, 0xf9, 0x37, 0x14, 0xec,
-	0x90, 0x08, 0x7a, 0xf2, 0xce, 0xcc, 0xb7, 0xdf, 0x7c, 0xa3, 0x99, 0xf1, 0x82, 0x7b, 0x94, 0x0d,
-	0x28, 0xb3, 0x18, 0x64, 0xa3, 0x21, 0x76, 0x4e, 0xc8, 0xc8, 0x42, 0xd0, 0x1d, 0xab, 0x43, 0x87,
-	0xba, 0x94, 0xe7, 0xa3, 0xa0, 0x3a, 0x0f, 0x8a, 0x1b, 0x26, 0x35, 0x69, 0x10, 0x86, 0xfe, 0x29,
-	0x44, 0x8a, 0x92, 0x49, 0xa9, 0x49, 0x30, 0x0c, 0x2c, 0x63, 0x74, 0x02, 0xd1, 0xc8, 0xd1, 0x5d,
-	0x8b, 0xda, 0x71, 0xbc, 0x17, 0x50, 0x41, 0x43, 0x67, 0x18, 0x7a, 0x75, 0x03, 0xbb, 0x7a, 0x1d,
-	0xf6, 0xa8, 0x15, 0xc7, 0x1f, 0xa5, 0xc8, 0x98, 0x1f, 0x43, 0x90, 0xe2, 0x81, 0xcd, 0x0e, 0x33,
-	0xdf, 0x24, 0xee, 0x16, 0x26, 0xd8, 0xd4, 0x5d, 0xcc, 0x3f, 0x05, 0x25, 0x86, 0x6d, 0x84, 0x1d,
-	0x81, 0xab, 0x72, 0xb5, 0x5b, 0xcd, 0xbb, 0x97, 0x13, 0x79, 0xed, 0x4c, 0x1f, 0x90, 0x97, 0x4a,
-	0xe8, 0x57, 0xb4, 0x08, 0xc0, 0x6f, 0x81, 0x32, 0xa1, 0xbd, 0x7e, 0xd7, 0x42, 0x42, 0xbe, 0xca,
-	0xd5, 0x8a, 0x5a, 0xc9, 0x37, 0xdb, 0x88, 0xaf, 0x80, 0x9b, 0x9e, 0x4e, 0xba, 0x3a, 0x42, 0x8e,
-	0x50, 0xf0, 0x59, 0xb4, 0xb2, 0xa7, 0x93, 0x03, 0x84, 0x1c, 0x45, 0x06, 0x0f, 0x52, 0xf3, 0x6a,
-	0x98, 0x0d, 0xa9, 0xcd, 0xb0, 0xf2, 0x1e, 0x6c, 0x5d, 0x01, 0x1c, 0xdb, 0xe8, 0x3f, 0x4a, 0x53,
-	0x1e, 0x02, 0x39, 0x83, 0x7e, 0x81, 0x02, 0x83, 0xda, 0xe8, 0x90, 0xf6, 0xfa, 0xd7, 0xa4, 0x20,
-	0xa6, 0x4f, 0x14, 0x7c, 0xe1, 0xc0, 0xfd, 0x0e, 0x33, 0x7d, 0xdf, 0x81, 0x8d, 0xfe, 0xad, 0x49,
-	0x3a, 0xb8, 0xe1, 0xcf, 0x06, 0x13, 0xf2, 0xd5, 0x42, 0xed, 0xf6, 0x5e, 0x45, 0x0d, 0xa7, 0x47,
-	0xf5, 0xa7, 0x47, 0x8d, 0xa6, 0x47, 0x7d, 0x45, 0x2d, 0xbb, 0xb9, 0x7b, 0x3e, 0x91, 0x73, 0x9f,
-	0xbf, 0xc9, 0x35, 0xd3, 0x72, 0x4f, 0x47, 0x86, 0xda, 0xa3, 0x03, 0x18, 0x8d, 0x5a, 0xf8, 0xd9,
-	0x61, 0xa8, 0x0f, 0xdd, 0xb3, 0x21, 0x66, 0xc1, 0x05, 0xa6, 0x85, 0xcc, 0x8b, 0xda, 0xbd, 0x0f,
-	0x1e, 0x2f, 0x2a, 0x24, 0xae, 0x98, 0x5f, 0x07, 0xf9, 0x76, 0x2b, 0x28, 0xa6, 0xa8, 0xe5, 0xdb,
-	0x2d, 0xc5, 0x01, 0x42, 0x87, 0x99, 0xc7, 0xf6, 0x11, 0xa5, 0xe4, 0xed, 0xa9, 0xe5, 0x62, 0x62,
-	0x31, 0x17, 0x23, 0xdf, 0x5c, 0xa5, 0xf8, 0x6d, 0x50, 0x1e, 0x52, 0x4a, 0x92, 0x26, 0x34, 0xf9,
-	0xcb, 0x89, 0xbc, 0x1e, 0x62, 0xa3, 0x80, 0xa2, 0x95, 0xfc, 0x53, 0x1b, 0x29, 0xaf, 0x41, 0x35,
-	0x2b, 0x67, 0xa2, 0xf3, 0x09, 0xb8, 0x83, 0xc7, 0x96, 0x8b, 0x51, 0x37, 0x6a, 0x2e, 0x13, 0xb8,
-	0x6a, 0xa1, 0x56, 0xd4, 0xd6, 0x42, 0xf7, 0x61, 0xd0, 0x63, 0xb6, 0xf7, 0xa3, 0x08, 0x0a, 0x1d,
-	0x66, 0xf2, 0x0e, 0xe0, 0xd3, 0xda, 0xa7, 0xfe, 0xf9, 0x33, 0x50, 0x53, 0xd7, 0x42, 0xac, 0x2f,
-	0x0d, 0x4d, 0x34, 0x8e, 0xc1, 0x46, 0xea, 0xfa, 0x6c, 0xff, 0x95, 0x6a, 0x0e, 0x16, 0x1b, 0x2b,
-	0x80, 0xb3, 0x32, 0x27, 0x6b, 0xb3, 0x4c, 0xe6, 0x18, 0xbc, 0x54, 0xe6, 0xdf, 0x37, 0x86, 0xff,
-	0xc8, 0x81, 0x4a, 0xf6, 0xba, 0xec, 0x66, 0x50, 0x66, 0xde, 0x10, 0x5f, 0xac, 0x7a, 0x23, 0x51,
-	0xf2, 0x01, 0x6c, 0xa6, 0x8f, 0xed, 0xb3, 0x0c, 0xca, 0x54, 0xb4, 0xf8, 0x7c, 0x15, 0x74, 0x9c,
-	0xbc, 0x79, 0x74, 0x3e, 0x95, 0xb8, 0x8b, 0xa9, 0xc4, 0x7d, 0x9f, 0x4a, 0xdc, 0xa7, 0x99, 0x94,
-	0xbb, 0x98, 0x49, 0xb9, 0xaf, 0x33, 0x29, 0xf7, 0x6e, 0xff, 0x97, 0xa5, 0x8e, 0x98, 0x77, 0x88,
-	0x6e, 0xb0, 0xd8, 0x80, 0x5e, 0xbd, 0x01, 0xc7, 0x57, 0x5e, 0x2e, 0x7f, 0xd1, 0x8d, 0x52, 0xf0,
-	0x5c, 0x34, 0x7e, 0x06, 0x00, 0x00, 0xff, 0xff, 0x64, 0xb3, 0x1a, 0x05, 0xdc, 0x06, 0x00, 0x00,
+	// 707 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0xcf, 0x6e, 0xd3, 0x4e,
+	0x10, 0x8e, 0x93, 0xfe, 0x92, 0x1f, 0x03, 0x2d, 0xc2, 0x6a, 0xd5, 0xd4, 0x80, 0x63, 0x5c, 0x84,
+	0x82, 0x4a, 0xed, 0xa6, 0x41, 0x05, 0x71, 0x41, 0x0d, 0x3d, 0x10, 0xd4, 0x48, 0x95, 0x4b, 0x8b,
+	0x84, 0x84, 0x22, 0x3b, 0xbb, 0x75, 0xad, 0xba, 0xde, 0xc8, 0xbb, 0x49, 0x53, 0x71, 0xe0, 0xc8,
+	0x09, 0x89, 0x07, 0xe0, 0x09, 0x78, 0x11, 0x7a, 0xec, 0x91, 0x53, 0x41, 0xed, 0x1b, 0xf4, 0x09,
+	0x90, 0xe3, 0x3f, 0xa1, 0xa9, 0xdd, 0xc6, 0x50, 0x4e, 0xde, 0xdd, 0xf9, 0xe6, 0x9b, 0x6f, 0x67,
+	0x76, 0x46, 0x86, 0xdb, 0x84, 0xee, 0x12, 0x6a, 0x51, 0x95, 0x76, 0xda, 0xd8, 0xdd, 0xb2, 0x3b,
+	0x16, 0x52, 0x59, 0x4f, 0x69, 0xbb, 0x84, 0x11, 0x9e, 0x0f, 0x8c, 0xca, 0xc0, 0x28, 0x4c, 0x9a,
+	0xc4, 0x24, 0x7d, 0xb3, 0xea, 0xad, 0x7c, 0xa4, 0x20, 0x9a, 0x84, 0x98, 0x36, 0x56, 0xfb, 0x3b,
+	0xa3, 0xb3, 0xa5, 0xa2, 0x8e, 0xab, 0x33, 0x8b, 0x38, 0xa1, 0xbd, 0xd5, 0xa7, 0x52, 0x0d, 0x9d,
+	0x62, 0xb5, 0x5b, 0x31, 0x30, 0xd3, 0x2b, 0x6a, 0x8b, 0x58, 0xa1, 0x7d, 0x36, 0x46, 0xc6, 0x60,
+	0xe9, 0x83, 0xe4, 0x2e, 0x4c, 0x35, 0xa8, 0xb9, 0x1e, 0x1d, 0xaf, 0x60, 0x1b, 0x9b, 0x3a, 0xc3,
+	0xfc, 0x43, 0xc8, 0x53, 0xec, 0x20, 0xec, 0x16, 0x39, 0x89, 0x2b, 0x5f, 0xab, 0xdd, 0x3a, 0x3d,
+	0x2a, 0x8d, 0xef, 0xeb, 0xbb, 0xf6, 0x33, 0xd9, 0x3f, 0x97, 0xb5, 0x00, 0xc0, 0x4f, 0x43, 0xc1,
+	0x26, 0xad, 0x9d, 0xa6, 0x85, 0x8a, 0x59, 0x89, 0x2b, 0x8f, 0x69, 0x79, 0x6f, 0x5b, 0x47, 0xfc,
+	0x0c, 0xfc, 0xdf, 0xd5, 0xed, 0xa6, 0x8e, 0x90, 0x5b, 0xcc, 0x79, 0x2c, 0x5a, 0xa1, 0xab, 0xdb,
+	0xcb, 0x08, 0xb9, 0x72, 0x09, 0xee, 0xc6, 0xc6, 0xd5, 0x30, 0x6d, 0x13, 0x87, 0x62, 0xf9, 0x1d,
+	0x4c, 0x9f, 0x01, 0x6c, 0x38, 0xe8, 0x0a, 0xa5, 0xc9, 0xf7, 0xa0, 0x94, 0x40, 0x7f, 0x81, 0x02,
+	0x83, 0x38, 0x68, 0x95, 0xb4, 0x76, 0xfe, 0x91, 0x82, 0x90, 0x3e, 0x52, 0xf0, 0x61, 0x48, 0x81,
+	0x86, 0xaf, 0x32, 0x07, 0xbc, 0x04, 0x37, 0x1c, 0xbc, 0xd7, 0x1c, 0x2a, 0x11, 0x38, 0x78, 0x6f,
+	0x33, 0xa8, 0xd2, 0xb0, 0xc6, 0x81, 0x80, 0x48, 0xa3, 0x05, 0xb3, 0xb1, 0x85, 0x7c, 0x4d, 0x36,
+	0x75, 0xdb, 0x42, 0x3a, 0x23, 0xee, 0x3a, 0x66, 0x57, 0x92, 0xb1, 0x97, 0x30, 0x37, 0x42, 0xa8,
+	0x50, 0x99, 0xf7, 0xfa, 0x02, 0x1e, 0x5a, 0xe4, 0xa4, 0x5c, 0x79, 0x4c, 0x2b, 0xf8, 0x44, 0x54,
+	0xfe, 0xc6, 0xc1, 0x9d, 0x06, 0x35, 0xbd, 0x64, 0x2f, 0x3b, 0xe8, 0xef, 0x5e, 0xbf, 0x0e, 0xff,
+	0x79, 0x4d, 0x47, 0x8b, 0x59, 0x29, 0x57, 0xbe, 0xbe, 0x38, 0xa3, 0xf8, 0x6d, 0xa9, 0x78, 0x6d,
+	0xa9, 0x04, 0x6d, 0xa9, 0xbc, 0x20, 0x96, 0x53, 0x5b, 0x38, 0x38, 0x2a, 0x65, 0xbe, 0xfe, 0x28,
+	0x95, 0x4d, 0x8b, 0x6d, 0x77, 0x0c, 0xa5, 0x45, 0x76, 0xd5, 0xa0, 0x87, 0xfd, 0xcf, 0x3c, 0x45,
+	0x3b, 0x2a, 0xdb, 0x6f, 0x63, 0xda, 0x77, 0xa0, 0x9a, 0xcf, 0x7c, 0x51, 0x1f, 0x2d, 0xc1, 0xfd,
+	0x8b, 0x2e, 0x12, 0x25, 0x63, 0x02, 0xb2, 0xf5, 0x95, 0xfe, 0x65, 0xc6, 0xb4, 0x6c, 0x7d, 0x45,
+	0x76, 0xa1, 0xd8, 0xa0, 0xe6, 0x86, 0xb3, 0x46, 0x88, 0xfd, 0x66, 0xdb, 0x62, 0xd8, 0xb6, 0x28,
+	0xc3, 0xc8, 0xdb, 0xa6, 0xb9, 0xfc, 0x1c, 0x14, 0xda, 0x84, 0xd8, 0x51, 0xad, 0x6a, 0xfc, 0xe9,
+	0x51, 0x69, 0xc2, 0xc7, 0x06, 0x06, 0x59, 0xcb, 0x7b, 0xab, 0x3a, 0x92, 0x5f, 0x81, 0x94, 0x14,
+	0x33, 0xd2, 0xf9, 0x00, 0x6e, 0xe2, 0x9e, 0xc5, 0x30, 0x6a, 0x0e, 0xd5, 0x6e, 0xdc, 0x3f, 0x5e,
+	0xf5, 0x2b, 0xb8, 0xf8, 0xa9, 0x00, 0xb9, 0x06, 0x35, 0x79, 0x17, 0xf8, 0xb8, 0xf2, 0x29, 0xe7,
+	0xa7, 0xac, 0x12, 0xfb, 0x76, 0x84, 0xca, 0xc8, 0xd0, 0x48, 0x63, 0x0f, 0x26, 0x63, 0xe7, 0xd2,
+	0xdc, 0xa5, 0x54, 0x03, 0xb0, 0x50, 0x4d, 0x01, 0x8e, 0x8f, 0xac, 0xe1, 0x14, 0x91, 0x35, 0x9c,
+	0x22, 0xf2, 0xf9, 0x36, 0xe7, 0xbf, 0x70, 0x20, 0x5d, 0xda, 0xe4, 0x4f, 0x46, 0xce, 0xe5, 0x59,
+	0x47, 0xe1, 0xf9, 0x1f, 0x3a, 0x26, 0x95, 0x24, 0x1a, 0xd4, 0xa3, 0x94, 0x24, 0x04, 0x0b, 0xd5,
+	0x14, 0xe0, 0x28, 0xf2, 0x47, 0x0e, 0x66, 0x92, 0xe7, 0xc8, 0x42, 0x02, 0x65, 0xa2, 0x87, 0xf0,
+	0x34, 0xad, 0x47, 0xa4, 0xe4, 0x3d, 0x4c, 0xc5, 0xf7, 0xf3, 0xa3, 0x04, 0xca, 0x58, 0xb4, 0xf0,
+	0x38, 0x0d, 0x3a, 0x0c, 0x5e, 0x5b, 0x3b, 0x38, 0x16, 0xb9, 0xc3, 0x63, 0x91, 0xfb, 0x79, 0x2c,
+	0x72, 0x9f, 0x4f, 0xc4, 0xcc, 0xe1, 0x89, 0x98, 0xf9, 0x7e, 0x22, 0x66, 0xde, 0x2e, 0xfd, 0x36,
+	0xed, 0x02, 0xe6, 0x79, 0x5b, 0x37, 0x68, 0xb8, 0x51, 0xbb, 0x95, 0xaa, 0xda, 0x3b, 0xf3, 0xaf,
+	0xe4, 0x4d, 0x40, 0x23, 0xdf, 0xff, 0x41, 0xa9, 0xfe, 0x1a, 0x00, 0x67, 0xa7, 0xfb, 0x39, 0x4e,
+	0x09, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -587,6 +802,11 @@ type MsgClient interface {
 	SuperfluidDelegate(ctx context.Context, in *MsgSuperfluidDelegate, opts ...grpc.CallOption) (*MsgSuperfluidDelegateResponse, error)
 	// Execute superfluid undelegation for a lockup
 	SuperfluidUndelegate(ctx context.Context, in *MsgSuperfluidUndelegate, opts ...grpc.CallOption) (*MsgSuperfluidUndelegateResponse, error)
+	// Execute superfluid redelegation for a lockup
+	SuperfluidRedelegate(ctx context.Context, in *MsgSuperfluidRedelegate, opts ...grpc.CallOption) (*MsgSuperfluidRedelegateResponse, error)
+	// Execute superfluid delegation for a lockup, split across the sender's
+	// validator set preferences
+	SuperfluidDelegateToValidatorSet(ctx context.Context, in *MsgSuperfluidDelegateToValidatorSet, opts ...grpc.CallOption) (*MsgSuperfluidDelegateToValidatorSetResponse, error)
 	// For a given lock that is being superfluidly undelegated,
 	// also unbond the underlying lock.
 	SuperfluidUnbondLock(ctx context.Context, in *MsgSuperfluidUnbondLock, opts ...grpc.CallOption) (*MsgSuperfluidUnbondLockResponse, error)
@@ -621,6 +841,24 @@ func (c *msgClient) SuperfluidUndelegate(ctx context.Context, in *MsgSuperfluidU
 	return out, nil
 }
 
+func (c *msgClient) SuperfluidRedelegate(ctx context.Context, in *MsgSuperfluidRedelegate, opts ...grpc.CallOption) (*MsgSuperfluidRedelegateResponse, error) {
+	out := new(MsgSuperfluidRedelegateResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.superfluid.Msg/SuperfluidRedelegate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SuperfluidDelegateToValidatorSet(ctx context.Context, in *MsgSuperfluidDelegateToValidatorSet, opts ...grpc.CallOption) (*MsgSuperfluidDelegateToValidatorSetResponse, error) {
+	out := new(MsgSuperfluidDelegateToValidatorSetResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.superfluid.Msg/SuperfluidDelegateToValidatorSet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) SuperfluidUnbondLock(ctx context.Context, in *MsgSuperfluidUnbondLock, opts ...grpc.CallOption) (*MsgSuperfluidUnbondLockResponse, error) {
 	out := new(MsgSuperfluidUnbondLockResponse)
 	err := c.cc.Invoke(ctx, "/osmosis.superfluid.Msg/SuperfluidUnbondLock", in, out, opts...)
@@ -654,6 +892,11 @@ type MsgServer interface {
 	SuperfluidDelegate(context.Context, *MsgSuperfluidDelegate) (*MsgSuperfluidDelegateResponse, error)
 	// Execute superfluid undelegation for a lockup
 	SuperfluidUndelegate(context.Context, *MsgSuperfluidUndelegate) (*MsgSuperfluidUndelegateResponse, error)
+	// Execute superfluid redelegation for a lockup
+	SuperfluidRedelegate(context.Context, *MsgSuperfluidRedelegate) (*MsgSuperfluidRedelegateResponse, error)
+	// Execute superfluid delegation for a lockup, split across the sender's
+	// validator set preferences
+	SuperfluidDelegateToValidatorSet(context.Context, *MsgSuperfluidDelegateToValidatorSet) (*MsgSuperfluidDelegateToValidatorSetResponse, error)
 	// For a given lock that is being superfluidly undelegated,
 	// also unbond the underlying lock.
 	SuperfluidUnbondLock(context.Context, *MsgSuperfluidUnbondLock) (*MsgSuperfluidUnbondLockResponse, error)
@@ -672,6 +915,12 @@ func (*UnimplementedMsgServer) SuperfluidDelegate(ctx context.Context, req *MsgS
 func (*UnimplementedMsgServer) SuperfluidUndelegate(ctx context.Context, req *MsgSuperfluidUndelegate) (*MsgSuperfluidUndelegateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SuperfluidUndelegate not implemented")
 }
+func (*UnimplementedMsgServer) SuperfluidRedelegate(ctx context.Context, req *MsgSuperfluidRedelegate) (*MsgSuperfluidRedelegateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SuperfluidRedelegate not implemented")
+}
+func (*UnimplementedMsgServer) SuperfluidDelegateToValidatorSet(ctx context.Context, req *MsgSuperfluidDelegateToValidatorSet) (*MsgSuperfluidDelegateToValidatorSetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SuperfluidDelegateToValidatorSet not implemented")
+}
 func (*UnimplementedMsgServer) SuperfluidUnbondLock(ctx context.Context, req *MsgSuperfluidUnbondLock) (*MsgSuperfluidUnbondLockResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SuperfluidUnbondLock not implemented")
 }
@@ -722,6 +971,42 @@ func _Msg_SuperfluidUndelegate_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SuperfluidRedelegate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSuperfluidRedelegate)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SuperfluidRedelegate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.superfluid.Msg/SuperfluidRedelegate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SuperfluidRedelegate(ctx, req.(*MsgSuperfluidRedelegate))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SuperfluidDelegateToValidatorSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSuperfluidDelegateToValidatorSet)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SuperfluidDelegateToValidatorSet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.superfluid.Msg/SuperfluidDelegateToValidatorSet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SuperfluidDelegateToValidatorSet(ctx, req.(*MsgSuperfluidDelegateToValidatorSet))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_SuperfluidUnbondLock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgSuperfluidUnbondLock)
 	if err := dec(in); err != nil {
@@ -788,6 +1073,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SuperfluidUndelegate",
 			Handler:    _Msg_SuperfluidUndelegate_Handler,
 		},
+		{
+			MethodName: "SuperfluidRedelegate",
+			Handler:    _Msg_SuperfluidRedelegate_Handler,
+		},
+		{
+			MethodName: "SuperfluidDelegateToValidatorSet",
+			Handler:    _Msg_SuperfluidDelegateToValidatorSet_Handler,
+		},
 		{
 			MethodName: "SuperfluidUnbondLock",
 			Handler:    _Msg_SuperfluidUnbondLock_Handler,
@@ -986,7 +1279,7 @@ func (m *MsgSuperfluidUnbondLockResponse) MarshalToSizedBuffer(dAtA []byte) (int
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgLockAndSuperfluidDelegate) Marshal() (dAtA []byte, err error) {
+func (m *MsgSuperfluidRedelegate) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -996,36 +1289,27 @@ func (m *MsgLockAndSuperfluidDelegate) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgLockAndSuperfluidDelegate) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgSuperfluidRedelegate) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgLockAndSuperfluidDelegate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgSuperfluidRedelegate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.ValAddr) > 0 {
-		i -= len(m.ValAddr)
-		copy(dAtA[i:], m.ValAddr)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ValAddr)))
+	if len(m.NewValAddr) > 0 {
+		i -= len(m.NewValAddr)
+		copy(dAtA[i:], m.NewValAddr)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.NewValAddr)))
 		i--
 		dAtA[i] = 0x1a
 	}
-	if len(m.Coins) > 0 {
-		for iNdEx := len(m.Coins) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Coins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
+	if m.LockId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.LockId))
+		i--
+		dAtA[i] = 0x10
 	}
 	if len(m.Sender) > 0 {
 		i -= len(m.Sender)
@@ -1037,7 +1321,7 @@ func (m *MsgLockAndSuperfluidDelegate) MarshalToSizedBuffer(dAtA []byte) (int, e
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgLockAndSuperfluidDelegateResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgSuperfluidRedelegateResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1047,25 +1331,20 @@ func (m *MsgLockAndSuperfluidDelegateResponse) Marshal() (dAtA []byte, err error
 	return dAtA[:n], nil
 }
 
-func (m *MsgLockAndSuperfluidDelegateResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgSuperfluidRedelegateResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgLockAndSuperfluidDelegateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgSuperfluidRedelegateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.ID != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.ID))
-		i--
-		dAtA[i] = 0x8
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgUnPoolWhitelistedPool) Marshal() (dAtA []byte, err error) {
+func (m *MsgSuperfluidDelegateToValidatorSet) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1075,18 +1354,18 @@ func (m *MsgUnPoolWhitelistedPool) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgUnPoolWhitelistedPool) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgSuperfluidDelegateToValidatorSet) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgUnPoolWhitelistedPool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgSuperfluidDelegateToValidatorSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.PoolId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.PoolId))
+	if m.LockId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.LockId))
 		i--
 		dAtA[i] = 0x10
 	}
@@ -1100,7 +1379,7 @@ func (m *MsgUnPoolWhitelistedPool) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgUnPoolWhitelistedPoolResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgSuperfluidDelegateToValidatorSetResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1110,20 +1389,20 @@ func (m *MsgUnPoolWhitelistedPoolResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgUnPoolWhitelistedPoolResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgSuperfluidDelegateToValidatorSetResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgUnPoolWhitelistedPoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgSuperfluidDelegateToValidatorSetResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.ExitedLockIds) > 0 {
-		dAtA2 := make([]byte, len(m.ExitedLockIds)*10)
+	if len(m.LockIds) > 0 {
+		dAtA2 := make([]byte, len(m.LockIds)*10)
 		var j1 int
-		for _, num := range m.ExitedLockIds {
+		for _, num := range m.LockIds {
 			for num >= 1<<7 {
 				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
@@ -1141,29 +1420,184 @@ func (m *MsgUnPoolWhitelistedPoolResponse) MarshalToSizedBuffer(dAtA []byte) (in
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgLockAndSuperfluidDelegate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgSuperfluidDelegate) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgLockAndSuperfluidDelegate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgLockAndSuperfluidDelegate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.ValAddr) > 0 {
+		i -= len(m.ValAddr)
+		copy(dAtA[i:], m.ValAddr)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ValAddr)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.LockId != 0 {
-		n += 1 + sovTx(uint64(m.LockId))
+	if len(m.Coins) > 0 {
+		for iNdEx := len(m.Coins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Coins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgLockAndSuperfluidDelegateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgLockAndSuperfluidDelegateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgLockAndSuperfluidDelegateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ID != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnPoolWhitelistedPool) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnPoolWhitelistedPool) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnPoolWhitelistedPool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnPoolWhitelistedPoolResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnPoolWhitelistedPoolResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnPoolWhitelistedPoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ExitedLockIds) > 0 {
+		dAtA4 := make([]byte, len(m.ExitedLockIds)*10)
+		var j3 int
+		for _, num := range m.ExitedLockIds {
+			for num >= 1<<7 {
+				dAtA4[j3] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j3++
+			}
+			dAtA4[j3] = uint8(num)
+			j3++
+		}
+		i -= j3
+		copy(dAtA[i:], dAtA4[:j3])
+		i = encodeVarintTx(dAtA, i, uint64(j3))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgSuperfluidDelegate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.LockId != 0 {
+		n += 1 + sovTx(uint64(m.LockId))
 	}
 	l = len(m.ValAddr)
 	if l > 0 {
@@ -1231,6 +1665,67 @@ func (m *MsgSuperfluidUnbondLockResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgSuperfluidRedelegate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.LockId != 0 {
+		n += 1 + sovTx(uint64(m.LockId))
+	}
+	l = len(m.NewValAddr)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSuperfluidRedelegateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSuperfluidDelegateToValidatorSet) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.LockId != 0 {
+		n += 1 + sovTx(uint64(m.LockId))
+	}
+	return n
+}
+
+func (m *MsgSuperfluidDelegateToValidatorSetResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.LockIds) > 0 {
+		l = 0
+		for _, e := range m.LockIds {
+			l += sovTx(uint64(e))
+		}
+		n += 1 + sovTx(uint64(l)) + l
+	}
+	return n
+}
+
 func (m *MsgLockAndSuperfluidDelegate) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1789,6 +2284,416 @@ func (m *MsgSuperfluidUnbondLockResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgSuperfluidRedelegate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSuperfluidRedelegate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSuperfluidRedelegate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockId", wireType)
+			}
+			m.LockId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LockId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewValAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewValAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSuperfluidRedelegateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSuperfluidRedelegateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSuperfluidRedelegateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSuperfluidDelegateToValidatorSet) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSuperfluidDelegateToValidatorSet: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSuperfluidDelegateToValidatorSet: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockId", wireType)
+			}
+			m.LockId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LockId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSuperfluidDelegateToValidatorSetResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSuperfluidDelegateToValidatorSetResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSuperfluidDelegateToValidatorSetResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.LockIds = append(m.LockIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.LockIds) == 0 {
+					m.LockIds = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.LockIds = append(m.LockIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockIds", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *MsgLockAndSuperfluidDelegate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
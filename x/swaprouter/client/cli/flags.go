@@ -21,6 +21,8 @@ const (
 	FlagSwapRoutePoolIds = "swap-route-pool-ids"
 	// Will be parsed to []string.
 	FlagSwapRouteDenoms = "swap-route-denoms"
+	// Will be parsed to string.
+	FlagSplitRoutesFile = "routes-file"
 )
 
 type createBalancerPoolInputs struct {
@@ -69,3 +71,10 @@ func FlagSetCreatePool() *flag.FlagSet {
 	fs.String(FlagPoolFile, "", "Pool json file path (if this path is given, other create pool flags should not be used)")
 	return fs
 }
+
+func FlagSetSplitRoutes() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+
+	fs.String(FlagSplitRoutesFile, "", "Split routes json file path")
+	return fs
+}
@@ -95,3 +95,23 @@ func parseCreateStableswapPoolFlags(fs *pflag.FlagSet) (*createStableswapPoolInp
 
 	return pool, nil
 }
+
+// parseSplitRoutesFile reads the json file given by the --routes-file flag into routes,
+// which must point to a slice of split routes. Unknown fields raise an error.
+func parseSplitRoutesFile(fs *pflag.FlagSet, routes interface{}) error {
+	routesFile, _ := fs.GetString(FlagSplitRoutesFile)
+
+	if routesFile == "" {
+		return fmt.Errorf("must pass in a routes json using the --%s flag", FlagSplitRoutesFile)
+	}
+
+	contents, err := os.ReadFile(routesFile)
+	if err != nil {
+		return err
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(contents))
+	dec.DisallowUnknownFields() // Force
+
+	return dec.Decode(routes)
+}
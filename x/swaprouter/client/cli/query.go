@@ -27,7 +27,14 @@ func GetQueryCmd() *cobra.Command {
 	cmd.AddCommand(
 		GetCmdEstimateSwapExactAmountIn(),
 		GetCmdEstimateSwapExactAmountOut(),
+		GetCmdEstimateSplitRouteSwapExactAmountIn(),
+		GetCmdEstimateSplitRouteSwapExactAmountOut(),
+		GetCmdEstimateOptimalRouteSwapExactAmountIn(),
 		GetCmdNumPools(),
+		GetCmdPool(),
+		GetCmdAllPools(),
+		GetCmdSpotPrice(),
+		GetCmdTotalLiquidity(),
 	)
 
 	return cmd
@@ -139,6 +146,140 @@ $ %s query swaprouter estimate-swap-exact-amount-out 1 osm11vmx8jtggpd9u7qr0t8vx
 	return cmd
 }
 
+// GetCmdEstimateSplitRouteSwapExactAmountIn returns estimation of output coin of a split route swap, along with the output of each route.
+func GetCmdEstimateSplitRouteSwapExactAmountIn() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "estimate-split-route-swap-exact-amount-in <tokenInDenom>",
+		Short: "Query estimate-split-route-swap-exact-amount-in",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query estimate-split-route-swap-exact-amount-in.
+Example:
+$ %s query swaprouter estimate-split-route-swap-exact-amount-in stake --routes-file=routes.json
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := queryproto.NewQueryClient(clientCtx)
+
+			routes := []types.SwapAmountInSplitRoute{}
+			if err := parseSplitRoutesFile(cmd.Flags(), &routes); err != nil {
+				return err
+			}
+
+			res, err := queryClient.EstimateSplitRouteSwapExactAmountIn(cmd.Context(), &queryproto.EstimateSplitRouteSwapExactAmountInRequest{
+				Routes:       routes,
+				TokenInDenom: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().AddFlagSet(FlagSetSplitRoutes())
+	flags.AddQueryFlagsToCmd(cmd)
+	_ = cmd.MarkFlagRequired(FlagSplitRoutesFile)
+
+	return cmd
+}
+
+// GetCmdEstimateSplitRouteSwapExactAmountOut returns estimation of input coin of a split route swap, along with the input of each route.
+func GetCmdEstimateSplitRouteSwapExactAmountOut() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "estimate-split-route-swap-exact-amount-out <tokenOutDenom>",
+		Short: "Query estimate-split-route-swap-exact-amount-out",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query estimate-split-route-swap-exact-amount-out.
+Example:
+$ %s query swaprouter estimate-split-route-swap-exact-amount-out stake --routes-file=routes.json
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := queryproto.NewQueryClient(clientCtx)
+
+			routes := []types.SwapAmountOutSplitRoute{}
+			if err := parseSplitRoutesFile(cmd.Flags(), &routes); err != nil {
+				return err
+			}
+
+			res, err := queryClient.EstimateSplitRouteSwapExactAmountOut(cmd.Context(), &queryproto.EstimateSplitRouteSwapExactAmountOutRequest{
+				Routes:        routes,
+				TokenOutDenom: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().AddFlagSet(FlagSetSplitRoutes())
+	flags.AddQueryFlagsToCmd(cmd)
+	_ = cmd.MarkFlagRequired(FlagSplitRoutesFile)
+
+	return cmd
+}
+
+// GetCmdEstimateOptimalRouteSwapExactAmountIn returns the route that gives the most token out for the token in, along with its estimated output.
+func GetCmdEstimateOptimalRouteSwapExactAmountIn() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "estimate-optimal-route-swap-exact-amount-in <tokenIn> <tokenOutDenom> <maxHops>",
+		Short: "Query estimate-optimal-route-swap-exact-amount-in",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query estimate-optimal-route-swap-exact-amount-in, finding the route of at most maxHops pools that gives the most tokenOutDenom for tokenIn.
+Example:
+$ %s query swaprouter estimate-optimal-route-swap-exact-amount-in 1000stake uosmo 3
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := queryproto.NewQueryClient(clientCtx)
+
+			maxHops, err := strconv.ParseUint(args[2], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.EstimateOptimalRouteSwapExactAmountIn(cmd.Context(), &queryproto.EstimateOptimalRouteSwapExactAmountInRequest{
+				TokenIn:       args[0],
+				TokenOutDenom: args[1],
+				MaxHops:       maxHops,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // GetCmdNumPools return number of pools available.
 func GetCmdNumPools() *cobra.Command {
 	cmd := &cobra.Command{
@@ -173,3 +314,159 @@ $ %s query swaprouter num-pools
 
 	return cmd
 }
+
+// GetCmdPool returns the pool with the given id.
+func GetCmdPool() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pool <poolID>",
+		Short: "Query pool",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query pool, whichever pool module it belongs to.
+Example:
+$ %s query swaprouter pool 1
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := queryproto.NewQueryClient(clientCtx)
+
+			poolID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.Pool(cmd.Context(), &queryproto.PoolRequest{
+				PoolId: poolID,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdAllPools returns the pools of every pool module.
+func GetCmdAllPools() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "all-pools",
+		Short: "Query all pools",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the pools of every pool module, ordered by id.
+Example:
+$ %s query swaprouter all-pools
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := queryproto.NewQueryClient(clientCtx)
+
+			res, err := queryClient.AllPools(cmd.Context(), &queryproto.AllPoolsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdSpotPrice returns the spot price of the base asset in terms of the quote asset in a pool.
+func GetCmdSpotPrice() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "spot-price <poolID> <baseAssetDenom> <quoteAssetDenom>",
+		Short: "Query spot-price",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the spot price of the base asset in terms of the quote asset in a pool.
+Example:
+$ %s query swaprouter spot-price 1 uosmo ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := queryproto.NewQueryClient(clientCtx)
+
+			poolID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.SpotPrice(cmd.Context(), &queryproto.SpotPriceRequest{
+				PoolId:          poolID,
+				BaseAssetDenom:  args[1],
+				QuoteAssetDenom: args[2],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdTotalLiquidity returns the coins held by all pools of every pool module.
+func GetCmdTotalLiquidity() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "total-liquidity",
+		Short: "Query total-liquidity",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the coins held by all pools of every pool module.
+Example:
+$ %s query swaprouter total-liquidity
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := queryproto.NewQueryClient(clientCtx)
+
+			res, err := queryClient.TotalLiquidity(cmd.Context(), &queryproto.TotalLiquidityRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
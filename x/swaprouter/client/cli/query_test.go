@@ -36,6 +36,30 @@ func (s *QueryTestSuite) TestQueriesNeverAlterState() {
 			&swaprouterqueryproto.NumPoolsRequest{},
 			&swaprouterqueryproto.NumPoolsResponse{},
 		},
+		{
+			"Query pool",
+			"/osmosis.swaprouter.v1beta1.Query/Pool",
+			&swaprouterqueryproto.PoolRequest{PoolId: 1},
+			&swaprouterqueryproto.PoolResponse{},
+		},
+		{
+			"Query all pools",
+			"/osmosis.swaprouter.v1beta1.Query/AllPools",
+			&swaprouterqueryproto.AllPoolsRequest{},
+			&swaprouterqueryproto.AllPoolsResponse{},
+		},
+		{
+			"Query spot price",
+			"/osmosis.swaprouter.v1beta1.Query/SpotPrice",
+			&swaprouterqueryproto.SpotPriceRequest{PoolId: 1, BaseAssetDenom: "foo", QuoteAssetDenom: "bar"},
+			&swaprouterqueryproto.SpotPriceResponse{},
+		},
+		{
+			"Query total liquidity",
+			"/osmosis.swaprouter.v1beta1.Query/TotalLiquidity",
+			&swaprouterqueryproto.TotalLiquidityRequest{},
+			&swaprouterqueryproto.TotalLiquidityResponse{},
+		},
 	}
 
 	for _, tc := range testCases {
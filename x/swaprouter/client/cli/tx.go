@@ -34,6 +34,8 @@ func NewTxCmd() *cobra.Command {
 		NewCreatePoolCmd(),
 		NewSwapExactAmountInCmd(),
 		NewSwapExactAmountOutCmd(),
+		NewSplitRouteSwapExactAmountInCmd(),
+		NewSplitRouteSwapExactAmountOutCmd(),
 	)
 
 	return txCmd
@@ -99,6 +101,76 @@ func NewSwapExactAmountOutCmd() *cobra.Command {
 	return cmd
 }
 
+func NewSplitRouteSwapExactAmountInCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "split-route-swap-exact-amount-in [token-in-denom] [token-out-min-amount]",
+		Short: "swap exact amount in across several routes",
+		Long: `Swap an exact amount of token-in-denom across several routes, each swapping its own amount in.
+The routes are read from a json file, for example:
+[
+  {"pools": [{"pool_id": 1, "token_out_denom": "uosmo"}], "token_in_amount": "1000"},
+  {"pools": [{"pool_id": 2, "token_out_denom": "uion"}, {"pool_id": 3, "token_out_denom": "uosmo"}], "token_in_amount": "3000"}
+]`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf := tx.NewFactoryCLI(clientCtx, cmd.Flags()).WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			txf, msg, err := NewBuildSplitRouteSwapExactAmountInMsg(clientCtx, args[0], args[1], txf, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	cmd.Flags().AddFlagSet(FlagSetSplitRoutes())
+	flags.AddTxFlagsToCmd(cmd)
+	_ = cmd.MarkFlagRequired(FlagSplitRoutesFile)
+
+	return cmd
+}
+
+func NewSplitRouteSwapExactAmountOutCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "split-route-swap-exact-amount-out [token-out-denom] [token-in-max-amount]",
+		Short: "swap exact amount out across several routes",
+		Long: `Swap for an exact amount of token-out-denom across several routes, each swapping for its own amount out.
+The routes are read from a json file, for example:
+[
+  {"pools": [{"pool_id": 1, "token_in_denom": "uosmo"}], "token_out_amount": "1000"},
+  {"pools": [{"pool_id": 3, "token_in_denom": "uosmo"}, {"pool_id": 2, "token_in_denom": "uion"}], "token_out_amount": "3000"}
+]`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf := tx.NewFactoryCLI(clientCtx, cmd.Flags()).WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			txf, msg, err := NewBuildSplitRouteSwapExactAmountOutMsg(clientCtx, args[0], args[1], txf, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	cmd.Flags().AddFlagSet(FlagSetSplitRoutes())
+	flags.AddTxFlagsToCmd(cmd)
+	_ = cmd.MarkFlagRequired(FlagSplitRoutesFile)
+
+	return cmd
+}
+
 func NewBuildSwapExactAmountInMsg(clientCtx client.Context, tokenInStr, tokenOutMinAmtStr string, txf tx.Factory, fs *flag.FlagSet) (tx.Factory, sdk.Msg, error) {
 	routes, err := swapAmountInRoutes(fs)
 	if err != nil {
@@ -149,6 +221,46 @@ func NewBuildSwapExactAmountOutMsg(clientCtx client.Context, tokenOutStr, tokenI
 	return txf, msg, nil
 }
 
+func NewBuildSplitRouteSwapExactAmountInMsg(clientCtx client.Context, tokenInDenom, tokenOutMinAmtStr string, txf tx.Factory, fs *flag.FlagSet) (tx.Factory, sdk.Msg, error) {
+	routes := []types.SwapAmountInSplitRoute{}
+	if err := parseSplitRoutesFile(fs, &routes); err != nil {
+		return txf, nil, err
+	}
+
+	tokenOutMinAmt, ok := sdk.NewIntFromString(tokenOutMinAmtStr)
+	if !ok {
+		return txf, nil, fmt.Errorf("invalid token out min amount, %s", tokenOutMinAmtStr)
+	}
+	msg := &types.MsgSplitRouteSwapExactAmountIn{
+		Sender:            clientCtx.GetFromAddress().String(),
+		Routes:            routes,
+		TokenInDenom:      tokenInDenom,
+		TokenOutMinAmount: tokenOutMinAmt,
+	}
+
+	return txf, msg, nil
+}
+
+func NewBuildSplitRouteSwapExactAmountOutMsg(clientCtx client.Context, tokenOutDenom, tokenInMaxAmountStr string, txf tx.Factory, fs *flag.FlagSet) (tx.Factory, sdk.Msg, error) {
+	routes := []types.SwapAmountOutSplitRoute{}
+	if err := parseSplitRoutesFile(fs, &routes); err != nil {
+		return txf, nil, err
+	}
+
+	tokenInMaxAmount, ok := sdk.NewIntFromString(tokenInMaxAmountStr)
+	if !ok {
+		return txf, nil, errors.New("invalid token in max amount")
+	}
+	msg := &types.MsgSplitRouteSwapExactAmountOut{
+		Sender:           clientCtx.GetFromAddress().String(),
+		Routes:           routes,
+		TokenOutDenom:    tokenOutDenom,
+		TokenInMaxAmount: tokenInMaxAmount,
+	}
+
+	return txf, msg, nil
+}
+
 func NewCreatePoolCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "create-pool [flags]",
@@ -30,6 +30,46 @@ func (q Querier) Params(grpcCtx context.Context,
 	return q.Q.Params(ctx, *req)
 }
 
+func (q Querier) TotalLiquidity(grpcCtx context.Context,
+	req *queryproto.TotalLiquidityRequest,
+) (*queryproto.TotalLiquidityResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(grpcCtx)
+	return q.Q.TotalLiquidity(ctx, *req)
+}
+
+func (q Querier) SpotPrice(grpcCtx context.Context,
+	req *queryproto.SpotPriceRequest,
+) (*queryproto.SpotPriceResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(grpcCtx)
+	return q.Q.SpotPrice(ctx, *req)
+}
+
+func (q Querier) AllPools(grpcCtx context.Context,
+	req *queryproto.AllPoolsRequest,
+) (*queryproto.AllPoolsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(grpcCtx)
+	return q.Q.AllPools(ctx, *req)
+}
+
+func (q Querier) Pool(grpcCtx context.Context,
+	req *queryproto.PoolRequest,
+) (*queryproto.PoolResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(grpcCtx)
+	return q.Q.Pool(ctx, *req)
+}
+
 func (q Querier) NumPools(grpcCtx context.Context,
 	req *queryproto.NumPoolsRequest,
 ) (*queryproto.NumPoolsResponse, error) {
@@ -40,6 +80,36 @@ func (q Querier) NumPools(grpcCtx context.Context,
 	return q.Q.NumPools(ctx, *req)
 }
 
+func (q Querier) EstimateOptimalRouteSwapExactAmountIn(grpcCtx context.Context,
+	req *queryproto.EstimateOptimalRouteSwapExactAmountInRequest,
+) (*queryproto.EstimateOptimalRouteSwapExactAmountInResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(grpcCtx)
+	return q.Q.EstimateOptimalRouteSwapExactAmountIn(ctx, *req)
+}
+
+func (q Querier) EstimateSplitRouteSwapExactAmountOut(grpcCtx context.Context,
+	req *queryproto.EstimateSplitRouteSwapExactAmountOutRequest,
+) (*queryproto.EstimateSplitRouteSwapExactAmountOutResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(grpcCtx)
+	return q.Q.EstimateSplitRouteSwapExactAmountOut(ctx, *req)
+}
+
+func (q Querier) EstimateSplitRouteSwapExactAmountIn(grpcCtx context.Context,
+	req *queryproto.EstimateSplitRouteSwapExactAmountInRequest,
+) (*queryproto.EstimateSplitRouteSwapExactAmountInResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(grpcCtx)
+	return q.Q.EstimateSplitRouteSwapExactAmountIn(ctx, *req)
+}
+
 func (q Querier) EstimateSwapExactAmountOut(grpcCtx context.Context,
 	req *queryproto.EstimateSwapExactAmountOutRequest,
 ) (*queryproto.EstimateSwapExactAmountOutResponse, error) {
@@ -1,6 +1,7 @@
 package client
 
 import (
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -73,9 +74,138 @@ func (q Querier) EstimateSwapExactAmountOut(ctx sdk.Context, req queryproto.Esti
 	}, nil
 }
 
+// EstimateSplitRouteSwapExactAmountIn estimates the output token amount of a split route swap,
+// along with the output token amount of each route.
+func (q Querier) EstimateSplitRouteSwapExactAmountIn(ctx sdk.Context, req queryproto.EstimateSplitRouteSwapExactAmountInRequest) (*queryproto.EstimateSplitRouteSwapExactAmountInResponse, error) {
+	if err := sdk.ValidateDenom(req.TokenInDenom); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid token: %s", err.Error())
+	}
+
+	tokenOutAmount, routeTokenOutAmounts, err := q.K.SplitRouteEstimateOutGivenExactAmountIn(ctx, req.Routes, req.TokenInDenom)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &queryproto.EstimateSplitRouteSwapExactAmountInResponse{
+		TokenOutAmount:       tokenOutAmount,
+		RouteTokenOutAmounts: routeTokenOutAmounts,
+	}, nil
+}
+
+// EstimateSplitRouteSwapExactAmountOut estimates the input token amount of a split route swap,
+// along with the input token amount of each route.
+func (q Querier) EstimateSplitRouteSwapExactAmountOut(ctx sdk.Context, req queryproto.EstimateSplitRouteSwapExactAmountOutRequest) (*queryproto.EstimateSplitRouteSwapExactAmountOutResponse, error) {
+	if err := sdk.ValidateDenom(req.TokenOutDenom); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid token: %s", err.Error())
+	}
+
+	tokenInAmount, routeTokenInAmounts, err := q.K.SplitRouteEstimateInGivenExactAmountOut(ctx, req.Routes, req.TokenOutDenom)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &queryproto.EstimateSplitRouteSwapExactAmountOutResponse{
+		TokenInAmount:       tokenInAmount,
+		RouteTokenInAmounts: routeTokenInAmounts,
+	}, nil
+}
+
+// EstimateOptimalRouteSwapExactAmountIn finds the route that gives the most token out for the token in,
+// along with its estimated token out amount.
+func (q Querier) EstimateOptimalRouteSwapExactAmountIn(ctx sdk.Context, req queryproto.EstimateOptimalRouteSwapExactAmountInRequest) (*queryproto.EstimateOptimalRouteSwapExactAmountInResponse, error) {
+	if req.TokenIn == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid token")
+	}
+
+	tokenIn, err := sdk.ParseCoinNormalized(req.TokenIn)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid token: %s", err.Error())
+	}
+
+	routes, tokenOutAmount, err := q.K.FindOptimalRouteExactAmountIn(ctx, tokenIn, req.TokenOutDenom, req.MaxHops)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &queryproto.EstimateOptimalRouteSwapExactAmountInResponse{
+		Routes:         routes,
+		TokenOutAmount: tokenOutAmount,
+	}, nil
+}
+
 // NumPools returns total number of pools.
 func (q Querier) NumPools(ctx sdk.Context, _ queryproto.NumPoolsRequest) (*queryproto.NumPoolsResponse, error) {
 	return &queryproto.NumPoolsResponse{
 		NumPools: q.K.GetNextPoolId(ctx) - 1,
 	}, nil
 }
+
+// Pool returns the pool with the given id, whichever pool module it belongs to.
+func (q Querier) Pool(ctx sdk.Context, req queryproto.PoolRequest) (*queryproto.PoolResponse, error) {
+	pool, err := q.K.GetPool(ctx, req.PoolId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	any, err := codectypes.NewAnyWithValue(pool)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &queryproto.PoolResponse{
+		Pool: any,
+	}, nil
+}
+
+// AllPools returns the pools of every pool module, ordered by id.
+func (q Querier) AllPools(ctx sdk.Context, _ queryproto.AllPoolsRequest) (*queryproto.AllPoolsResponse, error) {
+	pools, err := q.K.AllPools(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	anys := make([]*codectypes.Any, 0, len(pools))
+	for _, pool := range pools {
+		any, err := codectypes.NewAnyWithValue(pool)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		anys = append(anys, any)
+	}
+
+	return &queryproto.AllPoolsResponse{
+		Pools: anys,
+	}, nil
+}
+
+// SpotPrice returns the spot price of the base asset in terms of the quote asset in the given pool.
+func (q Querier) SpotPrice(ctx sdk.Context, req queryproto.SpotPriceRequest) (*queryproto.SpotPriceResponse, error) {
+	if req.BaseAssetDenom == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid base asset denom")
+	}
+
+	if req.QuoteAssetDenom == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid quote asset denom")
+	}
+
+	sp, err := q.K.RouteCalculateSpotPrice(ctx, req.PoolId, req.QuoteAssetDenom, req.BaseAssetDenom)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &queryproto.SpotPriceResponse{
+		SpotPrice: sp.String(),
+	}, nil
+}
+
+// TotalLiquidity returns the coins held by all pools of every pool module.
+func (q Querier) TotalLiquidity(ctx sdk.Context, _ queryproto.TotalLiquidityRequest) (*queryproto.TotalLiquidityResponse, error) {
+	totalLiquidity, err := q.K.GetTotalLiquidity(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &queryproto.TotalLiquidityResponse{
+		Liquidity: totalLiquidity,
+	}, nil
+}
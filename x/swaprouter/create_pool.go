@@ -18,6 +18,7 @@ func (k Keeper) validateCreatedPool(
 	initialPoolLiquidity sdk.Coins,
 	poolId uint64,
 	pool types.PoolI,
+	poolType types.PoolType,
 ) error {
 	if pool.GetId() != poolId {
 		return sdkerrors.Wrapf(types.ErrInvalidPool,
@@ -33,6 +34,10 @@ func (k Keeper) validateCreatedPool(
 		return sdkerrors.Wrapf(types.ErrInvalidPool,
 			"Pool was attempted to be created, with initial liquidity not equal to what was specified.")
 	}
+	// Concentrated liquidity pools do not issue shares.
+	if poolType == types.Concentrated {
+		return nil
+	}
 	// This check can be removed later, and replaced with a minimum.
 	if !pool.GetTotalShares().Equal(gammtypes.InitPoolSharesSupply) {
 		return sdkerrors.Wrapf(types.ErrInvalidPool,
@@ -47,6 +52,12 @@ func validateCreatePoolMsg(ctx sdk.Context, msg types.CreatePoolMsg) error {
 		return err
 	}
 
+	// Concentrated liquidity pools are created empty, liquidity is only
+	// added afterwards through positions.
+	if msg.GetPoolType() == types.Concentrated {
+		return nil
+	}
+
 	initialPoolLiquidity := msg.InitialLiquidity()
 	numAssets := initialPoolLiquidity.Len()
 	if numAssets < types.MinPoolAssets {
@@ -94,7 +105,7 @@ func (k Keeper) CreatePool(ctx sdk.Context, msg types.CreatePoolMsg) (uint64, er
 
 	k.SetPoolRoute(ctx, poolId, msg.GetPoolType())
 
-	if err := k.validateCreatedPool(ctx, initialPoolLiquidity, poolId, pool); err != nil {
+	if err := k.validateCreatedPool(ctx, initialPoolLiquidity, poolId, pool, msg.GetPoolType()); err != nil {
 		return 0, err
 	}
 
@@ -154,21 +165,33 @@ func (k Keeper) SetPoolRoute(ctx sdk.Context, poolId uint64, poolType types.Pool
 // TODO: unexport after concentrated-liqudity upgrade. Currently, it is exported
 // for the upgrade handler logic and tests.
 func (k Keeper) GetPoolModule(ctx sdk.Context, poolId uint64) (types.SwapI, error) {
+	poolType, err := k.GetPoolType(ctx, poolId)
+	if err != nil {
+		return nil, err
+	}
+
+	swapModule, routeExists := k.routes[poolType]
+	if !routeExists {
+		return nil, types.UndefinedRouteError{PoolType: poolType, PoolId: poolId}
+	}
+
+	return swapModule, nil
+}
+
+// GetPoolType returns the type of the pool with the given id, as recorded in
+// its module route.
+// Returns error if any database error occurs or if no route exists for the pool.
+func (k Keeper) GetPoolType(ctx sdk.Context, poolId uint64) (types.PoolType, error) {
 	store := ctx.KVStore(k.storeKey)
 
 	moduleRoute := &types.ModuleRoute{}
 	found, err := osmoutils.Get(store, types.FormatModuleRouteKey(poolId), moduleRoute)
 	if err != nil {
-		return nil, err
+		return 0, err
 	}
 	if !found {
-		return nil, types.FailedToFindRouteError{PoolId: poolId}
+		return 0, types.FailedToFindRouteError{PoolId: poolId}
 	}
 
-	swapModule, routeExists := k.routes[moduleRoute.PoolType]
-	if !routeExists {
-		return nil, types.UndefinedRouteError{PoolType: moduleRoute.PoolType, PoolId: poolId}
-	}
-
-	return swapModule, nil
+	return moduleRoute.PoolType, nil
 }
@@ -7,6 +7,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/osmosis-labs/osmosis/v13/app/apptesting"
+	clmodel "github.com/osmosis-labs/osmosis/v13/x/concentrated-liquidity/model"
 	"github.com/osmosis-labs/osmosis/v13/x/gamm/pool-models/balancer"
 	balancertypes "github.com/osmosis-labs/osmosis/v13/x/gamm/pool-models/balancer"
 	gammtypes "github.com/osmosis-labs/osmosis/v13/x/gamm/types"
@@ -146,8 +147,13 @@ func (suite *KeeperTestSuite) TestCreatePool() {
 			msg:                validBalancerPoolMsg,
 			expectedModuleType: gammKeeperType,
 		},
+		{
+			name:               "concentrated liquidity pool - success",
+			creatorFundAmount:  sdk.NewCoins(),
+			msg:                clmodel.NewMsgCreateConcentratedPool(suite.TestAccs[0], foo, bar, 1, sdk.ZeroDec()),
+			expectedModuleType: concentratedKeeperType,
+		},
 		// TODO: add stableswap test
-		// TODO: add concentrated-liquidity test
 		// TODO: cover errors and edge cases
 	}
 
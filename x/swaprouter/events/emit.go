@@ -2,10 +2,12 @@ package events
 
 import (
 	"strconv"
+	"strings"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/osmosis-labs/osmosis/v13/x/gamm/types"
+	swaproutertypes "github.com/osmosis-labs/osmosis/v13/x/swaprouter/types"
 )
 
 func EmitSwapEvent(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, input sdk.Coins, output sdk.Coins) {
@@ -56,3 +58,28 @@ func newRemoveLiquidityEvent(sender sdk.AccAddress, poolId uint64, liquidity sdk
 		sdk.NewAttribute(types.AttributeKeyTokensOut, liquidity.String()),
 	)
 }
+
+// EmitSplitRouteSwapEvent emits an event for a single route of a split route swap,
+// identified by its index among the routes of the swap.
+func EmitSplitRouteSwapEvent(ctx sdk.Context, sender sdk.AccAddress, routeIndex int, poolIds []uint64, tokenIn sdk.Coin, tokenOut sdk.Coin) {
+	ctx.EventManager().EmitEvents(sdk.Events{
+		newSplitRouteSwapEvent(sender, routeIndex, poolIds, tokenIn, tokenOut),
+	})
+}
+
+func newSplitRouteSwapEvent(sender sdk.AccAddress, routeIndex int, poolIds []uint64, tokenIn sdk.Coin, tokenOut sdk.Coin) sdk.Event {
+	poolIdStrs := make([]string, 0, len(poolIds))
+	for _, poolId := range poolIds {
+		poolIdStrs = append(poolIdStrs, strconv.FormatUint(poolId, 10))
+	}
+
+	return sdk.NewEvent(
+		swaproutertypes.TypeEvtSplitRouteSwapped,
+		sdk.NewAttribute(sdk.AttributeKeyModule, swaproutertypes.AttributeValueCategory),
+		sdk.NewAttribute(sdk.AttributeKeySender, sender.String()),
+		sdk.NewAttribute(swaproutertypes.AttributeKeyRouteIndex, strconv.Itoa(routeIndex)),
+		sdk.NewAttribute(swaproutertypes.AttributeKeyPoolIds, strings.Join(poolIdStrs, ",")),
+		sdk.NewAttribute(swaproutertypes.AttributeKeyTokensIn, tokenIn.String()),
+		sdk.NewAttribute(swaproutertypes.AttributeKeyTokensOut, tokenOut.String()),
+	)
+}
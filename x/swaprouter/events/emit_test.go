@@ -10,6 +10,7 @@ import (
 	"github.com/osmosis-labs/osmosis/v13/app/apptesting"
 	"github.com/osmosis-labs/osmosis/v13/x/gamm/types"
 	"github.com/osmosis-labs/osmosis/v13/x/swaprouter/events"
+	swaproutertypes "github.com/osmosis-labs/osmosis/v13/x/swaprouter/types"
 )
 
 type SwapRouterEventsTestSuite struct {
@@ -184,3 +185,65 @@ func (suite *SwapRouterEventsTestSuite) TestEmitRemoveLiquidityEvent() {
 		})
 	}
 }
+
+func (suite *SwapRouterEventsTestSuite) TestEmitSplitRouteSwapEvent() {
+	testcases := map[string]struct {
+		ctx             sdk.Context
+		testAccountAddr sdk.AccAddress
+		routeIndex      int
+		poolIds         []uint64
+		expectedPoolIds string
+		tokenIn         sdk.Coin
+		tokenOut        sdk.Coin
+	}{
+		"single pool route": {
+			ctx:             suite.CreateTestContext(),
+			testAccountAddr: sdk.AccAddress([]byte(addressString)),
+			routeIndex:      0,
+			poolIds:         []uint64{1},
+			expectedPoolIds: "1",
+			tokenIn:         sdk.NewCoin(testDenomA, sdk.NewInt(1234)),
+			tokenOut:        sdk.NewCoin(testDenomB, sdk.NewInt(5678)),
+		},
+		"multihop route": {
+			ctx:             suite.CreateTestContext(),
+			testAccountAddr: sdk.AccAddress([]byte(addressString)),
+			routeIndex:      2,
+			poolIds:         []uint64{3, 200},
+			expectedPoolIds: "3,200",
+			tokenIn:         sdk.NewCoin(testDenomA, sdk.NewInt(12)),
+			tokenOut:        sdk.NewCoin(testDenomC, sdk.NewInt(88)),
+		},
+	}
+
+	for name, tc := range testcases {
+		suite.Run(name, func() {
+			expectedEvents := sdk.Events{
+				sdk.NewEvent(
+					swaproutertypes.TypeEvtSplitRouteSwapped,
+					sdk.NewAttribute(sdk.AttributeKeyModule, swaproutertypes.AttributeValueCategory),
+					sdk.NewAttribute(sdk.AttributeKeySender, tc.testAccountAddr.String()),
+					sdk.NewAttribute(swaproutertypes.AttributeKeyRouteIndex, strconv.Itoa(tc.routeIndex)),
+					sdk.NewAttribute(swaproutertypes.AttributeKeyPoolIds, tc.expectedPoolIds),
+					sdk.NewAttribute(swaproutertypes.AttributeKeyTokensIn, tc.tokenIn.String()),
+					sdk.NewAttribute(swaproutertypes.AttributeKeyTokensOut, tc.tokenOut.String()),
+				),
+			}
+
+			hasNoEventManager := tc.ctx.EventManager() == nil
+
+			// System under test.
+			events.EmitSplitRouteSwapEvent(tc.ctx, tc.testAccountAddr, tc.routeIndex, tc.poolIds, tc.tokenIn, tc.tokenOut)
+
+			// Assertions
+			if hasNoEventManager {
+				// If there is no event manager on context, this is a no-op.
+				return
+			}
+
+			eventManager := tc.ctx.EventManager()
+			actualEvents := eventManager.Events()
+			suite.Equal(expectedEvents, actualEvents)
+		})
+	}
+}
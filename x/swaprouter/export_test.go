@@ -1,6 +1,8 @@
 package swaprouter
 
 import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
 	"github.com/osmosis-labs/osmosis/v13/x/swaprouter/types"
 )
 
@@ -12,3 +14,8 @@ import (
 func (k *Keeper) SetPoolRoutesUnsafe(routes map[types.PoolType]types.SwapI) {
 	k.routes = routes
 }
+
+func (k Keeper) GetOsmoRoutedMultihopTotalSwapFee(ctx sdk.Context, route types.MultihopRoute) (
+	totalPathSwapFee sdk.Dec, sumOfSwapFees sdk.Dec, err error) {
+	return k.getOsmoRoutedMultihopTotalSwapFee(ctx, route)
+}
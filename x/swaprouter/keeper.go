@@ -24,6 +24,9 @@ type Keeper struct {
 
 	routes map[types.PoolType]types.SwapI
 
+	// poolModules are the distinct pool modules, in the order their pools are gathered.
+	poolModules []types.SwapI
+
 	paramSpace paramtypes.Subspace
 }
 
@@ -39,7 +42,9 @@ func NewKeeper(storeKey sdk.StoreKey, paramSpace paramtypes.Subspace, gammKeeper
 		types.Concentrated: concentratedKeeper,
 	}
 
-	return &Keeper{storeKey: storeKey, paramSpace: paramSpace, gammKeeper: gammKeeper, concentratedKeeper: concentratedKeeper, bankKeeper: bankKeeper, accountKeeper: accountKeeper, communityPoolKeeper: communityPoolKeeper, routes: routes}
+	poolModules := []types.SwapI{gammKeeper, concentratedKeeper}
+
+	return &Keeper{storeKey: storeKey, paramSpace: paramSpace, gammKeeper: gammKeeper, concentratedKeeper: concentratedKeeper, bankKeeper: bankKeeper, accountKeeper: accountKeeper, communityPoolKeeper: communityPoolKeeper, routes: routes, poolModules: poolModules}
 }
 
 // GetParams returns the total set of swaprouter parameters.
@@ -7,6 +7,7 @@ import (
 	"github.com/stretchr/testify/suite"
 
 	"github.com/osmosis-labs/osmosis/v13/app/apptesting"
+	"github.com/osmosis-labs/osmosis/v13/x/swaprouter/client/queryproto"
 	"github.com/osmosis-labs/osmosis/v13/x/swaprouter/types"
 )
 
@@ -19,10 +20,6 @@ const testExpectedPoolId = 3
 var testPoolCreationFee = sdk.Coins{sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000_000_000)}
 
 func TestKeeperTestSuite(t *testing.T) {
-
-	// TODO: re-enable this once swaprouter is fully merged.
-	t.SkipNow()
-
 	suite.Run(t, new(KeeperTestSuite))
 }
 
@@ -40,7 +37,7 @@ func (suite *KeeperTestSuite) createPoolFromType(poolType types.PoolType) {
 		// TODO
 		return
 	case types.Concentrated:
-		// TODO
+		suite.PrepareConcentratedPool("foo", "bar")
 		return
 	}
 }
@@ -54,3 +51,51 @@ func (suite *KeeperTestSuite) createBalancerPoolsFromCoins(poolCoins []sdk.Coins
 		suite.PrepareBalancerPoolWithCoins(curPoolCoins...)
 	}
 }
+
+// TestServicesRegistered tests that split route swaps and their estimates are routed
+// by the app's Msg and gRPC query routers.
+func (suite *KeeperTestSuite) TestServicesRegistered() {
+	suite.SetupTest()
+	poolId := suite.PrepareBalancerPool()
+	sender := suite.TestAccs[0]
+	suite.FundAcc(sender, sdk.NewCoins(sdk.NewInt64Coin("foo", 2000)))
+
+	inRoutes := []types.SwapAmountInSplitRoute{{
+		Pools:         []types.SwapAmountInRoute{{PoolId: poolId, TokenOutDenom: "bar"}},
+		TokenInAmount: sdk.NewInt(1000),
+	}}
+	outRoutes := []types.SwapAmountOutSplitRoute{{
+		Pools:          []types.SwapAmountOutRoute{{PoolId: poolId, TokenInDenom: "foo"}},
+		TokenOutAmount: sdk.NewInt(100),
+	}}
+
+	queryClient := queryproto.NewQueryClient(suite.QueryHelper)
+	estimateIn, err := queryClient.EstimateSplitRouteSwapExactAmountIn(sdk.WrapSDKContext(suite.Ctx),
+		&queryproto.EstimateSplitRouteSwapExactAmountInRequest{Routes: inRoutes, TokenInDenom: "foo"})
+	suite.Require().NoError(err)
+	suite.Require().True(estimateIn.TokenOutAmount.IsPositive())
+	_, err = queryClient.EstimateSplitRouteSwapExactAmountOut(sdk.WrapSDKContext(suite.Ctx),
+		&queryproto.EstimateSplitRouteSwapExactAmountOutRequest{Routes: outRoutes, TokenOutDenom: "bar"})
+	suite.Require().NoError(err)
+
+	msgs := []sdk.Msg{
+		&types.MsgSplitRouteSwapExactAmountIn{
+			Sender:            sender.String(),
+			Routes:            inRoutes,
+			TokenInDenom:      "foo",
+			TokenOutMinAmount: sdk.OneInt(),
+		},
+		&types.MsgSplitRouteSwapExactAmountOut{
+			Sender:           sender.String(),
+			Routes:           outRoutes,
+			TokenOutDenom:    "bar",
+			TokenInMaxAmount: sdk.NewInt(1000),
+		},
+	}
+	for _, msg := range msgs {
+		handler := suite.App.MsgServiceRouter().Handler(msg)
+		suite.Require().NotNil(handler, sdk.MsgTypeURL(msg))
+		_, err := handler(suite.Ctx, msg)
+		suite.Require().NoError(err, sdk.MsgTypeURL(msg))
+	}
+}
@@ -0,0 +1,69 @@
+package swaprouter
+
+import (
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v13/x/swaprouter/types"
+)
+
+// GetPool returns the pool with the given id from the pool module it is routed to.
+func (k Keeper) GetPool(ctx sdk.Context, poolId uint64) (types.PoolI, error) {
+	_, pool, err := k.getPoolModuleAndPool(ctx, poolId)
+	if err != nil {
+		return nil, err
+	}
+	return pool, nil
+}
+
+// AllPools returns the pools of every pool module, ordered by pool id.
+func (k Keeper) AllPools(ctx sdk.Context) ([]types.PoolI, error) {
+	pools := []types.PoolI{}
+	for _, poolModule := range k.poolModules {
+		modulePools, err := poolModule.GetPools(ctx)
+		if err != nil {
+			return nil, err
+		}
+		pools = append(pools, modulePools...)
+	}
+
+	sort.Slice(pools, func(i, j int) bool {
+		return pools[i].GetId() < pools[j].GetId()
+	})
+	return pools, nil
+}
+
+// RouteCalculateSpotPrice returns the spot price of the quote asset in terms of the base asset
+// in the given pool, as calculated by the pool module the pool is routed to.
+func (k Keeper) RouteCalculateSpotPrice(ctx sdk.Context, poolId uint64, quoteAssetDenom string, baseAssetDenom string) (sdk.Dec, error) {
+	swapModule, err := k.GetPoolModule(ctx, poolId)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+
+	return swapModule.CalculateSpotPrice(ctx, poolId, quoteAssetDenom, baseAssetDenom)
+}
+
+// GetTotalPoolLiquidity returns the coins held by the given pool.
+func (k Keeper) GetTotalPoolLiquidity(ctx sdk.Context, poolId uint64) (sdk.Coins, error) {
+	pool, err := k.GetPool(ctx, poolId)
+	if err != nil {
+		return nil, err
+	}
+	return pool.GetTotalPoolLiquidity(ctx), nil
+}
+
+// GetTotalLiquidity returns the coins held by all pools of every pool module.
+func (k Keeper) GetTotalLiquidity(ctx sdk.Context) (sdk.Coins, error) {
+	pools, err := k.AllPools(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	totalLiquidity := sdk.Coins{}
+	for _, pool := range pools {
+		totalLiquidity = totalLiquidity.Add(pool.GetTotalPoolLiquidity(ctx)...)
+	}
+	return totalLiquidity, nil
+}
@@ -0,0 +1,83 @@
+package swaprouter_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	cltypes "github.com/osmosis-labs/osmosis/v13/x/concentrated-liquidity/types"
+	"github.com/osmosis-labs/osmosis/v13/x/swaprouter/types"
+)
+
+// TestAllPools tests that pools of every pool module are returned, ordered by id.
+func (suite *KeeperTestSuite) TestAllPools() {
+	suite.SetupTest()
+
+	balancerPoolId := suite.PrepareBalancerPool()
+	concentratedPoolId := suite.PrepareConcentratedPool(foo, bar)
+	stableswapPoolId := suite.PrepareBasicStableswapPool()
+
+	pools, err := suite.App.SwapRouterKeeper.AllPools(suite.Ctx)
+	suite.Require().NoError(err)
+
+	suite.Require().Len(pools, 3)
+	for i, expectedPoolId := range []uint64{balancerPoolId, concentratedPoolId, stableswapPoolId} {
+		suite.Require().Equal(expectedPoolId, pools[i].GetId())
+
+		pool, err := suite.App.SwapRouterKeeper.GetPool(suite.Ctx, expectedPoolId)
+		suite.Require().NoError(err)
+		suite.Require().Equal(pool, pools[i])
+	}
+}
+
+func (suite *KeeperTestSuite) TestGetPoolType() {
+	suite.SetupTest()
+
+	balancerPoolId := suite.PrepareBalancerPool()
+	concentratedPoolId := suite.PrepareConcentratedPool(foo, bar)
+
+	poolType, err := suite.App.SwapRouterKeeper.GetPoolType(suite.Ctx, balancerPoolId)
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.Balancer, poolType)
+
+	poolType, err = suite.App.SwapRouterKeeper.GetPoolType(suite.Ctx, concentratedPoolId)
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.Concentrated, poolType)
+
+	_, err = suite.App.SwapRouterKeeper.GetPoolType(suite.Ctx, concentratedPoolId+1)
+	suite.Require().ErrorIs(err, types.FailedToFindRouteError{PoolId: concentratedPoolId + 1})
+}
+
+func (suite *KeeperTestSuite) TestRouteCalculateSpotPrice() {
+	suite.SetupTest()
+
+	balancerPoolId := suite.PrepareBalancerPool()
+	concentratedPoolId := suite.PrepareConcentratedPool(foo, bar)
+
+	spotPrice, err := suite.App.SwapRouterKeeper.RouteCalculateSpotPrice(suite.Ctx, balancerPoolId, foo, bar)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewDec(2).String(), spotPrice.String())
+
+	// the concentrated pool has no price until its first position is created.
+	_, err = suite.App.SwapRouterKeeper.RouteCalculateSpotPrice(suite.Ctx, concentratedPoolId, foo, bar)
+	suite.Require().ErrorIs(err, cltypes.ErrPoolNotInitialized)
+
+	_, err = suite.App.SwapRouterKeeper.RouteCalculateSpotPrice(suite.Ctx, concentratedPoolId+1, foo, bar)
+	suite.Require().Error(err)
+}
+
+func (suite *KeeperTestSuite) TestGetTotalLiquidity() {
+	suite.SetupTest()
+
+	firstPoolId := suite.PrepareBalancerPool()
+	secondPoolId := suite.PrepareBasicStableswapPool()
+	suite.PrepareConcentratedPool(foo, bar)
+
+	firstPoolLiquidity, err := suite.App.SwapRouterKeeper.GetTotalPoolLiquidity(suite.Ctx, firstPoolId)
+	suite.Require().NoError(err)
+	secondPoolLiquidity, err := suite.App.SwapRouterKeeper.GetTotalPoolLiquidity(suite.Ctx, secondPoolId)
+	suite.Require().NoError(err)
+
+	totalLiquidity, err := suite.App.SwapRouterKeeper.GetTotalLiquidity(suite.Ctx)
+	suite.Require().NoError(err)
+	suite.Require().Equal(firstPoolLiquidity.Add(secondPoolLiquidity...), totalLiquidity)
+	suite.Require().Equal(suite.App.GAMMKeeper.GetTotalLiquidity(suite.Ctx), totalLiquidity)
+}
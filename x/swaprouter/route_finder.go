@@ -0,0 +1,193 @@
+package swaprouter
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v13/x/swaprouter/types"
+)
+
+// denomGraph maps each denom to the pools it can be swapped in, and each pool
+// to the denoms it holds.
+type denomGraph struct {
+	denomPools map[string][]uint64
+	poolDenoms map[uint64][]string
+}
+
+// partialRoute is a route being extended by the optimal route finder, along
+// with the denom it currently ends in.
+type partialRoute struct {
+	routes []types.SwapAmountInRoute
+	denom  string
+}
+
+// FindOptimalRouteExactAmountIn searches for the route of at most maxHops pools that
+// gives the most tokenOutDenom for tokenIn, and returns it along with its estimated
+// amount out.
+//
+// Routes are explored in order of increasing length, never visiting a pool or a denom
+// twice. Each complete route is estimated with MultihopEstimateOutGivenExactAmountIn,
+// and routes that fail to estimate are skipped. To keep the search bounded, at most
+// MaxRouteFinderSearchSteps partial routes are explored and at most
+// MaxRouteFinderCandidates complete routes are estimated. On ties, the shorter route,
+// then the one with the lower pool ids, is returned.
+func (k Keeper) FindOptimalRouteExactAmountIn(
+	ctx sdk.Context,
+	tokenIn sdk.Coin,
+	tokenOutDenom string,
+	maxHops uint64,
+) (routes []types.SwapAmountInRoute, tokenOutAmount sdk.Int, err error) {
+	if err := validateRouteFinderArgs(tokenOutDenom, maxHops); err != nil {
+		return nil, sdk.Int{}, err
+	}
+
+	return k.findOptimalRoute(ctx, k.buildDenomGraph(ctx), tokenIn, tokenOutDenom, maxHops)
+}
+
+// FindOptimalRoutesExactAmountIn searches for the optimal route of each of tokensIn to
+// tokenOutDenom as FindOptimalRouteExactAmountIn does, building the denom graph of the pools
+// only once. The routes of a token in are nil if no route is found for it.
+func (k Keeper) FindOptimalRoutesExactAmountIn(
+	ctx sdk.Context,
+	tokensIn []sdk.Coin,
+	tokenOutDenom string,
+	maxHops uint64,
+) ([][]types.SwapAmountInRoute, error) {
+	if err := validateRouteFinderArgs(tokenOutDenom, maxHops); err != nil {
+		return nil, err
+	}
+
+	graph := k.buildDenomGraph(ctx)
+	routes := make([][]types.SwapAmountInRoute, len(tokensIn))
+	for i, tokenIn := range tokensIn {
+		routes[i], _, _ = k.findOptimalRoute(ctx, graph, tokenIn, tokenOutDenom, maxHops)
+	}
+	return routes, nil
+}
+
+func validateRouteFinderArgs(tokenOutDenom string, maxHops uint64) error {
+	if err := sdk.ValidateDenom(tokenOutDenom); err != nil {
+		return err
+	}
+	if maxHops == 0 || maxHops > types.MaxRouteFinderHops {
+		return types.InvalidMaxHopsError{MaxHops: maxHops}
+	}
+	return nil
+}
+
+// findOptimalRoute searches the given denom graph for the optimal route of tokenIn, see FindOptimalRouteExactAmountIn.
+func (k Keeper) findOptimalRoute(
+	ctx sdk.Context,
+	graph denomGraph,
+	tokenIn sdk.Coin,
+	tokenOutDenom string,
+	maxHops uint64,
+) (routes []types.SwapAmountInRoute, tokenOutAmount sdk.Int, err error) {
+	if !tokenIn.IsValid() || !tokenIn.IsPositive() {
+		return nil, sdk.Int{}, types.ErrInvalidTokenIn
+	}
+	if tokenIn.Denom == tokenOutDenom {
+		return nil, sdk.Int{}, types.ErrSameDenomRoute
+	}
+
+	tokenOutAmount = sdk.ZeroInt()
+	searchSteps, candidates := 0, 0
+	queue := []partialRoute{{denom: tokenIn.Denom}}
+	for len(queue) > 0 && searchSteps < types.MaxRouteFinderSearchSteps && candidates < types.MaxRouteFinderCandidates {
+		cur := queue[0]
+		queue = queue[1:]
+		searchSteps++
+
+		for _, poolId := range graph.denomPools[cur.denom] {
+			if cur.containsPool(poolId) {
+				continue
+			}
+
+			for _, nextDenom := range graph.poolDenoms[poolId] {
+				if nextDenom == tokenIn.Denom || cur.containsDenom(nextDenom) {
+					continue
+				}
+
+				next := cur.extend(poolId, nextDenom)
+				if nextDenom != tokenOutDenom {
+					if uint64(len(next.routes)) < maxHops {
+						queue = append(queue, next)
+					}
+					continue
+				}
+
+				if candidates >= types.MaxRouteFinderCandidates {
+					continue
+				}
+				candidates++
+
+				estimate, err := k.MultihopEstimateOutGivenExactAmountIn(ctx, next.routes, tokenIn)
+				if err != nil {
+					continue
+				}
+				if estimate.GT(tokenOutAmount) {
+					routes, tokenOutAmount = next.routes, estimate
+				}
+			}
+		}
+	}
+
+	if routes == nil {
+		return nil, sdk.Int{}, types.NoRouteFoundError{TokenInDenom: tokenIn.Denom, TokenOutDenom: tokenOutDenom, MaxHops: maxHops}
+	}
+
+	return routes, tokenOutAmount, nil
+}
+
+// buildDenomGraph builds the denom graph of all active pools, in order of pool id.
+// Pools are linked to the denoms of their total liquidity, so pools that do not
+// report it are left out.
+func (k Keeper) buildDenomGraph(ctx sdk.Context) denomGraph {
+	graph := denomGraph{
+		denomPools: make(map[string][]uint64),
+		poolDenoms: make(map[uint64][]string),
+	}
+
+	nextPoolId := k.GetNextPoolId(ctx)
+	for poolId := uint64(1); poolId < nextPoolId; poolId++ {
+		_, pool, err := k.getPoolModuleAndPool(ctx, poolId)
+		if err != nil || !pool.IsActive(ctx) {
+			continue
+		}
+
+		liquidity := pool.GetTotalPoolLiquidity(ctx)
+		for _, coin := range liquidity {
+			graph.denomPools[coin.Denom] = append(graph.denomPools[coin.Denom], poolId)
+			graph.poolDenoms[poolId] = append(graph.poolDenoms[poolId], coin.Denom)
+		}
+	}
+
+	return graph
+}
+
+func (r partialRoute) containsPool(poolId uint64) bool {
+	for _, route := range r.routes {
+		if route.PoolId == poolId {
+			return true
+		}
+	}
+	return false
+}
+
+func (r partialRoute) containsDenom(denom string) bool {
+	for _, route := range r.routes {
+		if route.TokenOutDenom == denom {
+			return true
+		}
+	}
+	return false
+}
+
+// extend returns a copy of the route with a swap to denom through the given pool appended.
+func (r partialRoute) extend(poolId uint64, denom string) partialRoute {
+	routes := make([]types.SwapAmountInRoute, len(r.routes), len(r.routes)+1)
+	copy(routes, r.routes)
+	return partialRoute{
+		routes: append(routes, types.SwapAmountInRoute{PoolId: poolId, TokenOutDenom: denom}),
+		denom:  denom,
+	}
+}
@@ -0,0 +1,151 @@
+package swaprouter_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v13/x/swaprouter/types"
+)
+
+func (suite *KeeperTestSuite) TestFindOptimalRouteExactAmountIn() {
+	var (
+		shallowLiquidity = sdk.NewInt(1_000_000)
+		deepLiquidity    = sdk.NewInt(1_000_000_000_000)
+
+		// pool 1 is a shallow foo/bar pool, pools 2 and 3 are deep pools through uosmo.
+		shallowDirectPools = []sdk.Coins{
+			sdk.NewCoins(sdk.NewCoin(foo, shallowLiquidity), sdk.NewCoin(bar, shallowLiquidity)),
+			sdk.NewCoins(sdk.NewCoin(foo, deepLiquidity), sdk.NewCoin(uosmo, deepLiquidity)),
+			sdk.NewCoins(sdk.NewCoin(uosmo, deepLiquidity), sdk.NewCoin(bar, deepLiquidity)),
+		}
+		// pool 1 is a deep foo/bar pool, pools 2 and 3 are shallow pools through uosmo.
+		deepDirectPools = []sdk.Coins{
+			sdk.NewCoins(sdk.NewCoin(foo, deepLiquidity), sdk.NewCoin(bar, deepLiquidity)),
+			sdk.NewCoins(sdk.NewCoin(foo, shallowLiquidity), sdk.NewCoin(uosmo, shallowLiquidity)),
+			sdk.NewCoins(sdk.NewCoin(uosmo, shallowLiquidity), sdk.NewCoin(bar, shallowLiquidity)),
+		}
+	)
+
+	tests := map[string]struct {
+		poolCoins      []sdk.Coins
+		tokenIn        sdk.Coin
+		tokenOutDenom  string
+		maxHops        uint64
+		expectedRoutes []types.SwapAmountInRoute
+		expectedErr    error
+	}{
+		"deep multihop route beats shallow direct route": {
+			poolCoins:      shallowDirectPools,
+			tokenIn:        sdk.NewCoin(foo, sdk.NewInt(100_000)),
+			tokenOutDenom:  bar,
+			maxHops:        2,
+			expectedRoutes: []types.SwapAmountInRoute{{PoolId: 2, TokenOutDenom: uosmo}, {PoolId: 3, TokenOutDenom: bar}},
+		},
+		"deep direct route beats shallow multihop route": {
+			poolCoins:      deepDirectPools,
+			tokenIn:        sdk.NewCoin(foo, sdk.NewInt(100_000)),
+			tokenOutDenom:  bar,
+			maxHops:        2,
+			expectedRoutes: []types.SwapAmountInRoute{{PoolId: 1, TokenOutDenom: bar}},
+		},
+		"max hops restricts the routes considered": {
+			poolCoins:      shallowDirectPools,
+			tokenIn:        sdk.NewCoin(foo, sdk.NewInt(100_000)),
+			tokenOutDenom:  bar,
+			maxHops:        1,
+			expectedRoutes: []types.SwapAmountInRoute{{PoolId: 1, TokenOutDenom: bar}},
+		},
+		"single pool route to an intermediate denom": {
+			poolCoins:      shallowDirectPools,
+			tokenIn:        sdk.NewCoin(foo, sdk.NewInt(100_000)),
+			tokenOutDenom:  uosmo,
+			maxHops:        types.MaxRouteFinderHops,
+			expectedRoutes: []types.SwapAmountInRoute{{PoolId: 2, TokenOutDenom: uosmo}},
+		},
+		"no route to denom": {
+			poolCoins:     shallowDirectPools,
+			tokenIn:       sdk.NewCoin(foo, sdk.NewInt(100_000)),
+			tokenOutDenom: baz,
+			maxHops:       3,
+			expectedErr:   types.NoRouteFoundError{TokenInDenom: foo, TokenOutDenom: baz, MaxHops: 3},
+		},
+		"same token in and out denom": {
+			poolCoins:     shallowDirectPools,
+			tokenIn:       sdk.NewCoin(foo, sdk.NewInt(100_000)),
+			tokenOutDenom: foo,
+			maxHops:       3,
+			expectedErr:   types.ErrSameDenomRoute,
+		},
+		"zero max hops": {
+			poolCoins:     shallowDirectPools,
+			tokenIn:       sdk.NewCoin(foo, sdk.NewInt(100_000)),
+			tokenOutDenom: bar,
+			maxHops:       0,
+			expectedErr:   types.InvalidMaxHopsError{MaxHops: 0},
+		},
+		"max hops above cap": {
+			poolCoins:     shallowDirectPools,
+			tokenIn:       sdk.NewCoin(foo, sdk.NewInt(100_000)),
+			tokenOutDenom: bar,
+			maxHops:       types.MaxRouteFinderHops + 1,
+			expectedErr:   types.InvalidMaxHopsError{MaxHops: types.MaxRouteFinderHops + 1},
+		},
+		"zero token in": {
+			poolCoins:     shallowDirectPools,
+			tokenIn:       sdk.NewCoin(foo, sdk.ZeroInt()),
+			tokenOutDenom: bar,
+			maxHops:       3,
+			expectedErr:   types.ErrInvalidTokenIn,
+		},
+	}
+
+	for name, tc := range tests {
+		suite.Run(name, func() {
+			suite.SetupTest()
+			swaprouterKeeper := suite.App.SwapRouterKeeper
+
+			suite.createBalancerPoolsFromCoins(tc.poolCoins)
+
+			routes, tokenOutAmount, err := swaprouterKeeper.FindOptimalRouteExactAmountIn(suite.Ctx, tc.tokenIn, tc.tokenOutDenom, tc.maxHops)
+			if tc.expectedErr != nil {
+				suite.Require().ErrorIs(err, tc.expectedErr)
+				return
+			}
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.expectedRoutes, routes)
+
+			expectedTokenOutAmount, err := swaprouterKeeper.MultihopEstimateOutGivenExactAmountIn(suite.Ctx, routes, tc.tokenIn)
+			suite.Require().NoError(err)
+			suite.Require().Equal(expectedTokenOutAmount, tokenOutAmount)
+		})
+	}
+}
+
+// TestFindOptimalRoutesExactAmountIn tests that the routes found for several tokens in
+// are the ones found for each of them alone, and are nil for tokens without a route.
+func (suite *KeeperTestSuite) TestFindOptimalRoutesExactAmountIn() {
+	suite.SetupTest()
+	swaprouterKeeper := suite.App.SwapRouterKeeper
+	liquidity := sdk.NewInt(1_000_000_000)
+	suite.createBalancerPoolsFromCoins([]sdk.Coins{
+		sdk.NewCoins(sdk.NewCoin(foo, liquidity), sdk.NewCoin(uosmo, liquidity)),
+		sdk.NewCoins(sdk.NewCoin(uosmo, liquidity), sdk.NewCoin(bar, liquidity)),
+	})
+
+	tokensIn := []sdk.Coin{
+		sdk.NewCoin(foo, sdk.NewInt(100_000)),
+		sdk.NewCoin(uosmo, sdk.NewInt(100_000)),
+		sdk.NewCoin(baz, sdk.NewInt(100_000)),
+		sdk.NewCoin(bar, sdk.NewInt(100_000)),
+	}
+	routes, err := swaprouterKeeper.FindOptimalRoutesExactAmountIn(suite.Ctx, tokensIn, bar, 2)
+	suite.Require().NoError(err)
+	suite.Require().Equal([][]types.SwapAmountInRoute{
+		{{PoolId: 1, TokenOutDenom: uosmo}, {PoolId: 2, TokenOutDenom: bar}},
+		{{PoolId: 2, TokenOutDenom: bar}},
+		nil,
+		nil,
+	}, routes)
+
+	_, err = swaprouterKeeper.FindOptimalRoutesExactAmountIn(suite.Ctx, tokensIn, bar, 0)
+	suite.Require().ErrorIs(err, types.InvalidMaxHopsError{MaxHops: 0})
+}
@@ -2,7 +2,10 @@ package swaprouter
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
+	appparams "github.com/osmosis-labs/osmosis/v13/app/params"
+	"github.com/osmosis-labs/osmosis/v13/x/swaprouter/events"
 	"github.com/osmosis-labs/osmosis/v13/x/swaprouter/types"
 )
 
@@ -15,7 +18,65 @@ func (k Keeper) RouteExactAmountIn(
 	routes []types.SwapAmountInRoute,
 	tokenIn sdk.Coin,
 	tokenOutMinAmount sdk.Int) (tokenOutAmount sdk.Int, err error) {
-	panic("not implemented")
+	var (
+		isMultiHopRouted bool
+		routeSwapFee     sdk.Dec
+		sumOfSwapFees    sdk.Dec
+	)
+
+	route := types.SwapAmountInRoutes(routes)
+	if err := route.Validate(); err != nil {
+		return sdk.Int{}, err
+	}
+
+	// In this loop, we check if:
+	// - the route is of length 2
+	// - route 1 and route 2 don't trade via the same pool
+	// - route 1 contains uosmo
+	// - both route 1 and route 2 are incentivized pools
+	//
+	// If all of the above is true, then we collect the additive and max fee between the
+	// two pools to later calculate the following:
+	// total_swap_fee = total_swap_fee = max(swapfee1, swapfee2)
+	// fee_per_pool = total_swap_fee * ((pool_fee) / (swapfee1 + swapfee2))
+	if k.isOsmoRoutedMultihop(ctx, route, routes[0].TokenOutDenom, tokenIn.Denom) {
+		isMultiHopRouted = true
+		routeSwapFee, sumOfSwapFees, err = k.getOsmoRoutedMultihopTotalSwapFee(ctx, route)
+		if err != nil {
+			return sdk.Int{}, err
+		}
+	}
+
+	for i, route := range routes {
+		// To prevent the multihop swap from being interrupted prematurely, we keep
+		// the minimum expected output at a very low number until the last pool
+		_outMinAmount := sdk.NewInt(1)
+		if len(routes)-1 == i {
+			_outMinAmount = tokenOutMinAmount
+		}
+
+		swapModule, pool, err := k.getPoolModuleAndPool(ctx, route.PoolId)
+		if err != nil {
+			return sdk.Int{}, err
+		}
+
+		swapFee := pool.GetSwapFee(ctx)
+
+		// If we determined the route is an osmo multi-hop and both routes are incentivized,
+		// we modify the swap fee accordingly.
+		if isMultiHopRouted {
+			swapFee = routeSwapFee.Mul((swapFee.Quo(sumOfSwapFees)))
+		}
+
+		tokenOutAmount, err = swapModule.SwapExactAmountIn(ctx, sender, pool, tokenIn, route.TokenOutDenom, _outMinAmount, swapFee)
+		if err != nil {
+			return sdk.Int{}, err
+		}
+
+		// Chain output of current pool as the input for the next routed pool
+		tokenIn = sdk.NewCoin(route.TokenOutDenom, tokenOutAmount)
+	}
+	return tokenOutAmount, err
 }
 
 func (k Keeper) MultihopEstimateOutGivenExactAmountIn(
@@ -23,10 +84,56 @@ func (k Keeper) MultihopEstimateOutGivenExactAmountIn(
 	routes []types.SwapAmountInRoute,
 	tokenIn sdk.Coin,
 ) (tokenOutAmount sdk.Int, err error) {
-	panic("not implemented")
+	var (
+		isMultiHopRouted bool
+		routeSwapFee     sdk.Dec
+		sumOfSwapFees    sdk.Dec
+	)
+
+	route := types.SwapAmountInRoutes(routes)
+	if err := route.Validate(); err != nil {
+		return sdk.Int{}, err
+	}
+
+	if k.isOsmoRoutedMultihop(ctx, route, routes[0].TokenOutDenom, tokenIn.Denom) {
+		isMultiHopRouted = true
+		routeSwapFee, sumOfSwapFees, err = k.getOsmoRoutedMultihopTotalSwapFee(ctx, route)
+		if err != nil {
+			return sdk.Int{}, err
+		}
+	}
+
+	for _, route := range routes {
+		swapModule, pool, err := k.getPoolModuleAndPool(ctx, route.PoolId)
+		if err != nil {
+			return sdk.Int{}, err
+		}
+
+		swapFee := pool.GetSwapFee(ctx)
+
+		// If we determined the route is an osmo multi-hop and both routes are incentivized,
+		// we modify the swap fee accordingly.
+		if isMultiHopRouted {
+			swapFee = routeSwapFee.Mul((swapFee.Quo(sumOfSwapFees)))
+		}
+
+		tokenOut, err := swapModule.CalcOutAmtGivenIn(ctx, pool, tokenIn, route.TokenOutDenom, swapFee)
+		if err != nil {
+			return sdk.Int{}, err
+		}
+
+		tokenOutAmount = tokenOut.Amount
+		if !tokenOutAmount.IsPositive() {
+			return sdk.Int{}, sdkerrors.Wrapf(types.ErrInvalidMathApprox, "token amount must be positive")
+		}
+
+		// Chain output of current pool as the input for the next routed pool
+		tokenIn = sdk.NewCoin(route.TokenOutDenom, tokenOutAmount)
+	}
+	return tokenOutAmount, err
 }
 
-// MultihopSwapExactAmountOut defines the output denom and output amount for the last pool.
+// RouteExactAmountOut defines the output denom and output amount for the last pool.
 // Calculation starts by providing the tokenOutAmount of the final pool to calculate the required tokenInAmount
 // the calculated tokenInAmount is used as defined tokenOutAmount of the previous pool, calculating in reverse order of the swap
 // Transaction succeeds if the calculated tokenInAmount of the first pool is less than the defined tokenInMaxAmount defined.
@@ -36,7 +143,82 @@ func (k Keeper) RouteExactAmountOut(ctx sdk.Context,
 	tokenInMaxAmount sdk.Int,
 	tokenOut sdk.Coin,
 ) (tokenInAmount sdk.Int, err error) {
-	panic("not implemented")
+	isMultiHopRouted, routeSwapFee, sumOfSwapFees := false, sdk.Dec{}, sdk.Dec{}
+	route := types.SwapAmountOutRoutes(routes)
+	if err := route.Validate(); err != nil {
+		return sdk.Int{}, err
+	}
+
+	// in this loop, we check if:
+	// - the route is of length 2
+	// - route 1 and route 2 don't trade via the same pool
+	// - route 1 contains uosmo
+	// - both route 1 and route 2 are incentivized pools
+	// if all of the above is true, then we collect the additive and max fee between the two pools to later calculate the following:
+	// total_swap_fee = total_swap_fee = max(swapfee1, swapfee2)
+	// fee_per_pool = total_swap_fee * ((pool_fee) / (swapfee1 + swapfee2))
+	if k.isOsmoRoutedMultihop(ctx, route, routes[0].TokenInDenom, tokenOut.Denom) {
+		isMultiHopRouted = true
+		routeSwapFee, sumOfSwapFees, err = k.getOsmoRoutedMultihopTotalSwapFee(ctx, route)
+		if err != nil {
+			return sdk.Int{}, err
+		}
+	}
+
+	// Determine what the estimated input would be for each pool along the multi-hop route
+	// if we determined the route is an osmo multi-hop and both routes are incentivized,
+	// we utilize a separate function that calculates the discounted swap fees
+	var insExpected []sdk.Int
+	if isMultiHopRouted {
+		insExpected, err = k.createOsmoMultihopExpectedSwapOuts(ctx, routes, tokenOut, routeSwapFee, sumOfSwapFees)
+	} else {
+		insExpected, err = k.createMultihopExpectedSwapOuts(ctx, routes, tokenOut)
+	}
+	if err != nil {
+		return sdk.Int{}, err
+	}
+	if len(insExpected) == 0 {
+		return sdk.Int{}, nil
+	}
+
+	insExpected[0] = tokenInMaxAmount
+
+	// Iterates through each routed pool and executes their respective swaps. Note that all of the work to get the return
+	// value of this method is done when we calculate insExpected – this for loop primarily serves to execute the actual
+	// swaps on each pool.
+	for i, route := range routes {
+		_tokenOut := tokenOut
+
+		// If there is one pool left in the route, set the expected output of the current swap
+		// to the estimated input of the final pool.
+		if i != len(routes)-1 {
+			_tokenOut = sdk.NewCoin(routes[i+1].TokenInDenom, insExpected[i+1])
+		}
+
+		swapModule, pool, err := k.getPoolModuleAndPool(ctx, route.PoolId)
+		if err != nil {
+			return sdk.Int{}, err
+		}
+
+		swapFee := pool.GetSwapFee(ctx)
+		if isMultiHopRouted {
+			swapFee = routeSwapFee.Mul((swapFee.Quo(sumOfSwapFees)))
+		}
+
+		_tokenInAmount, swapErr := swapModule.SwapExactAmountOut(ctx, sender, pool, route.TokenInDenom, insExpected[i], _tokenOut, swapFee)
+		if swapErr != nil {
+			return sdk.Int{}, swapErr
+		}
+
+		// Sets the final amount of tokens that need to be input into the first pool. Even though this is the final return value for the
+		// whole method and will not change after the first iteration, we still iterate through the rest of the pools to execute their respective
+		// swaps.
+		if i == 0 {
+			tokenInAmount = _tokenInAmount
+		}
+	}
+
+	return tokenInAmount, nil
 }
 
 func (k Keeper) MultihopEstimateInGivenExactAmountOut(
@@ -44,5 +226,289 @@ func (k Keeper) MultihopEstimateInGivenExactAmountOut(
 	routes []types.SwapAmountOutRoute,
 	tokenOut sdk.Coin,
 ) (tokenInAmount sdk.Int, err error) {
-	panic("not implemented")
+	isMultiHopRouted, routeSwapFee, sumOfSwapFees := false, sdk.Dec{}, sdk.Dec{}
+	route := types.SwapAmountOutRoutes(routes)
+	if err := route.Validate(); err != nil {
+		return sdk.Int{}, err
+	}
+
+	if k.isOsmoRoutedMultihop(ctx, route, routes[0].TokenInDenom, tokenOut.Denom) {
+		isMultiHopRouted = true
+		routeSwapFee, sumOfSwapFees, err = k.getOsmoRoutedMultihopTotalSwapFee(ctx, route)
+		if err != nil {
+			return sdk.Int{}, err
+		}
+	}
+
+	// Determine what the estimated input would be for each pool along the multi-hop route
+	// if we determined the route is an osmo multi-hop and both routes are incentivized,
+	// we utilize a separate function that calculates the discounted swap fees
+	var insExpected []sdk.Int
+	if isMultiHopRouted {
+		insExpected, err = k.createOsmoMultihopExpectedSwapOuts(ctx, routes, tokenOut, routeSwapFee, sumOfSwapFees)
+	} else {
+		insExpected, err = k.createMultihopExpectedSwapOuts(ctx, routes, tokenOut)
+	}
+	if err != nil {
+		return sdk.Int{}, err
+	}
+	if len(insExpected) == 0 {
+		return sdk.Int{}, nil
+	}
+
+	return insExpected[0], nil
+}
+
+// SplitRouteExactAmountIn swaps tokenInDenom across several multihop routes, each
+// swapping its own amount in, and returns the aggregate amount out of all routes.
+// Every route must swap to the same denom. Only the aggregate amount out is checked
+// against tokenOutMinAmount, so a single route may give a worse price than the others.
+func (k Keeper) SplitRouteExactAmountIn(
+	ctx sdk.Context,
+	sender sdk.AccAddress,
+	routes []types.SwapAmountInSplitRoute,
+	tokenInDenom string,
+	tokenOutMinAmount sdk.Int,
+) (tokenOutAmount sdk.Int, err error) {
+	if err := types.SwapAmountInSplitRoutes(routes).Validate(); err != nil {
+		return sdk.Int{}, err
+	}
+
+	tokenOutAmount = sdk.ZeroInt()
+	for i, route := range routes {
+		tokenIn := sdk.NewCoin(tokenInDenom, route.TokenInAmount)
+
+		// The minimum amount out is only enforced on the aggregate below, so each
+		// route just has to give a positive amount out.
+		routeTokenOutAmount, err := k.RouteExactAmountIn(ctx, sender, route.Pools, tokenIn, sdk.OneInt())
+		if err != nil {
+			return sdk.Int{}, err
+		}
+
+		tokenOut := sdk.NewCoin(route.TokenOutDenom(), routeTokenOutAmount)
+		events.EmitSplitRouteSwapEvent(ctx, sender, i, types.SwapAmountInRoutes(route.Pools).PoolIds(), tokenIn, tokenOut)
+
+		tokenOutAmount = tokenOutAmount.Add(routeTokenOutAmount)
+	}
+
+	if tokenOutAmount.LT(tokenOutMinAmount) {
+		return sdk.Int{}, types.AmountLessThanMinError{TokenAmount: tokenOutAmount, TokenMin: tokenOutMinAmount}
+	}
+
+	return tokenOutAmount, nil
+}
+
+// SplitRouteExactAmountOut swaps for tokenOutDenom across several multihop routes, each
+// swapping for its own amount out, and returns the aggregate amount in of all routes.
+// Every route must swap from the same denom. Only the aggregate amount in is checked
+// against tokenInMaxAmount.
+func (k Keeper) SplitRouteExactAmountOut(
+	ctx sdk.Context,
+	sender sdk.AccAddress,
+	routes []types.SwapAmountOutSplitRoute,
+	tokenOutDenom string,
+	tokenInMaxAmount sdk.Int,
+) (tokenInAmount sdk.Int, err error) {
+	if err := types.SwapAmountOutSplitRoutes(routes).Validate(); err != nil {
+		return sdk.Int{}, err
+	}
+
+	tokenInAmount = sdk.ZeroInt()
+	for i, route := range routes {
+		tokenOut := sdk.NewCoin(tokenOutDenom, route.TokenOutAmount)
+
+		// No single route can take more than the aggregate maximum, which is
+		// enforced once all routes are swapped.
+		routeTokenInAmount, err := k.RouteExactAmountOut(ctx, sender, route.Pools, tokenInMaxAmount, tokenOut)
+		if err != nil {
+			return sdk.Int{}, err
+		}
+
+		tokenIn := sdk.NewCoin(route.TokenInDenom(), routeTokenInAmount)
+		events.EmitSplitRouteSwapEvent(ctx, sender, i, types.SwapAmountOutRoutes(route.Pools).PoolIds(), tokenIn, tokenOut)
+
+		tokenInAmount = tokenInAmount.Add(routeTokenInAmount)
+	}
+
+	if tokenInAmount.GT(tokenInMaxAmount) {
+		return sdk.Int{}, types.AmountGreaterThanMaxError{TokenAmount: tokenInAmount, TokenMax: tokenInMaxAmount}
+	}
+
+	return tokenInAmount, nil
+}
+
+// SplitRouteEstimateOutGivenExactAmountIn estimates the aggregate amount out of a split
+// route swap, along with the amount out of each route. Each route is estimated against
+// the current pool state, so routes that share a pool are estimated independently of
+// one another.
+func (k Keeper) SplitRouteEstimateOutGivenExactAmountIn(
+	ctx sdk.Context,
+	routes []types.SwapAmountInSplitRoute,
+	tokenInDenom string,
+) (tokenOutAmount sdk.Int, routeTokenOutAmounts []sdk.Int, err error) {
+	if err := types.SwapAmountInSplitRoutes(routes).Validate(); err != nil {
+		return sdk.Int{}, nil, err
+	}
+
+	tokenOutAmount = sdk.ZeroInt()
+	routeTokenOutAmounts = make([]sdk.Int, 0, len(routes))
+	for _, route := range routes {
+		routeTokenOutAmount, err := k.MultihopEstimateOutGivenExactAmountIn(ctx, route.Pools, sdk.NewCoin(tokenInDenom, route.TokenInAmount))
+		if err != nil {
+			return sdk.Int{}, nil, err
+		}
+
+		routeTokenOutAmounts = append(routeTokenOutAmounts, routeTokenOutAmount)
+		tokenOutAmount = tokenOutAmount.Add(routeTokenOutAmount)
+	}
+
+	return tokenOutAmount, routeTokenOutAmounts, nil
+}
+
+// SplitRouteEstimateInGivenExactAmountOut estimates the aggregate amount in of a split
+// route swap, along with the amount in of each route. As with
+// SplitRouteEstimateOutGivenExactAmountIn, each route is estimated independently.
+func (k Keeper) SplitRouteEstimateInGivenExactAmountOut(
+	ctx sdk.Context,
+	routes []types.SwapAmountOutSplitRoute,
+	tokenOutDenom string,
+) (tokenInAmount sdk.Int, routeTokenInAmounts []sdk.Int, err error) {
+	if err := types.SwapAmountOutSplitRoutes(routes).Validate(); err != nil {
+		return sdk.Int{}, nil, err
+	}
+
+	tokenInAmount = sdk.ZeroInt()
+	routeTokenInAmounts = make([]sdk.Int, 0, len(routes))
+	for _, route := range routes {
+		routeTokenInAmount, err := k.MultihopEstimateInGivenExactAmountOut(ctx, route.Pools, sdk.NewCoin(tokenOutDenom, route.TokenOutAmount))
+		if err != nil {
+			return sdk.Int{}, nil, err
+		}
+
+		routeTokenInAmounts = append(routeTokenInAmounts, routeTokenInAmount)
+		tokenInAmount = tokenInAmount.Add(routeTokenInAmount)
+	}
+
+	return tokenInAmount, routeTokenInAmounts, nil
+}
+
+// getPoolModuleAndPool returns the swap module routed to for the given pool id,
+// together with the pool itself as returned by that module.
+func (k Keeper) getPoolModuleAndPool(ctx sdk.Context, poolId uint64) (types.SwapI, types.PoolI, error) {
+	swapModule, err := k.GetPoolModule(ctx, poolId)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	pool, err := swapModule.GetPool(ctx, poolId)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return swapModule, pool, nil
+}
+
+func (k Keeper) isOsmoRoutedMultihop(ctx sdk.Context, route types.MultihopRoute, inDenom, outDenom string) (isRouted bool) {
+	if route.Length() != 2 {
+		return false
+	}
+	intemediateDenoms := route.IntermediateDenoms()
+	if len(intemediateDenoms) != 1 || intemediateDenoms[0] != appparams.BaseCoinUnit {
+		return false
+	}
+	if inDenom == outDenom {
+		return false
+	}
+	poolIds := route.PoolIds()
+	if poolIds[0] == poolIds[1] {
+		return false
+	}
+
+	route0Incentivized := k.poolIncentivesKeeper.IsPoolIncentivized(ctx, poolIds[0])
+	route1Incentivized := k.poolIncentivesKeeper.IsPoolIncentivized(ctx, poolIds[1])
+
+	return route0Incentivized && route1Incentivized
+}
+
+// getOsmoRoutedMultihopTotalSwapFee returns the total swap fee of an osmo-routed multihop,
+// and the sum of the swap fees of its pools, by which each pool's swap fee is discounted.
+// Pools that send a taker fee share of their swap fee to a recipient have it discounted alike,
+// as the taker fee is charged as a share of the discounted swap fee passed to the swap module.
+func (k Keeper) getOsmoRoutedMultihopTotalSwapFee(ctx sdk.Context, route types.MultihopRoute) (
+	totalPathSwapFee sdk.Dec, sumOfSwapFees sdk.Dec, err error) {
+	additiveSwapFee := sdk.ZeroDec()
+	maxSwapFee := sdk.ZeroDec()
+
+	for _, poolId := range route.PoolIds() {
+		_, pool, poolErr := k.getPoolModuleAndPool(ctx, poolId)
+		if poolErr != nil {
+			return sdk.Dec{}, sdk.Dec{}, poolErr
+		}
+		swapFee := pool.GetSwapFee(ctx)
+		additiveSwapFee = additiveSwapFee.Add(swapFee)
+		maxSwapFee = sdk.MaxDec(maxSwapFee, swapFee)
+	}
+	averageSwapFee := additiveSwapFee.QuoInt64(2)
+	maxSwapFee = sdk.MaxDec(maxSwapFee, averageSwapFee)
+	return maxSwapFee, additiveSwapFee, nil
+}
+
+// createMultihopExpectedSwapOuts defines the output denom and output amount for the last pool in
+// the route of pools the caller is intending to hop through in a fixed-output multihop tx. It estimates the input
+// amount for this last pool and then chains that input as the output of the previous pool in the route, repeating
+// until the first pool is reached. It returns an array of inputs, each of which correspond to a pool ID in the
+// route of pools for the original multihop transaction.
+func (k Keeper) createMultihopExpectedSwapOuts(
+	ctx sdk.Context,
+	routes []types.SwapAmountOutRoute,
+	tokenOut sdk.Coin,
+) ([]sdk.Int, error) {
+	insExpected := make([]sdk.Int, len(routes))
+	for i := len(routes) - 1; i >= 0; i-- {
+		route := routes[i]
+
+		swapModule, pool, err := k.getPoolModuleAndPool(ctx, route.PoolId)
+		if err != nil {
+			return nil, err
+		}
+
+		tokenIn, err := swapModule.CalcInAmtGivenOut(ctx, pool, tokenOut, route.TokenInDenom, pool.GetSwapFee(ctx))
+		if err != nil {
+			return nil, err
+		}
+
+		insExpected[i] = tokenIn.Amount
+		tokenOut = tokenIn
+	}
+
+	return insExpected, nil
+}
+
+// createOsmoMultihopExpectedSwapOuts does the same as createMultihopExpectedSwapOuts, however discounts the swap fee
+func (k Keeper) createOsmoMultihopExpectedSwapOuts(
+	ctx sdk.Context,
+	routes []types.SwapAmountOutRoute,
+	tokenOut sdk.Coin,
+	cumulativeRouteSwapFee, sumOfSwapFees sdk.Dec,
+) ([]sdk.Int, error) {
+	insExpected := make([]sdk.Int, len(routes))
+	for i := len(routes) - 1; i >= 0; i-- {
+		route := routes[i]
+
+		swapModule, pool, err := k.getPoolModuleAndPool(ctx, route.PoolId)
+		if err != nil {
+			return nil, err
+		}
+
+		swapFee := pool.GetSwapFee(ctx)
+		tokenIn, err := swapModule.CalcInAmtGivenOut(ctx, pool, tokenOut, route.TokenInDenom, cumulativeRouteSwapFee.Mul((swapFee.Quo(sumOfSwapFees))))
+		if err != nil {
+			return nil, err
+		}
+
+		insExpected[i] = tokenIn.Amount
+		tokenOut = tokenIn
+	}
+
+	return insExpected, nil
 }
@@ -5,6 +5,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	cl "github.com/osmosis-labs/osmosis/v13/x/concentrated-liquidity"
 	gamm "github.com/osmosis-labs/osmosis/v13/x/gamm/keeper"
 	"github.com/osmosis-labs/osmosis/v13/x/gamm/pool-models/balancer"
 	poolincentivestypes "github.com/osmosis-labs/osmosis/v13/x/pool-incentives/types"
@@ -19,12 +20,708 @@ const (
 )
 
 var (
-	defaultInitPoolAmount = sdk.NewInt(1000000000000)
-	defaultPoolSwapFee    = sdk.NewDecWithPrec(1, 2) // 1% pool swap fee default
-	defaultSwapAmount     = sdk.NewInt(1000000)
-	gammKeeperType        = reflect.TypeOf(&gamm.Keeper{})
+	defaultInitPoolAmount  = sdk.NewInt(1000000000000)
+	defaultPoolSwapFee     = sdk.NewDecWithPrec(1, 2) // 1% pool swap fee default
+	defaultSwapAmount      = sdk.NewInt(1000000)
+	gammKeeperType         = reflect.TypeOf(&gamm.Keeper{})
+	concentratedKeeperType = reflect.TypeOf(&cl.Keeper{})
+
+	fooCoin   = sdk.NewCoin(foo, sdk.NewInt(1000000000))
+	barCoin   = sdk.NewCoin(bar, sdk.NewInt(1000000000))
+	bazCoin   = sdk.NewCoin(baz, sdk.NewInt(1000000000))
+	uosmoCoin = sdk.NewCoin(uosmo, sdk.NewInt(1000000000))
 )
 
+// TestRouteExactAmountIn tests that a multihop swap gives the same amount of token out as
+// swapping through each pool of the route separately, with the osmo routed swap fee discount applied
+// when the route qualifies for it.
+func (suite *KeeperTestSuite) TestRouteExactAmountIn() {
+	type param struct {
+		routes             []types.SwapAmountInRoute
+		incentivizedGauges []uint64
+		fourAssetPools     int
+		poolAssets         []sdk.Coins
+		poolFee            []sdk.Dec
+		tokenIn            sdk.Coin
+		tokenOutMinAmount  sdk.Int
+	}
+
+	poolDefaultSwapFee := sdk.NewDecWithPrec(1, 2) // 1% pool swap fee default
+
+	tests := []struct {
+		name                    string
+		param                   param
+		expectPass              bool
+		expectReducedFeeApplied bool
+	}{
+		{
+			name: "Swap - [foo -> bar](pool 1) - [bar -> baz](pool 2), both pools 1 percent fee",
+			param: param{
+				routes: []types.SwapAmountInRoute{
+					{
+						PoolId:        1,
+						TokenOutDenom: "bar",
+					},
+					{
+						PoolId:        2,
+						TokenOutDenom: "baz",
+					},
+				},
+				incentivizedGauges: []uint64{},
+				poolAssets:         []sdk.Coins{sdk.NewCoins(fooCoin, barCoin), sdk.NewCoins(barCoin, bazCoin)},
+				poolFee:            []sdk.Dec{poolDefaultSwapFee, poolDefaultSwapFee},
+				tokenIn:            sdk.NewCoin("foo", sdk.NewInt(100000)),
+				tokenOutMinAmount:  sdk.NewInt(1),
+			},
+			expectPass: true,
+		},
+		{
+			name: "Swap - [foo -> uosmo](pool 1) - [uosmo -> baz](pool 2) with a half fee applied, both pools 1 percent fee",
+			param: param{
+				routes: []types.SwapAmountInRoute{
+					{
+						PoolId:        1,
+						TokenOutDenom: "uosmo",
+					},
+					{
+						PoolId:        2,
+						TokenOutDenom: "baz",
+					},
+				},
+				incentivizedGauges: []uint64{1, 2, 3, 4, 5, 6},
+				poolAssets:         []sdk.Coins{sdk.NewCoins(fooCoin, uosmoCoin), sdk.NewCoins(uosmoCoin, bazCoin)},
+				poolFee:            []sdk.Dec{poolDefaultSwapFee, poolDefaultSwapFee},
+				tokenIn:            sdk.NewCoin("foo", sdk.NewInt(100000)),
+				tokenOutMinAmount:  sdk.NewInt(1),
+			},
+			expectReducedFeeApplied: true,
+			expectPass:              true,
+		},
+		{
+			name: "Swap - [foo -> uosmo](pool 1) - [uosmo -> baz](pool 2) with a half fee applied, (pool 1) 1 percent fee, (pool 2) 10 percent fee",
+			param: param{
+				routes: []types.SwapAmountInRoute{
+					{
+						PoolId:        1,
+						TokenOutDenom: "uosmo",
+					},
+					{
+						PoolId:        2,
+						TokenOutDenom: "baz",
+					},
+				},
+				incentivizedGauges: []uint64{1, 2, 3, 4, 5, 6},
+				poolAssets:         []sdk.Coins{sdk.NewCoins(fooCoin, uosmoCoin), sdk.NewCoins(uosmoCoin, bazCoin)},
+				poolFee:            []sdk.Dec{poolDefaultSwapFee, sdk.NewDecWithPrec(1, 1)},
+				tokenIn:            sdk.NewCoin("foo", sdk.NewInt(100000)),
+				tokenOutMinAmount:  sdk.NewInt(1),
+			},
+			expectReducedFeeApplied: true,
+			expectPass:              true,
+		},
+		{
+			name: "Swap - [foo -> uosmo](pool 1) - [uosmo -> baz](pool 2) - [baz -> bar](pool 3), all pools 1 percent fee",
+			param: param{
+				routes: []types.SwapAmountInRoute{
+					{
+						PoolId:        1,
+						TokenOutDenom: "uosmo",
+					},
+					{
+						PoolId:        2,
+						TokenOutDenom: "baz",
+					},
+					{
+						PoolId:        3,
+						TokenOutDenom: "bar",
+					},
+				},
+				incentivizedGauges: []uint64{1, 2, 3, 4, 5, 6},
+				poolAssets:         []sdk.Coins{sdk.NewCoins(fooCoin, uosmoCoin), sdk.NewCoins(uosmoCoin, bazCoin), sdk.NewCoins(bazCoin, barCoin)},
+				poolFee:            []sdk.Dec{poolDefaultSwapFee, poolDefaultSwapFee, poolDefaultSwapFee},
+				tokenIn:            sdk.NewCoin("foo", sdk.NewInt(100000)),
+				tokenOutMinAmount:  sdk.NewInt(1),
+			},
+			expectReducedFeeApplied: false,
+			expectPass:              true,
+		},
+		{
+			name: "Swap between four asset pools - [foo -> bar](pool 1) - [bar -> baz](pool 2), all pools 1 percent fee",
+			param: param{
+				routes: []types.SwapAmountInRoute{
+					{
+						PoolId:        1,
+						TokenOutDenom: "bar",
+					},
+					{
+						PoolId:        2,
+						TokenOutDenom: "baz",
+					},
+				},
+				fourAssetPools:     2,
+				incentivizedGauges: []uint64{1, 2, 3, 4, 5, 6},
+				poolFee:            []sdk.Dec{poolDefaultSwapFee, poolDefaultSwapFee},
+				tokenIn:            sdk.NewCoin("foo", sdk.NewInt(100000)),
+				tokenOutMinAmount:  sdk.NewInt(1),
+			},
+			expectReducedFeeApplied: false,
+			expectPass:              true,
+		},
+		{
+			name: "Swap between four asset pools - [foo -> uosmo](pool 1) - [uosmo -> baz](pool 2), with a half fee applied, both pools 1 percent fee",
+			param: param{
+				routes: []types.SwapAmountInRoute{
+					{
+						PoolId:        1,
+						TokenOutDenom: "uosmo",
+					},
+					{
+						PoolId:        2,
+						TokenOutDenom: "baz",
+					},
+				},
+				fourAssetPools:     2,
+				incentivizedGauges: []uint64{1, 2, 3, 4, 5, 6},
+				poolFee:            []sdk.Dec{poolDefaultSwapFee, poolDefaultSwapFee},
+				tokenIn:            sdk.NewCoin("foo", sdk.NewInt(100000)),
+				tokenOutMinAmount:  sdk.NewInt(1),
+			},
+			expectReducedFeeApplied: true,
+			expectPass:              true,
+		},
+		{
+			name: "Swap between four asset pools - [foo -> uosmo](pool 1) - [uosmo -> baz](pool 2) - [baz -> bar](pool 3), all pools 1 percent fee",
+			param: param{
+				routes: []types.SwapAmountInRoute{
+					{
+						PoolId:        1,
+						TokenOutDenom: "uosmo",
+					},
+					{
+						PoolId:        2,
+						TokenOutDenom: "baz",
+					},
+					{
+						PoolId:        3,
+						TokenOutDenom: "bar",
+					},
+				},
+				fourAssetPools:     3,
+				incentivizedGauges: []uint64{1, 2, 3, 4, 5, 6, 7, 8, 9},
+				poolFee:            []sdk.Dec{poolDefaultSwapFee, poolDefaultSwapFee, poolDefaultSwapFee},
+				tokenIn:            sdk.NewCoin("foo", sdk.NewInt(100000)),
+				tokenOutMinAmount:  sdk.NewInt(1),
+			},
+			expectReducedFeeApplied: false,
+			expectPass:              true,
+		},
+		{
+			name: "Swap - [foo -> bar](pool 1) - [bar -> baz](pool 3), pool 3 does not exist",
+			param: param{
+				routes: []types.SwapAmountInRoute{
+					{
+						PoolId:        1,
+						TokenOutDenom: "bar",
+					},
+					{
+						PoolId:        3,
+						TokenOutDenom: "baz",
+					},
+				},
+				incentivizedGauges: []uint64{},
+				poolAssets:         []sdk.Coins{sdk.NewCoins(fooCoin, barCoin), sdk.NewCoins(barCoin, bazCoin)},
+				poolFee:            []sdk.Dec{poolDefaultSwapFee, poolDefaultSwapFee},
+				tokenIn:            sdk.NewCoin("foo", sdk.NewInt(100000)),
+				tokenOutMinAmount:  sdk.NewInt(1),
+			},
+			expectPass: false,
+		},
+		{
+			name: "Swap - [foo -> bar](pool 1) - [bar -> uosmo](pool 2), uosmo is not in pool 2",
+			param: param{
+				routes: []types.SwapAmountInRoute{
+					{
+						PoolId:        1,
+						TokenOutDenom: "bar",
+					},
+					{
+						PoolId:        2,
+						TokenOutDenom: "uosmo",
+					},
+				},
+				incentivizedGauges: []uint64{},
+				poolAssets:         []sdk.Coins{sdk.NewCoins(fooCoin, barCoin), sdk.NewCoins(barCoin, bazCoin)},
+				poolFee:            []sdk.Dec{poolDefaultSwapFee, poolDefaultSwapFee},
+				tokenIn:            sdk.NewCoin("foo", sdk.NewInt(100000)),
+				tokenOutMinAmount:  sdk.NewInt(1),
+			},
+			expectPass: false,
+		},
+		{
+			name: "Swap - [foo -> bar](pool 1) - [bar -> baz](pool 2), token out less than the minimum amount out",
+			param: param{
+				routes: []types.SwapAmountInRoute{
+					{
+						PoolId:        1,
+						TokenOutDenom: "bar",
+					},
+					{
+						PoolId:        2,
+						TokenOutDenom: "baz",
+					},
+				},
+				incentivizedGauges: []uint64{},
+				poolAssets:         []sdk.Coins{sdk.NewCoins(fooCoin, barCoin), sdk.NewCoins(barCoin, bazCoin)},
+				poolFee:            []sdk.Dec{poolDefaultSwapFee, poolDefaultSwapFee},
+				tokenIn:            sdk.NewCoin("foo", sdk.NewInt(100000)),
+				tokenOutMinAmount:  sdk.NewInt(100000),
+			},
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		suite.SetupTest()
+
+		suite.Run(test.name, func() {
+			swaprouterKeeper := suite.App.SwapRouterKeeper
+
+			// Create two asset pools with desired swap fee, if specified. Fund acc with respective coins
+			for i, coins := range test.param.poolAssets {
+				suite.PrepareCustomBalancerPoolFromCoins(coins, balancer.PoolParams{
+					SwapFee: test.param.poolFee[i],
+					ExitFee: sdk.NewDec(0),
+				})
+				suite.FundAcc(suite.TestAccs[0], coins)
+			}
+
+			// Create four asset pools with desired swap fee, if specified.
+			for i := 0; i < test.param.fourAssetPools; i++ {
+				suite.PrepareBalancerPoolWithPoolParams(balancer.PoolParams{
+					SwapFee: test.param.poolFee[i],
+					ExitFee: sdk.NewDec(0),
+				})
+			}
+
+			// if test specifies incentivized gauges, set them here
+			if len(test.param.incentivizedGauges) > 0 {
+				suite.makeGaugesIncentivized(test.param.incentivizedGauges)
+			}
+
+			// calcOutAmountAsSeparateSwaps calculates the multi-hop swap as separate swaps, while also
+			// utilizing a cacheContext so the state does not change
+			calcOutAmountAsSeparateSwaps := func(osmoFeeReduced bool) sdk.Coin {
+				cacheCtx, _ := suite.Ctx.CacheContext()
+
+				// when the route is osmo routed, each pool charges its share of the discounted route swap fee:
+				// swapFee = routeSwapFee * ((pool_fee) / (sumOfSwapFees))
+				routeSwapFee, sumOfSwapFees := sdk.ZeroDec(), sdk.ZeroDec()
+				if osmoFeeReduced {
+					var err error
+					routeSwapFee, sumOfSwapFees, err = swaprouterKeeper.GetOsmoRoutedMultihopTotalSwapFee(suite.Ctx, types.SwapAmountInRoutes(test.param.routes))
+					suite.Require().NoError(err)
+				}
+				hopSwapFee := func(hopPool types.PoolI) sdk.Dec {
+					swapFee := hopPool.GetSwapFee(cacheCtx)
+					if osmoFeeReduced {
+						swapFee = routeSwapFee.Mul(swapFee.Quo(sumOfSwapFees))
+					}
+					return swapFee
+				}
+
+				nextTokenIn := test.param.tokenIn
+				// we then do individual swaps until we reach the end of the swap route
+				for _, hop := range test.param.routes {
+					hopPool, err := swaprouterKeeper.GetPool(cacheCtx, hop.PoolId)
+					suite.Require().NoError(err)
+					tokenOut, err := suite.App.GAMMKeeper.SwapExactAmountIn(cacheCtx, suite.TestAccs[0], hopPool, nextTokenIn, hop.TokenOutDenom, sdk.OneInt(), hopSwapFee(hopPool))
+					suite.Require().NoError(err)
+					nextTokenIn = sdk.NewCoin(hop.TokenOutDenom, tokenOut)
+				}
+				return nextTokenIn
+			}
+
+			if test.expectPass {
+				var expectedMultihopTokenOutAmount sdk.Coin
+				// calculate the swap as separate swaps with either the reduced swap fee or normal fee
+				expectedMultihopTokenOutAmount = calcOutAmountAsSeparateSwaps(test.expectReducedFeeApplied)
+				// compare the expected tokenOut to the actual tokenOut
+				multihopTokenOutAmount, err := swaprouterKeeper.RouteExactAmountIn(suite.Ctx, suite.TestAccs[0], test.param.routes, test.param.tokenIn, test.param.tokenOutMinAmount)
+				suite.Require().NoError(err)
+				suite.Require().Equal(expectedMultihopTokenOutAmount.Amount.String(), multihopTokenOutAmount.String())
+			} else {
+				_, err := swaprouterKeeper.RouteExactAmountIn(suite.Ctx, suite.TestAccs[0], test.param.routes, test.param.tokenIn, test.param.tokenOutMinAmount)
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+// TestRouteExactAmountOut tests that a multihop swap takes the same amount of token in as
+// swapping through each pool of the route separately, with the osmo routed swap fee discount applied
+// when the route qualifies for it.
+func (suite *KeeperTestSuite) TestRouteExactAmountOut() {
+	type param struct {
+		routes             []types.SwapAmountOutRoute
+		incentivizedGauges []uint64
+		fourAssetPools     int
+		poolAssets         []sdk.Coins
+		poolFee            []sdk.Dec
+		tokenInMaxAmount   sdk.Int
+		tokenOut           sdk.Coin
+	}
+
+	poolDefaultSwapFee := sdk.NewDecWithPrec(1, 2) // 1% pool swap fee default
+
+	tests := []struct {
+		name                    string
+		param                   param
+		expectPass              bool
+		expectReducedFeeApplied bool
+	}{
+		{
+			name: "Swap - [foo -> bar](pool 1) - [bar -> baz](pool 2), both pools 1 percent fee",
+			param: param{
+				routes: []types.SwapAmountOutRoute{
+					{
+						PoolId:       1,
+						TokenInDenom: "foo",
+					},
+					{
+						PoolId:       2,
+						TokenInDenom: "bar",
+					},
+				},
+				incentivizedGauges: []uint64{},
+				poolAssets:         []sdk.Coins{sdk.NewCoins(fooCoin, barCoin), sdk.NewCoins(barCoin, bazCoin)},
+				poolFee:            []sdk.Dec{poolDefaultSwapFee, poolDefaultSwapFee},
+				tokenInMaxAmount:   sdk.NewInt(90000000),
+				tokenOut:           sdk.NewCoin("baz", sdk.NewInt(100000)),
+			},
+			expectPass: true,
+		},
+		{
+			name: "Swap - [foo -> uosmo](pool 1) - [uosmo -> baz](pool 2) with a half fee applied, both pools 1 percent fee",
+			param: param{
+				routes: []types.SwapAmountOutRoute{
+					{
+						PoolId:       1,
+						TokenInDenom: "foo",
+					},
+					{
+						PoolId:       2,
+						TokenInDenom: "uosmo",
+					},
+				},
+				incentivizedGauges: []uint64{1, 2, 3, 4, 5, 6},
+				poolAssets:         []sdk.Coins{sdk.NewCoins(fooCoin, uosmoCoin), sdk.NewCoins(uosmoCoin, bazCoin)},
+				poolFee:            []sdk.Dec{poolDefaultSwapFee, poolDefaultSwapFee},
+				tokenInMaxAmount:   sdk.NewInt(90000000),
+				tokenOut:           sdk.NewCoin("baz", sdk.NewInt(100000)),
+			},
+			expectPass:              true,
+			expectReducedFeeApplied: true,
+		},
+		{
+			name: "Swap - [foo -> uosmo](pool 1) - [uosmo -> baz](pool 2) with a half fee applied, (pool 1) 1 percent fee, (pool 2) 10 percent fee",
+			param: param{
+				routes: []types.SwapAmountOutRoute{
+					{
+						PoolId:       1,
+						TokenInDenom: "foo",
+					},
+					{
+						PoolId:       2,
+						TokenInDenom: "uosmo",
+					},
+				},
+				incentivizedGauges: []uint64{1, 2, 3, 4, 5, 6},
+				poolAssets:         []sdk.Coins{sdk.NewCoins(fooCoin, uosmoCoin), sdk.NewCoins(uosmoCoin, bazCoin)},
+				poolFee:            []sdk.Dec{poolDefaultSwapFee, sdk.NewDecWithPrec(1, 1)},
+				tokenInMaxAmount:   sdk.NewInt(90000000),
+				tokenOut:           sdk.NewCoin("baz", sdk.NewInt(100000)),
+			},
+			expectPass:              true,
+			expectReducedFeeApplied: true,
+		},
+		{
+			name: "Swap - [foo -> uosmo](pool 1) - [uosmo -> baz](pool 2) - [baz -> bar](pool 3), all pools 1 percent fee",
+			param: param{
+				routes: []types.SwapAmountOutRoute{
+					{
+						PoolId:       1,
+						TokenInDenom: "foo",
+					},
+					{
+						PoolId:       2,
+						TokenInDenom: "uosmo",
+					},
+					{
+						PoolId:       3,
+						TokenInDenom: "baz",
+					},
+				},
+				incentivizedGauges: []uint64{1, 2, 3, 4, 5, 6},
+				poolAssets:         []sdk.Coins{sdk.NewCoins(fooCoin, uosmoCoin), sdk.NewCoins(uosmoCoin, bazCoin), sdk.NewCoins(bazCoin, barCoin)},
+				poolFee:            []sdk.Dec{poolDefaultSwapFee, poolDefaultSwapFee, poolDefaultSwapFee},
+				tokenInMaxAmount:   sdk.NewInt(90000000),
+				tokenOut:           sdk.NewCoin("bar", sdk.NewInt(100000)),
+			},
+			expectReducedFeeApplied: false,
+			expectPass:              true,
+		},
+		{
+			name: "Swap between four asset pools - [foo -> bar](pool 1) - [bar -> baz](pool 2), all pools 1 percent fee",
+			param: param{
+				routes: []types.SwapAmountOutRoute{
+					{
+						PoolId:       1,
+						TokenInDenom: "foo",
+					},
+					{
+						PoolId:       2,
+						TokenInDenom: "bar",
+					},
+				},
+				fourAssetPools:     2,
+				incentivizedGauges: []uint64{1, 2, 3, 4, 5, 6},
+				poolFee:            []sdk.Dec{poolDefaultSwapFee, poolDefaultSwapFee},
+				tokenOut:           sdk.NewCoin("baz", sdk.NewInt(100000)),
+				tokenInMaxAmount:   sdk.NewInt(90000000),
+			},
+			expectReducedFeeApplied: false,
+			expectPass:              true,
+		},
+		{
+			name: "Swap between four asset pools - [foo -> uosmo](pool 1) - [uosmo -> baz](pool 2), with a half fee applied, both pools 1 percent fee",
+			param: param{
+				routes: []types.SwapAmountOutRoute{
+					{
+						PoolId:       1,
+						TokenInDenom: "foo",
+					},
+					{
+						PoolId:       2,
+						TokenInDenom: "uosmo",
+					},
+				},
+				fourAssetPools:     2,
+				incentivizedGauges: []uint64{1, 2, 3, 4, 5, 6},
+				poolFee:            []sdk.Dec{poolDefaultSwapFee, poolDefaultSwapFee},
+				tokenOut:           sdk.NewCoin("baz", sdk.NewInt(100000)),
+				tokenInMaxAmount:   sdk.NewInt(90000000),
+			},
+			expectReducedFeeApplied: true,
+			expectPass:              true,
+		},
+		{
+			name: "Swap between four asset pools - [foo -> uosmo](pool 1) - [uosmo -> baz](pool 2) - [baz -> bar](pool 3), all pools 1 percent fee",
+			param: param{
+				routes: []types.SwapAmountOutRoute{
+					{
+						PoolId:       1,
+						TokenInDenom: "foo",
+					},
+					{
+						PoolId:       2,
+						TokenInDenom: "uosmo",
+					},
+					{
+						PoolId:       3,
+						TokenInDenom: "baz",
+					},
+				},
+				fourAssetPools:     3,
+				incentivizedGauges: []uint64{1, 2, 3, 4, 5, 6, 7, 8, 9},
+				poolFee:            []sdk.Dec{poolDefaultSwapFee, poolDefaultSwapFee, poolDefaultSwapFee},
+				tokenOut:           sdk.NewCoin("bar", sdk.NewInt(100000)),
+				tokenInMaxAmount:   sdk.NewInt(90000000),
+			},
+			expectReducedFeeApplied: false,
+			expectPass:              true,
+		},
+		{
+			name: "Swap - [foo -> bar](pool 1) - [bar -> baz](pool 3), pool 3 does not exist",
+			param: param{
+				routes: []types.SwapAmountOutRoute{
+					{
+						PoolId:       1,
+						TokenInDenom: "foo",
+					},
+					{
+						PoolId:       3,
+						TokenInDenom: "bar",
+					},
+				},
+				incentivizedGauges: []uint64{},
+				poolAssets:         []sdk.Coins{sdk.NewCoins(fooCoin, barCoin), sdk.NewCoins(barCoin, bazCoin)},
+				poolFee:            []sdk.Dec{poolDefaultSwapFee, poolDefaultSwapFee},
+				tokenInMaxAmount:   sdk.NewInt(90000000),
+				tokenOut:           sdk.NewCoin("baz", sdk.NewInt(100000)),
+			},
+			expectPass: false,
+		},
+		{
+			name: "Swap - [foo -> bar](pool 1) - [uosmo -> baz](pool 2), uosmo is not in pool 2",
+			param: param{
+				routes: []types.SwapAmountOutRoute{
+					{
+						PoolId:       1,
+						TokenInDenom: "foo",
+					},
+					{
+						PoolId:       2,
+						TokenInDenom: "uosmo",
+					},
+				},
+				incentivizedGauges: []uint64{},
+				poolAssets:         []sdk.Coins{sdk.NewCoins(fooCoin, barCoin), sdk.NewCoins(barCoin, bazCoin)},
+				poolFee:            []sdk.Dec{poolDefaultSwapFee, poolDefaultSwapFee},
+				tokenInMaxAmount:   sdk.NewInt(90000000),
+				tokenOut:           sdk.NewCoin("baz", sdk.NewInt(100000)),
+			},
+			expectPass: false,
+		},
+		{
+			name: "Swap - [foo -> bar](pool 1) - [bar -> baz](pool 2), token in more than the maximum amount in",
+			param: param{
+				routes: []types.SwapAmountOutRoute{
+					{
+						PoolId:       1,
+						TokenInDenom: "foo",
+					},
+					{
+						PoolId:       2,
+						TokenInDenom: "bar",
+					},
+				},
+				incentivizedGauges: []uint64{},
+				poolAssets:         []sdk.Coins{sdk.NewCoins(fooCoin, barCoin), sdk.NewCoins(barCoin, bazCoin)},
+				poolFee:            []sdk.Dec{poolDefaultSwapFee, poolDefaultSwapFee},
+				tokenInMaxAmount:   sdk.NewInt(100000),
+				tokenOut:           sdk.NewCoin("baz", sdk.NewInt(100000)),
+			},
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		suite.SetupTest()
+
+		suite.Run(test.name, func() {
+			swaprouterKeeper := suite.App.SwapRouterKeeper
+
+			// Create two asset pools with desired swap fee, if specified. Fund acc with respective coins
+			for i, coins := range test.param.poolAssets {
+				suite.PrepareCustomBalancerPoolFromCoins(coins, balancer.PoolParams{
+					SwapFee: test.param.poolFee[i],
+					ExitFee: sdk.NewDec(0),
+				})
+				suite.FundAcc(suite.TestAccs[0], coins)
+			}
+
+			// Create four asset pools with desired swap fee, if specified.
+			for i := 0; i < test.param.fourAssetPools; i++ {
+				suite.PrepareBalancerPoolWithPoolParams(balancer.PoolParams{
+					SwapFee: test.param.poolFee[i],
+					ExitFee: sdk.NewDec(0),
+				})
+			}
+
+			// if test specifies incentivized gauges, set them here
+			if len(test.param.incentivizedGauges) > 0 {
+				suite.makeGaugesIncentivized(test.param.incentivizedGauges)
+			}
+
+			// calcInAmountAsSeparateSwaps calculates the multi-hop swap as separate swaps, while also
+			// utilizing a cacheContext so the state does not change
+			calcInAmountAsSeparateSwaps := func(osmoFeeReduced bool) sdk.Coin {
+				cacheCtx, _ := suite.Ctx.CacheContext()
+
+				// when the route is osmo routed, each pool charges its share of the discounted route swap fee:
+				// swapFee = routeSwapFee * ((pool_fee) / (sumOfSwapFees))
+				routeSwapFee, sumOfSwapFees := sdk.ZeroDec(), sdk.ZeroDec()
+				if osmoFeeReduced {
+					var err error
+					routeSwapFee, sumOfSwapFees, err = swaprouterKeeper.GetOsmoRoutedMultihopTotalSwapFee(suite.Ctx, types.SwapAmountOutRoutes(test.param.routes))
+					suite.Require().NoError(err)
+				}
+				hopSwapFee := func(hopPool types.PoolI) sdk.Dec {
+					swapFee := hopPool.GetSwapFee(cacheCtx)
+					if osmoFeeReduced {
+						swapFee = routeSwapFee.Mul(swapFee.Quo(sumOfSwapFees))
+					}
+					return swapFee
+				}
+
+				nextTokenOut := test.param.tokenOut
+				// we then do individual swaps until we reach the end of the swap route
+				for i := len(test.param.routes) - 1; i >= 0; i-- {
+					hop := test.param.routes[i]
+					hopPool, err := swaprouterKeeper.GetPool(cacheCtx, hop.PoolId)
+					suite.Require().NoError(err)
+					tokenOut, err := suite.App.GAMMKeeper.SwapExactAmountOut(cacheCtx, suite.TestAccs[0], hopPool, hop.TokenInDenom, sdk.NewInt(100000000), nextTokenOut, hopSwapFee(hopPool))
+					suite.Require().NoError(err)
+					nextTokenOut = sdk.NewCoin(hop.TokenInDenom, tokenOut)
+				}
+				return nextTokenOut
+			}
+
+			if test.expectPass {
+				var expectedMultihopTokenOutAmount sdk.Coin
+				// calculate the swap as separate swaps with either the reduced swap fee or normal fee
+				expectedMultihopTokenOutAmount = calcInAmountAsSeparateSwaps(test.expectReducedFeeApplied)
+				// compare the expected tokenOut to the actual tokenOut
+				multihopTokenOutAmount, err := swaprouterKeeper.RouteExactAmountOut(suite.Ctx, suite.TestAccs[0], test.param.routes, test.param.tokenInMaxAmount, test.param.tokenOut)
+				suite.Require().NoError(err)
+				suite.Require().Equal(expectedMultihopTokenOutAmount.Amount.String(), multihopTokenOutAmount.String())
+			} else {
+				_, err := swaprouterKeeper.RouteExactAmountOut(suite.Ctx, suite.TestAccs[0], test.param.routes, test.param.tokenInMaxAmount, test.param.tokenOut)
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+// TestRouteExactAmountInTakerFee tests that the taker fee share of a pool on an osmo routed
+// multihop is charged on the discounted swap fee, like the swap fee that remains for LPs.
+func (suite *KeeperTestSuite) TestRouteExactAmountInTakerFee() {
+	suite.SetupTest()
+	swaprouterKeeper := suite.App.SwapRouterKeeper
+	takerFeeRecipient := suite.TestAccs[1]
+
+	suite.PrepareCustomBalancerPoolFromCoins(sdk.NewCoins(fooCoin, uosmoCoin), balancer.PoolParams{
+		SwapFee:           defaultPoolSwapFee,
+		ExitFee:           sdk.ZeroDec(),
+		TakerFeeShare:     sdk.NewDecWithPrec(5, 1),
+		TakerFeeRecipient: takerFeeRecipient.String(),
+	})
+	suite.PrepareCustomBalancerPoolFromCoins(sdk.NewCoins(uosmoCoin, bazCoin), balancer.PoolParams{
+		SwapFee: defaultPoolSwapFee,
+		ExitFee: sdk.ZeroDec(),
+	})
+	suite.makeGaugesIncentivized([]uint64{1, 2, 3, 4, 5, 6})
+
+	routes := []types.SwapAmountInRoute{
+		{PoolId: 1, TokenOutDenom: uosmo},
+		{PoolId: 2, TokenOutDenom: baz},
+	}
+	tokenIn := sdk.NewCoin(foo, defaultSwapAmount)
+	suite.FundAcc(suite.TestAccs[0], sdk.NewCoins(tokenIn))
+
+	routeSwapFee, sumOfSwapFees, err := swaprouterKeeper.GetOsmoRoutedMultihopTotalSwapFee(suite.Ctx, types.SwapAmountInRoutes(routes))
+	suite.Require().NoError(err)
+	swapFee := routeSwapFee.Mul(defaultPoolSwapFee.Quo(sumOfSwapFees))
+	expectedTakerFee := tokenIn.Amount.ToDec().MulTruncate(swapFee.Mul(sdk.NewDecWithPrec(5, 1))).TruncateInt()
+
+	_, err = swaprouterKeeper.RouteExactAmountIn(suite.Ctx, suite.TestAccs[0], routes, tokenIn, sdk.OneInt())
+	suite.Require().NoError(err)
+
+	suite.Require().Equal(sdk.NewCoins(sdk.NewCoin(foo, expectedTakerFee)), suite.App.GAMMKeeper.GetProtocolFees(suite.Ctx, 1))
+	suite.Require().Equal(expectedTakerFee, suite.App.BankKeeper.GetBalance(suite.Ctx, takerFeeRecipient, foo).Amount)
+	suite.Require().True(suite.App.GAMMKeeper.GetProtocolFees(suite.Ctx, 2).IsZero())
+}
+
 // TestEstimateMultihopSwapExactAmountIn tests that the estimation done via `EstimateSwapExactAmountIn`
 // results in the same amount of token out as the actual swap.
 func (suite *KeeperTestSuite) TestEstimateMultihopSwapExactAmountIn() {
@@ -98,6 +795,98 @@ func (suite *KeeperTestSuite) TestEstimateMultihopSwapExactAmountIn() {
 			reducedFeeApplied: true,
 			expectPass:        true,
 		},
+		{
+			name: "Proper swap - foo -> bar(pool 1) - bar(pool 2) -> baz - baz(pool 1) -> uosmo",
+			param: param{
+				routes: []types.SwapAmountInRoute{
+					{
+						PoolId:        1,
+						TokenOutDenom: bar,
+					},
+					{
+						PoolId:        2,
+						TokenOutDenom: baz,
+					},
+					{
+						PoolId:        1,
+						TokenOutDenom: uosmo,
+					},
+				},
+				estimateRoutes: []types.SwapAmountInRoute{
+					{
+						PoolId:        3,
+						TokenOutDenom: bar,
+					},
+					{
+						PoolId:        4,
+						TokenOutDenom: baz,
+					},
+					{
+						PoolId:        3,
+						TokenOutDenom: uosmo,
+					},
+				},
+				tokenIn:           sdk.NewCoin(foo, sdk.NewInt(100000)),
+				tokenOutMinAmount: sdk.NewInt(1),
+			},
+			expectPass: true,
+		},
+		{
+			name: "Estimate - foo -> bar(pool 3) - bar(pool 5) -> baz, pool 5 does not exist",
+			param: param{
+				routes: []types.SwapAmountInRoute{
+					{
+						PoolId:        1,
+						TokenOutDenom: bar,
+					},
+					{
+						PoolId:        2,
+						TokenOutDenom: baz,
+					},
+				},
+				estimateRoutes: []types.SwapAmountInRoute{
+					{
+						PoolId:        3,
+						TokenOutDenom: bar,
+					},
+					{
+						PoolId:        5,
+						TokenOutDenom: baz,
+					},
+				},
+				tokenIn:           sdk.NewCoin(foo, sdk.NewInt(100000)),
+				tokenOutMinAmount: sdk.NewInt(1),
+			},
+			expectPass: false,
+		},
+		{
+			name: "Estimate - foo -> bar(pool 3) - bar(pool 4) -> qux, qux is not in pool 4",
+			param: param{
+				routes: []types.SwapAmountInRoute{
+					{
+						PoolId:        1,
+						TokenOutDenom: bar,
+					},
+					{
+						PoolId:        2,
+						TokenOutDenom: baz,
+					},
+				},
+				estimateRoutes: []types.SwapAmountInRoute{
+					{
+						PoolId:        3,
+						TokenOutDenom: bar,
+					},
+					{
+						PoolId:        4,
+						TokenOutDenom: "qux",
+					},
+				},
+				tokenIn:           sdk.NewCoin(foo, sdk.NewInt(100000)),
+				tokenOutMinAmount: sdk.NewInt(1),
+			},
+			expectPass: false,
+		},
 	}
 
 	for _, test := range tests {
@@ -148,6 +937,10 @@ func (suite *KeeperTestSuite) TestEstimateMultihopSwapExactAmountIn() {
 				suite.Ctx,
 				test.param.estimateRoutes,
 				test.param.tokenIn)
+			if !test.expectPass {
+				suite.Require().Error(err)
+				return
+			}
 			suite.Require().NoError(err)
 
 			// ensure that the token out amount is same
@@ -238,6 +1031,98 @@ func (suite *KeeperTestSuite) TestEstimateMultihopSwapExactAmountOut() {
 			expectPass:        true,
 			reducedFeeApplied: true,
 		},
+		{
+			name: "Proper swap: foo -> bar (pool 1), bar -> baz (pool 2), baz -> uosmo (pool 1)",
+			param: param{
+				routes: []types.SwapAmountOutRoute{
+					{
+						PoolId:       1,
+						TokenInDenom: foo,
+					},
+					{
+						PoolId:       2,
+						TokenInDenom: bar,
+					},
+					{
+						PoolId:       1,
+						TokenInDenom: baz,
+					},
+				},
+				estimateRoutes: []types.SwapAmountOutRoute{
+					{
+						PoolId:       3,
+						TokenInDenom: foo,
+					},
+					{
+						PoolId:       4,
+						TokenInDenom: bar,
+					},
+					{
+						PoolId:       3,
+						TokenInDenom: baz,
+					},
+				},
+				tokenInMaxAmount: sdk.NewInt(90000000),
+				tokenOut:         sdk.NewCoin(uosmo, sdk.NewInt(100000)),
+			},
+			expectPass: true,
+		},
+		{
+			name: "Estimate: foo -> bar (pool 3), bar -> baz (pool 5), pool 5 does not exist",
+			param: param{
+				routes: []types.SwapAmountOutRoute{
+					{
+						PoolId:       1,
+						TokenInDenom: foo,
+					},
+					{
+						PoolId:       2,
+						TokenInDenom: bar,
+					},
+				},
+				estimateRoutes: []types.SwapAmountOutRoute{
+					{
+						PoolId:       3,
+						TokenInDenom: foo,
+					},
+					{
+						PoolId:       5,
+						TokenInDenom: bar,
+					},
+				},
+				tokenInMaxAmount: sdk.NewInt(90000000),
+				tokenOut:         sdk.NewCoin(baz, sdk.NewInt(100000)),
+			},
+			expectPass: false,
+		},
+		{
+			name: "Estimate: qux -> bar (pool 3), bar -> baz (pool 4), qux is not in pool 3",
+			param: param{
+				routes: []types.SwapAmountOutRoute{
+					{
+						PoolId:       1,
+						TokenInDenom: foo,
+					},
+					{
+						PoolId:       2,
+						TokenInDenom: bar,
+					},
+				},
+				estimateRoutes: []types.SwapAmountOutRoute{
+					{
+						PoolId:       3,
+						TokenInDenom: "qux",
+					},
+					{
+						PoolId:       4,
+						TokenInDenom: bar,
+					},
+				},
+				tokenInMaxAmount: sdk.NewInt(90000000),
+				tokenOut:         sdk.NewCoin(baz, sdk.NewInt(100000)),
+			},
+			expectPass: false,
+		},
 	}
 
 	for _, test := range tests {
@@ -284,6 +1169,10 @@ func (suite *KeeperTestSuite) TestEstimateMultihopSwapExactAmountOut() {
 				suite.Ctx,
 				test.param.estimateRoutes,
 				test.param.tokenOut)
+			if !test.expectPass {
+				suite.Require().Error(err, "test: %v", test.name)
+				return
+			}
 			suite.Require().NoError(err, "test: %v", test.name)
 
 			suite.Require().Equal(multihopTokenInAmount, estimateMultihopTokenInAmount)
@@ -300,6 +1189,199 @@ func (suite *KeeperTestSuite) TestEstimateMultihopSwapExactAmountOut() {
 	}
 }
 
+// TestSplitRouteExactAmountIn tests that a split route swap with an exact amount in
+// gives out the sum of the estimated amounts out of each route, and enforces the
+// minimum amount out on the aggregate.
+func (suite *KeeperTestSuite) TestSplitRouteExactAmountIn() {
+	var (
+		directRoute = types.SwapAmountInSplitRoute{
+			Pools:         []types.SwapAmountInRoute{{PoolId: 1, TokenOutDenom: bar}},
+			TokenInAmount: sdk.NewInt(100000),
+		}
+		multihopRoute = types.SwapAmountInSplitRoute{
+			Pools:         []types.SwapAmountInRoute{{PoolId: 2, TokenOutDenom: baz}, {PoolId: 3, TokenOutDenom: bar}},
+			TokenInAmount: sdk.NewInt(300000),
+		}
+		mismatchedRoute = types.SwapAmountInSplitRoute{
+			Pools:         []types.SwapAmountInRoute{{PoolId: 2, TokenOutDenom: baz}},
+			TokenInAmount: sdk.NewInt(300000),
+		}
+	)
+
+	tests := map[string]struct {
+		routes            []types.SwapAmountInSplitRoute
+		tokenOutMinAmount sdk.Int
+		expectedErr       error
+	}{
+		"single route": {
+			routes:            []types.SwapAmountInSplitRoute{directRoute},
+			tokenOutMinAmount: sdk.OneInt(),
+		},
+		"two routes": {
+			routes:            []types.SwapAmountInSplitRoute{directRoute, multihopRoute},
+			tokenOutMinAmount: sdk.OneInt(),
+		},
+		"empty routes": {
+			tokenOutMinAmount: sdk.OneInt(),
+			expectedErr:       types.ErrEmptyRoutes,
+		},
+		"duplicate routes": {
+			routes:            []types.SwapAmountInSplitRoute{directRoute, directRoute},
+			tokenOutMinAmount: sdk.OneInt(),
+			expectedErr:       types.ErrDuplicateRoutes,
+		},
+		"routes swap to different denoms": {
+			routes:            []types.SwapAmountInSplitRoute{directRoute, mismatchedRoute},
+			tokenOutMinAmount: sdk.OneInt(),
+			expectedErr:       types.MismatchedSplitRouteDenomError{RouteIndex: 1, Denom: baz, ExpectedDenom: bar},
+		},
+		"aggregate amount out less than min": {
+			routes:            []types.SwapAmountInSplitRoute{directRoute, multihopRoute},
+			tokenOutMinAmount: sdk.NewInt(1000000000),
+			expectedErr: types.AmountLessThanMinError{
+				// Estimated amounts out of the direct and the multihop routes.
+				TokenAmount: sdk.NewInt(190134),
+				TokenMin:    sdk.NewInt(1000000000),
+			},
+		},
+	}
+
+	for name, tc := range tests {
+		suite.Run(name, func() {
+			suite.SetupTest()
+			swaprouterKeeper := suite.App.SwapRouterKeeper
+
+			for i := 0; i < 3; i++ {
+				suite.PrepareBalancerPool()
+			}
+			sender := suite.TestAccs[0]
+
+			estimatedTokenOut, estimatedRouteTokenOuts, estimateErr := swaprouterKeeper.SplitRouteEstimateOutGivenExactAmountIn(suite.Ctx, tc.routes, foo)
+			balancesBefore := suite.App.BankKeeper.GetAllBalances(suite.Ctx, sender)
+			suite.Ctx = suite.Ctx.WithEventManager(sdk.NewEventManager())
+
+			tokenOutAmount, err := swaprouterKeeper.SplitRouteExactAmountIn(suite.Ctx, sender, tc.routes, foo, tc.tokenOutMinAmount)
+			if tc.expectedErr != nil {
+				suite.Require().EqualError(err, tc.expectedErr.Error())
+				return
+			}
+			suite.Require().NoError(err)
+			suite.Require().NoError(estimateErr)
+
+			// The routes share no pools, so the estimates are exact.
+			suite.Require().Equal(estimatedTokenOut, tokenOutAmount)
+			suite.Require().Len(estimatedRouteTokenOuts, len(tc.routes))
+
+			totalTokenIn := sdk.ZeroInt()
+			for _, route := range tc.routes {
+				totalTokenIn = totalTokenIn.Add(route.TokenInAmount)
+			}
+			balancesAfter := suite.App.BankKeeper.GetAllBalances(suite.Ctx, sender)
+			suite.Require().Equal(totalTokenIn, balancesBefore.AmountOf(foo).Sub(balancesAfter.AmountOf(foo)))
+			suite.Require().Equal(tokenOutAmount, balancesAfter.AmountOf(bar).Sub(balancesBefore.AmountOf(bar)))
+
+			suite.AssertEventEmitted(suite.Ctx, types.TypeEvtSplitRouteSwapped, len(tc.routes))
+		})
+	}
+}
+
+// TestSplitRouteExactAmountOut tests that a split route swap with an exact amount out
+// takes in the sum of the estimated amounts in of each route, and enforces the
+// maximum amount in on the aggregate.
+func (suite *KeeperTestSuite) TestSplitRouteExactAmountOut() {
+	var (
+		directRoute = types.SwapAmountOutSplitRoute{
+			Pools:          []types.SwapAmountOutRoute{{PoolId: 1, TokenInDenom: foo}},
+			TokenOutAmount: sdk.NewInt(100000),
+		}
+		multihopRoute = types.SwapAmountOutSplitRoute{
+			Pools:          []types.SwapAmountOutRoute{{PoolId: 2, TokenInDenom: foo}, {PoolId: 3, TokenInDenom: baz}},
+			TokenOutAmount: sdk.NewInt(300000),
+		}
+		mismatchedRoute = types.SwapAmountOutSplitRoute{
+			Pools:          []types.SwapAmountOutRoute{{PoolId: 2, TokenInDenom: baz}},
+			TokenOutAmount: sdk.NewInt(300000),
+		}
+	)
+
+	tests := map[string]struct {
+		routes           []types.SwapAmountOutSplitRoute
+		tokenInMaxAmount sdk.Int
+		expectedErr      error
+	}{
+		"single route": {
+			routes:           []types.SwapAmountOutSplitRoute{directRoute},
+			tokenInMaxAmount: sdk.NewInt(1000000),
+		},
+		"two routes": {
+			routes:           []types.SwapAmountOutSplitRoute{directRoute, multihopRoute},
+			tokenInMaxAmount: sdk.NewInt(1000000),
+		},
+		"empty routes": {
+			tokenInMaxAmount: sdk.NewInt(1000000),
+			expectedErr:      types.ErrEmptyRoutes,
+		},
+		"duplicate routes": {
+			routes:           []types.SwapAmountOutSplitRoute{directRoute, directRoute},
+			tokenInMaxAmount: sdk.NewInt(1000000),
+			expectedErr:      types.ErrDuplicateRoutes,
+		},
+		"routes swap from different denoms": {
+			routes:           []types.SwapAmountOutSplitRoute{directRoute, mismatchedRoute},
+			tokenInMaxAmount: sdk.NewInt(1000000),
+			expectedErr:      types.MismatchedSplitRouteDenomError{RouteIndex: 1, Denom: baz, ExpectedDenom: foo},
+		},
+		"aggregate amount in greater than max": {
+			routes: []types.SwapAmountOutSplitRoute{directRoute, multihopRoute},
+			// Enough for each route on its own, but not for both.
+			tokenInMaxAmount: sdk.NewInt(700000),
+			expectedErr: types.AmountGreaterThanMaxError{
+				// Estimated amounts in of the direct and the multihop routes.
+				TokenAmount: sdk.NewInt(895051),
+				TokenMax:    sdk.NewInt(700000),
+			},
+		},
+	}
+
+	for name, tc := range tests {
+		suite.Run(name, func() {
+			suite.SetupTest()
+			swaprouterKeeper := suite.App.SwapRouterKeeper
+
+			for i := 0; i < 3; i++ {
+				suite.PrepareBalancerPool()
+			}
+			sender := suite.TestAccs[0]
+
+			estimatedTokenIn, estimatedRouteTokenIns, estimateErr := swaprouterKeeper.SplitRouteEstimateInGivenExactAmountOut(suite.Ctx, tc.routes, bar)
+			balancesBefore := suite.App.BankKeeper.GetAllBalances(suite.Ctx, sender)
+			suite.Ctx = suite.Ctx.WithEventManager(sdk.NewEventManager())
+
+			tokenInAmount, err := swaprouterKeeper.SplitRouteExactAmountOut(suite.Ctx, sender, tc.routes, bar, tc.tokenInMaxAmount)
+			if tc.expectedErr != nil {
+				suite.Require().EqualError(err, tc.expectedErr.Error())
+				return
+			}
+			suite.Require().NoError(err)
+			suite.Require().NoError(estimateErr)
+
+			// The routes share no pools, so the estimates are exact.
+			suite.Require().Equal(estimatedTokenIn, tokenInAmount)
+			suite.Require().Len(estimatedRouteTokenIns, len(tc.routes))
+
+			totalTokenOut := sdk.ZeroInt()
+			for _, route := range tc.routes {
+				totalTokenOut = totalTokenOut.Add(route.TokenOutAmount)
+			}
+			balancesAfter := suite.App.BankKeeper.GetAllBalances(suite.Ctx, sender)
+			suite.Require().Equal(tokenInAmount, balancesBefore.AmountOf(foo).Sub(balancesAfter.AmountOf(foo)))
+			suite.Require().Equal(totalTokenOut, balancesAfter.AmountOf(bar).Sub(balancesBefore.AmountOf(bar)))
+
+			suite.AssertEventEmitted(suite.Ctx, types.TypeEvtSplitRouteSwapped, len(tc.routes))
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) makeGaugesIncentivized(incentivizedGauges []uint64) {
 	var records []poolincentivestypes.DistrRecord
 	totalWeight := sdk.NewInt(int64(len(incentivizedGauges)))
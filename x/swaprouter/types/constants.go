@@ -4,3 +4,12 @@ const (
 	MinPoolAssets = 2
 	MaxPoolAssets = 8
 )
+
+const (
+	// MaxRouteFinderHops is the maximum number of pools in a route returned by the optimal route finder.
+	MaxRouteFinderHops = 4
+	// MaxRouteFinderSearchSteps bounds the number of partial routes the optimal route finder explores.
+	MaxRouteFinderSearchSteps = 10000
+	// MaxRouteFinderCandidates bounds the number of complete routes the optimal route finder estimates.
+	MaxRouteFinderCandidates = 100
+)
@@ -3,6 +3,8 @@ package types
 import (
 	"errors"
 	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 var (
@@ -10,6 +12,10 @@ var (
 	ErrInvalidPool       = errors.New("attempting to create an invalid pool")
 	ErrTooFewPoolAssets  = errors.New("pool should have at least 2 assets, as they must be swapping between at least two assets")
 	ErrTooManyPoolAssets = errors.New("pool has too many assets (currently capped at 8 assets per pool)")
+	ErrInvalidMathApprox = errors.New("invalid calculated result")
+	ErrDuplicateRoutes   = errors.New("duplicate split routes are not allowed")
+	ErrSameDenomRoute    = errors.New("token in and token out denoms must differ")
+	ErrInvalidTokenIn    = errors.New("token in must be a valid positive coin")
 )
 
 type nonPositiveAmountError struct {
@@ -36,3 +42,49 @@ type UndefinedRouteError struct {
 func (e UndefinedRouteError) Error() string {
 	return fmt.Sprintf("route is not defined for the given pool type (%s) and pool id (%d)", e.PoolType, e.PoolId)
 }
+
+type MismatchedSplitRouteDenomError struct {
+	RouteIndex    int
+	Denom         string
+	ExpectedDenom string
+}
+
+func (e MismatchedSplitRouteDenomError) Error() string {
+	return fmt.Sprintf("split route (%d) swaps denom (%s), all routes must swap denom (%s)", e.RouteIndex, e.Denom, e.ExpectedDenom)
+}
+
+type AmountLessThanMinError struct {
+	TokenAmount sdk.Int
+	TokenMin    sdk.Int
+}
+
+func (e AmountLessThanMinError) Error() string {
+	return fmt.Sprintf("aggregate token amount (%s) is less than the minimum (%s)", e.TokenAmount, e.TokenMin)
+}
+
+type AmountGreaterThanMaxError struct {
+	TokenAmount sdk.Int
+	TokenMax    sdk.Int
+}
+
+func (e AmountGreaterThanMaxError) Error() string {
+	return fmt.Sprintf("aggregate token amount (%s) is greater than the maximum (%s)", e.TokenAmount, e.TokenMax)
+}
+
+type InvalidMaxHopsError struct {
+	MaxHops uint64
+}
+
+func (e InvalidMaxHopsError) Error() string {
+	return fmt.Sprintf("max hops (%d) must be between 1 and %d", e.MaxHops, MaxRouteFinderHops)
+}
+
+type NoRouteFoundError struct {
+	TokenInDenom  string
+	TokenOutDenom string
+	MaxHops       uint64
+}
+
+func (e NoRouteFoundError) Error() string {
+	return fmt.Sprintf("no route found from (%s) to (%s) within (%d) hops", e.TokenInDenom, e.TokenOutDenom, e.MaxHops)
+}
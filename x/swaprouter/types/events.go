@@ -1,5 +1,11 @@
 package types
 
 const (
+	TypeEvtSplitRouteSwapped = "split_route_swapped"
+
 	AttributeValueCategory = ModuleName
+	AttributeKeyRouteIndex = "route_index"
+	AttributeKeyPoolIds    = "pool_ids"
+	AttributeKeyTokensIn   = "tokens_in"
+	AttributeKeyTokensOut  = "tokens_out"
 )
@@ -324,3 +324,239 @@ func TestAuthzMsg(t *testing.T) {
 		})
 	}
 }
+
+func TestMsgSplitRouteSwapExactAmountIn(t *testing.T) {
+	appParams.SetAddressPrefixes()
+	pk1 := ed25519.GenPrivKey().PubKey()
+	addr1 := sdk.AccAddress(pk1.Address()).String()
+	invalidAddr := sdk.AccAddress("invalid")
+
+	createMsg := func(after func(msg types.MsgSplitRouteSwapExactAmountIn) types.MsgSplitRouteSwapExactAmountIn) types.MsgSplitRouteSwapExactAmountIn {
+		properMsg := types.MsgSplitRouteSwapExactAmountIn{
+			Sender: addr1,
+			Routes: []types.SwapAmountInSplitRoute{{
+				Pools:         []types.SwapAmountInRoute{{PoolId: 1, TokenOutDenom: "test2"}},
+				TokenInAmount: sdk.NewInt(100),
+			}, {
+				Pools:         []types.SwapAmountInRoute{{PoolId: 2, TokenOutDenom: "test3"}, {PoolId: 3, TokenOutDenom: "test2"}},
+				TokenInAmount: sdk.NewInt(300),
+			}},
+			TokenInDenom:      "test",
+			TokenOutMinAmount: sdk.NewInt(200),
+		}
+
+		return after(properMsg)
+	}
+
+	msg := createMsg(func(msg types.MsgSplitRouteSwapExactAmountIn) types.MsgSplitRouteSwapExactAmountIn {
+		// Do nothing
+		return msg
+	})
+
+	require.Equal(t, msg.Route(), types.RouterKey)
+	require.Equal(t, msg.Type(), "split_route_swap_exact_amount_in")
+	signers := msg.GetSigners()
+	require.Equal(t, len(signers), 1)
+	require.Equal(t, signers[0].String(), addr1)
+
+	tests := []struct {
+		name       string
+		msg        types.MsgSplitRouteSwapExactAmountIn
+		expectPass bool
+	}{
+		{
+			name: "proper msg",
+			msg: createMsg(func(msg types.MsgSplitRouteSwapExactAmountIn) types.MsgSplitRouteSwapExactAmountIn {
+				// Do nothing
+				return msg
+			}),
+			expectPass: true,
+		},
+		{
+			name: "invalid sender",
+			msg: createMsg(func(msg types.MsgSplitRouteSwapExactAmountIn) types.MsgSplitRouteSwapExactAmountIn {
+				msg.Sender = invalidAddr.String()
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "empty routes",
+			msg: createMsg(func(msg types.MsgSplitRouteSwapExactAmountIn) types.MsgSplitRouteSwapExactAmountIn {
+				msg.Routes = nil
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "route with empty pools",
+			msg: createMsg(func(msg types.MsgSplitRouteSwapExactAmountIn) types.MsgSplitRouteSwapExactAmountIn {
+				msg.Routes[1].Pools = nil
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "zero route amount",
+			msg: createMsg(func(msg types.MsgSplitRouteSwapExactAmountIn) types.MsgSplitRouteSwapExactAmountIn {
+				msg.Routes[0].TokenInAmount = sdk.ZeroInt()
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "routes with different denoms out",
+			msg: createMsg(func(msg types.MsgSplitRouteSwapExactAmountIn) types.MsgSplitRouteSwapExactAmountIn {
+				msg.Routes[1].Pools = msg.Routes[1].Pools[:1]
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "duplicate routes",
+			msg: createMsg(func(msg types.MsgSplitRouteSwapExactAmountIn) types.MsgSplitRouteSwapExactAmountIn {
+				msg.Routes[1] = msg.Routes[0]
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "invalid denom in",
+			msg: createMsg(func(msg types.MsgSplitRouteSwapExactAmountIn) types.MsgSplitRouteSwapExactAmountIn {
+				msg.TokenInDenom = "1"
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "zero amount token out min",
+			msg: createMsg(func(msg types.MsgSplitRouteSwapExactAmountIn) types.MsgSplitRouteSwapExactAmountIn {
+				msg.TokenOutMinAmount = sdk.ZeroInt()
+				return msg
+			}),
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		if test.expectPass {
+			require.NoError(t, test.msg.ValidateBasic(), "test: %v", test.name)
+		} else {
+			require.Error(t, test.msg.ValidateBasic(), "test: %v", test.name)
+		}
+	}
+}
+
+func TestMsgSplitRouteSwapExactAmountOut(t *testing.T) {
+	appParams.SetAddressPrefixes()
+	pk1 := ed25519.GenPrivKey().PubKey()
+	addr1 := sdk.AccAddress(pk1.Address()).String()
+	invalidAddr := sdk.AccAddress("invalid")
+
+	createMsg := func(after func(msg types.MsgSplitRouteSwapExactAmountOut) types.MsgSplitRouteSwapExactAmountOut) types.MsgSplitRouteSwapExactAmountOut {
+		properMsg := types.MsgSplitRouteSwapExactAmountOut{
+			Sender: addr1,
+			Routes: []types.SwapAmountOutSplitRoute{{
+				Pools:          []types.SwapAmountOutRoute{{PoolId: 1, TokenInDenom: "test"}},
+				TokenOutAmount: sdk.NewInt(100),
+			}, {
+				Pools:          []types.SwapAmountOutRoute{{PoolId: 2, TokenInDenom: "test"}, {PoolId: 3, TokenInDenom: "test3"}},
+				TokenOutAmount: sdk.NewInt(300),
+			}},
+			TokenOutDenom:    "test2",
+			TokenInMaxAmount: sdk.NewInt(200),
+		}
+
+		return after(properMsg)
+	}
+
+	msg := createMsg(func(msg types.MsgSplitRouteSwapExactAmountOut) types.MsgSplitRouteSwapExactAmountOut {
+		// Do nothing
+		return msg
+	})
+
+	require.Equal(t, msg.Route(), types.RouterKey)
+	require.Equal(t, msg.Type(), "split_route_swap_exact_amount_out")
+	signers := msg.GetSigners()
+	require.Equal(t, len(signers), 1)
+	require.Equal(t, signers[0].String(), addr1)
+
+	tests := []struct {
+		name       string
+		msg        types.MsgSplitRouteSwapExactAmountOut
+		expectPass bool
+	}{
+		{
+			name: "proper msg",
+			msg: createMsg(func(msg types.MsgSplitRouteSwapExactAmountOut) types.MsgSplitRouteSwapExactAmountOut {
+				// Do nothing
+				return msg
+			}),
+			expectPass: true,
+		},
+		{
+			name: "invalid sender",
+			msg: createMsg(func(msg types.MsgSplitRouteSwapExactAmountOut) types.MsgSplitRouteSwapExactAmountOut {
+				msg.Sender = invalidAddr.String()
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "empty routes",
+			msg: createMsg(func(msg types.MsgSplitRouteSwapExactAmountOut) types.MsgSplitRouteSwapExactAmountOut {
+				msg.Routes = nil
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "zero route amount",
+			msg: createMsg(func(msg types.MsgSplitRouteSwapExactAmountOut) types.MsgSplitRouteSwapExactAmountOut {
+				msg.Routes[0].TokenOutAmount = sdk.ZeroInt()
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "routes with different denoms in",
+			msg: createMsg(func(msg types.MsgSplitRouteSwapExactAmountOut) types.MsgSplitRouteSwapExactAmountOut {
+				msg.Routes[1].Pools = msg.Routes[1].Pools[1:]
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "duplicate routes",
+			msg: createMsg(func(msg types.MsgSplitRouteSwapExactAmountOut) types.MsgSplitRouteSwapExactAmountOut {
+				msg.Routes[1] = msg.Routes[0]
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "invalid denom out",
+			msg: createMsg(func(msg types.MsgSplitRouteSwapExactAmountOut) types.MsgSplitRouteSwapExactAmountOut {
+				msg.TokenOutDenom = "1"
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "zero amount token in max",
+			msg: createMsg(func(msg types.MsgSplitRouteSwapExactAmountOut) types.MsgSplitRouteSwapExactAmountOut {
+				msg.TokenInMaxAmount = sdk.ZeroInt()
+				return msg
+			}),
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		if test.expectPass {
+			require.NoError(t, test.msg.ValidateBasic(), "test: %v", test.name)
+		} else {
+			require.Error(t, test.msg.ValidateBasic(), "test: %v", test.name)
+		}
+	}
+}
@@ -1,6 +1,8 @@
 package types
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
@@ -27,47 +29,53 @@ type CommunityPoolI interface {
 	FundCommunityPool(ctx sdk.Context, amount sdk.Coins, sender sdk.AccAddress) error
 }
 
-// TODO: godoc
+// SwapI defines the interface that a pool module must implement so that
+// swaprouter can create pools of its type and route swaps through them.
 type SwapI interface {
 	InitializePool(ctx sdk.Context, pool PoolI, creatorAddress sdk.AccAddress) error
 
-	// GetPool(ctx sdk.Context, poolId uint64) (PoolI, error)
-
-	// SwapExactAmountIn(
-	// 	ctx sdk.Context,
-	// 	sender sdk.AccAddress,
-	// 	pool PoolI,
-	// 	tokenIn sdk.Coin,
-	// 	tokenOutDenom string,
-	// 	tokenOutMinAmount sdk.Int,
-	// 	swapFee sdk.Dec,
-	// ) (sdk.Int, error)
-
-	// CalcOutAmtGivenIn(
-	// 	ctx sdk.Context,
-	// 	poolI PoolI,
-	// 	tokenIn sdk.Coin,
-	// 	tokenOutDenom string,
-	// 	swapFee sdk.Dec,
-	// ) (tokenOut sdk.Coin, err error)
-
-	// SwapExactAmountOut(
-	// 	ctx sdk.Context,
-	// 	sender sdk.AccAddress,
-	// 	pool PoolI,
-	// 	tokenInDenom string,
-	// 	tokenInMaxAmount sdk.Int,
-	// 	tokenOut sdk.Coin,
-	// 	swapFee sdk.Dec,
-	// ) (tokenInAmount sdk.Int, err error)
-
-	// CalcInAmtGivenOut(
-	// 	ctx sdk.Context,
-	// 	poolI PoolI,
-	// 	tokenOut sdk.Coin,
-	// 	tokenInDenom string,
-	// 	swapFee sdk.Dec,
-	// ) (tokenIn sdk.Coin, err error)
+	GetPool(ctx sdk.Context, poolId uint64) (PoolI, error)
+	// GetPools returns all pools of the module.
+	GetPools(ctx sdk.Context) ([]PoolI, error)
+	// CalculateSpotPrice returns the spot price of the quote asset in terms of the base asset
+	// in the given pool.
+	CalculateSpotPrice(ctx sdk.Context, poolId uint64, quoteAssetDenom string, baseAssetDenom string) (sdk.Dec, error)
+
+	SwapExactAmountIn(
+		ctx sdk.Context,
+		sender sdk.AccAddress,
+		pool PoolI,
+		tokenIn sdk.Coin,
+		tokenOutDenom string,
+		tokenOutMinAmount sdk.Int,
+		swapFee sdk.Dec,
+	) (sdk.Int, error)
+
+	CalcOutAmtGivenIn(
+		ctx sdk.Context,
+		poolI PoolI,
+		tokenIn sdk.Coin,
+		tokenOutDenom string,
+		swapFee sdk.Dec,
+	) (tokenOut sdk.Coin, err error)
+
+	SwapExactAmountOut(
+		ctx sdk.Context,
+		sender sdk.AccAddress,
+		pool PoolI,
+		tokenInDenom string,
+		tokenInMaxAmount sdk.Int,
+		tokenOut sdk.Coin,
+		swapFee sdk.Dec,
+	) (tokenInAmount sdk.Int, err error)
+
+	CalcInAmtGivenOut(
+		ctx sdk.Context,
+		poolI PoolI,
+		tokenOut sdk.Coin,
+		tokenInDenom string,
+		swapFee sdk.Dec,
+	) (tokenIn sdk.Coin, err error)
 }
 
 type PoolIncentivesKeeperI interface {
@@ -161,3 +169,89 @@ func (routes SwapAmountOutRoutes) PoolIds() []uint64 {
 func (routes SwapAmountOutRoutes) Length() int {
 	return len(routes)
 }
+
+type SwapAmountInSplitRoutes []SwapAmountInSplitRoute
+
+// Validate checks that there is at least one route, that every route is a valid
+// multihop route with a positive amount in, that all routes swap to the same
+// denom, and that no route is repeated.
+func (routes SwapAmountInSplitRoutes) Validate() error {
+	if len(routes) == 0 {
+		return ErrEmptyRoutes
+	}
+
+	seenRoutes := make(map[string]struct{}, len(routes))
+	tokenOutDenom := routes[0].TokenOutDenom()
+	for i, route := range routes {
+		if err := SwapAmountInRoutes(route.Pools).Validate(); err != nil {
+			return err
+		}
+
+		if !route.TokenInAmount.IsPositive() {
+			return nonPositiveAmountError{route.TokenInAmount.String()}
+		}
+
+		if route.TokenOutDenom() != tokenOutDenom {
+			return MismatchedSplitRouteDenomError{RouteIndex: i, Denom: route.TokenOutDenom(), ExpectedDenom: tokenOutDenom}
+		}
+
+		routeKey := fmt.Sprint(route.Pools)
+		if _, ok := seenRoutes[routeKey]; ok {
+			return ErrDuplicateRoutes
+		}
+		seenRoutes[routeKey] = struct{}{}
+	}
+
+	return nil
+}
+
+// TokenOutDenom returns the denom swapped to by the last pool of the route.
+func (route SwapAmountInSplitRoute) TokenOutDenom() string {
+	if len(route.Pools) == 0 {
+		return ""
+	}
+	return route.Pools[len(route.Pools)-1].TokenOutDenom
+}
+
+type SwapAmountOutSplitRoutes []SwapAmountOutSplitRoute
+
+// Validate checks that there is at least one route, that every route is a valid
+// multihop route with a positive amount out, that all routes swap from the same
+// denom, and that no route is repeated.
+func (routes SwapAmountOutSplitRoutes) Validate() error {
+	if len(routes) == 0 {
+		return ErrEmptyRoutes
+	}
+
+	seenRoutes := make(map[string]struct{}, len(routes))
+	tokenInDenom := routes[0].TokenInDenom()
+	for i, route := range routes {
+		if err := SwapAmountOutRoutes(route.Pools).Validate(); err != nil {
+			return err
+		}
+
+		if !route.TokenOutAmount.IsPositive() {
+			return nonPositiveAmountError{route.TokenOutAmount.String()}
+		}
+
+		if route.TokenInDenom() != tokenInDenom {
+			return MismatchedSplitRouteDenomError{RouteIndex: i, Denom: route.TokenInDenom(), ExpectedDenom: tokenInDenom}
+		}
+
+		routeKey := fmt.Sprint(route.Pools)
+		if _, ok := seenRoutes[routeKey]; ok {
+			return ErrDuplicateRoutes
+		}
+		seenRoutes[routeKey] = struct{}{}
+	}
+
+	return nil
+}
+
+// TokenInDenom returns the denom swapped from by the first pool of the route.
+func (route SwapAmountOutSplitRoute) TokenInDenom() string {
+	if len(route.Pools) == 0 {
+		return ""
+	}
+	return route.Pools[0].TokenInDenom
+}
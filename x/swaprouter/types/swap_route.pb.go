@@ -5,6 +5,7 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	io "io"
@@ -127,9 +128,107 @@ func (m *SwapAmountOutRoute) GetTokenInDenom() string {
 	return ""
 }
 
+// SwapAmountInSplitRoute is one of the routes of a split route swap with an
+// exact amount in. It swaps token_in_amount of the shared token in through
+// the pools of the route.
+type SwapAmountInSplitRoute struct {
+	Pools         []SwapAmountInRoute                    `protobuf:"bytes,1,rep,name=pools,proto3" json:"pools" yaml:"pools"`
+	TokenInAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=token_in_amount,json=tokenInAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"token_in_amount" yaml:"token_in_amount"`
+}
+
+func (m *SwapAmountInSplitRoute) Reset()         { *m = SwapAmountInSplitRoute{} }
+func (m *SwapAmountInSplitRoute) String() string { return proto.CompactTextString(m) }
+func (*SwapAmountInSplitRoute) ProtoMessage()    {}
+func (*SwapAmountInSplitRoute) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9eda4cafb53adf83, []int{2}
+}
+func (m *SwapAmountInSplitRoute) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SwapAmountInSplitRoute) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SwapAmountInSplitRoute.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SwapAmountInSplitRoute) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SwapAmountInSplitRoute.Merge(m, src)
+}
+func (m *SwapAmountInSplitRoute) XXX_Size() int {
+	return m.Size()
+}
+func (m *SwapAmountInSplitRoute) XXX_DiscardUnknown() {
+	xxx_messageInfo_SwapAmountInSplitRoute.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SwapAmountInSplitRoute proto.InternalMessageInfo
+
+func (m *SwapAmountInSplitRoute) GetPools() []SwapAmountInRoute {
+	if m != nil {
+		return m.Pools
+	}
+	return nil
+}
+
+// SwapAmountOutSplitRoute is one of the routes of a split route swap with an
+// exact amount out. It swaps for token_out_amount of the shared token out
+// through the pools of the route.
+type SwapAmountOutSplitRoute struct {
+	Pools          []SwapAmountOutRoute                   `protobuf:"bytes,1,rep,name=pools,proto3" json:"pools" yaml:"pools"`
+	TokenOutAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=token_out_amount,json=tokenOutAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"token_out_amount" yaml:"token_out_amount"`
+}
+
+func (m *SwapAmountOutSplitRoute) Reset()         { *m = SwapAmountOutSplitRoute{} }
+func (m *SwapAmountOutSplitRoute) String() string { return proto.CompactTextString(m) }
+func (*SwapAmountOutSplitRoute) ProtoMessage()    {}
+func (*SwapAmountOutSplitRoute) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9eda4cafb53adf83, []int{3}
+}
+func (m *SwapAmountOutSplitRoute) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SwapAmountOutSplitRoute) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SwapAmountOutSplitRoute.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SwapAmountOutSplitRoute) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SwapAmountOutSplitRoute.Merge(m, src)
+}
+func (m *SwapAmountOutSplitRoute) XXX_Size() int {
+	return m.Size()
+}
+func (m *SwapAmountOutSplitRoute) XXX_DiscardUnknown() {
+	xxx_messageInfo_SwapAmountOutSplitRoute.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SwapAmountOutSplitRoute proto.InternalMessageInfo
+
+func (m *SwapAmountOutSplitRoute) GetPools() []SwapAmountOutRoute {
+	if m != nil {
+		return m.Pools
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*SwapAmountInRoute)(nil), "osmosis.swaprouter.v1beta1.SwapAmountInRoute")
 	proto.RegisterType((*SwapAmountOutRoute)(nil), "osmosis.swaprouter.v1beta1.SwapAmountOutRoute")
+	proto.RegisterType((*SwapAmountInSplitRoute)(nil), "osmosis.swaprouter.v1beta1.SwapAmountInSplitRoute")
+	proto.RegisterType((*SwapAmountOutSplitRoute)(nil), "osmosis.swaprouter.v1beta1.SwapAmountOutSplitRoute")
 }
 
 func init() {
@@ -137,27 +236,64 @@ func init() {
 }
 
 var fileDescriptor_9eda4cafb53adf83 = []byte{
-	// 287 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xd2, 0xce, 0x2f, 0xce, 0xcd,
-	0x2f, 0xce, 0x2c, 0xd6, 0x2f, 0x2e, 0x4f, 0x2c, 0x28, 0xca, 0x2f, 0x2d, 0x49, 0x2d, 0xd2, 0x2f,
-	0x33, 0x4c, 0x4a, 0x2d, 0x49, 0x34, 0x04, 0x0b, 0xc5, 0x83, 0xc5, 0xf4, 0x0a, 0x8a, 0xf2, 0x4b,
-	0xf2, 0x85, 0xa4, 0xa0, 0x8a, 0xf5, 0x10, 0x8a, 0xf5, 0xa0, 0x8a, 0xa5, 0x44, 0xd2, 0xf3, 0xd3,
-	0xf3, 0xc1, 0xca, 0xf4, 0x41, 0x2c, 0x88, 0x0e, 0xa5, 0x16, 0x46, 0x2e, 0xc1, 0xe0, 0xf2, 0xc4,
-	0x02, 0xc7, 0xdc, 0xfc, 0xd2, 0xbc, 0x12, 0xcf, 0xbc, 0x20, 0x90, 0x26, 0x21, 0x6d, 0x2e, 0xf6,
-	0x82, 0xfc, 0xfc, 0x9c, 0xf8, 0xcc, 0x14, 0x09, 0x46, 0x05, 0x46, 0x0d, 0x16, 0x27, 0xa1, 0x4f,
-	0xf7, 0xe4, 0xf9, 0x2a, 0x13, 0x73, 0x73, 0xac, 0x94, 0xa0, 0x12, 0x4a, 0x41, 0x6c, 0x20, 0x96,
-	0x67, 0x8a, 0x90, 0x13, 0x17, 0x7f, 0x49, 0x7e, 0x76, 0x6a, 0x5e, 0x7c, 0x7e, 0x69, 0x49, 0x7c,
-	0x4a, 0x6a, 0x5e, 0x7e, 0xae, 0x04, 0x93, 0x02, 0xa3, 0x06, 0xa7, 0x93, 0xd4, 0xa7, 0x7b, 0xf2,
-	0x62, 0x10, 0x4d, 0x68, 0x0a, 0x94, 0x82, 0x78, 0xc1, 0x22, 0xfe, 0xa5, 0x25, 0x2e, 0x60, 0x7e,
-	0x33, 0x23, 0x97, 0x10, 0xc2, 0x19, 0xfe, 0xa5, 0x25, 0x64, 0xb8, 0xc3, 0x81, 0x8b, 0x0f, 0x62,
-	0x4d, 0x66, 0x1e, 0xd1, 0xce, 0xe0, 0x01, 0x8b, 0x78, 0xe6, 0x81, 0x5d, 0xe1, 0x14, 0x70, 0xe2,
-	0x91, 0x1c, 0xe3, 0x85, 0x47, 0x72, 0x8c, 0x0f, 0x1e, 0xc9, 0x31, 0x4e, 0x78, 0x2c, 0xc7, 0x70,
-	0xe1, 0xb1, 0x1c, 0xc3, 0x8d, 0xc7, 0x72, 0x0c, 0x51, 0x66, 0xe9, 0x99, 0x25, 0x19, 0xa5, 0x49,
-	0x7a, 0xc9, 0xf9, 0xb9, 0xfa, 0xd0, 0x30, 0xd6, 0xcd, 0x49, 0x4c, 0x2a, 0x86, 0x71, 0xf4, 0xcb,
-	0x0c, 0x8d, 0xf5, 0x2b, 0x90, 0xe3, 0xa8, 0xa4, 0xb2, 0x20, 0xb5, 0x38, 0x89, 0x0d, 0x1c, 0xca,
-	0xc6, 0x80, 0x00, 0x00, 0x00, 0xff, 0xff, 0xb9, 0x4f, 0xbf, 0xa2, 0xc6, 0x01, 0x00, 0x00,
+	// 437 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x93, 0x31, 0xcf, 0xd2, 0x40,
+	0x18, 0xc7, 0x7b, 0xfa, 0xfa, 0x1a, 0xcf, 0x57, 0xd4, 0x86, 0x00, 0x61, 0x68, 0xc9, 0x0d, 0x86,
+	0x84, 0x70, 0x0d, 0x92, 0x38, 0x30, 0x69, 0xe3, 0x60, 0x27, 0x4c, 0x99, 0x64, 0x69, 0x5a, 0xda,
+	0x60, 0x43, 0x7b, 0xd7, 0x70, 0x57, 0x90, 0xd9, 0xcd, 0xc9, 0x8f, 0xe0, 0xc7, 0x61, 0x64, 0x34,
+	0x0e, 0x8d, 0x81, 0x98, 0x18, 0x47, 0x3e, 0x81, 0xe9, 0xb5, 0xb5, 0x05, 0xa3, 0x41, 0xa7, 0xde,
+	0x3d, 0x7d, 0x9e, 0x7b, 0x7e, 0xff, 0xff, 0x73, 0x07, 0x7b, 0x94, 0x85, 0x94, 0xf9, 0x4c, 0x63,
+	0x6b, 0x3b, 0x5a, 0xd2, 0x98, 0x7b, 0x4b, 0x6d, 0x35, 0x70, 0x3c, 0x6e, 0x0f, 0x44, 0xc8, 0x12,
+	0x31, 0x1c, 0x2d, 0x29, 0xa7, 0x72, 0x3b, 0x4f, 0xc6, 0x65, 0x32, 0xce, 0x93, 0xdb, 0xf5, 0x39,
+	0x9d, 0x53, 0x91, 0xa6, 0xa5, 0xab, 0xac, 0x02, 0x7d, 0x00, 0xf0, 0xf1, 0x64, 0x6d, 0x47, 0x2f,
+	0x42, 0x1a, 0x13, 0x6e, 0x10, 0x33, 0x2d, 0x92, 0x7b, 0xf0, 0x6e, 0x44, 0x69, 0x60, 0xf9, 0x6e,
+	0x0b, 0x74, 0x40, 0xf7, 0x4a, 0x97, 0x8f, 0x89, 0x5a, 0xdb, 0xd8, 0x61, 0x30, 0x42, 0xf9, 0x0f,
+	0x64, 0x5e, 0xa7, 0x2b, 0xc3, 0x95, 0x75, 0xf8, 0x90, 0xd3, 0x85, 0x47, 0x2c, 0x1a, 0x73, 0xcb,
+	0xf5, 0x08, 0x0d, 0x5b, 0xb7, 0x3a, 0xa0, 0x7b, 0x4f, 0x6f, 0x1f, 0x13, 0xb5, 0x91, 0x15, 0x9d,
+	0x25, 0x20, 0xf3, 0x81, 0x88, 0x8c, 0x63, 0xfe, 0x32, 0xdd, 0x8f, 0xae, 0xbe, 0x7f, 0x52, 0x01,
+	0x7a, 0x0f, 0xa0, 0x5c, 0xc2, 0x8c, 0x63, 0xfe, 0x1f, 0x34, 0xcf, 0x61, 0x2d, 0x6b, 0xe6, 0x93,
+	0x8b, 0x61, 0x6e, 0x44, 0xc4, 0x20, 0x82, 0x05, 0x7d, 0x03, 0xb0, 0x51, 0xb5, 0x64, 0x12, 0x05,
+	0x7e, 0x4e, 0xf2, 0x06, 0xde, 0x49, 0xdb, 0xb0, 0x16, 0xe8, 0xdc, 0xee, 0xde, 0x7f, 0xda, 0xc7,
+	0x7f, 0xf6, 0x1b, 0xff, 0xe6, 0xaa, 0x5e, 0xdf, 0x26, 0xaa, 0x74, 0x4c, 0xd4, 0x9b, 0x12, 0x9d,
+	0x21, 0x33, 0x3b, 0x51, 0x8e, 0x0a, 0x17, 0x7d, 0x62, 0xd9, 0xa2, 0x2c, 0x07, 0x7f, 0x95, 0x56,
+	0x7d, 0x49, 0xd4, 0x27, 0x73, 0x9f, 0xbf, 0x8d, 0x1d, 0x3c, 0xa3, 0xa1, 0x36, 0x13, 0x7d, 0xf3,
+	0x4f, 0x9f, 0xb9, 0x0b, 0x8d, 0x6f, 0x22, 0x8f, 0x61, 0x83, 0xf0, 0x73, 0x99, 0xbf, 0x8e, 0x2b,
+	0x3c, 0x37, 0x48, 0x46, 0x85, 0x7e, 0x00, 0xd8, 0x3c, 0x71, 0xbb, 0x22, 0x74, 0x7a, 0x2a, 0x14,
+	0x5f, 0x26, 0xb4, 0x98, 0xd8, 0xdf, 0x95, 0x32, 0xf8, 0xa8, 0x9c, 0xc0, 0x89, 0x54, 0xe3, 0x9f,
+	0xa5, 0x36, 0xcf, 0x27, 0x5a, 0x68, 0xad, 0x15, 0xf7, 0x2b, 0x23, 0xd3, 0x5f, 0x6f, 0xf7, 0x0a,
+	0xd8, 0xed, 0x15, 0xf0, 0x75, 0xaf, 0x80, 0x8f, 0x07, 0x45, 0xda, 0x1d, 0x14, 0xe9, 0xf3, 0x41,
+	0x91, 0xa6, 0xcf, 0x2a, 0xcd, 0x72, 0x95, 0xfd, 0xc0, 0x76, 0x58, 0xb1, 0xd1, 0x56, 0x83, 0xa1,
+	0xf6, 0xae, 0xfa, 0xfc, 0x04, 0x80, 0x73, 0x2d, 0x1e, 0xd0, 0xf0, 0xe7, 0x00, 0xdf, 0xe4, 0x60,
+	0x10, 0xa1, 0x03, 0x00, 0x00,
 }
 
+func (this *SwapAmountInRoute) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*SwapAmountInRoute)
+	if !ok {
+		that2, ok := that.(SwapAmountInRoute)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.PoolId != that1.PoolId {
+		return false
+	}
+	if this.TokenOutDenom != that1.TokenOutDenom {
+		return false
+	}
+	return true
+}
 func (m *SwapAmountInRoute) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -228,6 +364,100 @@ func (m *SwapAmountOutRoute) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *SwapAmountInSplitRoute) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SwapAmountInSplitRoute) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SwapAmountInSplitRoute) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.TokenInAmount.Size()
+		i -= size
+		if _, err := m.TokenInAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSwapRoute(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Pools) > 0 {
+		for iNdEx := len(m.Pools) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Pools[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSwapRoute(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SwapAmountOutSplitRoute) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SwapAmountOutSplitRoute) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SwapAmountOutSplitRoute) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.TokenOutAmount.Size()
+		i -= size
+		if _, err := m.TokenOutAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSwapRoute(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Pools) > 0 {
+		for iNdEx := len(m.Pools) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Pools[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSwapRoute(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintSwapRoute(dAtA []byte, offset int, v uint64) int {
 	offset -= sovSwapRoute(v)
 	base := offset
@@ -271,6 +501,40 @@ func (m *SwapAmountOutRoute) Size() (n int) {
 	return n
 }
 
+func (m *SwapAmountInSplitRoute) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Pools) > 0 {
+		for _, e := range m.Pools {
+			l = e.Size()
+			n += 1 + l + sovSwapRoute(uint64(l))
+		}
+	}
+	l = m.TokenInAmount.Size()
+	n += 1 + l + sovSwapRoute(uint64(l))
+	return n
+}
+
+func (m *SwapAmountOutSplitRoute) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Pools) > 0 {
+		for _, e := range m.Pools {
+			l = e.Size()
+			n += 1 + l + sovSwapRoute(uint64(l))
+		}
+	}
+	l = m.TokenOutAmount.Size()
+	n += 1 + l + sovSwapRoute(uint64(l))
+	return n
+}
+
 func sovSwapRoute(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -479,6 +743,242 @@ func (m *SwapAmountOutRoute) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *SwapAmountInSplitRoute) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSwapRoute
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SwapAmountInSplitRoute: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SwapAmountInSplitRoute: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pools", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwapRoute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSwapRoute
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwapRoute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pools = append(m.Pools, SwapAmountInRoute{})
+			if err := m.Pools[len(m.Pools)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenInAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwapRoute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSwapRoute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwapRoute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TokenInAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSwapRoute(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSwapRoute
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SwapAmountOutSplitRoute) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSwapRoute
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SwapAmountOutSplitRoute: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SwapAmountOutSplitRoute: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pools", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwapRoute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSwapRoute
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwapRoute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pools = append(m.Pools, SwapAmountOutRoute{})
+			if err := m.Pools[len(m.Pools)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOutAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwapRoute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSwapRoute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwapRoute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TokenOutAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSwapRoute(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSwapRoute
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipSwapRoute(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
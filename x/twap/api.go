@@ -14,7 +14,6 @@ const (
 	// arithmeticTwapType is the type of twap that is calculated by taking the arithmetic weighted average of the spot prices.
 	arithmeticTwapType twapType = true
 	// geometricTwapType is the type of twap that is calculated by taking the geometric weighted average of the spot prices.
-	// nolint: unused
 	geometricTwapType twapType = false
 )
 
@@ -28,8 +27,11 @@ const (
 // the state machine will interpolate the accumulator values for those times
 // from the latest Twap accumulation record prior to the provided time.
 //
-// startTime must be within 48 hours of ctx.BlockTime(), if you need older TWAPs,
-// you will have to maintain the accumulator yourself.
+// startTime should be within RecordHistoryKeepPeriod (48 hours) of ctx.BlockTime() for an exact TWAP.
+// Older startTimes are served from the sparse historical checkpoints configured by the CheckpointTiers param
+// (by default one per hour for 30 days, and one per day for a year).
+// These are interpolated from the latest checkpoint at or before startTime,
+// and are exact if no records were written between that checkpoint and startTime.
 //
 // endTime will be set in the function ArithmeticTwap() to ctx.BlockTime() which calls GetArithmeticTwap function if:
 // * it is not provided externally
@@ -38,12 +40,12 @@ const (
 // This function will error if:
 // * startTime > endTime
 // * endTime in the future
-// * startTime older than 48 hours OR pool creation
+// * startTime older than the oldest retained checkpoint OR pool creation
 // * pool with id poolId does not exist, or does not contain quoteAssetDenom, baseAssetDenom
 // * there were some computational errors during computing arithmetic twap within the time range of
 //   startRecord, endRecord - including the exact record times, which indicates that the result returned could be faulty
 
-// N.B. If there is a notable use case, the state machine could maintain more historical records, e.g. at one per hour.
+// N.B. TWAPs older than RecordHistoryKeepPeriod are computed from checkpoints, see getInterpolatedRecord.
 func (k Keeper) GetArithmeticTwap(
 	ctx sdk.Context,
 	poolId uint64,
@@ -69,6 +71,44 @@ func (k Keeper) GetArithmeticTwapToNow(
 	return k.getTwapToNow(ctx, poolId, baseAssetDenom, quoteAssetDenom, startTime, arithmeticStrategy)
 }
 
+// GetGeometricTwap returns a geometric time weighted average price.
+// The returned twap is the geometric mean of the spot prices of:
+// * the base asset, in units of the quote asset (1 unit of base = x units of quote)
+// * from (startTime, endTime),
+// * as determined by prices from AMM pool `poolId`.
+//
+// It follows the same interpolation, time bound and spot price error semantics as GetArithmeticTwap.
+// A geometric mean is less sensitive to short-lived price spikes than an arithmetic mean,
+// which makes it harder to manipulate.
+//
+// In addition to the errors returned by GetArithmeticTwap, this function will error with
+// types.GeometricTwapUnavailableError if startTime precedes the time at which the geometric
+// accumulator began being populated for the given pool and asset pair.
+func (k Keeper) GetGeometricTwap(
+	ctx sdk.Context,
+	poolId uint64,
+	baseAssetDenom string,
+	quoteAssetDenom string,
+	startTime time.Time,
+	endTime time.Time,
+) (sdk.Dec, error) {
+	geometricStrategy := &geometric{k}
+	return k.getTwap(ctx, poolId, baseAssetDenom, quoteAssetDenom, startTime, endTime, geometricStrategy)
+}
+
+// GetGeometricTwapToNow returns geometric twap from start time until the current block time for quote and base
+// assets in a given pool.
+func (k Keeper) GetGeometricTwapToNow(
+	ctx sdk.Context,
+	poolId uint64,
+	baseAssetDenom string,
+	quoteAssetDenom string,
+	startTime time.Time,
+) (sdk.Dec, error) {
+	geometricStrategy := &geometric{k}
+	return k.getTwapToNow(ctx, poolId, baseAssetDenom, quoteAssetDenom, startTime, geometricStrategy)
+}
+
 // getTwap computes and returns twap from the start time until the end time. The type
 // of twap returned depends on the strategy given and can be either arithmetic or geometric.
 func (k Keeper) getTwap(
@@ -7,6 +7,8 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	"github.com/osmosis-labs/osmosis/v13/app/apptesting/osmoassert"
+	"github.com/osmosis-labs/osmosis/v13/osmomath"
 	"github.com/osmosis-labs/osmosis/v13/x/twap"
 	"github.com/osmosis-labs/osmosis/v13/x/twap/types"
 )
@@ -597,6 +599,61 @@ func (s *TestSuite) TestGetArithmeticTwap_PruningRecordKeepPeriod() {
 	}
 }
 
+// TestGetArithmeticTwap_Checkpoints tests that TWAPs with a start time older than
+// the record history keep period are served from checkpoints after pruning.
+func (s *TestSuite) TestGetArithmeticTwap_Checkpoints() {
+	day := 24 * time.Hour
+
+	// sp0 = 10 at base time, 30 at base time + 3 days, 20 at base time + 6 days.
+	recordPlus3Days := twap.RecordWithUpdatedAccumulators(baseRecord, baseTime.Add(3*day))
+	recordPlus3Days.P0LastSpotPrice, recordPlus3Days.P1LastSpotPrice = sdk.NewDec(30), sdk.OneDec().Quo(sdk.NewDec(30))
+	recordPlus6Days := twap.RecordWithUpdatedAccumulators(recordPlus3Days, baseTime.Add(6*day))
+	recordPlus6Days.P0LastSpotPrice, recordPlus6Days.P1LastSpotPrice = sdk.NewDec(20), sdk.OneDec().Quo(sdk.NewDec(20))
+
+	ctxTime := baseTime.Add(7*day + time.Hour)
+
+	tests := map[string]struct {
+		input       getTwapInput
+		expTwap     sdk.Dec
+		expectError error
+	}{
+		"7 day twap; no records between checkpoint and start time; exact": {
+			input: makeSimpleTwapInput(baseTime.Add(time.Hour), ctxTime, baseQuoteBA),
+			// expTwap: = (10 * 71h + 30 * 72h + 20 * 25h) / 168h = 20.059523809523809523
+			expTwap: sdk.MustNewDecFromStr("20.059523809523809523"),
+		},
+		"start time before first checkpoint; error": {
+			input:       makeSimpleTwapInput(baseTime.Add(-time.Millisecond), ctxTime, baseQuoteBA),
+			expectError: twap.TimeTooOldError{Time: baseTime.Add(-time.Millisecond)},
+		},
+	}
+
+	for name, test := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			s.preSetRecords([]types.TwapRecord{baseRecord, recordPlus3Days, recordPlus6Days})
+			s.Ctx = s.Ctx.WithBlockTime(ctxTime)
+
+			s.Require().NoError(s.twapkeeper.PruneRecords(s.Ctx))
+			// base record is pruned from the historical records.
+			_, err := s.twapkeeper.GetRecordAtOrBeforeTime(s.Ctx, baseRecord.PoolId, test.input.startTime, denom0, denom1)
+			s.Require().ErrorIs(err, twap.TimeTooOldError{Time: test.input.startTime})
+
+			twap, err := s.twapkeeper.GetArithmeticTwap(s.Ctx, test.input.poolId,
+				test.input.baseAssetDenom, test.input.quoteAssetDenom,
+				test.input.startTime, test.input.endTime)
+
+			if test.expectError != nil {
+				s.Require().Error(err)
+				s.Require().ErrorIs(err, test.expectError)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().Equal(test.expTwap, twap)
+		})
+	}
+}
+
 func (s *TestSuite) TestGetArithmeticTwap_PruningRecordKeepPeriod_ThreeAsset() {
 	var (
 		defaultRecordHistoryKeepPeriod = types.DefaultParams().RecordHistoryKeepPeriod
@@ -837,3 +894,162 @@ func (s *TestSuite) TestGetArithmeticTwapToNow_ThreeAsset() {
 		})
 	}
 }
+
+// TestGetGeometricTwap tests if we get the expected twap value from `GetGeometricTwap`
+// and `GetGeometricTwapToNow`. Since the geometric twap is computed with approximated
+// logarithm and power functions, results are compared within the pow precision.
+func (s *TestSuite) TestGetGeometricTwap() {
+	// geometric mean of 10 for 10s and 5 for 10s = sqrt(10 * 5)
+	sqrtFifty, err := sdk.NewDec(50).ApproxSqrt()
+	s.Require().NoError(err)
+
+	// record with a zero spot price at t = baseTime + 10, which getSpotPrices records as an error.
+	zeroSpTPlus10Record := withLastErrTime(withSp1(withSp0(tPlus10sp5Record, sdk.ZeroDec()), sdk.ZeroDec()), tPlus10sp5Record.Time)
+
+	tests := map[string]struct {
+		recordsToSet  []types.TwapRecord
+		ctxTime       time.Time
+		input         getTwapInput
+		expTwap       sdk.Dec
+		expectedError error
+	}{
+		"(1 record) start and end point to same record": {
+			recordsToSet: []types.TwapRecord{baseRecord},
+			ctxTime:      tPlusOneMin,
+			input:        makeSimpleTwapInput(baseTime, tPlusOne, baseQuoteBA),
+			expTwap:      sdk.NewDec(10),
+		},
+		"(1 record) start and end point to same record, use sp1": {
+			recordsToSet: []types.TwapRecord{baseRecord},
+			ctxTime:      tPlusOneMin,
+			input:        makeSimpleTwapInput(baseTime, tPlusOne, baseQuoteAB),
+			expTwap:      sdk.NewDecWithPrec(1, 1),
+		},
+		"(1 record) price below one": {
+			recordsToSet: []types.TwapRecord{newTwoAssetPoolTwapRecordWithDefaults(baseTime, sdk.NewDecWithPrec(1, 1), sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec())},
+			ctxTime:      tPlusOneMin,
+			input:        makeSimpleTwapInput(baseTime, tPlusOne, baseQuoteBA),
+			expTwap:      sdk.NewDecWithPrec(1, 1),
+		},
+		"(2 record) start at first record, end after second record": {
+			recordsToSet: []types.TwapRecord{baseRecord, tPlus10sp5Record},
+			ctxTime:      tPlusOneMin,
+			input:        makeSimpleTwapInput(baseTime, baseTime.Add(20*time.Second), baseQuoteBA),
+			expTwap:      sqrtFifty,
+		},
+		"(2 record) start at first record, end after second record, use sp1": {
+			recordsToSet: []types.TwapRecord{baseRecord, tPlus10sp5Record},
+			ctxTime:      tPlusOneMin,
+			input:        makeSimpleTwapInput(baseTime, baseTime.Add(20*time.Second), baseQuoteAB),
+			expTwap:      sdk.OneDec().Quo(sqrtFifty),
+		},
+
+		// error catching
+		"end time in future": {
+			recordsToSet:  []types.TwapRecord{baseRecord},
+			ctxTime:       baseTime,
+			input:         makeSimpleTwapInput(baseTime, tPlusOne, baseQuoteBA),
+			expectedError: types.EndTimeInFutureError{BlockTime: baseTime, EndTime: tPlusOne},
+		},
+		"start time after end time": {
+			recordsToSet:  []types.TwapRecord{baseRecord},
+			ctxTime:       tPlusOneMin,
+			input:         makeSimpleTwapInput(tPlusOne, baseTime, baseQuoteBA),
+			expectedError: types.StartTimeAfterEndTimeError{StartTime: tPlusOne, EndTime: baseTime},
+		},
+		"start time too old": {
+			recordsToSet:  []types.TwapRecord{baseRecord},
+			ctxTime:       tPlusOneMin,
+			input:         makeSimpleTwapInput(baseTime.Add(-time.Hour), baseTime, baseQuoteBA),
+			expectedError: twap.TimeTooOldError{Time: baseTime.Add(-time.Hour)},
+		},
+		// the zero spot price is left out of the geometric accumulator, so the twap must not be returned as valid.
+		"zero spot price within window": {
+			recordsToSet:  []types.TwapRecord{baseRecord, zeroSpTPlus10Record},
+			ctxTime:       tPlusOneMin,
+			input:         makeSimpleTwapInput(baseTime, baseTime.Add(20*time.Second), baseQuoteBA),
+			expectedError: spotPriceError,
+		},
+		"zero spot price at end time": {
+			recordsToSet:  []types.TwapRecord{baseRecord, zeroSpTPlus10Record},
+			ctxTime:       tPlusOneMin,
+			input:         makeSimpleTwapInput(baseTime, baseTime.Add(10*time.Second), baseQuoteBA),
+			expectedError: spotPriceError,
+		},
+		"spot price error in record before window": {
+			recordsToSet: []types.TwapRecord{withLastErrTime(baseRecord, tMinOne)},
+			ctxTime:      tPlusOneMin,
+			input:        makeSimpleTwapInput(baseTime, tPlusOne, baseQuoteBA),
+			expTwap:      sdk.NewDec(10),
+		},
+	}
+	for name, test := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			s.preSetRecords(test.recordsToSet)
+			s.Ctx = s.Ctx.WithBlockTime(test.ctxTime)
+
+			twap, err := s.twapkeeper.GetGeometricTwap(s.Ctx, test.input.poolId,
+				test.input.baseAssetDenom, test.input.quoteAssetDenom,
+				test.input.startTime, test.input.endTime)
+
+			if test.expectedError != nil {
+				s.Require().Error(err)
+				s.Require().Equal(test.expectedError, err)
+				return
+			}
+			s.Require().NoError(err)
+			osmoassert.DecApproxEq(s.T(), test.expTwap, twap, osmomath.GetPowPrecision())
+
+			// the same window ending at the current block time must match `GetGeometricTwapToNow`.
+			s.Ctx = s.Ctx.WithBlockTime(test.input.endTime)
+			twapToNow, err := s.twapkeeper.GetGeometricTwapToNow(s.Ctx, test.input.poolId,
+				test.input.baseAssetDenom, test.input.quoteAssetDenom,
+				test.input.startTime)
+			s.Require().NoError(err)
+			s.Require().Equal(twap, twapToNow)
+		})
+	}
+}
+
+// TestGeometricTwap_UnpopulatedAccumulator tests that records written before the geometric
+// accumulator was populated result in an error, rather than a silently wrong twap.
+func (s *TestSuite) TestGeometricTwap_UnpopulatedAccumulator() {
+	unpopulatedRecord := withGeomAccum(baseRecord, sdk.Dec{})
+	// the first record written after the unpopulated one starts the geometric accumulator at zero.
+	populatedRecord := withGeomAccum(tPlus10sp5Record, sdk.ZeroDec())
+
+	tests := map[string]struct {
+		startRecord   types.TwapRecord
+		endRecord     types.TwapRecord
+		expectedError error
+	}{
+		"start record unpopulated": {
+			startRecord:   unpopulatedRecord,
+			endRecord:     populatedRecord,
+			expectedError: types.GeometricTwapUnavailableError{PoolId: unpopulatedRecord.PoolId, StartTime: unpopulatedRecord.Time},
+		},
+		"start and end record unpopulated": {
+			startRecord:   unpopulatedRecord,
+			endRecord:     twap.RecordWithUpdatedAccumulators(unpopulatedRecord, tPlusOne),
+			expectedError: types.GeometricTwapUnavailableError{PoolId: unpopulatedRecord.PoolId, StartTime: unpopulatedRecord.Time},
+		},
+		"start record populated": {
+			startRecord: populatedRecord,
+			endRecord:   twap.RecordWithUpdatedAccumulators(populatedRecord, populatedRecord.Time.Add(time.Second)),
+		},
+	}
+	for name, test := range tests {
+		s.Run(name, func() {
+			twap, err := twap.ComputeGeometricStrategyTwap(*s.twapkeeper, test.startRecord, test.endRecord, denom0)
+
+			if test.expectedError != nil {
+				s.Require().Error(err)
+				s.Require().Equal(test.expectedError, err)
+				return
+			}
+			s.Require().NoError(err)
+			osmoassert.DecApproxEq(s.T(), test.startRecord.P0LastSpotPrice, twap, osmomath.GetPowPrecision())
+		})
+	}
+}
@@ -19,6 +19,7 @@ import (
 func GetQueryCmd() *cobra.Command {
 	cmd := osmocli.QueryIndexCmd(types.ModuleName)
 	cmd.AddCommand(GetQueryTwapCommand())
+	cmd.AddCommand(GetQueryGeometricTwapCommand())
 
 	return cmd
 }
@@ -46,26 +47,62 @@ Example:
 			if err != nil {
 				return err
 			}
+			quoteDenom, err := getQuoteDenom(cmd, clientCtx, poolId, baseDenom)
+			if err != nil {
+				return err
+			}
+
 			queryClient := queryproto.NewQueryClient(clientCtx)
-			gammClient := gammtypes.NewQueryClient(clientCtx)
-			liquidity, err := gammClient.TotalPoolLiquidity(cmd.Context(), &gammtypes.QueryTotalPoolLiquidityRequest{PoolId: poolId})
+			res, err := queryClient.ArithmeticTwap(cmd.Context(), &queryproto.ArithmeticTwapRequest{
+				PoolId:     poolId,
+				BaseAsset:  baseDenom,
+				QuoteAsset: quoteDenom,
+				StartTime:  startTime,
+				EndTime:    &endTime,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetQueryGeometricTwapCommand returns the geometric twap of an asset by denom.
+func GetQueryGeometricTwapCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "geometric-twap [poolid] [base denom] [start time] [end time]",
+		Short: "Query geometric twap",
+		Long: osmocli.FormatLongDescDirect(`Query geometric twap for pool. Start time must be unix time. End time can be unix time or duration.
+
+Example:
+{{.CommandPrefix}} geometric-twap 1 uosmo 1667088000 24h
+{{.CommandPrefix}} geometric-twap 1 uosmo 1667088000 1667174400
+`, types.ModuleName),
+		Args: cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// boilerplate parse fields
+			poolId, baseDenom, startTime, endTime, err := twapQueryParseArgs(args)
 			if err != nil {
 				return err
 			}
-			if len(liquidity.Liquidity) != 2 {
-				return fmt.Errorf("pool %d has %d assets of liquidity, CLI support only exists for 2 assets right now.", poolId, len(liquidity.Liquidity))
+
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
 			}
-			quoteDenom := ""
-			if liquidity.Liquidity[0].Denom == baseDenom {
-				quoteDenom = liquidity.Liquidity[1].Denom
-			} else if liquidity.Liquidity[1].Denom == baseDenom {
-				quoteDenom = liquidity.Liquidity[0].Denom
-			} else {
-				return fmt.Errorf("pool %d doesn't have provided baseDenom %s, has %s and %s",
-					poolId, baseDenom, liquidity.Liquidity[0], liquidity.Liquidity[1])
+			quoteDenom, err := getQuoteDenom(cmd, clientCtx, poolId, baseDenom)
+			if err != nil {
+				return err
 			}
 
-			res, err := queryClient.ArithmeticTwap(cmd.Context(), &queryproto.ArithmeticTwapRequest{
+			queryClient := queryproto.NewQueryClient(clientCtx)
+			res, err := queryClient.GeometricTwap(cmd.Context(), &queryproto.GeometricTwapRequest{
 				PoolId:     poolId,
 				BaseAsset:  baseDenom,
 				QuoteAsset: quoteDenom,
@@ -85,6 +122,25 @@ Example:
 	return cmd
 }
 
+// getQuoteDenom returns the denom of the other asset in a two asset pool.
+func getQuoteDenom(cmd *cobra.Command, clientCtx client.Context, poolId uint64, baseDenom string) (string, error) {
+	gammClient := gammtypes.NewQueryClient(clientCtx)
+	liquidity, err := gammClient.TotalPoolLiquidity(cmd.Context(), &gammtypes.QueryTotalPoolLiquidityRequest{PoolId: poolId})
+	if err != nil {
+		return "", err
+	}
+	if len(liquidity.Liquidity) != 2 {
+		return "", fmt.Errorf("pool %d has %d assets of liquidity, CLI support only exists for 2 assets right now.", poolId, len(liquidity.Liquidity))
+	}
+	if liquidity.Liquidity[0].Denom == baseDenom {
+		return liquidity.Liquidity[1].Denom, nil
+	} else if liquidity.Liquidity[1].Denom == baseDenom {
+		return liquidity.Liquidity[0].Denom, nil
+	}
+	return "", fmt.Errorf("pool %d doesn't have provided baseDenom %s, has %s and %s",
+		poolId, baseDenom, liquidity.Liquidity[0], liquidity.Liquidity[1])
+}
+
 func twapQueryParseArgs(args []string) (poolId uint64, baseDenom string, startTime time.Time, endTime time.Time, err error) {
 	// boilerplate parse fields
 	// <UINT PARSE>
@@ -44,6 +44,18 @@ func (k Keeper) GetAllHistoricalPoolIndexedTWAPs(ctx sdk.Context) ([]types.TwapR
 	return k.getAllHistoricalPoolIndexedTWAPs(ctx)
 }
 
+func (k Keeper) GetAllCheckpoints(ctx sdk.Context) ([]types.TwapCheckpoint, error) {
+	return k.getAllCheckpoints(ctx)
+}
+
+func (k Keeper) StoreCheckpoint(ctx sdk.Context, checkpoint types.TwapCheckpoint) {
+	k.storeCheckpoint(ctx, checkpoint)
+}
+
+func (k Keeper) PruneCheckpoints(ctx sdk.Context, tiers []types.CheckpointTier) error {
+	return k.pruneCheckpoints(ctx, tiers)
+}
+
 func (k Keeper) TrackChangedPool(ctx sdk.Context, poolId uint64) {
 	k.trackChangedPool(ctx, poolId)
 }
@@ -84,6 +96,11 @@ func ComputeGeometricTwap(startRecord types.TwapRecord, endRecord types.TwapReco
 	return computeGeometricTwap(startRecord, endRecord, quoteAsset)
 }
 
+func ComputeGeometricStrategyTwap(k Keeper, startRecord types.TwapRecord, endRecord types.TwapRecord, quoteAsset string) (sdk.Dec, error) {
+	geometricStrategy := &geometric{k}
+	return geometricStrategy.computeTwap(startRecord, endRecord, quoteAsset)
+}
+
 func RecordWithUpdatedAccumulators(record types.TwapRecord, t time.Time) types.TwapRecord {
 	return recordWithUpdatedAccumulators(record, t)
 }
@@ -63,9 +63,16 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState *types.GenesisState) {
 	sort.Slice(genState.Twaps, func(i, j int) bool {
 		return genState.Twaps[i].Time.Before(genState.Twaps[j].Time)
 	})
+	fillGeometricTwapAccumulators(genState.Twaps)
 
+	// Records are stored without deriving checkpoints from them,
+	// as the checkpoints are imported as is.
 	for _, twap := range genState.Twaps {
-		k.storeNewRecord(ctx, twap)
+		k.storeRecord(ctx, twap)
+	}
+
+	for _, checkpoint := range genState.Checkpoints {
+		k.storeCheckpoint(ctx, checkpoint)
 	}
 }
 
@@ -78,8 +85,14 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		panic(err)
 	}
 
+	checkpoints, err := k.getAllCheckpoints(ctx)
+	if err != nil {
+		panic(err)
+	}
+
 	return &types.GenesisState{
-		Params: k.GetParams(ctx),
-		Twaps:  twapRecords,
+		Params:      k.GetParams(ctx),
+		Twaps:       twapRecords,
+		Checkpoints: checkpoints,
 	}
 }
@@ -10,6 +10,7 @@ import (
 
 	"github.com/osmosis-labs/osmosis/v13/app/apptesting"
 	"github.com/osmosis-labs/osmosis/v13/app/apptesting/osmoassert"
+	"github.com/osmosis-labs/osmosis/v13/osmomath"
 	"github.com/osmosis-labs/osmosis/v13/x/twap"
 	"github.com/osmosis-labs/osmosis/v13/x/twap/types"
 )
@@ -44,7 +45,7 @@ func (s *TestSuite) SetupTest() {
 }
 
 var (
-	basicParams = types.NewParams("week", 48*time.Hour)
+	basicParams = types.NewParams("week", 48*time.Hour, nil)
 
 	mostRecentRecordPoolOne = types.TwapRecord{
 		PoolId:                      basePoolId,
@@ -65,6 +66,16 @@ var (
 			mostRecentRecordPoolOne,
 		})
 
+	checkpointGenesis = &types.GenesisState{
+		Params: types.DefaultParams(),
+		Twaps:  []types.TwapRecord{mostRecentRecordPoolOne},
+		Checkpoints: []types.TwapCheckpoint{
+			newCheckpoint(0, withTime(mostRecentRecordPoolOne, baseTime.Add(-7*24*time.Hour))),
+			newCheckpoint(0, mostRecentRecordPoolOne),
+			newCheckpoint(1, withTime(mostRecentRecordPoolOne, baseTime.Add(-7*24*time.Hour))),
+		},
+	}
+
 	increasingOrderByTimeRecordsPoolOne = types.NewGenesisState(
 		basicParams,
 		[]types.TwapRecord{
@@ -149,11 +160,21 @@ func withPoolId(twap types.TwapRecord, poolId uint64) types.TwapRecord {
 	return twap
 }
 
+func withTime(twap types.TwapRecord, t time.Time) types.TwapRecord {
+	twap.Time = t
+	return twap
+}
+
 func withLastErrTime(twap types.TwapRecord, lastErrorTime time.Time) types.TwapRecord {
 	twap.LastErrorTime = lastErrorTime
 	return twap
 }
 
+func withGeomAccum(twap types.TwapRecord, geomAccum sdk.Dec) types.TwapRecord {
+	twap.GeometricTwapAccumulator = geomAccum
+	return twap
+}
+
 func withSp0(twap types.TwapRecord, sp sdk.Dec) types.TwapRecord {
 	twap.P0LastSpotPrice = sp
 	return twap
@@ -208,7 +229,7 @@ func (suite *TestSuite) TestTwapInitGenesis() {
 		},
 		"custom invalid genesis - error": {
 			twapGenesis: types.NewGenesisState(
-				types.NewParams("week", 48*time.Hour),
+				types.NewParams("week", 48*time.Hour, nil),
 				[]types.TwapRecord{
 					{
 						PoolId:                      0, // invalid
@@ -274,6 +295,9 @@ func (suite *TestSuite) TestTWAPExportGenesis() {
 		"custom multi-record; decreasing": {
 			expectedGenesis: decreasingOrderByTimeRecordsPoolTwo,
 		},
+		"custom genesis with checkpoints": {
+			expectedGenesis: checkpointGenesis,
+		},
 	}
 
 	for name, tc := range testCases {
@@ -299,10 +323,54 @@ func (suite *TestSuite) TestTWAPExportGenesis() {
 			})
 
 			suite.Require().Equal(tc.expectedGenesis.Twaps, actualGenesis.Twaps)
+			suite.Require().ElementsMatch(tc.expectedGenesis.Checkpoints, actualGenesis.Checkpoints)
 		})
 	}
 }
 
+// TestTwapGenesisRoundTrip_LegacyRecords tests that records written before geometric twap support,
+// which have no geometric accumulator, are imported with accumulators derived from the records
+// that follow them. The exported genesis is valid, and geometric twaps over the legacy records
+// are computed as if their accumulators had always been populated.
+func (suite *TestSuite) TestTwapGenesisRoundTrip_LegacyRecords() {
+	// spot price 10 for 10s, 5 for 10s, then 2. The last record is the first written after
+	// geometric twap support, so its accumulator starts at zero, see updateRecord.
+	legacyRecordSp10 := withGeomAccum(newTwoAssetPoolTwapRecordWithDefaults(baseTime, sdk.NewDec(10), sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec()), sdk.Dec{})
+	legacyRecordSp5 := withGeomAccum(newTwoAssetPoolTwapRecordWithDefaults(baseTime.Add(10*time.Second), sdk.NewDec(5), OneSec.MulInt64(10*10), OneSec, sdk.ZeroDec()), sdk.Dec{})
+	populatedRecordSp2 := newTwoAssetPoolTwapRecordWithDefaults(baseTime.Add(20*time.Second), sdk.NewDec(2), OneSec.MulInt64(10*10+5*10), OneSec.MulInt64(3), sdk.ZeroDec())
+	legacyRecordSp10.Height, legacyRecordSp5.Height, populatedRecordSp2.Height = 1, 2, 3
+
+	genesis := types.NewGenesisState(types.DefaultParams(), []types.TwapRecord{legacyRecordSp10, legacyRecordSp5, populatedRecordSp2})
+	suite.Require().NoError(genesis.Validate())
+
+	suite.SetupTest()
+	suite.Ctx = suite.Ctx.WithBlockTime(tPlusOneMin)
+	suite.twapkeeper.InitGenesis(suite.Ctx, genesis)
+
+	exportedGenesis := suite.twapkeeper.ExportGenesis(suite.Ctx)
+	suite.Require().NoError(exportedGenesis.Validate())
+
+	geomAccumSp5 := OneSec.MulInt64(10).Mul(twap.TwapLog(sdk.NewDec(5))).Neg()
+	geomAccumSp10 := geomAccumSp5.Sub(OneSec.MulInt64(10).Mul(logTen))
+	suite.Require().Equal([]types.TwapRecord{
+		withGeomAccum(legacyRecordSp10, geomAccumSp10),
+		withGeomAccum(legacyRecordSp5, geomAccumSp5),
+		populatedRecordSp2,
+	}, exportedGenesis.Twaps)
+
+	suite.SetupTest()
+	suite.Ctx = suite.Ctx.WithBlockTime(tPlusOneMin)
+	suite.twapkeeper.InitGenesis(suite.Ctx, exportedGenesis)
+	suite.Require().Equal(exportedGenesis, suite.twapkeeper.ExportGenesis(suite.Ctx))
+
+	// geometric mean of 10 for 10s and 5 for 10s = sqrt(10 * 5)
+	sqrtFifty, err := sdk.NewDec(50).ApproxSqrt()
+	suite.Require().NoError(err)
+	geometricTwap, err := suite.twapkeeper.GetGeometricTwap(suite.Ctx, basePoolId, denom1, denom0, baseTime, baseTime.Add(20*time.Second))
+	suite.Require().NoError(err)
+	osmoassert.DecApproxEq(suite.T(), sqrtFifty, geometricTwap, osmomath.GetPowPrecision())
+}
+
 // sets up a new two asset pool, with spot price 1
 func (s *TestSuite) setupDefaultPool() (poolId uint64, denomA, denomB string) {
 	poolId = s.PrepareBalancerPoolWithCoins(defaultTwoAssetCoins[0], defaultTwoAssetCoins[1])
@@ -454,6 +522,10 @@ func newThreeAssetPoolTwapRecordWithDefaults(t time.Time, sp0, accumA, accumB, a
 	return twapAB, twapAC, twapBC
 }
 
+func newCheckpoint(tier uint32, record types.TwapRecord) types.TwapCheckpoint {
+	return types.TwapCheckpoint{Tier: tier, Record: record}
+}
+
 func newEmptyPriceRecord(poolId uint64, t time.Time, asset0 string, asset1 string) types.TwapRecord {
 	return types.TwapRecord{
 		PoolId:      poolId,
@@ -14,11 +14,7 @@ import (
 // geometricTwapMathBase is the base used for geometric twap calculation
 // in logarithm and power math functions.
 // See twapLog and computeGeometricTwap functions for more details.
-var (
-	geometricTwapMathBase = sdk.NewDec(2)
-	// TODO: analyze choice.
-	geometricTwapPowPrecision = sdk.MustNewDecFromStr("0.00000001")
-)
+var geometricTwapMathBase = sdk.NewDec(2)
 
 func newTwapRecord(k types.AmmInterface, ctx sdk.Context, poolId uint64, denom0, denom1 string) (types.TwapRecord, error) {
 	denom0, denom1, err := types.LexicographicalOrderDenoms(denom0, denom1)
@@ -47,6 +43,8 @@ func newTwapRecord(k types.AmmInterface, ctx sdk.Context, poolId uint64, denom0,
 // returns spot prices for both pairs of assets, and the 'latest error time'.
 // The latest error time is the previous time if there is no error in getting spot prices.
 // if there is an error in getting spot prices, then the latest error time is ctx.Blocktime()
+// A zero spot price is also treated as an error, since its logarithm is undefined and it
+// cannot be accounted for in the geometric accumulator.
 func getSpotPrices(
 	ctx sdk.Context,
 	k types.AmmInterface,
@@ -71,6 +69,9 @@ func getSpotPrices(
 			sp1 = sdk.ZeroDec()
 		}
 	}
+	if sp0.IsZero() || sp1.IsZero() {
+		latestErrTime = ctx.BlockTime()
+	}
 	if sp0.GT(types.MaxSpotPrice) {
 		sp0, latestErrTime = types.MaxSpotPrice, ctx.BlockTime()
 	}
@@ -157,6 +158,13 @@ func (k Keeper) updateRecord(ctx sdk.Context, record types.TwapRecord) types.Twa
 	newRecord := recordWithUpdatedAccumulators(record, ctx.BlockTime())
 	newRecord.Height = ctx.BlockHeight()
 
+	// records written before geometric twap support have no geometric accumulator.
+	// We start populating it from this record onwards. Geometric twaps starting before
+	// this record's time are reported as unavailable, see GetGeometricTwap.
+	if newRecord.GeometricTwapAccumulator.IsNil() {
+		newRecord.GeometricTwapAccumulator = sdk.ZeroDec()
+	}
+
 	newSp0, newSp1, lastErrorTime := getSpotPrices(
 		ctx, k.ammkeeper, record.PoolId, record.Asset0Denom, record.Asset1Denom, record.LastErrorTime)
 
@@ -168,6 +176,39 @@ func (k Keeper) updateRecord(ctx sdk.Context, record types.TwapRecord) types.Twa
 	return newRecord
 }
 
+// fillGeometricTwapAccumulators sets the geometric accumulators of the records written before
+// geometric twap support. Their nil accumulator would be stored as zero, so geometric twaps over
+// them would no longer be reported as unavailable but silently computed from zero.
+// Instead, each such accumulator is derived backwards from the next record of the same pool
+// and asset pair, as if it had always been populated. The most recent record of a pair
+// starts from zero, since twaps only depend on accumulator differences.
+//
+// pre-condition: records are sorted by time in increasing order.
+func fillGeometricTwapAccumulators(records []types.TwapRecord) {
+	type pairKey struct {
+		poolId         uint64
+		asset0, asset1 string
+	}
+	nextRecords := map[pairKey]types.TwapRecord{}
+	for i := len(records) - 1; i >= 0; i-- {
+		record := &records[i]
+		key := pairKey{poolId: record.PoolId, asset0: record.Asset0Denom, asset1: record.Asset1Denom}
+		nextRecord, hasNextRecord := nextRecords[key]
+		if record.GeometricTwapAccumulator.IsNil() {
+			record.GeometricTwapAccumulator = sdk.ZeroDec()
+			if hasNextRecord {
+				record.GeometricTwapAccumulator = nextRecord.GeometricTwapAccumulator
+				// zero spot prices are left out of the accumulator, see recordWithUpdatedAccumulators.
+				if !record.P0LastSpotPrice.IsZero() {
+					geomAccum := types.SpotPriceMulDuration(twapLog(record.P0LastSpotPrice), nextRecord.Time.Sub(record.Time))
+					record.GeometricTwapAccumulator = record.GeometricTwapAccumulator.Sub(geomAccum)
+				}
+			}
+		}
+		nextRecords[key] = *record
+	}
+}
+
 // pruneRecords prunes twap records that happened earlier than recordHistoryKeepPeriod
 // before current block time while preserving the most recent record before the threshold.
 // Such record is preserved for each pool.
@@ -177,7 +218,11 @@ func (k Keeper) pruneRecords(ctx sdk.Context) error {
 	recordHistoryKeepPeriod := k.RecordHistoryKeepPeriod(ctx)
 
 	lastKeptTime := ctx.BlockTime().Add(-recordHistoryKeepPeriod)
-	return k.pruneRecordsBeforeTimeButNewest(ctx, lastKeptTime)
+	if err := k.pruneRecordsBeforeTimeButNewest(ctx, lastKeptTime); err != nil {
+		return err
+	}
+
+	return k.pruneCheckpoints(ctx, k.GetParams(ctx).CheckpointTiers)
 }
 
 // recordWithUpdatedAccumulators returns a record, with updated accumulator values and time for provided newTime,
@@ -203,6 +248,19 @@ func recordWithUpdatedAccumulators(record types.TwapRecord, newTime time.Time) t
 	p1NewAccum := types.SpotPriceMulDuration(record.P1LastSpotPrice, timeDelta)
 	newRecord.P1ArithmeticTwapAccumulator = newRecord.P1ArithmeticTwapAccumulator.Add(p1NewAccum)
 
+	// the geometric accumulator is left unpopulated for records that were written
+	// before geometric twap support, see updateRecord.
+	if record.GeometricTwapAccumulator.IsNil() {
+		return newRecord
+	}
+
+	// the logarithm is undefined at zero. Zero spot prices are always recorded as errors
+	// in LastErrorTime, see getSpotPrices, so any twap over this window returns an error
+	// and we leave the geometric accumulator unchanged.
+	if record.P0LastSpotPrice.IsZero() {
+		return newRecord
+	}
+
 	// logP0SpotPrice = log_{2}{P_0}
 	logP0SpotPrice := twapLog(record.P0LastSpotPrice)
 	// p0NewGeomAccum = log_{2}{P_0} * timeDelta
@@ -216,8 +274,16 @@ func recordWithUpdatedAccumulators(record types.TwapRecord, newTime time.Time) t
 // This is achieved by getting the record `r` that is at, or immediately preceding in state time `t`.
 // To be clear: the record r s.t. `t - r.Time` is minimized AND `t >= r.Time`
 // If for the record obtained, r.Time == r.LastErrorTime, this will also hold for the interpolated record.
+//
+// If `t` is older than all historical records in state, the record `r` is the latest checkpoint of any tier at or before `t`.
+// Since checkpoints are the first record within their interval, the interpolated record is exact
+// unless records were written between that checkpoint and `t`.
 func (k Keeper) getInterpolatedRecord(ctx sdk.Context, poolId uint64, t time.Time, assetA, assetB string) (types.TwapRecord, error) {
 	record, err := k.getRecordAtOrBeforeTime(ctx, poolId, t, assetA, assetB)
+	// If t is older than the retained historical records, interpolate from the latest checkpoint instead.
+	if errors.As(err, &timeTooOldError{}) {
+		record, err = k.getCheckpointAtOrBeforeTime(ctx, poolId, t, assetA, assetB)
+	}
 	if err != nil {
 		return types.TwapRecord{}, err
 	}
@@ -311,7 +377,12 @@ func twapLog(price sdk.Dec) sdk.Dec {
 }
 
 // twapPow exponentiates the geometricTwapMathBase to the given exponent.
-// TODO: basic test and benchmark.
+// The exponent is negative whenever the geometric mean price is below 1.
+// Since Exp2 only supports non-negative exponents, we compute 1 / 2^{|exponent|} in that case.
 func twapPow(exponent sdk.Dec) sdk.Dec {
-	return osmomath.PowApprox(geometricTwapMathBase, exponent, geometricTwapPowPrecision)
+	exp2 := osmomath.Exp2(osmomath.BigDecFromSDKDec(exponent.Abs()))
+	if exponent.IsNegative() {
+		return osmomath.OneDec().Quo(exp2).SDKDec()
+	}
+	return exp2.SDKDec()
 }
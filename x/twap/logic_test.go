@@ -60,6 +60,15 @@ func (s *TestSuite) TestGetSpotPrices() {
 			expectedSp1:           sdk.ZeroDec(),
 			expectedLatestErrTime: ctx.BlockTime(),
 		},
+		"zero sp without error": {
+			poolID:                poolID,
+			prevErrTime:           currTime,
+			mockSp0:               sdk.ZeroDec(),
+			mockSp1:               sdk.NewDec(10),
+			expectedSp0:           sdk.ZeroDec(),
+			expectedSp1:           sdk.NewDec(10),
+			expectedLatestErrTime: ctx.BlockTime(),
+		},
 		"exceeds max spot price": {
 			poolID:                poolID,
 			prevErrTime:           currTime,
@@ -213,6 +222,12 @@ func (s *TestSuite) TestUpdateRecord() {
 			spotPriceResult1: spotPriceResOneErr,
 			expRecord:        withLastErrTime(sp10OneTimeUnitAccumRecord, updateTime),
 		},
+		"nil geom accum start, geom accum populated from update time": {
+			record:           withGeomAccum(zeroAccumNoErrSp10Record, sdk.Dec{}),
+			spotPriceResult0: spotPriceResOne,
+			spotPriceResult1: spotPriceResOne,
+			expRecord:        newExpRecord(OneSec.MulInt64(10), OneSec.QuoInt64(10), zeroDec),
+		},
 	}
 	for name, test := range tests {
 		s.Run(name, func() {
@@ -271,10 +286,20 @@ func TestRecordWithUpdatedAccumulators(t *testing.T) {
 			newTime:   time.Unix(1, 0),
 			expRecord: newExpRecord(oneDec, twoDec, pointFiveDec),
 		},
-		"zero spot price - panic": {
-			record:      withPrice0Set(defaultRecord, sdk.ZeroDec()),
-			newTime:     defaultRecord.Time.Add(time.Second),
-			expectPanic: true,
+		"zero spot price - geom accumulator unchanged": {
+			record:    withPrice0Set(defaultRecord, sdk.ZeroDec()),
+			newTime:   defaultRecord.Time.Add(time.Second),
+			expRecord: newExpRecord(oneDec, twoDec.Add(OneSec.QuoInt64(10)), pointFiveDec),
+		},
+		"nil geom accumulator - left unpopulated": {
+			record:  withGeomAccum(defaultRecord, sdk.Dec{}),
+			newTime: defaultRecord.Time.Add(time.Second),
+			expRecord: types.TwapRecord{
+				Asset0Denom:                 defaultTwoAssetCoins[0].Denom,
+				Asset1Denom:                 defaultTwoAssetCoins[1].Denom,
+				P0ArithmeticTwapAccumulator: oneDec.Add(OneSec.MulInt64(10)),
+				P1ArithmeticTwapAccumulator: twoDec.Add(OneSec.QuoInt64(10)),
+			},
 		},
 		"spot price of one - geom accumulator 0": {
 			record:    withPrice1Set(withPrice0Set(defaultRecord, sdk.OneDec()), sdk.OneDec()),
@@ -1617,6 +1642,14 @@ func (s *TestSuite) TestTwapPow() {
 	result_by_mathPow := math.Pow(twap.GeometricTwapMathBase.MustFloat64(), exponentValue.SDKDec().MustFloat64())
 	s.Require().True(expectedValue.Sub(osmomath.BigDecFromSDKDec(result)).Abs().LTE(expectedErrTolerance))
 	s.Require().True(osmomath.MustNewDecFromStr(fmt.Sprint(result_by_mathPow)).Sub(osmomath.BigDecFromSDKDec(result)).Abs().LTE(expectedErrTolerance))
+
+	// "TwapPow(-0.5) = 0.70710678"
+	// From: https://www.wolframalpha.com/input?i2d=true&i=power+base+2+exponent+-0.5+with+9+digits
+	exponentValue = osmomath.MustNewDecFromStr("-0.5")
+	expectedValue = osmomath.MustNewDecFromStr("0.70710678")
+
+	result = twap.TwapPow(exponentValue.SDKDec())
+	s.Require().True(expectedValue.Sub(osmomath.BigDecFromSDKDec(result)).Abs().LTE(expectedErrTolerance))
 }
 
 func testCaseFromDeltas(startAccum, accumDiff sdk.Dec, timeDelta time.Duration, expectedTwap sdk.Dec) computeTwapTestCase {
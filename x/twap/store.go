@@ -163,13 +163,122 @@ func (k Keeper) getAllHistoricalPoolIndexedTWAPs(ctx sdk.Context) ([]types.TwapR
 }
 
 // storeNewRecord stores a record, in both the most recent record store and historical stores.
+// It also stores the record as a checkpoint of every checkpoint tier whose interval it starts,
+// i.e. if the previous record of its (pool id, asset 0, asset 1) triplet is in an earlier interval.
 func (k Keeper) storeNewRecord(ctx sdk.Context, twap types.TwapRecord) {
+	checkpointTiers := k.GetParams(ctx).CheckpointTiers
+	if len(checkpointTiers) > 0 {
+		store := ctx.KVStore(k.storeKey)
+		key := types.FormatMostRecentTWAPKey(twap.PoolId, twap.Asset0Denom, twap.Asset1Denom)
+		prevRecord, err := types.ParseTwapFromBz(store.Get(key))
+		hasPrevRecord := err == nil
+		for tier, checkpointTier := range checkpointTiers {
+			interval := checkpointTier.Interval
+			if !hasPrevRecord || !prevRecord.Time.Truncate(interval).Equal(twap.Time.Truncate(interval)) {
+				k.storeCheckpoint(ctx, types.TwapCheckpoint{Tier: uint32(tier), Record: twap})
+			}
+		}
+	}
+	k.storeRecord(ctx, twap)
+}
+
+// storeRecord stores a record, in both the most recent record store and historical stores,
+// without updating checkpoints.
+func (k Keeper) storeRecord(ctx sdk.Context, twap types.TwapRecord) {
 	store := ctx.KVStore(k.storeKey)
 	key := types.FormatMostRecentTWAPKey(twap.PoolId, twap.Asset0Denom, twap.Asset1Denom)
 	osmoutils.MustSet(store, key, &twap)
 	k.storeHistoricalTWAP(ctx, twap)
 }
 
+// storeCheckpoint writes a checkpoint to the store, in all needed indexing.
+func (k Keeper) storeCheckpoint(ctx sdk.Context, checkpoint types.TwapCheckpoint) {
+	store := ctx.KVStore(k.storeKey)
+	twap := checkpoint.Record
+	key1 := types.FormatCheckpointTimeIndexKey(checkpoint.Tier, twap.Time, twap.PoolId, twap.Asset0Denom, twap.Asset1Denom)
+	key2 := types.FormatCheckpointPoolIndexKey(twap.PoolId, twap.Asset0Denom, twap.Asset1Denom, checkpoint.Tier, twap.Time)
+	osmoutils.MustSet(store, key1, &checkpoint)
+	osmoutils.MustSet(store, key2, &checkpoint)
+}
+
+func (k Keeper) deleteCheckpoint(ctx sdk.Context, checkpoint types.TwapCheckpoint) {
+	store := ctx.KVStore(k.storeKey)
+	twap := checkpoint.Record
+	key1 := types.FormatCheckpointTimeIndexKey(checkpoint.Tier, twap.Time, twap.PoolId, twap.Asset0Denom, twap.Asset1Denom)
+	key2 := types.FormatCheckpointPoolIndexKey(twap.PoolId, twap.Asset0Denom, twap.Asset1Denom, checkpoint.Tier, twap.Time)
+	store.Delete(key1)
+	store.Delete(key2)
+}
+
+// getAllCheckpoints returns all checkpoints, ordered by tier and then by time.
+func (k Keeper) getAllCheckpoints(ctx sdk.Context) ([]types.TwapCheckpoint, error) {
+	return osmoutils.GatherValuesFromStorePrefix(ctx.KVStore(k.storeKey), []byte(types.CheckpointTimeIndexPrefix), types.ParseCheckpointFromBz)
+}
+
+// getCheckpointAtOrBeforeTime returns the latest checkpoint of any configured tier
+// for (id, asset0, asset1) with a time at or before t.
+// This returns a timeTooOldError if there is no such checkpoint.
+func (k Keeper) getCheckpointAtOrBeforeTime(ctx sdk.Context, poolId uint64, t time.Time, asset0Denom string, asset1Denom string) (types.TwapRecord, error) {
+	asset0Denom, asset1Denom, err := types.LexicographicalOrderDenoms(asset0Denom, asset1Denom)
+	if err != nil {
+		return types.TwapRecord{}, err
+	}
+	store := ctx.KVStore(k.storeKey)
+	reverseIterate := true
+
+	found := false
+	latest := types.TwapRecord{}
+	for tier := range k.GetParams(ctx).CheckpointTiers {
+		startKey := types.FormatCheckpointPoolIndexTimePrefix(poolId, asset0Denom, asset1Denom, uint32(tier))
+		endKey := types.FormatCheckpointPoolIndexTimeSuffix(poolId, asset0Denom, asset1Denom, uint32(tier), t)
+		checkpoint, err := osmoutils.GetFirstValueInRange(store, startKey, endKey, reverseIterate, types.ParseCheckpointFromBz)
+		if err != nil {
+			continue
+		}
+		if !found || checkpoint.Record.Time.After(latest.Time) {
+			latest = checkpoint.Record
+			found = true
+		}
+	}
+	if !found {
+		return types.TwapRecord{}, timeTooOldError{Time: t}
+	}
+	return latest, nil
+}
+
+// pruneCheckpoints deletes all checkpoints of each of the given tiers that are older than the tier's keep period,
+// and all checkpoints of tiers that are no longer configured.
+// As checkpoints are indexed by tier and then by time, each tier is pruned by a single key range.
+func (k Keeper) pruneCheckpoints(ctx sdk.Context, tiers []types.CheckpointTier) error {
+	for tier, checkpointTier := range tiers {
+		startKey := types.FormatCheckpointTimeIndexTierPrefix(uint32(tier))
+		endKey := types.FormatCheckpointTimeIndexKey(uint32(tier), ctx.BlockTime().Add(-checkpointTier.KeepPeriod), 0, "", "")
+		if err := k.deleteCheckpointsInRange(ctx, startKey, endKey); err != nil {
+			return err
+		}
+	}
+
+	startKey := types.FormatCheckpointTimeIndexTierPrefix(uint32(len(tiers)))
+	endKey := sdk.PrefixEndBytes([]byte(types.CheckpointTimeIndexPrefix))
+	return k.deleteCheckpointsInRange(ctx, startKey, endKey)
+}
+
+// deleteCheckpointsInRange deletes all checkpoints whose time index key is within [startKey, endKey).
+func (k Keeper) deleteCheckpointsInRange(ctx sdk.Context, startKey, endKey []byte) error {
+	store := ctx.KVStore(k.storeKey)
+	iter := store.Iterator(startKey, endKey)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		checkpoint, err := types.ParseCheckpointFromBz(iter.Value())
+		if err != nil {
+			return err
+		}
+		k.deleteCheckpoint(ctx, checkpoint)
+	}
+	return nil
+}
+
 // getRecordAtOrBeforeTime on a given input (id, t, asset0, asset1)
 // returns the TWAP record from state for (id, t', asset0, asset1),
 // where t' is such that:
@@ -454,6 +454,158 @@ func (s *TestSuite) TestPruneRecordsBeforeTimeButNewest() {
 	}
 }
 
+// TestStoreNewRecord_Checkpoints tests that storing new records keeps
+// the first record within each interval of every checkpoint tier as a checkpoint of the tier.
+func (s *TestSuite) TestStoreNewRecord_Checkpoints() {
+	day := 24 * time.Hour
+	tiers := []types.CheckpointTier{
+		{Interval: time.Hour, KeepPeriod: 30 * day},
+		{Interval: day, KeepPeriod: 365 * day},
+	}
+	dayStart := baseTime.Truncate(day)
+
+	tests := map[string]struct {
+		checkpointTiers     []types.CheckpointTier
+		recordsToSet        []types.TwapRecord
+		expectedCheckpoints []types.TwapCheckpoint
+	}{
+		"no checkpoint tiers; no checkpoints": {
+			recordsToSet: []types.TwapRecord{
+				newEmptyPriceRecord(1, dayStart, denom0, denom1),
+			},
+			expectedCheckpoints: []types.TwapCheckpoint{},
+		},
+		"single record; checkpoint of every tier": {
+			checkpointTiers: tiers,
+			recordsToSet: []types.TwapRecord{
+				newEmptyPriceRecord(1, dayStart, denom0, denom1),
+			},
+			expectedCheckpoints: []types.TwapCheckpoint{
+				newCheckpoint(0, newEmptyPriceRecord(1, dayStart, denom0, denom1)),
+				newCheckpoint(1, newEmptyPriceRecord(1, dayStart, denom0, denom1)),
+			},
+		},
+		"two records in the same interval; first one kept": {
+			checkpointTiers: tiers,
+			recordsToSet: []types.TwapRecord{
+				newEmptyPriceRecord(1, dayStart, denom0, denom1),
+				newEmptyPriceRecord(1, dayStart.Add(59*time.Minute), denom0, denom1),
+			},
+			expectedCheckpoints: []types.TwapCheckpoint{
+				newCheckpoint(0, newEmptyPriceRecord(1, dayStart, denom0, denom1)),
+				newCheckpoint(1, newEmptyPriceRecord(1, dayStart, denom0, denom1)),
+			},
+		},
+		"two records in different hours of the same day; both kept by the hourly tier only": {
+			checkpointTiers: tiers,
+			recordsToSet: []types.TwapRecord{
+				newEmptyPriceRecord(1, dayStart.Add(59*time.Minute), denom0, denom1),
+				newEmptyPriceRecord(1, dayStart.Add(time.Hour), denom0, denom1),
+			},
+			expectedCheckpoints: []types.TwapCheckpoint{
+				newCheckpoint(0, newEmptyPriceRecord(1, dayStart.Add(59*time.Minute), denom0, denom1)),
+				newCheckpoint(0, newEmptyPriceRecord(1, dayStart.Add(time.Hour), denom0, denom1)),
+				newCheckpoint(1, newEmptyPriceRecord(1, dayStart.Add(59*time.Minute), denom0, denom1)),
+			},
+		},
+		"two pools in the same interval; both kept": {
+			checkpointTiers: tiers[:1],
+			recordsToSet: []types.TwapRecord{
+				newEmptyPriceRecord(1, dayStart, denom0, denom1),
+				newEmptyPriceRecord(2, dayStart.Add(time.Minute), denom0, denom1),
+			},
+			expectedCheckpoints: []types.TwapCheckpoint{
+				newCheckpoint(0, newEmptyPriceRecord(1, dayStart, denom0, denom1)),
+				newCheckpoint(0, newEmptyPriceRecord(2, dayStart.Add(time.Minute), denom0, denom1)),
+			},
+		},
+	}
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			params := s.twapkeeper.GetParams(s.Ctx)
+			params.CheckpointTiers = tc.checkpointTiers
+			s.twapkeeper.SetParams(s.Ctx, params)
+
+			s.preSetRecords(tc.recordsToSet)
+
+			checkpoints, err := s.twapkeeper.GetAllCheckpoints(s.Ctx)
+			s.Require().NoError(err)
+			s.Require().Equal(tc.expectedCheckpoints, checkpoints)
+		})
+	}
+}
+
+// TestPruneCheckpoints tests that checkpoints of every tier are kept
+// only within the keep period of their tier.
+func (s *TestSuite) TestPruneCheckpoints() {
+	day := 24 * time.Hour
+	tiers := []types.CheckpointTier{
+		{Interval: time.Hour, KeepPeriod: 30 * day},
+		{Interval: day, KeepPeriod: 365 * day},
+	}
+	pruneTime := baseTime.Add(400 * day)
+
+	// within the hourly tier keep period.
+	recentHourly := newCheckpoint(0, newEmptyPriceRecord(1, pruneTime.Add(-2*day), denom0, denom1))
+	recentDaily := newCheckpoint(1, newEmptyPriceRecord(1, pruneTime.Add(-2*day), denom0, denom1))
+
+	// within the daily tier keep period only.
+	oldHourly := newCheckpoint(0, newEmptyPriceRecord(1, pruneTime.Add(-100*day), denom0, denom1))
+	oldDaily := newCheckpoint(1, newEmptyPriceRecord(1, pruneTime.Add(-100*day), denom0, denom1))
+	oldDailyOtherPool := newCheckpoint(1, newEmptyPriceRecord(2, pruneTime.Add(-100*day), denom0, denom1))
+
+	// outside of all keep periods.
+	tooOldDaily := newCheckpoint(1, newEmptyPriceRecord(1, pruneTime.Add(-366*day), denom0, denom1))
+
+	tests := map[string]struct {
+		checkpointTiers     []types.CheckpointTier
+		checkpointsToSet    []types.TwapCheckpoint
+		expectedCheckpoints []types.TwapCheckpoint
+	}{
+		"within keep periods; all kept": {
+			checkpointTiers:     tiers,
+			checkpointsToSet:    []types.TwapCheckpoint{recentHourly, recentDaily, oldDaily, oldDailyOtherPool},
+			expectedCheckpoints: []types.TwapCheckpoint{recentHourly, oldDaily, oldDailyOtherPool, recentDaily},
+		},
+		"hourly checkpoint outside of its keep period; pruned": {
+			checkpointTiers:     tiers,
+			checkpointsToSet:    []types.TwapCheckpoint{oldHourly, recentHourly, oldDaily},
+			expectedCheckpoints: []types.TwapCheckpoint{recentHourly, oldDaily},
+		},
+		"daily checkpoint outside of its keep period; pruned": {
+			checkpointTiers:     tiers,
+			checkpointsToSet:    []types.TwapCheckpoint{tooOldDaily, oldDaily},
+			expectedCheckpoints: []types.TwapCheckpoint{oldDaily},
+		},
+		"tier no longer configured; all its checkpoints pruned": {
+			checkpointTiers:     tiers[:1],
+			checkpointsToSet:    []types.TwapCheckpoint{recentHourly, recentDaily, oldDaily},
+			expectedCheckpoints: []types.TwapCheckpoint{recentHourly},
+		},
+		"no checkpoint tiers; all pruned": {
+			checkpointsToSet:    []types.TwapCheckpoint{recentHourly, tooOldDaily, recentDaily},
+			expectedCheckpoints: []types.TwapCheckpoint{},
+		},
+	}
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			for _, checkpoint := range tc.checkpointsToSet {
+				s.twapkeeper.StoreCheckpoint(s.Ctx, checkpoint)
+			}
+
+			ctx := s.Ctx.WithBlockTime(pruneTime)
+			err := s.twapkeeper.PruneCheckpoints(ctx, tc.checkpointTiers)
+			s.Require().NoError(err)
+
+			checkpoints, err := s.twapkeeper.GetAllCheckpoints(ctx)
+			s.Require().NoError(err)
+			s.Require().Equal(tc.expectedCheckpoints, checkpoints)
+		})
+	}
+}
+
 func (s *TestSuite) TestGetAllHistoricalTimeIndexedTWAPs() {
 	tests := map[string]struct {
 		expectedRecords []types.TwapRecord
@@ -22,3 +22,20 @@ var _ twapStrategy = &arithmetic{}
 func (s *arithmetic) computeTwap(startRecord types.TwapRecord, endRecord types.TwapRecord, quoteAsset string) (sdk.Dec, error) {
 	return computeTwap(startRecord, endRecord, quoteAsset, arithmeticTwapType)
 }
+
+type geometric struct {
+	keeper Keeper
+}
+
+var _ twapStrategy = &geometric{}
+
+// computeTwap computes the geometric TWAP between the two records.
+// Returns GeometricTwapUnavailableError if the geometric accumulator was not yet
+// populated at the start record. Since the accumulator is only ever populated going forward,
+// a populated start record guarantees a populated end record.
+func (s *geometric) computeTwap(startRecord types.TwapRecord, endRecord types.TwapRecord, quoteAsset string) (sdk.Dec, error) {
+	if startRecord.GeometricTwapAccumulator.IsNil() || endRecord.GeometricTwapAccumulator.IsNil() {
+		return sdk.Dec{}, types.GeometricTwapUnavailableError{PoolId: startRecord.PoolId, StartTime: startRecord.Time}
+	}
+	return computeTwap(startRecord, endRecord, quoteAsset, geometricTwapType)
+}
@@ -62,3 +62,13 @@ type InvalidRecordCountError struct {
 func (e InvalidRecordCountError) Error() string {
 	return fmt.Sprintf("The number of records do not match, expected: %d\n got: %d", e.Expected, e.Actual)
 }
+
+type GeometricTwapUnavailableError struct {
+	PoolId    uint64
+	StartTime time.Time
+}
+
+func (e GeometricTwapUnavailableError) Error() string {
+	return fmt.Sprintf("geometric twap is unavailable for pool %d at start time %s,"+
+		" the geometric accumulator was not yet populated at that time", e.PoolId, e.StartTime)
+}
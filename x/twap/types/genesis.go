@@ -29,6 +29,35 @@ func (g *GenesisState) Validate() error {
 			return err
 		}
 	}
+
+	seenCheckpoints := map[string]bool{}
+	for _, checkpoint := range g.Checkpoints {
+		if err := checkpoint.validate(len(g.Params.CheckpointTiers)); err != nil {
+			return fmt.Errorf("invalid checkpoint: %w", err)
+		}
+		record := checkpoint.Record
+		key := string(FormatCheckpointTimeIndexKey(checkpoint.Tier, record.Time, record.PoolId, record.Asset0Denom, record.Asset1Denom))
+		if seenCheckpoints[key] {
+			return fmt.Errorf("duplicate checkpoint of tier %d for pool %d, denoms (%s, %s) at %s",
+				checkpoint.Tier, record.PoolId, record.Asset0Denom, record.Asset1Denom, record.Time)
+		}
+		seenCheckpoints[key] = true
+	}
+	return nil
+}
+
+// validate validates the checkpoint against the number of configured checkpoint tiers,
+// returns nil on success, error otherwise.
+func (c TwapCheckpoint) validate(numTiers int) error {
+	if int(c.Tier) >= numTiers {
+		return fmt.Errorf("checkpoint tier %d is not configured, there are %d checkpoint tiers", c.Tier, numTiers)
+	}
+	if err := c.Record.validate(); err != nil {
+		return err
+	}
+	if c.Record.Asset0Denom >= c.Record.Asset1Denom {
+		return fmt.Errorf("checkpoint denoms must be lexicographically ordered, got (%s, %s)", c.Record.Asset0Denom, c.Record.Asset1Denom)
+	}
 	return nil
 }
 
@@ -82,8 +111,7 @@ func (t TwapRecord) validate() error {
 		return fmt.Errorf("twap record p1 accumulator cannot be negative, was (%s)", t.P1ArithmeticTwapAccumulator)
 	}
 
-	if t.GeometricTwapAccumulator.IsNil() || t.GeometricTwapAccumulator.IsNegative() {
-		return fmt.Errorf("twap record geometric accumulator cannot be negative, was (%s)", t.GeometricTwapAccumulator)
-	}
+	// the geometric accumulator sums log_2 of spot prices, which is negative for prices below 1.
+	// Hence, it may be negative. It is nil for records written before geometric twap support.
 	return nil
 }
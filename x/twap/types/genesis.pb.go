@@ -5,12 +5,12 @@ package types
 
 import (
 	fmt "fmt"
-	_ "github.com/cosmos/cosmos-proto"
 	_ "github.com/cosmos/cosmos-sdk/codec/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	_ "github.com/gogo/protobuf/types"
 	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "github.com/regen-network/cosmos-proto"
 	io "io"
 	math "math"
 	math_bits "math/bits"
@@ -33,6 +33,11 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type Params struct {
 	PruneEpochIdentifier    string        `protobuf:"bytes,1,opt,name=prune_epoch_identifier,json=pruneEpochIdentifier,proto3" json:"prune_epoch_identifier,omitempty"`
 	RecordHistoryKeepPeriod time.Duration `protobuf:"bytes,2,opt,name=record_history_keep_period,json=recordHistoryKeepPeriod,proto3,stdduration" json:"record_history_keep_period" yaml:"record_history_keep_period"`
+	// checkpoint_tiers defines how sparse historical checkpoints of twap records
+	// are kept after the records are pruned by record_history_keep_period.
+	// Tiers must be sorted by increasing interval and keep period, and there can
+	// be at most 10 tiers.
+	CheckpointTiers []CheckpointTier `protobuf:"bytes,3,rep,name=checkpoint_tiers,json=checkpointTiers,proto3" json:"checkpoint_tiers" yaml:"checkpoint_tiers"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -82,19 +87,138 @@ func (m *Params) GetRecordHistoryKeepPeriod() time.Duration {
 	return 0
 }
 
+func (m *Params) GetCheckpointTiers() []CheckpointTier {
+	if m != nil {
+		return m.CheckpointTiers
+	}
+	return nil
+}
+
+// CheckpointTier keeps one twap record checkpoint per pool and asset pair
+// for every interval, for keep_period.
+type CheckpointTier struct {
+	Interval   time.Duration `protobuf:"bytes,1,opt,name=interval,proto3,stdduration" json:"interval" yaml:"interval"`
+	KeepPeriod time.Duration `protobuf:"bytes,2,opt,name=keep_period,json=keepPeriod,proto3,stdduration" json:"keep_period" yaml:"keep_period"`
+}
+
+func (m *CheckpointTier) Reset()         { *m = CheckpointTier{} }
+func (m *CheckpointTier) String() string { return proto.CompactTextString(m) }
+func (*CheckpointTier) ProtoMessage()    {}
+func (*CheckpointTier) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3f4bdf49b69bd63c, []int{1}
+}
+func (m *CheckpointTier) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CheckpointTier) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CheckpointTier.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CheckpointTier) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CheckpointTier.Merge(m, src)
+}
+func (m *CheckpointTier) XXX_Size() int {
+	return m.Size()
+}
+func (m *CheckpointTier) XXX_DiscardUnknown() {
+	xxx_messageInfo_CheckpointTier.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CheckpointTier proto.InternalMessageInfo
+
+func (m *CheckpointTier) GetInterval() time.Duration {
+	if m != nil {
+		return m.Interval
+	}
+	return 0
+}
+
+func (m *CheckpointTier) GetKeepPeriod() time.Duration {
+	if m != nil {
+		return m.KeepPeriod
+	}
+	return 0
+}
+
+// TwapCheckpoint is a twap record kept as the checkpoint of an interval of a
+// checkpoint tier.
+type TwapCheckpoint struct {
+	// tier is the index of the checkpoint tier in the checkpoint_tiers param.
+	Tier uint32 `protobuf:"varint,1,opt,name=tier,proto3" json:"tier,omitempty"`
+	// record is the first twap record written within its interval of the tier.
+	Record TwapRecord `protobuf:"bytes,2,opt,name=record,proto3" json:"record"`
+}
+
+func (m *TwapCheckpoint) Reset()         { *m = TwapCheckpoint{} }
+func (m *TwapCheckpoint) String() string { return proto.CompactTextString(m) }
+func (*TwapCheckpoint) ProtoMessage()    {}
+func (*TwapCheckpoint) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3f4bdf49b69bd63c, []int{2}
+}
+func (m *TwapCheckpoint) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TwapCheckpoint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TwapCheckpoint.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TwapCheckpoint) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TwapCheckpoint.Merge(m, src)
+}
+func (m *TwapCheckpoint) XXX_Size() int {
+	return m.Size()
+}
+func (m *TwapCheckpoint) XXX_DiscardUnknown() {
+	xxx_messageInfo_TwapCheckpoint.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TwapCheckpoint proto.InternalMessageInfo
+
+func (m *TwapCheckpoint) GetTier() uint32 {
+	if m != nil {
+		return m.Tier
+	}
+	return 0
+}
+
+func (m *TwapCheckpoint) GetRecord() TwapRecord {
+	if m != nil {
+		return m.Record
+	}
+	return TwapRecord{}
+}
+
 // GenesisState defines the twap module's genesis state.
 type GenesisState struct {
 	// twaps is the collection of all twap records.
 	Twaps []TwapRecord `protobuf:"bytes,1,rep,name=twaps,proto3" json:"twaps"`
 	// params is the container of twap parameters.
 	Params Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
+	// checkpoints is the collection of all historical twap checkpoints.
+	Checkpoints []TwapCheckpoint `protobuf:"bytes,3,rep,name=checkpoints,proto3" json:"checkpoints"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
 func (m *GenesisState) String() string { return proto.CompactTextString(m) }
 func (*GenesisState) ProtoMessage()    {}
 func (*GenesisState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3f4bdf49b69bd63c, []int{1}
+	return fileDescriptor_3f4bdf49b69bd63c, []int{3}
 }
 func (m *GenesisState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -137,8 +261,17 @@ func (m *GenesisState) GetParams() Params {
 	return Params{}
 }
 
+func (m *GenesisState) GetCheckpoints() []TwapCheckpoint {
+	if m != nil {
+		return m.Checkpoints
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "osmosis.twap.v1beta1.Params")
+	proto.RegisterType((*CheckpointTier)(nil), "osmosis.twap.v1beta1.CheckpointTier")
+	proto.RegisterType((*TwapCheckpoint)(nil), "osmosis.twap.v1beta1.TwapCheckpoint")
 	proto.RegisterType((*GenesisState)(nil), "osmosis.twap.v1beta1.GenesisState")
 }
 
@@ -147,32 +280,41 @@ func init() {
 }
 
 var fileDescriptor_3f4bdf49b69bd63c = []byte{
-	// 397 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x92, 0xbf, 0xce, 0xd3, 0x30,
-	0x14, 0xc5, 0x63, 0xfe, 0x54, 0x22, 0x1f, 0x53, 0x54, 0x41, 0xbf, 0x0a, 0xa5, 0x21, 0x03, 0xea,
-	0x52, 0x9b, 0xb6, 0x4c, 0x15, 0x53, 0x05, 0xe2, 0xdf, 0x52, 0x05, 0x26, 0x96, 0xc8, 0x49, 0x6e,
-	0x53, 0x8b, 0x26, 0xb6, 0x6c, 0xa7, 0x25, 0x0f, 0x80, 0xc4, 0xc8, 0xc8, 0x13, 0xa1, 0x8e, 0x1d,
-	0x99, 0x0a, 0x6a, 0xdf, 0x80, 0x27, 0x40, 0x89, 0x5d, 0x06, 0xd4, 0x6f, 0xcb, 0xd5, 0xef, 0x9c,
-	0xa3, 0x93, 0x7b, 0xed, 0x86, 0x5c, 0x15, 0x5c, 0x31, 0x45, 0xf4, 0x96, 0x0a, 0xb2, 0x19, 0x27,
-	0xa0, 0xe9, 0x98, 0xe4, 0x50, 0x82, 0x62, 0x0a, 0x0b, 0xc9, 0x35, 0xf7, 0xba, 0x56, 0x83, 0x1b,
-	0x0d, 0xb6, 0x9a, 0x7e, 0x37, 0xe7, 0x39, 0x6f, 0x05, 0xa4, 0xf9, 0x32, 0xda, 0xfe, 0x93, 0x8b,
-	0x79, 0xcd, 0x10, 0x4b, 0x48, 0xb9, 0xcc, 0xac, 0xee, 0x3a, 0xe7, 0x3c, 0x5f, 0x03, 0x69, 0xa7,
-	0xa4, 0x5a, 0x12, 0x5a, 0xd6, 0x67, 0x94, 0xb6, 0x19, 0xb1, 0xc9, 0x36, 0x83, 0x45, 0xfe, 0xff,
-	0xae, 0xac, 0x92, 0x54, 0x33, 0x5e, 0x1a, 0x1e, 0xfe, 0x40, 0x6e, 0x67, 0x41, 0x25, 0x2d, 0x94,
-	0xf7, 0xcc, 0x7d, 0x20, 0x64, 0x55, 0x42, 0x0c, 0x82, 0xa7, 0xab, 0x98, 0x65, 0x50, 0x6a, 0xb6,
-	0x64, 0x20, 0x7b, 0x28, 0x40, 0xc3, 0x7b, 0x51, 0xb7, 0xa5, 0x2f, 0x1b, 0xf8, 0xe6, 0x1f, 0xf3,
-	0xbe, 0x20, 0xb7, 0x6f, 0x7a, 0xc6, 0x2b, 0xa6, 0x34, 0x97, 0x75, 0xfc, 0x09, 0x40, 0xc4, 0x02,
-	0x24, 0xe3, 0x59, 0xef, 0x56, 0x80, 0x86, 0x57, 0x93, 0x6b, 0x6c, 0x6a, 0xe0, 0x73, 0x0d, 0xfc,
-	0xc2, 0xd6, 0x98, 0x8f, 0x76, 0x87, 0x81, 0xf3, 0xe7, 0x30, 0x78, 0x5c, 0xd3, 0x62, 0x3d, 0x0b,
-	0x6f, 0x8e, 0x0a, 0xbf, 0xff, 0x1a, 0xa0, 0xe8, 0xa1, 0x11, 0xbc, 0x36, 0xfc, 0x1d, 0x80, 0x58,
-	0x18, 0xfa, 0x15, 0xb9, 0xf7, 0x5f, 0x99, 0x23, 0xbc, 0xd7, 0x54, 0x83, 0xf7, 0xdc, 0xbd, 0xdb,
-	0x2c, 0x51, 0xf5, 0x50, 0x70, 0x7b, 0x78, 0x35, 0x09, 0xf0, 0xa5, 0x9b, 0xe0, 0x0f, 0x5b, 0x2a,
-	0xa2, 0x36, 0x72, 0x7e, 0xa7, 0x69, 0x12, 0x19, 0x93, 0x37, 0x73, 0x3b, 0xa2, 0x5d, 0x8b, 0xfd,
-	0x83, 0x47, 0x97, 0xed, 0x66, 0x75, 0xd6, 0x6a, 0x1d, 0xf3, 0xb7, 0xbb, 0xa3, 0x8f, 0xf6, 0x47,
-	0x1f, 0xfd, 0x3e, 0xfa, 0xe8, 0xdb, 0xc9, 0x77, 0xf6, 0x27, 0xdf, 0xf9, 0x79, 0xf2, 0x9d, 0x8f,
-	0x4f, 0x73, 0xa6, 0x57, 0x55, 0x82, 0x53, 0x5e, 0x10, 0x9b, 0x37, 0x5a, 0xd3, 0x44, 0x9d, 0x07,
-	0xb2, 0x19, 0x4f, 0xc9, 0x67, 0xf3, 0x12, 0x74, 0x2d, 0x40, 0x25, 0x9d, 0x76, 0x63, 0xd3, 0xbf,
-	0x01, 0x00, 0x00, 0xff, 0xff, 0xf4, 0xba, 0xd9, 0x22, 0x76, 0x02, 0x00, 0x00,
+	// 536 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x53, 0xcb, 0x6e, 0x13, 0x3d,
+	0x14, 0x8e, 0x9b, 0xfe, 0xd1, 0x8f, 0x03, 0x2d, 0xb2, 0x22, 0x9a, 0x06, 0x34, 0x09, 0x23, 0x84,
+	0xb2, 0xe9, 0x0c, 0x69, 0x59, 0x55, 0x88, 0x45, 0x00, 0x71, 0x5d, 0x54, 0x43, 0x57, 0xdd, 0x8c,
+	0x9c, 0x99, 0xd3, 0x89, 0x95, 0x64, 0x6c, 0xd9, 0x4e, 0x4a, 0x1e, 0x80, 0x3d, 0x4b, 0x1e, 0x85,
+	0x25, 0xcb, 0x2e, 0xbb, 0x42, 0xac, 0x0a, 0x4a, 0xde, 0x80, 0x27, 0x40, 0x33, 0xf6, 0xa4, 0xa4,
+	0x04, 0x55, 0xdd, 0xf9, 0xe8, 0xbb, 0xf8, 0xf3, 0x39, 0xc7, 0xd8, 0xe5, 0x6a, 0xc4, 0x15, 0x53,
+	0xbe, 0x3e, 0xa1, 0xc2, 0x9f, 0x74, 0x7a, 0xa0, 0x69, 0xc7, 0x4f, 0x20, 0x05, 0xc5, 0x94, 0x27,
+	0x24, 0xd7, 0x9c, 0xd4, 0x2c, 0xc7, 0xcb, 0x38, 0x9e, 0xe5, 0x34, 0x6a, 0x09, 0x4f, 0x78, 0x4e,
+	0xf0, 0xb3, 0x93, 0xe1, 0x36, 0x1e, 0xae, 0xf4, 0xcb, 0x8a, 0x50, 0x42, 0xc4, 0x65, 0x6c, 0x79,
+	0xdb, 0x09, 0xe7, 0xc9, 0x10, 0xfc, 0xbc, 0xea, 0x8d, 0x8f, 0x7d, 0x9a, 0x4e, 0x0b, 0x28, 0xca,
+	0x3d, 0x42, 0xe3, 0x6d, 0x0a, 0x0b, 0x39, 0x97, 0x55, 0xf1, 0x58, 0x52, 0xcd, 0x78, 0x6a, 0x70,
+	0xf7, 0xcb, 0x1a, 0xae, 0x1c, 0x50, 0x49, 0x47, 0x8a, 0x3c, 0xc6, 0x77, 0x84, 0x1c, 0xa7, 0x10,
+	0x82, 0xe0, 0x51, 0x3f, 0x64, 0x31, 0xa4, 0x9a, 0x1d, 0x33, 0x90, 0x75, 0xd4, 0x42, 0xed, 0x1b,
+	0x41, 0x2d, 0x47, 0x5f, 0x64, 0xe0, 0xeb, 0x05, 0x46, 0x3e, 0x22, 0xdc, 0x30, 0x39, 0xc3, 0x3e,
+	0x53, 0x9a, 0xcb, 0x69, 0x38, 0x00, 0x10, 0xa1, 0x00, 0xc9, 0x78, 0x5c, 0x5f, 0x6b, 0xa1, 0x76,
+	0x75, 0x77, 0xdb, 0x33, 0x31, 0xbc, 0x22, 0x86, 0xf7, 0xdc, 0xc6, 0xe8, 0xee, 0x9c, 0x9e, 0x37,
+	0x4b, 0xbf, 0xce, 0x9b, 0xf7, 0xa7, 0x74, 0x34, 0xdc, 0x77, 0xff, 0x6d, 0xe5, 0x7e, 0xfe, 0xd1,
+	0x44, 0xc1, 0x96, 0x21, 0xbc, 0x32, 0xf8, 0x5b, 0x00, 0x71, 0x90, 0xa3, 0x44, 0xe0, 0xdb, 0x51,
+	0x1f, 0xa2, 0x81, 0xe0, 0x2c, 0xd5, 0xa1, 0x66, 0x20, 0x55, 0xbd, 0xdc, 0x2a, 0xb7, 0xab, 0xbb,
+	0x0f, 0xbc, 0x55, 0xd3, 0xf0, 0x9e, 0x2d, 0xd8, 0x87, 0x0c, 0x64, 0xb7, 0x69, 0x73, 0x6c, 0x99,
+	0x1c, 0x97, 0xbd, 0xdc, 0x60, 0x33, 0x5a, 0x12, 0x28, 0xf7, 0x2b, 0xc2, 0x1b, 0xcb, 0x26, 0x24,
+	0xc0, 0xff, 0xb3, 0x54, 0x83, 0x9c, 0xd0, 0x61, 0x1d, 0x5d, 0xf5, 0xf2, 0xbb, 0xf6, 0xc6, 0x4d,
+	0x73, 0x63, 0x21, 0x34, 0xef, 0x5c, 0xf8, 0x90, 0x23, 0x5c, 0xbd, 0x56, 0x43, 0x1d, 0x6b, 0x4b,
+	0x8c, 0xed, 0x5f, 0x1d, 0xc4, 0x83, 0x45, 0xd3, 0xdc, 0x18, 0x6f, 0x1c, 0x9e, 0x50, 0x71, 0xf1,
+	0x0a, 0x42, 0xf0, 0xba, 0x2e, 0x46, 0x7e, 0x2b, 0xc8, 0xcf, 0xe4, 0x29, 0xae, 0x98, 0xae, 0xdb,
+	0xcb, 0x5b, 0xab, 0x1b, 0x9a, 0x39, 0x05, 0x39, 0xaf, 0xbb, 0x9e, 0x65, 0x08, 0xac, 0xca, 0xfd,
+	0x86, 0xf0, 0xcd, 0x97, 0xe6, 0x7f, 0xbc, 0xd7, 0x54, 0x03, 0x79, 0x82, 0xff, 0xcb, 0x94, 0xaa,
+	0x8e, 0x5a, 0xe5, 0x6b, 0xf8, 0x19, 0x11, 0xd9, 0xc7, 0x15, 0x91, 0x6f, 0xac, 0x8d, 0x73, 0x6f,
+	0xb5, 0xdc, 0x6c, 0x75, 0x11, 0xc5, 0x28, 0xc8, 0x3b, 0x5c, 0xbd, 0x18, 0xe3, 0x15, 0x0b, 0xb2,
+	0xdc, 0x19, 0x6b, 0xf4, 0xa7, 0xbc, 0xfb, 0xe6, 0x74, 0xe6, 0xa0, 0xb3, 0x99, 0x83, 0x7e, 0xce,
+	0x1c, 0xf4, 0x69, 0xee, 0x94, 0xce, 0xe6, 0x4e, 0xe9, 0xfb, 0xdc, 0x29, 0x1d, 0x3d, 0x4a, 0x98,
+	0xee, 0x8f, 0x7b, 0x5e, 0xc4, 0x47, 0xbe, 0x35, 0xdf, 0x19, 0xd2, 0x9e, 0x2a, 0x0a, 0x7f, 0xd2,
+	0xd9, 0xf3, 0x3f, 0x98, 0x2f, 0xaf, 0xa7, 0x02, 0x54, 0xaf, 0x92, 0x4f, 0x72, 0xef, 0xf7, 0x00,
+	0x20, 0x56, 0x45, 0xe0, 0x5f, 0x04, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -195,6 +337,20 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.CheckpointTiers) > 0 {
+		for iNdEx := len(m.CheckpointTiers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CheckpointTiers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	n1, err1 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.RecordHistoryKeepPeriod, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.RecordHistoryKeepPeriod):])
 	if err1 != nil {
 		return 0, err1
@@ -213,6 +369,83 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *CheckpointTier) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CheckpointTier) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CheckpointTier) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n2, err2 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.KeepPeriod, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.KeepPeriod):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintGenesis(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x12
+	n3, err3 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Interval, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Interval):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintGenesis(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *TwapCheckpoint) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TwapCheckpoint) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TwapCheckpoint) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Record.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Tier != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Tier))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -233,6 +466,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Checkpoints) > 0 {
+		for iNdEx := len(m.Checkpoints) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Checkpoints[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	{
 		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -283,6 +530,39 @@ func (m *Params) Size() (n int) {
 	}
 	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.RecordHistoryKeepPeriod)
 	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.CheckpointTiers) > 0 {
+		for _, e := range m.CheckpointTiers {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *CheckpointTier) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Interval)
+	n += 1 + l + sovGenesis(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.KeepPeriod)
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *TwapCheckpoint) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Tier != 0 {
+		n += 1 + sovGenesis(uint64(m.Tier))
+	}
+	l = m.Record.Size()
+	n += 1 + l + sovGenesis(uint64(l))
 	return n
 }
 
@@ -300,6 +580,12 @@ func (m *GenesisState) Size() (n int) {
 	}
 	l = m.Params.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.Checkpoints) > 0 {
+		for _, e := range m.Checkpoints {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -403,6 +689,258 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CheckpointTiers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CheckpointTiers = append(m.CheckpointTiers, CheckpointTier{})
+			if err := m.CheckpointTiers[len(m.CheckpointTiers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CheckpointTier) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CheckpointTier: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CheckpointTier: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Interval, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeepPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.KeepPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TwapCheckpoint) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TwapCheckpoint: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TwapCheckpoint: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tier", wireType)
+			}
+			m.Tier = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Tier |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Record", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Record.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -520,6 +1058,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Checkpoints", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Checkpoints = append(m.Checkpoints, TwapCheckpoint{})
+			if err := m.Checkpoints[len(m.Checkpoints)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -34,8 +34,17 @@ var (
 
 func TestGenesisState_Validate(t *testing.T) {
 	var (
-		basicParams = NewParams("week", 48*time.Hour)
+		basicParams = NewParams("week", 48*time.Hour, nil)
 
+		checkpointParams = NewParams("week", 48*time.Hour, DefaultCheckpointTiers())
+
+		tooManyCheckpointTiers = make([]CheckpointTier, MaxCheckpointTiers+1)
+
+		orderedBaseRecord = baseRecord
+	)
+	orderedBaseRecord.Asset0Denom, orderedBaseRecord.Asset1Denom = denom1, denom0
+
+	var (
 		basicCustomGenesis = NewGenesisState(
 			basicParams,
 			[]TwapRecord{
@@ -92,7 +101,7 @@ func TestGenesisState_Validate(t *testing.T) {
 		},
 		"invalid genesis - pool ID doesn't exist": {
 			twapGenesis: NewGenesisState(
-				NewParams("week", 48*time.Hour),
+				NewParams("week", 48*time.Hour, nil),
 				[]TwapRecord{
 					{
 						PoolId:                      0, // invalid
@@ -111,16 +120,92 @@ func TestGenesisState_Validate(t *testing.T) {
 		},
 		"invalid pruneEpochIdentifier - error": {
 			twapGenesis: NewGenesisState(
-				NewParams("", 48*time.Hour), // invalid empty string
+				NewParams("", 48*time.Hour, nil), // invalid empty string
+				[]TwapRecord{
+					baseRecord,
+				}),
+
+			expectedErr: true,
+		},
+		"invalid checkpoint tiers; keep period shorter than interval - error": {
+			twapGenesis: NewGenesisState(
+				NewParams("week", 48*time.Hour, []CheckpointTier{{Interval: 24 * time.Hour, KeepPeriod: time.Hour}}),
 				[]TwapRecord{
 					baseRecord,
 				}),
 
 			expectedErr: true,
 		},
+		"invalid checkpoint tiers; not sorted - error": {
+			twapGenesis: NewGenesisState(
+				NewParams("week", 48*time.Hour, []CheckpointTier{
+					{Interval: 24 * time.Hour, KeepPeriod: 365 * 24 * time.Hour},
+					{Interval: time.Hour, KeepPeriod: 30 * 24 * time.Hour},
+				}),
+				[]TwapRecord{
+					baseRecord,
+				}),
+
+			expectedErr: true,
+		},
+		"too many checkpoint tiers - error": {
+			twapGenesis: NewGenesisState(
+				NewParams("week", 48*time.Hour, tooManyCheckpointTiers),
+				[]TwapRecord{
+					baseRecord,
+				}),
+
+			expectedErr: true,
+		},
+		"valid checkpoints": {
+			twapGenesis: &GenesisState{
+				Params: checkpointParams,
+				Twaps:  []TwapRecord{baseRecord},
+				Checkpoints: []TwapCheckpoint{
+					{Tier: 0, Record: orderedBaseRecord},
+					{Tier: 1, Record: orderedBaseRecord},
+				},
+			},
+		},
+		"invalid checkpoint - error": {
+			twapGenesis: &GenesisState{
+				Params:      checkpointParams,
+				Twaps:       []TwapRecord{baseRecord},
+				Checkpoints: []TwapCheckpoint{{Tier: 0, Record: TwapRecord{PoolId: 0}}}, // invalid
+			},
+
+			expectedErr: true,
+		},
+		"checkpoint of tier that is not configured - error": {
+			twapGenesis: &GenesisState{
+				Params:      checkpointParams,
+				Twaps:       []TwapRecord{baseRecord},
+				Checkpoints: []TwapCheckpoint{{Tier: 2, Record: orderedBaseRecord}},
+			},
+
+			expectedErr: true,
+		},
+		"duplicate checkpoints - error": {
+			twapGenesis: &GenesisState{
+				Params:      checkpointParams,
+				Twaps:       []TwapRecord{baseRecord},
+				Checkpoints: []TwapCheckpoint{{Tier: 0, Record: orderedBaseRecord}, {Tier: 0, Record: orderedBaseRecord}},
+			},
+
+			expectedErr: true,
+		},
+		"checkpoint denoms not ordered - error": {
+			twapGenesis: &GenesisState{
+				Params:      checkpointParams,
+				Twaps:       []TwapRecord{baseRecord},
+				Checkpoints: []TwapCheckpoint{{Tier: 0, Record: baseRecord}}, // denom0 > denom1
+			},
+
+			expectedErr: true,
+		},
 		"invalid recordHistoryKeepPeriod - error": {
 			twapGenesis: NewGenesisState(
-				NewParams("week", -1*time.Hour), // invalid duration
+				NewParams("week", -1*time.Hour, nil), // invalid duration
 				[]TwapRecord{
 					baseRecord,
 				}),
@@ -297,7 +382,14 @@ func TestTWAPRecord_Validate(t *testing.T) {
 			}(),
 			expectedErr: true,
 		},
-		"invalid geometric accum: nil": {
+		"valid geometric accum: negative": {
+			twapRecord: func() TwapRecord {
+				r := baseRecord
+				r.GeometricTwapAccumulator = sdk.OneDec().Neg()
+				return r
+			}(),
+		},
+		"valid geometric accum: nil": {
 			twapRecord: func() TwapRecord {
 				r := TwapRecord{
 					PoolId:                      basePoolId,
@@ -312,7 +404,6 @@ func TestTWAPRecord_Validate(t *testing.T) {
 				}
 				return r
 			}(),
-			expectedErr: true,
 		},
 	}
 	// make test cases symmetric
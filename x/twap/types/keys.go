@@ -27,6 +27,8 @@ var (
 	mostRecentTWAPsNoSeparator         = "recent_twap"
 	historicalTWAPTimeIndexNoSeparator = "historical_time_index"
 	historicalTWAPPoolIndexNoSeparator = "historical_pool_index"
+	checkpointTimeIndexNoSeparator     = "checkpoint_time_index"
+	checkpointPoolIndexNoSeparator     = "checkpoint_pool_index"
 
 	// We do key management to let us easily meet the goals of (AKA minimal iteration):
 	// * Get most recent twap for a (pool id, asset 1, asset 2) with no iteration
@@ -42,6 +44,12 @@ var (
 	// format is pool id | denom1 | denom2 | time
 	// made for efficiently getting records given (pool id, denom1, denom2) and time bounds
 	HistoricalTWAPPoolIndexPrefix = historicalTWAPPoolIndexNoSeparator + KeySeparator
+	// Checkpoints are sparse historical records, that are kept after the historical records are pruned.
+	// They are stored per checkpoint tier, so every tier can be pruned by key range.
+	// format is tier | time | pool id | denom1 | denom2
+	CheckpointTimeIndexPrefix = checkpointTimeIndexNoSeparator + KeySeparator
+	// format is pool id | denom1 | denom2 | tier | time
+	CheckpointPoolIndexPrefix = checkpointPoolIndexNoSeparator + KeySeparator
 )
 
 // TODO: make utility command to automatically interlace separators
@@ -72,6 +80,30 @@ func FormatHistoricalPoolIndexTimeSuffix(poolId uint64, denom1, denom2 string, a
 	return []byte(fmt.Sprintf("%s%d%s%s%s%s%s%s.", HistoricalTWAPPoolIndexPrefix, poolId, KeySeparator, denom1, KeySeparator, denom2, KeySeparator, timeS))
 }
 
+func FormatCheckpointTimeIndexTierPrefix(tier uint32) []byte {
+	return []byte(fmt.Sprintf("%s%d%s", CheckpointTimeIndexPrefix, tier, KeySeparator))
+}
+
+func FormatCheckpointTimeIndexKey(tier uint32, accumulatorWriteTime time.Time, poolId uint64, denom1, denom2 string) []byte {
+	timeS := osmoutils.FormatTimeString(accumulatorWriteTime)
+	return []byte(fmt.Sprintf("%s%d%s%s%s%d%s%s%s%s", CheckpointTimeIndexPrefix, tier, KeySeparator, timeS, KeySeparator, poolId, KeySeparator, denom1, KeySeparator, denom2))
+}
+
+func FormatCheckpointPoolIndexKey(poolId uint64, denom1, denom2 string, tier uint32, accumulatorWriteTime time.Time) []byte {
+	timeS := osmoutils.FormatTimeString(accumulatorWriteTime)
+	return []byte(fmt.Sprintf("%s%d%s%s%s%s%s%d%s%s", CheckpointPoolIndexPrefix, poolId, KeySeparator, denom1, KeySeparator, denom2, KeySeparator, tier, KeySeparator, timeS))
+}
+
+func FormatCheckpointPoolIndexTimePrefix(poolId uint64, denom1, denom2 string, tier uint32) []byte {
+	return []byte(fmt.Sprintf("%s%d%s%s%s%s%s%d%s", CheckpointPoolIndexPrefix, poolId, KeySeparator, denom1, KeySeparator, denom2, KeySeparator, tier, KeySeparator))
+}
+
+func FormatCheckpointPoolIndexTimeSuffix(poolId uint64, denom1, denom2 string, tier uint32, accumulatorWriteTime time.Time) []byte {
+	timeS := osmoutils.FormatTimeString(accumulatorWriteTime)
+	// . acts as a suffix for lexicographical orderings
+	return []byte(fmt.Sprintf("%s%d%s%s%s%s%s%d%s%s.", CheckpointPoolIndexPrefix, poolId, KeySeparator, denom1, KeySeparator, denom2, KeySeparator, tier, KeySeparator, timeS))
+}
+
 // GetAllMostRecentTwapsForPool returns all of the most recent twap records for a pool id.
 // if the pool id doesn't exist, then this returns a blank list.
 func GetAllMostRecentTwapsForPool(store sdk.KVStore, poolId uint64) ([]TwapRecord, error) {
@@ -82,6 +114,14 @@ func GetAllMostRecentTwapsForPool(store sdk.KVStore, poolId uint64) ([]TwapRecor
 	return osmoutils.GatherValuesFromStore(store, []byte(startPrefix), []byte(endPrefix), ParseTwapFromBz)
 }
 
+func ParseCheckpointFromBz(bz []byte) (checkpoint TwapCheckpoint, err error) {
+	if len(bz) == 0 {
+		return TwapCheckpoint{}, errors.New("checkpoint not found")
+	}
+	err = proto.Unmarshal(bz, &checkpoint)
+	return checkpoint, err
+}
+
 func ParseTwapFromBz(bz []byte) (twap TwapRecord, err error) {
 	if len(bz) == 0 {
 		return TwapRecord{}, errors.New("twap not found")
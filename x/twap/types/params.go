@@ -13,6 +13,7 @@ import (
 var (
 	KeyPruneEpochIdentifier    = []byte("PruneEpochIdentifier")
 	KeyRecordHistoryKeepPeriod = []byte("RecordHistoryKeepPeriod")
+	KeyCheckpointTiers         = []byte("CheckpointTiers")
 
 	_ paramtypes.ParamSet = &Params{}
 )
@@ -20,6 +21,9 @@ var (
 const (
 	defaultPruneEpochIdentifier    = "day"
 	defaultRecordHistoryKeepPeriod = 48 * time.Hour
+
+	// MaxCheckpointTiers is the maximum number of checkpoint tiers.
+	MaxCheckpointTiers = 10
 )
 
 // ParamTable for twap module.
@@ -27,10 +31,11 @@ func ParamKeyTable() paramtypes.KeyTable {
 	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
 }
 
-func NewParams(pruneEpochIdentifier string, recordHistoryKeepPeriod time.Duration) Params {
+func NewParams(pruneEpochIdentifier string, recordHistoryKeepPeriod time.Duration, checkpointTiers []CheckpointTier) Params {
 	return Params{
 		PruneEpochIdentifier:    pruneEpochIdentifier,
 		RecordHistoryKeepPeriod: recordHistoryKeepPeriod,
+		CheckpointTiers:         checkpointTiers,
 	}
 }
 
@@ -39,6 +44,16 @@ func DefaultParams() Params {
 	return Params{
 		PruneEpochIdentifier:    defaultPruneEpochIdentifier,
 		RecordHistoryKeepPeriod: defaultRecordHistoryKeepPeriod,
+		CheckpointTiers:         DefaultCheckpointTiers(),
+	}
+}
+
+// DefaultCheckpointTiers returns the default checkpoint tiers:
+// one checkpoint per hour for 30 days, and one checkpoint per day for a year.
+func DefaultCheckpointTiers() []CheckpointTier {
+	return []CheckpointTier{
+		{Interval: time.Hour, KeepPeriod: 30 * 24 * time.Hour},
+		{Interval: 24 * time.Hour, KeepPeriod: 365 * 24 * time.Hour},
 	}
 }
 
@@ -52,6 +67,10 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := validateCheckpointTiers(p.CheckpointTiers); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -60,6 +79,7 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 	return paramtypes.ParamSetPairs{
 		paramtypes.NewParamSetPair(KeyPruneEpochIdentifier, &p.PruneEpochIdentifier, epochtypes.ValidateEpochIdentifierInterface),
 		paramtypes.NewParamSetPair(KeyRecordHistoryKeepPeriod, &p.RecordHistoryKeepPeriod, validatePeriod),
+		paramtypes.NewParamSetPair(KeyCheckpointTiers, &p.CheckpointTiers, validateCheckpointTiers),
 	}
 }
 
@@ -75,3 +95,39 @@ func validatePeriod(i interface{}) error {
 
 	return nil
 }
+
+func validateCheckpointTiers(i interface{}) error {
+	tiers, ok := i.([]CheckpointTier)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	// checkpoint keys hold the tier index as a single digit, to keep them ordered by tier.
+	if len(tiers) > MaxCheckpointTiers {
+		return fmt.Errorf("there can be at most %d checkpoint tiers, got %d", MaxCheckpointTiers, len(tiers))
+	}
+
+	for idx, tier := range tiers {
+		if err := validatePeriod(tier.Interval); err != nil {
+			return fmt.Errorf("checkpoint tier %d interval: %w", idx, err)
+		}
+		if err := validatePeriod(tier.KeepPeriod); err != nil {
+			return fmt.Errorf("checkpoint tier %d keep period: %w", idx, err)
+		}
+		if tier.KeepPeriod < tier.Interval {
+			return fmt.Errorf("checkpoint tier %d keep period (%s) must not be shorter than its interval (%s)",
+				idx, tier.KeepPeriod, tier.Interval)
+		}
+		if idx == 0 {
+			continue
+		}
+		prev := tiers[idx-1]
+		if tier.Interval <= prev.Interval || tier.KeepPeriod <= prev.KeepPeriod {
+			return fmt.Errorf("checkpoint tiers must be sorted by strictly increasing interval and keep period, "+
+				"tier %d (%s, %s) does not follow tier %d (%s, %s)",
+				idx, tier.Interval, tier.KeepPeriod, idx-1, prev.Interval, prev.KeepPeriod)
+		}
+	}
+
+	return nil
+}
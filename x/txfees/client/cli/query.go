@@ -15,6 +15,12 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdFeeTokens(),
 		GetCmdDenomPoolID(),
 		GetCmdBaseDenom(),
+		GetCmdDeferredFeeSwaps(),
+		GetCmdBaseFee(),
+		GetCmdAutoFeeTokens(),
+		GetCmdFeeSponsors(),
+		GetCmdFeeSponsor(),
+		GetCmdParams(),
 	)
 
 	return cmd
@@ -52,3 +58,69 @@ func GetCmdBaseDenom() *cobra.Command {
 		types.ModuleName, types.NewQueryClient,
 	)
 }
+
+func GetCmdDeferredFeeSwaps() *cobra.Command {
+	return osmocli.SimpleQueryCmd[*types.QueryDeferredFeeSwapsRequest](
+		"deferred-fee-swaps",
+		"Query the pending fee token swaps that were deferred for breaching the twap slippage bound",
+		`{{.Short}}{{.ExampleHeader}}
+{{.CommandPrefix}} deferred-fee-swaps
+`,
+		types.ModuleName, types.NewQueryClient,
+	)
+}
+
+func GetCmdBaseFee() *cobra.Command {
+	return osmocli.SimpleQueryCmd[*types.QueryBaseFeeRequest](
+		"base-fee",
+		"Query the current base fee, in base denom per unit of gas",
+		`{{.Short}}{{.ExampleHeader}}
+{{.CommandPrefix}} base-fee
+`,
+		types.ModuleName, types.NewQueryClient,
+	)
+}
+
+func GetCmdAutoFeeTokens() *cobra.Command {
+	return osmocli.SimpleQueryCmd[*types.QueryAutoFeeTokensRequest](
+		"auto-fee-tokens",
+		"Query the list of fee tokens not whitelisted by governance and their routes to the base denom",
+		`{{.Short}}{{.ExampleHeader}}
+{{.CommandPrefix}} auto-fee-tokens
+`,
+		types.ModuleName, types.NewQueryClient,
+	)
+}
+
+func GetCmdParams() *cobra.Command {
+	return osmocli.SimpleQueryCmd[*types.QueryParamsRequest](
+		"params",
+		"Query the txfees module params",
+		`{{.Short}}{{.ExampleHeader}}
+{{.CommandPrefix}} params
+`,
+		types.ModuleName, types.NewQueryClient,
+	)
+}
+
+func GetCmdFeeSponsors() *cobra.Command {
+	return osmocli.SimpleQueryCmd[*types.QueryFeeSponsorsRequest](
+		"fee-sponsors",
+		"Query the contracts registered to sponsor the fees of other accounts' txs",
+		`{{.Short}}{{.ExampleHeader}}
+{{.CommandPrefix}} fee-sponsors
+`,
+		types.ModuleName, types.NewQueryClient,
+	)
+}
+
+func GetCmdFeeSponsor() *cobra.Command {
+	return osmocli.SimpleQueryCmd[*types.QueryFeeSponsorRequest](
+		"fee-sponsor",
+		"Query the fee sponsor registration of a contract",
+		`{{.Short}}{{.ExampleHeader}}
+{{.CommandPrefix}} fee-sponsor [contract-address]
+`,
+		types.ModuleName, types.NewQueryClient,
+	)
+}
@@ -74,6 +74,36 @@ func (s *QueryTestSuite) TestQueriesNeverAlterState() {
 			&types.QueryFeeTokensRequest{},
 			&types.QueryFeeTokensResponse{},
 		},
+		{
+			"Query deferred fee swaps",
+			"/osmosis.txfees.v1beta1.Query/DeferredFeeSwaps",
+			&types.QueryDeferredFeeSwapsRequest{},
+			&types.QueryDeferredFeeSwapsResponse{},
+		},
+		{
+			"Query base fee",
+			"/osmosis.txfees.v1beta1.Query/BaseFee",
+			&types.QueryBaseFeeRequest{},
+			&types.QueryBaseFeeResponse{},
+		},
+		{
+			"Query auto fee tokens",
+			"/osmosis.txfees.v1beta1.Query/AutoFeeTokens",
+			&types.QueryAutoFeeTokensRequest{},
+			&types.QueryAutoFeeTokensResponse{},
+		},
+		{
+			"Query fee sponsors",
+			"/osmosis.txfees.v1beta1.Query/FeeSponsors",
+			&types.QueryFeeSponsorsRequest{},
+			&types.QueryFeeSponsorsResponse{},
+		},
+		{
+			"Query params",
+			"/osmosis.txfees.v1beta1.Query/Params",
+			&types.QueryParamsRequest{},
+			&types.QueryParamsResponse{},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -6,6 +6,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/tx"
 
 	"github.com/spf13/cobra"
+	flag "github.com/spf13/pflag"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
@@ -21,10 +22,49 @@ func NewTxCmd() *cobra.Command {
 	txCmd := osmocli.TxIndexCmd(types.ModuleName)
 	txCmd.AddCommand(
 		NewCmdSubmitUpdateFeeTokenProposal(),
+		NewRegisterFeeSponsorCmd(),
+		NewUnregisterFeeSponsorCmd(),
 	)
 	return txCmd
 }
 
+const (
+	FlagMsgTypeUrls = "msg-type-urls"
+	FlagAddresses   = "addresses"
+)
+
+func FlagSetRegisterFeeSponsor() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+	fs.StringArray(FlagMsgTypeUrls, []string{}, "Type url of the messages to sponsor, all if unset (specify multiple with: --msg-type-urls=/cosmos.bank.v1beta1.MsgSend --msg-type-urls=/osmosis.gamm.v1beta1.MsgSwapExactAmountIn)")
+	fs.StringArray(FlagAddresses, []string{}, "Fee payer addresses to sponsor, all if unset (specify multiple with: --addresses=osmo1... --addresses=osmo1...)")
+	return fs
+}
+
+func NewRegisterFeeSponsorCmd() *cobra.Command {
+	return osmocli.BuildTxCli[*types.MsgRegisterFeeSponsor](&osmocli.TxCliDesc{
+		Use:   "register-fee-sponsor [contract-address]",
+		Short: "Register a contract to pay the fees of the txs it approves. Must be sent by the contract or its admin.",
+		CustomFieldParsers: map[string]osmocli.CustomFieldParserFn{
+			"MsgTypeUrls": osmocli.FlagOnlyParser(stringArrayParser(FlagMsgTypeUrls)),
+			"Addresses":   osmocli.FlagOnlyParser(stringArrayParser(FlagAddresses)),
+		},
+		Flags: osmocli.FlagDesc{OptionalFlags: []*flag.FlagSet{FlagSetRegisterFeeSponsor()}},
+	})
+}
+
+func NewUnregisterFeeSponsorCmd() *cobra.Command {
+	return osmocli.BuildTxCli[*types.MsgUnregisterFeeSponsor](&osmocli.TxCliDesc{
+		Use:   "unregister-fee-sponsor [contract-address]",
+		Short: "Remove a contract from the fee sponsors. Must be sent by the contract or its admin.",
+	})
+}
+
+func stringArrayParser(flagName string) func(fs *flag.FlagSet) ([]string, error) {
+	return func(fs *flag.FlagSet) ([]string, error) {
+		return fs.GetStringArray(flagName)
+	}
+}
+
 func NewCmdSubmitUpdateFeeTokenProposal() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "update-fee-token [denom] [poolId]",
@@ -0,0 +1,231 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/gogo/protobuf/proto"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	swaproutertypes "github.com/osmosis-labs/osmosis/v13/x/swaprouter/types"
+	"github.com/osmosis-labs/osmosis/v13/x/txfees/types"
+)
+
+// UpdateAutoFeeTokens recomputes the auto fee tokens, and is called at the end of each
+// AutoFeeTokensEpochIdentifier epoch.
+// Every pooled denom that is neither the base denom nor whitelisted by governance becomes an
+// auto fee token if swaprouter finds a route of at most MaxAutoFeeTokenRouteHops pools from it
+// to the base denom, and each pool of the route holds at least MinAutoFeeTokenLiquidity worth
+// of the route's token out. The route is the one giving the most base denom for 1% of the
+// denom's total pool liquidity, so that it is picked for a sizeable amount rather than dust.
+// At most MaxAutoFeeTokenCandidates denoms are considered, the current auto fee tokens first
+// and then the other denoms in order, and the routes of all of them are searched at once.
+func (k Keeper) UpdateAutoFeeTokens(ctx sdk.Context) {
+	prevAutoFeeTokens := k.GetAutoFeeTokens(ctx)
+	k.clearAutoFeeTokens(ctx)
+
+	params := k.GetParams(ctx)
+	if !params.AutoFeeTokensEnabled {
+		return
+	}
+
+	baseDenom, err := k.GetBaseDenom(ctx)
+	if err != nil {
+		return
+	}
+
+	totalLiquidity, err := k.swaprouterKeeper.GetTotalLiquidity(ctx)
+	if err != nil {
+		return
+	}
+
+	isCandidate := func(denom string) bool {
+		if denom == baseDenom {
+			return false
+		}
+		if _, err := k.GetFeeToken(ctx, denom); err == nil {
+			return false
+		}
+		return totalLiquidity.AmountOf(denom).QuoRaw(100).IsPositive()
+	}
+
+	probes := []sdk.Coin{}
+	seen := map[string]bool{}
+	addProbe := func(denom string) {
+		if seen[denom] || len(probes) >= types.MaxAutoFeeTokenCandidates || !isCandidate(denom) {
+			return
+		}
+		seen[denom] = true
+		probes = append(probes, sdk.NewCoin(denom, totalLiquidity.AmountOf(denom).QuoRaw(100)))
+	}
+	for _, autoFeeToken := range prevAutoFeeTokens {
+		addProbe(autoFeeToken.Denom)
+	}
+	for _, coin := range totalLiquidity {
+		addProbe(coin.Denom)
+	}
+
+	allRoutes, err := k.swaprouterKeeper.FindOptimalRoutesExactAmountIn(ctx, probes, baseDenom, types.MaxAutoFeeTokenRouteHops)
+	if err != nil {
+		return
+	}
+
+	for i, probe := range probes {
+		routes := allRoutes[i]
+		if routes == nil {
+			continue
+		}
+
+		if err := k.validateAutoFeeTokenRouteLiquidity(ctx, probe.Denom, routes, params.MinAutoFeeTokenLiquidity); err != nil {
+			continue
+		}
+
+		k.setAutoFeeToken(ctx, types.AutoFeeToken{Denom: probe.Denom, Routes: routes})
+	}
+}
+
+// validateAutoFeeTokenRouteLiquidity checks that each pool of the route from denom holds at
+// least minLiquidity worth of base denom of the route's token out, valued at the twap price
+// of the rest of the route. It also checks that twaps are available to price the route.
+func (k Keeper) validateAutoFeeTokenRouteLiquidity(ctx sdk.Context, denom string, routes []swaproutertypes.SwapAmountInRoute, minLiquidity sdk.Int) error {
+	// tokenOutPrice is the price in base denom of the token out of the current hop.
+	tokenOutPrice := sdk.OneDec()
+	for i := len(routes) - 1; i >= 0; i-- {
+		liquidity, err := k.swaprouterKeeper.GetTotalPoolLiquidity(ctx, routes[i].PoolId)
+		if err != nil {
+			return err
+		}
+
+		reserveValue := tokenOutPrice.MulInt(liquidity.AmountOf(routes[i].TokenOutDenom))
+		if reserveValue.LT(minLiquidity.ToDec()) {
+			return fmt.Errorf("pool %d holds %s base denom worth of %s, less than the required %s",
+				routes[i].PoolId, reserveValue, routes[i].TokenOutDenom, minLiquidity)
+		}
+
+		tokenInDenom := denom
+		if i > 0 {
+			tokenInDenom = routes[i-1].TokenOutDenom
+		}
+		twap, err := k.getFeeTokenTwap(ctx, routes[i].PoolId, tokenInDenom, routes[i].TokenOutDenom)
+		if err != nil {
+			return err
+		}
+		tokenOutPrice = tokenOutPrice.Mul(twap)
+	}
+
+	return nil
+}
+
+// calcRouteTwap returns the price of tokenInDenom in units of the token out of the last pool
+// of the route, as the product of the arithmetic twaps of each pool over the FeeSwapTwapWindow.
+func (k Keeper) calcRouteTwap(ctx sdk.Context, tokenInDenom string, routes []swaproutertypes.SwapAmountInRoute) (sdk.Dec, error) {
+	price := sdk.OneDec()
+	for _, route := range routes {
+		twap, err := k.getFeeTokenTwap(ctx, route.PoolId, tokenInDenom, route.TokenOutDenom)
+		if err != nil {
+			return sdk.Dec{}, err
+		}
+		price = price.Mul(twap)
+		tokenInDenom = route.TokenOutDenom
+	}
+	return price, nil
+}
+
+// getFeeTokenTwap returns the arithmetic twap of baseAssetDenom in units of quoteAssetDenom
+// in the given pool over the FeeSwapTwapWindow.
+func (k Keeper) getFeeTokenTwap(ctx sdk.Context, poolId uint64, baseAssetDenom string, quoteAssetDenom string) (sdk.Dec, error) {
+	startTime := ctx.BlockTime().Add(-k.GetParams(ctx).FeeSwapTwapWindow)
+	twap, err := k.twapKeeper.GetArithmeticTwapToNow(ctx, poolId, baseAssetDenom, quoteAssetDenom, startTime)
+	if err != nil {
+		return sdk.Dec{}, sdkerrors.Wrapf(types.ErrNoFeeTokenTwap, "pool %d: %s", poolId, err)
+	}
+	return twap, nil
+}
+
+// getFeeTokenRoutes returns the route from a non-base fee denom to the base denom:
+// the pool of a fee token whitelisted by governance, or the route of an auto fee token.
+func (k Keeper) getFeeTokenRoutes(ctx sdk.Context, denom string, baseDenom string) ([]swaproutertypes.SwapAmountInRoute, error) {
+	if feeToken, err := k.GetFeeToken(ctx, denom); err == nil {
+		return []swaproutertypes.SwapAmountInRoute{{PoolId: feeToken.PoolID, TokenOutDenom: baseDenom}}, nil
+	}
+	if autoFeeToken, found := k.GetAutoFeeToken(ctx, denom); found {
+		return autoFeeToken.Routes, nil
+	}
+	return nil, sdkerrors.Wrapf(types.ErrInvalidFeeToken, "%s", denom)
+}
+
+// getEnabledAutoFeeToken returns the auto fee token of the given denom, if auto fee tokens
+// are enabled.
+func (k Keeper) getEnabledAutoFeeToken(ctx sdk.Context, denom string) (types.AutoFeeToken, error) {
+	if !k.GetParams(ctx).AutoFeeTokensEnabled {
+		return types.AutoFeeToken{}, sdkerrors.Wrapf(types.ErrInvalidFeeToken, "%s", denom)
+	}
+	autoFeeToken, found := k.GetAutoFeeToken(ctx, denom)
+	if !found {
+		return types.AutoFeeToken{}, sdkerrors.Wrapf(types.ErrInvalidFeeToken, "%s", denom)
+	}
+	return autoFeeToken, nil
+}
+
+func (k Keeper) getAutoFeeTokensStore(ctx sdk.Context) sdk.KVStore {
+	store := ctx.KVStore(k.storeKey)
+	return prefix.NewStore(store, types.AutoFeeTokensStorePrefix)
+}
+
+// GetAutoFeeToken returns the auto fee token of the given denom, if any.
+func (k Keeper) GetAutoFeeToken(ctx sdk.Context, denom string) (types.AutoFeeToken, bool) {
+	prefixStore := k.getAutoFeeTokensStore(ctx)
+	bz := prefixStore.Get([]byte(denom))
+	if bz == nil {
+		return types.AutoFeeToken{}, false
+	}
+
+	autoFeeToken := types.AutoFeeToken{}
+	err := proto.Unmarshal(bz, &autoFeeToken)
+	if err != nil {
+		panic(err)
+	}
+
+	return autoFeeToken, true
+}
+
+func (k Keeper) setAutoFeeToken(ctx sdk.Context, autoFeeToken types.AutoFeeToken) {
+	prefixStore := k.getAutoFeeTokensStore(ctx)
+
+	bz, err := proto.Marshal(&autoFeeToken)
+	if err != nil {
+		panic(err)
+	}
+
+	prefixStore.Set([]byte(autoFeeToken.Denom), bz)
+}
+
+func (k Keeper) clearAutoFeeTokens(ctx sdk.Context) {
+	for _, autoFeeToken := range k.GetAutoFeeTokens(ctx) {
+		k.getAutoFeeTokensStore(ctx).Delete([]byte(autoFeeToken.Denom))
+	}
+}
+
+// GetAutoFeeTokens returns all of the auto fee tokens, ordered by denom.
+func (k Keeper) GetAutoFeeTokens(ctx sdk.Context) []types.AutoFeeToken {
+	prefixStore := k.getAutoFeeTokensStore(ctx)
+
+	iterator := prefixStore.Iterator(nil, nil)
+	defer iterator.Close()
+
+	autoFeeTokens := []types.AutoFeeToken{}
+
+	for ; iterator.Valid(); iterator.Next() {
+		autoFeeToken := types.AutoFeeToken{}
+
+		err := proto.Unmarshal(iterator.Value(), &autoFeeToken)
+		if err != nil {
+			panic(err)
+		}
+
+		autoFeeTokens = append(autoFeeTokens, autoFeeToken)
+	}
+	return autoFeeTokens
+}
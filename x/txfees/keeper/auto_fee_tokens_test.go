@@ -0,0 +1,171 @@
+package keeper_test
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	swaproutertypes "github.com/osmosis-labs/osmosis/v13/x/swaprouter/types"
+	"github.com/osmosis-labs/osmosis/v13/x/txfees/types"
+)
+
+const autoFeeTokenPoolAmount = int64(1_000_000)
+
+// setupAutoFeeTokenPools creates a liquid base denom / uion pool, a liquid uion / atom pool,
+// an illiquid base denom / ust pool, and a foo pool whitelisted by governance.
+// Auto fee tokens are enabled, requiring 100,000 base denom of liquidity,
+// and the block time is moved past the twap window.
+func (suite *KeeperTestSuite) setupAutoFeeTokenPools() (baseUionPoolId, uionAtomPoolId uint64) {
+	baseDenom, _ := suite.App.TxFeesKeeper.GetBaseDenom(suite.Ctx)
+
+	baseUionPoolId = suite.PrepareBalancerPoolWithCoins(
+		sdk.NewInt64Coin(baseDenom, autoFeeTokenPoolAmount),
+		sdk.NewInt64Coin("uion", autoFeeTokenPoolAmount),
+	)
+	uionAtomPoolId = suite.PrepareBalancerPoolWithCoins(
+		sdk.NewInt64Coin("uion", autoFeeTokenPoolAmount),
+		sdk.NewInt64Coin("atom", autoFeeTokenPoolAmount),
+	)
+	suite.PrepareBalancerPoolWithCoins(
+		sdk.NewInt64Coin(baseDenom, 1000),
+		sdk.NewInt64Coin("ust", 1000),
+	)
+	suite.preparePool("foo")
+
+	params := suite.App.TxFeesKeeper.GetParams(suite.Ctx)
+	params.AutoFeeTokensEnabled = true
+	params.MinAutoFeeTokenLiquidity = sdk.NewInt(100_000)
+	suite.App.TxFeesKeeper.SetParams(suite.Ctx, params)
+
+	suite.Ctx = suite.Ctx.WithBlockTime(suite.Ctx.BlockTime().Add(params.FeeSwapTwapWindow).Add(time.Minute))
+	return baseUionPoolId, uionAtomPoolId
+}
+
+func (suite *KeeperTestSuite) TestUpdateAutoFeeTokens() {
+	suite.SetupTest(false)
+	baseDenom, _ := suite.App.TxFeesKeeper.GetBaseDenom(suite.Ctx)
+	baseUionPoolId, uionAtomPoolId := suite.setupAutoFeeTokenPools()
+
+	suite.App.TxFeesKeeper.UpdateAutoFeeTokens(suite.Ctx)
+
+	// ust is not liquid enough, and foo is whitelisted by governance.
+	expectedAutoFeeTokens := []types.AutoFeeToken{
+		{
+			Denom: "atom",
+			Routes: []swaproutertypes.SwapAmountInRoute{
+				{PoolId: uionAtomPoolId, TokenOutDenom: "uion"},
+				{PoolId: baseUionPoolId, TokenOutDenom: baseDenom},
+			},
+		},
+		{
+			Denom:  "uion",
+			Routes: []swaproutertypes.SwapAmountInRoute{{PoolId: baseUionPoolId, TokenOutDenom: baseDenom}},
+		},
+	}
+	suite.Require().Equal(expectedAutoFeeTokens, suite.App.TxFeesKeeper.GetAutoFeeTokens(suite.Ctx))
+
+	res, err := suite.queryClient.AutoFeeTokens(sdk.WrapSDKContext(suite.Ctx), &types.QueryAutoFeeTokensRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(expectedAutoFeeTokens, res.AutoFeeTokens)
+
+	// raising the liquidity threshold above the uion / atom pool drops atom.
+	params := suite.App.TxFeesKeeper.GetParams(suite.Ctx)
+	params.MinAutoFeeTokenLiquidity = sdk.NewInt(autoFeeTokenPoolAmount + 1)
+	suite.App.TxFeesKeeper.SetParams(suite.Ctx, params)
+	suite.App.TxFeesKeeper.UpdateAutoFeeTokens(suite.Ctx)
+	suite.Require().Empty(suite.App.TxFeesKeeper.GetAutoFeeTokens(suite.Ctx))
+
+	// disabling auto fee tokens clears them.
+	params.MinAutoFeeTokenLiquidity = sdk.NewInt(100_000)
+	suite.App.TxFeesKeeper.SetParams(suite.Ctx, params)
+	suite.App.TxFeesKeeper.UpdateAutoFeeTokens(suite.Ctx)
+	suite.Require().Len(suite.App.TxFeesKeeper.GetAutoFeeTokens(suite.Ctx), 2)
+
+	params.AutoFeeTokensEnabled = false
+	suite.App.TxFeesKeeper.SetParams(suite.Ctx, params)
+	suite.App.TxFeesKeeper.UpdateAutoFeeTokens(suite.Ctx)
+	suite.Require().Empty(suite.App.TxFeesKeeper.GetAutoFeeTokens(suite.Ctx))
+}
+
+func (suite *KeeperTestSuite) TestAutoFeeTokenFees() {
+	suite.SetupTest(false)
+	baseDenom, _ := suite.App.TxFeesKeeper.GetBaseDenom(suite.Ctx)
+	suite.setupAutoFeeTokenPools()
+
+	// atom is not accepted until the auto fee tokens are computed.
+	suite.Require().Error(suite.App.TxFeesKeeper.ValidateFeeDenom(suite.Ctx, "atom"))
+	suite.App.TxFeesKeeper.UpdateAutoFeeTokens(suite.Ctx)
+	suite.Require().NoError(suite.App.TxFeesKeeper.ValidateFeeDenom(suite.Ctx, "atom"))
+	suite.Require().Error(suite.App.TxFeesKeeper.ValidateFeeDenom(suite.Ctx, "ust"))
+
+	// atom is priced at the twap of its route, which is one base denom per atom.
+	converted, err := suite.App.TxFeesKeeper.ConvertToBaseToken(suite.Ctx, sdk.NewInt64Coin("atom", 1000))
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewInt64Coin(baseDenom, 1000), converted)
+
+	// atom fees are swapped through its route at the end of the epoch.
+	suite.fundNonNativeFeeCollector(sdk.NewCoins(sdk.NewInt64Coin("atom", 1000)))
+	err = suite.App.TxFeesKeeper.AfterEpochEnd(suite.Ctx, "day", 1)
+	suite.Require().NoError(err)
+
+	moduleAddrNonNativeFee := suite.App.AccountKeeper.GetModuleAddress(types.NonNativeFeeCollectorName)
+	moduleAddrFee := suite.App.AccountKeeper.GetModuleAddress(types.FeeCollectorName)
+	suite.Require().Empty(suite.App.BankKeeper.GetAllBalances(suite.Ctx, moduleAddrNonNativeFee))
+	suite.Require().True(suite.App.BankKeeper.GetBalance(suite.Ctx, moduleAddrFee, baseDenom).IsPositive())
+
+	// disabling auto fee tokens stops accepting atom right away.
+	params := suite.App.TxFeesKeeper.GetParams(suite.Ctx)
+	params.AutoFeeTokensEnabled = false
+	suite.App.TxFeesKeeper.SetParams(suite.Ctx, params)
+	suite.Require().Error(suite.App.TxFeesKeeper.ValidateFeeDenom(suite.Ctx, "atom"))
+	_, err = suite.App.TxFeesKeeper.ConvertToBaseToken(suite.Ctx, sdk.NewInt64Coin("atom", 1000))
+	suite.Require().Error(err)
+}
+
+// TestAutoFeeTokensEpochIdentifier tests that the auto fee tokens are only recomputed
+// at the end of AutoFeeTokensEpochIdentifier epochs.
+func (suite *KeeperTestSuite) TestAutoFeeTokensEpochIdentifier() {
+	suite.SetupTest(false)
+	suite.setupAutoFeeTokenPools()
+	params := suite.App.TxFeesKeeper.GetParams(suite.Ctx)
+	suite.Require().Equal("day", params.AutoFeeTokensEpochIdentifier)
+
+	err := suite.App.TxFeesKeeper.AfterEpochEnd(suite.Ctx, "week", 1)
+	suite.Require().NoError(err)
+	suite.Require().Empty(suite.App.TxFeesKeeper.GetAutoFeeTokens(suite.Ctx))
+
+	err = suite.App.TxFeesKeeper.AfterEpochEnd(suite.Ctx, "day", 1)
+	suite.Require().NoError(err)
+	suite.Require().Len(suite.App.TxFeesKeeper.GetAutoFeeTokens(suite.Ctx), 2)
+}
+
+// TestUpdateAutoFeeTokensCandidateCap tests that at most MaxAutoFeeTokenCandidates denoms are
+// considered, keeping the current auto fee tokens ahead of the other denoms.
+func (suite *KeeperTestSuite) TestUpdateAutoFeeTokensCandidateCap() {
+	suite.SetupTest(false)
+	baseDenom, _ := suite.App.TxFeesKeeper.GetBaseDenom(suite.Ctx)
+	suite.setupAutoFeeTokenPools()
+	suite.App.TxFeesKeeper.UpdateAutoFeeTokens(suite.Ctx)
+	suite.Require().Len(suite.App.TxFeesKeeper.GetAutoFeeTokens(suite.Ctx), 2)
+
+	// Pool denoms ordered before atom and uion take up all of the other candidate slots.
+	for i := 0; i < types.MaxAutoFeeTokenCandidates; i++ {
+		suite.PrepareBalancerPoolWithCoins(
+			sdk.NewInt64Coin(baseDenom, autoFeeTokenPoolAmount),
+			sdk.NewInt64Coin(fmt.Sprintf("aaa%03d", i), autoFeeTokenPoolAmount),
+		)
+	}
+	twapWindow := suite.App.TxFeesKeeper.GetParams(suite.Ctx).FeeSwapTwapWindow
+	suite.Ctx = suite.Ctx.WithBlockTime(suite.Ctx.BlockTime().Add(twapWindow).Add(time.Minute))
+
+	suite.App.TxFeesKeeper.UpdateAutoFeeTokens(suite.Ctx)
+	autoFeeTokens := suite.App.TxFeesKeeper.GetAutoFeeTokens(suite.Ctx)
+	suite.Require().Len(autoFeeTokens, types.MaxAutoFeeTokenCandidates)
+	_, found := suite.App.TxFeesKeeper.GetAutoFeeToken(suite.Ctx, "atom")
+	suite.Require().True(found)
+	_, found = suite.App.TxFeesKeeper.GetAutoFeeToken(suite.Ctx, "uion")
+	suite.Require().True(found)
+	_, found = suite.App.TxFeesKeeper.GetAutoFeeToken(suite.Ctx, fmt.Sprintf("aaa%03d", types.MaxAutoFeeTokenCandidates-2))
+	suite.Require().False(found)
+}
@@ -0,0 +1,61 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v13/x/txfees/types"
+)
+
+// GetCurrentBaseFee returns the current base fee, in base denom per unit of gas.
+// It defaults to the MinBaseFee param until a base fee is set.
+func (k Keeper) GetCurrentBaseFee(ctx sdk.Context) sdk.Dec {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.CurrentBaseFeeKey)
+	if bz == nil {
+		return k.GetParams(ctx).MinBaseFee
+	}
+
+	baseFee, err := sdk.NewDecFromStr(string(bz))
+	if err != nil {
+		panic(err)
+	}
+	return baseFee
+}
+
+// SetCurrentBaseFee sets the current base fee, in base denom per unit of gas.
+func (k Keeper) SetCurrentBaseFee(ctx sdk.Context, baseFee sdk.Dec) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.CurrentBaseFeeKey, []byte(baseFee.String()))
+}
+
+// GetBaseFeeForTx returns the minimum gas price, in base denom, that the base fee requires
+// transactions to pay. It is zero if the base fee is disabled, and for genesis transactions.
+func (k Keeper) GetBaseFeeForTx(ctx sdk.Context) sdk.Dec {
+	if ctx.BlockHeight() == 0 || !k.GetParams(ctx).BaseFeeEnabled {
+		return sdk.ZeroDec()
+	}
+	return k.GetCurrentBaseFee(ctx)
+}
+
+// UpdateBaseFee adjusts the base fee after a block that used blockGasUsed gas, as in EIP-1559:
+// baseFee * (1 + maxChangeRate * (blockGasUsed - targetBlockGas) / targetBlockGas),
+// where the gas used deviation from the target is capped at 100%, and the result is kept
+// within [MinBaseFee, MaxBaseFee].
+// The base fee is left unchanged while it is disabled.
+func (k Keeper) UpdateBaseFee(ctx sdk.Context, blockGasUsed uint64) {
+	params := k.GetParams(ctx)
+	if !params.BaseFeeEnabled {
+		return
+	}
+
+	targetBlockGas := sdk.NewDec(int64(params.TargetBlockGas))
+	gasUsedDeviation := sdk.NewDec(int64(blockGasUsed)).Sub(targetBlockGas).Quo(targetBlockGas)
+	gasUsedDeviation = sdk.MinDec(gasUsedDeviation, sdk.OneDec())
+
+	baseFee := k.GetCurrentBaseFee(ctx)
+	baseFee = baseFee.Add(baseFee.Mul(params.MaxBaseFeeChangeRate).Mul(gasUsedDeviation))
+	baseFee = sdk.MaxDec(baseFee, params.MinBaseFee)
+	baseFee = sdk.MinDec(baseFee, params.MaxBaseFee)
+
+	k.SetCurrentBaseFee(ctx, baseFee)
+}
@@ -0,0 +1,112 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v13/x/txfees/types"
+)
+
+func (suite *KeeperTestSuite) TestUpdateBaseFee() {
+	const targetBlockGas = uint64(1_000_000)
+
+	tests := []struct {
+		name            string
+		baseFeeEnabled  bool
+		baseFee         sdk.Dec
+		blockGasUsed    uint64
+		expectedBaseFee sdk.Dec
+	}{
+		{
+			name:            "block at target gas leaves base fee unchanged",
+			baseFeeEnabled:  true,
+			baseFee:         sdk.NewDec(1),
+			blockGasUsed:    targetBlockGas,
+			expectedBaseFee: sdk.NewDec(1),
+		},
+		{
+			name:            "full block increases base fee by max change rate",
+			baseFeeEnabled:  true,
+			baseFee:         sdk.NewDec(1),
+			blockGasUsed:    2 * targetBlockGas,
+			expectedBaseFee: sdk.MustNewDecFromStr("1.125"),
+		},
+		{
+			name:            "block over twice the target increases base fee by max change rate",
+			baseFeeEnabled:  true,
+			baseFee:         sdk.NewDec(1),
+			blockGasUsed:    10 * targetBlockGas,
+			expectedBaseFee: sdk.MustNewDecFromStr("1.125"),
+		},
+		{
+			name:            "half full block increases base fee proportionally",
+			baseFeeEnabled:  true,
+			baseFee:         sdk.NewDec(1),
+			blockGasUsed:    targetBlockGas * 3 / 2,
+			expectedBaseFee: sdk.MustNewDecFromStr("1.0625"),
+		},
+		{
+			name:            "empty block decreases base fee by max change rate",
+			baseFeeEnabled:  true,
+			baseFee:         sdk.NewDec(1),
+			blockGasUsed:    0,
+			expectedBaseFee: sdk.MustNewDecFromStr("0.875"),
+		},
+		{
+			name:            "base fee does not drop below min base fee",
+			baseFeeEnabled:  true,
+			baseFee:         sdk.MustNewDecFromStr("0.0026"),
+			blockGasUsed:    0,
+			expectedBaseFee: sdk.MustNewDecFromStr("0.0025"),
+		},
+		{
+			name:            "base fee does not rise above max base fee",
+			baseFeeEnabled:  true,
+			baseFee:         sdk.MustNewDecFromStr("4.9"),
+			blockGasUsed:    2 * targetBlockGas,
+			expectedBaseFee: sdk.NewDec(5),
+		},
+		{
+			name:            "disabled base fee is left unchanged",
+			baseFeeEnabled:  false,
+			baseFee:         sdk.NewDec(1),
+			blockGasUsed:    2 * targetBlockGas,
+			expectedBaseFee: sdk.NewDec(1),
+		},
+	}
+
+	for _, tc := range tests {
+		suite.Run(tc.name, func() {
+			suite.SetupTest(false)
+			params := suite.App.TxFeesKeeper.GetParams(suite.Ctx)
+			params.BaseFeeEnabled = tc.baseFeeEnabled
+			params.TargetBlockGas = targetBlockGas
+			suite.App.TxFeesKeeper.SetParams(suite.Ctx, params)
+			suite.App.TxFeesKeeper.SetCurrentBaseFee(suite.Ctx, tc.baseFee)
+
+			suite.App.TxFeesKeeper.UpdateBaseFee(suite.Ctx, tc.blockGasUsed)
+
+			suite.Require().Equal(tc.expectedBaseFee.String(), suite.App.TxFeesKeeper.GetCurrentBaseFee(suite.Ctx).String())
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestGetBaseFeeForTx() {
+	suite.SetupTest(false)
+	baseFee := sdk.MustNewDecFromStr("0.01")
+	suite.App.TxFeesKeeper.SetCurrentBaseFee(suite.Ctx, baseFee)
+
+	// disabled by default.
+	suite.Require().True(suite.App.TxFeesKeeper.GetBaseFeeForTx(suite.Ctx).IsZero())
+
+	params := suite.App.TxFeesKeeper.GetParams(suite.Ctx)
+	params.BaseFeeEnabled = true
+	suite.App.TxFeesKeeper.SetParams(suite.Ctx, params)
+	suite.Require().Equal(baseFee, suite.App.TxFeesKeeper.GetBaseFeeForTx(suite.Ctx))
+
+	// genesis transactions pay no base fee.
+	suite.Require().True(suite.App.TxFeesKeeper.GetBaseFeeForTx(suite.Ctx.WithBlockHeight(0)).IsZero())
+
+	res, err := suite.queryClient.BaseFee(sdk.WrapSDKContext(suite.Ctx), &types.QueryBaseFeeRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(baseFee, res.BaseFee)
+}
@@ -0,0 +1,177 @@
+package keeper
+
+import (
+	"encoding/json"
+
+	"github.com/gogo/protobuf/proto"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/osmosis-labs/osmosis/v13/osmoutils"
+	"github.com/osmosis-labs/osmosis/v13/x/txfees/types"
+)
+
+// RegisterFeeSponsor registers a contract as a fee sponsor, replacing any previous registration.
+// The sender must be the contract itself, or its admin.
+func (k Keeper) RegisterFeeSponsor(ctx sdk.Context, sender sdk.AccAddress, feeSponsor types.FeeSponsor) error {
+	if err := k.validateFeeSponsorAuthority(ctx, sender, feeSponsor.ContractAddress); err != nil {
+		return err
+	}
+
+	k.SetFeeSponsor(ctx, feeSponsor)
+	return nil
+}
+
+// UnregisterFeeSponsor removes a contract from the fee sponsors.
+// The sender must be the contract itself, or its admin.
+func (k Keeper) UnregisterFeeSponsor(ctx sdk.Context, sender sdk.AccAddress, contractAddress string) error {
+	if err := k.validateFeeSponsorAuthority(ctx, sender, contractAddress); err != nil {
+		return err
+	}
+
+	contractAddr, err := sdk.AccAddressFromBech32(contractAddress)
+	if err != nil {
+		return err
+	}
+	if _, found := k.GetFeeSponsor(ctx, contractAddr); !found {
+		return sdkerrors.Wrapf(types.ErrFeeSponsorNotFound, "%s", contractAddress)
+	}
+
+	k.deleteFeeSponsor(ctx, contractAddr)
+	return nil
+}
+
+// validateFeeSponsorAuthority checks that contractAddress is a contract, and that sender is
+// either the contract or its admin.
+func (k Keeper) validateFeeSponsorAuthority(ctx sdk.Context, sender sdk.AccAddress, contractAddress string) error {
+	contractAddr, err := sdk.AccAddressFromBech32(contractAddress)
+	if err != nil {
+		return err
+	}
+
+	if k.contractKeeper == nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "fee sponsors are not enabled")
+	}
+	contractInfo := k.contractKeeper.GetContractInfo(ctx, contractAddr)
+	if contractInfo == nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "%s is not a contract", contractAddress)
+	}
+
+	if !sender.Equals(contractAddr) && sender.String() != contractInfo.Admin {
+		return sdkerrors.Wrapf(types.ErrNotFeeSponsorAuthority, "sender %s, contract %s", sender, contractAddress)
+	}
+	return nil
+}
+
+// ValidateSponsoredFee checks that the fee sponsor sponsors feePayer's tx of msgs, without calling the sponsor contract.
+func (k Keeper) ValidateSponsoredFee(feeSponsor types.FeeSponsor, feePayer sdk.AccAddress, msgs []sdk.Msg) error {
+	if !feeSponsor.Sponsors(feePayer, msgs) {
+		return sdkerrors.Wrapf(types.ErrFeeNotSponsored, "%s does not sponsor txs of %s with these messages", feeSponsor.ContractAddress, feePayer)
+	}
+	if k.contractKeeper == nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "fee sponsors are not enabled")
+	}
+	return nil
+}
+
+// ApproveSponsoredFee checks that the fee sponsor sponsors feePayer's tx of msgs, and asks the
+// sponsor contract to approve paying fee for it through a sudo call.
+// The sudo call may consume at most MaxFeeSponsorApprovalGas, which is charged to the tx.
+// Its state changes are only kept if the contract approves the tx.
+// It must only be called once the signatures of the tx have been verified, so that the sponsor
+// contract is never called for, or charged by, txs its fee payer did not sign.
+func (k Keeper) ApproveSponsoredFee(ctx sdk.Context, feeSponsor types.FeeSponsor, feePayer sdk.AccAddress, fee sdk.Coins, gas uint64, msgs []sdk.Msg) error {
+	if err := k.ValidateSponsoredFee(feeSponsor, feePayer, msgs); err != nil {
+		return err
+	}
+
+	contractAddr, err := sdk.AccAddressFromBech32(feeSponsor.ContractAddress)
+	if err != nil {
+		return err
+	}
+	sudoMsg, err := json.Marshal(types.NewFeeSponsorSudoMsg(feePayer, fee, gas, msgs))
+	if err != nil {
+		return err
+	}
+
+	sudoCtx := ctx.WithGasMeter(sdk.NewGasMeter(types.MaxFeeSponsorApprovalGas))
+	err = osmoutils.ApplyFuncIfNoError(sudoCtx, func(cacheCtx sdk.Context) error {
+		_, err := k.contractKeeper.Sudo(cacheCtx, contractAddr, sudoMsg)
+		return err
+	})
+	ctx.GasMeter().ConsumeGas(sudoCtx.GasMeter().GasConsumedToLimit(), "fee sponsor approval")
+	if err != nil {
+		return sdkerrors.Wrapf(types.ErrFeeSponsorRejected, "%s: %s", feeSponsor.ContractAddress, err)
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.TypeEvtSponsoredFee,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		sdk.NewAttribute(types.AttributeKeyContract, feeSponsor.ContractAddress),
+		sdk.NewAttribute(types.AttributeKeyFeePayer, feePayer.String()),
+		sdk.NewAttribute(types.AttributeKeyAmount, fee.String()),
+	))
+	return nil
+}
+
+func (k Keeper) getFeeSponsorsStore(ctx sdk.Context) sdk.KVStore {
+	store := ctx.KVStore(k.storeKey)
+	return prefix.NewStore(store, types.FeeSponsorsStorePrefix)
+}
+
+// GetFeeSponsor returns the fee sponsor registration of the given contract, if any.
+func (k Keeper) GetFeeSponsor(ctx sdk.Context, contractAddr sdk.AccAddress) (types.FeeSponsor, bool) {
+	prefixStore := k.getFeeSponsorsStore(ctx)
+	bz := prefixStore.Get(contractAddr)
+	if bz == nil {
+		return types.FeeSponsor{}, false
+	}
+
+	feeSponsor := types.FeeSponsor{}
+	err := proto.Unmarshal(bz, &feeSponsor)
+	if err != nil {
+		panic(err)
+	}
+
+	return feeSponsor, true
+}
+
+// SetFeeSponsor sets the fee sponsor registration of a contract.
+func (k Keeper) SetFeeSponsor(ctx sdk.Context, feeSponsor types.FeeSponsor) {
+	prefixStore := k.getFeeSponsorsStore(ctx)
+
+	bz, err := proto.Marshal(&feeSponsor)
+	if err != nil {
+		panic(err)
+	}
+
+	prefixStore.Set(sdk.MustAccAddressFromBech32(feeSponsor.ContractAddress), bz)
+}
+
+func (k Keeper) deleteFeeSponsor(ctx sdk.Context, contractAddr sdk.AccAddress) {
+	k.getFeeSponsorsStore(ctx).Delete(contractAddr)
+}
+
+// GetFeeSponsors returns all of the fee sponsors, ordered by contract address bytes.
+func (k Keeper) GetFeeSponsors(ctx sdk.Context) []types.FeeSponsor {
+	prefixStore := k.getFeeSponsorsStore(ctx)
+
+	iterator := prefixStore.Iterator(nil, nil)
+	defer iterator.Close()
+
+	feeSponsors := []types.FeeSponsor{}
+
+	for ; iterator.Valid(); iterator.Next() {
+		feeSponsor := types.FeeSponsor{}
+
+		err := proto.Unmarshal(iterator.Value(), &feeSponsor)
+		if err != nil {
+			panic(err)
+		}
+
+		feeSponsors = append(feeSponsors, feeSponsor)
+	}
+	return feeSponsors
+}
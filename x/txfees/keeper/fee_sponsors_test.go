@@ -0,0 +1,362 @@
+package keeper_test
+
+import (
+	"encoding/json"
+	"errors"
+
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	clienttx "github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	"github.com/cosmos/cosmos-sdk/x/feegrant"
+
+	"github.com/osmosis-labs/osmosis/v13/app/apptesting"
+	"github.com/osmosis-labs/osmosis/v13/x/txfees/keeper"
+	"github.com/osmosis-labs/osmosis/v13/x/txfees/types"
+)
+
+// mockContractKeeper stands in for the wasm keeper, with contracts that approve or reject
+// every sponsored tx.
+type mockContractKeeper struct {
+	contractInfos map[string]*wasmtypes.ContractInfo
+	// sudoErr is returned by every sudo call, rejecting the sponsored tx when set.
+	sudoErr error
+	// sudoGas is the gas consumed by every sudo call.
+	sudoGas  uint64
+	sudoMsgs []types.FeeSponsorSudoMsg
+}
+
+func (m *mockContractKeeper) GetContractInfo(_ sdk.Context, contractAddress sdk.AccAddress) *wasmtypes.ContractInfo {
+	return m.contractInfos[contractAddress.String()]
+}
+
+func (m *mockContractKeeper) Sudo(ctx sdk.Context, _ sdk.AccAddress, msg []byte) ([]byte, error) {
+	ctx.GasMeter().ConsumeGas(m.sudoGas, "mock sudo")
+
+	sudoMsg := types.FeeSponsorSudoMsg{}
+	if err := json.Unmarshal(msg, &sudoMsg); err != nil {
+		return nil, err
+	}
+	m.sudoMsgs = append(m.sudoMsgs, sudoMsg)
+	return nil, m.sudoErr
+}
+
+// setupMockContract registers a contract with the given admin on a mock contract keeper,
+// and sets it on the txfees keeper.
+func (suite *KeeperTestSuite) setupMockContract(admin sdk.AccAddress) (*mockContractKeeper, sdk.AccAddress) {
+	contractAddr := apptesting.CreateRandomAccounts(1)[0]
+	contractKeeper := &mockContractKeeper{
+		contractInfos: map[string]*wasmtypes.ContractInfo{
+			contractAddr.String(): {CodeID: 1, Creator: admin.String(), Admin: admin.String()},
+		},
+	}
+	suite.App.TxFeesKeeper.SetContractKeeper(contractKeeper)
+	return contractKeeper, contractAddr
+}
+
+func (suite *KeeperTestSuite) TestRegisterFeeSponsor() {
+	tests := []struct {
+		name string
+		// senderIsAdmin sends the message from the contract admin instead of the contract.
+		senderIsAdmin bool
+		// senderIsOther sends the message from an account that is neither the contract nor its admin.
+		senderIsOther bool
+		notAContract  bool
+		msgTypeUrls   []string
+		sponsorOthers bool
+		expectedError error
+	}{
+		{
+			name: "registered by the contract",
+		},
+		{
+			name:          "registered by the contract admin",
+			senderIsAdmin: true,
+			msgTypeUrls:   []string{"/cosmos.bank.v1beta1.MsgSend"},
+			sponsorOthers: true,
+		},
+		{
+			name:          "registered by another account",
+			senderIsOther: true,
+			expectedError: types.ErrNotFeeSponsorAuthority,
+		},
+		{
+			name:          "not a contract",
+			senderIsAdmin: true,
+			notAContract:  true,
+			expectedError: sdkerrors.ErrInvalidAddress,
+		},
+	}
+
+	for _, tc := range tests {
+		suite.Run(tc.name, func() {
+			suite.SetupTest(false)
+			_, contractAddr := suite.setupMockContract(suite.TestAccs[0])
+			if tc.notAContract {
+				contractAddr = suite.TestAccs[2]
+			}
+			sender := contractAddr
+			if tc.senderIsAdmin {
+				sender = suite.TestAccs[0]
+			} else if tc.senderIsOther {
+				sender = suite.TestAccs[1]
+			}
+			var addresses []string
+			if tc.sponsorOthers {
+				addresses = []string{suite.TestAccs[1].String(), suite.TestAccs[2].String()}
+			}
+			msgServer := keeper.NewMsgServerImpl(*suite.App.TxFeesKeeper)
+
+			msg := types.NewMsgRegisterFeeSponsor(sender.String(), contractAddr.String(), tc.msgTypeUrls, addresses)
+			suite.Require().NoError(msg.ValidateBasic())
+			_, err := msgServer.RegisterFeeSponsor(sdk.WrapSDKContext(suite.Ctx), msg)
+
+			if tc.expectedError != nil {
+				suite.Require().ErrorIs(err, tc.expectedError)
+				suite.Require().Empty(suite.App.TxFeesKeeper.GetFeeSponsors(suite.Ctx))
+				return
+			}
+			suite.Require().NoError(err)
+			suite.AssertEventEmitted(suite.Ctx, types.TypeEvtRegisterFeeSponsor, 1)
+
+			expectedFeeSponsor := msg.FeeSponsor()
+			res, err := suite.queryClient.FeeSponsor(sdk.WrapSDKContext(suite.Ctx), &types.QueryFeeSponsorRequest{ContractAddress: contractAddr.String()})
+			suite.Require().NoError(err)
+			suite.Require().Equal(expectedFeeSponsor, res.FeeSponsor)
+			suite.Require().Equal([]types.FeeSponsor{expectedFeeSponsor}, suite.App.TxFeesKeeper.GetFeeSponsors(suite.Ctx))
+
+			// only the contract or its admin can unregister it.
+			_, err = msgServer.UnregisterFeeSponsor(sdk.WrapSDKContext(suite.Ctx), types.NewMsgUnregisterFeeSponsor(suite.TestAccs[1].String(), contractAddr.String()))
+			suite.Require().ErrorIs(err, types.ErrNotFeeSponsorAuthority)
+
+			_, err = msgServer.UnregisterFeeSponsor(sdk.WrapSDKContext(suite.Ctx), types.NewMsgUnregisterFeeSponsor(sender.String(), contractAddr.String()))
+			suite.Require().NoError(err)
+			suite.Require().Empty(suite.App.TxFeesKeeper.GetFeeSponsors(suite.Ctx))
+
+			_, err = msgServer.UnregisterFeeSponsor(sdk.WrapSDKContext(suite.Ctx), types.NewMsgUnregisterFeeSponsor(sender.String(), contractAddr.String()))
+			suite.Require().ErrorIs(err, types.ErrFeeSponsorNotFound)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestDeductFeeDecoratorFeeGranter() {
+	uion := "uion"
+	testMsgTypeUrl := "/testdata.TestMsg"
+	txFee := sdk.NewCoins(sdk.NewInt64Coin(uion, 1000))
+
+	tests := []struct {
+		name string
+		// sponsor registers the fee granter as a fee sponsor when set.
+		sponsor *types.FeeSponsor
+		sudoErr error
+		sudoGas uint64
+		// allowance is granted by the fee granter to the fee payer when set.
+		allowance     sdk.Coins
+		expectedError error
+	}{
+		{
+			name:    "approved by the fee sponsor",
+			sponsor: &types.FeeSponsor{MsgTypeUrls: []string{testMsgTypeUrl}},
+			sudoGas: 50_000,
+		},
+		{
+			name:          "rejected by the fee sponsor",
+			sponsor:       &types.FeeSponsor{},
+			sudoErr:       errors.New("unauthorized"),
+			expectedError: types.ErrFeeSponsorRejected,
+		},
+		{
+			name:          "fee sponsor runs out of gas",
+			sponsor:       &types.FeeSponsor{},
+			sudoGas:       types.MaxFeeSponsorApprovalGas + 1,
+			expectedError: types.ErrFeeSponsorRejected,
+		},
+		{
+			name:          "msg type not sponsored",
+			sponsor:       &types.FeeSponsor{MsgTypeUrls: []string{"/cosmos.bank.v1beta1.MsgSend"}},
+			expectedError: types.ErrFeeNotSponsored,
+		},
+		{
+			name:          "fee payer not sponsored",
+			sponsor:       &types.FeeSponsor{Addresses: []string{apptesting.CreateRandomAccounts(1)[0].String()}},
+			expectedError: types.ErrFeeNotSponsored,
+		},
+		{
+			name:      "fee allowance in a non-native fee token",
+			allowance: sdk.NewCoins(sdk.NewInt64Coin(uion, 1500)),
+		},
+		{
+			name:          "fee allowance too low",
+			allowance:     sdk.NewCoins(sdk.NewInt64Coin(uion, 999)),
+			expectedError: feegrant.ErrFeeLimitExceeded,
+		},
+		{
+			name:          "no fee allowance",
+			expectedError: sdkerrors.ErrUnauthorized,
+		},
+	}
+
+	for _, tc := range tests {
+		suite.Run(tc.name, func() {
+			suite.SetupTest(false)
+			uionPoolId := suite.PrepareBalancerPoolWithCoins(
+				sdk.NewInt64Coin(sdk.DefaultBondDenom, 500),
+				sdk.NewInt64Coin(uion, 500),
+			)
+			suite.Require().NoError(suite.ExecuteUpgradeFeeTokenProposal(uion, uionPoolId))
+
+			contractKeeper, contractAddr := suite.setupMockContract(suite.TestAccs[0])
+			contractKeeper.sudoErr = tc.sudoErr
+			contractKeeper.sudoGas = tc.sudoGas
+
+			feeGranter := suite.TestAccs[0]
+			if tc.sponsor != nil {
+				feeGranter = contractAddr
+				tc.sponsor.ContractAddress = contractAddr.String()
+				suite.App.TxFeesKeeper.SetFeeSponsor(suite.Ctx, *tc.sponsor)
+				suite.FundAcc(contractAddr, txFee)
+			}
+
+			priv0, _, addr0 := testdata.KeyTestPubAddr()
+			suite.App.AccountKeeper.SetAccount(suite.Ctx, suite.App.AccountKeeper.NewAccountWithAddress(suite.Ctx, addr0))
+			if tc.allowance != nil {
+				err := suite.App.FeeGrantKeeper.GrantAllowance(suite.Ctx, feeGranter, addr0, &feegrant.BasicAllowance{SpendLimit: tc.allowance})
+				suite.Require().NoError(err)
+			}
+
+			txBuilder := suite.clientCtx.TxConfig.NewTxBuilder()
+			txBuilder.SetFeeGranter(feeGranter)
+			signerData := authsigning.SignerData{ChainID: suite.Ctx.ChainID()}
+			sigV2, _ := clienttx.SignWithPrivKey(1, signerData, txBuilder, priv0, suite.clientCtx.TxConfig, 0)
+			tx := suite.BuildTx(txBuilder, []sdk.Msg{testdata.NewTestMsg(addr0)}, sigV2, "", txFee, 100_000)
+
+			mfd := keeper.NewMempoolFeeDecorator(*suite.App.TxFeesKeeper, types.NewDefaultMempoolFeeOptions())
+			dfd := keeper.NewDeductFeeDecorator(*suite.App.TxFeesKeeper, *suite.App.AccountKeeper, *suite.App.BankKeeper, suite.App.FeeGrantKeeper)
+			fsd := keeper.NewFeeSponsorApprovalDecorator(*suite.App.TxFeesKeeper)
+			ctx := suite.Ctx.WithGasMeter(sdk.NewGasMeter(1_000_000))
+			granterBalanceBefore := suite.App.BankKeeper.GetBalance(ctx, feeGranter, uion)
+
+			// the ante handler state changes are only written if it succeeds, as in baseapp.
+			anteCtx, writeCache := ctx.CacheContext()
+			_, err := sdk.ChainAnteDecorators(mfd, dfd, fsd)(anteCtx, tx, false)
+			if err == nil {
+				writeCache()
+			}
+
+			if tc.sponsor != nil && tc.sudoGas > 0 {
+				// the sponsor approval gas is charged to the tx, up to its limit.
+				approvalGas := tc.sudoGas
+				if approvalGas > types.MaxFeeSponsorApprovalGas {
+					approvalGas = types.MaxFeeSponsorApprovalGas
+				}
+				suite.Require().GreaterOrEqual(ctx.GasMeter().GasConsumed(), approvalGas)
+			}
+
+			nonNativeFeeAddr := suite.App.AccountKeeper.GetModuleAddress(types.NonNativeFeeCollectorName)
+			if tc.expectedError != nil {
+				suite.Require().ErrorIs(err, tc.expectedError)
+				suite.Require().Equal(granterBalanceBefore, suite.App.BankKeeper.GetBalance(ctx, feeGranter, uion))
+				suite.Require().True(suite.App.BankKeeper.GetBalance(ctx, nonNativeFeeAddr, uion).IsZero())
+				return
+			}
+			suite.Require().NoError(err)
+
+			// the fees are paid by the fee granter, in the non-native fee token.
+			suite.Require().Equal(granterBalanceBefore.Sub(txFee[0]).String(), suite.App.BankKeeper.GetBalance(ctx, feeGranter, uion).String())
+			suite.Require().Equal(txFee[0], suite.App.BankKeeper.GetBalance(ctx, nonNativeFeeAddr, uion))
+
+			if tc.sponsor != nil {
+				suite.AssertEventEmitted(anteCtx, types.TypeEvtSponsoredFee, 1)
+				suite.Require().Len(contractKeeper.sudoMsgs, 1)
+				approval := contractKeeper.sudoMsgs[0].ApproveFeeSponsorship
+				suite.Require().Equal(addr0.String(), approval.FeePayer)
+				suite.Require().Equal(txFee.String(), approval.Fee.String())
+				suite.Require().Equal(uint64(100_000), approval.Gas)
+				suite.Require().Equal([]string{testMsgTypeUrl}, approval.MsgTypeUrls)
+			} else {
+				allowance, err := suite.App.FeeGrantKeeper.GetAllowance(ctx, feeGranter, addr0)
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.allowance.Sub(txFee), allowance.(*feegrant.BasicAllowance).SpendLimit)
+			}
+		})
+	}
+}
+
+// TestFeeSponsorApprovalAfterSigVerification tests fee sponsors are only asked to approve txs
+// once their signatures are verified, and not by the DeductFeeDecorator.
+func (suite *KeeperTestSuite) TestFeeSponsorApprovalAfterSigVerification() {
+	testMsgTypeUrl := "/testdata.TestMsg"
+	txFee := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000))
+
+	tests := []struct {
+		name    string
+		chainId string
+		expPass bool
+	}{
+		{
+			name:    "valid signature",
+			chainId: "osmosis-1",
+			expPass: true,
+		},
+		{
+			name:    "invalid signature",
+			chainId: "other-chain",
+		},
+	}
+
+	for _, tc := range tests {
+		suite.Run(tc.name, func() {
+			suite.SetupTest(false)
+			ctx := suite.Ctx.WithChainID("osmosis-1")
+			contractKeeper, contractAddr := suite.setupMockContract(suite.TestAccs[0])
+			suite.App.TxFeesKeeper.SetFeeSponsor(ctx, types.FeeSponsor{ContractAddress: contractAddr.String(), MsgTypeUrls: []string{testMsgTypeUrl}})
+			suite.FundAcc(contractAddr, txFee)
+
+			priv0, _, addr0 := testdata.KeyTestPubAddr()
+			acc := suite.App.AccountKeeper.NewAccountWithAddress(ctx, addr0)
+			suite.App.AccountKeeper.SetAccount(ctx, acc)
+
+			txBuilder := suite.clientCtx.TxConfig.NewTxBuilder()
+			txBuilder.SetFeeGranter(contractAddr)
+			// the messages, fee and gas must be set before signing.
+			suite.Require().NoError(txBuilder.SetMsgs(testdata.NewTestMsg(addr0)))
+			txBuilder.SetFeeAmount(txFee)
+			txBuilder.SetGasLimit(100_000)
+			signMode := suite.clientCtx.TxConfig.SignModeHandler().DefaultMode()
+			suite.Require().NoError(txBuilder.SetSignatures(signing.SignatureV2{
+				PubKey: priv0.PubKey(),
+				Data:   &signing.SingleSignatureData{SignMode: signMode},
+			}))
+			signerData := authsigning.SignerData{ChainID: tc.chainId, AccountNumber: acc.GetAccountNumber()}
+			sigV2, err := clienttx.SignWithPrivKey(signMode, signerData, txBuilder, priv0, suite.clientCtx.TxConfig, 0)
+			suite.Require().NoError(err)
+			suite.Require().NoError(txBuilder.SetSignatures(sigV2))
+			tx := txBuilder.GetTx()
+
+			// the fee sponsor is not asked to approve the tx when its fee is deducted.
+			dfd := keeper.NewDeductFeeDecorator(*suite.App.TxFeesKeeper, *suite.App.AccountKeeper, *suite.App.BankKeeper, suite.App.FeeGrantKeeper)
+			cacheCtx, _ := ctx.CacheContext()
+			_, err = sdk.ChainAnteDecorators(dfd)(cacheCtx, tx, false)
+			suite.Require().NoError(err)
+			suite.Require().Len(contractKeeper.sudoMsgs, 0)
+
+			_, err = sdk.ChainAnteDecorators(
+				dfd,
+				ante.NewSetPubKeyDecorator(*suite.App.AccountKeeper),
+				ante.NewSigVerificationDecorator(*suite.App.AccountKeeper, suite.clientCtx.TxConfig.SignModeHandler()),
+				keeper.NewFeeSponsorApprovalDecorator(*suite.App.TxFeesKeeper),
+			)(ctx, tx, false)
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Len(contractKeeper.sudoMsgs, 1)
+			} else {
+				suite.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+				suite.Require().Len(contractKeeper.sudoMsgs, 0)
+			}
+		})
+	}
+}
@@ -0,0 +1,209 @@
+package keeper
+
+import (
+	"github.com/gogo/protobuf/proto"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v13/osmoutils"
+	swaproutertypes "github.com/osmosis-labs/osmosis/v13/x/swaprouter/types"
+	"github.com/osmosis-labs/osmosis/v13/x/txfees/types"
+)
+
+// calcFeeSwapMinAmountOut returns the minimum amount of base denom that swapping tokenIn
+// through the fee token's route must return. It is the value of the fee tokens at the
+// arithmetic TWAP price of the route over the FeeSwapTwapWindow, less the swap fees of the
+// route's pools, less the MaxFeeSwapPriceDeviation.
+// This prevents anyone from moving the pool price right before the swap to grief the fee revenue.
+func (k Keeper) calcFeeSwapMinAmountOut(ctx sdk.Context, routes []swaproutertypes.SwapAmountInRoute, tokenIn sdk.Coin) (sdk.Int, error) {
+	twap, err := k.calcRouteTwap(ctx, tokenIn.Denom, routes)
+	if err != nil {
+		return sdk.Int{}, err
+	}
+
+	swapFeeRemainder, err := k.calcRouteSwapFeeRemainder(ctx, routes)
+	if err != nil {
+		return sdk.Int{}, err
+	}
+
+	maxFeeSwapPriceDeviation := k.GetParams(ctx).MaxFeeSwapPriceDeviation
+	return twap.MulInt(tokenIn.Amount).Mul(swapFeeRemainder).Mul(sdk.OneDec().Sub(maxFeeSwapPriceDeviation)).TruncateInt(), nil
+}
+
+// calcRouteSwapFeeRemainder returns the share of the value swapped through the route that is left
+// once each pool of the route has charged its swap fee.
+// Osmo routed multihops may charge less, so this never overstates the expected amount out.
+func (k Keeper) calcRouteSwapFeeRemainder(ctx sdk.Context, routes []swaproutertypes.SwapAmountInRoute) (sdk.Dec, error) {
+	remainder := sdk.OneDec()
+	for _, route := range routes {
+		pool, err := k.swaprouterKeeper.GetPool(ctx, route.PoolId)
+		if err != nil {
+			return sdk.Dec{}, err
+		}
+		remainder = remainder.Mul(sdk.OneDec().Sub(pool.GetSwapFee(ctx)))
+	}
+	return remainder, nil
+}
+
+// swapFeeToken swaps tokenIn from the non-native fee collector to the base denom through the
+// fee token's route, failing without any state change if the swap would breach the TWAP based
+// slippage bound.
+func (k Keeper) swapFeeToken(ctx sdk.Context, nonNativeFeeAddr sdk.AccAddress, routes []swaproutertypes.SwapAmountInRoute, tokenIn sdk.Coin) error {
+	minAmountOut, err := k.calcFeeSwapMinAmountOut(ctx, routes, tokenIn)
+	if err != nil {
+		return err
+	}
+
+	return osmoutils.ApplyFuncIfNoError(ctx, func(cacheCtx sdk.Context) error {
+		_, err := k.swaprouterKeeper.RouteExactAmountIn(cacheCtx, nonNativeFeeAddr, routes, tokenIn, minAmountOut)
+		return err
+	})
+}
+
+// deferFeeSwap records that the swap of tokenIn failed with swapErr, so that it is retried
+// in the following blocks, and emits an event reporting the skipped swap.
+func (k Keeper) deferFeeSwap(ctx sdk.Context, deferredFeeSwap types.DeferredFeeSwap, tokenIn sdk.Coin, swapErr error) {
+	deferredFeeSwap.Reason = swapErr.Error()
+	k.SetDeferredFeeSwap(ctx, deferredFeeSwap)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.TypeEvtDeferredFeeSwap,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		sdk.NewAttribute(types.AttributeKeyDenom, deferredFeeSwap.Denom),
+		sdk.NewAttribute(types.AttributeKeyPoolId, sdk.NewUint(deferredFeeSwap.PoolID).String()),
+		sdk.NewAttribute(types.AttributeKeyAmount, tokenIn.String()),
+		sdk.NewAttribute(types.AttributeKeyReason, deferredFeeSwap.Reason),
+	))
+}
+
+// RetryDeferredFeeSwaps retries every deferred fee swap, and is called at the end of each block.
+// Each retry swaps 1/2^splits of the fee token balance, and every failed retry halves
+// the amount swapped by the next one, up to MaxDeferredFeeSwapSplits times. This lets balances
+// whose price impact alone breaches the slippage bound be swapped across several blocks.
+// A deferred swap is cleared once the whole fee token balance is swapped, the fee token
+// is neither whitelisted nor an auto fee token anymore, or a retry fails after MaxDeferredFeeSwapSplits
+// splits. In the latter case, the remaining balance is swapped at the end of the next epoch.
+func (k Keeper) RetryDeferredFeeSwaps(ctx sdk.Context) {
+	deferredFeeSwaps := k.GetDeferredFeeSwaps(ctx)
+	if len(deferredFeeSwaps) == 0 {
+		return
+	}
+
+	nonNativeFeeAddr := k.accountKeeper.GetModuleAddress(types.NonNativeFeeCollectorName)
+	baseDenom, err := k.GetBaseDenom(ctx)
+	if err != nil {
+		return
+	}
+
+	for _, deferredFeeSwap := range deferredFeeSwaps {
+		routes, err := k.getFeeTokenRoutes(ctx, deferredFeeSwap.Denom, baseDenom)
+		if err != nil {
+			k.deleteDeferredFeeSwap(ctx, deferredFeeSwap.Denom)
+			continue
+		}
+		// The route of the fee token may have been updated since the swap was deferred.
+		deferredFeeSwap.PoolID = routes[0].PoolId
+
+		coinBalance := k.bankKeeper.GetBalance(ctx, nonNativeFeeAddr, deferredFeeSwap.Denom)
+		if coinBalance.Amount.IsZero() {
+			k.deleteDeferredFeeSwap(ctx, deferredFeeSwap.Denom)
+			continue
+		}
+
+		swapAmount := coinBalance.Amount.QuoRaw(int64(1) << deferredFeeSwap.Splits)
+		if swapAmount.IsZero() {
+			swapAmount = coinBalance.Amount
+		}
+		tokenIn := sdk.NewCoin(deferredFeeSwap.Denom, swapAmount)
+
+		err = k.swapFeeToken(ctx, nonNativeFeeAddr, routes, tokenIn)
+		if err != nil {
+			// The balance is left to the swap at the end of the next epoch, which defers it again if it still fails.
+			if deferredFeeSwap.Splits >= types.MaxDeferredFeeSwapSplits {
+				k.deleteDeferredFeeSwap(ctx, deferredFeeSwap.Denom)
+				continue
+			}
+			deferredFeeSwap.Splits++
+			k.deferFeeSwap(ctx, deferredFeeSwap, tokenIn, err)
+			continue
+		}
+
+		if swapAmount.Equal(coinBalance.Amount) {
+			k.deleteDeferredFeeSwap(ctx, deferredFeeSwap.Denom)
+		}
+	}
+
+	k.sendBaseDenomToFeeCollector(ctx, nonNativeFeeAddr, baseDenom)
+}
+
+// sendBaseDenomToFeeCollector sends all of the base denom in the non-native fee collector
+// to the fee collector.
+func (k Keeper) sendBaseDenomToFeeCollector(ctx sdk.Context, nonNativeFeeAddr sdk.AccAddress, baseDenom string) {
+	baseDenomCoins := sdk.NewCoins(k.bankKeeper.GetBalance(ctx, nonNativeFeeAddr, baseDenom))
+
+	_ = osmoutils.ApplyFuncIfNoError(ctx, func(cacheCtx sdk.Context) error {
+		err := k.bankKeeper.SendCoinsFromModuleToModule(cacheCtx, types.NonNativeFeeCollectorName, types.FeeCollectorName, baseDenomCoins)
+		return err
+	})
+}
+
+func (k Keeper) getDeferredFeeSwapsStore(ctx sdk.Context) sdk.KVStore {
+	store := ctx.KVStore(k.storeKey)
+	return prefix.NewStore(store, types.DeferredFeeSwapsStorePrefix)
+}
+
+// GetDeferredFeeSwap returns the deferred swap of the given fee token denom, if any.
+func (k Keeper) GetDeferredFeeSwap(ctx sdk.Context, denom string) (types.DeferredFeeSwap, bool) {
+	prefixStore := k.getDeferredFeeSwapsStore(ctx)
+	bz := prefixStore.Get([]byte(denom))
+	if bz == nil {
+		return types.DeferredFeeSwap{}, false
+	}
+
+	deferredFeeSwap := types.DeferredFeeSwap{}
+	err := proto.Unmarshal(bz, &deferredFeeSwap)
+	if err != nil {
+		panic(err)
+	}
+
+	return deferredFeeSwap, true
+}
+
+// SetDeferredFeeSwap sets the deferred swap of a fee token denom.
+func (k Keeper) SetDeferredFeeSwap(ctx sdk.Context, deferredFeeSwap types.DeferredFeeSwap) {
+	prefixStore := k.getDeferredFeeSwapsStore(ctx)
+
+	bz, err := proto.Marshal(&deferredFeeSwap)
+	if err != nil {
+		panic(err)
+	}
+
+	prefixStore.Set([]byte(deferredFeeSwap.Denom), bz)
+}
+
+func (k Keeper) deleteDeferredFeeSwap(ctx sdk.Context, denom string) {
+	k.getDeferredFeeSwapsStore(ctx).Delete([]byte(denom))
+}
+
+// GetDeferredFeeSwaps returns all of the deferred fee swaps, ordered by fee token denom.
+func (k Keeper) GetDeferredFeeSwaps(ctx sdk.Context) []types.DeferredFeeSwap {
+	prefixStore := k.getDeferredFeeSwapsStore(ctx)
+
+	iterator := prefixStore.Iterator(nil, nil)
+	defer iterator.Close()
+
+	deferredFeeSwaps := []types.DeferredFeeSwap{}
+
+	for ; iterator.Valid(); iterator.Next() {
+		deferredFeeSwap := types.DeferredFeeSwap{}
+
+		err := proto.Unmarshal(iterator.Value(), &deferredFeeSwap)
+		if err != nil {
+			panic(err)
+		}
+
+		deferredFeeSwaps = append(deferredFeeSwaps, deferredFeeSwap)
+	}
+	return deferredFeeSwaps
+}
@@ -16,7 +16,9 @@ import (
 // as the local validator's minimum gasFee (defined in validator config).
 // If fee is too low, decorator returns error and tx is rejected from mempool.
 // Note this only applies when ctx.CheckTx = true
-// If fee is high enough or not CheckTx, then call next AnteHandler
+// When the on-chain base fee is enabled, the fee must also cover the base fee,
+// in both CheckTx and DeliverTx.
+// If fee is high enough, then call next AnteHandler
 // CONTRACT: Tx must implement FeeTx to use MempoolFeeDecorator.
 type MempoolFeeDecorator struct {
 	TxFeesKeeper Keeper
@@ -63,20 +65,22 @@ func (mfd MempoolFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate b
 	// If there is a fee attached to the tx, make sure the fee denom is a denom accepted by the chain
 	if len(feeCoins) == 1 {
 		feeDenom := feeCoins.GetDenomByIndex(0)
-		if feeDenom != baseDenom {
-			_, err := mfd.TxFeesKeeper.GetFeeToken(ctx, feeDenom)
-			if err != nil {
-				return ctx, err
-			}
+		err := mfd.TxFeesKeeper.ValidateFeeDenom(ctx, feeDenom)
+		if err != nil {
+			return ctx, err
 		}
 	}
 
-	// If we are in CheckTx, this function is ran locally to determine if these fees are sufficient
+	// The on-chain base fee is enforced in both CheckTx and DeliverTx.
+	// If we are in CheckTx, this function is also ran locally to determine if these fees are sufficient
 	// to enter our mempool.
 	// So we ensure that the provided fees meet a minimum threshold for the validator,
 	// converting every non-osmo specified asset into an osmo-equivalent amount, to determine sufficiency.
-	if (ctx.IsCheckTx() || ctx.IsReCheckTx()) && !simulate {
-		minBaseGasPrice := mfd.GetMinBaseGasPriceForTx(ctx, baseDenom, feeTx)
+	if !simulate {
+		minBaseGasPrice := mfd.TxFeesKeeper.GetBaseFeeForTx(ctx)
+		if ctx.IsCheckTx() || ctx.IsReCheckTx() {
+			minBaseGasPrice = sdk.MaxDec(minBaseGasPrice, mfd.GetMinBaseGasPriceForTx(ctx, baseDenom, feeTx))
+		}
 		if !(minBaseGasPrice.IsZero()) {
 			// You should only be able to pay with one fee token in a single tx
 			if len(feeCoins) != 1 {
@@ -175,11 +179,12 @@ func (dfd DeductFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bo
 	deductFeesFrom := feePayer
 
 	// If a fee granter was set, deduct fee from the fee granter's account.
+	// The fee granter is either a contract registered as a fee sponsor, which must sponsor the tx,
+	// or an account that granted the fee payer an allowance through x/feegrant.
+	// Fee sponsors approve the tx later, in the FeeSponsorApprovalDecorator, once its signatures are verified.
 	if feeGranter != nil {
-		if dfd.feegrantKeeper == nil {
-			return ctx, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "fee grants is not enabled")
-		} else if !feeGranter.Equals(feePayer) {
-			err := dfd.feegrantKeeper.UseGrantedFees(ctx, feeGranter, feePayer, fee, tx.GetMsgs())
+		if !feeGranter.Equals(feePayer) {
+			err := dfd.useFeeGranter(ctx, feeGranter, feePayer, fee, tx.GetMsgs())
 			if err != nil {
 				return ctx, sdkerrors.Wrapf(err, "%s not allowed to pay fees from %s", feeGranter, feePayer)
 			}
@@ -209,6 +214,53 @@ func (dfd DeductFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bo
 	return next(ctx, tx, simulate)
 }
 
+// useFeeGranter checks that feeGranter pays the fee of feePayer's tx, either as a fee sponsor
+// of the tx, or through a fee allowance.
+func (dfd DeductFeeDecorator) useFeeGranter(ctx sdk.Context, feeGranter, feePayer sdk.AccAddress, fee sdk.Coins, msgs []sdk.Msg) error {
+	if feeSponsor, found := dfd.txFeesKeeper.GetFeeSponsor(ctx, feeGranter); found {
+		return dfd.txFeesKeeper.ValidateSponsoredFee(feeSponsor, feePayer, msgs)
+	}
+
+	if dfd.feegrantKeeper == nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "fee grants is not enabled")
+	}
+	return dfd.feegrantKeeper.UseGrantedFees(ctx, feeGranter, feePayer, fee, msgs)
+}
+
+// FeeSponsorApprovalDecorator asks the fee sponsor paying the fee of a tx to approve it.
+// It must come after the signature verification decorators, so that sponsor contracts are
+// only called for txs signed by their fee payer.
+// CONTRACT: Tx must implement FeeTx interface to use FeeSponsorApprovalDecorator
+type FeeSponsorApprovalDecorator struct {
+	txFeesKeeper Keeper
+}
+
+func NewFeeSponsorApprovalDecorator(tk Keeper) FeeSponsorApprovalDecorator {
+	return FeeSponsorApprovalDecorator{
+		txFeesKeeper: tk,
+	}
+}
+
+func (fsd FeeSponsorApprovalDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return ctx, sdkerrors.Wrap(sdkerrors.ErrTxDecode, "Tx must be a FeeTx")
+	}
+
+	feePayer := feeTx.FeePayer()
+	feeGranter := feeTx.FeeGranter()
+	if feeGranter != nil && !feeGranter.Equals(feePayer) {
+		if feeSponsor, found := fsd.txFeesKeeper.GetFeeSponsor(ctx, feeGranter); found {
+			err := fsd.txFeesKeeper.ApproveSponsoredFee(ctx, feeSponsor, feePayer, feeTx.GetFee(), feeTx.GetGas(), tx.GetMsgs())
+			if err != nil {
+				return ctx, sdkerrors.Wrapf(err, "%s not allowed to pay fees from %s", feeGranter, feePayer)
+			}
+		}
+	}
+
+	return next(ctx, tx, simulate)
+}
+
 // DeductFees deducts fees from the given account and transfers them to the set module account.
 func DeductFees(txFeesKeeper types.TxFeesKeeper, bankKeeper types.BankKeeper, ctx sdk.Context, acc authtypes.AccountI, fees sdk.Coins) error {
 	// Checks the validity of the fee tokens (sorted, have positive amount, valid and unique denomination)
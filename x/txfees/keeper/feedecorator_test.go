@@ -36,6 +36,8 @@ func (suite *KeeperTestSuite) TestFeeDecorator() {
 		isCheckTx    bool
 		expectPass   bool
 		baseDenomGas bool
+		// baseFee enables the on-chain base fee when set.
+		baseFee sdk.Dec
 	}{
 		{
 			name:         "no min gas price - checktx",
@@ -178,6 +180,57 @@ func (suite *KeeperTestSuite) TestFeeDecorator() {
 			expectPass:   true,
 			baseDenomGas: false,
 		},
+		{
+			name:         "not enough fee for the base fee in delivertx",
+			txFee:        sdk.NewCoins(sdk.NewInt64Coin(baseDenom, 99)),
+			minGasPrices: sdk.NewDecCoins(),
+			gasRequested: 10000,
+			isCheckTx:    false,
+			expectPass:   false,
+			baseDenomGas: true,
+			baseFee:      sdk.MustNewDecFromStr("0.01"),
+		},
+		{
+			name:         "enough fee for the base fee in delivertx",
+			txFee:        sdk.NewCoins(sdk.NewInt64Coin(baseDenom, 100)),
+			minGasPrices: sdk.NewDecCoins(),
+			gasRequested: 10000,
+			isCheckTx:    false,
+			expectPass:   true,
+			baseDenomGas: true,
+			baseFee:      sdk.MustNewDecFromStr("0.01"),
+		},
+		{
+			name:         "not enough converted fee for the base fee in delivertx",
+			txFee:        sdk.NewCoins(sdk.NewInt64Coin(uion, 1)),
+			minGasPrices: sdk.NewDecCoins(),
+			gasRequested: 10000,
+			isCheckTx:    false,
+			expectPass:   false,
+			baseDenomGas: false,
+			baseFee:      sdk.MustNewDecFromStr("0.01"),
+		},
+		{
+			name:         "enough converted fee for the base fee in delivertx",
+			txFee:        sdk.NewCoins(sdk.NewInt64Coin(uion, 100)),
+			minGasPrices: sdk.NewDecCoins(),
+			gasRequested: 10000,
+			isCheckTx:    false,
+			expectPass:   true,
+			baseDenomGas: false,
+			baseFee:      sdk.MustNewDecFromStr("0.01"),
+		},
+		{
+			name:  "base fee above the min gas price in checktx",
+			txFee: sdk.NewCoins(sdk.NewInt64Coin(baseDenom, 100)),
+			minGasPrices: sdk.NewDecCoins(sdk.NewDecCoinFromDec(baseDenom,
+				sdk.MustNewDecFromStr("0.001"))),
+			gasRequested: 10000,
+			isCheckTx:    true,
+			expectPass:   false,
+			baseDenomGas: true,
+			baseFee:      sdk.MustNewDecFromStr("0.1"),
+		},
 	}
 
 	for _, tc := range tests {
@@ -190,6 +243,13 @@ func (suite *KeeperTestSuite) TestFeeDecorator() {
 			)
 			suite.ExecuteUpgradeFeeTokenProposal(uion, uionPoolId)
 
+			if !tc.baseFee.IsNil() {
+				params := suite.App.TxFeesKeeper.GetParams(suite.Ctx)
+				params.BaseFeeEnabled = true
+				suite.App.TxFeesKeeper.SetParams(suite.Ctx, params)
+				suite.App.TxFeesKeeper.SetCurrentBaseFee(suite.Ctx, tc.baseFee)
+			}
+
 			suite.Ctx = suite.Ctx.WithIsCheckTx(tc.isCheckTx).WithMinGasPrices(tc.minGasPrices)
 			suite.Ctx = suite.Ctx.WithMinGasPrices(tc.minGasPrices)
 
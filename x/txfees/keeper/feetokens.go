@@ -9,7 +9,8 @@ import (
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
-// ConvertToBaseToken converts a fee amount in a whitelisted fee token to the base fee token amount.
+// ConvertToBaseToken converts a fee amount in a whitelisted fee token or an auto fee token
+// to the base fee token amount.
 func (k Keeper) ConvertToBaseToken(ctx sdk.Context, inputFee sdk.Coin) (sdk.Coin, error) {
 	baseDenom, err := k.GetBaseDenom(ctx)
 	if err != nil {
@@ -22,7 +23,8 @@ func (k Keeper) ConvertToBaseToken(ctx sdk.Context, inputFee sdk.Coin) (sdk.Coin
 
 	feeToken, err := k.GetFeeToken(ctx, inputFee.Denom)
 	if err != nil {
-		return sdk.Coin{}, err
+		// Denoms that are not whitelisted by governance may still be auto fee tokens.
+		return k.convertAutoFeeTokenToBaseToken(ctx, baseDenom, inputFee)
 	}
 
 	spotPrice, err := k.CalcFeeSpotPrice(ctx, feeToken.Denom)
@@ -33,6 +35,40 @@ func (k Keeper) ConvertToBaseToken(ctx sdk.Context, inputFee sdk.Coin) (sdk.Coin
 	return sdk.NewCoin(baseDenom, spotPrice.MulInt(inputFee.Amount).RoundInt()), nil
 }
 
+// convertAutoFeeTokenToBaseToken converts a fee amount in an auto fee token to the base fee token amount,
+// at the twap price of its route.
+func (k Keeper) convertAutoFeeTokenToBaseToken(ctx sdk.Context, baseDenom string, inputFee sdk.Coin) (sdk.Coin, error) {
+	autoFeeToken, err := k.getEnabledAutoFeeToken(ctx, inputFee.Denom)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	twap, err := k.calcRouteTwap(ctx, autoFeeToken.Denom, autoFeeToken.Routes)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	return sdk.NewCoin(baseDenom, twap.MulInt(inputFee.Amount).RoundInt()), nil
+}
+
+// ValidateFeeDenom checks that tx fees can be paid in the given denom,
+// that is the base denom, a fee token whitelisted by governance, or an enabled auto fee token.
+func (k Keeper) ValidateFeeDenom(ctx sdk.Context, denom string) error {
+	baseDenom, err := k.GetBaseDenom(ctx)
+	if err != nil {
+		return err
+	}
+	if denom == baseDenom {
+		return nil
+	}
+
+	if _, err := k.GetFeeToken(ctx, denom); err != nil {
+		_, err := k.getEnabledAutoFeeToken(ctx, denom)
+		return err
+	}
+	return nil
+}
+
 // CalcFeeSpotPrice converts the provided tx fees into their equivalent value in the base denomination.
 // Spot Price Calculation: spotPrice / (1 - swapFee),
 // where spotPrice is defined as:
@@ -48,7 +84,7 @@ func (k Keeper) CalcFeeSpotPrice(ctx sdk.Context, inputDenom string) (sdk.Dec, e
 		return sdk.Dec{}, err
 	}
 
-	spotPrice, err := k.spotPriceCalculator.CalculateSpotPrice(ctx, feeToken.PoolID, baseDenom, feeToken.Denom)
+	spotPrice, err := k.swaprouterKeeper.RouteCalculateSpotPrice(ctx, feeToken.PoolID, baseDenom, feeToken.Denom)
 	if err != nil {
 		return sdk.Dec{}, err
 	}
@@ -100,7 +136,7 @@ func (k Keeper) ValidateFeeToken(ctx sdk.Context, feeToken types.FeeToken) error
 	// - feeToken.Denom exists
 	// - feeToken.PoolID exists
 	// - feeToken.PoolID has both feeToken.Denom and baseDenom
-	_, err = k.spotPriceCalculator.CalculateSpotPrice(ctx, feeToken.PoolID, feeToken.Denom, baseDenom)
+	_, err = k.swaprouterKeeper.RouteCalculateSpotPrice(ctx, feeToken.PoolID, feeToken.Denom, baseDenom)
 
 	return err
 }
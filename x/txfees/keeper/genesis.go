@@ -17,6 +17,17 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState types.GenesisState) {
 	if err != nil {
 		panic(err)
 	}
+	k.SetParams(ctx, genState.Params)
+	k.SetCurrentBaseFee(ctx, genState.BaseFee)
+	for _, autoFeeToken := range genState.AutoFeeTokens {
+		k.setAutoFeeToken(ctx, autoFeeToken)
+	}
+	for _, deferredFeeSwap := range genState.DeferredFeeSwaps {
+		k.SetDeferredFeeSwap(ctx, deferredFeeSwap)
+	}
+	for _, feeSponsor := range genState.FeeSponsors {
+		k.SetFeeSponsor(ctx, feeSponsor)
+	}
 }
 
 // ExportGenesis returns the txfees module's exported genesis.
@@ -24,5 +35,10 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 	genesis := types.DefaultGenesis()
 	genesis.Basedenom, _ = k.GetBaseDenom(ctx)
 	genesis.Feetokens = k.GetFeeTokens(ctx)
+	genesis.Params = k.GetParams(ctx)
+	genesis.DeferredFeeSwaps = k.GetDeferredFeeSwaps(ctx)
+	genesis.BaseFee = k.GetCurrentBaseFee(ctx)
+	genesis.AutoFeeTokens = k.GetAutoFeeTokens(ctx)
+	genesis.FeeSponsors = k.GetFeeSponsors(ctx)
 	return genesis
 }
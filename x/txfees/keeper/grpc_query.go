@@ -81,3 +81,56 @@ func (q Querier) BaseDenom(ctx context.Context, _ *types.QueryBaseDenomRequest)
 
 	return &types.QueryBaseDenomResponse{BaseDenom: baseDenom}, nil
 }
+
+func (q Querier) DeferredFeeSwaps(ctx context.Context, _ *types.QueryDeferredFeeSwapsRequest) (*types.QueryDeferredFeeSwapsResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	deferredFeeSwaps := q.Keeper.GetDeferredFeeSwaps(sdkCtx)
+
+	return &types.QueryDeferredFeeSwapsResponse{DeferredFeeSwaps: deferredFeeSwaps}, nil
+}
+
+func (q Querier) BaseFee(ctx context.Context, _ *types.QueryBaseFeeRequest) (*types.QueryBaseFeeResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	baseFee := q.Keeper.GetCurrentBaseFee(sdkCtx)
+
+	return &types.QueryBaseFeeResponse{BaseFee: baseFee}, nil
+}
+
+func (q Querier) AutoFeeTokens(ctx context.Context, _ *types.QueryAutoFeeTokensRequest) (*types.QueryAutoFeeTokensResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	autoFeeTokens := q.Keeper.GetAutoFeeTokens(sdkCtx)
+
+	return &types.QueryAutoFeeTokensResponse{AutoFeeTokens: autoFeeTokens}, nil
+}
+
+func (q Querier) FeeSponsors(ctx context.Context, _ *types.QueryFeeSponsorsRequest) (*types.QueryFeeSponsorsResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	feeSponsors := q.Keeper.GetFeeSponsors(sdkCtx)
+
+	return &types.QueryFeeSponsorsResponse{FeeSponsors: feeSponsors}, nil
+}
+
+func (q Querier) FeeSponsor(ctx context.Context, req *types.QueryFeeSponsorRequest) (*types.QueryFeeSponsorResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	contractAddr, err := sdk.AccAddressFromBech32(req.ContractAddress)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	feeSponsor, found := q.Keeper.GetFeeSponsor(sdkCtx, contractAddr)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrFeeSponsorNotFound, "%s", req.ContractAddress)
+	}
+
+	return &types.QueryFeeSponsorResponse{FeeSponsor: feeSponsor}, nil
+}
+
+func (q Querier) Params(ctx context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	params := q.Keeper.GetParams(sdkCtx)
+
+	return &types.QueryParamsResponse{Params: params}, nil
+}
@@ -12,40 +12,60 @@ func (k Keeper) BeforeEpochStart(ctx sdk.Context, epochIdentifier string, epochN
 	return nil
 }
 
-// at the end of each epoch, swap all non-OSMO fees into OSMO and transfer to fee module account
+// at the end of each epoch, swap all non-OSMO fees into OSMO and transfer to fee module account,
+// then recompute the auto fee tokens if the epoch is the AutoFeeTokensEpochIdentifier one.
+// A swap that would return less than the TWAP based minimum amount out is deferred,
+// see RetryDeferredFeeSwaps.
 func (k Keeper) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, epochNumber int64) error {
 	nonNativeFeeAddr := k.accountKeeper.GetModuleAddress(txfeestypes.NonNativeFeeCollectorName)
 	baseDenom, _ := k.GetBaseDenom(ctx)
-	feeTokens := k.GetFeeTokens(ctx)
 
-	for _, feetoken := range feeTokens {
-		if feetoken.Denom == baseDenom {
+	feeDenoms := []string{}
+	for _, feeToken := range k.GetFeeTokens(ctx) {
+		feeDenoms = append(feeDenoms, feeToken.Denom)
+	}
+	for _, autoFeeToken := range k.GetAutoFeeTokens(ctx) {
+		feeDenoms = append(feeDenoms, autoFeeToken.Denom)
+	}
+
+	for _, feeDenom := range feeDenoms {
+		if feeDenom == baseDenom {
 			continue
 		}
-		coinBalance := k.bankKeeper.GetBalance(ctx, nonNativeFeeAddr, feetoken.Denom)
+		coinBalance := k.bankKeeper.GetBalance(ctx, nonNativeFeeAddr, feeDenom)
 		if coinBalance.Amount.IsZero() {
 			continue
 		}
+		// The balance of a fee token whose swap is already deferred is swapped by the retries.
+		if _, found := k.GetDeferredFeeSwap(ctx, feeDenom); found {
+			continue
+		}
+		routes, err := k.getFeeTokenRoutes(ctx, feeDenom, baseDenom)
+		if err != nil {
+			continue
+		}
 
 		// Do the swap of this fee token denom to base denom.
-		_ = osmoutils.ApplyFuncIfNoError(ctx, func(cacheCtx sdk.Context) error {
-			// We allow full slippage. Theres not really an effective way to bound slippage until TWAP's land,
-			// but even then the point is a bit moot.
-			// The only thing that could be done is a costly griefing attack to reduce the amount of osmo given as tx fees.
-			// However the idea of the txfees FeeToken gating is that the pool is sufficiently liquid for that base token.
-			minAmountOut := sdk.ZeroInt()
-			_, err := k.gammKeeper.SwapExactAmountIn(cacheCtx, nonNativeFeeAddr, feetoken.PoolID, coinBalance, baseDenom, minAmountOut)
-			return err
-		})
+		err = k.swapFeeToken(ctx, nonNativeFeeAddr, routes, coinBalance)
+		if err != nil {
+			deferredFeeSwap := txfeestypes.DeferredFeeSwap{
+				Denom:       feeDenom,
+				PoolID:      routes[0].PoolId,
+				EpochNumber: epochNumber,
+			}
+			k.deferFeeSwap(ctx, deferredFeeSwap, coinBalance, err)
+		}
 	}
 
-	// Get all of the txfee payout denom in the module account
-	baseDenomCoins := sdk.NewCoins(k.bankKeeper.GetBalance(ctx, nonNativeFeeAddr, baseDenom))
+	// Transfer all of the txfee payout denom in the module account
+	k.sendBaseDenomToFeeCollector(ctx, nonNativeFeeAddr, baseDenom)
 
-	_ = osmoutils.ApplyFuncIfNoError(ctx, func(cacheCtx sdk.Context) error {
-		err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, txfeestypes.NonNativeFeeCollectorName, txfeestypes.FeeCollectorName, baseDenomCoins)
-		return err
-	})
+	if epochIdentifier == k.GetParams(ctx).AutoFeeTokensEpochIdentifier {
+		_ = osmoutils.ApplyFuncIfNoError(ctx, func(cacheCtx sdk.Context) error {
+			k.UpdateAutoFeeTokens(cacheCtx)
+			return nil
+		})
+	}
 
 	return nil
 }
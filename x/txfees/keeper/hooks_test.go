@@ -7,6 +7,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	"github.com/osmosis-labs/osmosis/v13/x/gamm/pool-models/balancer"
 	gammtypes "github.com/osmosis-labs/osmosis/v13/x/gamm/types"
 	swaproutertypes "github.com/osmosis-labs/osmosis/v13/x/swaprouter/types"
 	"github.com/osmosis-labs/osmosis/v13/x/txfees/types"
@@ -97,8 +98,10 @@ func (suite *KeeperTestSuite) TestTxFeesAfterEpochEnd() {
 			suite.Equal(suite.App.BankKeeper.GetAllBalances(suite.Ctx, moduleAddrNonNativeFee), tc.coins)
 
 			// End of epoch, so all the non-osmo fee amount should be swapped to osmo and transfer to fee module account
+			// The epoch ends after the twap window bounding the swaps has elapsed since pool creation.
 			params := suite.App.IncentivesKeeper.GetParams(suite.Ctx)
-			futureCtx := suite.Ctx.WithBlockTime(time.Now().Add(time.Minute))
+			twapWindow := suite.App.TxFeesKeeper.GetParams(suite.Ctx).FeeSwapTwapWindow
+			futureCtx := suite.Ctx.WithBlockTime(suite.Ctx.BlockTime().Add(twapWindow).Add(time.Minute))
 			suite.App.TxFeesKeeper.AfterEpochEnd(futureCtx, params.DistrEpochIdentifier, int64(1))
 
 			// check the balance of the native-basedenom in module
@@ -112,3 +115,172 @@ func (suite *KeeperTestSuite) TestTxFeesAfterEpochEnd() {
 		})
 	}
 }
+
+// fundNonNativeFeeCollector deposits coins as fees to the non-native fee collector.
+func (suite *KeeperTestSuite) fundNonNativeFeeCollector(coins sdk.Coins) {
+	_, _, addr := testdata.KeyTestPubAddr()
+	suite.FundAcc(addr, coins)
+	err := suite.App.BankKeeper.SendCoinsFromAccountToModule(suite.Ctx, addr, types.NonNativeFeeCollectorName, coins)
+	suite.Require().NoError(err)
+}
+
+func (suite *KeeperTestSuite) TestTxFeesAfterEpochEndDefersManipulatedSwap() {
+	suite.SetupTest(false)
+	baseDenom, _ := suite.App.TxFeesKeeper.GetBaseDenom(suite.Ctx)
+	uion := "uion"
+	poolId, _ := suite.preparePool(uion)
+	fee := sdk.NewInt64Coin(uion, 10)
+	suite.fundNonNativeFeeCollector(sdk.NewCoins(fee))
+
+	moduleAddrNonNativeFee := suite.App.AccountKeeper.GetModuleAddress(types.NonNativeFeeCollectorName)
+	moduleAddrFee := suite.App.AccountKeeper.GetModuleAddress(types.FeeCollectorName)
+	twapWindow := suite.App.TxFeesKeeper.GetParams(suite.Ctx).FeeSwapTwapWindow
+	suite.Ctx = suite.Ctx.WithBlockTime(suite.Ctx.BlockTime().Add(twapWindow).Add(time.Minute))
+
+	// Right before the epoch ends, dump uion into the pool to crash its spot price,
+	// while the twap still reflects the price before the manipulation.
+	routes := []swaproutertypes.SwapAmountInRoute{{PoolId: poolId, TokenOutDenom: baseDenom}}
+	_, err := suite.App.SwapRouterKeeper.RouteExactAmountIn(suite.Ctx, suite.TestAccs[0], routes, sdk.NewInt64Coin(uion, defaultPooledAssetAmount), sdk.OneInt())
+	suite.Require().NoError(err)
+
+	epochCtx := suite.Ctx.WithEventManager(sdk.NewEventManager())
+	err = suite.App.TxFeesKeeper.AfterEpochEnd(epochCtx, "day", 1)
+	suite.Require().NoError(err)
+
+	// The swap is deferred and reported, the fees are left untouched.
+	suite.AssertEventEmitted(epochCtx, types.TypeEvtDeferredFeeSwap, 1)
+	suite.Require().Equal(sdk.NewCoins(fee), suite.App.BankKeeper.GetAllBalances(suite.Ctx, moduleAddrNonNativeFee))
+	suite.Require().True(suite.App.BankKeeper.GetBalance(suite.Ctx, moduleAddrFee, baseDenom).IsZero())
+
+	res, err := suite.queryClient.DeferredFeeSwaps(sdk.WrapSDKContext(suite.Ctx), &types.QueryDeferredFeeSwapsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.DeferredFeeSwaps, 1)
+	deferredFeeSwap := res.DeferredFeeSwaps[0]
+	suite.Require().Equal(uion, deferredFeeSwap.Denom)
+	suite.Require().Equal(poolId, deferredFeeSwap.PoolID)
+	suite.Require().Equal(int64(1), deferredFeeSwap.EpochNumber)
+	suite.Require().Equal(uint64(0), deferredFeeSwap.Splits)
+	suite.Require().NotEmpty(deferredFeeSwap.Reason)
+
+	// Once the price is restored, the deferred swap goes through at the end of the next block.
+	_, err = suite.App.SwapRouterKeeper.RouteExactAmountIn(suite.Ctx, suite.TestAccs[0],
+		[]swaproutertypes.SwapAmountInRoute{{PoolId: poolId, TokenOutDenom: uion}},
+		sdk.NewInt64Coin(baseDenom, defaultPooledAssetAmount/2), sdk.OneInt())
+	suite.Require().NoError(err)
+
+	suite.App.TxFeesKeeper.RetryDeferredFeeSwaps(suite.Ctx)
+
+	suite.Require().Empty(suite.App.BankKeeper.GetAllBalances(suite.Ctx, moduleAddrNonNativeFee))
+	suite.Require().True(suite.App.BankKeeper.GetBalance(suite.Ctx, moduleAddrFee, baseDenom).IsPositive())
+	suite.Require().Empty(suite.App.TxFeesKeeper.GetDeferredFeeSwaps(suite.Ctx))
+}
+
+func (suite *KeeperTestSuite) TestRetryDeferredFeeSwapsSplitsSwap() {
+	suite.SetupTest(false)
+	uion := "uion"
+	poolId, _ := suite.preparePool(uion)
+	twapWindow := suite.App.TxFeesKeeper.GetParams(suite.Ctx).FeeSwapTwapWindow
+	suite.Ctx = suite.Ctx.WithBlockTime(suite.Ctx.BlockTime().Add(twapWindow).Add(time.Minute))
+
+	// The price impact of swapping 100uion into the 500uion pool alone exceeds the 5% deviation,
+	// as does swapping 50uion, whereas swapping 25uion does not.
+	suite.fundNonNativeFeeCollector(sdk.NewCoins(sdk.NewInt64Coin(uion, 100)))
+	err := suite.App.TxFeesKeeper.AfterEpochEnd(suite.Ctx, "day", 1)
+	suite.Require().NoError(err)
+
+	moduleAddrNonNativeFee := suite.App.AccountKeeper.GetModuleAddress(types.NonNativeFeeCollectorName)
+	expectedBalances := []int64{100, 100, 75}
+	expectedSplits := []uint64{1, 2, 2}
+	for i := range expectedBalances {
+		suite.App.TxFeesKeeper.RetryDeferredFeeSwaps(suite.Ctx)
+
+		balance := suite.App.BankKeeper.GetBalance(suite.Ctx, moduleAddrNonNativeFee, uion)
+		suite.Require().Equal(sdk.NewInt(expectedBalances[i]), balance.Amount, "retry %d", i)
+
+		deferredFeeSwap, found := suite.App.TxFeesKeeper.GetDeferredFeeSwap(suite.Ctx, uion)
+		suite.Require().True(found)
+		suite.Require().Equal(poolId, deferredFeeSwap.PoolID)
+		suite.Require().Equal(expectedSplits[i], deferredFeeSwap.Splits, "retry %d", i)
+	}
+}
+
+func (suite *KeeperTestSuite) TestRetryDeferredFeeSwapsStopsAfterMaxSplits() {
+	suite.SetupTest(false)
+	baseDenom, _ := suite.App.TxFeesKeeper.GetBaseDenom(suite.Ctx)
+	uion := "uion"
+	poolId, _ := suite.preparePool(uion)
+	fee := sdk.NewInt64Coin(uion, 10)
+	suite.fundNonNativeFeeCollector(sdk.NewCoins(fee))
+
+	moduleAddrNonNativeFee := suite.App.AccountKeeper.GetModuleAddress(types.NonNativeFeeCollectorName)
+	twapWindow := suite.App.TxFeesKeeper.GetParams(suite.Ctx).FeeSwapTwapWindow
+	suite.Ctx = suite.Ctx.WithBlockTime(suite.Ctx.BlockTime().Add(twapWindow).Add(time.Minute))
+
+	// Crash the price of uion, so that every swap of the fees breaches the slippage bound.
+	routes := []swaproutertypes.SwapAmountInRoute{{PoolId: poolId, TokenOutDenom: baseDenom}}
+	_, err := suite.App.SwapRouterKeeper.RouteExactAmountIn(suite.Ctx, suite.TestAccs[0], routes, sdk.NewInt64Coin(uion, defaultPooledAssetAmount), sdk.OneInt())
+	suite.Require().NoError(err)
+
+	err = suite.App.TxFeesKeeper.AfterEpochEnd(suite.Ctx, "day", 1)
+	suite.Require().NoError(err)
+
+	for i := 0; i < types.MaxDeferredFeeSwapSplits; i++ {
+		suite.App.TxFeesKeeper.RetryDeferredFeeSwaps(suite.Ctx)
+	}
+	deferredFeeSwap, found := suite.App.TxFeesKeeper.GetDeferredFeeSwap(suite.Ctx, uion)
+	suite.Require().True(found)
+	suite.Require().Equal(uint64(types.MaxDeferredFeeSwapSplits), deferredFeeSwap.Splits)
+
+	// The retry with the maximum number of splits fails, so the swap is no longer retried every block.
+	suite.App.TxFeesKeeper.RetryDeferredFeeSwaps(suite.Ctx)
+	suite.Require().Empty(suite.App.TxFeesKeeper.GetDeferredFeeSwaps(suite.Ctx))
+	suite.Require().Equal(sdk.NewCoins(fee), suite.App.BankKeeper.GetAllBalances(suite.Ctx, moduleAddrNonNativeFee))
+
+	// The swap of the remaining balance is deferred again at the end of the next epoch.
+	err = suite.App.TxFeesKeeper.AfterEpochEnd(suite.Ctx, "day", 2)
+	suite.Require().NoError(err)
+	deferredFeeSwap, found = suite.App.TxFeesKeeper.GetDeferredFeeSwap(suite.Ctx, uion)
+	suite.Require().True(found)
+	suite.Require().Equal(int64(2), deferredFeeSwap.EpochNumber)
+	suite.Require().Equal(uint64(0), deferredFeeSwap.Splits)
+}
+
+func (suite *KeeperTestSuite) TestTxFeesAfterEpochEndSwapsThroughFeeChargingRoute() {
+	suite.SetupTest(false)
+	baseDenom, _ := suite.App.TxFeesKeeper.GetBaseDenom(suite.Ctx)
+
+	// atom is swapped to the base denom through two pools that each charge a 3% swap fee,
+	// so that the swap returns ~6% less than the fees are worth at the twap price.
+	poolParams := balancer.PoolParams{SwapFee: sdk.NewDecWithPrec(3, 2), ExitFee: sdk.ZeroDec()}
+	suite.PrepareCustomBalancerPool([]balancer.PoolAsset{
+		{Weight: sdk.OneInt(), Token: sdk.NewInt64Coin(baseDenom, autoFeeTokenPoolAmount)},
+		{Weight: sdk.OneInt(), Token: sdk.NewInt64Coin("uion", autoFeeTokenPoolAmount)},
+	}, poolParams)
+	suite.PrepareCustomBalancerPool([]balancer.PoolAsset{
+		{Weight: sdk.OneInt(), Token: sdk.NewInt64Coin("uion", autoFeeTokenPoolAmount)},
+		{Weight: sdk.OneInt(), Token: sdk.NewInt64Coin("atom", autoFeeTokenPoolAmount)},
+	}, poolParams)
+
+	params := suite.App.TxFeesKeeper.GetParams(suite.Ctx)
+	params.AutoFeeTokensEnabled = true
+	params.MinAutoFeeTokenLiquidity = sdk.NewInt(100_000)
+	suite.App.TxFeesKeeper.SetParams(suite.Ctx, params)
+	suite.Ctx = suite.Ctx.WithBlockTime(suite.Ctx.BlockTime().Add(params.FeeSwapTwapWindow).Add(time.Minute))
+	suite.App.TxFeesKeeper.UpdateAutoFeeTokens(suite.Ctx)
+	autoFeeToken, found := suite.App.TxFeesKeeper.GetAutoFeeToken(suite.Ctx, "atom")
+	suite.Require().True(found)
+	suite.Require().Len(autoFeeToken.Routes, 2)
+
+	// The swap fees of the route are not mistaken for a breach of the 5% price deviation.
+	suite.fundNonNativeFeeCollector(sdk.NewCoins(sdk.NewInt64Coin("atom", 1000)))
+	epochCtx := suite.Ctx.WithEventManager(sdk.NewEventManager())
+	err := suite.App.TxFeesKeeper.AfterEpochEnd(epochCtx, "day", 1)
+	suite.Require().NoError(err)
+
+	suite.AssertEventEmitted(epochCtx, types.TypeEvtDeferredFeeSwap, 0)
+	suite.Require().Empty(suite.App.TxFeesKeeper.GetDeferredFeeSwaps(suite.Ctx))
+	moduleAddrNonNativeFee := suite.App.AccountKeeper.GetModuleAddress(types.NonNativeFeeCollectorName)
+	moduleAddrFee := suite.App.AccountKeeper.GetModuleAddress(types.FeeCollectorName)
+	suite.Require().Empty(suite.App.BankKeeper.GetAllBalances(suite.Ctx, moduleAddrNonNativeFee))
+	suite.Require().True(suite.App.BankKeeper.GetBalance(suite.Ctx, moduleAddrFee, baseDenom).IsPositive())
+}
@@ -7,17 +7,20 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 
 	"github.com/osmosis-labs/osmosis/v13/x/txfees/types"
 )
 
 type Keeper struct {
-	storeKey sdk.StoreKey
+	storeKey   sdk.StoreKey
+	paramSpace paramtypes.Subspace
 
-	accountKeeper       types.AccountKeeper
-	bankKeeper          types.BankKeeper
-	gammKeeper          types.GammKeeper
-	spotPriceCalculator types.SpotPriceCalculator
+	accountKeeper    types.AccountKeeper
+	bankKeeper       types.BankKeeper
+	swaprouterKeeper types.SwapRouterKeeper
+	twapKeeper       types.TwapKeeper
+	contractKeeper   types.ContractKeeper
 }
 
 var _ types.TxFeesKeeper = (*Keeper)(nil)
@@ -26,18 +29,42 @@ func NewKeeper(
 	accountKeeper types.AccountKeeper,
 	bankKeeper types.BankKeeper,
 	storeKey sdk.StoreKey,
-	gammKeeper types.GammKeeper,
-	spotPriceCalculator types.SpotPriceCalculator,
+	paramSpace paramtypes.Subspace,
+	swaprouterKeeper types.SwapRouterKeeper,
+	twapKeeper types.TwapKeeper,
 ) Keeper {
+	// set KeyTable if it has not already been set
+	if !paramSpace.HasKeyTable() {
+		paramSpace = paramSpace.WithKeyTable(types.ParamKeyTable())
+	}
+
 	return Keeper{
-		accountKeeper:       accountKeeper,
-		bankKeeper:          bankKeeper,
-		storeKey:            storeKey,
-		gammKeeper:          gammKeeper,
-		spotPriceCalculator: spotPriceCalculator,
+		accountKeeper:    accountKeeper,
+		bankKeeper:       bankKeeper,
+		storeKey:         storeKey,
+		paramSpace:       paramSpace,
+		swaprouterKeeper: swaprouterKeeper,
+		twapKeeper:       twapKeeper,
 	}
 }
 
+// SetContractKeeper sets the contract keeper used by fee sponsors.
+// It is set after the keeper is created, since the wasm keeper is created after the txfees keeper.
+func (k *Keeper) SetContractKeeper(contractKeeper types.ContractKeeper) {
+	k.contractKeeper = contractKeeper
+}
+
+// GetParams returns the total set of txfees parameters.
+func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
+	k.paramSpace.GetParamSet(ctx, &params)
+	return params
+}
+
+// SetParams sets the total set of txfees parameters.
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	k.paramSpace.SetParamSet(ctx, &params)
+}
+
 func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
 }
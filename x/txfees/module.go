@@ -6,6 +6,7 @@ only specify their tx fee parameters for a single "base" asset.
 - Adds a whitelist of tokens that can be used as fees on the chain.
 - Any token not on this list cannot be provided as a tx fee.
 - Adds a new SDK message for creating governance proposals for adding new TxFee denoms.
+- Lets contracts register as fee sponsors that pay for the txs they approve.
 */
 package txfees
 
@@ -136,6 +137,7 @@ func (am AppModule) LegacyQuerierHandler(legacyQuerierCdc *codec.LegacyAmino) sd
 // RegisterServices registers a GRPC query service to respond to the
 // module-specific GRPC queries.
 func (am AppModule) RegisterServices(cfg module.Configurator) {
+	types.RegisterMsgServer(cfg.MsgServer(), keeper.NewMsgServerImpl(am.keeper))
 	types.RegisterQueryServer(cfg.QueryServer(), keeper.NewQuerier(am.keeper))
 }
 
@@ -168,7 +170,15 @@ func (am AppModule) BeginBlock(_ sdk.Context, _ abci.RequestBeginBlock) {}
 
 // EndBlock executes all ABCI EndBlock logic respective to the txfees module. It
 // returns no validator updates.
-func (am AppModule) EndBlock(_ sdk.Context, _ abci.RequestEndBlock) []abci.ValidatorUpdate {
+func (am AppModule) EndBlock(ctx sdk.Context, _ abci.RequestEndBlock) []abci.ValidatorUpdate {
+	am.keeper.RetryDeferredFeeSwaps(ctx)
+
+	// The block gas meter is only unset in contexts built outside of a block, e.g. in tests.
+	blockGasUsed := uint64(0)
+	if ctx.BlockGasMeter() != nil {
+		blockGasUsed = ctx.BlockGasMeter().GasConsumed()
+	}
+	am.keeper.UpdateBaseFee(ctx, blockGasUsed)
 	return []abci.ValidatorUpdate{}
 }
 
@@ -11,4 +11,10 @@ var (
 	ErrNoBaseDenom     = sdkerrors.Register(ModuleName, 1, "no base denom was set")
 	ErrTooManyFeeCoins = sdkerrors.Register(ModuleName, 2, "too many fee coins. only accepts fees in one denom")
 	ErrInvalidFeeToken = sdkerrors.Register(ModuleName, 3, "invalid fee token")
+	ErrNoFeeTokenTwap  = sdkerrors.Register(ModuleName, 4, "no twap available to price the fee token")
+
+	ErrNotFeeSponsorAuthority = sdkerrors.Register(ModuleName, 5, "sender must be the fee sponsor contract or its admin")
+	ErrFeeSponsorNotFound     = sdkerrors.Register(ModuleName, 6, "fee sponsor not found")
+	ErrFeeNotSponsored        = sdkerrors.Register(ModuleName, 7, "fee sponsor does not sponsor the tx")
+	ErrFeeSponsorRejected     = sdkerrors.Register(ModuleName, 8, "fee sponsor rejected the tx")
 )
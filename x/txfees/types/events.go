@@ -0,0 +1,16 @@
+package types
+
+const (
+	TypeEvtDeferredFeeSwap      = "deferred_fee_swap"
+	TypeEvtRegisterFeeSponsor   = "register_fee_sponsor"
+	TypeEvtUnregisterFeeSponsor = "unregister_fee_sponsor"
+	TypeEvtSponsoredFee         = "sponsored_fee"
+
+	AttributeValueCategory = ModuleName
+	AttributeKeyDenom      = "denom"
+	AttributeKeyPoolId     = "pool_id"
+	AttributeKeyAmount     = "amount"
+	AttributeKeyReason     = "reason"
+	AttributeKeyContract   = "contract_address"
+	AttributeKeyFeePayer   = "fee_payer"
+)
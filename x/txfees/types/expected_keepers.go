@@ -1,26 +1,52 @@
 package types
 
 import (
+	"time"
+
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
-)
 
-// SpotPriceCalculator defines the contract that must be fulfilled by a spot price calculator
-// The x/gamm keeper is expected to satisfy this interface.
-type SpotPriceCalculator interface {
-	CalculateSpotPrice(ctx sdk.Context, poolId uint64, quoteDenom, baseDenom string) (sdk.Dec, error)
-}
+	swaproutertypes "github.com/osmosis-labs/osmosis/v13/x/swaprouter/types"
+)
 
-// GammKeeper defines the contract needed for AccountKeeper related APIs.
-type GammKeeper interface {
-	SwapExactAmountIn(
+// SwapRouterKeeper defines the contract needed to swap fee tokens, price them and find
+// their routes through whichever pool module their pools belong to.
+// The x/swaprouter keeper is expected to satisfy this interface.
+type SwapRouterKeeper interface {
+	RouteExactAmountIn(
 		ctx sdk.Context,
 		sender sdk.AccAddress,
-		poolId uint64,
+		routes []swaproutertypes.SwapAmountInRoute,
 		tokenIn sdk.Coin,
-		tokenOutDenom string,
 		tokenOutMinAmount sdk.Int,
 	) (tokenOutAmount sdk.Int, err error)
+
+	RouteCalculateSpotPrice(ctx sdk.Context, poolId uint64, quoteAssetDenom string, baseAssetDenom string) (sdk.Dec, error)
+
+	FindOptimalRoutesExactAmountIn(
+		ctx sdk.Context,
+		tokensIn []sdk.Coin,
+		tokenOutDenom string,
+		maxHops uint64,
+	) ([][]swaproutertypes.SwapAmountInRoute, error)
+
+	GetPool(ctx sdk.Context, poolId uint64) (swaproutertypes.PoolI, error)
+
+	GetTotalPoolLiquidity(ctx sdk.Context, poolId uint64) (sdk.Coins, error)
+
+	GetTotalLiquidity(ctx sdk.Context) (sdk.Coins, error)
+}
+
+// TwapKeeper defines the contract needed to bound the slippage of fee token swaps.
+type TwapKeeper interface {
+	GetArithmeticTwapToNow(
+		ctx sdk.Context,
+		poolId uint64,
+		baseAssetDenom string,
+		quoteAssetDenom string,
+		startTime time.Time,
+	) (sdk.Dec, error)
 }
 
 // AccountKeeper defines the contract needed for AccountKeeper related APIs.
@@ -35,6 +61,14 @@ type FeegrantKeeper interface {
 	UseGrantedFees(ctx sdk.Context, granter, grantee sdk.AccAddress, fee sdk.Coins, msgs []sdk.Msg) error
 }
 
+// ContractKeeper defines the contract needed to authorize fee sponsor registrations and
+// ask fee sponsor contracts to approve the txs they pay for.
+// The x/wasm keeper is expected to satisfy this interface.
+type ContractKeeper interface {
+	GetContractInfo(ctx sdk.Context, contractAddress sdk.AccAddress) *wasmtypes.ContractInfo
+	Sudo(ctx sdk.Context, contractAddress sdk.AccAddress, msg []byte) ([]byte, error)
+}
+
 // BankKeeper defines the contract needed for supply related APIs (noalias)
 type BankKeeper interface {
 	SendCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
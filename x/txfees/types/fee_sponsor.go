@@ -0,0 +1,103 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// Validate checks that the fee sponsor's contract address and sponsored addresses are valid,
+// and that its sponsored message type urls are non-empty and unique.
+func (s FeeSponsor) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(s.ContractAddress); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid contract address (%s)", err)
+	}
+	return validateFeeSponsorFilters(s.MsgTypeUrls, s.Addresses)
+}
+
+func validateFeeSponsorFilters(msgTypeUrls []string, addresses []string) error {
+	seenMsgTypeUrls := make(map[string]bool, len(msgTypeUrls))
+	for _, msgTypeUrl := range msgTypeUrls {
+		if msgTypeUrl == "" {
+			return fmt.Errorf("sponsored msg type url cannot be empty")
+		}
+		if seenMsgTypeUrls[msgTypeUrl] {
+			return fmt.Errorf("duplicate sponsored msg type url %s", msgTypeUrl)
+		}
+		seenMsgTypeUrls[msgTypeUrl] = true
+	}
+
+	seenAddresses := make(map[string]bool, len(addresses))
+	for _, address := range addresses {
+		if _, err := sdk.AccAddressFromBech32(address); err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sponsored address (%s)", err)
+		}
+		if seenAddresses[address] {
+			return fmt.Errorf("duplicate sponsored address %s", address)
+		}
+		seenAddresses[address] = true
+	}
+
+	return nil
+}
+
+// Sponsors returns whether the fee sponsor sponsors a tx of feePayer made of msgs,
+// i.e. whether feePayer is one of its sponsored addresses and every msg has one of its
+// sponsored types. Empty sponsored addresses or types match any.
+func (s FeeSponsor) Sponsors(feePayer sdk.AccAddress, msgs []sdk.Msg) bool {
+	if len(s.Addresses) > 0 && !contains(s.Addresses, feePayer.String()) {
+		return false
+	}
+	if len(s.MsgTypeUrls) == 0 {
+		return true
+	}
+	for _, msg := range msgs {
+		if !contains(s.MsgTypeUrls, sdk.MsgTypeURL(msg)) {
+			return false
+		}
+	}
+	return true
+}
+
+func contains(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
+
+// FeeSponsorSudoMsg is the sudo message sent to a fee sponsor contract to approve a tx
+// before its fees are deducted from the contract's balance. The contract rejects the tx
+// by returning an error.
+type FeeSponsorSudoMsg struct {
+	ApproveFeeSponsorship ApproveFeeSponsorship `json:"approve_fee_sponsorship"`
+}
+
+// ApproveFeeSponsorship describes the tx a fee sponsor contract is asked to pay for.
+type ApproveFeeSponsorship struct {
+	FeePayer    string    `json:"fee_payer"`
+	Fee         sdk.Coins `json:"fee"`
+	Gas         uint64    `json:"gas"`
+	MsgTypeUrls []string  `json:"msg_type_urls"`
+}
+
+// NewFeeSponsorSudoMsg returns the sudo message asking a fee sponsor contract to pay fee
+// for feePayer's tx with the given gas limit and msgs.
+func NewFeeSponsorSudoMsg(feePayer sdk.AccAddress, fee sdk.Coins, gas uint64, msgs []sdk.Msg) FeeSponsorSudoMsg {
+	msgTypeUrls := make([]string, len(msgs))
+	for i, msg := range msgs {
+		msgTypeUrls[i] = sdk.MsgTypeURL(msg)
+	}
+
+	return FeeSponsorSudoMsg{
+		ApproveFeeSponsorship: ApproveFeeSponsorship{
+			FeePayer:    feePayer.String(),
+			Fee:         fee,
+			Gas:         gas,
+			MsgTypeUrls: msgTypeUrls,
+		},
+	}
+}
@@ -7,6 +7,7 @@ import (
 	fmt "fmt"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	types "github.com/osmosis-labs/osmosis/v13/x/swaprouter/types"
 	io "io"
 	math "math"
 	math_bits "math/bits"
@@ -79,8 +80,155 @@ func (m *FeeToken) GetPoolID() uint64 {
 	return 0
 }
 
+// DeferredFeeSwap records a non-native fee token whose epoch swap to the base
+// denom was skipped because it would have breached the TWAP based slippage
+// bound, or because no TWAP was available. Deferred swaps are retried every
+// block, splitting the fee token balance into 2^splits chunks, until a retry
+// with the maximum number of splits fails. The remaining balance is then
+// swapped at the end of the next epoch.
+type DeferredFeeSwap struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
+	// poolID is the first pool of the route of the fee token to the base denom.
+	PoolID uint64 `protobuf:"varint,2,opt,name=poolID,proto3" json:"poolID,omitempty" yaml:"pool_id"`
+	// epoch_number is the number of the epoch whose swap was first deferred.
+	EpochNumber int64 `protobuf:"varint,3,opt,name=epoch_number,json=epochNumber,proto3" json:"epoch_number,omitempty" yaml:"epoch_number"`
+	// splits is the number of times the swap amount was halved after failed
+	// retries.
+	Splits uint64 `protobuf:"varint,4,opt,name=splits,proto3" json:"splits,omitempty" yaml:"splits"`
+	// reason is the error of the last failed swap attempt.
+	Reason string `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty" yaml:"reason"`
+}
+
+func (m *DeferredFeeSwap) Reset()         { *m = DeferredFeeSwap{} }
+func (m *DeferredFeeSwap) String() string { return proto.CompactTextString(m) }
+func (*DeferredFeeSwap) ProtoMessage()    {}
+func (*DeferredFeeSwap) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c50689857adfcfe0, []int{1}
+}
+func (m *DeferredFeeSwap) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeferredFeeSwap) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeferredFeeSwap.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DeferredFeeSwap) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeferredFeeSwap.Merge(m, src)
+}
+func (m *DeferredFeeSwap) XXX_Size() int {
+	return m.Size()
+}
+func (m *DeferredFeeSwap) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeferredFeeSwap.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeferredFeeSwap proto.InternalMessageInfo
+
+func (m *DeferredFeeSwap) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *DeferredFeeSwap) GetPoolID() uint64 {
+	if m != nil {
+		return m.PoolID
+	}
+	return 0
+}
+
+func (m *DeferredFeeSwap) GetEpochNumber() int64 {
+	if m != nil {
+		return m.EpochNumber
+	}
+	return 0
+}
+
+func (m *DeferredFeeSwap) GetSplits() uint64 {
+	if m != nil {
+		return m.Splits
+	}
+	return 0
+}
+
+func (m *DeferredFeeSwap) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+// AutoFeeToken is a denom that is not whitelisted by governance but can be
+// used as a tx fee asset, as it has a route to the base denom through pools
+// holding at least the MinAutoFeeTokenLiquidity param worth of base denom.
+// Auto fee tokens and their routes are recomputed at the end of every epoch.
+// Their price in base denom is the product of the arithmetic TWAPs of each
+// pool of the route.
+type AutoFeeToken struct {
+	Denom  string                    `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
+	Routes []types.SwapAmountInRoute `protobuf:"bytes,2,rep,name=routes,proto3" json:"routes" yaml:"routes"`
+}
+
+func (m *AutoFeeToken) Reset()         { *m = AutoFeeToken{} }
+func (m *AutoFeeToken) String() string { return proto.CompactTextString(m) }
+func (*AutoFeeToken) ProtoMessage()    {}
+func (*AutoFeeToken) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c50689857adfcfe0, []int{2}
+}
+func (m *AutoFeeToken) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AutoFeeToken) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AutoFeeToken.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AutoFeeToken) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AutoFeeToken.Merge(m, src)
+}
+func (m *AutoFeeToken) XXX_Size() int {
+	return m.Size()
+}
+func (m *AutoFeeToken) XXX_DiscardUnknown() {
+	xxx_messageInfo_AutoFeeToken.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AutoFeeToken proto.InternalMessageInfo
+
+func (m *AutoFeeToken) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *AutoFeeToken) GetRoutes() []types.SwapAmountInRoute {
+	if m != nil {
+		return m.Routes
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*FeeToken)(nil), "osmosis.txfees.v1beta1.FeeToken")
+	proto.RegisterType((*DeferredFeeSwap)(nil), "osmosis.txfees.v1beta1.DeferredFeeSwap")
+	proto.RegisterType((*AutoFeeToken)(nil), "osmosis.txfees.v1beta1.AutoFeeToken")
 }
 
 func init() {
@@ -88,22 +236,34 @@ func init() {
 }
 
 var fileDescriptor_c50689857adfcfe0 = []byte{
-	// 236 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0xcd, 0x2f, 0xce, 0xcd,
-	0x2f, 0xce, 0x2c, 0xd6, 0x2f, 0xa9, 0x48, 0x4b, 0x4d, 0x2d, 0xd6, 0x2f, 0x33, 0x4c, 0x4a, 0x2d,
-	0x49, 0x34, 0xd4, 0x4f, 0x4b, 0x4d, 0x2d, 0xc9, 0xcf, 0x4e, 0xcd, 0xd3, 0x2b, 0x28, 0xca, 0x2f,
-	0xc9, 0x17, 0x12, 0x83, 0x2a, 0xd3, 0x83, 0x28, 0xd3, 0x83, 0x2a, 0x93, 0x12, 0x49, 0xcf, 0x4f,
-	0xcf, 0x07, 0x2b, 0xd1, 0x07, 0xb1, 0x20, 0xaa, 0x95, 0x52, 0xb8, 0x38, 0xdc, 0x52, 0x53, 0x43,
-	0x40, 0xfa, 0x85, 0xd4, 0xb8, 0x58, 0x53, 0x52, 0xf3, 0xf2, 0x73, 0x25, 0x18, 0x15, 0x18, 0x35,
-	0x38, 0x9d, 0x04, 0x3e, 0xdd, 0x93, 0xe7, 0xa9, 0x4c, 0xcc, 0xcd, 0xb1, 0x52, 0x02, 0x0b, 0x2b,
-	0x05, 0x41, 0xa4, 0x85, 0xb4, 0xb8, 0xd8, 0x0a, 0xf2, 0xf3, 0x73, 0x3c, 0x5d, 0x24, 0x98, 0x14,
-	0x18, 0x35, 0x58, 0x9c, 0x84, 0x3e, 0xdd, 0x93, 0xe7, 0x83, 0x28, 0x04, 0x89, 0xc7, 0x67, 0xa6,
-	0x28, 0x05, 0x41, 0x55, 0x58, 0xb1, 0xbc, 0x58, 0x20, 0xcf, 0xe8, 0xe4, 0x73, 0xe2, 0x91, 0x1c,
-	0xe3, 0x85, 0x47, 0x72, 0x8c, 0x0f, 0x1e, 0xc9, 0x31, 0x4e, 0x78, 0x2c, 0xc7, 0x70, 0xe1, 0xb1,
-	0x1c, 0xc3, 0x8d, 0xc7, 0x72, 0x0c, 0x51, 0x46, 0xe9, 0x99, 0x25, 0x19, 0xa5, 0x49, 0x7a, 0xc9,
-	0xf9, 0xb9, 0xfa, 0x50, 0x87, 0xeb, 0xe6, 0x24, 0x26, 0x15, 0xc3, 0x38, 0xfa, 0x65, 0x86, 0xc6,
-	0xfa, 0x15, 0x30, 0x2f, 0x97, 0x54, 0x16, 0xa4, 0x16, 0x27, 0xb1, 0x81, 0x9d, 0x6e, 0x0c, 0x08,
-	0x00, 0x00, 0xff, 0xff, 0xbc, 0x3a, 0x8b, 0xb0, 0x11, 0x01, 0x00, 0x00,
+	// 421 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x92, 0x3d, 0x6f, 0xd4, 0x40,
+	0x10, 0x86, 0x6f, 0x73, 0x97, 0x13, 0x6c, 0x8e, 0x2f, 0xf3, 0x65, 0xa5, 0xb0, 0xad, 0x95, 0x40,
+	0x06, 0x14, 0x5b, 0x97, 0x74, 0xd7, 0xc5, 0x8a, 0x22, 0x45, 0x42, 0x14, 0x86, 0x0a, 0x21, 0x9d,
+	0xec, 0x78, 0xee, 0x62, 0x61, 0x7b, 0x2c, 0xef, 0x3a, 0x1f, 0xff, 0x82, 0x82, 0x1f, 0xc0, 0xcf,
+	0x49, 0x79, 0x25, 0x95, 0x85, 0xee, 0x1a, 0x6a, 0x37, 0xb4, 0x68, 0x77, 0x6d, 0xb8, 0x96, 0x82,
+	0x6e, 0xe7, 0x9d, 0x67, 0xe7, 0x1d, 0xed, 0xbe, 0xf4, 0x05, 0xf2, 0x1c, 0x79, 0xca, 0x7d, 0x71,
+	0xbd, 0x00, 0xe0, 0xfe, 0xe5, 0x34, 0x06, 0x11, 0x4d, 0xfd, 0x05, 0x80, 0xc0, 0xcf, 0x50, 0x78,
+	0x65, 0x85, 0x02, 0x8d, 0x67, 0x1d, 0xe6, 0x69, 0xcc, 0xeb, 0xb0, 0xfd, 0x27, 0x4b, 0x5c, 0xa2,
+	0x42, 0x7c, 0x79, 0xd2, 0xf4, 0xfe, 0x9b, 0x7e, 0x28, 0xbf, 0x8a, 0xca, 0x0a, 0x6b, 0x01, 0xd5,
+	0x9f, 0xc1, 0x52, 0x9a, 0x2b, 0x4d, 0xc3, 0x2c, 0xa1, 0x77, 0x4e, 0x01, 0x3e, 0x48, 0x33, 0xe3,
+	0x25, 0xdd, 0x4d, 0xa0, 0xc0, 0xdc, 0x24, 0x0e, 0x71, 0xef, 0x06, 0x0f, 0xdb, 0xc6, 0x9e, 0xdc,
+	0x44, 0x79, 0x36, 0x63, 0x4a, 0x66, 0xa1, 0x6e, 0x1b, 0xaf, 0xe9, 0xb8, 0x44, 0xcc, 0xce, 0x4e,
+	0xcc, 0x1d, 0x87, 0xb8, 0xa3, 0xc0, 0x68, 0x1b, 0xfb, 0xbe, 0x06, 0xa5, 0x3e, 0x4f, 0x13, 0x16,
+	0x76, 0xc4, 0x6c, 0xf4, 0xf3, 0x9b, 0x4d, 0xd8, 0x2f, 0x42, 0x1f, 0x9c, 0xc0, 0x02, 0xaa, 0x0a,
+	0x92, 0x53, 0x80, 0xf7, 0x57, 0x51, 0xf9, 0x3f, 0xdc, 0x8c, 0x19, 0x9d, 0x40, 0x89, 0xe7, 0x17,
+	0xf3, 0xa2, 0xce, 0x63, 0xa8, 0xcc, 0xa1, 0x43, 0xdc, 0x61, 0xf0, 0xbc, 0x6d, 0xec, 0xc7, 0xfa,
+	0xc6, 0x76, 0x97, 0x85, 0x7b, 0xaa, 0x7c, 0xa7, 0x2a, 0xe3, 0x15, 0x1d, 0xf3, 0x32, 0x4b, 0x05,
+	0x37, 0x47, 0xca, 0xe7, 0x51, 0xdb, 0xd8, 0xf7, 0xf4, 0x2d, 0xad, 0xb3, 0xb0, 0x03, 0x24, 0x5a,
+	0x41, 0xc4, 0xb1, 0x30, 0x77, 0xd5, 0xee, 0x5b, 0xa8, 0xd6, 0x59, 0xd8, 0x01, 0xec, 0x2b, 0xa1,
+	0x93, 0xe3, 0x5a, 0xe0, 0x3f, 0x3f, 0xf2, 0x27, 0x3a, 0x56, 0xff, 0xc4, 0xcd, 0x1d, 0x67, 0xe8,
+	0xee, 0x1d, 0x1e, 0x78, 0x7d, 0x08, 0xfe, 0x7e, 0x6b, 0x1f, 0x04, 0x4f, 0x3e, 0xe8, 0x71, 0x8e,
+	0x75, 0x21, 0xce, 0x8a, 0x50, 0xb6, 0x82, 0xa7, 0xb7, 0x8d, 0x3d, 0xd8, 0x5a, 0x4b, 0x8d, 0x92,
+	0x6b, 0xa9, 0x43, 0xf0, 0xf6, 0x76, 0x6d, 0x91, 0xd5, 0xda, 0x22, 0x3f, 0xd6, 0x16, 0xf9, 0xb2,
+	0xb1, 0x06, 0xab, 0x8d, 0x35, 0xf8, 0xbe, 0xb1, 0x06, 0x1f, 0x0f, 0x97, 0xa9, 0xb8, 0xa8, 0x63,
+	0xef, 0x1c, 0x73, 0xbf, 0x73, 0x3c, 0xc8, 0xa2, 0x98, 0xf7, 0x85, 0x7f, 0x39, 0x3d, 0xf2, 0xaf,
+	0xfb, 0xc0, 0x8a, 0x9b, 0x12, 0x78, 0x3c, 0x56, 0x59, 0x3a, 0xfa, 0x3d, 0x00, 0xd5, 0x0a, 0x04,
+	0xe9, 0xcf, 0x02, 0x00, 0x00,
 }
 
 func (this *FeeToken) Equal(that interface{}) bool {
@@ -168,6 +328,102 @@ func (m *FeeToken) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *DeferredFeeSwap) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeferredFeeSwap) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeferredFeeSwap) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintFeetoken(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Splits != 0 {
+		i = encodeVarintFeetoken(dAtA, i, uint64(m.Splits))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.EpochNumber != 0 {
+		i = encodeVarintFeetoken(dAtA, i, uint64(m.EpochNumber))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.PoolID != 0 {
+		i = encodeVarintFeetoken(dAtA, i, uint64(m.PoolID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintFeetoken(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AutoFeeToken) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AutoFeeToken) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AutoFeeToken) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Routes) > 0 {
+		for iNdEx := len(m.Routes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Routes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFeetoken(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintFeetoken(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintFeetoken(dAtA []byte, offset int, v uint64) int {
 	offset -= sovFeetoken(v)
 	base := offset
@@ -195,6 +451,51 @@ func (m *FeeToken) Size() (n int) {
 	return n
 }
 
+func (m *DeferredFeeSwap) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovFeetoken(uint64(l))
+	}
+	if m.PoolID != 0 {
+		n += 1 + sovFeetoken(uint64(m.PoolID))
+	}
+	if m.EpochNumber != 0 {
+		n += 1 + sovFeetoken(uint64(m.EpochNumber))
+	}
+	if m.Splits != 0 {
+		n += 1 + sovFeetoken(uint64(m.Splits))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovFeetoken(uint64(l))
+	}
+	return n
+}
+
+func (m *AutoFeeToken) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovFeetoken(uint64(l))
+	}
+	if len(m.Routes) > 0 {
+		for _, e := range m.Routes {
+			l = e.Size()
+			n += 1 + l + sovFeetoken(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovFeetoken(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -302,6 +603,293 @@ func (m *FeeToken) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *DeferredFeeSwap) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeetoken
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeferredFeeSwap: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeferredFeeSwap: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeetoken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeetoken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeetoken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolID", wireType)
+			}
+			m.PoolID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeetoken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNumber", wireType)
+			}
+			m.EpochNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeetoken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochNumber |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Splits", wireType)
+			}
+			m.Splits = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeetoken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Splits |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeetoken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeetoken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeetoken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeetoken(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFeetoken
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AutoFeeToken) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeetoken
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AutoFeeToken: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AutoFeeToken: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeetoken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeetoken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeetoken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Routes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeetoken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeetoken
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeetoken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Routes = append(m.Routes, types.SwapAmountInRoute{})
+			if err := m.Routes[len(m.Routes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeetoken(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFeetoken
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipFeetoken(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -1,12 +1,21 @@
 package types
 
-import sdk "github.com/cosmos/cosmos-sdk/types"
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
 
 // DefaultGenesis returns the default txfee genesis state.
 func DefaultGenesis() *GenesisState {
 	return &GenesisState{
-		Basedenom: sdk.DefaultBondDenom,
-		Feetokens: []FeeToken{},
+		Basedenom:        sdk.DefaultBondDenom,
+		Feetokens:        []FeeToken{},
+		Params:           DefaultParams(),
+		DeferredFeeSwaps: []DeferredFeeSwap{},
+		BaseFee:          DefaultParams().MinBaseFee,
+		AutoFeeTokens:    []AutoFeeToken{},
+		FeeSponsors:      []FeeSponsor{},
 	}
 }
 
@@ -26,5 +35,36 @@ func (gs GenesisState) Validate() error {
 		}
 	}
 
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	if gs.BaseFee.IsNil() || gs.BaseFee.IsNegative() {
+		return fmt.Errorf("base fee must be non-negative: %s", gs.BaseFee)
+	}
+
+	for _, deferredFeeSwap := range gs.DeferredFeeSwaps {
+		err := sdk.ValidateDenom(deferredFeeSwap.Denom)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, autoFeeToken := range gs.AutoFeeTokens {
+		err := sdk.ValidateDenom(autoFeeToken.Denom)
+		if err != nil {
+			return err
+		}
+		if len(autoFeeToken.Routes) == 0 {
+			return fmt.Errorf("auto fee token %s has no route", autoFeeToken.Denom)
+		}
+	}
+
+	for _, feeSponsor := range gs.FeeSponsors {
+		if err := feeSponsor.Validate(); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
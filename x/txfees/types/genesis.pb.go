@@ -5,6 +5,7 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	io "io"
@@ -25,8 +26,14 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // GenesisState defines the txfees module's genesis state.
 type GenesisState struct {
-	Basedenom string     `protobuf:"bytes,1,opt,name=basedenom,proto3" json:"basedenom,omitempty"`
-	Feetokens []FeeToken `protobuf:"bytes,2,rep,name=feetokens,proto3" json:"feetokens"`
+	Basedenom        string            `protobuf:"bytes,1,opt,name=basedenom,proto3" json:"basedenom,omitempty"`
+	Feetokens        []FeeToken        `protobuf:"bytes,2,rep,name=feetokens,proto3" json:"feetokens"`
+	Params           Params            `protobuf:"bytes,3,opt,name=params,proto3" json:"params"`
+	DeferredFeeSwaps []DeferredFeeSwap `protobuf:"bytes,4,rep,name=deferred_fee_swaps,json=deferredFeeSwaps,proto3" json:"deferred_fee_swaps"`
+	// base_fee is the current base fee, in base denom per unit of gas.
+	BaseFee       github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=base_fee,json=baseFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"base_fee"`
+	AutoFeeTokens []AutoFeeToken                         `protobuf:"bytes,6,rep,name=auto_fee_tokens,json=autoFeeTokens,proto3" json:"auto_fee_tokens"`
+	FeeSponsors   []FeeSponsor                           `protobuf:"bytes,7,rep,name=fee_sponsors,json=feeSponsors,proto3" json:"fee_sponsors"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -76,6 +83,34 @@ func (m *GenesisState) GetFeetokens() []FeeToken {
 	return nil
 }
 
+func (m *GenesisState) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+func (m *GenesisState) GetDeferredFeeSwaps() []DeferredFeeSwap {
+	if m != nil {
+		return m.DeferredFeeSwaps
+	}
+	return nil
+}
+
+func (m *GenesisState) GetAutoFeeTokens() []AutoFeeToken {
+	if m != nil {
+		return m.AutoFeeTokens
+	}
+	return nil
+}
+
+func (m *GenesisState) GetFeeSponsors() []FeeSponsor {
+	if m != nil {
+		return m.FeeSponsors
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "osmosis.txfees.v1beta1.GenesisState")
 }
@@ -85,22 +120,33 @@ func init() {
 }
 
 var fileDescriptor_4423c18e3d020b37 = []byte{
-	// 236 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0xc9, 0x2f, 0xce, 0xcd,
-	0x2f, 0xce, 0x2c, 0xd6, 0x2f, 0xa9, 0x48, 0x4b, 0x4d, 0x2d, 0xd6, 0x2f, 0x33, 0x4c, 0x4a, 0x2d,
-	0x49, 0x34, 0xd4, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9,
-	0x17, 0x12, 0x83, 0xaa, 0xd2, 0x83, 0xa8, 0xd2, 0x83, 0xaa, 0x92, 0x12, 0x49, 0xcf, 0x4f, 0xcf,
-	0x07, 0x2b, 0xd1, 0x07, 0xb1, 0x20, 0xaa, 0xa5, 0x54, 0x71, 0x98, 0x99, 0x96, 0x9a, 0x5a, 0x92,
-	0x9f, 0x9d, 0x9a, 0x07, 0x51, 0xa6, 0x54, 0xc4, 0xc5, 0xe3, 0x0e, 0xb1, 0x25, 0xb8, 0x24, 0xb1,
-	0x24, 0x55, 0x48, 0x86, 0x8b, 0x33, 0x29, 0xb1, 0x38, 0x35, 0x25, 0x35, 0x2f, 0x3f, 0x57, 0x82,
-	0x51, 0x81, 0x51, 0x83, 0x33, 0x08, 0x21, 0x20, 0xe4, 0xc2, 0xc5, 0x09, 0xd3, 0x5f, 0x2c, 0xc1,
-	0xa4, 0xc0, 0xac, 0xc1, 0x6d, 0xa4, 0xa0, 0x87, 0xdd, 0x59, 0x7a, 0x6e, 0xa9, 0xa9, 0x21, 0x20,
-	0x85, 0x4e, 0x2c, 0x27, 0xee, 0xc9, 0x33, 0x04, 0x21, 0x34, 0x3a, 0xf9, 0x9c, 0x78, 0x24, 0xc7,
-	0x78, 0xe1, 0x91, 0x1c, 0xe3, 0x83, 0x47, 0x72, 0x8c, 0x13, 0x1e, 0xcb, 0x31, 0x5c, 0x78, 0x2c,
-	0xc7, 0x70, 0xe3, 0xb1, 0x1c, 0x43, 0x94, 0x51, 0x7a, 0x66, 0x49, 0x46, 0x69, 0x92, 0x5e, 0x72,
-	0x7e, 0xae, 0x3e, 0xd4, 0x58, 0xdd, 0x9c, 0xc4, 0xa4, 0x62, 0x18, 0x47, 0xbf, 0xcc, 0xd0, 0x58,
-	0xbf, 0x02, 0xe6, 0xa5, 0x92, 0xca, 0x82, 0xd4, 0xe2, 0x24, 0x36, 0xb0, 0x47, 0x8c, 0x01, 0x01,
-	0x00, 0x00, 0xff, 0xff, 0xbd, 0xfa, 0x3d, 0x95, 0x45, 0x01, 0x00, 0x00,
+	// 414 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x92, 0x4f, 0x8b, 0xd3, 0x40,
+	0x18, 0xc6, 0x13, 0xbb, 0x76, 0xed, 0x74, 0x45, 0x19, 0x44, 0xc2, 0x22, 0xd9, 0xb0, 0xae, 0x9a,
+	0xcb, 0xce, 0xd0, 0xee, 0xd5, 0x8b, 0xa5, 0x54, 0x44, 0x0f, 0x92, 0x7a, 0xd2, 0x43, 0x99, 0x34,
+	0x6f, 0x62, 0xa9, 0xc9, 0x84, 0xbc, 0xd3, 0x3f, 0x7e, 0x0b, 0x3f, 0x56, 0x8f, 0x3d, 0x8a, 0x87,
+	0x22, 0xed, 0xa7, 0xf0, 0x26, 0x99, 0x4c, 0x6d, 0x15, 0xb3, 0xa7, 0x24, 0x2f, 0xbf, 0xe7, 0xc9,
+	0xf3, 0xcc, 0xbc, 0xe4, 0x4a, 0x62, 0x2a, 0x71, 0x82, 0x5c, 0x2d, 0x63, 0x00, 0xe4, 0xf3, 0x4e,
+	0x08, 0x4a, 0x74, 0x78, 0x02, 0x19, 0xe0, 0x04, 0x59, 0x5e, 0x48, 0x25, 0xe9, 0x63, 0x43, 0xb1,
+	0x8a, 0x62, 0x86, 0x3a, 0x7f, 0x94, 0xc8, 0x44, 0x6a, 0x84, 0x97, 0x6f, 0x15, 0x7d, 0xfe, 0xac,
+	0xc6, 0x33, 0x06, 0x50, 0x72, 0x0a, 0x99, 0xc1, 0xfc, 0x7a, 0x6c, 0x84, 0xb9, 0xcc, 0x50, 0x16,
+	0x86, 0x7c, 0x5a, 0x43, 0xe6, 0xa2, 0x10, 0xa9, 0xc9, 0x78, 0xf9, 0xab, 0x41, 0xce, 0x5e, 0x57,
+	0xa9, 0x87, 0x4a, 0x28, 0xa0, 0x4f, 0x48, 0x2b, 0x14, 0x08, 0x11, 0x64, 0x32, 0x75, 0x6c, 0xcf,
+	0xf6, 0x5b, 0xc1, 0x61, 0x40, 0xfb, 0xa4, 0xb5, 0xcf, 0x83, 0xce, 0x1d, 0xaf, 0xe1, 0xb7, 0xbb,
+	0x1e, 0xfb, 0x7f, 0x4d, 0x36, 0x00, 0xf8, 0x50, 0x82, 0xbd, 0x93, 0xd5, 0xe6, 0xc2, 0x0a, 0x0e,
+	0x42, 0xfa, 0x92, 0x34, 0xab, 0x10, 0x4e, 0xc3, 0xb3, 0xfd, 0x76, 0xd7, 0xad, 0xb3, 0x78, 0xaf,
+	0x29, 0x63, 0x60, 0x34, 0xf4, 0x13, 0xa1, 0x11, 0xc4, 0x50, 0x14, 0x10, 0x8d, 0x74, 0xeb, 0x85,
+	0xc8, 0xd1, 0x39, 0xd1, 0x61, 0x5e, 0xd4, 0x39, 0xf5, 0x8d, 0x62, 0x00, 0x30, 0x5c, 0x88, 0xdc,
+	0x58, 0x3e, 0x8c, 0xfe, 0x1e, 0x23, 0x7d, 0x43, 0xee, 0x95, 0x6d, 0x4b, 0x63, 0xe7, 0x6e, 0xd9,
+	0xbe, 0xc7, 0x4a, 0xf2, 0xc7, 0xe6, 0xe2, 0x79, 0x32, 0x51, 0x9f, 0x67, 0x21, 0x1b, 0xcb, 0x94,
+	0x8f, 0xf5, 0x5f, 0xcc, 0xe3, 0x1a, 0xa3, 0x29, 0x57, 0x5f, 0x73, 0x40, 0xd6, 0x87, 0x71, 0x70,
+	0x5a, 0xea, 0x07, 0x00, 0x34, 0x20, 0x0f, 0xc4, 0x4c, 0x49, 0x9d, 0xd1, 0x9c, 0x58, 0x53, 0x87,
+	0xbc, 0xaa, 0x0b, 0xf9, 0x6a, 0xa6, 0xe4, 0x3f, 0xa7, 0x76, 0x5f, 0x1c, 0xcd, 0x90, 0xbe, 0x25,
+	0x67, 0x47, 0x17, 0x8d, 0xce, 0xa9, 0x36, 0xbc, 0xbc, 0xe5, 0x0a, 0x86, 0x15, 0x6a, 0xec, 0xda,
+	0xf1, 0x9f, 0x09, 0xf6, 0xde, 0xad, 0xb6, 0xae, 0xbd, 0xde, 0xba, 0xf6, 0xcf, 0xad, 0x6b, 0x7f,
+	0xdb, 0xb9, 0xd6, 0x7a, 0xe7, 0x5a, 0xdf, 0x77, 0xae, 0xf5, 0xb1, 0x7b, 0xd4, 0xd5, 0x58, 0x5f,
+	0x7f, 0x11, 0x21, 0xee, 0x3f, 0xf8, 0xbc, 0x73, 0xc3, 0x97, 0xfb, 0xc5, 0xd2, 0xdd, 0xc3, 0xa6,
+	0x5e, 0xa8, 0x9b, 0xdf, 0x03, 0x00, 0x94, 0xda, 0x17, 0xcf, 0x1c, 0x03, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -123,6 +169,68 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.FeeSponsors) > 0 {
+		for iNdEx := len(m.FeeSponsors) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeeSponsors[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.AutoFeeTokens) > 0 {
+		for iNdEx := len(m.AutoFeeTokens) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AutoFeeTokens[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	{
+		size := m.BaseFee.Size()
+		i -= size
+		if _, err := m.BaseFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.DeferredFeeSwaps) > 0 {
+		for iNdEx := len(m.DeferredFeeSwaps) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DeferredFeeSwaps[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
 	if len(m.Feetokens) > 0 {
 		for iNdEx := len(m.Feetokens) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -174,6 +282,28 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.DeferredFeeSwaps) > 0 {
+		for _, e := range m.DeferredFeeSwaps {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = m.BaseFee.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.AutoFeeTokens) > 0 {
+		for _, e := range m.AutoFeeTokens {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.FeeSponsors) > 0 {
+		for _, e := range m.FeeSponsors {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -278,6 +408,175 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeferredFeeSwaps", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeferredFeeSwaps = append(m.DeferredFeeSwaps, DeferredFeeSwap{})
+			if err := m.DeferredFeeSwaps[len(m.DeferredFeeSwaps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BaseFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoFeeTokens", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AutoFeeTokens = append(m.AutoFeeTokens, AutoFeeToken{})
+			if err := m.AutoFeeTokens[len(m.AutoFeeTokens)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeSponsors", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeSponsors = append(m.FeeSponsors, FeeSponsor{})
+			if err := m.FeeSponsors[len(m.FeeSponsors)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
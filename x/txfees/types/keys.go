@@ -18,9 +18,38 @@ const (
 
 	// QuerierRoute defines the module's query routing key
 	QuerierRoute = ModuleName
+
+	// MaxDeferredFeeSwapSplits is the maximum number of times the amount of a deferred
+	// fee swap is halved after failed retries, i.e. it is retried in chunks of at least
+	// 1/2^MaxDeferredFeeSwapSplits of the fee token balance. Once a retry of that size fails,
+	// the swap is no longer retried until the end of the next epoch.
+	MaxDeferredFeeSwapSplits = 10
+
+	// MaxAutoFeeTokenRouteHops is the maximum number of pools in the route of an auto fee token.
+	// Every hop adds a twap computation to pricing the fees of each tx.
+	MaxAutoFeeTokenRouteHops = 2
+
+	// MaxAutoFeeTokenCandidates is the maximum number of denoms whose route to the base denom
+	// is searched when the auto fee tokens are recomputed.
+	MaxAutoFeeTokenCandidates = 100
+
+	// MaxFeeSponsorApprovalGas is the maximum gas a fee sponsor contract may consume to approve a tx.
+	// It is charged to the tx, and bounds the work done for txs the contract rejects,
+	// which pay no fees.
+	MaxFeeSponsorApprovalGas = 200_000
 )
 
 var (
 	BaseDenomKey         = []byte("base_denom")
 	FeeTokensStorePrefix = []byte("fee_tokens")
+	CurrentBaseFeeKey    = []byte("current_base_fee")
+
+	// DeferredFeeSwapsStorePrefix is the prefix of the deferred fee swaps, keyed by fee token denom.
+	DeferredFeeSwapsStorePrefix = []byte("deferred_fee_swaps")
+
+	// AutoFeeTokensStorePrefix is the prefix of the auto fee tokens, keyed by denom.
+	AutoFeeTokensStorePrefix = []byte("auto_fee_tokens")
+
+	// FeeSponsorsStorePrefix is the prefix of the fee sponsors, keyed by contract address.
+	FeeSponsorsStorePrefix = []byte("fee_sponsors")
 )
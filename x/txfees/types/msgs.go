@@ -0,0 +1,88 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// constants
+const (
+	TypeMsgRegisterFeeSponsor   = "register_fee_sponsor"
+	TypeMsgUnregisterFeeSponsor = "unregister_fee_sponsor"
+)
+
+var _ sdk.Msg = &MsgRegisterFeeSponsor{}
+
+// NewMsgRegisterFeeSponsor creates a message to register a contract as a fee sponsor.
+func NewMsgRegisterFeeSponsor(sender, contractAddress string, msgTypeUrls, addresses []string) *MsgRegisterFeeSponsor {
+	return &MsgRegisterFeeSponsor{
+		Sender:          sender,
+		ContractAddress: contractAddress,
+		MsgTypeUrls:     msgTypeUrls,
+		Addresses:       addresses,
+	}
+}
+
+func (m MsgRegisterFeeSponsor) Route() string { return RouterKey }
+func (m MsgRegisterFeeSponsor) Type() string  { return TypeMsgRegisterFeeSponsor }
+func (m MsgRegisterFeeSponsor) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	return m.FeeSponsor().Validate()
+}
+
+func (m MsgRegisterFeeSponsor) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgRegisterFeeSponsor) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+// FeeSponsor returns the fee sponsor registered by the message.
+func (m MsgRegisterFeeSponsor) FeeSponsor() FeeSponsor {
+	return FeeSponsor{
+		ContractAddress: m.ContractAddress,
+		MsgTypeUrls:     m.MsgTypeUrls,
+		Addresses:       m.Addresses,
+	}
+}
+
+var _ sdk.Msg = &MsgUnregisterFeeSponsor{}
+
+// NewMsgUnregisterFeeSponsor creates a message to remove a contract from the fee sponsors.
+func NewMsgUnregisterFeeSponsor(sender, contractAddress string) *MsgUnregisterFeeSponsor {
+	return &MsgUnregisterFeeSponsor{
+		Sender:          sender,
+		ContractAddress: contractAddress,
+	}
+}
+
+func (m MsgUnregisterFeeSponsor) Route() string { return RouterKey }
+func (m MsgUnregisterFeeSponsor) Type() string  { return TypeMsgUnregisterFeeSponsor }
+func (m MsgUnregisterFeeSponsor) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	_, err = sdk.AccAddressFromBech32(m.ContractAddress)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid contract address (%s)", err)
+	}
+
+	return nil
+}
+
+func (m MsgUnregisterFeeSponsor) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgUnregisterFeeSponsor) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{sender}
+}
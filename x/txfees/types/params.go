@@ -0,0 +1,259 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	epochtypes "github.com/osmosis-labs/osmosis/v13/x/epochs/types"
+)
+
+// Parameter store keys.
+var (
+	KeyFeeSwapTwapWindow            = []byte("FeeSwapTwapWindow")
+	KeyMaxFeeSwapPriceDeviation     = []byte("MaxFeeSwapPriceDeviation")
+	KeyBaseFeeEnabled               = []byte("BaseFeeEnabled")
+	KeyMinBaseFee                   = []byte("MinBaseFee")
+	KeyMaxBaseFee                   = []byte("MaxBaseFee")
+	KeyTargetBlockGas               = []byte("TargetBlockGas")
+	KeyMaxBaseFeeChangeRate         = []byte("MaxBaseFeeChangeRate")
+	KeyAutoFeeTokensEnabled         = []byte("AutoFeeTokensEnabled")
+	KeyMinAutoFeeTokenLiquidity     = []byte("MinAutoFeeTokenLiquidity")
+	KeyAutoFeeTokensEpochIdentifier = []byte("AutoFeeTokensEpochIdentifier")
+
+	_ paramtypes.ParamSet = &Params{}
+)
+
+const (
+	defaultFeeSwapTwapWindow = time.Hour
+	defaultTargetBlockGas    = 60_000_000
+	// defaultAutoFeeTokensEpochIdentifier recomputes the auto fee tokens once a day.
+	defaultAutoFeeTokensEpochIdentifier = "day"
+)
+
+var (
+	// defaultMaxFeeSwapPriceDeviation allows a fee token swap to return up to 5% less
+	// than the fee tokens are worth at the TWAP price.
+	defaultMaxFeeSwapPriceDeviation = sdk.NewDecWithPrec(5, 2)
+
+	defaultMinBaseFee = sdk.NewDecWithPrec(25, 4)
+	defaultMaxBaseFee = sdk.NewDec(5)
+	// defaultMaxBaseFeeChangeRate lets the base fee change by at most 12.5% per block, as in EIP-1559.
+	defaultMaxBaseFeeChangeRate = sdk.NewDecWithPrec(125, 3)
+
+	// defaultMinAutoFeeTokenLiquidity requires each pool of the route of an auto fee token
+	// to hold 100,000 OSMO worth of the route's token out.
+	defaultMinAutoFeeTokenLiquidity = sdk.NewInt(100_000_000_000)
+)
+
+// ParamTable for txfees module.
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+func NewParams(
+	feeSwapTwapWindow time.Duration,
+	maxFeeSwapPriceDeviation sdk.Dec,
+	baseFeeEnabled bool,
+	minBaseFee sdk.Dec,
+	maxBaseFee sdk.Dec,
+	targetBlockGas uint64,
+	maxBaseFeeChangeRate sdk.Dec,
+	autoFeeTokensEnabled bool,
+	minAutoFeeTokenLiquidity sdk.Int,
+	autoFeeTokensEpochIdentifier string,
+) Params {
+	return Params{
+		FeeSwapTwapWindow:            feeSwapTwapWindow,
+		MaxFeeSwapPriceDeviation:     maxFeeSwapPriceDeviation,
+		BaseFeeEnabled:               baseFeeEnabled,
+		MinBaseFee:                   minBaseFee,
+		MaxBaseFee:                   maxBaseFee,
+		TargetBlockGas:               targetBlockGas,
+		MaxBaseFeeChangeRate:         maxBaseFeeChangeRate,
+		AutoFeeTokensEnabled:         autoFeeTokensEnabled,
+		MinAutoFeeTokenLiquidity:     minAutoFeeTokenLiquidity,
+		AutoFeeTokensEpochIdentifier: autoFeeTokensEpochIdentifier,
+	}
+}
+
+// default txfees module parameters.
+// The base fee is disabled by default, leaving the minimum gas price to the node configuration.
+// Auto fee tokens are disabled by default, leaving fee tokens to governance.
+func DefaultParams() Params {
+	return Params{
+		FeeSwapTwapWindow:            defaultFeeSwapTwapWindow,
+		MaxFeeSwapPriceDeviation:     defaultMaxFeeSwapPriceDeviation,
+		BaseFeeEnabled:               false,
+		MinBaseFee:                   defaultMinBaseFee,
+		MaxBaseFee:                   defaultMaxBaseFee,
+		TargetBlockGas:               defaultTargetBlockGas,
+		MaxBaseFeeChangeRate:         defaultMaxBaseFeeChangeRate,
+		AutoFeeTokensEnabled:         false,
+		MinAutoFeeTokenLiquidity:     defaultMinAutoFeeTokenLiquidity,
+		AutoFeeTokensEpochIdentifier: defaultAutoFeeTokensEpochIdentifier,
+	}
+}
+
+// validate params.
+func (p Params) Validate() error {
+	if err := validateFeeSwapTwapWindow(p.FeeSwapTwapWindow); err != nil {
+		return err
+	}
+
+	if err := validateMaxFeeSwapPriceDeviation(p.MaxFeeSwapPriceDeviation); err != nil {
+		return err
+	}
+
+	if err := validateBaseFeeEnabled(p.BaseFeeEnabled); err != nil {
+		return err
+	}
+
+	if err := validateBaseFee(p.MinBaseFee); err != nil {
+		return err
+	}
+
+	if err := validateBaseFee(p.MaxBaseFee); err != nil {
+		return err
+	}
+
+	if p.MaxBaseFee.LT(p.MinBaseFee) {
+		return fmt.Errorf("max base fee (%s) must not be less than min base fee (%s)", p.MaxBaseFee, p.MinBaseFee)
+	}
+
+	if err := validateTargetBlockGas(p.TargetBlockGas); err != nil {
+		return err
+	}
+
+	if err := validateMaxBaseFeeChangeRate(p.MaxBaseFeeChangeRate); err != nil {
+		return err
+	}
+
+	if err := validateAutoFeeTokensEnabled(p.AutoFeeTokensEnabled); err != nil {
+		return err
+	}
+
+	if err := validateMinAutoFeeTokenLiquidity(p.MinAutoFeeTokenLiquidity); err != nil {
+		return err
+	}
+
+	if err := epochtypes.ValidateEpochIdentifierInterface(p.AutoFeeTokensEpochIdentifier); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Implements params.ParamSet.
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyFeeSwapTwapWindow, &p.FeeSwapTwapWindow, validateFeeSwapTwapWindow),
+		paramtypes.NewParamSetPair(KeyMaxFeeSwapPriceDeviation, &p.MaxFeeSwapPriceDeviation, validateMaxFeeSwapPriceDeviation),
+		paramtypes.NewParamSetPair(KeyBaseFeeEnabled, &p.BaseFeeEnabled, validateBaseFeeEnabled),
+		paramtypes.NewParamSetPair(KeyMinBaseFee, &p.MinBaseFee, validateBaseFee),
+		paramtypes.NewParamSetPair(KeyMaxBaseFee, &p.MaxBaseFee, validateBaseFee),
+		paramtypes.NewParamSetPair(KeyTargetBlockGas, &p.TargetBlockGas, validateTargetBlockGas),
+		paramtypes.NewParamSetPair(KeyMaxBaseFeeChangeRate, &p.MaxBaseFeeChangeRate, validateMaxBaseFeeChangeRate),
+		paramtypes.NewParamSetPair(KeyAutoFeeTokensEnabled, &p.AutoFeeTokensEnabled, validateAutoFeeTokensEnabled),
+		paramtypes.NewParamSetPair(KeyMinAutoFeeTokenLiquidity, &p.MinAutoFeeTokenLiquidity, validateMinAutoFeeTokenLiquidity),
+		paramtypes.NewParamSetPair(KeyAutoFeeTokensEpochIdentifier, &p.AutoFeeTokensEpochIdentifier, epochtypes.ValidateEpochIdentifierInterface),
+	}
+}
+
+func validateFeeSwapTwapWindow(i interface{}) error {
+	v, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v <= 0 {
+		return fmt.Errorf("fee swap twap window must be positive: %d", v)
+	}
+
+	return nil
+}
+
+func validateMaxFeeSwapPriceDeviation(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() || v.IsNegative() || v.GTE(sdk.OneDec()) {
+		return fmt.Errorf("max fee swap price deviation must be in [0, 1): %s", v)
+	}
+
+	return nil
+}
+
+func validateBaseFeeEnabled(i interface{}) error {
+	_, ok := i.(bool)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validateBaseFee(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() || v.IsNegative() {
+		return fmt.Errorf("base fee must be non-negative: %s", v)
+	}
+
+	return nil
+}
+
+func validateTargetBlockGas(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 {
+		return fmt.Errorf("target block gas must be positive: %d", v)
+	}
+
+	return nil
+}
+
+func validateMaxBaseFeeChangeRate(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() || !v.IsPositive() || v.GT(sdk.OneDec()) {
+		return fmt.Errorf("max base fee change rate must be in (0, 1]: %s", v)
+	}
+
+	return nil
+}
+
+func validateAutoFeeTokensEnabled(i interface{}) error {
+	_, ok := i.(bool)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validateMinAutoFeeTokenLiquidity(i interface{}) error {
+	v, ok := i.(sdk.Int)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() || v.IsNegative() {
+		return fmt.Errorf("min auto fee token liquidity must be non-negative: %s", v)
+	}
+
+	return nil
+}
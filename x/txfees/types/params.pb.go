@@ -0,0 +1,776 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: osmosis/txfees/v1beta1/params.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	_ "github.com/gogo/protobuf/types"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Params holds parameters for the txfees module
+type Params struct {
+	// fee_swap_twap_window is the window of the arithmetic TWAP that bounds the
+	// minimum amount out of the epoch swaps of non-native fee tokens, and that
+	// prices auto fee tokens.
+	FeeSwapTwapWindow time.Duration `protobuf:"bytes,1,opt,name=fee_swap_twap_window,json=feeSwapTwapWindow,proto3,stdduration" json:"fee_swap_twap_window" yaml:"fee_swap_twap_window"`
+	// max_fee_swap_price_deviation is the maximum fraction by which the amount
+	// out of a fee token swap may fall short of its value at the TWAP price.
+	MaxFeeSwapPriceDeviation github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=max_fee_swap_price_deviation,json=maxFeeSwapPriceDeviation,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_fee_swap_price_deviation" yaml:"max_fee_swap_price_deviation"`
+	// base_fee_enabled sets whether the on-chain base fee is adjusted every
+	// block and enforced in both CheckTx and DeliverTx.
+	BaseFeeEnabled bool `protobuf:"varint,3,opt,name=base_fee_enabled,json=baseFeeEnabled,proto3" json:"base_fee_enabled,omitempty" yaml:"base_fee_enabled"`
+	// min_base_fee is the lowest base fee, in base denom per unit of gas.
+	MinBaseFee github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=min_base_fee,json=minBaseFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_base_fee" yaml:"min_base_fee"`
+	// max_base_fee is the highest base fee, in base denom per unit of gas.
+	MaxBaseFee github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=max_base_fee,json=maxBaseFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_base_fee" yaml:"max_base_fee"`
+	// target_block_gas is the gas used by a block at which the base fee is left
+	// unchanged. The base fee increases after blocks using more gas, and
+	// decreases after blocks using less.
+	TargetBlockGas uint64 `protobuf:"varint,6,opt,name=target_block_gas,json=targetBlockGas,proto3" json:"target_block_gas,omitempty" yaml:"target_block_gas"`
+	// max_base_fee_change_rate is the largest fraction by which the base fee
+	// changes after a single block, reached when a block uses either no gas or
+	// twice the target_block_gas.
+	MaxBaseFeeChangeRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=max_base_fee_change_rate,json=maxBaseFeeChangeRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_base_fee_change_rate" yaml:"max_base_fee_change_rate"`
+	// auto_fee_tokens_enabled sets whether denoms that are not whitelisted by
+	// governance, but have a liquid route to the base denom, can pay tx fees.
+	AutoFeeTokensEnabled bool `protobuf:"varint,8,opt,name=auto_fee_tokens_enabled,json=autoFeeTokensEnabled,proto3" json:"auto_fee_tokens_enabled,omitempty" yaml:"auto_fee_tokens_enabled"`
+	// min_auto_fee_token_liquidity is the minimum value, in base denom, of the
+	// reserves of the token out of each pool of the route of an auto fee token.
+	MinAutoFeeTokenLiquidity github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,9,opt,name=min_auto_fee_token_liquidity,json=minAutoFeeTokenLiquidity,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"min_auto_fee_token_liquidity" yaml:"min_auto_fee_token_liquidity"`
+	// auto_fee_tokens_epoch_identifier is the identifier of the epoch at the end
+	// of which the auto fee tokens are recomputed.
+	AutoFeeTokensEpochIdentifier string `protobuf:"bytes,10,opt,name=auto_fee_tokens_epoch_identifier,json=autoFeeTokensEpochIdentifier,proto3" json:"auto_fee_tokens_epoch_identifier,omitempty" yaml:"auto_fee_tokens_epoch_identifier"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fcbfbe8e37bb08e6, []int{0}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetFeeSwapTwapWindow() time.Duration {
+	if m != nil {
+		return m.FeeSwapTwapWindow
+	}
+	return 0
+}
+
+func (m *Params) GetBaseFeeEnabled() bool {
+	if m != nil {
+		return m.BaseFeeEnabled
+	}
+	return false
+}
+
+func (m *Params) GetTargetBlockGas() uint64 {
+	if m != nil {
+		return m.TargetBlockGas
+	}
+	return 0
+}
+
+func (m *Params) GetAutoFeeTokensEnabled() bool {
+	if m != nil {
+		return m.AutoFeeTokensEnabled
+	}
+	return false
+}
+
+func (m *Params) GetAutoFeeTokensEpochIdentifier() string {
+	if m != nil {
+		return m.AutoFeeTokensEpochIdentifier
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*Params)(nil), "osmosis.txfees.v1beta1.Params")
+}
+
+func init() {
+	proto.RegisterFile("osmosis/txfees/v1beta1/params.proto", fileDescriptor_fcbfbe8e37bb08e6)
+}
+
+var fileDescriptor_fcbfbe8e37bb08e6 = []byte{
+	// 615 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x54, 0xc1, 0x4f, 0xd4, 0x4e,
+	0x14, 0xde, 0xfe, 0x7e, 0x88, 0x30, 0x1a, 0x82, 0x75, 0x23, 0x15, 0x48, 0xbb, 0x29, 0x89, 0x6c,
+	0x62, 0x68, 0x03, 0xdc, 0xbc, 0xb9, 0xb2, 0x18, 0x12, 0x0e, 0x58, 0x31, 0x46, 0x2f, 0x93, 0x69,
+	0xfb, 0xb6, 0x4c, 0x68, 0x3b, 0xb5, 0x33, 0xcb, 0x96, 0x3f, 0xc1, 0x9b, 0x17, 0x13, 0xff, 0x24,
+	0x8e, 0x1c, 0x8d, 0x87, 0x6a, 0xe0, 0xe4, 0x75, 0xff, 0x02, 0xd3, 0x69, 0x0b, 0x05, 0x84, 0x64,
+	0xe3, 0x65, 0xb7, 0xf3, 0xde, 0xf7, 0xbe, 0xef, 0x7d, 0x6f, 0x5e, 0x06, 0xad, 0x30, 0x1e, 0x31,
+	0x4e, 0xb9, 0x2d, 0xb2, 0x01, 0x00, 0xb7, 0x8f, 0xd6, 0x5d, 0x10, 0x64, 0xdd, 0x4e, 0x48, 0x4a,
+	0x22, 0x6e, 0x25, 0x29, 0x13, 0x4c, 0x7d, 0x52, 0x81, 0xac, 0x12, 0x64, 0x55, 0xa0, 0xc5, 0x76,
+	0xc0, 0x02, 0x26, 0x21, 0x76, 0xf1, 0x55, 0xa2, 0x17, 0xf5, 0x80, 0xb1, 0x20, 0x04, 0x5b, 0x9e,
+	0xdc, 0xe1, 0xc0, 0xf6, 0x87, 0x29, 0x11, 0x94, 0xc5, 0x65, 0xde, 0xfc, 0x3d, 0x83, 0xa6, 0xf7,
+	0x24, 0xbd, 0xca, 0x51, 0x7b, 0x00, 0x80, 0xf9, 0x88, 0x24, 0x58, 0x14, 0x3f, 0x23, 0x1a, 0xfb,
+	0x6c, 0xa4, 0x29, 0x1d, 0xa5, 0xfb, 0x60, 0xe3, 0xa9, 0x55, 0x32, 0x59, 0x35, 0x93, 0xb5, 0x55,
+	0x31, 0xf5, 0x56, 0x4f, 0x72, 0xa3, 0x35, 0xce, 0x8d, 0xa5, 0x63, 0x12, 0x85, 0x2f, 0xcc, 0xbf,
+	0x91, 0x98, 0xdf, 0x7e, 0x1a, 0x8a, 0xf3, 0x68, 0x00, 0xf0, 0x76, 0x44, 0x92, 0xfd, 0x11, 0x49,
+	0xde, 0xcb, 0xb8, 0xfa, 0x55, 0x41, 0xcb, 0x11, 0xc9, 0xf0, 0x45, 0x51, 0x92, 0x52, 0x0f, 0xb0,
+	0x0f, 0x47, 0x54, 0x92, 0x6b, 0xff, 0x75, 0x94, 0xee, 0x6c, 0xef, 0x5d, 0x21, 0xf1, 0x23, 0x37,
+	0x9e, 0x05, 0x54, 0x1c, 0x0c, 0x5d, 0xcb, 0x63, 0x91, 0xed, 0xc9, 0x41, 0x54, 0x7f, 0x6b, 0xdc,
+	0x3f, 0xb4, 0xc5, 0x71, 0x02, 0xdc, 0xda, 0x02, 0x6f, 0x9c, 0x1b, 0x2b, 0x65, 0x33, 0x77, 0x71,
+	0x9b, 0x8e, 0x16, 0x91, 0x6c, 0xbb, 0xec, 0x69, 0xaf, 0xc8, 0x6d, 0xd5, 0x29, 0xb5, 0x8f, 0xe6,
+	0x5d, 0xc2, 0x41, 0xd6, 0x42, 0x4c, 0xdc, 0x10, 0x7c, 0xed, 0xff, 0x8e, 0xd2, 0x9d, 0xe9, 0x2d,
+	0x8d, 0x73, 0x63, 0xa1, 0x24, 0xbf, 0x8e, 0x30, 0x9d, 0xb9, 0x22, 0xb4, 0x0d, 0xd0, 0x2f, 0x03,
+	0x6a, 0x80, 0x1e, 0x46, 0x34, 0xc6, 0x35, 0x50, 0x9b, 0x92, 0x6e, 0xfa, 0x13, 0xbb, 0x79, 0x5c,
+	0xb9, 0x69, 0x70, 0x99, 0x0e, 0x8a, 0x68, 0xdc, 0x2b, 0xf5, 0xa4, 0x10, 0xc9, 0x2e, 0x85, 0xee,
+	0xfd, 0xa3, 0x10, 0xc9, 0xae, 0x08, 0x91, 0xac, 0x16, 0xea, 0xa3, 0x79, 0x41, 0xd2, 0x00, 0x04,
+	0x76, 0x43, 0xe6, 0x1d, 0xe2, 0x80, 0x70, 0x6d, 0xba, 0xa3, 0x74, 0xa7, 0x9a, 0x83, 0xb9, 0x8e,
+	0x30, 0x9d, 0xb9, 0x32, 0xd4, 0x2b, 0x22, 0xaf, 0x09, 0x57, 0x3f, 0x2b, 0x48, 0x6b, 0x8a, 0x60,
+	0xef, 0x80, 0xc4, 0x01, 0xe0, 0x94, 0x08, 0xd0, 0xee, 0xcb, 0xe6, 0xdf, 0x4c, 0xdc, 0xbc, 0x71,
+	0xb3, 0xf9, 0x26, 0xaf, 0xe9, 0xb4, 0x2f, 0x8d, 0xbc, 0x92, 0x71, 0x87, 0x08, 0x50, 0x3f, 0xa0,
+	0x05, 0x32, 0x14, 0x4c, 0xc2, 0x05, 0x3b, 0x84, 0x98, 0x5f, 0x5c, 0xf9, 0x8c, 0xbc, 0x72, 0x73,
+	0x9c, 0x1b, 0x7a, 0xc9, 0x7d, 0x0b, 0xd0, 0x74, 0xda, 0x45, 0x66, 0x1b, 0x60, 0x5f, 0xc6, 0xeb,
+	0xfb, 0x97, 0xeb, 0x4d, 0x63, 0x7c, 0xb5, 0x0c, 0x87, 0xf4, 0xd3, 0x90, 0xfa, 0x54, 0x1c, 0x6b,
+	0xb3, 0x13, 0xaf, 0xf7, 0x4e, 0x2c, 0x1a, 0xeb, 0x7d, 0x07, 0x77, 0xb1, 0xde, 0x34, 0x7e, 0xd9,
+	0x68, 0x6b, 0xb7, 0x4e, 0xa9, 0x1c, 0x75, 0x6e, 0x38, 0x49, 0x98, 0x77, 0x80, 0xa9, 0x0f, 0xb1,
+	0xa0, 0x03, 0x0a, 0xa9, 0x86, 0x64, 0x6b, 0xcf, 0xc7, 0xb9, 0xb1, 0x7a, 0x8b, 0xf7, 0x6b, 0x15,
+	0xa6, 0xb3, 0x7c, 0x75, 0x08, 0x45, 0x7e, 0xe7, 0x22, 0xdd, 0xdb, 0x3d, 0x39, 0xd3, 0x95, 0xd3,
+	0x33, 0x5d, 0xf9, 0x75, 0xa6, 0x2b, 0x5f, 0xce, 0xf5, 0xd6, 0xe9, 0xb9, 0xde, 0xfa, 0x7e, 0xae,
+	0xb7, 0x3e, 0x6e, 0x34, 0x7c, 0x57, 0xcf, 0xdb, 0x5a, 0x48, 0x5c, 0x5e, 0x1f, 0xec, 0xa3, 0xf5,
+	0x4d, 0x3b, 0xab, 0x9f, 0x45, 0x39, 0x07, 0x77, 0x5a, 0x3e, 0x44, 0x9b, 0x7f, 0x06, 0x00, 0x1b,
+	0x8b, 0x63, 0xdf, 0x35, 0x05, 0x00, 0x00,
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AutoFeeTokensEpochIdentifier) > 0 {
+		i -= len(m.AutoFeeTokensEpochIdentifier)
+		copy(dAtA[i:], m.AutoFeeTokensEpochIdentifier)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.AutoFeeTokensEpochIdentifier)))
+		i--
+		dAtA[i] = 0x52
+	}
+	{
+		size := m.MinAutoFeeTokenLiquidity.Size()
+		i -= size
+		if _, err := m.MinAutoFeeTokenLiquidity.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	if m.AutoFeeTokensEnabled {
+		i--
+		if m.AutoFeeTokensEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	{
+		size := m.MaxBaseFeeChangeRate.Size()
+		i -= size
+		if _, err := m.MaxBaseFeeChangeRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if m.TargetBlockGas != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.TargetBlockGas))
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		size := m.MaxBaseFee.Size()
+		i -= size
+		if _, err := m.MaxBaseFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.MinBaseFee.Size()
+		i -= size
+		if _, err := m.MinBaseFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.BaseFeeEnabled {
+		i--
+		if m.BaseFeeEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.MaxFeeSwapPriceDeviation.Size()
+		i -= size
+		if _, err := m.MaxFeeSwapPriceDeviation.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	n1, err1 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.FeeSwapTwapWindow, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.FeeSwapTwapWindow):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintParams(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
+	offset -= sovParams(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.FeeSwapTwapWindow)
+	n += 1 + l + sovParams(uint64(l))
+	l = m.MaxFeeSwapPriceDeviation.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if m.BaseFeeEnabled {
+		n += 2
+	}
+	l = m.MinBaseFee.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.MaxBaseFee.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if m.TargetBlockGas != 0 {
+		n += 1 + sovParams(uint64(m.TargetBlockGas))
+	}
+	l = m.MaxBaseFeeChangeRate.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if m.AutoFeeTokensEnabled {
+		n += 2
+	}
+	l = m.MinAutoFeeTokenLiquidity.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = len(m.AutoFeeTokensEpochIdentifier)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	return n
+}
+
+func sovParams(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozParams(x uint64) (n int) {
+	return sovParams(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeSwapTwapWindow", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.FeeSwapTwapWindow, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxFeeSwapPriceDeviation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxFeeSwapPriceDeviation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseFeeEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.BaseFeeEnabled = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinBaseFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinBaseFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBaseFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxBaseFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetBlockGas", wireType)
+			}
+			m.TargetBlockGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TargetBlockGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBaseFeeChangeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxBaseFeeChangeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoFeeTokensEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AutoFeeTokensEnabled = bool(v != 0)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinAutoFeeTokenLiquidity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinAutoFeeTokenLiquidity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoFeeTokensEpochIdentifier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AutoFeeTokensEpochIdentifier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipParams(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthParams
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupParams
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthParams
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthParams        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowParams          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupParams = fmt.Errorf("proto: unexpected end of group")
+)
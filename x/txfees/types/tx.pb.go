@@ -0,0 +1,1080 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: osmosis/txfees/v1beta1/tx.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	grpc1 "github.com/gogo/protobuf/grpc"
+	proto "github.com/gogo/protobuf/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// MsgRegisterFeeSponsor registers a contract as a fee sponsor, or replaces the
+// message types and addresses it sponsors. The sender must be the contract
+// itself or its admin.
+type MsgRegisterFeeSponsor struct {
+	Sender          string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
+	ContractAddress string `protobuf:"bytes,2,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty" yaml:"contract_address"`
+	// msg_type_urls are the type urls of the messages the contract sponsors.
+	// An empty list sponsors messages of any type.
+	MsgTypeUrls []string `protobuf:"bytes,3,rep,name=msg_type_urls,json=msgTypeUrls,proto3" json:"msg_type_urls,omitempty" yaml:"msg_type_urls"`
+	// addresses are the fee payers the contract sponsors.
+	// An empty list sponsors any fee payer.
+	Addresses []string `protobuf:"bytes,4,rep,name=addresses,proto3" json:"addresses,omitempty" yaml:"addresses"`
+}
+
+func (m *MsgRegisterFeeSponsor) Reset()         { *m = MsgRegisterFeeSponsor{} }
+func (m *MsgRegisterFeeSponsor) String() string { return proto.CompactTextString(m) }
+func (*MsgRegisterFeeSponsor) ProtoMessage()    {}
+func (*MsgRegisterFeeSponsor) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3d23e2aa9435ce2a, []int{0}
+}
+func (m *MsgRegisterFeeSponsor) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRegisterFeeSponsor) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRegisterFeeSponsor.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRegisterFeeSponsor) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRegisterFeeSponsor.Merge(m, src)
+}
+func (m *MsgRegisterFeeSponsor) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRegisterFeeSponsor) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRegisterFeeSponsor.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRegisterFeeSponsor proto.InternalMessageInfo
+
+func (m *MsgRegisterFeeSponsor) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgRegisterFeeSponsor) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
+	}
+	return ""
+}
+
+func (m *MsgRegisterFeeSponsor) GetMsgTypeUrls() []string {
+	if m != nil {
+		return m.MsgTypeUrls
+	}
+	return nil
+}
+
+func (m *MsgRegisterFeeSponsor) GetAddresses() []string {
+	if m != nil {
+		return m.Addresses
+	}
+	return nil
+}
+
+type MsgRegisterFeeSponsorResponse struct {
+}
+
+func (m *MsgRegisterFeeSponsorResponse) Reset()         { *m = MsgRegisterFeeSponsorResponse{} }
+func (m *MsgRegisterFeeSponsorResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRegisterFeeSponsorResponse) ProtoMessage()    {}
+func (*MsgRegisterFeeSponsorResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3d23e2aa9435ce2a, []int{1}
+}
+func (m *MsgRegisterFeeSponsorResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRegisterFeeSponsorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRegisterFeeSponsorResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRegisterFeeSponsorResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRegisterFeeSponsorResponse.Merge(m, src)
+}
+func (m *MsgRegisterFeeSponsorResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRegisterFeeSponsorResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRegisterFeeSponsorResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRegisterFeeSponsorResponse proto.InternalMessageInfo
+
+// MsgUnregisterFeeSponsor removes a contract from the fee sponsors. The sender
+// must be the contract itself or its admin.
+type MsgUnregisterFeeSponsor struct {
+	Sender          string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
+	ContractAddress string `protobuf:"bytes,2,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty" yaml:"contract_address"`
+}
+
+func (m *MsgUnregisterFeeSponsor) Reset()         { *m = MsgUnregisterFeeSponsor{} }
+func (m *MsgUnregisterFeeSponsor) String() string { return proto.CompactTextString(m) }
+func (*MsgUnregisterFeeSponsor) ProtoMessage()    {}
+func (*MsgUnregisterFeeSponsor) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3d23e2aa9435ce2a, []int{2}
+}
+func (m *MsgUnregisterFeeSponsor) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUnregisterFeeSponsor) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUnregisterFeeSponsor.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUnregisterFeeSponsor) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUnregisterFeeSponsor.Merge(m, src)
+}
+func (m *MsgUnregisterFeeSponsor) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUnregisterFeeSponsor) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUnregisterFeeSponsor.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUnregisterFeeSponsor proto.InternalMessageInfo
+
+func (m *MsgUnregisterFeeSponsor) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgUnregisterFeeSponsor) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
+	}
+	return ""
+}
+
+type MsgUnregisterFeeSponsorResponse struct {
+}
+
+func (m *MsgUnregisterFeeSponsorResponse) Reset()         { *m = MsgUnregisterFeeSponsorResponse{} }
+func (m *MsgUnregisterFeeSponsorResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUnregisterFeeSponsorResponse) ProtoMessage()    {}
+func (*MsgUnregisterFeeSponsorResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3d23e2aa9435ce2a, []int{3}
+}
+func (m *MsgUnregisterFeeSponsorResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUnregisterFeeSponsorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUnregisterFeeSponsorResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUnregisterFeeSponsorResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUnregisterFeeSponsorResponse.Merge(m, src)
+}
+func (m *MsgUnregisterFeeSponsorResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUnregisterFeeSponsorResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUnregisterFeeSponsorResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUnregisterFeeSponsorResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgRegisterFeeSponsor)(nil), "osmosis.txfees.v1beta1.MsgRegisterFeeSponsor")
+	proto.RegisterType((*MsgRegisterFeeSponsorResponse)(nil), "osmosis.txfees.v1beta1.MsgRegisterFeeSponsorResponse")
+	proto.RegisterType((*MsgUnregisterFeeSponsor)(nil), "osmosis.txfees.v1beta1.MsgUnregisterFeeSponsor")
+	proto.RegisterType((*MsgUnregisterFeeSponsorResponse)(nil), "osmosis.txfees.v1beta1.MsgUnregisterFeeSponsorResponse")
+}
+
+func init() { proto.RegisterFile("osmosis/txfees/v1beta1/tx.proto", fileDescriptor_3d23e2aa9435ce2a) }
+
+var fileDescriptor_3d23e2aa9435ce2a = []byte{
+	// 396 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x93, 0xbf, 0xef, 0xd2, 0x40,
+	0x18, 0xc6, 0x39, 0x30, 0x24, 0x9c, 0x21, 0xe2, 0xa5, 0x4a, 0x83, 0xb1, 0xc5, 0x9b, 0x70, 0xa0,
+	0x17, 0x20, 0xc6, 0xc4, 0xb8, 0xc8, 0xc0, 0x24, 0x4b, 0x95, 0xc5, 0x85, 0xb4, 0x70, 0x9e, 0x24,
+	0x6d, 0xaf, 0xb9, 0xf7, 0x20, 0xe0, 0xe4, 0xe0, 0xe8, 0xe0, 0x9f, 0xe5, 0xc8, 0xe8, 0xd4, 0x18,
+	0xf8, 0x0f, 0x3a, 0x3b, 0x18, 0xfa, 0x43, 0xa3, 0xa9, 0xdf, 0x84, 0xed, 0xbb, 0x5d, 0xdf, 0xfb,
+	0x3c, 0x4f, 0x9e, 0xbe, 0xf7, 0xbe, 0xd8, 0x96, 0x10, 0x4a, 0xd8, 0x00, 0xd3, 0xfb, 0xf7, 0x9c,
+	0x03, 0xdb, 0x8d, 0x7c, 0xae, 0xbd, 0x11, 0xd3, 0x7b, 0x27, 0x56, 0x52, 0x4b, 0xf2, 0xb0, 0x00,
+	0x9c, 0x1c, 0x70, 0x0a, 0xa0, 0x67, 0x08, 0x29, 0x64, 0x86, 0xb0, 0xcb, 0x29, 0xa7, 0xe9, 0x4f,
+	0x84, 0x1f, 0xcc, 0x41, 0xb8, 0x5c, 0x6c, 0x40, 0x73, 0x35, 0xe3, 0xfc, 0x4d, 0x2c, 0x23, 0x90,
+	0x8a, 0x3c, 0xc5, 0x4d, 0xe0, 0xd1, 0x9a, 0x2b, 0x13, 0xf5, 0xd1, 0xa0, 0x35, 0xbd, 0x9f, 0x26,
+	0x76, 0xfb, 0xe0, 0x85, 0xc1, 0x0b, 0x9a, 0xd7, 0xa9, 0x5b, 0x00, 0x64, 0x86, 0x3b, 0x2b, 0x19,
+	0x69, 0xe5, 0xad, 0xf4, 0xd2, 0x5b, 0xaf, 0x15, 0x07, 0x30, 0xeb, 0x99, 0xe8, 0x51, 0x9a, 0xd8,
+	0xdd, 0x5c, 0xf4, 0x2f, 0x41, 0xdd, 0x7b, 0x65, 0xe9, 0x55, 0x5e, 0x21, 0x2f, 0x71, 0x3b, 0x04,
+	0xb1, 0xd4, 0x87, 0x98, 0x2f, 0xb7, 0x2a, 0x00, 0xb3, 0xd1, 0x6f, 0x0c, 0x5a, 0x53, 0x33, 0x4d,
+	0x6c, 0x23, 0x37, 0xf9, 0xeb, 0x9a, 0xba, 0x77, 0x43, 0x10, 0x6f, 0x0f, 0x31, 0x5f, 0xa8, 0x00,
+	0xc8, 0x18, 0xb7, 0x0a, 0x6b, 0x0e, 0xe6, 0x9d, 0x4c, 0x69, 0xa4, 0x89, 0xdd, 0xc9, 0x95, 0xbf,
+	0xaf, 0xa8, 0xfb, 0x07, 0xa3, 0x36, 0x7e, 0x5c, 0xf9, 0xf7, 0x2e, 0x87, 0xcb, 0x81, 0xd3, 0x2f,
+	0x08, 0x77, 0xe7, 0x20, 0x16, 0x91, 0xba, 0x0d, 0x1d, 0xa2, 0x4f, 0xb0, 0xfd, 0x9f, 0x34, 0x65,
+	0xe2, 0xf1, 0xe7, 0x3a, 0x6e, 0xcc, 0x41, 0x90, 0x8f, 0x98, 0x54, 0xbc, 0xea, 0xd0, 0xa9, 0x1e,
+	0x0f, 0xa7, 0xb2, 0x0d, 0xbd, 0x67, 0x57, 0xe1, 0x65, 0x06, 0xf2, 0x09, 0x61, 0xa3, 0xb2, 0x65,
+	0xec, 0x06, 0xbf, 0x2a, 0x41, 0xef, 0xf9, 0x95, 0x82, 0x32, 0xc2, 0xf4, 0xf5, 0xb7, 0x93, 0x85,
+	0x8e, 0x27, 0x0b, 0xfd, 0x38, 0x59, 0xe8, 0xeb, 0xd9, 0xaa, 0x1d, 0xcf, 0x56, 0xed, 0xfb, 0xd9,
+	0xaa, 0xbd, 0x1b, 0x8b, 0x8d, 0xfe, 0xb0, 0xf5, 0x9d, 0x95, 0x0c, 0x59, 0x61, 0x3e, 0x0c, 0x3c,
+	0x1f, 0xca, 0x0f, 0xb6, 0x1b, 0x4d, 0xd8, 0xbe, 0xdc, 0xaf, 0xcb, 0xb4, 0x81, 0xdf, 0xcc, 0xb6,
+	0x65, 0xf2, 0x6b, 0x00, 0xc4, 0xff, 0x54, 0xc3, 0x7e, 0x03, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	RegisterFeeSponsor(ctx context.Context, in *MsgRegisterFeeSponsor, opts ...grpc.CallOption) (*MsgRegisterFeeSponsorResponse, error)
+	UnregisterFeeSponsor(ctx context.Context, in *MsgUnregisterFeeSponsor, opts ...grpc.CallOption) (*MsgUnregisterFeeSponsorResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) RegisterFeeSponsor(ctx context.Context, in *MsgRegisterFeeSponsor, opts ...grpc.CallOption) (*MsgRegisterFeeSponsorResponse, error) {
+	out := new(MsgRegisterFeeSponsorResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.txfees.v1beta1.Msg/RegisterFeeSponsor", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UnregisterFeeSponsor(ctx context.Context, in *MsgUnregisterFeeSponsor, opts ...grpc.CallOption) (*MsgUnregisterFeeSponsorResponse, error) {
+	out := new(MsgUnregisterFeeSponsorResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.txfees.v1beta1.Msg/UnregisterFeeSponsor", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	RegisterFeeSponsor(context.Context, *MsgRegisterFeeSponsor) (*MsgRegisterFeeSponsorResponse, error)
+	UnregisterFeeSponsor(context.Context, *MsgUnregisterFeeSponsor) (*MsgUnregisterFeeSponsorResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) RegisterFeeSponsor(ctx context.Context, req *MsgRegisterFeeSponsor) (*MsgRegisterFeeSponsorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterFeeSponsor not implemented")
+}
+func (*UnimplementedMsgServer) UnregisterFeeSponsor(ctx context.Context, req *MsgUnregisterFeeSponsor) (*MsgUnregisterFeeSponsorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnregisterFeeSponsor not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_RegisterFeeSponsor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRegisterFeeSponsor)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RegisterFeeSponsor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.txfees.v1beta1.Msg/RegisterFeeSponsor",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RegisterFeeSponsor(ctx, req.(*MsgRegisterFeeSponsor))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UnregisterFeeSponsor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUnregisterFeeSponsor)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UnregisterFeeSponsor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.txfees.v1beta1.Msg/UnregisterFeeSponsor",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UnregisterFeeSponsor(ctx, req.(*MsgUnregisterFeeSponsor))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "osmosis.txfees.v1beta1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "RegisterFeeSponsor",
+			Handler:    _Msg_RegisterFeeSponsor_Handler,
+		},
+		{
+			MethodName: "UnregisterFeeSponsor",
+			Handler:    _Msg_UnregisterFeeSponsor_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "osmosis/txfees/v1beta1/tx.proto",
+}
+
+func (m *MsgRegisterFeeSponsor) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterFeeSponsor) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterFeeSponsor) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Addresses) > 0 {
+		for iNdEx := len(m.Addresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Addresses[iNdEx])
+			copy(dAtA[i:], m.Addresses[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Addresses[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.MsgTypeUrls) > 0 {
+		for iNdEx := len(m.MsgTypeUrls) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.MsgTypeUrls[iNdEx])
+			copy(dAtA[i:], m.MsgTypeUrls[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.MsgTypeUrls[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRegisterFeeSponsorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterFeeSponsorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterFeeSponsorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnregisterFeeSponsor) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnregisterFeeSponsor) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnregisterFeeSponsor) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnregisterFeeSponsorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnregisterFeeSponsorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnregisterFeeSponsorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgRegisterFeeSponsor) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.MsgTypeUrls) > 0 {
+		for _, s := range m.MsgTypeUrls {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.Addresses) > 0 {
+		for _, s := range m.Addresses {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgRegisterFeeSponsorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUnregisterFeeSponsor) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUnregisterFeeSponsorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgRegisterFeeSponsor) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterFeeSponsor: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterFeeSponsor: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrls", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrls = append(m.MsgTypeUrls, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRegisterFeeSponsorResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterFeeSponsorResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterFeeSponsorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUnregisterFeeSponsor) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUnregisterFeeSponsor: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUnregisterFeeSponsor: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUnregisterFeeSponsorResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUnregisterFeeSponsorResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUnregisterFeeSponsorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipTx(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthTx
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupTx
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthTx
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthTx        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTx          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupTx = fmt.Errorf("proto: unexpected end of group")
+)
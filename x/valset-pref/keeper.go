@@ -16,6 +16,7 @@ type Keeper struct {
 	storeKey      sdk.StoreKey
 	paramSpace    paramtypes.Subspace
 	stakingKeeper types.StakingInterface
+	hooks         types.ValidatorSetPreferenceHooks
 }
 
 func NewKeeper(storeKey sdk.StoreKey,
@@ -29,6 +30,17 @@ func NewKeeper(storeKey sdk.StoreKey,
 	}
 }
 
+// SetHooks sets the validator set preference hooks.
+func (k *Keeper) SetHooks(vh types.ValidatorSetPreferenceHooks) *Keeper {
+	if k.hooks != nil {
+		panic("cannot set validator set preference hooks twice")
+	}
+
+	k.hooks = vh
+
+	return k
+}
+
 func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
 }
@@ -38,6 +50,18 @@ func (k Keeper) SetValidatorSetPreferences(ctx sdk.Context, delegator string, va
 	osmoutils.MustSet(store, []byte(delegator), &validators)
 }
 
+// AfterValidatorSetPreferenceSet calls the hooks after the validator set preference of a delegator is set.
+func (k Keeper) AfterValidatorSetPreferenceSet(ctx sdk.Context, delegator string) error {
+	if k.hooks == nil {
+		return nil
+	}
+	delegatorAddr, err := sdk.AccAddressFromBech32(delegator)
+	if err != nil {
+		return err
+	}
+	return k.hooks.AfterValidatorSetPreferenceSet(ctx, delegatorAddr)
+}
+
 func (k Keeper) GetValidatorSetPreference(ctx sdk.Context, delegator string) (types.ValidatorSetPreferences, bool) {
 	store := ctx.KVStore(k.storeKey)
 	bz := store.Get([]byte(delegator))
@@ -35,6 +35,11 @@ func (server msgServer) SetValidatorSetPreference(goCtx context.Context, msg *ty
 	}
 
 	server.keeper.SetValidatorSetPreferences(ctx, msg.Delegator, setMsg)
+
+	err = server.keeper.AfterValidatorSetPreferenceSet(ctx, msg.Delegator)
+	if err != nil {
+		return nil, err
+	}
 	return &types.MsgSetValidatorSetPreferenceResponse{}, nil
 }
 
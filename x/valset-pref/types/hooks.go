@@ -0,0 +1,30 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+type ValidatorSetPreferenceHooks interface {
+	// AfterValidatorSetPreferenceSet is called after the validator set preference of a delegator is created or changed.
+	// An error reverts the preference change.
+	AfterValidatorSetPreferenceSet(ctx sdk.Context, delegator sdk.AccAddress) error
+}
+
+var _ ValidatorSetPreferenceHooks = MultiValidatorSetPreferenceHooks{}
+
+// combine multiple validator set preference hooks, all hook functions are run in array sequence.
+type MultiValidatorSetPreferenceHooks []ValidatorSetPreferenceHooks
+
+func NewMultiValidatorSetPreferenceHooks(hooks ...ValidatorSetPreferenceHooks) MultiValidatorSetPreferenceHooks {
+	return hooks
+}
+
+// AfterValidatorSetPreferenceSet runs the hooks in sequence, returning the first error.
+func (h MultiValidatorSetPreferenceHooks) AfterValidatorSetPreferenceSet(ctx sdk.Context, delegator sdk.AccAddress) error {
+	for i := range h {
+		if err := h[i].AfterValidatorSetPreferenceSet(ctx, delegator); err != nil {
+			return err
+		}
+	}
+	return nil
+}